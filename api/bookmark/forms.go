@@ -28,4 +28,5 @@ type SearchImprovePostForm struct {
 	Level  models.BookmarkLevel  `json:"level"`
 	Limit  int                   `json:"limit"`
 	Offset int                   `json:"offset"`
+	Cursor *string               `json:"cursor"`
 }
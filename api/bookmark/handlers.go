@@ -39,6 +39,21 @@ func improvePostReadAPI(c *gin.Context, _ string, form ReadImprovePostForm, prov
 }
 
 func improvePostReadSearchAPI(c *gin.Context, _ string, form SearchImprovePostForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	if form.Cursor != nil {
+		res, next, err := provider.ListAfter(c, form.UserID, form.Level, form.Target, *form.Cursor, form.Limit)
+
+		if err != nil {
+			return api.CallbackResponse{}, err
+		}
+
+		return api.CallbackResponse{
+			Body: map[string]interface{}{
+				"data": res,
+				"next": next,
+			},
+		}, nil
+	}
+
 	res, total, err := provider.List(c, form.UserID, form.Level, form.Target, form.Limit, form.Offset)
 
 	if err != nil {
@@ -3,6 +3,8 @@ package forumapi
 import (
 	"github.com/a-novel/agora-backend/api"
 	"github.com/a-novel/agora-backend/environment/forum/improve_post"
+	"github.com/a-novel/agora-backend/environment/forum/moderation"
+	"github.com/a-novel/agora-backend/environment/forum/tags"
 	"github.com/gin-gonic/gin"
 	"net/http"
 )
@@ -17,12 +19,72 @@ func ImproveRequestAPI(basePath string, r gin.IRouter, provider improve_post.Pro
 			http.MethodPut:    api.WithContext[UpdateImproveRequestForm, improve_post.Provider](improveRequestUpdateAPI, provider),
 			http.MethodDelete: api.WithContext[DeleteImproveRequestForm, improve_post.Provider](improveRequestDeleteAPI, provider),
 		},
+		"/restore": {
+			http.MethodPut: api.WithContext[RestoreImproveRequestForm, improve_post.Provider](improveRequestRestoreAPI, provider),
+		},
 		"/search": {
 			http.MethodPost: api.WithContext[SearchImproveRequestForm, improve_post.Provider](improveRequestSearchAPI, provider),
 		},
 		"/previews": {
 			http.MethodPost: api.WithContext[PreviewImproveRequestsForm, improve_post.Provider](improveRequestPreviewsAPI, provider),
 		},
+		"/related": {
+			http.MethodPost: api.WithContext[RelatedImproveRequestsForm, improve_post.Provider](improveRequestRelatedAPI, provider),
+		},
+		"/collaborators": {
+			http.MethodPost:   api.WithContext[ListImproveRequestCollaboratorsForm, improve_post.Provider](improveRequestCollaboratorsListAPI, provider),
+			http.MethodPut:    api.WithContext[ImproveRequestCollaboratorForm, improve_post.Provider](improveRequestCollaboratorsCreateAPI, provider),
+			http.MethodPatch:  api.WithContext[ImproveRequestCollaboratorForm, improve_post.Provider](improveRequestCollaboratorsUpdateAPI, provider),
+			http.MethodDelete: api.WithContext[RemoveImproveRequestCollaboratorForm, improve_post.Provider](improveRequestCollaboratorsDeleteAPI, provider),
+		},
+		"/collaborators/invitations": {
+			http.MethodGet: api.WithContext[any, improve_post.Provider](improveRequestCollaborationInvitationsListAPI, provider),
+		},
+		"/collaborators/accept": {
+			http.MethodPost: api.WithContext[AcceptImproveRequestCollaborationForm, improve_post.Provider](improveRequestCollaborationAcceptAPI, provider),
+		},
+		"/state": {
+			http.MethodPost: api.WithContext[ReadImproveRequestStateForm, improve_post.Provider](improveRequestStateReadAPI, provider),
+			http.MethodPut:  api.WithContext[UpdateImproveRequestStateForm, improve_post.Provider](improveRequestStateUpdateAPI, provider),
+		},
+		"/visibility": {
+			http.MethodPost: api.WithContext[ReadImproveRequestVisibilityForm, improve_post.Provider](improveRequestVisibilityReadAPI, provider),
+			http.MethodPut:  api.WithContext[UpdateImproveRequestVisibilityForm, improve_post.Provider](improveRequestVisibilityUpdateAPI, provider),
+		},
+		"/invites": {
+			http.MethodPost:   api.WithContext[ListImproveRequestInvitesForm, improve_post.Provider](improveRequestInvitesListAPI, provider),
+			http.MethodPut:    api.WithContext[ImproveRequestInviteForm, improve_post.Provider](improveRequestInvitesCreateAPI, provider),
+			http.MethodDelete: api.WithContext[ImproveRequestInviteForm, improve_post.Provider](improveRequestInvitesDeleteAPI, provider),
+		},
+		"/critique": {
+			http.MethodPost: api.WithContext[ReadImproveRequestForm, improve_post.Provider](improveRequestCritiqueReadAPI, provider),
+			http.MethodPut:  api.WithContext[UpdateImproveRequestAspectsForm, improve_post.Provider](improveRequestAspectsUpdateAPI, provider),
+		},
+		"/share": {
+			http.MethodPost:   api.WithContext[ListImproveRequestShareTokensForm, improve_post.Provider](improveRequestShareTokensListAPI, provider),
+			http.MethodPut:    api.WithContext[CreateImproveRequestShareTokenForm, improve_post.Provider](improveRequestShareTokensCreateAPI, provider),
+			http.MethodDelete: api.WithContext[RevokeImproveRequestShareTokenForm, improve_post.Provider](improveRequestShareTokensDeleteAPI, provider),
+		},
+	})
+}
+
+// ImproveRequestDraftAPI manages the drafts of the current user.
+func ImproveRequestDraftAPI(basePath string, r gin.IRouter, provider improve_post.Provider) {
+	api.LoadAPI(r, basePath, api.Config{
+		"/": {
+			http.MethodPost: api.WithContext[ReadImproveRequestDraftForm, improve_post.Provider](improveRequestDraftReadAPI, provider),
+		},
+		"/list": {
+			http.MethodPost: api.WithContext[ListImproveRequestDraftsForm, improve_post.Provider](improveRequestDraftListAPI, provider),
+		},
+		"/edit": {
+			http.MethodPost:   api.WithContext[CreateImproveRequestDraftForm, improve_post.Provider](improveRequestDraftCreateAPI, provider),
+			http.MethodPut:    api.WithContext[UpdateImproveRequestDraftForm, improve_post.Provider](improveRequestDraftUpdateAPI, provider),
+			http.MethodDelete: api.WithContext[DeleteImproveRequestDraftForm, improve_post.Provider](improveRequestDraftDeleteAPI, provider),
+		},
+		"/publish": {
+			http.MethodPost: api.WithContext[PublishImproveRequestDraftForm, improve_post.Provider](improveRequestDraftPublishAPI, provider),
+		},
 	})
 }
 
@@ -31,11 +93,21 @@ func ImproveSuggestionAPI(basePath string, r gin.IRouter, provider improve_post.
 		"/": {
 			http.MethodPost: api.WithContext[ReadImproveSuggestionForm, improve_post.Provider](improveSuggestionReadAPI, provider),
 		},
+		"/revisions": {
+			http.MethodPost: api.WithContext[ReadImproveSuggestionForm, improve_post.Provider](improveSuggestionRevisionsAPI, provider),
+		},
 		"/edit": {
 			http.MethodPost:   api.WithContext[CreateImproveSuggestionForm, improve_post.Provider](improveSuggestionCreateAPI, provider),
 			http.MethodPut:    api.WithContext[UpdateImproveSuggestionForm, improve_post.Provider](improveSuggestionUpdateAPI, provider),
 			http.MethodDelete: api.WithContext[DeleteImproveSuggestionForm, improve_post.Provider](improveSuggestionDeleteAPI, provider),
 		},
+		"/restore": {
+			http.MethodPut: api.WithContext[RestoreImproveSuggestionForm, improve_post.Provider](improveSuggestionRestoreAPI, provider),
+		},
+		"/ratings": {
+			http.MethodPost: api.WithContext[ReadImproveSuggestionForm, improve_post.Provider](improveSuggestionRatingsReadAPI, provider),
+			http.MethodPut:  api.WithContext[RateImproveSuggestionForm, improve_post.Provider](improveSuggestionRatingsUpdateAPI, provider),
+		},
 		"/search": {
 			http.MethodPost: api.WithContext[SearchImproveSuggestionForm, improve_post.Provider](improveSuggestionSearchAPI, provider),
 		},
@@ -45,6 +117,23 @@ func ImproveSuggestionAPI(basePath string, r gin.IRouter, provider improve_post.
 	})
 }
 
+func SearchAPI(basePath string, r gin.IRouter, provider improve_post.Provider) {
+	api.LoadAPI(r, basePath, api.Config{
+		"/": {
+			http.MethodPost: api.WithContext[SearchForumForm, improve_post.Provider](forumSearchAPI, provider),
+		},
+	})
+}
+
+// LeaderboardsAPI exposes the leaderboards of the forum, as computed by the last run of the /leaderboards job.
+func LeaderboardsAPI(basePath string, r gin.IRouter, provider improve_post.Provider) {
+	api.LoadAPI(r, basePath, api.Config{
+		"/": {
+			http.MethodPost: api.WithContext[ListLeaderboardForm, improve_post.Provider](leaderboardListAPI, provider),
+		},
+	})
+}
+
 func VotesAPI(basePath string, r gin.IRouter, provider improve_post.Provider) {
 	api.LoadAPI(r, basePath, api.Config{
 		"/": {
@@ -56,5 +145,156 @@ func VotesAPI(basePath string, r gin.IRouter, provider improve_post.Provider) {
 		"/search": {
 			http.MethodPost: api.WithContext[SearchVotesForm, improve_post.Provider](voteSearchAPI, provider),
 		},
+		"/reactions": {
+			http.MethodGet:  api.WithContext[any, improve_post.Provider](reactionListAPI, provider),
+			http.MethodPost: api.WithContext[ReactForm, improve_post.Provider](reactionUpdateAPI, provider),
+		},
+		"/reactions/status": {
+			http.MethodPost: api.WithContext[ReadReactionsForm, improve_post.Provider](reactionReadAPI, provider),
+		},
+	})
+}
+
+func TagsAPI(basePath string, r gin.IRouter, provider tags.Provider) {
+	api.LoadAPI(r, basePath, api.Config{
+		"/": {
+			http.MethodPost: api.WithContext[ListTagsForm, tags.Provider](tagsListAPI, provider),
+		},
+		"/autocomplete": {
+			http.MethodPost: api.WithContext[SearchTagsForm, tags.Provider](tagsAutocompleteAPI, provider),
+		},
+		"/edit": {
+			http.MethodPost:   api.WithContext[CreateTagForm, tags.Provider](tagsCreateAPI, provider),
+			http.MethodDelete: api.WithContext[DeleteTagForm, tags.Provider](tagsDeleteAPI, provider),
+		},
+	})
+}
+
+// ModerationAPI is restricted to moderators, except for the /report route.
+func ModerationAPI(basePath string, r gin.IRouter, provider moderation.Provider) {
+	api.LoadAPI(r, basePath, api.Config{
+		"/duplicates": {
+			http.MethodPost: api.WithContext[ListDuplicateFlagsForm, moderation.Provider](duplicateFlagsListAPI, provider),
+			http.MethodPut:  api.WithContext[ReviewDuplicateFlagForm, moderation.Provider](duplicateFlagsReviewAPI, provider),
+		},
+		"/vote-rings": {
+			http.MethodPost: api.WithContext[ListVoteRingFlagsForm, moderation.Provider](voteRingFlagsListAPI, provider),
+			http.MethodPut:  api.WithContext[ReviewVoteRingFlagForm, moderation.Provider](voteRingFlagsReviewAPI, provider),
+		},
+		"/lock": {
+			http.MethodPut:    api.WithContext[ModerateImproveRequestForm, moderation.Provider](improveRequestLockAPI, provider),
+			http.MethodDelete: api.WithContext[ModerateImproveRequestForm, moderation.Provider](improveRequestUnlockAPI, provider),
+		},
+		"/report": {
+			http.MethodPut: api.WithContext[ReportContentForm, moderation.Provider](reportContentAPI, provider),
+		},
+		"/queue": {
+			http.MethodPost: api.WithContext[ListReportCasesForm, moderation.Provider](reportCasesListAPI, provider),
+		},
+		"/queue/reports": {
+			http.MethodPost: api.WithContext[ReportCaseForm, moderation.Provider](reportCaseReportsAPI, provider),
+		},
+		"/queue/claim": {
+			http.MethodPut: api.WithContext[ReportCaseForm, moderation.Provider](reportCaseClaimAPI, provider),
+		},
+		"/queue/resolve": {
+			http.MethodPut: api.WithContext[ReportCaseForm, moderation.Provider](reportCaseResolveAPI, provider),
+		},
+		"/queue/dismiss": {
+			http.MethodPut: api.WithContext[ReportCaseForm, moderation.Provider](reportCaseDismissAPI, provider),
+		},
+		"/log": {
+			http.MethodPost: api.WithContext[ListModerationActionsForm, moderation.Provider](moderationActionsListAPI, provider),
+		},
+	})
+}
+
+// JobsAPI exposes the forum maintenance tasks, meant to be triggered periodically by a backend service. Requests are
+// only authenticated if allowedUsers is not empty.
+func JobsAPI(
+	basePath string, r gin.IRouter, provider improve_post.Provider, moderationProvider moderation.Provider,
+	allowedUsers []string,
+) {
+	api.LoadAPI(r, basePath, api.Config{
+		"/rankings": {
+			http.MethodPost: func(c *gin.Context) {
+				if err := provider.RefreshImproveRequestRankings(c, backendServiceAuth(c, allowedUsers)); err != nil {
+					_ = c.AbortWithError(http.StatusInternalServerError, err)
+					return
+				}
+
+				c.AbortWithStatus(http.StatusNoContent)
+			},
+		},
+		"/drafts": {
+			http.MethodPost: func(c *gin.Context) {
+				if err := provider.PublishScheduledImproveRequestDrafts(c, backendServiceAuth(c, allowedUsers)); err != nil {
+					_ = c.AbortWithError(http.StatusInternalServerError, err)
+					return
+				}
+
+				c.AbortWithStatus(http.StatusNoContent)
+			},
+		},
+		"/close": {
+			http.MethodPost: func(c *gin.Context) {
+				if err := provider.CloseInactiveImproveRequests(c, backendServiceAuth(c, allowedUsers)); err != nil {
+					_ = c.AbortWithError(http.StatusInternalServerError, err)
+					return
+				}
+
+				c.AbortWithStatus(http.StatusNoContent)
+			},
+		},
+		"/purge": {
+			http.MethodPost: func(c *gin.Context) {
+				if err := provider.PurgeDeletedPosts(c, backendServiceAuth(c, allowedUsers)); err != nil {
+					_ = c.AbortWithError(http.StatusInternalServerError, err)
+					return
+				}
+
+				c.AbortWithStatus(http.StatusNoContent)
+			},
+		},
+		"/votes": {
+			http.MethodPost: func(c *gin.Context) {
+				if err := provider.ReconcileVoteCounters(c, backendServiceAuth(c, allowedUsers)); err != nil {
+					_ = c.AbortWithError(http.StatusInternalServerError, err)
+					return
+				}
+
+				c.AbortWithStatus(http.StatusNoContent)
+			},
+		},
+		"/vote-rings": {
+			http.MethodPost: func(c *gin.Context) {
+				if err := moderationProvider.DetectVoteRings(c, backendServiceAuth(c, allowedUsers)); err != nil {
+					_ = c.AbortWithError(http.StatusInternalServerError, err)
+					return
+				}
+
+				c.AbortWithStatus(http.StatusNoContent)
+			},
+		},
+		"/leaderboards": {
+			http.MethodPost: func(c *gin.Context) {
+				if err := provider.RefreshLeaderboards(c, backendServiceAuth(c, allowedUsers)); err != nil {
+					_ = c.AbortWithError(http.StatusInternalServerError, err)
+					return
+				}
+
+				c.AbortWithStatus(http.StatusNoContent)
+			},
+		},
+		"/badges": {
+			http.MethodPost: func(c *gin.Context) {
+				if err := provider.AwardBadges(c, backendServiceAuth(c, allowedUsers)); err != nil {
+					_ = c.AbortWithError(http.StatusInternalServerError, err)
+					return
+				}
+
+				c.AbortWithStatus(http.StatusNoContent)
+			},
+		},
 	})
 }
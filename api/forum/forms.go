@@ -3,48 +3,173 @@ package forumapi
 import (
 	"github.com/a-novel/agora-backend/models"
 	"github.com/google/uuid"
+	"time"
 )
 
 type ReadImproveRequestForm struct {
-	PostID uuid.UUID `json:"postID"`
+	PostID     uuid.UUID `json:"postID"`
+	ShareToken string    `json:"shareToken"`
 }
 
 type CreateImproveRequestForm struct {
-	Title   string `json:"title"`
-	Content string `json:"content"`
+	Title    string      `json:"title"`
+	Content  string      `json:"content"`
+	Language string      `json:"language"`
+	Tags     []uuid.UUID `json:"tags"`
 }
 
 type UpdateImproveRequestForm struct {
-	SourceID uuid.UUID `json:"sourceID"`
-	Title    string    `json:"title"`
-	Content  string    `json:"content"`
+	SourceID uuid.UUID   `json:"sourceID"`
+	Title    string      `json:"title"`
+	Content  string      `json:"content"`
+	Language string      `json:"language"`
+	Tags     []uuid.UUID `json:"tags"`
 }
 
 type DeleteImproveRequestForm struct {
 	PostID uuid.UUID `json:"postID"`
 }
 
+type RestoreImproveRequestForm struct {
+	PostID uuid.UUID `json:"postID"`
+}
+
 type SearchImproveRequestForm struct {
-	UserID *uuid.UUID                        `json:"userID"`
-	Query  string                            `json:"query"`
-	Limit  int                               `json:"limit"`
-	Offset int                               `json:"offset"`
-	Order  *models.ImproveRequestSearchOrder `json:"order"`
+	UserID    *uuid.UUID                        `json:"userID"`
+	Query     string                            `json:"query"`
+	Language  string                            `json:"language"`
+	Tags      []uuid.UUID                       `json:"tags"`
+	Reactions []string                          `json:"reactions"`
+	States    []models.ImproveRequestState      `json:"states"`
+	Limit     int                               `json:"limit"`
+	Offset    int                               `json:"offset"`
+	Cursor    *string                           `json:"cursor"`
+	Order     *models.ImproveRequestSearchOrder `json:"order"`
 }
 
 type PreviewImproveRequestsForm struct {
 	IDs []uuid.UUID `json:"ids"`
 }
 
-type ReadImproveSuggestionForm struct {
+type RelatedImproveRequestsForm struct {
+	PostID     uuid.UUID `json:"postID"`
+	ShareToken string    `json:"shareToken"`
+	Limit      int       `json:"limit"`
+}
+
+type ListImproveRequestCollaboratorsForm struct {
+	PostID uuid.UUID `json:"postID"`
+}
+
+type ImproveRequestCollaboratorForm struct {
+	PostID uuid.UUID                             `json:"postID"`
+	UserID uuid.UUID                             `json:"userID"`
+	Role   models.ImproveRequestCollaboratorRole `json:"role"`
+}
+
+type RemoveImproveRequestCollaboratorForm struct {
+	PostID uuid.UUID `json:"postID"`
+	UserID uuid.UUID `json:"userID"`
+}
+
+type AcceptImproveRequestCollaborationForm struct {
+	PostID uuid.UUID `json:"postID"`
+}
+
+type ReadImproveRequestStateForm struct {
+	PostID     uuid.UUID `json:"postID"`
+	ShareToken string    `json:"shareToken"`
+}
+
+type UpdateImproveRequestStateForm struct {
+	PostID uuid.UUID                  `json:"postID"`
+	State  models.ImproveRequestState `json:"state"`
+	Reason string                     `json:"reason"`
+}
+
+type ReadImproveRequestVisibilityForm struct {
+	PostID uuid.UUID `json:"postID"`
+}
+
+type UpdateImproveRequestVisibilityForm struct {
+	PostID     uuid.UUID                       `json:"postID"`
+	Visibility models.ImproveRequestVisibility `json:"visibility"`
+}
+
+type ListImproveRequestInvitesForm struct {
 	PostID uuid.UUID `json:"postID"`
+	Limit  int       `json:"limit"`
+	Offset int       `json:"offset"`
+}
+
+type ImproveRequestInviteForm struct {
+	PostID uuid.UUID `json:"postID"`
+	UserID uuid.UUID `json:"userID"`
+}
+
+type ListImproveRequestShareTokensForm struct {
+	PostID uuid.UUID `json:"postID"`
+}
+
+type CreateImproveRequestShareTokenForm struct {
+	PostID uuid.UUID `json:"postID"`
+}
+
+type RevokeImproveRequestShareTokenForm struct {
+	PostID       uuid.UUID `json:"postID"`
+	ShareTokenID uuid.UUID `json:"shareTokenID"`
+}
+
+type UpdateImproveRequestAspectsForm struct {
+	PostID  uuid.UUID               `json:"postID"`
+	Aspects []models.CritiqueAspect `json:"aspects"`
+}
+
+type ReadImproveRequestDraftForm struct {
+	DraftID uuid.UUID `json:"draftID"`
+}
+
+type ListImproveRequestDraftsForm struct {
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+type CreateImproveRequestDraftForm struct {
+	Title     string      `json:"title"`
+	Content   string      `json:"content"`
+	Language  string      `json:"language"`
+	Tags      []uuid.UUID `json:"tags"`
+	PublishAt *time.Time  `json:"publishAt"`
+}
+
+type UpdateImproveRequestDraftForm struct {
+	DraftID   uuid.UUID   `json:"draftID"`
+	Title     string      `json:"title"`
+	Content   string      `json:"content"`
+	Language  string      `json:"language"`
+	Tags      []uuid.UUID `json:"tags"`
+	PublishAt *time.Time  `json:"publishAt"`
+}
+
+type DeleteImproveRequestDraftForm struct {
+	DraftID uuid.UUID `json:"draftID"`
+}
+
+type PublishImproveRequestDraftForm struct {
+	DraftID uuid.UUID `json:"draftID"`
+}
+
+type ReadImproveSuggestionForm struct {
+	PostID     uuid.UUID `json:"postID"`
+	ShareToken string    `json:"shareToken"`
 }
 
 type CreateImproveSuggestionForm struct {
-	RequestID uuid.UUID `json:"requestID"`
-	SourceID  uuid.UUID `json:"sourceID"`
-	Title     string    `json:"title"`
-	Content   string    `json:"content"`
+	RequestID  uuid.UUID `json:"requestID"`
+	SourceID   uuid.UUID `json:"sourceID"`
+	Title      string    `json:"title"`
+	Content    string    `json:"content"`
+	ShareToken string    `json:"shareToken"`
 }
 
 type UpdateImproveSuggestionForm struct {
@@ -58,14 +183,42 @@ type DeleteImproveSuggestionForm struct {
 	PostID uuid.UUID `json:"postID"`
 }
 
+type RestoreImproveSuggestionForm struct {
+	PostID uuid.UUID `json:"postID"`
+}
+
+type RateImproveSuggestionForm struct {
+	PostID  uuid.UUID                         `json:"postID"`
+	Ratings []*models.ImproveSuggestionRating `json:"ratings"`
+}
+
 type SearchImproveSuggestionForm struct {
-	UserID    *uuid.UUID                           `json:"userID"`
-	SourceID  *uuid.UUID                           `json:"sourceID"`
-	RequestID *uuid.UUID                           `json:"requestID"`
-	Validated *bool                                `json:"validated"`
-	Limit     int                                  `json:"limit"`
-	Offset    int                                  `json:"offset"`
-	Order     *models.ImproveSuggestionSearchOrder `json:"order"`
+	UserID     *uuid.UUID                           `json:"userID"`
+	SourceID   *uuid.UUID                           `json:"sourceID"`
+	RequestID  *uuid.UUID                           `json:"requestID"`
+	Validated  *bool                                `json:"validated"`
+	Reactions  []string                             `json:"reactions"`
+	Query      string                               `json:"query"`
+	Language   string                               `json:"language"`
+	Limit      int                                  `json:"limit"`
+	Offset     int                                  `json:"offset"`
+	Cursor     *string                              `json:"cursor"`
+	Order      *models.ImproveSuggestionSearchOrder `json:"order"`
+	ShareToken string                               `json:"shareToken"`
+}
+
+type SearchForumForm struct {
+	Query    string `json:"query"`
+	Language string `json:"language"`
+	Limit    int    `json:"limit"`
+	Offset   int    `json:"offset"`
+}
+
+type ListLeaderboardForm struct {
+	Board  models.Leaderboard       `json:"board"`
+	Period models.LeaderboardPeriod `json:"period"`
+	Limit  int                      `json:"limit"`
+	Offset int                      `json:"offset"`
 }
 
 type PreviewImproveSuggestionsForm struct {
@@ -83,9 +236,95 @@ type ReadVoteForm struct {
 	Target models.VoteTarget `json:"target"`
 }
 
+type ReactForm struct {
+	PostID   uuid.UUID         `json:"postID"`
+	Target   models.VoteTarget `json:"target"`
+	Reaction string            `json:"reaction"`
+	Active   bool              `json:"active"`
+}
+
+type ReadReactionsForm struct {
+	PostID uuid.UUID         `json:"postID"`
+	Target models.VoteTarget `json:"target"`
+}
+
 type SearchVotesForm struct {
 	UserID uuid.UUID         `json:"userID"`
 	Target models.VoteTarget `json:"target"`
 	Limit  int               `json:"limit"`
 	Offset int               `json:"offset"`
+	Cursor *string           `json:"cursor"`
+}
+
+type ListTagsForm struct {
+	Category *models.ForumTagCategory `json:"category"`
+}
+
+type SearchTagsForm struct {
+	Query    string                   `json:"query"`
+	Category *models.ForumTagCategory `json:"category"`
+	Limit    int                      `json:"limit"`
+}
+
+type CreateTagForm struct {
+	Category models.ForumTagCategory `json:"category"`
+	Slug     string                  `json:"slug"`
+	Name     string                  `json:"name"`
+}
+
+type DeleteTagForm struct {
+	TagID uuid.UUID `json:"tagID"`
+}
+
+type ListDuplicateFlagsForm struct {
+	Reviewed bool `json:"reviewed"`
+	Limit    int  `json:"limit"`
+	Offset   int  `json:"offset"`
+}
+
+type ReviewDuplicateFlagForm struct {
+	RevisionID         uuid.UUID `json:"revisionID"`
+	OriginalRevisionID uuid.UUID `json:"originalRevisionID"`
+}
+
+type ListVoteRingFlagsForm struct {
+	Reviewed bool `json:"reviewed"`
+	Limit    int  `json:"limit"`
+	Offset   int  `json:"offset"`
+}
+
+type ReviewVoteRingFlagForm struct {
+	AuthorID uuid.UUID `json:"authorID"`
+	VoterID  uuid.UUID `json:"voterID"`
+	Void     bool      `json:"void"`
+}
+
+type ModerateImproveRequestForm struct {
+	PostID uuid.UUID `json:"postID"`
+	Reason string    `json:"reason"`
+}
+
+type ReportContentForm struct {
+	Target   models.ModerationTarget `json:"target"`
+	TargetID uuid.UUID               `json:"targetID"`
+	Reason   models.ReportReason     `json:"reason"`
+	Content  string                  `json:"content"`
+}
+
+type ListReportCasesForm struct {
+	Statuses []models.ReportStatus `json:"statuses"`
+	Limit    int                   `json:"limit"`
+	Offset   int                   `json:"offset"`
+}
+
+type ReportCaseForm struct {
+	Target   models.ModerationTarget `json:"target"`
+	TargetID uuid.UUID               `json:"targetID"`
+	Note     string                  `json:"note"`
+}
+
+type ListModerationActionsForm struct {
+	Query  models.ModerationActionQuery `json:"query"`
+	Limit  int                          `json:"limit"`
+	Offset int                          `json:"offset"`
 }
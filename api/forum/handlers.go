@@ -3,12 +3,21 @@ package forumapi
 import (
 	"github.com/a-novel/agora-backend/api"
 	"github.com/a-novel/agora-backend/environment/forum/improve_post"
+	"github.com/a-novel/agora-backend/environment/forum/moderation"
+	"github.com/a-novel/agora-backend/environment/forum/tags"
+	"github.com/a-novel/agora-backend/environment/user/authentication"
 	"github.com/a-novel/agora-backend/models"
 	"github.com/gin-gonic/gin"
 )
 
-func improveRequestReadAPI(c *gin.Context, _ string, form ReadImproveRequestForm, provider improve_post.Provider) (api.CallbackResponse, error) {
-	revisions, err := provider.ReadImproveRequest(c, form.PostID)
+func improveRequestReadAPI(c *gin.Context, token string, form ReadImproveRequestForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	revisions, err := provider.ReadImproveRequest(c, token, form.ShareToken, form.PostID)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	critique, err := provider.ReadImproveRequestCritique(c, token, form.ShareToken, form.PostID)
 
 	if err != nil {
 		return api.CallbackResponse{}, err
@@ -16,13 +25,35 @@ func improveRequestReadAPI(c *gin.Context, _ string, form ReadImproveRequestForm
 
 	return api.CallbackResponse{
 		Body: map[string]interface{}{
-			"data": revisions,
+			"data":     revisions,
+			"critique": critique,
 		},
 	}, nil
 }
 
+func backendServiceAuth(c *gin.Context, allowedUsers []string) *authentication.BackendServiceAuth {
+	if len(allowedUsers) == 0 {
+		return nil
+	}
+
+	return &authentication.BackendServiceAuth{
+		UserAgent:     c.Request.UserAgent(),
+		Authorization: c.GetHeader("Authorization"),
+		AllowedUsers:  allowedUsers,
+	}
+}
+
+// The language of a search query defaults to the preferred language of the client.
+func searchLanguage(c *gin.Context, language string) string {
+	if language != "" {
+		return language
+	}
+
+	return c.GetHeader("Accept-Language")
+}
+
 func improveRequestCreateAPI(c *gin.Context, token string, form CreateImproveRequestForm, provider improve_post.Provider) (api.CallbackResponse, error) {
-	res, err := provider.CreateImproveRequest(c, token, form.Title, form.Content)
+	res, err := provider.CreateImproveRequest(c, token, form.Title, form.Content, form.Language, form.Tags)
 
 	if err != nil {
 		return api.CallbackResponse{}, err
@@ -36,7 +67,7 @@ func improveRequestCreateAPI(c *gin.Context, token string, form CreateImproveReq
 }
 
 func improveRequestUpdateAPI(c *gin.Context, token string, form UpdateImproveRequestForm, provider improve_post.Provider) (api.CallbackResponse, error) {
-	res, err := provider.CreateImproveRequestRevision(c, token, form.SourceID, form.Title, form.Content)
+	res, err := provider.CreateImproveRequestRevision(c, token, form.SourceID, form.Title, form.Content, form.Language, form.Tags)
 
 	if err != nil {
 		return api.CallbackResponse{}, err
@@ -53,12 +84,255 @@ func improveRequestDeleteAPI(c *gin.Context, token string, form DeleteImproveReq
 	return api.CallbackResponse{}, provider.DeleteImproveRequest(c, token, form.PostID)
 }
 
+func improveRequestRestoreAPI(c *gin.Context, token string, form RestoreImproveRequestForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	return api.CallbackResponse{}, provider.RestoreImproveRequest(c, token, form.PostID)
+}
+
 func improveRequestSearchAPI(c *gin.Context, _ string, form SearchImproveRequestForm, provider improve_post.Provider) (api.CallbackResponse, error) {
-	res, total, err := provider.SearchImproveRequests(c, models.ImproveRequestSearch{
-		UserID: form.UserID,
-		Query:  form.Query,
-		Order:  form.Order,
-	}, form.Limit, form.Offset)
+	query := models.ImproveRequestSearch{
+		UserID:    form.UserID,
+		Query:     form.Query,
+		Tags:      form.Tags,
+		Reactions: form.Reactions,
+		States:    form.States,
+		Order:     form.Order,
+		Language:  searchLanguage(c, form.Language),
+	}
+
+	if form.Cursor != nil {
+		return improveRequestSearchAfterAPI(c, query, *form.Cursor, form.Limit, provider)
+	}
+
+	res, total, err := provider.SearchImproveRequests(c, query, form.Limit, form.Offset)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	facets, err := provider.GetImproveRequestSearchFacets(c, query)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	body := map[string]interface{}{
+		"data":   res,
+		"total":  total,
+		"facets": facets,
+	}
+
+	if total == 0 && form.Query != "" {
+		suggestions, err := provider.GetImproveRequestSearchSuggestions(c, form.Query)
+
+		if err != nil {
+			return api.CallbackResponse{}, err
+		}
+
+		body["didYouMean"] = suggestions
+	}
+
+	return api.CallbackResponse{Body: body}, nil
+}
+
+// Cursor paginated version of improveRequestSearchAPI. Facets and suggestions do not depend on the page, so they are
+// only computed for the first one.
+func improveRequestSearchAfterAPI(c *gin.Context, query models.ImproveRequestSearch, cursor string, limit int, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, next, err := provider.SearchImproveRequestsAfter(c, query, cursor, limit)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	body := map[string]interface{}{
+		"data": res,
+		"next": next,
+	}
+
+	if cursor != "" {
+		return api.CallbackResponse{Body: body}, nil
+	}
+
+	facets, err := provider.GetImproveRequestSearchFacets(c, query)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	body["facets"] = facets
+
+	if len(res) == 0 && query.Query != "" {
+		suggestions, err := provider.GetImproveRequestSearchSuggestions(c, query.Query)
+
+		if err != nil {
+			return api.CallbackResponse{}, err
+		}
+
+		body["didYouMean"] = suggestions
+	}
+
+	return api.CallbackResponse{Body: body}, nil
+}
+
+func improveRequestPreviewsAPI(c *gin.Context, token string, form PreviewImproveRequestsForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.GetImproveRequestPreviews(c, token, form.IDs)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func improveRequestRelatedAPI(c *gin.Context, token string, form RelatedImproveRequestsForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.GetRelatedImproveRequests(c, token, form.ShareToken, form.PostID, form.Limit)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func improveRequestCollaboratorsListAPI(c *gin.Context, token string, form ListImproveRequestCollaboratorsForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.ListImproveRequestCollaborators(c, token, form.PostID)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func improveRequestCollaboratorsCreateAPI(c *gin.Context, token string, form ImproveRequestCollaboratorForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.InviteImproveRequestCollaborator(c, token, form.PostID, form.UserID, form.Role)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func improveRequestCollaboratorsUpdateAPI(c *gin.Context, token string, form ImproveRequestCollaboratorForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.UpdateImproveRequestCollaborator(c, token, form.PostID, form.UserID, form.Role)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func improveRequestCollaboratorsDeleteAPI(c *gin.Context, token string, form RemoveImproveRequestCollaboratorForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	return api.CallbackResponse{}, provider.RemoveImproveRequestCollaborator(c, token, form.PostID, form.UserID)
+}
+
+func improveRequestCollaborationInvitationsListAPI(c *gin.Context, token string, _ interface{}, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.ListImproveRequestCollaborationInvitations(c, token)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func improveRequestCollaborationAcceptAPI(c *gin.Context, token string, form AcceptImproveRequestCollaborationForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.AcceptImproveRequestCollaboration(c, token, form.PostID)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func improveRequestStateReadAPI(c *gin.Context, token string, form ReadImproveRequestStateForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.ReadImproveRequestState(c, token, form.ShareToken, form.PostID)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func improveRequestStateUpdateAPI(c *gin.Context, token string, form UpdateImproveRequestStateForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.UpdateImproveRequestState(c, token, form.PostID, form.State, form.Reason)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func improveRequestVisibilityReadAPI(c *gin.Context, token string, form ReadImproveRequestVisibilityForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.ReadImproveRequestVisibility(c, token, form.PostID)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func improveRequestVisibilityUpdateAPI(c *gin.Context, token string, form UpdateImproveRequestVisibilityForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.UpdateImproveRequestVisibility(c, token, form.PostID, form.Visibility)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func improveRequestInvitesListAPI(c *gin.Context, token string, form ListImproveRequestInvitesForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, total, err := provider.ListImproveRequestInvites(c, token, form.PostID, form.Limit, form.Offset)
 
 	if err != nil {
 		return api.CallbackResponse{}, err
@@ -72,8 +346,8 @@ func improveRequestSearchAPI(c *gin.Context, _ string, form SearchImproveRequest
 	}, nil
 }
 
-func improveRequestPreviewsAPI(c *gin.Context, _ string, form PreviewImproveRequestsForm, provider improve_post.Provider) (api.CallbackResponse, error) {
-	res, err := provider.GetImproveRequestPreviews(c, form.IDs)
+func improveRequestInvitesCreateAPI(c *gin.Context, token string, form ImproveRequestInviteForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.InviteToImproveRequest(c, token, form.PostID, form.UserID)
 
 	if err != nil {
 		return api.CallbackResponse{}, err
@@ -86,8 +360,12 @@ func improveRequestPreviewsAPI(c *gin.Context, _ string, form PreviewImproveRequ
 	}, nil
 }
 
-func improveSuggestionReadAPI(c *gin.Context, _ string, form ReadImproveSuggestionForm, provider improve_post.Provider) (api.CallbackResponse, error) {
-	res, err := provider.ReadImproveSuggestion(c, form.PostID)
+func improveRequestInvitesDeleteAPI(c *gin.Context, token string, form ImproveRequestInviteForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	return api.CallbackResponse{}, provider.UninviteFromImproveRequest(c, token, form.PostID, form.UserID)
+}
+
+func improveRequestShareTokensListAPI(c *gin.Context, token string, form ListImproveRequestShareTokensForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.ListImproveRequestShareTokens(c, token, form.PostID)
 
 	if err != nil {
 		return api.CallbackResponse{}, err
@@ -100,8 +378,8 @@ func improveSuggestionReadAPI(c *gin.Context, _ string, form ReadImproveSuggesti
 	}, nil
 }
 
-func improveSuggestionCreateAPI(c *gin.Context, token string, form CreateImproveSuggestionForm, provider improve_post.Provider) (api.CallbackResponse, error) {
-	res, err := provider.CreateImproveSuggestion(c, token, form.RequestID, form.SourceID, form.Title, form.Content)
+func improveRequestShareTokensCreateAPI(c *gin.Context, token string, form CreateImproveRequestShareTokenForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.CreateImproveRequestShareToken(c, token, form.PostID)
 
 	if err != nil {
 		return api.CallbackResponse{}, err
@@ -114,8 +392,12 @@ func improveSuggestionCreateAPI(c *gin.Context, token string, form CreateImprove
 	}, nil
 }
 
-func improveSuggestionUpdateAPI(c *gin.Context, token string, form UpdateImproveSuggestionForm, provider improve_post.Provider) (api.CallbackResponse, error) {
-	res, err := provider.UpdateImproveSuggestion(c, token, form.PostID, form.RequestID, form.Title, form.Content)
+func improveRequestShareTokensDeleteAPI(c *gin.Context, token string, form RevokeImproveRequestShareTokenForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	return api.CallbackResponse{}, provider.RevokeImproveRequestShareToken(c, token, form.PostID, form.ShareTokenID)
+}
+
+func improveRequestCritiqueReadAPI(c *gin.Context, token string, form ReadImproveRequestForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.ReadImproveRequestCritique(c, token, form.ShareToken, form.PostID)
 
 	if err != nil {
 		return api.CallbackResponse{}, err
@@ -128,18 +410,36 @@ func improveSuggestionUpdateAPI(c *gin.Context, token string, form UpdateImprove
 	}, nil
 }
 
-func improveSuggestionDeleteAPI(c *gin.Context, token string, form DeleteImproveSuggestionForm, provider improve_post.Provider) (api.CallbackResponse, error) {
-	return api.CallbackResponse{}, provider.DeleteImproveSuggestion(c, token, form.PostID)
+func improveRequestAspectsUpdateAPI(c *gin.Context, token string, form UpdateImproveRequestAspectsForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.UpdateImproveRequestAspects(c, token, form.PostID, form.Aspects)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
 }
 
-func improveSuggestionSearchAPI(c *gin.Context, _ string, form SearchImproveSuggestionForm, provider improve_post.Provider) (api.CallbackResponse, error) {
-	res, total, err := provider.ListImproveSuggestions(c, models.ImproveSuggestionsList{
-		UserID:    form.UserID,
-		SourceID:  form.SourceID,
-		RequestID: form.RequestID,
-		Validated: form.Validated,
-		Order:     form.Order,
-	}, form.Limit, form.Offset)
+func improveRequestDraftReadAPI(c *gin.Context, token string, form ReadImproveRequestDraftForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.ReadImproveRequestDraft(c, token, form.DraftID)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func improveRequestDraftListAPI(c *gin.Context, token string, form ListImproveRequestDraftsForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, total, err := provider.ListImproveRequestDrafts(c, token, form.Limit, form.Offset)
 
 	if err != nil {
 		return api.CallbackResponse{}, err
@@ -153,8 +453,14 @@ func improveSuggestionSearchAPI(c *gin.Context, _ string, form SearchImproveSugg
 	}, nil
 }
 
-func improveSuggestionPreviewsAPI(c *gin.Context, _ string, form PreviewImproveSuggestionsForm, provider improve_post.Provider) (api.CallbackResponse, error) {
-	res, err := provider.GetImproveSuggestionPreviews(c, form.IDs)
+func improveRequestDraftCreateAPI(c *gin.Context, token string, form CreateImproveRequestDraftForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.CreateImproveRequestDraft(c, token, &models.ImproveRequestDraftUpsert{
+		Title:     form.Title,
+		Content:   form.Content,
+		Language:  form.Language,
+		Tags:      form.Tags,
+		PublishAt: form.PublishAt,
+	})
 
 	if err != nil {
 		return api.CallbackResponse{}, err
@@ -167,8 +473,14 @@ func improveSuggestionPreviewsAPI(c *gin.Context, _ string, form PreviewImproveS
 	}, nil
 }
 
-func voteUpdateAPI(c *gin.Context, token string, form VoteForm, provider improve_post.Provider) (api.CallbackResponse, error) {
-	res, err := provider.Vote(c, token, form.PostID, form.Target, form.Vote)
+func improveRequestDraftUpdateAPI(c *gin.Context, token string, form UpdateImproveRequestDraftForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.UpdateImproveRequestDraft(c, token, form.DraftID, &models.ImproveRequestDraftUpsert{
+		Title:     form.Title,
+		Content:   form.Content,
+		Language:  form.Language,
+		Tags:      form.Tags,
+		PublishAt: form.PublishAt,
+	})
 
 	if err != nil {
 		return api.CallbackResponse{}, err
@@ -181,8 +493,12 @@ func voteUpdateAPI(c *gin.Context, token string, form VoteForm, provider improve
 	}, nil
 }
 
-func voteReadAPI(c *gin.Context, token string, form ReadVoteForm, provider improve_post.Provider) (api.CallbackResponse, error) {
-	res, err := provider.HasVoted(c, token, form.PostID, form.Target)
+func improveRequestDraftDeleteAPI(c *gin.Context, token string, form DeleteImproveRequestDraftForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	return api.CallbackResponse{}, provider.DeleteImproveRequestDraft(c, token, form.DraftID)
+}
+
+func improveRequestDraftPublishAPI(c *gin.Context, token string, form PublishImproveRequestDraftForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.PublishImproveRequestDraft(c, token, form.DraftID)
 
 	if err != nil {
 		return api.CallbackResponse{}, err
@@ -195,8 +511,488 @@ func voteReadAPI(c *gin.Context, token string, form ReadVoteForm, provider impro
 	}, nil
 }
 
-func voteSearchAPI(c *gin.Context, _ string, form SearchVotesForm, provider improve_post.Provider) (api.CallbackResponse, error) {
-	res, total, err := provider.GetVotedPosts(c, form.UserID, form.Target, form.Limit, form.Offset)
+func improveSuggestionReadAPI(c *gin.Context, token string, form ReadImproveSuggestionForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.ReadImproveSuggestion(c, token, form.ShareToken, form.PostID)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func improveSuggestionRevisionsAPI(c *gin.Context, token string, form ReadImproveSuggestionForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	revisions, err := provider.ReadImproveSuggestionRevisions(c, token, form.ShareToken, form.PostID)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": revisions,
+		},
+	}, nil
+}
+
+func improveSuggestionCreateAPI(c *gin.Context, token string, form CreateImproveSuggestionForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.CreateImproveSuggestion(c, token, form.ShareToken, form.RequestID, form.SourceID, form.Title, form.Content)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func improveSuggestionUpdateAPI(c *gin.Context, token string, form UpdateImproveSuggestionForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.UpdateImproveSuggestion(c, token, form.PostID, form.RequestID, form.Title, form.Content)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func improveSuggestionDeleteAPI(c *gin.Context, token string, form DeleteImproveSuggestionForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	return api.CallbackResponse{}, provider.DeleteImproveSuggestion(c, token, form.PostID)
+}
+
+func improveSuggestionRestoreAPI(c *gin.Context, token string, form RestoreImproveSuggestionForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.RestoreImproveSuggestion(c, token, form.PostID)
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func improveSuggestionRatingsReadAPI(c *gin.Context, token string, form ReadImproveSuggestionForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.ReadImproveSuggestionRatings(c, token, form.ShareToken, form.PostID)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func improveSuggestionRatingsUpdateAPI(c *gin.Context, token string, form RateImproveSuggestionForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.RateImproveSuggestion(c, token, form.PostID, form.Ratings)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func improveSuggestionSearchAPI(c *gin.Context, token string, form SearchImproveSuggestionForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	query := models.ImproveSuggestionsList{
+		UserID:    form.UserID,
+		SourceID:  form.SourceID,
+		RequestID: form.RequestID,
+		Validated: form.Validated,
+		Reactions: form.Reactions,
+		Query:     form.Query,
+		Order:     form.Order,
+		Language:  searchLanguage(c, form.Language),
+	}
+
+	if form.Cursor != nil {
+		res, next, err := provider.ListImproveSuggestionsAfter(c, token, form.ShareToken, query, *form.Cursor, form.Limit)
+
+		if err != nil {
+			return api.CallbackResponse{}, err
+		}
+
+		return api.CallbackResponse{
+			Body: map[string]interface{}{
+				"data": res,
+				"next": next,
+			},
+		}, nil
+	}
+
+	res, total, err := provider.ListImproveSuggestions(c, token, form.ShareToken, query, form.Limit, form.Offset)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data":  res,
+			"total": total,
+		},
+	}, nil
+}
+
+func forumSearchAPI(c *gin.Context, _ string, form SearchForumForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.SearchForum(c, form.Query, searchLanguage(c, form.Language), form.Limit, form.Offset)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func leaderboardListAPI(c *gin.Context, _ string, form ListLeaderboardForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, total, err := provider.ListLeaderboard(c, form.Board, form.Period, form.Limit, form.Offset)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data":  res,
+			"total": total,
+		},
+	}, nil
+}
+
+func improveSuggestionPreviewsAPI(c *gin.Context, token string, form PreviewImproveSuggestionsForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.GetImproveSuggestionPreviews(c, token, form.IDs)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func voteUpdateAPI(c *gin.Context, token string, form VoteForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.Vote(c, token, form.PostID, form.Target, form.Vote)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func voteReadAPI(c *gin.Context, token string, form ReadVoteForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.HasVoted(c, token, form.PostID, form.Target)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func reactionUpdateAPI(c *gin.Context, token string, form ReactForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.React(c, token, form.PostID, form.Target, form.Reaction, form.Active)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func reactionReadAPI(c *gin.Context, token string, form ReadReactionsForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	res, err := provider.GetReactions(c, token, form.PostID, form.Target)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func reactionListAPI(_ *gin.Context, _ string, _ interface{}, provider improve_post.Provider) (api.CallbackResponse, error) {
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": provider.ListReactions(),
+		},
+	}, nil
+}
+
+func voteSearchAPI(c *gin.Context, _ string, form SearchVotesForm, provider improve_post.Provider) (api.CallbackResponse, error) {
+	if form.Cursor != nil {
+		res, next, err := provider.GetVotedPostsAfter(c, form.UserID, form.Target, *form.Cursor, form.Limit)
+
+		if err != nil {
+			return api.CallbackResponse{}, err
+		}
+
+		return api.CallbackResponse{
+			Body: map[string]interface{}{
+				"data": res,
+				"next": next,
+			},
+		}, nil
+	}
+
+	res, total, err := provider.GetVotedPosts(c, form.UserID, form.Target, form.Limit, form.Offset)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data":  res,
+			"total": total,
+		},
+	}, nil
+}
+
+func tagsListAPI(c *gin.Context, _ string, form ListTagsForm, provider tags.Provider) (api.CallbackResponse, error) {
+	res, err := provider.ListTags(c, form.Category)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func tagsAutocompleteAPI(c *gin.Context, _ string, form SearchTagsForm, provider tags.Provider) (api.CallbackResponse, error) {
+	res, err := provider.SearchTags(c, form.Query, form.Category, form.Limit)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func tagsCreateAPI(c *gin.Context, token string, form CreateTagForm, provider tags.Provider) (api.CallbackResponse, error) {
+	res, err := provider.CreateTag(c, token, &models.ForumTagCreate{
+		Category: form.Category,
+		Slug:     form.Slug,
+		Name:     form.Name,
+	})
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func tagsDeleteAPI(c *gin.Context, token string, form DeleteTagForm, provider tags.Provider) (api.CallbackResponse, error) {
+	return api.CallbackResponse{}, provider.DeleteTag(c, token, form.TagID)
+}
+
+func duplicateFlagsListAPI(c *gin.Context, token string, form ListDuplicateFlagsForm, provider moderation.Provider) (api.CallbackResponse, error) {
+	res, total, err := provider.ListDuplicateFlags(c, token, form.Reviewed, form.Limit, form.Offset)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data":  res,
+			"total": total,
+		},
+	}, nil
+}
+
+func duplicateFlagsReviewAPI(c *gin.Context, token string, form ReviewDuplicateFlagForm, provider moderation.Provider) (api.CallbackResponse, error) {
+	return api.CallbackResponse{}, provider.ReviewDuplicateFlag(c, token, form.RevisionID, form.OriginalRevisionID)
+}
+
+func voteRingFlagsListAPI(c *gin.Context, token string, form ListVoteRingFlagsForm, provider moderation.Provider) (api.CallbackResponse, error) {
+	res, total, err := provider.ListVoteRingFlags(c, token, form.Reviewed, form.Limit, form.Offset)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data":  res,
+			"total": total,
+		},
+	}, nil
+}
+
+func voteRingFlagsReviewAPI(c *gin.Context, token string, form ReviewVoteRingFlagForm, provider moderation.Provider) (api.CallbackResponse, error) {
+	res, err := provider.ReviewVoteRingFlag(c, token, form.AuthorID, form.VoterID, form.Void)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func improveRequestLockAPI(c *gin.Context, token string, form ModerateImproveRequestForm, provider moderation.Provider) (api.CallbackResponse, error) {
+	res, err := provider.LockImproveRequest(c, token, form.PostID, form.Reason)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func improveRequestUnlockAPI(c *gin.Context, token string, form ModerateImproveRequestForm, provider moderation.Provider) (api.CallbackResponse, error) {
+	res, err := provider.UnlockImproveRequest(c, token, form.PostID, form.Reason)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func reportContentAPI(c *gin.Context, token string, form ReportContentForm, provider moderation.Provider) (api.CallbackResponse, error) {
+	return api.CallbackResponse{}, provider.ReportContent(c, token, form.Target, form.TargetID, form.Reason, form.Content)
+}
+
+func reportCasesListAPI(c *gin.Context, token string, form ListReportCasesForm, provider moderation.Provider) (api.CallbackResponse, error) {
+	res, total, err := provider.ListReportCases(c, token, form.Statuses, form.Limit, form.Offset)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data":  res,
+			"total": total,
+		},
+	}, nil
+}
+
+func reportCaseReportsAPI(c *gin.Context, token string, form ReportCaseForm, provider moderation.Provider) (api.CallbackResponse, error) {
+	res, err := provider.ListReports(c, token, form.Target, form.TargetID)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func reportCaseClaimAPI(c *gin.Context, token string, form ReportCaseForm, provider moderation.Provider) (api.CallbackResponse, error) {
+	res, err := provider.ClaimReportCase(c, token, form.Target, form.TargetID)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func reportCaseResolveAPI(c *gin.Context, token string, form ReportCaseForm, provider moderation.Provider) (api.CallbackResponse, error) {
+	res, err := provider.ResolveReportCase(c, token, form.Target, form.TargetID, form.Note)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func reportCaseDismissAPI(c *gin.Context, token string, form ReportCaseForm, provider moderation.Provider) (api.CallbackResponse, error) {
+	res, err := provider.DismissReportCase(c, token, form.Target, form.TargetID, form.Note)
+
+	if err != nil {
+		return api.CallbackResponse{}, err
+	}
+
+	return api.CallbackResponse{
+		Body: map[string]interface{}{
+			"data": res,
+		},
+	}, nil
+}
+
+func moderationActionsListAPI(c *gin.Context, token string, form ListModerationActionsForm, provider moderation.Provider) (api.CallbackResponse, error) {
+	res, total, err := provider.ListModerationActions(c, token, form.Query, form.Limit, form.Offset)
 
 	if err != nil {
 		return api.CallbackResponse{}, err
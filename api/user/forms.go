@@ -66,9 +66,10 @@ type ReadProfileForm struct {
 }
 
 type SearchProfileForm struct {
-	Query  string `json:"query"`
-	Limit  int    `json:"limit"`
-	Offset int    `json:"offset"`
+	Query  string  `json:"query"`
+	Limit  int     `json:"limit"`
+	Offset int     `json:"offset"`
+	Cursor *string `json:"cursor"`
 }
 
 type PreviewProfilesForm struct {
@@ -327,6 +327,21 @@ func profileReadAPI(c *gin.Context, _ string, body ReadProfileForm, provider pro
 }
 
 func profileSearchAPI(c *gin.Context, _ string, body SearchProfileForm, provider profile.Provider) (api.CallbackResponse, error) {
+	if body.Cursor != nil {
+		res, next, err := provider.SearchAfter(c, body.Query, *body.Cursor, body.Limit)
+
+		if err != nil {
+			return api.CallbackResponse{}, err
+		}
+
+		return api.CallbackResponse{
+			Body: map[string]interface{}{
+				"data": res,
+				"next": next,
+			},
+		}, nil
+	}
+
 	res, total, err := provider.Search(c, body.Query, body.Limit, body.Offset)
 
 	if err != nil {
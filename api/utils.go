@@ -80,6 +80,7 @@ func WithContext[Body any, Provider any](callback Callback[Body, Provider], prov
 				{Err: validation.ErrUnauthorized, Code: http.StatusUnauthorized},
 				{Err: validation.ErrValidated, Code: http.StatusGone},
 				{Err: validation.ErrNotFound, Code: http.StatusNotFound},
+				{Err: validation.ErrRateLimited, Code: http.StatusTooManyRequests},
 			}, resp.MaskErrorsWithStatus)
 			_ = c.AbortWithError(status, err)
 		}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/a-novel/agora-backend/domains/forum/service/duplicates"
+	"github.com/a-novel/agora-backend/domains/forum/storage/duplicates"
+	"github.com/a-novel/agora-backend/framework/bunframework"
+	"github.com/a-novel/agora-backend/framework/bunframework/pgconfig"
+	"github.com/gookit/color"
+	"os"
+	"time"
+)
+
+var (
+	dsn       string
+	batchSize int
+)
+
+func init() {
+	flag.StringVar(&dsn, "d", os.Getenv("POSTGRES_URL"), "database to process")
+	flag.IntVar(&batchSize, "b", 200, "number of revisions to fingerprint at once")
+}
+
+func quit(err string) {
+	fmt.Println("")
+	color.C256(9).Println(err)
+	os.Exit(1)
+}
+
+func main() {
+	flag.Parse()
+
+	color.C256(45).Println("Fingerprinting forum posts.")
+	fmt.Printf("Target instance: %s\n\n", color.C256(13).Sprint(dsn))
+
+	postgresClient, sqlClient, err := bunframework.NewClient(context.Background(), bunframework.Config{
+		Driver: pgconfig.Driver{
+			DSN:         dsn,
+			DialTimeout: 120 * time.Second,
+		},
+		DiscardUnknownColumns: true,
+	})
+	if err != nil {
+		quit(fmt.Sprintf("💥 failed to acquire connection to '%s': %s", dsn, err.Error()))
+		return
+	}
+
+	defer postgresClient.Close()
+	defer sqlClient.Close()
+
+	ctx := context.Background()
+	service := duplicates_service.NewService(duplicates_storage.NewRepository(postgresClient))
+
+	// Revisions are processed from the oldest, so each one is only compared with the revisions it may have copied.
+	total := 0
+	for {
+		count, err := service.Backfill(ctx, batchSize, time.Now())
+		if err != nil {
+			quit(fmt.Sprintf("💥 failed to fingerprint revisions: %s", err.Error()))
+			return
+		}
+
+		total += count
+		color.C256(245).Printf("\r\033[0K\t%d revisions fingerprinted", total)
+
+		if count < batchSize {
+			break
+		}
+	}
+
+	fmt.Println("")
+	color.C256(45).Println("🚀 Every revision is fingerprinted!")
+}
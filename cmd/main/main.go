@@ -13,11 +13,35 @@ import (
 	"github.com/a-novel/agora-backend/config"
 	"github.com/a-novel/agora-backend/domains/bookmark/service/improve_post"
 	"github.com/a-novel/agora-backend/domains/bookmark/storage/improve_post"
+	"github.com/a-novel/agora-backend/domains/forum/service/badges"
+	"github.com/a-novel/agora-backend/domains/forum/service/collaborator"
+	"github.com/a-novel/agora-backend/domains/forum/service/critique"
+	"github.com/a-novel/agora-backend/domains/forum/service/drafts"
+	"github.com/a-novel/agora-backend/domains/forum/service/duplicates"
 	"github.com/a-novel/agora-backend/domains/forum/service/improve_request"
 	"github.com/a-novel/agora-backend/domains/forum/service/improve_suggestion"
+	"github.com/a-novel/agora-backend/domains/forum/service/leaderboards"
+	"github.com/a-novel/agora-backend/domains/forum/service/moderation_log"
+	"github.com/a-novel/agora-backend/domains/forum/service/reports"
+	"github.com/a-novel/agora-backend/domains/forum/service/tags"
+	"github.com/a-novel/agora-backend/domains/forum/service/thread_state"
+	"github.com/a-novel/agora-backend/domains/forum/service/visibility"
+	"github.com/a-novel/agora-backend/domains/forum/service/vote_rings"
 	"github.com/a-novel/agora-backend/domains/forum/service/votes"
+	"github.com/a-novel/agora-backend/domains/forum/storage/badges"
+	"github.com/a-novel/agora-backend/domains/forum/storage/collaborator"
+	"github.com/a-novel/agora-backend/domains/forum/storage/critique"
+	"github.com/a-novel/agora-backend/domains/forum/storage/drafts"
+	"github.com/a-novel/agora-backend/domains/forum/storage/duplicates"
 	"github.com/a-novel/agora-backend/domains/forum/storage/improve_request"
 	"github.com/a-novel/agora-backend/domains/forum/storage/improve_suggestion"
+	"github.com/a-novel/agora-backend/domains/forum/storage/leaderboards"
+	"github.com/a-novel/agora-backend/domains/forum/storage/moderation_log"
+	"github.com/a-novel/agora-backend/domains/forum/storage/reports"
+	"github.com/a-novel/agora-backend/domains/forum/storage/tags"
+	"github.com/a-novel/agora-backend/domains/forum/storage/thread_state"
+	"github.com/a-novel/agora-backend/domains/forum/storage/visibility"
+	"github.com/a-novel/agora-backend/domains/forum/storage/vote_rings"
 	"github.com/a-novel/agora-backend/domains/forum/storage/votes"
 	"github.com/a-novel/agora-backend/domains/generics"
 	"github.com/a-novel/agora-backend/domains/keys/service/jwk"
@@ -25,20 +49,25 @@ import (
 	"github.com/a-novel/agora-backend/domains/user/service/credentials"
 	"github.com/a-novel/agora-backend/domains/user/service/identity"
 	"github.com/a-novel/agora-backend/domains/user/service/profile"
+	"github.com/a-novel/agora-backend/domains/user/service/reputation"
 	"github.com/a-novel/agora-backend/domains/user/service/token"
 	"github.com/a-novel/agora-backend/domains/user/service/user"
 	"github.com/a-novel/agora-backend/domains/user/storage/credentials"
 	"github.com/a-novel/agora-backend/domains/user/storage/identity"
 	"github.com/a-novel/agora-backend/domains/user/storage/profile"
+	"github.com/a-novel/agora-backend/domains/user/storage/reputation"
 	"github.com/a-novel/agora-backend/domains/user/storage/user"
 	improve_post_bookmark "github.com/a-novel/agora-backend/environment/bookmark/improve_post"
 	improve_post_forum "github.com/a-novel/agora-backend/environment/forum/improve_post"
+	moderation_forum "github.com/a-novel/agora-backend/environment/forum/moderation"
+	tags_forum "github.com/a-novel/agora-backend/environment/forum/tags"
 	"github.com/a-novel/agora-backend/environment/secrets"
 	"github.com/a-novel/agora-backend/environment/user/account"
 	"github.com/a-novel/agora-backend/environment/user/authentication"
 	"github.com/a-novel/agora-backend/environment/user/profile"
 	"github.com/a-novel/agora-backend/framework/bunframework"
 	"github.com/a-novel/agora-backend/framework/bunframework/pgconfig"
+	"github.com/a-novel/agora-backend/framework/language"
 	"github.com/a-novel/agora-backend/framework/mailer"
 	"github.com/a-novel/agora-backend/framework/security"
 	"github.com/a-novel/agora-backend/migrations"
@@ -137,10 +166,23 @@ func main() {
 	userIdentityRepository := identity_storage.NewRepository(postgres)
 	userProfileRepository := profile_storage.NewRepository(postgres)
 	userRepository := user_storage.NewRepository(postgres)
+	userReputationRepository := reputation_storage.NewRepository(postgres)
 
 	forumImproveRequestRepository := improve_request_storage.NewRepository(postgres, cfg.Forum.Search.CropContent)
 	forumImproveSuggestionRepository := improve_suggestion_storage.NewRepository(postgres, cfg.Forum.Search.CropContent)
 	forumVotesRepository := votes_storage.NewRepository(postgres)
+	forumTagsRepository := tags_storage.NewRepository(postgres)
+	forumDuplicatesRepository := duplicates_storage.NewRepository(postgres)
+	forumDraftsRepository := drafts_storage.NewRepository(postgres)
+	forumVisibilityRepository := visibility_storage.NewRepository(postgres)
+	forumCollaboratorRepository := collaborator_storage.NewRepository(postgres)
+	forumThreadStateRepository := thread_state_storage.NewRepository(postgres)
+	forumReportsRepository := reports_storage.NewRepository(postgres)
+	forumModerationLogRepository := moderation_log_storage.NewRepository(postgres)
+	forumVoteRingsRepository := vote_rings_storage.NewRepository(postgres)
+	forumCritiqueRepository := critique_storage.NewRepository(postgres)
+	forumBadgesRepository := badges_storage.NewRepository(postgres)
+	forumLeaderboardsRepository := leaderboards_storage.NewRepository(postgres)
 
 	bookmarkImprovePostRepository := improve_post_storage.NewRepository(postgres)
 
@@ -164,10 +206,31 @@ func main() {
 		userIdentityService,
 		userProfileService,
 	)
+	userReputationService := reputation_service.NewService(userReputationRepository)
 
-	forumImproveRequestService := improve_request_service.NewService(forumImproveRequestRepository)
-	forumImproveSuggestionService := improve_suggestion_service.NewService(forumImproveSuggestionRepository)
+	forumSearchLanguages := language.Languages{
+		Default: cfg.Forum.Search.DefaultLanguage,
+		Configs: cfg.Forum.Search.Languages,
+	}
+	forumImproveRequestService := improve_request_service.NewService(forumImproveRequestRepository, forumSearchLanguages)
+	forumImproveSuggestionService := improve_suggestion_service.NewService(forumImproveSuggestionRepository, forumSearchLanguages)
 	forumVotesService := votes_service.NewService(forumVotesRepository)
+	forumTagsService := tags_service.NewService(forumTagsRepository)
+	forumDuplicatesService := duplicates_service.NewService(forumDuplicatesRepository)
+	forumDraftsService := drafts_service.NewService(forumDraftsRepository)
+	forumVisibilityService := visibility_service.NewService(
+		forumVisibilityRepository,
+		security.GenerateCode,
+		security.VerifyCode,
+	)
+	forumCollaboratorService := collaborator_service.NewService(forumCollaboratorRepository)
+	forumThreadStateService := thread_state_service.NewService(forumThreadStateRepository)
+	forumReportsService := reports_service.NewService(forumReportsRepository)
+	forumModerationLogService := moderation_log_service.NewService(forumModerationLogRepository)
+	forumVoteRingsService := vote_rings_service.NewService(forumVoteRingsRepository)
+	forumCritiqueService := critique_service.NewService(forumCritiqueRepository)
+	forumBadgesService := badges_service.NewService(forumBadgesRepository)
+	forumLeaderboardsService := leaderboards_service.NewService(forumLeaderboardsRepository)
 
 	bookmarkImprovePostService := improve_post_service.NewService(bookmarkImprovePostRepository)
 
@@ -210,18 +273,73 @@ func main() {
 		TokenRenewDelta:    cfg.Tokens.RenewDelta,
 	})
 	profileProvider := profile.NewProvider(profile.Config{
-		UserService: userService,
+		UserService:   userService,
+		BadgesService: forumBadgesService,
 	})
 
 	forumImprovePostProvider := improve_post_forum.NewProvider(improve_post_forum.Config{
 		ImproveRequestService:    forumImproveRequestService,
 		ImproveSuggestionService: forumImproveSuggestionService,
 		VotesService:             forumVotesService,
+		DraftsService:            forumDraftsService,
+		DuplicatesService:        forumDuplicatesService,
+		VisibilityService:        forumVisibilityService,
+		CollaboratorService:      forumCollaboratorService,
+		ThreadStateService:       forumThreadStateService,
+		CritiqueService:          forumCritiqueService,
+		TokenService:             tokenService,
+		KeysService:              keysServiceCached,
+		UserService:              userService,
+		ReputationService:        userReputationService,
+		BadgesService:            forumBadgesService,
+		LeaderboardsService:      forumLeaderboardsService,
+		ReportsService:           forumReportsService,
+		Time:                     time.Now,
+		ID:                       uuid.New,
+
+		AutoCloseAcceptedSuggestions: cfg.Forum.Threads.AutoClose.AcceptedSuggestions,
+		AutoCloseInactivity:          cfg.Forum.Threads.AutoClose.Inactivity,
+		RestoreWindow:                cfg.Forum.Deletion.RestoreWindow,
+		PurgeAfter:                   cfg.Forum.Deletion.PurgeAfter,
+		VoteRateLimit:                cfg.Forum.Votes.RateLimit,
+		VoteRateWindow:               cfg.Forum.Votes.RateWindow,
+		Reactions:                    cfg.Forum.Votes.Reactions,
+		DownVoteReputation:           cfg.Forum.Reputation.DownVote,
+		BadgesWindow:                 cfg.Forum.Badges.Window,
+		Logger:                       logger,
+	})
+
+	forumTagsProvider := tags_forum.NewProvider(tags_forum.Config{
+		TagsService:         forumTagsService,
+		TokenService:        tokenService,
+		KeysService:         keysServiceCached,
+		UserService:         userService,
+		ReputationService:   userReputationService,
+		CreateTagReputation: cfg.Forum.Reputation.CreateTag,
+		Time:                time.Now,
+		ID:                  uuid.New,
+	})
+
+	forumModerationProvider := moderation_forum.NewProvider(moderation_forum.Config{
+		DuplicatesService:        forumDuplicatesService,
+		ImproveRequestService:    forumImproveRequestService,
+		ImproveSuggestionService: forumImproveSuggestionService,
+		ThreadStateService:       forumThreadStateService,
+		ReportsService:           forumReportsService,
+		ModerationLogService:     forumModerationLogService,
+		VoteRingsService:         forumVoteRingsService,
 		TokenService:             tokenService,
 		KeysService:              keysServiceCached,
 		UserService:              userService,
 		Time:                     time.Now,
 		ID:                       uuid.New,
+
+		AutoHideReports: cfg.Forum.Reports.AutoHide,
+
+		VoteRingWindow:        cfg.Forum.Votes.Rings.Window,
+		VoteRingMaxAccountAge: cfg.Forum.Votes.Rings.MaxAccountAge,
+		VoteRingMinVotes:      cfg.Forum.Votes.Rings.MinVotes,
+		VoteRingMinVoters:     cfg.Forum.Votes.Rings.MinVoters,
 	})
 
 	bookmarkImprovePostProvider := improve_post_bookmark.NewProvider(improve_post_bookmark.Config{
@@ -257,8 +375,14 @@ func main() {
 	userapi.ProfileAPI("/user/profile", apiRouter, profileProvider)
 
 	forumapi.ImproveRequestAPI("/forum/improve-request", apiRouter, forumImprovePostProvider)
+	forumapi.ImproveRequestDraftAPI("/forum/improve-request-draft", apiRouter, forumImprovePostProvider)
 	forumapi.ImproveSuggestionAPI("/forum/improve-suggestion", apiRouter, forumImprovePostProvider)
 	forumapi.VotesAPI("/forum/votes", apiRouter, forumImprovePostProvider)
+	forumapi.TagsAPI("/forum/tags", apiRouter, forumTagsProvider)
+	forumapi.SearchAPI("/forum/search", apiRouter, forumImprovePostProvider)
+	forumapi.LeaderboardsAPI("/forum/leaderboards", apiRouter, forumImprovePostProvider)
+	forumapi.ModerationAPI("/forum/moderation", apiRouter, forumModerationProvider)
+	forumapi.JobsAPI("/forum/jobs", apiRouter, forumImprovePostProvider, forumModerationProvider, cfg.IAM.ServiceAccounts.Scheduler)
 
 	bookmarkapi.ImprovePostAPI("/bookmark/improve-post", apiRouter, bookmarkImprovePostProvider)
 
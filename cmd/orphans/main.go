@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/a-novel/agora-backend/domains/bookmark/storage/improve_post"
+	"github.com/a-novel/agora-backend/domains/forum/storage/improve_suggestion"
+	"github.com/a-novel/agora-backend/domains/forum/storage/votes"
+	"github.com/a-novel/agora-backend/framework/bunframework"
+	"github.com/a-novel/agora-backend/framework/bunframework/pgconfig"
+	"github.com/gookit/color"
+	"os"
+	"time"
+)
+
+var (
+	dsn       string
+	batchSize int
+	check     bool
+)
+
+func init() {
+	flag.StringVar(&dsn, "d", os.Getenv("POSTGRES_URL"), "database to clean")
+	flag.IntVar(&batchSize, "b", 500, "number of rows to remove at once")
+	flag.BoolVar(&check, "check", false, "only report orphaned rows, without removing them")
+}
+
+// A table holding references to forum posts, that are not enforced by a foreign key.
+type referenceTable struct {
+	name          string
+	countOrphans  func(ctx context.Context) (int64, error)
+	deleteOrphans func(ctx context.Context, limit int) (int64, error)
+}
+
+func quit(err string) {
+	fmt.Println("")
+	color.C256(9).Println(err)
+	os.Exit(1)
+}
+
+func clean(ctx context.Context, table referenceTable) {
+	var total int64
+
+	for {
+		count, err := table.deleteOrphans(ctx, batchSize)
+		if err != nil {
+			quit(fmt.Sprintf("💥 failed to clean table '%s': %s", table.name, err.Error()))
+			return
+		}
+
+		total += count
+		color.C256(245).Printf("\r\033[0K\t%d orphaned rows removed", total)
+
+		if count < int64(batchSize) {
+			break
+		}
+	}
+
+	fmt.Println("")
+}
+
+// Returns true if the table still references missing posts.
+func reportOrphans(ctx context.Context, table referenceTable) bool {
+	count, err := table.countOrphans(ctx)
+	if err != nil {
+		quit(fmt.Sprintf("💥 failed to check table '%s': %s", table.name, err.Error()))
+		return false
+	}
+
+	if count == 0 {
+		color.C256(40).Println("\t✔ no orphaned rows")
+		return false
+	}
+
+	color.C256(220).Printf("\t⚠ %d rows reference a post that no longer exists\n", count)
+	return true
+}
+
+func main() {
+	flag.Parse()
+
+	if check {
+		color.C256(45).Println("Checking references to forum posts.")
+	} else {
+		color.C256(45).Println("Removing references to missing forum posts.")
+	}
+	fmt.Printf("Target instance: %s\n\n", color.C256(13).Sprint(dsn))
+
+	postgresClient, sqlClient, err := bunframework.NewClient(context.Background(), bunframework.Config{
+		Driver: pgconfig.Driver{
+			DSN:         dsn,
+			DialTimeout: 120 * time.Second,
+		},
+		DiscardUnknownColumns: true,
+	})
+	if err != nil {
+		quit(fmt.Sprintf("💥 failed to acquire connection to '%s': %s", dsn, err.Error()))
+		return
+	}
+
+	defer postgresClient.Close()
+	defer sqlClient.Close()
+
+	ctx := context.Background()
+	suggestionsRepository := improve_suggestion_storage.NewRepository(postgresClient, 0)
+	votesRepository := votes_storage.NewRepository(postgresClient)
+	bookmarksRepository := improve_post_storage.NewRepository(postgresClient)
+
+	// Removing suggestions also removes their votes and bookmarks, so they are cleaned first.
+	tables := []referenceTable{
+		{name: "improve_suggestions", countOrphans: suggestionsRepository.CountOrphans, deleteOrphans: suggestionsRepository.DeleteOrphans},
+		{name: "votes", countOrphans: votesRepository.CountOrphans, deleteOrphans: votesRepository.DeleteOrphans},
+		{name: "improve_posts_bookmarks", countOrphans: bookmarksRepository.CountOrphans, deleteOrphans: bookmarksRepository.DeleteOrphans},
+	}
+
+	orphaned := false
+	for _, table := range tables {
+		color.C256(255).Printf("- %s\n", table.name)
+
+		if !check {
+			clean(ctx, table)
+		}
+
+		orphaned = reportOrphans(ctx, table) || orphaned
+	}
+
+	fmt.Println("")
+	if orphaned {
+		quit("💥 some rows still reference missing posts")
+		return
+	}
+
+	color.C256(45).Println("🚀 Every reference points to an existing post!")
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/a-novel/agora-backend/domains/forum/storage/search_index"
+	"github.com/a-novel/agora-backend/framework/bunframework"
+	"github.com/a-novel/agora-backend/framework/bunframework/pgconfig"
+	"github.com/google/uuid"
+	"github.com/gookit/color"
+	"os"
+	"time"
+)
+
+var (
+	dsn       string
+	batchSize int
+	table     string
+	check     bool
+)
+
+// Number of drifted rows to display, when running a consistency check.
+const driftSampleSize = 10
+
+func init() {
+	flag.StringVar(&dsn, "d", os.Getenv("POSTGRES_URL"), "database to reindex")
+	flag.IntVar(&batchSize, "b", 500, "number of rows to reindex at once")
+	flag.StringVar(&table, "t", "", "only process the given table (default: every searchable table)")
+	flag.BoolVar(&check, "check", false, "only report rows with a stale search vector, without reindexing them")
+}
+
+func quit(err string) {
+	fmt.Println("")
+	color.C256(9).Println(err)
+	os.Exit(1)
+}
+
+func reindex(ctx context.Context, repository search_index_storage.Repository, target search_index_storage.Table) {
+	var (
+		after = uuid.Nil
+		total int
+	)
+
+	for {
+		last, count, err := repository.Reindex(ctx, target, after, batchSize)
+		if err != nil {
+			quit(fmt.Sprintf("💥 failed to reindex table '%s' after row %s: %s", target, after, err.Error()))
+			return
+		}
+
+		total += count
+		color.C256(245).Printf("\r\033[0K\t%d rows reindexed", total)
+
+		if count < batchSize {
+			break
+		}
+
+		after = last
+	}
+
+	fmt.Println("")
+}
+
+// Returns true if the table contains rows with a stale search vector.
+func reportDrift(ctx context.Context, repository search_index_storage.Repository, target search_index_storage.Table) bool {
+	ids, count, err := repository.Drifted(ctx, target, driftSampleSize)
+	if err != nil {
+		quit(fmt.Sprintf("💥 failed to check table '%s': %s", target, err.Error()))
+		return false
+	}
+
+	if count == 0 {
+		color.C256(40).Println("\t✔ search index is up to date")
+		return false
+	}
+
+	color.C256(220).Printf("\t⚠ %d rows have a stale search index, including:\n", count)
+	for _, id := range ids {
+		color.C256(255).Printf("\t\t%s\n", id)
+	}
+
+	return true
+}
+
+func main() {
+	flag.Parse()
+
+	tables := search_index_storage.Tables
+	if table != "" {
+		tables = []search_index_storage.Table{search_index_storage.Table(table)}
+	}
+
+	if check {
+		color.C256(45).Println("Checking forum search index.")
+	} else {
+		color.C256(45).Println("Rebuilding forum search index.")
+	}
+	fmt.Printf("Target instance: %s\n\n", color.C256(13).Sprint(dsn))
+
+	postgresClient, sqlClient, err := bunframework.NewClient(context.Background(), bunframework.Config{
+		Driver: pgconfig.Driver{
+			DSN:         dsn,
+			DialTimeout: 120 * time.Second,
+		},
+		DiscardUnknownColumns: true,
+	})
+	if err != nil {
+		quit(fmt.Sprintf("💥 failed to acquire connection to '%s': %s", dsn, err.Error()))
+		return
+	}
+
+	defer postgresClient.Close()
+	defer sqlClient.Close()
+
+	ctx := context.Background()
+	repository := search_index_storage.NewRepository(postgresClient)
+
+	drifted := false
+	for _, target := range tables {
+		color.C256(255).Printf("- %s\n", target)
+
+		if !check {
+			reindex(ctx, repository, target)
+		}
+
+		drifted = reportDrift(ctx, repository, target) || drifted
+	}
+
+	fmt.Println("")
+	if drifted {
+		quit("💥 some rows still have a stale search index")
+		return
+	}
+
+	color.C256(45).Println("🚀 Search index is consistent!")
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/a-novel/agora-backend/domains/forum/service/votes"
+	"github.com/a-novel/agora-backend/domains/forum/storage/votes"
+	"github.com/a-novel/agora-backend/framework/bunframework"
+	"github.com/a-novel/agora-backend/framework/bunframework/pgconfig"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/gookit/color"
+	"os"
+	"time"
+)
+
+var (
+	dsn       string
+	batchSize int
+	target    string
+	check     bool
+)
+
+// Number of drifted posts to display, when running a consistency check.
+const driftSampleSize = 10
+
+func init() {
+	flag.StringVar(&dsn, "d", os.Getenv("POSTGRES_URL"), "database to reconcile")
+	flag.IntVar(&batchSize, "b", 500, "number of posts to reconcile at once")
+	flag.StringVar(&target, "t", "", "only process the given target (default: every target)")
+	flag.BoolVar(&check, "check", false, "only report posts with drifted counters, without repairing them")
+}
+
+func quit(err string) {
+	fmt.Println("")
+	color.C256(9).Println(err)
+	os.Exit(1)
+}
+
+func formatCounter(counter *int64) string {
+	if counter == nil {
+		return "NULL"
+	}
+
+	return fmt.Sprintf("%d", *counter)
+}
+
+func reconcile(ctx context.Context, service votes_service.Service, target models.VoteTarget) {
+	report, err := service.ReconcileCounters(ctx, target, batchSize)
+	if err != nil {
+		quit(fmt.Sprintf("💥 failed to reconcile vote counters of '%s': %s", target, err.Error()))
+		return
+	}
+
+	color.C256(245).Printf("\t%d posts scanned, %d repaired\n", report.Scanned, report.Repaired)
+}
+
+// Returns true if some posts of the target have drifted counters.
+func reportDrift(ctx context.Context, service votes_service.Service, target models.VoteTarget) bool {
+	drifts, count, err := service.DriftedCounters(ctx, target, driftSampleSize)
+	if err != nil {
+		quit(fmt.Sprintf("💥 failed to check vote counters of '%s': %s", target, err.Error()))
+		return false
+	}
+
+	if count == 0 {
+		color.C256(40).Println("\t✔ vote counters are up to date")
+		return false
+	}
+
+	color.C256(220).Printf("\t⚠ %d posts have drifted vote counters, including:\n", count)
+	for _, drift := range drifts {
+		color.C256(255).Printf(
+			"\t\t%s: +%s/-%s, expected +%d/-%d\n",
+			drift.PostID, formatCounter(drift.UpVotes), formatCounter(drift.DownVotes),
+			drift.ExpectedUpVotes, drift.ExpectedDownVotes,
+		)
+	}
+
+	return true
+}
+
+func main() {
+	flag.Parse()
+
+	targets := []models.VoteTarget{models.VoteTargetImproveRequest, models.VoteTargetImproveSuggestion}
+	if target != "" {
+		targets = []models.VoteTarget{models.VoteTarget(target)}
+	}
+
+	if check {
+		color.C256(45).Println("Checking forum vote counters.")
+	} else {
+		color.C256(45).Println("Reconciling forum vote counters.")
+	}
+	fmt.Printf("Target instance: %s\n\n", color.C256(13).Sprint(dsn))
+
+	postgresClient, sqlClient, err := bunframework.NewClient(context.Background(), bunframework.Config{
+		Driver: pgconfig.Driver{
+			DSN:         dsn,
+			DialTimeout: 120 * time.Second,
+		},
+		DiscardUnknownColumns: true,
+	})
+	if err != nil {
+		quit(fmt.Sprintf("💥 failed to acquire connection to '%s': %s", dsn, err.Error()))
+		return
+	}
+
+	defer postgresClient.Close()
+	defer sqlClient.Close()
+
+	ctx := context.Background()
+	service := votes_service.NewService(votes_storage.NewRepository(postgresClient))
+
+	drifted := false
+	for _, target := range targets {
+		color.C256(255).Printf("- %s\n", target)
+
+		if !check {
+			reconcile(ctx, service, target)
+		}
+
+		drifted = reportDrift(ctx, service, target) || drifted
+	}
+
+	fmt.Println("")
+	if drifted {
+		quit("💥 some posts still have drifted vote counters")
+		return
+	}
+
+	color.C256(45).Println("🚀 Vote counters are consistent!")
+}
@@ -69,7 +69,60 @@ type Config struct {
 	Forum struct {
 		Search struct {
 			CropContent int `json:"cropContent" yaml:"cropContent"`
+			// DefaultLanguage is used when the language of a post or a query cannot be resolved.
+			DefaultLanguage string `json:"defaultLanguage" yaml:"defaultLanguage"`
+			// Languages maps the code of each supported language to its Postgres text search configuration.
+			Languages map[string]string `json:"languages" yaml:"languages"`
 		} `json:"search" yaml:"search"`
+		Threads struct {
+			AutoClose struct {
+				// AcceptedSuggestions closes a request once it has this many validated suggestions. 0 disables it.
+				AcceptedSuggestions int `json:"acceptedSuggestions" yaml:"acceptedSuggestions"`
+				// Inactivity closes a request after this long without a new revision or suggestion. 0 disables it.
+				Inactivity time.Duration `json:"inactivity" yaml:"inactivity"`
+			} `json:"autoClose" yaml:"autoClose"`
+		} `json:"threads" yaml:"threads"`
+		Reports struct {
+			// AutoHide hides a content once this many distinct users reported it. 0 disables it.
+			AutoHide int `json:"autoHide" yaml:"autoHide"`
+		} `json:"reports" yaml:"reports"`
+		Deletion struct {
+			// RestoreWindow is how long the author of a deleted post can still restore it.
+			RestoreWindow time.Duration `json:"restoreWindow" yaml:"restoreWindow"`
+			// PurgeAfter permanently removes deleted posts after this long. 0 disables it.
+			PurgeAfter time.Duration `json:"purgeAfter" yaml:"purgeAfter"`
+		} `json:"deletion" yaml:"deletion"`
+		Votes struct {
+			// RateLimit is the maximum number of times a user can cast, change or cancel a vote during RateWindow. 0
+			// disables it.
+			RateLimit  int           `json:"rateLimit" yaml:"rateLimit"`
+			RateWindow time.Duration `json:"rateWindow" yaml:"rateWindow"`
+			Rings      struct {
+				// Window is how far back the scheduler looks for suspicious votes.
+				Window time.Duration `json:"window" yaml:"window"`
+				// MaxAccountAge is the age under which an account is considered new, at the time it voted.
+				MaxAccountAge time.Duration `json:"maxAccountAge" yaml:"maxAccountAge"`
+				// MinVotes is the number of posts of the same author a new account must up vote to be suspicious.
+				// 0 disables the detection.
+				MinVotes int `json:"minVotes" yaml:"minVotes"`
+				// MinVoters is the number of suspicious voters an author must gather before they are flagged.
+				MinVoters int `json:"minVoters" yaml:"minVoters"`
+			} `json:"rings" yaml:"rings"`
+			// Reactions users can leave on posts, on top of their vote.
+			Reactions []string `json:"reactions" yaml:"reactions"`
+		} `json:"votes" yaml:"votes"`
+		Reputation struct {
+			// DownVote is the reputation a user needs to vote down a post. 0 disables the requirement.
+			DownVote int64 `json:"downVote" yaml:"downVote"`
+			// CreateTag is the reputation a user needs to create forum tags. Moderators can always create tags.
+			// 0 restricts tag creation to moderators.
+			CreateTag int64 `json:"createTag" yaml:"createTag"`
+		} `json:"reputation" yaml:"reputation"`
+		Badges struct {
+			// Window is how far back the scheduler looks for users whose activity may earn them a badge. It must be
+			// longer than the interval between two runs.
+			Window time.Duration `json:"window" yaml:"window"`
+		} `json:"badges" yaml:"badges"`
 	} `json:"forum" yaml:"forum"`
 }
 
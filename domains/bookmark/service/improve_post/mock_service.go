@@ -4,11 +4,12 @@ package improve_post_service
 
 import (
 	context "context"
-	"github.com/a-novel/agora-backend/domains/bookmark/storage/improve_post"
-	"github.com/a-novel/agora-backend/models"
 
+	"github.com/a-novel/agora-backend/domains/bookmark/storage/improve_post"
 	mock "github.com/stretchr/testify/mock"
 
+	"github.com/a-novel/agora-backend/models"
+
 	time "time"
 
 	uuid "github.com/google/uuid"
@@ -62,8 +63,8 @@ type MockService_Bookmark_Call struct {
 //   - ctx context.Context
 //   - userID uuid.UUID
 //   - requestID uuid.UUID
-//   - target BookmarkTarget
-//   - level bookmark_service.BookmarkLevel
+//   - target models.BookmarkTarget
+//   - level models.BookmarkLevel
 //   - now time.Time
 func (_e *MockService_Expecter) Bookmark(ctx interface{}, userID interface{}, requestID interface{}, target interface{}, level interface{}, now interface{}) *MockService_Bookmark_Call {
 	return &MockService_Bookmark_Call{Call: _e.mock.On("Bookmark", ctx, userID, requestID, target, level, now)}
@@ -121,7 +122,7 @@ type MockService_IsBookmarked_Call struct {
 //   - ctx context.Context
 //   - userID uuid.UUID
 //   - requestID uuid.UUID
-//   - target BookmarkTarget
+//   - target models.BookmarkTarget
 func (_e *MockService_Expecter) IsBookmarked(ctx interface{}, userID interface{}, requestID interface{}, target interface{}) *MockService_IsBookmarked_Call {
 	return &MockService_IsBookmarked_Call{Call: _e.mock.On("IsBookmarked", ctx, userID, requestID, target)}
 }
@@ -184,8 +185,8 @@ type MockService_List_Call struct {
 // List is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID uuid.UUID
-//   - level bookmark_service.BookmarkLevel
-//   - target BookmarkTarget
+//   - level models.BookmarkLevel
+//   - target models.BookmarkTarget
 //   - limit int
 //   - offset int
 func (_e *MockService_Expecter) List(ctx interface{}, userID interface{}, level interface{}, target interface{}, limit interface{}, offset interface{}) *MockService_List_Call {
@@ -209,6 +210,72 @@ func (_c *MockService_List_Call) RunAndReturn(run func(context.Context, uuid.UUI
 	return _c
 }
 
+// ListAfter provides a mock function with given fields: ctx, userID, level, target, cursor, limit
+func (_m *MockService) ListAfter(ctx context.Context, userID uuid.UUID, level models.BookmarkLevel, target models.BookmarkTarget, cursor string, limit int) ([]*models.Bookmark, string, error) {
+	ret := _m.Called(ctx, userID, level, target, cursor, limit)
+
+	var r0 []*models.Bookmark
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.BookmarkLevel, models.BookmarkTarget, string, int) ([]*models.Bookmark, string, error)); ok {
+		return rf(ctx, userID, level, target, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.BookmarkLevel, models.BookmarkTarget, string, int) []*models.Bookmark); ok {
+		r0 = rf(ctx, userID, level, target, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Bookmark)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.BookmarkLevel, models.BookmarkTarget, string, int) string); ok {
+		r1 = rf(ctx, userID, level, target, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, models.BookmarkLevel, models.BookmarkTarget, string, int) error); ok {
+		r2 = rf(ctx, userID, level, target, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockService_ListAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAfter'
+type MockService_ListAfter_Call struct {
+	*mock.Call
+}
+
+// ListAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - level models.BookmarkLevel
+//   - target models.BookmarkTarget
+//   - cursor string
+//   - limit int
+func (_e *MockService_Expecter) ListAfter(ctx interface{}, userID interface{}, level interface{}, target interface{}, cursor interface{}, limit interface{}) *MockService_ListAfter_Call {
+	return &MockService_ListAfter_Call{Call: _e.mock.On("ListAfter", ctx, userID, level, target, cursor, limit)}
+}
+
+func (_c *MockService_ListAfter_Call) Run(run func(ctx context.Context, userID uuid.UUID, level models.BookmarkLevel, target models.BookmarkTarget, cursor string, limit int)) *MockService_ListAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.BookmarkLevel), args[3].(models.BookmarkTarget), args[4].(string), args[5].(int))
+	})
+	return _c
+}
+
+func (_c *MockService_ListAfter_Call) Return(_a0 []*models.Bookmark, _a1 string, _a2 error) *MockService_ListAfter_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockService_ListAfter_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.BookmarkLevel, models.BookmarkTarget, string, int) ([]*models.Bookmark, string, error)) *MockService_ListAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StorageToModel provides a mock function with given fields: source
 func (_m *MockService) StorageToModel(source *improve_post_storage.Model) *models.Bookmark {
 	ret := _m.Called(source)
@@ -276,7 +343,7 @@ type MockService_UnBookmark_Call struct {
 //   - ctx context.Context
 //   - userID uuid.UUID
 //   - requestID uuid.UUID
-//   - target BookmarkTarget
+//   - target models.BookmarkTarget
 func (_e *MockService_Expecter) UnBookmark(ctx interface{}, userID interface{}, requestID interface{}, target interface{}) *MockService_UnBookmark_Call {
 	return &MockService_UnBookmark_Call{Call: _e.mock.On("UnBookmark", ctx, userID, requestID, target)}
 }
@@ -27,6 +27,9 @@ type Service interface {
 	// List returns all the bookmarked post for a given user. Only one type of bookmark can be retrieved at time.
 	// Results must be paginated using the limit and offset parameters.
 	List(ctx context.Context, userID uuid.UUID, level models.BookmarkLevel, target models.BookmarkTarget, limit, offset int) ([]*models.Bookmark, int64, error)
+	// ListAfter returns the bookmarks that come after the cursor, in the same order as List. An empty cursor returns
+	// the first page. It also returns the cursor of the next page, empty on the last page.
+	ListAfter(ctx context.Context, userID uuid.UUID, level models.BookmarkLevel, target models.BookmarkTarget, cursor string, limit int) ([]*models.Bookmark, string, error)
 
 	// StorageToModel converts a storage model to a service model.
 	StorageToModel(source *improve_post_storage.Model) *models.Bookmark
@@ -116,6 +119,29 @@ func (service *serviceImpl) List(ctx context.Context, userID uuid.UUID, level mo
 	return serviceModels, total, nil
 }
 
+func (service *serviceImpl) ListAfter(ctx context.Context, userID uuid.UUID, level models.BookmarkLevel, target models.BookmarkTarget, cursor string, limit int) ([]*models.Bookmark, string, error) {
+	if err := validation.CheckRestricted("level", level, levelValues...); err != nil {
+		return nil, "", err
+	}
+	if err := validation.CheckRestricted("target", target, bookmarkTargetValues...); err != nil {
+		return nil, "", err
+	}
+
+	storageModels, next, err := service.repository.ListAfter(
+		ctx, userID, bookmark_storage.Level(level), improve_post_storage.BookmarkTarget(target), cursor, limit,
+	)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list bookmarks: %w", err)
+	}
+
+	serviceModels := make([]*models.Bookmark, len(storageModels))
+	for i, storageModel := range storageModels {
+		serviceModels[i] = service.StorageToModel(storageModel)
+	}
+
+	return serviceModels, next, nil
+}
+
 func (service *serviceImpl) StorageToModel(source *improve_post_storage.Model) *models.Bookmark {
 	if source == nil {
 		return nil
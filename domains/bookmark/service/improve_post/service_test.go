@@ -388,3 +388,110 @@ func TestImprovePostService_List(t *testing.T) {
 		})
 	}
 }
+
+func TestImprovePostService_ListAfter(t *testing.T) {
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+		level  models.BookmarkLevel
+		target models.BookmarkTarget
+		cursor string
+		limit  int
+
+		shouldCallRepository bool
+		bookmarkData         []*improve_post_storage.Model
+		bookmarkNext         string
+		bookmarkErr          error
+
+		expect     []*models.Bookmark
+		expectNext string
+		expectErr  error
+	}{
+		{
+			name:                 "Success",
+			userID:               test_utils.NumberUUID(10),
+			level:                models.BookmarkLevelFavorite,
+			target:               models.BookmarkTargetImproveRequest,
+			cursor:               "cursor-1",
+			limit:                10,
+			shouldCallRepository: true,
+			bookmarkData: []*improve_post_storage.Model{
+				{
+					UserID:    test_utils.NumberUUID(10),
+					RequestID: test_utils.NumberUUID(101),
+					CreatedAt: baseTime,
+					Level:     bookmark_storage.LevelFavorite,
+					Target:    improve_post_storage.BookmarkTargetImproveRequest,
+				},
+			},
+			bookmarkNext: "cursor-2",
+			expect: []*models.Bookmark{
+				{
+					UserID:    test_utils.NumberUUID(10),
+					RequestID: test_utils.NumberUUID(101),
+					CreatedAt: baseTime,
+					Level:     models.BookmarkLevelFavorite,
+					Target:    models.BookmarkTargetImproveRequest,
+				},
+			},
+			expectNext: "cursor-2",
+		},
+		{
+			name:      "Error/InvalidLevel",
+			userID:    test_utils.NumberUUID(10),
+			level:     models.BookmarkLevel("foo"),
+			target:    models.BookmarkTargetImproveRequest,
+			cursor:    "cursor-1",
+			limit:     10,
+			expectErr: validation.ErrNotAllowed,
+		},
+		{
+			name:      "Error/InvalidTarget",
+			userID:    test_utils.NumberUUID(10),
+			level:     models.BookmarkLevelFavorite,
+			target:    models.BookmarkTarget("foo"),
+			cursor:    "cursor-1",
+			limit:     10,
+			expectErr: validation.ErrNotAllowed,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			userID:               test_utils.NumberUUID(10),
+			level:                models.BookmarkLevelFavorite,
+			target:               models.BookmarkTargetImproveRequest,
+			cursor:               "cursor-1",
+			limit:                10,
+			shouldCallRepository: true,
+			bookmarkErr:          fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := improve_post_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On(
+						"ListAfter", context.TODO(),
+						d.userID,
+						bookmark_storage.Level(d.level),
+						improve_post_storage.BookmarkTarget(d.target),
+						d.cursor, d.limit,
+					).
+					Return(d.bookmarkData, d.bookmarkNext, d.bookmarkErr)
+			}
+
+			service := NewService(repository)
+
+			res, next, err := service.ListAfter(context.TODO(), d.userID, d.level, d.target, d.cursor, d.limit)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expectNext, next)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(t))
+		})
+	}
+}
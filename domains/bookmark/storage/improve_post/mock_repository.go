@@ -4,6 +4,7 @@ package improve_post_storage
 
 import (
 	context "context"
+
 	"github.com/a-novel/agora-backend/domains/bookmark/storage"
 
 	mock "github.com/stretchr/testify/mock"
@@ -85,6 +86,111 @@ func (_c *MockRepository_Bookmark_Call) RunAndReturn(run func(context.Context, u
 	return _c
 }
 
+// CountOrphans provides a mock function with given fields: ctx
+func (_m *MockRepository) CountOrphans(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_CountOrphans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountOrphans'
+type MockRepository_CountOrphans_Call struct {
+	*mock.Call
+}
+
+// CountOrphans is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRepository_Expecter) CountOrphans(ctx interface{}) *MockRepository_CountOrphans_Call {
+	return &MockRepository_CountOrphans_Call{Call: _e.mock.On("CountOrphans", ctx)}
+}
+
+func (_c *MockRepository_CountOrphans_Call) Run(run func(ctx context.Context)) *MockRepository_CountOrphans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockRepository_CountOrphans_Call) Return(_a0 int64, _a1 error) *MockRepository_CountOrphans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_CountOrphans_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockRepository_CountOrphans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOrphans provides a mock function with given fields: ctx, limit
+func (_m *MockRepository) DeleteOrphans(ctx context.Context, limit int) (int64, error) {
+	ret := _m.Called(ctx, limit)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int64, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int64); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_DeleteOrphans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrphans'
+type MockRepository_DeleteOrphans_Call struct {
+	*mock.Call
+}
+
+// DeleteOrphans is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockRepository_Expecter) DeleteOrphans(ctx interface{}, limit interface{}) *MockRepository_DeleteOrphans_Call {
+	return &MockRepository_DeleteOrphans_Call{Call: _e.mock.On("DeleteOrphans", ctx, limit)}
+}
+
+func (_c *MockRepository_DeleteOrphans_Call) Run(run func(ctx context.Context, limit int)) *MockRepository_DeleteOrphans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteOrphans_Call) Return(_a0 int64, _a1 error) *MockRepository_DeleteOrphans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_DeleteOrphans_Call) RunAndReturn(run func(context.Context, int) (int64, error)) *MockRepository_DeleteOrphans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsBookmarked provides a mock function with given fields: ctx, userID, requestID, target
 func (_m *MockRepository) IsBookmarked(ctx context.Context, userID uuid.UUID, requestID uuid.UUID, target BookmarkTarget) (*bookmark_storage.Level, error) {
 	ret := _m.Called(ctx, userID, requestID, target)
@@ -208,6 +314,72 @@ func (_c *MockRepository_List_Call) RunAndReturn(run func(context.Context, uuid.
 	return _c
 }
 
+// ListAfter provides a mock function with given fields: ctx, userID, level, target, cursor, limit
+func (_m *MockRepository) ListAfter(ctx context.Context, userID uuid.UUID, level bookmark_storage.Level, target BookmarkTarget, cursor string, limit int) ([]*Model, string, error) {
+	ret := _m.Called(ctx, userID, level, target, cursor, limit)
+
+	var r0 []*Model
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bookmark_storage.Level, BookmarkTarget, string, int) ([]*Model, string, error)); ok {
+		return rf(ctx, userID, level, target, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bookmark_storage.Level, BookmarkTarget, string, int) []*Model); ok {
+		r0 = rf(ctx, userID, level, target, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bookmark_storage.Level, BookmarkTarget, string, int) string); ok {
+		r1 = rf(ctx, userID, level, target, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, bookmark_storage.Level, BookmarkTarget, string, int) error); ok {
+		r2 = rf(ctx, userID, level, target, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRepository_ListAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAfter'
+type MockRepository_ListAfter_Call struct {
+	*mock.Call
+}
+
+// ListAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - level bookmark_storage.Level
+//   - target BookmarkTarget
+//   - cursor string
+//   - limit int
+func (_e *MockRepository_Expecter) ListAfter(ctx interface{}, userID interface{}, level interface{}, target interface{}, cursor interface{}, limit interface{}) *MockRepository_ListAfter_Call {
+	return &MockRepository_ListAfter_Call{Call: _e.mock.On("ListAfter", ctx, userID, level, target, cursor, limit)}
+}
+
+func (_c *MockRepository_ListAfter_Call) Run(run func(ctx context.Context, userID uuid.UUID, level bookmark_storage.Level, target BookmarkTarget, cursor string, limit int)) *MockRepository_ListAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bookmark_storage.Level), args[3].(BookmarkTarget), args[4].(string), args[5].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListAfter_Call) Return(_a0 []*Model, _a1 string, _a2 error) *MockRepository_ListAfter_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRepository_ListAfter_Call) RunAndReturn(run func(context.Context, uuid.UUID, bookmark_storage.Level, BookmarkTarget, string, int) ([]*Model, string, error)) *MockRepository_ListAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnBookmark provides a mock function with given fields: ctx, userID, requestID, target
 func (_m *MockRepository) UnBookmark(ctx context.Context, userID uuid.UUID, requestID uuid.UUID, target BookmarkTarget) error {
 	ret := _m.Called(ctx, userID, requestID, target)
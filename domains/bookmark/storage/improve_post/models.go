@@ -31,7 +31,7 @@ const (
 //		Level:      improve_post_storage.LevelBookmark,
 //	}
 type Model struct {
-	bun.BaseModel `bun:"table:improve_posts_bookmarks"`
+	bun.BaseModel `bun:"table:improve_posts_bookmarks,alias:improve_posts_bookmarks"`
 
 	// UserID is the ID of the user who created the bookmark.
 	UserID uuid.UUID `json:"user_id" bun:"user_id,pk,type:uuid"`
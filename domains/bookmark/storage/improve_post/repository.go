@@ -2,6 +2,7 @@ package improve_post_storage
 
 import (
 	"context"
+	"github.com/a-novel/agora-backend/framework/pagination"
 	"github.com/a-novel/agora-backend/framework/validation"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
@@ -19,11 +20,31 @@ type Repository interface {
 	// The error is only returned when something unexpected happens.
 	IsBookmarked(ctx context.Context, userID, requestID uuid.UUID, target BookmarkTarget) (*bookmark_storage.Level, error)
 	// List returns all the bookmarked post for a given user. Only one type of bookmark can be retrieved at time.
+	// Deleted posts are left out, until they are restored.
 	// Results must be paginated using the limit and offset parameters.
 	// It also returns the total number of available results, to help with pagination.
 	List(ctx context.Context, userID uuid.UUID, level bookmark_storage.Level, target BookmarkTarget, limit, offset int) ([]*Model, int64, error)
+	// ListAfter returns the bookmarked posts, in the same order as List, that come after the given cursor. An empty
+	// cursor starts from the most recent bookmark.
+	// It also returns the cursor of the next page, which is empty when there are no more results.
+	ListAfter(ctx context.Context, userID uuid.UUID, level bookmark_storage.Level, target BookmarkTarget, cursor string, limit int) ([]*Model, string, error)
+	// CountOrphans returns the number of bookmarks whose target post no longer exists.
+	CountOrphans(ctx context.Context) (int64, error)
+	// DeleteOrphans removes up to limit bookmarks whose target post no longer exists, and returns the number of
+	// removed bookmarks.
+	DeleteOrphans(ctx context.Context, limit int) (int64, error)
 }
 
+// Bookmarks are removed along with their post by a trigger, so this only matches the bookmarks left over from before
+// the trigger existed. Soft deleted posts keep their bookmarks until they are purged.
+const orphanCondition = `(
+	improve_posts_bookmarks.target = 'improve_request'
+	AND NOT EXISTS (SELECT 1 FROM improve_requests WHERE improve_requests.id = improve_posts_bookmarks.request_id)
+) OR (
+	improve_posts_bookmarks.target = 'improve_suggestion'
+	AND NOT EXISTS (SELECT 1 FROM improve_suggestions WHERE improve_suggestions.id = improve_posts_bookmarks.request_id)
+)`
+
 // NewRepository returns a new Repository instance.
 // To use a mocked one, call NewMockRepository.
 func NewRepository(db bun.IDB) Repository {
@@ -34,6 +55,24 @@ type repositoryImpl struct {
 	db bun.IDB
 }
 
+// Selects the IDs of the deleted posts of a target, whose bookmarks are kept until the posts are purged.
+func (repository *repositoryImpl) deletedPostsQuery(target BookmarkTarget) (*bun.SelectQuery, error) {
+	var postTable string
+	switch target {
+	case BookmarkTargetImproveRequest:
+		postTable = "improve_requests"
+	case BookmarkTargetImproveSuggestion:
+		postTable = "improve_suggestions"
+	default:
+		return nil, validation.ErrInvalidEntity
+	}
+
+	return repository.db.NewSelect().
+		Column("id").
+		TableExpr(postTable).
+		Where("deleted_at IS NOT NULL"), nil
+}
+
 func (repository *repositoryImpl) Bookmark(ctx context.Context, userID, requestID uuid.UUID, target BookmarkTarget, level bookmark_storage.Level, now time.Time) (*Model, error) {
 	model := &Model{
 		UserID:    userID,
@@ -92,11 +131,17 @@ func (repository *repositoryImpl) IsBookmarked(ctx context.Context, userID, requ
 }
 
 func (repository *repositoryImpl) List(ctx context.Context, userID uuid.UUID, level bookmark_storage.Level, target BookmarkTarget, limit, offset int) ([]*Model, int64, error) {
+	deletedPosts, err := repository.deletedPostsQuery(target)
+	if err != nil {
+		return nil, 0, err
+	}
+
 	var models []*Model
 
 	count, err := repository.db.NewSelect().
 		Model(&models).
 		Where("user_id = ? AND level = ? AND target = ?", userID, level, target).
+		Where("request_id NOT IN (?)", deletedPosts).
 		Order("created_at DESC").
 		Limit(limit).Offset(offset).
 		ScanAndCount(ctx)
@@ -106,3 +151,85 @@ func (repository *repositoryImpl) List(ctx context.Context, userID uuid.UUID, le
 
 	return models, int64(count), nil
 }
+
+func (repository *repositoryImpl) ListAfter(ctx context.Context, userID uuid.UUID, level bookmark_storage.Level, target BookmarkTarget, cursor string, limit int) ([]*Model, string, error) {
+	type modelWithCursor struct {
+		Model  `bun:",extend"`
+		Cursor string `bun:"cursor,scanonly"`
+	}
+
+	deletedPosts, err := repository.deletedPostsQuery(target)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var results []*modelWithCursor
+
+	query, err := pagination.Apply(
+		repository.db.NewSelect().
+			Model(&results).
+			Column("*").
+			Where("user_id = ? AND level = ? AND target = ?", userID, level, target).
+			Where("request_id NOT IN (?)", deletedPosts).
+			Limit(limit),
+		// A post is bookmarked at most once per user and target.
+		[]pagination.Key{
+			{Expr: "created_at", Type: "timestamp"},
+			{Expr: "request_id", Type: "uuid"},
+		},
+		cursor,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, "", validation.HandlePGError(err)
+	}
+
+	models := make([]*Model, len(results))
+	for i, result := range results {
+		models[i] = &result.Model
+	}
+
+	next := pagination.Next(results, limit, func(row *modelWithCursor) string {
+		return row.Cursor
+	})
+
+	return models, next, nil
+}
+
+func (repository *repositoryImpl) CountOrphans(ctx context.Context) (int64, error) {
+	count, err := repository.db.NewSelect().
+		Model(new(Model)).
+		Where(orphanCondition).
+		Count(ctx)
+	if err != nil {
+		return 0, validation.HandlePGError(err)
+	}
+
+	return int64(count), nil
+}
+
+func (repository *repositoryImpl) DeleteOrphans(ctx context.Context, limit int) (int64, error) {
+	batch := repository.db.NewSelect().
+		Model(new(Model)).
+		Column("user_id", "request_id", "target").
+		Where(orphanCondition).
+		Limit(limit)
+
+	res, err := repository.db.NewDelete().
+		Model(new(Model)).
+		Where("(user_id, request_id, target) IN (?)", batch).
+		Exec(ctx)
+	if err != nil {
+		return 0, validation.HandlePGError(err)
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return 0, validation.HandlePGError(err)
+	}
+
+	return count, nil
+}
@@ -332,3 +332,347 @@ func TestImprovePostRepository_List(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestImprovePostRepository_ListAfter(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []*Model{
+		{
+			UserID:    test_utils.NumberUUID(1),
+			RequestID: test_utils.NumberUUID(10),
+			CreatedAt: baseTime,
+			Target:    BookmarkTargetImproveRequest,
+			Level:     bookmark_storage.LevelBookmark,
+		},
+		{
+			UserID:    test_utils.NumberUUID(1),
+			RequestID: test_utils.NumberUUID(11),
+			CreatedAt: baseTime.Add(time.Hour),
+			Target:    BookmarkTargetImproveRequest,
+			Level:     bookmark_storage.LevelBookmark,
+		},
+		// Same date as the previous bookmark, ordered by request ID.
+		{
+			UserID:    test_utils.NumberUUID(1),
+			RequestID: test_utils.NumberUUID(12),
+			CreatedAt: baseTime.Add(time.Hour),
+			Target:    BookmarkTargetImproveRequest,
+			Level:     bookmark_storage.LevelBookmark,
+		},
+		{
+			UserID:    test_utils.NumberUUID(1),
+			RequestID: test_utils.NumberUUID(13),
+			CreatedAt: baseTime.Add(30 * time.Minute),
+			Target:    BookmarkTargetImproveRequest,
+			Level:     bookmark_storage.LevelBookmark,
+		},
+		{
+			UserID:    test_utils.NumberUUID(1),
+			RequestID: test_utils.NumberUUID(20),
+			CreatedAt: baseTime.Add(2 * time.Hour),
+			Target:    BookmarkTargetImproveRequest,
+			Level:     bookmark_storage.LevelFavorite,
+		},
+		{
+			UserID:    test_utils.NumberUUID(2),
+			RequestID: test_utils.NumberUUID(10),
+			CreatedAt: baseTime.Add(2 * time.Hour),
+			Target:    BookmarkTargetImproveRequest,
+			Level:     bookmark_storage.LevelBookmark,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		t.Run("Success/Pagination", func(st *testing.T) {
+			var (
+				res    []*Model
+				cursor string
+			)
+
+			// Read every bookmark, one by one.
+			for i := 0; i < 4; i++ {
+				page, next, err := repository.ListAfter(
+					ctx, test_utils.NumberUUID(1), bookmark_storage.LevelBookmark, BookmarkTargetImproveRequest, cursor, 1,
+				)
+				require.NoError(st, err)
+				require.NotEmpty(st, next)
+
+				res = append(res, page...)
+				cursor = next
+			}
+
+			require.Equal(st, []*Model{fixtures[2], fixtures[1], fixtures[3], fixtures[0]}, res)
+
+			// The last page is empty.
+			page, next, err := repository.ListAfter(
+				ctx, test_utils.NumberUUID(1), bookmark_storage.LevelBookmark, BookmarkTargetImproveRequest, cursor, 1,
+			)
+			require.NoError(st, err)
+			require.Empty(st, page)
+			require.Empty(st, next)
+		})
+
+		t.Run("Error/InvalidCursor", func(st *testing.T) {
+			_, _, err := repository.ListAfter(
+				ctx, test_utils.NumberUUID(1), bookmark_storage.LevelBookmark, BookmarkTargetImproveRequest, "foo", 1,
+			)
+			require.ErrorIs(st, err, validation.ErrInvalidEntity)
+		})
+	})
+	require.NoError(t, err)
+}
+
+func TestImprovePostRepository_List_DeletedPosts(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	deleteTime := baseTime.Add(time.Hour)
+
+	fixtures := []interface{}{
+		&improve_request_storage.Model{
+			ID:        test_utils.NumberUUID(10),
+			CreatedAt: baseTime,
+			Source:    test_utils.NumberUUID(10),
+			UserID:    test_utils.NumberUUID(3),
+			Title:     "Test",
+			Content:   "Dummy content.",
+		},
+		&improve_request_storage.Model{
+			ID:        test_utils.NumberUUID(11),
+			CreatedAt: baseTime,
+			Source:    test_utils.NumberUUID(11),
+			UserID:    test_utils.NumberUUID(3),
+			Title:     "Deleted",
+			Content:   "Deleted request.",
+			DeletedAt: framework.ToPTR(deleteTime),
+		},
+		&improve_suggestion_storage.Model{
+			ID:         test_utils.NumberUUID(20),
+			CreatedAt:  baseTime,
+			SourceID:   test_utils.NumberUUID(10),
+			UserID:     test_utils.NumberUUID(4),
+			RevisionID: test_utils.NumberUUID(21),
+			DeletedAt:  framework.ToPTR(deleteTime),
+			Core: improve_suggestion_storage.Core{
+				RequestID: test_utils.NumberUUID(10),
+				Title:     "Deleted",
+				Content:   "Deleted suggestion.",
+			},
+		},
+		&Model{
+			UserID:    test_utils.NumberUUID(1),
+			RequestID: test_utils.NumberUUID(10),
+			CreatedAt: baseTime,
+			Target:    BookmarkTargetImproveRequest,
+			Level:     bookmark_storage.LevelBookmark,
+		},
+		&Model{
+			UserID:    test_utils.NumberUUID(1),
+			RequestID: test_utils.NumberUUID(11),
+			CreatedAt: baseTime.Add(time.Minute),
+			Target:    BookmarkTargetImproveRequest,
+			Level:     bookmark_storage.LevelBookmark,
+		},
+		&Model{
+			UserID:    test_utils.NumberUUID(1),
+			RequestID: test_utils.NumberUUID(20),
+			CreatedAt: baseTime,
+			Target:    BookmarkTargetImproveSuggestion,
+			Level:     bookmark_storage.LevelBookmark,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		t.Run("List", func(st *testing.T) {
+			res, count, err := repository.List(
+				ctx, test_utils.NumberUUID(1), bookmark_storage.LevelBookmark, BookmarkTargetImproveRequest, 10, 0,
+			)
+			require.NoError(st, err)
+			require.Equal(st, []*Model{fixtures[3].(*Model)}, res)
+			require.Equal(st, int64(1), count)
+
+			res, count, err = repository.List(
+				ctx, test_utils.NumberUUID(1), bookmark_storage.LevelBookmark, BookmarkTargetImproveSuggestion, 10, 0,
+			)
+			require.NoError(st, err)
+			require.Empty(st, res)
+			require.Equal(st, int64(0), count)
+		})
+
+		t.Run("ListAfter", func(st *testing.T) {
+			res, next, err := repository.ListAfter(
+				ctx, test_utils.NumberUUID(1), bookmark_storage.LevelBookmark, BookmarkTargetImproveRequest, "", 10,
+			)
+			require.NoError(st, err)
+			require.Equal(st, []*Model{fixtures[3].(*Model)}, res)
+			require.Empty(st, next)
+
+			res, next, err = repository.ListAfter(
+				ctx, test_utils.NumberUUID(1), bookmark_storage.LevelBookmark, BookmarkTargetImproveSuggestion, "", 10,
+			)
+			require.NoError(st, err)
+			require.Empty(st, res)
+			require.Empty(st, next)
+		})
+
+		// Deleted posts keep their bookmarks, so they show up again once restored.
+		t.Run("Restored", func(st *testing.T) {
+			stx, err := tx.Begin()
+			require.NoError(st, err)
+			defer stx.Rollback()
+
+			_, err = stx.NewUpdate().
+				Model(new(improve_request_storage.Model)).
+				Set("deleted_at = NULL").
+				Where("id = ?", test_utils.NumberUUID(11)).
+				Exec(ctx)
+			require.NoError(st, err)
+
+			_, count, err := NewRepository(stx).List(
+				ctx, test_utils.NumberUUID(1), bookmark_storage.LevelBookmark, BookmarkTargetImproveRequest, 10, 0,
+			)
+			require.NoError(st, err)
+			require.Equal(st, int64(2), count)
+		})
+	})
+	require.NoError(t, err)
+}
+
+func TestImprovePostRepository_DeleteOrphans(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&improve_request_storage.Model{
+			ID:        test_utils.NumberUUID(10),
+			CreatedAt: baseTime,
+			Source:    test_utils.NumberUUID(10),
+			UserID:    test_utils.NumberUUID(3),
+			Title:     "Test",
+			Content:   "Dummy content.",
+		},
+		&improve_suggestion_storage.Model{
+			ID:         test_utils.NumberUUID(20),
+			CreatedAt:  baseTime,
+			SourceID:   test_utils.NumberUUID(10),
+			UserID:     test_utils.NumberUUID(4),
+			RevisionID: test_utils.NumberUUID(21),
+			Core: improve_suggestion_storage.Core{
+				RequestID: test_utils.NumberUUID(10),
+				Title:     "Test",
+				Content:   "Dummy content.",
+			},
+		},
+		// Existing posts.
+		&Model{
+			UserID:    test_utils.NumberUUID(1),
+			RequestID: test_utils.NumberUUID(10),
+			CreatedAt: baseTime,
+			Target:    BookmarkTargetImproveRequest,
+			Level:     bookmark_storage.LevelBookmark,
+		},
+		&Model{
+			UserID:    test_utils.NumberUUID(1),
+			RequestID: test_utils.NumberUUID(20),
+			CreatedAt: baseTime,
+			Target:    BookmarkTargetImproveSuggestion,
+			Level:     bookmark_storage.LevelFavorite,
+		},
+		// Missing posts. The suggestion ID does not point to a request.
+		&Model{
+			UserID:    test_utils.NumberUUID(1),
+			RequestID: test_utils.NumberUUID(20),
+			CreatedAt: baseTime,
+			Target:    BookmarkTargetImproveRequest,
+			Level:     bookmark_storage.LevelBookmark,
+		},
+		&Model{
+			UserID:    test_utils.NumberUUID(1),
+			RequestID: test_utils.NumberUUID(30),
+			CreatedAt: baseTime,
+			Target:    BookmarkTargetImproveSuggestion,
+			Level:     bookmark_storage.LevelBookmark,
+		},
+		&Model{
+			UserID:    test_utils.NumberUUID(2),
+			RequestID: test_utils.NumberUUID(30),
+			CreatedAt: baseTime,
+			Target:    BookmarkTargetImproveSuggestion,
+			Level:     bookmark_storage.LevelFavorite,
+		},
+	}
+
+	data := []struct {
+		name string
+
+		limit int
+
+		expect          int64
+		expectRemaining int64
+	}{
+		{
+			name:            "Success",
+			limit:           10,
+			expect:          3,
+			expectRemaining: 0,
+		},
+		{
+			name:            "Success/Batch",
+			limit:           2,
+			expect:          2,
+			expectRemaining: 1,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		count, err := NewRepository(tx).CountOrphans(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), count)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				count, err := repository.DeleteOrphans(ctx, d.limit)
+				require.NoError(st, err)
+				require.Equal(st, d.expect, count)
+
+				remaining, err := repository.CountOrphans(ctx)
+				require.NoError(st, err)
+				require.Equal(st, d.expectRemaining, remaining)
+
+				level, err := repository.IsBookmarked(ctx, test_utils.NumberUUID(1), test_utils.NumberUUID(10), BookmarkTargetImproveRequest)
+				require.NoError(st, err)
+				require.Equal(st, framework.ToPTR(bookmark_storage.LevelBookmark), level)
+			})
+		}
+
+		t.Run("Success/DeletePostCascade", func(st *testing.T) {
+			stx, err := tx.Begin()
+			require.NoError(st, err)
+			defer stx.Rollback()
+
+			_, err = stx.NewDelete().
+				Model(new(improve_suggestion_storage.Model)).
+				Where("id = ?", test_utils.NumberUUID(20)).
+				Exec(ctx)
+			require.NoError(st, err)
+
+			level, err := NewRepository(stx).IsBookmarked(ctx, test_utils.NumberUUID(1), test_utils.NumberUUID(20), BookmarkTargetImproveSuggestion)
+			require.NoError(st, err)
+			require.Nil(st, level)
+		})
+	})
+	require.NoError(t, err)
+}
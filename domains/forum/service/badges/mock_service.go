@@ -0,0 +1,208 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package badges_service
+
+import (
+	context "context"
+
+	"github.com/a-novel/agora-backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// Evaluate provides a mock function with given fields: ctx, userID, now
+func (_m *MockService) Evaluate(ctx context.Context, userID uuid.UUID, now time.Time) ([]*models.UserBadge, error) {
+	ret := _m.Called(ctx, userID, now)
+
+	var r0 []*models.UserBadge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) ([]*models.UserBadge, error)); ok {
+		return rf(ctx, userID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) []*models.UserBadge); ok {
+		r0 = rf(ctx, userID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.UserBadge)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, userID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Evaluate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Evaluate'
+type MockService_Evaluate_Call struct {
+	*mock.Call
+}
+
+// Evaluate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - now time.Time
+func (_e *MockService_Expecter) Evaluate(ctx interface{}, userID interface{}, now interface{}) *MockService_Evaluate_Call {
+	return &MockService_Evaluate_Call{Call: _e.mock.On("Evaluate", ctx, userID, now)}
+}
+
+func (_c *MockService_Evaluate_Call) Run(run func(ctx context.Context, userID uuid.UUID, now time.Time)) *MockService_Evaluate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Evaluate_Call) Return(_a0 []*models.UserBadge, _a1 error) *MockService_Evaluate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Evaluate_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) ([]*models.UserBadge, error)) *MockService_Evaluate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, userID
+func (_m *MockService) List(ctx context.Context, userID uuid.UUID) ([]*models.UserBadge, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []*models.UserBadge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*models.UserBadge, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*models.UserBadge); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.UserBadge)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockService_Expecter) List(ctx interface{}, userID interface{}) *MockService_List_Call {
+	return &MockService_List_Call{Call: _e.mock.On("List", ctx, userID)}
+}
+
+func (_c *MockService_List_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_List_Call) Return(_a0 []*models.UserBadge, _a1 error) *MockService_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_List_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*models.UserBadge, error)) *MockService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActive provides a mock function with given fields: ctx, since
+func (_m *MockService) ListActive(ctx context.Context, since time.Time) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, since)
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]uuid.UUID, error)); ok {
+		return rf(ctx, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []uuid.UUID); ok {
+		r0 = rf(ctx, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActive'
+type MockService_ListActive_Call struct {
+	*mock.Call
+}
+
+// ListActive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since time.Time
+func (_e *MockService_Expecter) ListActive(ctx interface{}, since interface{}) *MockService_ListActive_Call {
+	return &MockService_ListActive_Call{Call: _e.mock.On("ListActive", ctx, since)}
+}
+
+func (_c *MockService_ListActive_Call) Run(run func(ctx context.Context, since time.Time)) *MockService_ListActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_ListActive_Call) Return(_a0 []uuid.UUID, _a1 error) *MockService_ListActive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListActive_Call) RunAndReturn(run func(context.Context, time.Time) ([]uuid.UUID, error)) *MockService_ListActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockService(t mockConstructorTestingTNewMockService) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,120 @@
+package badges_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	PersistentWriterRevisions = 10
+	HundredUpVotesThreshold   = 100
+)
+
+// Rule tells whether a badge is earned, given the forum activity of a user.
+type Rule struct {
+	Badge  models.ForumBadge
+	Earned func(activity *models.ForumActivity) bool
+}
+
+// Rules lists every badge that can be awarded on the forum.
+var Rules = []Rule{
+	{
+		Badge: models.ForumBadgeFirstAcceptedSuggestion,
+		Earned: func(activity *models.ForumActivity) bool {
+			return activity.AcceptedSuggestions > 0
+		},
+	},
+	{
+		Badge: models.ForumBadgePersistentWriter,
+		Earned: func(activity *models.ForumActivity) bool {
+			return activity.MaxRevisions >= PersistentWriterRevisions
+		},
+	},
+	{
+		Badge: models.ForumBadgeHundredUpVotes,
+		Earned: func(activity *models.ForumActivity) bool {
+			return activity.UpVotes >= HundredUpVotesThreshold
+		},
+	},
+}
+
+// Service of the current layer. You can instantiate a new one with NewService.
+type Service interface {
+	// ListActive returns the users with some recent activity that may earn them a badge.
+	ListActive(ctx context.Context, since time.Time) ([]uuid.UUID, error)
+	// Evaluate checks the activity of a user against the badge Rules, and awards the earned badges. It only
+	// returns the badges that were not awarded before, so it is safe to call repeatedly.
+	Evaluate(ctx context.Context, userID uuid.UUID, now time.Time) ([]*models.UserBadge, error)
+	// List returns the badges of a user, in the order they were awarded.
+	List(ctx context.Context, userID uuid.UUID) ([]*models.UserBadge, error)
+}
+
+type serviceImpl struct {
+	repository badges_storage.Repository
+}
+
+// NewService returns a new Service instance.
+// To use a mocked one, call NewMockService.
+func NewService(repository badges_storage.Repository) Service {
+	return &serviceImpl{repository: repository}
+}
+
+func (service *serviceImpl) ListActive(ctx context.Context, since time.Time) ([]uuid.UUID, error) {
+	userIDs, err := service.repository.ListActive(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active users: %w", err)
+	}
+
+	return userIDs, nil
+}
+
+func (service *serviceImpl) Evaluate(ctx context.Context, userID uuid.UUID, now time.Time) ([]*models.UserBadge, error) {
+	activity, err := service.repository.ReadActivity(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read forum activity of user %q: %w", userID, err)
+	}
+
+	modelActivity := &models.ForumActivity{
+		AcceptedSuggestions: activity.AcceptedSuggestions,
+		MaxRevisions:        activity.MaxRevisions,
+		UpVotes:             activity.UpVotes,
+	}
+
+	var earned []badges_storage.Badge
+	for _, rule := range Rules {
+		if rule.Earned(modelActivity) {
+			earned = append(earned, badges_storage.Badge(rule.Badge))
+		}
+	}
+
+	storageModels, err := service.repository.Award(ctx, userID, earned, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to award badges to user %q: %w", userID, err)
+	}
+
+	return service.storageToModels(storageModels), nil
+}
+
+func (service *serviceImpl) List(ctx context.Context, userID uuid.UUID) ([]*models.UserBadge, error) {
+	storageModels, err := service.repository.List(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list badges of user %q: %w", userID, err)
+	}
+
+	return service.storageToModels(storageModels), nil
+}
+
+func (service *serviceImpl) storageToModels(source []*badges_storage.Model) []*models.UserBadge {
+	badges := make([]*models.UserBadge, len(source))
+	for i, storageModel := range source {
+		badges[i] = &models.UserBadge{
+			Badge:     models.ForumBadge(storageModel.Badge),
+			AwardedAt: storageModel.AwardedAt,
+		}
+	}
+
+	return badges
+}
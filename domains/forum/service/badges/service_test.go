@@ -0,0 +1,222 @@
+package badges_service
+
+import (
+	"context"
+	"errors"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime   = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	updateTime = time.Date(2020, time.May, 4, 9, 0, 0, 0, time.UTC)
+	fooErr     = errors.New("it broken")
+)
+
+func TestBadgesService_ListActive(t *testing.T) {
+	data := []struct {
+		name string
+
+		since time.Time
+
+		repositoryData []uuid.UUID
+		repositoryErr  error
+
+		expect    []uuid.UUID
+		expectErr error
+	}{
+		{
+			name:           "Success",
+			since:          baseTime,
+			repositoryData: []uuid.UUID{test_utils.NumberUUID(100), test_utils.NumberUUID(101)},
+			expect:         []uuid.UUID{test_utils.NumberUUID(100), test_utils.NumberUUID(101)},
+		},
+		{
+			name:          "Error/RepositoryFailure",
+			since:         baseTime,
+			repositoryErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := badges_storage.NewMockRepository(st)
+			repository.On("ListActive", context.TODO(), d.since).Return(d.repositoryData, d.repositoryErr)
+
+			service := NewService(repository)
+			res, err := service.ListActive(context.TODO(), d.since)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			repository.AssertExpectations(st)
+		})
+	}
+}
+
+func TestBadgesService_Evaluate(t *testing.T) {
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+		now    time.Time
+
+		activityData *badges_storage.Activity
+		activityErr  error
+
+		shouldCallAward bool
+		awardBadges     []badges_storage.Badge
+		awardData       []*badges_storage.Model
+		awardErr        error
+
+		expect    []*models.UserBadge
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			userID: test_utils.NumberUUID(100),
+			now:    updateTime,
+			activityData: &badges_storage.Activity{
+				AcceptedSuggestions: 1,
+				MaxRevisions:        10,
+				UpVotes:             99,
+			},
+			shouldCallAward: true,
+			awardBadges:     []badges_storage.Badge{badges_storage.BadgeFirstAcceptedSuggestion, badges_storage.BadgePersistentWriter},
+			awardData: []*badges_storage.Model{
+				{UserID: test_utils.NumberUUID(100), Badge: badges_storage.BadgePersistentWriter, AwardedAt: updateTime},
+			},
+			expect: []*models.UserBadge{
+				{Badge: models.ForumBadgePersistentWriter, AwardedAt: updateTime},
+			},
+		},
+		{
+			name:   "Success/AllBadges",
+			userID: test_utils.NumberUUID(100),
+			now:    updateTime,
+			activityData: &badges_storage.Activity{
+				AcceptedSuggestions: 3,
+				MaxRevisions:        12,
+				UpVotes:             100,
+			},
+			shouldCallAward: true,
+			awardBadges: []badges_storage.Badge{
+				badges_storage.BadgeFirstAcceptedSuggestion,
+				badges_storage.BadgePersistentWriter,
+				badges_storage.BadgeHundredUpVotes,
+			},
+			awardData: []*badges_storage.Model{
+				{UserID: test_utils.NumberUUID(100), Badge: badges_storage.BadgeFirstAcceptedSuggestion, AwardedAt: updateTime},
+				{UserID: test_utils.NumberUUID(100), Badge: badges_storage.BadgePersistentWriter, AwardedAt: updateTime},
+				{UserID: test_utils.NumberUUID(100), Badge: badges_storage.BadgeHundredUpVotes, AwardedAt: updateTime},
+			},
+			expect: []*models.UserBadge{
+				{Badge: models.ForumBadgeFirstAcceptedSuggestion, AwardedAt: updateTime},
+				{Badge: models.ForumBadgePersistentWriter, AwardedAt: updateTime},
+				{Badge: models.ForumBadgeHundredUpVotes, AwardedAt: updateTime},
+			},
+		},
+		{
+			name:            "Success/NoBadges",
+			userID:          test_utils.NumberUUID(100),
+			now:             updateTime,
+			activityData:    &badges_storage.Activity{MaxRevisions: 9},
+			shouldCallAward: true,
+			awardData:       []*badges_storage.Model{},
+			expect:          []*models.UserBadge{},
+		},
+		{
+			name:        "Error/ActivityFailure",
+			userID:      test_utils.NumberUUID(100),
+			now:         updateTime,
+			activityErr: fooErr,
+			expectErr:   fooErr,
+		},
+		{
+			name:   "Error/AwardFailure",
+			userID: test_utils.NumberUUID(100),
+			now:    updateTime,
+			activityData: &badges_storage.Activity{
+				AcceptedSuggestions: 1,
+			},
+			shouldCallAward: true,
+			awardBadges:     []badges_storage.Badge{badges_storage.BadgeFirstAcceptedSuggestion},
+			awardErr:        fooErr,
+			expectErr:       fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := badges_storage.NewMockRepository(st)
+			repository.On("ReadActivity", context.TODO(), d.userID).Return(d.activityData, d.activityErr)
+
+			if d.shouldCallAward {
+				repository.On("Award", context.TODO(), d.userID, d.awardBadges, d.now).Return(d.awardData, d.awardErr)
+			}
+
+			service := NewService(repository)
+			res, err := service.Evaluate(context.TODO(), d.userID, d.now)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			repository.AssertExpectations(st)
+		})
+	}
+}
+
+func TestBadgesService_List(t *testing.T) {
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+
+		repositoryData []*badges_storage.Model
+		repositoryErr  error
+
+		expect    []*models.UserBadge
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			userID: test_utils.NumberUUID(100),
+			repositoryData: []*badges_storage.Model{
+				{UserID: test_utils.NumberUUID(100), Badge: badges_storage.BadgePersistentWriter, AwardedAt: baseTime},
+				{UserID: test_utils.NumberUUID(100), Badge: badges_storage.BadgeHundredUpVotes, AwardedAt: updateTime},
+			},
+			expect: []*models.UserBadge{
+				{Badge: models.ForumBadgePersistentWriter, AwardedAt: baseTime},
+				{Badge: models.ForumBadgeHundredUpVotes, AwardedAt: updateTime},
+			},
+		},
+		{
+			name:           "Success/NoBadges",
+			userID:         test_utils.NumberUUID(100),
+			repositoryData: []*badges_storage.Model{},
+			expect:         []*models.UserBadge{},
+		},
+		{
+			name:          "Error/RepositoryFailure",
+			userID:        test_utils.NumberUUID(100),
+			repositoryErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := badges_storage.NewMockRepository(st)
+			repository.On("List", context.TODO(), d.userID).Return(d.repositoryData, d.repositoryErr)
+
+			service := NewService(repository)
+			res, err := service.List(context.TODO(), d.userID)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			repository.AssertExpectations(st)
+		})
+	}
+}
@@ -0,0 +1,425 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package collaborator_service
+
+import (
+	context "context"
+
+	"github.com/a-novel/agora-backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// Accept provides a mock function with given fields: ctx, source, userID, now
+func (_m *MockService) Accept(ctx context.Context, source uuid.UUID, userID uuid.UUID, now time.Time) (*models.ImproveRequestCollaborator, error) {
+	ret := _m.Called(ctx, source, userID, now)
+
+	var r0 *models.ImproveRequestCollaborator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) (*models.ImproveRequestCollaborator, error)); ok {
+		return rf(ctx, source, userID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) *models.ImproveRequestCollaborator); ok {
+		r0 = rf(ctx, source, userID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestCollaborator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, source, userID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Accept_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Accept'
+type MockService_Accept_Call struct {
+	*mock.Call
+}
+
+// Accept is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - userID uuid.UUID
+//   - now time.Time
+func (_e *MockService_Expecter) Accept(ctx interface{}, source interface{}, userID interface{}, now interface{}) *MockService_Accept_Call {
+	return &MockService_Accept_Call{Call: _e.mock.On("Accept", ctx, source, userID, now)}
+}
+
+func (_c *MockService_Accept_Call) Run(run func(ctx context.Context, source uuid.UUID, userID uuid.UUID, now time.Time)) *MockService_Accept_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Accept_Call) Return(_a0 *models.ImproveRequestCollaborator, _a1 error) *MockService_Accept_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Accept_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, time.Time) (*models.ImproveRequestCollaborator, error)) *MockService_Accept_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Invite provides a mock function with given fields: ctx, source, userID, invitedBy, role, now
+func (_m *MockService) Invite(ctx context.Context, source uuid.UUID, userID uuid.UUID, invitedBy uuid.UUID, role models.ImproveRequestCollaboratorRole, now time.Time) (*models.ImproveRequestCollaborator, error) {
+	ret := _m.Called(ctx, source, userID, invitedBy, role, now)
+
+	var r0 *models.ImproveRequestCollaborator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, models.ImproveRequestCollaboratorRole, time.Time) (*models.ImproveRequestCollaborator, error)); ok {
+		return rf(ctx, source, userID, invitedBy, role, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, models.ImproveRequestCollaboratorRole, time.Time) *models.ImproveRequestCollaborator); ok {
+		r0 = rf(ctx, source, userID, invitedBy, role, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestCollaborator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, models.ImproveRequestCollaboratorRole, time.Time) error); ok {
+		r1 = rf(ctx, source, userID, invitedBy, role, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Invite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Invite'
+type MockService_Invite_Call struct {
+	*mock.Call
+}
+
+// Invite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - userID uuid.UUID
+//   - invitedBy uuid.UUID
+//   - role models.ImproveRequestCollaboratorRole
+//   - now time.Time
+func (_e *MockService_Expecter) Invite(ctx interface{}, source interface{}, userID interface{}, invitedBy interface{}, role interface{}, now interface{}) *MockService_Invite_Call {
+	return &MockService_Invite_Call{Call: _e.mock.On("Invite", ctx, source, userID, invitedBy, role, now)}
+}
+
+func (_c *MockService_Invite_Call) Run(run func(ctx context.Context, source uuid.UUID, userID uuid.UUID, invitedBy uuid.UUID, role models.ImproveRequestCollaboratorRole, now time.Time)) *MockService_Invite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(models.ImproveRequestCollaboratorRole), args[5].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Invite_Call) Return(_a0 *models.ImproveRequestCollaborator, _a1 error) *MockService_Invite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Invite_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, models.ImproveRequestCollaboratorRole, time.Time) (*models.ImproveRequestCollaborator, error)) *MockService_Invite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, source
+func (_m *MockService) List(ctx context.Context, source uuid.UUID) ([]*models.ImproveRequestCollaborator, error) {
+	ret := _m.Called(ctx, source)
+
+	var r0 []*models.ImproveRequestCollaborator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*models.ImproveRequestCollaborator, error)); ok {
+		return rf(ctx, source)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*models.ImproveRequestCollaborator); ok {
+		r0 = rf(ctx, source)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ImproveRequestCollaborator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, source)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+func (_e *MockService_Expecter) List(ctx interface{}, source interface{}) *MockService_List_Call {
+	return &MockService_List_Call{Call: _e.mock.On("List", ctx, source)}
+}
+
+func (_c *MockService_List_Call) Run(run func(ctx context.Context, source uuid.UUID)) *MockService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_List_Call) Return(_a0 []*models.ImproveRequestCollaborator, _a1 error) *MockService_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_List_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*models.ImproveRequestCollaborator, error)) *MockService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPending provides a mock function with given fields: ctx, userID
+func (_m *MockService) ListPending(ctx context.Context, userID uuid.UUID) ([]*models.ImproveRequestCollaborator, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []*models.ImproveRequestCollaborator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*models.ImproveRequestCollaborator, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*models.ImproveRequestCollaborator); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ImproveRequestCollaborator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPending'
+type MockService_ListPending_Call struct {
+	*mock.Call
+}
+
+// ListPending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockService_Expecter) ListPending(ctx interface{}, userID interface{}) *MockService_ListPending_Call {
+	return &MockService_ListPending_Call{Call: _e.mock.On("ListPending", ctx, userID)}
+}
+
+func (_c *MockService_ListPending_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockService_ListPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_ListPending_Call) Return(_a0 []*models.ImproveRequestCollaborator, _a1 error) *MockService_ListPending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListPending_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*models.ImproveRequestCollaborator, error)) *MockService_ListPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Read provides a mock function with given fields: ctx, source, userID
+func (_m *MockService) Read(ctx context.Context, source uuid.UUID, userID uuid.UUID) (*models.ImproveRequestCollaborator, error) {
+	ret := _m.Called(ctx, source, userID)
+
+	var r0 *models.ImproveRequestCollaborator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.ImproveRequestCollaborator, error)); ok {
+		return rf(ctx, source, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.ImproveRequestCollaborator); ok {
+		r0 = rf(ctx, source, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestCollaborator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, source, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Read'
+type MockService_Read_Call struct {
+	*mock.Call
+}
+
+// Read is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockService_Expecter) Read(ctx interface{}, source interface{}, userID interface{}) *MockService_Read_Call {
+	return &MockService_Read_Call{Call: _e.mock.On("Read", ctx, source, userID)}
+}
+
+func (_c *MockService_Read_Call) Run(run func(ctx context.Context, source uuid.UUID, userID uuid.UUID)) *MockService_Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_Read_Call) Return(_a0 *models.ImproveRequestCollaborator, _a1 error) *MockService_Read_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Read_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*models.ImproveRequestCollaborator, error)) *MockService_Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Remove provides a mock function with given fields: ctx, source, userID
+func (_m *MockService) Remove(ctx context.Context, source uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, source, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, source, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_Remove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remove'
+type MockService_Remove_Call struct {
+	*mock.Call
+}
+
+// Remove is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockService_Expecter) Remove(ctx interface{}, source interface{}, userID interface{}) *MockService_Remove_Call {
+	return &MockService_Remove_Call{Call: _e.mock.On("Remove", ctx, source, userID)}
+}
+
+func (_c *MockService_Remove_Call) Run(run func(ctx context.Context, source uuid.UUID, userID uuid.UUID)) *MockService_Remove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_Remove_Call) Return(_a0 error) *MockService_Remove_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_Remove_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockService_Remove_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRole provides a mock function with given fields: ctx, source, userID, role
+func (_m *MockService) UpdateRole(ctx context.Context, source uuid.UUID, userID uuid.UUID, role models.ImproveRequestCollaboratorRole) (*models.ImproveRequestCollaborator, error) {
+	ret := _m.Called(ctx, source, userID, role)
+
+	var r0 *models.ImproveRequestCollaborator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ImproveRequestCollaboratorRole) (*models.ImproveRequestCollaborator, error)); ok {
+		return rf(ctx, source, userID, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ImproveRequestCollaboratorRole) *models.ImproveRequestCollaborator); ok {
+		r0 = rf(ctx, source, userID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestCollaborator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ImproveRequestCollaboratorRole) error); ok {
+		r1 = rf(ctx, source, userID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
+type MockService_UpdateRole_Call struct {
+	*mock.Call
+}
+
+// UpdateRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - userID uuid.UUID
+//   - role models.ImproveRequestCollaboratorRole
+func (_e *MockService_Expecter) UpdateRole(ctx interface{}, source interface{}, userID interface{}, role interface{}) *MockService_UpdateRole_Call {
+	return &MockService_UpdateRole_Call{Call: _e.mock.On("UpdateRole", ctx, source, userID, role)}
+}
+
+func (_c *MockService_UpdateRole_Call) Run(run func(ctx context.Context, source uuid.UUID, userID uuid.UUID, role models.ImproveRequestCollaboratorRole)) *MockService_UpdateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ImproveRequestCollaboratorRole))
+	})
+	return _c
+}
+
+func (_c *MockService_UpdateRole_Call) Return(_a0 *models.ImproveRequestCollaborator, _a1 error) *MockService_UpdateRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_UpdateRole_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ImproveRequestCollaboratorRole) (*models.ImproveRequestCollaborator, error)) *MockService_UpdateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockService(t mockConstructorTestingTNewMockService) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,140 @@
+package collaborator_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Service of the current layer. You can instantiate a new one with NewService.
+type Service interface {
+	// Read returns the collaboration of a user on a request, whether it was accepted or not.
+	Read(ctx context.Context, source, userID uuid.UUID) (*models.ImproveRequestCollaborator, error)
+	// List returns every collaborator of a request, including pending invitations, oldest first.
+	List(ctx context.Context, source uuid.UUID) ([]*models.ImproveRequestCollaborator, error)
+	// ListPending returns the invitations a user has not answered yet, most recent first.
+	ListPending(ctx context.Context, userID uuid.UUID) ([]*models.ImproveRequestCollaborator, error)
+	// Invite asks a user to co-author a request. The user gets no rights until the invitation is accepted.
+	Invite(ctx context.Context, source, userID, invitedBy uuid.UUID, role models.ImproveRequestCollaboratorRole, now time.Time) (*models.ImproveRequestCollaborator, error)
+	// Accept confirms a pending invitation.
+	Accept(ctx context.Context, source, userID uuid.UUID, now time.Time) (*models.ImproveRequestCollaborator, error)
+	// UpdateRole changes the role of an existing collaborator.
+	UpdateRole(ctx context.Context, source, userID uuid.UUID, role models.ImproveRequestCollaboratorRole) (*models.ImproveRequestCollaborator, error)
+	// Remove deletes a collaborator, or declines a pending invitation.
+	Remove(ctx context.Context, source, userID uuid.UUID) error
+}
+
+type serviceImpl struct {
+	repository collaborator_storage.Repository
+}
+
+// NewService returns a new implementation of Service.
+func NewService(repository collaborator_storage.Repository) Service {
+	return &serviceImpl{repository: repository}
+}
+
+func (service *serviceImpl) Read(ctx context.Context, source, userID uuid.UUID) (*models.ImproveRequestCollaborator, error) {
+	storageModel, err := service.repository.Read(ctx, source, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read collaborator: %w", err)
+	}
+
+	return service.storageToModel(storageModel), nil
+}
+
+func (service *serviceImpl) List(ctx context.Context, source uuid.UUID) ([]*models.ImproveRequestCollaborator, error) {
+	storageModels, err := service.repository.List(ctx, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collaborators: %w", err)
+	}
+
+	return service.storageToModels(storageModels), nil
+}
+
+func (service *serviceImpl) ListPending(ctx context.Context, userID uuid.UUID) ([]*models.ImproveRequestCollaborator, error) {
+	storageModels, err := service.repository.ListPending(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending invitations: %w", err)
+	}
+
+	return service.storageToModels(storageModels), nil
+}
+
+func (service *serviceImpl) Invite(ctx context.Context, source, userID, invitedBy uuid.UUID, role models.ImproveRequestCollaboratorRole, now time.Time) (*models.ImproveRequestCollaborator, error) {
+	if err := checkRole(role); err != nil {
+		return nil, err
+	}
+
+	storageModel, err := service.repository.Invite(ctx, source, userID, invitedBy, collaborator_storage.Role(role), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invite collaborator: %w", err)
+	}
+
+	return service.storageToModel(storageModel), nil
+}
+
+func (service *serviceImpl) Accept(ctx context.Context, source, userID uuid.UUID, now time.Time) (*models.ImproveRequestCollaborator, error) {
+	storageModel, err := service.repository.Accept(ctx, source, userID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to accept invitation: %w", err)
+	}
+
+	return service.storageToModel(storageModel), nil
+}
+
+func (service *serviceImpl) UpdateRole(ctx context.Context, source, userID uuid.UUID, role models.ImproveRequestCollaboratorRole) (*models.ImproveRequestCollaborator, error) {
+	if err := checkRole(role); err != nil {
+		return nil, err
+	}
+
+	storageModel, err := service.repository.UpdateRole(ctx, source, userID, collaborator_storage.Role(role))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update collaborator role: %w", err)
+	}
+
+	return service.storageToModel(storageModel), nil
+}
+
+func (service *serviceImpl) Remove(ctx context.Context, source, userID uuid.UUID) error {
+	if err := service.repository.Delete(ctx, source, userID); err != nil {
+		return fmt.Errorf("failed to remove collaborator: %w", err)
+	}
+
+	return nil
+}
+
+func checkRole(role models.ImproveRequestCollaboratorRole) error {
+	switch role {
+	case models.ImproveRequestCollaboratorRoleOwner, models.ImproveRequestCollaboratorRoleEditor:
+		return nil
+	default:
+		return validation.NewErrInvalidEntity("role", fmt.Sprintf("unknown role %q", role))
+	}
+}
+
+func (service *serviceImpl) storageToModels(storageModels []*collaborator_storage.Model) []*models.ImproveRequestCollaborator {
+	results := make([]*models.ImproveRequestCollaborator, len(storageModels))
+	for i, storageModel := range storageModels {
+		results[i] = service.storageToModel(storageModel)
+	}
+
+	return results
+}
+
+func (service *serviceImpl) storageToModel(source *collaborator_storage.Model) *models.ImproveRequestCollaborator {
+	if source == nil {
+		return nil
+	}
+
+	return &models.ImproveRequestCollaborator{
+		Source:     source.Source,
+		UserID:     source.UserID,
+		Role:       models.ImproveRequestCollaboratorRole(source.Role),
+		InvitedBy:  source.InvitedBy,
+		CreatedAt:  source.CreatedAt,
+		AcceptedAt: source.AcceptedAt,
+	}
+}
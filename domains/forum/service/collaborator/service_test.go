@@ -0,0 +1,483 @@
+package collaborator_service
+
+import (
+	"context"
+	"errors"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	fooErr   = errors.New("it broken")
+)
+
+func TestCollaboratorService_Read(t *testing.T) {
+	data := []struct {
+		name string
+
+		source uuid.UUID
+		userID uuid.UUID
+
+		readData *collaborator_storage.Model
+		readErr  error
+
+		expect    *models.ImproveRequestCollaborator
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1),
+			userID: test_utils.NumberUUID(100),
+			readData: &collaborator_storage.Model{
+				Source:     test_utils.NumberUUID(1),
+				UserID:     test_utils.NumberUUID(100),
+				Role:       collaborator_storage.RoleEditor,
+				InvitedBy:  test_utils.NumberUUID(10),
+				CreatedAt:  baseTime,
+				AcceptedAt: &baseTime,
+			},
+			expect: &models.ImproveRequestCollaborator{
+				Source:     test_utils.NumberUUID(1),
+				UserID:     test_utils.NumberUUID(100),
+				Role:       models.ImproveRequestCollaboratorRoleEditor,
+				InvitedBy:  test_utils.NumberUUID(10),
+				CreatedAt:  baseTime,
+				AcceptedAt: &baseTime,
+			},
+		},
+		{
+			name:      "Error/RepositoryFailure",
+			source:    test_utils.NumberUUID(1),
+			userID:    test_utils.NumberUUID(100),
+			readErr:   fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := collaborator_storage.NewMockRepository(st)
+
+			repository.
+				On("Read", context.TODO(), d.source, d.userID).
+				Return(d.readData, d.readErr)
+
+			service := NewService(repository)
+			res, err := service.Read(context.TODO(), d.source, d.userID)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestCollaboratorService_List(t *testing.T) {
+	data := []struct {
+		name string
+
+		source uuid.UUID
+
+		listData []*collaborator_storage.Model
+		listErr  error
+
+		expect    []*models.ImproveRequestCollaborator
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1),
+			listData: []*collaborator_storage.Model{
+				{
+					Source:     test_utils.NumberUUID(1),
+					UserID:     test_utils.NumberUUID(100),
+					Role:       collaborator_storage.RoleEditor,
+					InvitedBy:  test_utils.NumberUUID(10),
+					CreatedAt:  baseTime,
+					AcceptedAt: &baseTime,
+				},
+				{
+					Source:    test_utils.NumberUUID(1),
+					UserID:    test_utils.NumberUUID(101),
+					Role:      collaborator_storage.RoleOwner,
+					InvitedBy: test_utils.NumberUUID(10),
+					CreatedAt: baseTime,
+				},
+			},
+			expect: []*models.ImproveRequestCollaborator{
+				{
+					Source:     test_utils.NumberUUID(1),
+					UserID:     test_utils.NumberUUID(100),
+					Role:       models.ImproveRequestCollaboratorRoleEditor,
+					InvitedBy:  test_utils.NumberUUID(10),
+					CreatedAt:  baseTime,
+					AcceptedAt: &baseTime,
+				},
+				{
+					Source:    test_utils.NumberUUID(1),
+					UserID:    test_utils.NumberUUID(101),
+					Role:      models.ImproveRequestCollaboratorRoleOwner,
+					InvitedBy: test_utils.NumberUUID(10),
+					CreatedAt: baseTime,
+				},
+			},
+		},
+		{
+			name:     "Success/NoResults",
+			source:   test_utils.NumberUUID(1),
+			listData: []*collaborator_storage.Model{},
+			expect:   []*models.ImproveRequestCollaborator{},
+		},
+		{
+			name:      "Error/RepositoryFailure",
+			source:    test_utils.NumberUUID(1),
+			listErr:   fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := collaborator_storage.NewMockRepository(st)
+
+			repository.
+				On("List", context.TODO(), d.source).
+				Return(d.listData, d.listErr)
+
+			service := NewService(repository)
+			res, err := service.List(context.TODO(), d.source)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestCollaboratorService_ListPending(t *testing.T) {
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+
+		listData []*collaborator_storage.Model
+		listErr  error
+
+		expect    []*models.ImproveRequestCollaborator
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			userID: test_utils.NumberUUID(100),
+			listData: []*collaborator_storage.Model{
+				{
+					Source:    test_utils.NumberUUID(1),
+					UserID:    test_utils.NumberUUID(100),
+					Role:      collaborator_storage.RoleEditor,
+					InvitedBy: test_utils.NumberUUID(10),
+					CreatedAt: baseTime,
+				},
+			},
+			expect: []*models.ImproveRequestCollaborator{
+				{
+					Source:    test_utils.NumberUUID(1),
+					UserID:    test_utils.NumberUUID(100),
+					Role:      models.ImproveRequestCollaboratorRoleEditor,
+					InvitedBy: test_utils.NumberUUID(10),
+					CreatedAt: baseTime,
+				},
+			},
+		},
+		{
+			name:      "Error/RepositoryFailure",
+			userID:    test_utils.NumberUUID(100),
+			listErr:   fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := collaborator_storage.NewMockRepository(st)
+
+			repository.
+				On("ListPending", context.TODO(), d.userID).
+				Return(d.listData, d.listErr)
+
+			service := NewService(repository)
+			res, err := service.ListPending(context.TODO(), d.userID)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestCollaboratorService_Invite(t *testing.T) {
+	data := []struct {
+		name string
+
+		source    uuid.UUID
+		userID    uuid.UUID
+		invitedBy uuid.UUID
+		role      models.ImproveRequestCollaboratorRole
+		now       time.Time
+
+		shouldCallRepository bool
+		inviteData           *collaborator_storage.Model
+		inviteErr            error
+
+		expect    *models.ImproveRequestCollaborator
+		expectErr error
+	}{
+		{
+			name:                 "Success",
+			source:               test_utils.NumberUUID(1),
+			userID:               test_utils.NumberUUID(100),
+			invitedBy:            test_utils.NumberUUID(10),
+			role:                 models.ImproveRequestCollaboratorRoleOwner,
+			now:                  baseTime,
+			shouldCallRepository: true,
+			inviteData: &collaborator_storage.Model{
+				Source:    test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(100),
+				Role:      collaborator_storage.RoleOwner,
+				InvitedBy: test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+			},
+			expect: &models.ImproveRequestCollaborator{
+				Source:    test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(100),
+				Role:      models.ImproveRequestCollaboratorRoleOwner,
+				InvitedBy: test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+			},
+		},
+		{
+			name:      "Error/UnknownRole",
+			source:    test_utils.NumberUUID(1),
+			userID:    test_utils.NumberUUID(100),
+			invitedBy: test_utils.NumberUUID(10),
+			role:      "admin",
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			source:               test_utils.NumberUUID(1),
+			userID:               test_utils.NumberUUID(100),
+			invitedBy:            test_utils.NumberUUID(10),
+			role:                 models.ImproveRequestCollaboratorRoleEditor,
+			now:                  baseTime,
+			shouldCallRepository: true,
+			inviteErr:            fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := collaborator_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("Invite", context.TODO(), d.source, d.userID, d.invitedBy, collaborator_storage.Role(d.role), d.now).
+					Return(d.inviteData, d.inviteErr)
+			}
+
+			service := NewService(repository)
+			res, err := service.Invite(context.TODO(), d.source, d.userID, d.invitedBy, d.role, d.now)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestCollaboratorService_Accept(t *testing.T) {
+	data := []struct {
+		name string
+
+		source uuid.UUID
+		userID uuid.UUID
+		now    time.Time
+
+		acceptData *collaborator_storage.Model
+		acceptErr  error
+
+		expect    *models.ImproveRequestCollaborator
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1),
+			userID: test_utils.NumberUUID(100),
+			now:    baseTime,
+			acceptData: &collaborator_storage.Model{
+				Source:     test_utils.NumberUUID(1),
+				UserID:     test_utils.NumberUUID(100),
+				Role:       collaborator_storage.RoleEditor,
+				InvitedBy:  test_utils.NumberUUID(10),
+				CreatedAt:  baseTime,
+				AcceptedAt: &baseTime,
+			},
+			expect: &models.ImproveRequestCollaborator{
+				Source:     test_utils.NumberUUID(1),
+				UserID:     test_utils.NumberUUID(100),
+				Role:       models.ImproveRequestCollaboratorRoleEditor,
+				InvitedBy:  test_utils.NumberUUID(10),
+				CreatedAt:  baseTime,
+				AcceptedAt: &baseTime,
+			},
+		},
+		{
+			name:      "Error/RepositoryFailure",
+			source:    test_utils.NumberUUID(1),
+			userID:    test_utils.NumberUUID(100),
+			now:       baseTime,
+			acceptErr: fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := collaborator_storage.NewMockRepository(st)
+
+			repository.
+				On("Accept", context.TODO(), d.source, d.userID, d.now).
+				Return(d.acceptData, d.acceptErr)
+
+			service := NewService(repository)
+			res, err := service.Accept(context.TODO(), d.source, d.userID, d.now)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestCollaboratorService_UpdateRole(t *testing.T) {
+	data := []struct {
+		name string
+
+		source uuid.UUID
+		userID uuid.UUID
+		role   models.ImproveRequestCollaboratorRole
+
+		shouldCallRepository bool
+		updateData           *collaborator_storage.Model
+		updateErr            error
+
+		expect    *models.ImproveRequestCollaborator
+		expectErr error
+	}{
+		{
+			name:                 "Success",
+			source:               test_utils.NumberUUID(1),
+			userID:               test_utils.NumberUUID(100),
+			role:                 models.ImproveRequestCollaboratorRoleOwner,
+			shouldCallRepository: true,
+			updateData: &collaborator_storage.Model{
+				Source:     test_utils.NumberUUID(1),
+				UserID:     test_utils.NumberUUID(100),
+				Role:       collaborator_storage.RoleOwner,
+				InvitedBy:  test_utils.NumberUUID(10),
+				CreatedAt:  baseTime,
+				AcceptedAt: &baseTime,
+			},
+			expect: &models.ImproveRequestCollaborator{
+				Source:     test_utils.NumberUUID(1),
+				UserID:     test_utils.NumberUUID(100),
+				Role:       models.ImproveRequestCollaboratorRoleOwner,
+				InvitedBy:  test_utils.NumberUUID(10),
+				CreatedAt:  baseTime,
+				AcceptedAt: &baseTime,
+			},
+		},
+		{
+			name:      "Error/UnknownRole",
+			source:    test_utils.NumberUUID(1),
+			userID:    test_utils.NumberUUID(100),
+			role:      "admin",
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			source:               test_utils.NumberUUID(1),
+			userID:               test_utils.NumberUUID(100),
+			role:                 models.ImproveRequestCollaboratorRoleEditor,
+			shouldCallRepository: true,
+			updateErr:            fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := collaborator_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("UpdateRole", context.TODO(), d.source, d.userID, collaborator_storage.Role(d.role)).
+					Return(d.updateData, d.updateErr)
+			}
+
+			service := NewService(repository)
+			res, err := service.UpdateRole(context.TODO(), d.source, d.userID, d.role)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestCollaboratorService_Remove(t *testing.T) {
+	data := []struct {
+		name string
+
+		source uuid.UUID
+		userID uuid.UUID
+
+		deleteErr error
+
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1),
+			userID: test_utils.NumberUUID(100),
+		},
+		{
+			name:      "Error/RepositoryFailure",
+			source:    test_utils.NumberUUID(1),
+			userID:    test_utils.NumberUUID(100),
+			deleteErr: fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := collaborator_storage.NewMockRepository(st)
+
+			repository.
+				On("Delete", context.TODO(), d.source, d.userID).
+				Return(d.deleteErr)
+
+			service := NewService(repository)
+			test_utils.RequireError(st, d.expectErr, service.Remove(context.TODO(), d.source, d.userID))
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
@@ -0,0 +1,317 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package critique_service
+
+import (
+	context "context"
+
+	"github.com/a-novel/agora-backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// Rate provides a mock function with given fields: ctx, suggestionID, ratings
+func (_m *MockService) Rate(ctx context.Context, suggestionID uuid.UUID, ratings []*models.ImproveSuggestionRating) ([]*models.ImproveSuggestionRating, error) {
+	ret := _m.Called(ctx, suggestionID, ratings)
+
+	var r0 []*models.ImproveSuggestionRating
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []*models.ImproveSuggestionRating) ([]*models.ImproveSuggestionRating, error)); ok {
+		return rf(ctx, suggestionID, ratings)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []*models.ImproveSuggestionRating) []*models.ImproveSuggestionRating); ok {
+		r0 = rf(ctx, suggestionID, ratings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ImproveSuggestionRating)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []*models.ImproveSuggestionRating) error); ok {
+		r1 = rf(ctx, suggestionID, ratings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Rate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rate'
+type MockService_Rate_Call struct {
+	*mock.Call
+}
+
+// Rate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - suggestionID uuid.UUID
+//   - ratings []*models.ImproveSuggestionRating
+func (_e *MockService_Expecter) Rate(ctx interface{}, suggestionID interface{}, ratings interface{}) *MockService_Rate_Call {
+	return &MockService_Rate_Call{Call: _e.mock.On("Rate", ctx, suggestionID, ratings)}
+}
+
+func (_c *MockService_Rate_Call) Run(run func(ctx context.Context, suggestionID uuid.UUID, ratings []*models.ImproveSuggestionRating)) *MockService_Rate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]*models.ImproveSuggestionRating))
+	})
+	return _c
+}
+
+func (_c *MockService_Rate_Call) Return(_a0 []*models.ImproveSuggestionRating, _a1 error) *MockService_Rate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Rate_Call) RunAndReturn(run func(context.Context, uuid.UUID, []*models.ImproveSuggestionRating) ([]*models.ImproveSuggestionRating, error)) *MockService_Rate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadAspects provides a mock function with given fields: ctx, source
+func (_m *MockService) ReadAspects(ctx context.Context, source uuid.UUID) ([]models.CritiqueAspect, error) {
+	ret := _m.Called(ctx, source)
+
+	var r0 []models.CritiqueAspect
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.CritiqueAspect, error)); ok {
+		return rf(ctx, source)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.CritiqueAspect); ok {
+		r0 = rf(ctx, source)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CritiqueAspect)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, source)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ReadAspects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadAspects'
+type MockService_ReadAspects_Call struct {
+	*mock.Call
+}
+
+// ReadAspects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+func (_e *MockService_Expecter) ReadAspects(ctx interface{}, source interface{}) *MockService_ReadAspects_Call {
+	return &MockService_ReadAspects_Call{Call: _e.mock.On("ReadAspects", ctx, source)}
+}
+
+func (_c *MockService_ReadAspects_Call) Run(run func(ctx context.Context, source uuid.UUID)) *MockService_ReadAspects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_ReadAspects_Call) Return(_a0 []models.CritiqueAspect, _a1 error) *MockService_ReadAspects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ReadAspects_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.CritiqueAspect, error)) *MockService_ReadAspects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadRatings provides a mock function with given fields: ctx, suggestionID
+func (_m *MockService) ReadRatings(ctx context.Context, suggestionID uuid.UUID) ([]*models.ImproveSuggestionRating, error) {
+	ret := _m.Called(ctx, suggestionID)
+
+	var r0 []*models.ImproveSuggestionRating
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*models.ImproveSuggestionRating, error)); ok {
+		return rf(ctx, suggestionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*models.ImproveSuggestionRating); ok {
+		r0 = rf(ctx, suggestionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ImproveSuggestionRating)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, suggestionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ReadRatings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadRatings'
+type MockService_ReadRatings_Call struct {
+	*mock.Call
+}
+
+// ReadRatings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - suggestionID uuid.UUID
+func (_e *MockService_Expecter) ReadRatings(ctx interface{}, suggestionID interface{}) *MockService_ReadRatings_Call {
+	return &MockService_ReadRatings_Call{Call: _e.mock.On("ReadRatings", ctx, suggestionID)}
+}
+
+func (_c *MockService_ReadRatings_Call) Run(run func(ctx context.Context, suggestionID uuid.UUID)) *MockService_ReadRatings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_ReadRatings_Call) Return(_a0 []*models.ImproveSuggestionRating, _a1 error) *MockService_ReadRatings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ReadRatings_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*models.ImproveSuggestionRating, error)) *MockService_ReadRatings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Summarize provides a mock function with given fields: ctx, source
+func (_m *MockService) Summarize(ctx context.Context, source uuid.UUID) ([]*models.ImproveRequestAspectSummary, error) {
+	ret := _m.Called(ctx, source)
+
+	var r0 []*models.ImproveRequestAspectSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*models.ImproveRequestAspectSummary, error)); ok {
+		return rf(ctx, source)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*models.ImproveRequestAspectSummary); ok {
+		r0 = rf(ctx, source)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ImproveRequestAspectSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, source)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Summarize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Summarize'
+type MockService_Summarize_Call struct {
+	*mock.Call
+}
+
+// Summarize is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+func (_e *MockService_Expecter) Summarize(ctx interface{}, source interface{}) *MockService_Summarize_Call {
+	return &MockService_Summarize_Call{Call: _e.mock.On("Summarize", ctx, source)}
+}
+
+func (_c *MockService_Summarize_Call) Run(run func(ctx context.Context, source uuid.UUID)) *MockService_Summarize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_Summarize_Call) Return(_a0 []*models.ImproveRequestAspectSummary, _a1 error) *MockService_Summarize_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Summarize_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*models.ImproveRequestAspectSummary, error)) *MockService_Summarize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAspects provides a mock function with given fields: ctx, source, aspects
+func (_m *MockService) UpdateAspects(ctx context.Context, source uuid.UUID, aspects []models.CritiqueAspect) ([]models.CritiqueAspect, error) {
+	ret := _m.Called(ctx, source, aspects)
+
+	var r0 []models.CritiqueAspect
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []models.CritiqueAspect) ([]models.CritiqueAspect, error)); ok {
+		return rf(ctx, source, aspects)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []models.CritiqueAspect) []models.CritiqueAspect); ok {
+		r0 = rf(ctx, source, aspects)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CritiqueAspect)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []models.CritiqueAspect) error); ok {
+		r1 = rf(ctx, source, aspects)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_UpdateAspects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAspects'
+type MockService_UpdateAspects_Call struct {
+	*mock.Call
+}
+
+// UpdateAspects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - aspects []models.CritiqueAspect
+func (_e *MockService_Expecter) UpdateAspects(ctx interface{}, source interface{}, aspects interface{}) *MockService_UpdateAspects_Call {
+	return &MockService_UpdateAspects_Call{Call: _e.mock.On("UpdateAspects", ctx, source, aspects)}
+}
+
+func (_c *MockService_UpdateAspects_Call) Run(run func(ctx context.Context, source uuid.UUID, aspects []models.CritiqueAspect)) *MockService_UpdateAspects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]models.CritiqueAspect))
+	})
+	return _c
+}
+
+func (_c *MockService_UpdateAspects_Call) Return(_a0 []models.CritiqueAspect, _a1 error) *MockService_UpdateAspects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_UpdateAspects_Call) RunAndReturn(run func(context.Context, uuid.UUID, []models.CritiqueAspect) ([]models.CritiqueAspect, error)) *MockService_UpdateAspects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockService(t mockConstructorTestingTNewMockService) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
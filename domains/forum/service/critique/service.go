@@ -0,0 +1,169 @@
+package critique_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+)
+
+const (
+	MinRating     = 1
+	MaxRating     = 5
+	MaxNoteLength = 1024
+)
+
+var aspectValues = []models.CritiqueAspect{
+	models.CritiqueAspectPacing,
+	models.CritiqueAspectDialogue,
+	models.CritiqueAspectPOV,
+	models.CritiqueAspectGrammar,
+	models.CritiqueAspectTone,
+}
+
+// Service of the current layer. You can instantiate a new one with NewService.
+type Service interface {
+	// ReadAspects returns the aspects requested on an improvement request, based on the ID of its first revision.
+	ReadAspects(ctx context.Context, source uuid.UUID) ([]models.CritiqueAspect, error)
+	// UpdateAspects replaces the aspects requested on an improvement request. Each aspect can only be given once.
+	UpdateAspects(ctx context.Context, source uuid.UUID, aspects []models.CritiqueAspect) ([]models.CritiqueAspect, error)
+	// ReadRatings returns the ratings attached to a suggestion.
+	ReadRatings(ctx context.Context, suggestionID uuid.UUID) ([]*models.ImproveSuggestionRating, error)
+	// Rate replaces the ratings attached to a suggestion. Each aspect can only be rated once.
+	Rate(ctx context.Context, suggestionID uuid.UUID, ratings []*models.ImproveSuggestionRating) ([]*models.ImproveSuggestionRating, error)
+	// Summarize aggregates the ratings of the suggestions of an improvement request, for every aspect currently
+	// requested.
+	Summarize(ctx context.Context, source uuid.UUID) ([]*models.ImproveRequestAspectSummary, error)
+}
+
+type serviceImpl struct {
+	repository critique_storage.Repository
+}
+
+// NewService returns a new implementation of Service.
+func NewService(repository critique_storage.Repository) Service {
+	return &serviceImpl{repository: repository}
+}
+
+// CheckAspect returns validation.ErrInvalidEntity if the aspect is unknown.
+func CheckAspect(aspect models.CritiqueAspect) error {
+	return validation.CheckRestricted("aspect", aspect, aspectValues...)
+}
+
+func (service *serviceImpl) ReadAspects(ctx context.Context, source uuid.UUID) ([]models.CritiqueAspect, error) {
+	storageAspects, err := service.repository.ReadAspects(ctx, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read aspects: %w", err)
+	}
+
+	return service.aspectsStorageToModel(storageAspects), nil
+}
+
+func (service *serviceImpl) UpdateAspects(ctx context.Context, source uuid.UUID, aspects []models.CritiqueAspect) ([]models.CritiqueAspect, error) {
+	seen := make(map[models.CritiqueAspect]bool, len(aspects))
+	storageAspects := make([]critique_storage.Aspect, len(aspects))
+	for i, aspect := range aspects {
+		if err := CheckAspect(aspect); err != nil {
+			return nil, err
+		}
+		if seen[aspect] {
+			return nil, validation.NewErrInvalidEntity("aspects", fmt.Sprintf("aspect %q is given more than once", aspect))
+		}
+
+		seen[aspect] = true
+		storageAspects[i] = critique_storage.Aspect(aspect)
+	}
+
+	storageAspects, err := service.repository.UpdateAspects(ctx, source, storageAspects)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update aspects: %w", err)
+	}
+
+	return service.aspectsStorageToModel(storageAspects), nil
+}
+
+func (service *serviceImpl) ReadRatings(ctx context.Context, suggestionID uuid.UUID) ([]*models.ImproveSuggestionRating, error) {
+	storageRatings, err := service.repository.ReadRatings(ctx, suggestionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ratings: %w", err)
+	}
+
+	return service.ratingsStorageToModel(storageRatings), nil
+}
+
+func (service *serviceImpl) Rate(ctx context.Context, suggestionID uuid.UUID, ratings []*models.ImproveSuggestionRating) ([]*models.ImproveSuggestionRating, error) {
+	seen := make(map[models.CritiqueAspect]bool, len(ratings))
+	storageRatings := make([]*critique_storage.Rating, len(ratings))
+	for i, rating := range ratings {
+		if rating == nil {
+			return nil, validation.NewErrNil("rating")
+		}
+		if err := CheckAspect(rating.Aspect); err != nil {
+			return nil, err
+		}
+		if seen[rating.Aspect] {
+			return nil, validation.NewErrInvalidEntity("ratings", fmt.Sprintf("aspect %q is rated more than once", rating.Aspect))
+		}
+		if err := validation.CheckMinMax("rating", rating.Rating, MinRating, MaxRating); err != nil {
+			return nil, err
+		}
+		if err := validation.CheckMinMax("note", rating.Note, -1, MaxNoteLength); err != nil {
+			return nil, err
+		}
+
+		seen[rating.Aspect] = true
+		storageRatings[i] = &critique_storage.Rating{
+			Aspect: critique_storage.Aspect(rating.Aspect),
+			Rating: rating.Rating,
+			Note:   rating.Note,
+		}
+	}
+
+	storageRatings, err := service.repository.Rate(ctx, suggestionID, storageRatings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rate suggestion: %w", err)
+	}
+
+	return service.ratingsStorageToModel(storageRatings), nil
+}
+
+func (service *serviceImpl) Summarize(ctx context.Context, source uuid.UUID) ([]*models.ImproveRequestAspectSummary, error) {
+	storageSummaries, err := service.repository.Summarize(ctx, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize ratings: %w", err)
+	}
+
+	summaries := make([]*models.ImproveRequestAspectSummary, len(storageSummaries))
+	for i, summary := range storageSummaries {
+		summaries[i] = &models.ImproveRequestAspectSummary{
+			Aspect:  models.CritiqueAspect(summary.Aspect),
+			Average: summary.Average,
+			Count:   summary.Count,
+		}
+	}
+
+	return summaries, nil
+}
+
+func (service *serviceImpl) aspectsStorageToModel(source []critique_storage.Aspect) []models.CritiqueAspect {
+	aspects := make([]models.CritiqueAspect, len(source))
+	for i, aspect := range source {
+		aspects[i] = models.CritiqueAspect(aspect)
+	}
+
+	return aspects
+}
+
+func (service *serviceImpl) ratingsStorageToModel(source []*critique_storage.Rating) []*models.ImproveSuggestionRating {
+	ratings := make([]*models.ImproveSuggestionRating, len(source))
+	for i, rating := range source {
+		ratings[i] = &models.ImproveSuggestionRating{
+			Aspect: models.CritiqueAspect(rating.Aspect),
+			Rating: rating.Rating,
+			Note:   rating.Note,
+		}
+	}
+
+	return ratings
+}
@@ -0,0 +1,347 @@
+package critique_service
+
+import (
+	"context"
+	"errors"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+var fooErr = errors.New("it broken")
+
+func TestCritiqueService_ReadAspects(t *testing.T) {
+	data := []struct {
+		name string
+
+		source uuid.UUID
+
+		readData []critique_storage.Aspect
+		readErr  error
+
+		expect    []models.CritiqueAspect
+		expectErr error
+	}{
+		{
+			name:     "Success",
+			source:   test_utils.NumberUUID(1),
+			readData: []critique_storage.Aspect{critique_storage.AspectPacing, critique_storage.AspectTone},
+			expect:   []models.CritiqueAspect{models.CritiqueAspectPacing, models.CritiqueAspectTone},
+		},
+		{
+			name:      "Error/RepositoryFailure",
+			source:    test_utils.NumberUUID(1),
+			readErr:   fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := critique_storage.NewMockRepository(st)
+
+			repository.
+				On("ReadAspects", context.TODO(), d.source).
+				Return(d.readData, d.readErr)
+
+			service := NewService(repository)
+			res, err := service.ReadAspects(context.TODO(), d.source)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			repository.AssertExpectations(st)
+		})
+	}
+}
+
+func TestCritiqueService_UpdateAspects(t *testing.T) {
+	data := []struct {
+		name string
+
+		source  uuid.UUID
+		aspects []models.CritiqueAspect
+
+		shouldCallRepository     bool
+		shouldCallRepositoryWith []critique_storage.Aspect
+		updateData               []critique_storage.Aspect
+		updateErr                error
+
+		expect    []models.CritiqueAspect
+		expectErr error
+	}{
+		{
+			name:                     "Success",
+			source:                   test_utils.NumberUUID(1),
+			aspects:                  []models.CritiqueAspect{models.CritiqueAspectTone, models.CritiqueAspectPOV},
+			shouldCallRepository:     true,
+			shouldCallRepositoryWith: []critique_storage.Aspect{critique_storage.AspectTone, critique_storage.AspectPOV},
+			updateData:               []critique_storage.Aspect{critique_storage.AspectPOV, critique_storage.AspectTone},
+			expect:                   []models.CritiqueAspect{models.CritiqueAspectPOV, models.CritiqueAspectTone},
+		},
+		{
+			name:                     "Success/Clear",
+			source:                   test_utils.NumberUUID(1),
+			shouldCallRepository:     true,
+			shouldCallRepositoryWith: []critique_storage.Aspect{},
+			updateData:               []critique_storage.Aspect{},
+			expect:                   []models.CritiqueAspect{},
+		},
+		{
+			name:      "Error/UnknownAspect",
+			source:    test_utils.NumberUUID(1),
+			aspects:   []models.CritiqueAspect{models.CritiqueAspectTone, "plot"},
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:      "Error/DuplicateAspect",
+			source:    test_utils.NumberUUID(1),
+			aspects:   []models.CritiqueAspect{models.CritiqueAspectTone, models.CritiqueAspectTone},
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:                     "Error/RepositoryFailure",
+			source:                   test_utils.NumberUUID(1),
+			aspects:                  []models.CritiqueAspect{models.CritiqueAspectTone},
+			shouldCallRepository:     true,
+			shouldCallRepositoryWith: []critique_storage.Aspect{critique_storage.AspectTone},
+			updateErr:                fooErr,
+			expectErr:                fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := critique_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("UpdateAspects", context.TODO(), d.source, d.shouldCallRepositoryWith).
+					Return(d.updateData, d.updateErr)
+			}
+
+			service := NewService(repository)
+			res, err := service.UpdateAspects(context.TODO(), d.source, d.aspects)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			repository.AssertExpectations(st)
+		})
+	}
+}
+
+func TestCritiqueService_ReadRatings(t *testing.T) {
+	data := []struct {
+		name string
+
+		suggestionID uuid.UUID
+
+		readData []*critique_storage.Rating
+		readErr  error
+
+		expect    []*models.ImproveSuggestionRating
+		expectErr error
+	}{
+		{
+			name:         "Success",
+			suggestionID: test_utils.NumberUUID(1),
+			readData: []*critique_storage.Rating{
+				{SuggestionID: test_utils.NumberUUID(1), Aspect: critique_storage.AspectPacing, Rating: 2, Note: "Too slow."},
+			},
+			expect: []*models.ImproveSuggestionRating{
+				{Aspect: models.CritiqueAspectPacing, Rating: 2, Note: "Too slow."},
+			},
+		},
+		{
+			name:         "Error/RepositoryFailure",
+			suggestionID: test_utils.NumberUUID(1),
+			readErr:      fooErr,
+			expectErr:    fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := critique_storage.NewMockRepository(st)
+
+			repository.
+				On("ReadRatings", context.TODO(), d.suggestionID).
+				Return(d.readData, d.readErr)
+
+			service := NewService(repository)
+			res, err := service.ReadRatings(context.TODO(), d.suggestionID)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			repository.AssertExpectations(st)
+		})
+	}
+}
+
+func TestCritiqueService_Rate(t *testing.T) {
+	data := []struct {
+		name string
+
+		suggestionID uuid.UUID
+		ratings      []*models.ImproveSuggestionRating
+
+		shouldCallRepository     bool
+		shouldCallRepositoryWith []*critique_storage.Rating
+		rateData                 []*critique_storage.Rating
+		rateErr                  error
+
+		expect    []*models.ImproveSuggestionRating
+		expectErr error
+	}{
+		{
+			name:         "Success",
+			suggestionID: test_utils.NumberUUID(1),
+			ratings: []*models.ImproveSuggestionRating{
+				{Aspect: models.CritiqueAspectTone, Rating: 4},
+				{Aspect: models.CritiqueAspectPacing, Rating: 2, Note: "Too slow."},
+			},
+			shouldCallRepository: true,
+			shouldCallRepositoryWith: []*critique_storage.Rating{
+				{Aspect: critique_storage.AspectTone, Rating: 4},
+				{Aspect: critique_storage.AspectPacing, Rating: 2, Note: "Too slow."},
+			},
+			rateData: []*critique_storage.Rating{
+				{SuggestionID: test_utils.NumberUUID(1), Aspect: critique_storage.AspectPacing, Rating: 2, Note: "Too slow."},
+				{SuggestionID: test_utils.NumberUUID(1), Aspect: critique_storage.AspectTone, Rating: 4},
+			},
+			expect: []*models.ImproveSuggestionRating{
+				{Aspect: models.CritiqueAspectPacing, Rating: 2, Note: "Too slow."},
+				{Aspect: models.CritiqueAspectTone, Rating: 4},
+			},
+		},
+		{
+			name:         "Error/NilRating",
+			suggestionID: test_utils.NumberUUID(1),
+			ratings:      []*models.ImproveSuggestionRating{nil},
+			expectErr:    validation.ErrNil,
+		},
+		{
+			name:         "Error/UnknownAspect",
+			suggestionID: test_utils.NumberUUID(1),
+			ratings: []*models.ImproveSuggestionRating{
+				{Aspect: "plot", Rating: 4},
+			},
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:         "Error/DuplicateAspect",
+			suggestionID: test_utils.NumberUUID(1),
+			ratings: []*models.ImproveSuggestionRating{
+				{Aspect: models.CritiqueAspectTone, Rating: 4},
+				{Aspect: models.CritiqueAspectTone, Rating: 2},
+			},
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:         "Error/RatingTooLow",
+			suggestionID: test_utils.NumberUUID(1),
+			ratings: []*models.ImproveSuggestionRating{
+				{Aspect: models.CritiqueAspectTone, Rating: MinRating - 1},
+			},
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:         "Error/RatingTooHigh",
+			suggestionID: test_utils.NumberUUID(1),
+			ratings: []*models.ImproveSuggestionRating{
+				{Aspect: models.CritiqueAspectTone, Rating: MaxRating + 1},
+			},
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:         "Error/NoteTooLong",
+			suggestionID: test_utils.NumberUUID(1),
+			ratings: []*models.ImproveSuggestionRating{
+				{Aspect: models.CritiqueAspectTone, Rating: 3, Note: strings.Repeat("a", MaxNoteLength+1)},
+			},
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:         "Error/RepositoryFailure",
+			suggestionID: test_utils.NumberUUID(1),
+			ratings: []*models.ImproveSuggestionRating{
+				{Aspect: models.CritiqueAspectTone, Rating: 4},
+			},
+			shouldCallRepository: true,
+			shouldCallRepositoryWith: []*critique_storage.Rating{
+				{Aspect: critique_storage.AspectTone, Rating: 4},
+			},
+			rateErr:   fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := critique_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("Rate", context.TODO(), d.suggestionID, d.shouldCallRepositoryWith).
+					Return(d.rateData, d.rateErr)
+			}
+
+			service := NewService(repository)
+			res, err := service.Rate(context.TODO(), d.suggestionID, d.ratings)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			repository.AssertExpectations(st)
+		})
+	}
+}
+
+func TestCritiqueService_Summarize(t *testing.T) {
+	data := []struct {
+		name string
+
+		source uuid.UUID
+
+		summarizeData []*critique_storage.Summary
+		summarizeErr  error
+
+		expect    []*models.ImproveRequestAspectSummary
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1),
+			summarizeData: []*critique_storage.Summary{
+				{Aspect: critique_storage.AspectPacing, Average: 2.5, Count: 2},
+			},
+			expect: []*models.ImproveRequestAspectSummary{
+				{Aspect: models.CritiqueAspectPacing, Average: 2.5, Count: 2},
+			},
+		},
+		{
+			name:         "Error/RepositoryFailure",
+			source:       test_utils.NumberUUID(1),
+			summarizeErr: fooErr,
+			expectErr:    fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := critique_storage.NewMockRepository(st)
+
+			repository.
+				On("Summarize", context.TODO(), d.source).
+				Return(d.summarizeData, d.summarizeErr)
+
+			service := NewService(repository)
+			res, err := service.Summarize(context.TODO(), d.source)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			repository.AssertExpectations(st)
+		})
+	}
+}
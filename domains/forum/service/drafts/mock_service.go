@@ -0,0 +1,477 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package drafts_service
+
+import (
+	context "context"
+
+	"github.com/a-novel/agora-backend/domains/forum/storage/drafts"
+	mock "github.com/stretchr/testify/mock"
+
+	"github.com/a-novel/agora-backend/models"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, data, userID, id, now
+func (_m *MockService) Create(ctx context.Context, data *models.ImproveRequestDraftUpsert, userID uuid.UUID, id uuid.UUID, now time.Time) (*models.ImproveRequestDraft, error) {
+	ret := _m.Called(ctx, data, userID, id, now)
+
+	var r0 *models.ImproveRequestDraft
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ImproveRequestDraftUpsert, uuid.UUID, uuid.UUID, time.Time) (*models.ImproveRequestDraft, error)); ok {
+		return rf(ctx, data, userID, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ImproveRequestDraftUpsert, uuid.UUID, uuid.UUID, time.Time) *models.ImproveRequestDraft); ok {
+		r0 = rf(ctx, data, userID, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestDraft)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ImproveRequestDraftUpsert, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, data, userID, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *models.ImproveRequestDraftUpsert
+//   - userID uuid.UUID
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *MockService_Expecter) Create(ctx interface{}, data interface{}, userID interface{}, id interface{}, now interface{}) *MockService_Create_Call {
+	return &MockService_Create_Call{Call: _e.mock.On("Create", ctx, data, userID, id, now)}
+}
+
+func (_c *MockService_Create_Call) Run(run func(ctx context.Context, data *models.ImproveRequestDraftUpsert, userID uuid.UUID, id uuid.UUID, now time.Time)) *MockService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.ImproveRequestDraftUpsert), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Create_Call) Return(_a0 *models.ImproveRequestDraft, _a1 error) *MockService_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Create_Call) RunAndReturn(run func(context.Context, *models.ImproveRequestDraftUpsert, uuid.UUID, uuid.UUID, time.Time) (*models.ImproveRequestDraft, error)) *MockService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockService) Delete(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockService_Expecter) Delete(ctx interface{}, id interface{}) *MockService_Delete_Call {
+	return &MockService_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockService_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_Delete_Call) Return(_a0 error) *MockService_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, userID, limit, offset
+func (_m *MockService) List(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]*models.ImproveRequestDraft, int64, error) {
+	ret := _m.Called(ctx, userID, limit, offset)
+
+	var r0 []*models.ImproveRequestDraft
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]*models.ImproveRequestDraft, int64, error)); ok {
+		return rf(ctx, userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []*models.ImproveRequestDraft); ok {
+		r0 = rf(ctx, userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ImproveRequestDraft)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) int64); ok {
+		r1 = rf(ctx, userID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r2 = rf(ctx, userID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - limit int
+//   - offset int
+func (_e *MockService_Expecter) List(ctx interface{}, userID interface{}, limit interface{}, offset interface{}) *MockService_List_Call {
+	return &MockService_List_Call{Call: _e.mock.On("List", ctx, userID, limit, offset)}
+}
+
+func (_c *MockService_List_Call) Run(run func(ctx context.Context, userID uuid.UUID, limit int, offset int)) *MockService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockService_List_Call) Return(_a0 []*models.ImproveRequestDraft, _a1 int64, _a2 error) *MockService_List_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockService_List_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, int) ([]*models.ImproveRequestDraft, int64, error)) *MockService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListScheduled provides a mock function with given fields: ctx, now, limit
+func (_m *MockService) ListScheduled(ctx context.Context, now time.Time, limit int) ([]*models.ImproveRequestDraft, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	var r0 []*models.ImproveRequestDraft
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*models.ImproveRequestDraft, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*models.ImproveRequestDraft); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ImproveRequestDraft)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListScheduled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListScheduled'
+type MockService_ListScheduled_Call struct {
+	*mock.Call
+}
+
+// ListScheduled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int
+func (_e *MockService_Expecter) ListScheduled(ctx interface{}, now interface{}, limit interface{}) *MockService_ListScheduled_Call {
+	return &MockService_ListScheduled_Call{Call: _e.mock.On("ListScheduled", ctx, now, limit)}
+}
+
+func (_c *MockService_ListScheduled_Call) Run(run func(ctx context.Context, now time.Time, limit int)) *MockService_ListScheduled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockService_ListScheduled_Call) Return(_a0 []*models.ImproveRequestDraft, _a1 error) *MockService_ListScheduled_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListScheduled_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]*models.ImproveRequestDraft, error)) *MockService_ListScheduled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Read provides a mock function with given fields: ctx, id
+func (_m *MockService) Read(ctx context.Context, id uuid.UUID) (*models.ImproveRequestDraft, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *models.ImproveRequestDraft
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.ImproveRequestDraft, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.ImproveRequestDraft); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestDraft)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Read'
+type MockService_Read_Call struct {
+	*mock.Call
+}
+
+// Read is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockService_Expecter) Read(ctx interface{}, id interface{}) *MockService_Read_Call {
+	return &MockService_Read_Call{Call: _e.mock.On("Read", ctx, id)}
+}
+
+func (_c *MockService_Read_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockService_Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_Read_Call) Return(_a0 *models.ImproveRequestDraft, _a1 error) *MockService_Read_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Read_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.ImproveRequestDraft, error)) *MockService_Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StorageToModel provides a mock function with given fields: source
+func (_m *MockService) StorageToModel(source *drafts_storage.Model) *models.ImproveRequestDraft {
+	ret := _m.Called(source)
+
+	var r0 *models.ImproveRequestDraft
+	if rf, ok := ret.Get(0).(func(*drafts_storage.Model) *models.ImproveRequestDraft); ok {
+		r0 = rf(source)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestDraft)
+		}
+	}
+
+	return r0
+}
+
+// MockService_StorageToModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StorageToModel'
+type MockService_StorageToModel_Call struct {
+	*mock.Call
+}
+
+// StorageToModel is a helper method to define mock.On call
+//   - source *drafts_storage.Model
+func (_e *MockService_Expecter) StorageToModel(source interface{}) *MockService_StorageToModel_Call {
+	return &MockService_StorageToModel_Call{Call: _e.mock.On("StorageToModel", source)}
+}
+
+func (_c *MockService_StorageToModel_Call) Run(run func(source *drafts_storage.Model)) *MockService_StorageToModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*drafts_storage.Model))
+	})
+	return _c
+}
+
+func (_c *MockService_StorageToModel_Call) Return(_a0 *models.ImproveRequestDraft) *MockService_StorageToModel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_StorageToModel_Call) RunAndReturn(run func(*drafts_storage.Model) *models.ImproveRequestDraft) *MockService_StorageToModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unschedule provides a mock function with given fields: ctx, id, now
+func (_m *MockService) Unschedule(ctx context.Context, id uuid.UUID, now time.Time) (*models.ImproveRequestDraft, error) {
+	ret := _m.Called(ctx, id, now)
+
+	var r0 *models.ImproveRequestDraft
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) (*models.ImproveRequestDraft, error)); ok {
+		return rf(ctx, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) *models.ImproveRequestDraft); ok {
+		r0 = rf(ctx, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestDraft)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Unschedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unschedule'
+type MockService_Unschedule_Call struct {
+	*mock.Call
+}
+
+// Unschedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *MockService_Expecter) Unschedule(ctx interface{}, id interface{}, now interface{}) *MockService_Unschedule_Call {
+	return &MockService_Unschedule_Call{Call: _e.mock.On("Unschedule", ctx, id, now)}
+}
+
+func (_c *MockService_Unschedule_Call) Run(run func(ctx context.Context, id uuid.UUID, now time.Time)) *MockService_Unschedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Unschedule_Call) Return(_a0 *models.ImproveRequestDraft, _a1 error) *MockService_Unschedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Unschedule_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) (*models.ImproveRequestDraft, error)) *MockService_Unschedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, data, id, now
+func (_m *MockService) Update(ctx context.Context, data *models.ImproveRequestDraftUpsert, id uuid.UUID, now time.Time) (*models.ImproveRequestDraft, error) {
+	ret := _m.Called(ctx, data, id, now)
+
+	var r0 *models.ImproveRequestDraft
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ImproveRequestDraftUpsert, uuid.UUID, time.Time) (*models.ImproveRequestDraft, error)); ok {
+		return rf(ctx, data, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ImproveRequestDraftUpsert, uuid.UUID, time.Time) *models.ImproveRequestDraft); ok {
+		r0 = rf(ctx, data, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestDraft)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ImproveRequestDraftUpsert, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, data, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *models.ImproveRequestDraftUpsert
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *MockService_Expecter) Update(ctx interface{}, data interface{}, id interface{}, now interface{}) *MockService_Update_Call {
+	return &MockService_Update_Call{Call: _e.mock.On("Update", ctx, data, id, now)}
+}
+
+func (_c *MockService_Update_Call) Run(run func(ctx context.Context, data *models.ImproveRequestDraftUpsert, id uuid.UUID, now time.Time)) *MockService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.ImproveRequestDraftUpsert), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Update_Call) Return(_a0 *models.ImproveRequestDraft, _a1 error) *MockService_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Update_Call) RunAndReturn(run func(context.Context, *models.ImproveRequestDraftUpsert, uuid.UUID, time.Time) (*models.ImproveRequestDraft, error)) *MockService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockService(t mockConstructorTestingTNewMockService) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,215 @@
+package drafts_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	MaxListLimit      = 100
+	MaxScheduledLimit = 100
+)
+
+// Service of the current layer. You can instantiate a new one with NewService.
+type Service interface {
+	// Read reads a single draft, based on its ID.
+	Read(ctx context.Context, id uuid.UUID) (*models.ImproveRequestDraft, error)
+	// List returns the drafts of a user, most recently saved first.
+	// It also returns the total number of available results, to help with pagination.
+	List(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*models.ImproveRequestDraft, int64, error)
+
+	// Create creates a new draft. Title and content are only checked against the limits of an improvement request
+	// once a publication time is set.
+	Create(ctx context.Context, data *models.ImproveRequestDraftUpsert, userID, id uuid.UUID, now time.Time) (*models.ImproveRequestDraft, error)
+	// Update overwrites the content of a draft, with the same checks as Create.
+	Update(ctx context.Context, data *models.ImproveRequestDraftUpsert, id uuid.UUID, now time.Time) (*models.ImproveRequestDraft, error)
+	// Delete removes a draft.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListScheduled returns the drafts which publication time is over, oldest first.
+	ListScheduled(ctx context.Context, now time.Time, limit int) ([]*models.ImproveRequestDraft, error)
+	// Unschedule cancels the automatic publication of a draft, so its author can fix it.
+	Unschedule(ctx context.Context, id uuid.UUID, now time.Time) (*models.ImproveRequestDraft, error)
+
+	// StorageToModel converts a storage model to a service model.
+	StorageToModel(source *drafts_storage.Model) *models.ImproveRequestDraft
+}
+
+type serviceImpl struct {
+	repository drafts_storage.Repository
+}
+
+// NewService returns a new Service instance.
+// To use a mocked one, call NewMockService.
+func NewService(repository drafts_storage.Repository) Service {
+	return &serviceImpl{repository: repository}
+}
+
+func (service *serviceImpl) checkUpsert(data *models.ImproveRequestDraftUpsert, now time.Time) error {
+	if data == nil {
+		return validation.NewErrNil("data")
+	}
+	if err := validation.CheckMinMax("tags", data.Tags, -1, improve_request_service.MaxTags); err != nil {
+		return err
+	}
+
+	// A draft may be saved at any time, so it is not required to be complete. It should still fit in an
+	// improvement request.
+	if data.PublishAt == nil {
+		if err := validation.CheckMinMax("title", data.Title, -1, improve_request_service.MaxTitleLength); err != nil {
+			return err
+		}
+		if err := validation.CheckMinMax("content", data.Content, -1, improve_request_service.MaxContentLength); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	// Scheduled drafts are published without their author, so they must be ready.
+	if !data.PublishAt.After(now) {
+		return validation.NewErrInvalidEntity("publishAt", "publication time must be in the future")
+	}
+	if err := validation.CheckRequire("title", data.Title); err != nil {
+		return err
+	}
+	if err := validation.CheckRequire("content", data.Content); err != nil {
+		return err
+	}
+	if err := validation.CheckMinMax(
+		"title", data.Title, improve_request_service.MinTitleLength, improve_request_service.MaxTitleLength,
+	); err != nil {
+		return err
+	}
+	if err := validation.CheckMinMax(
+		"content", data.Content, improve_request_service.MinContentLength, improve_request_service.MaxContentLength,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (service *serviceImpl) upsertToCore(data *models.ImproveRequestDraftUpsert) *drafts_storage.Core {
+	tags := data.Tags
+	if tags == nil {
+		tags = []uuid.UUID{}
+	}
+
+	return &drafts_storage.Core{
+		Title:     data.Title,
+		Content:   data.Content,
+		Language:  data.Language,
+		Tags:      tags,
+		PublishAt: data.PublishAt,
+	}
+}
+
+func (service *serviceImpl) Read(ctx context.Context, id uuid.UUID) (*models.ImproveRequestDraft, error) {
+	storageModel, err := service.repository.Read(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read draft: %w", err)
+	}
+
+	return service.StorageToModel(storageModel), nil
+}
+
+func (service *serviceImpl) List(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*models.ImproveRequestDraft, int64, error) {
+	if err := validation.CheckMinMax("limit", limit, 1, MaxListLimit); err != nil {
+		return nil, 0, err
+	}
+
+	storageModels, total, err := service.repository.List(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list drafts: %w", err)
+	}
+
+	return service.storageToModels(storageModels), total, nil
+}
+
+func (service *serviceImpl) Create(ctx context.Context, data *models.ImproveRequestDraftUpsert, userID, id uuid.UUID, now time.Time) (*models.ImproveRequestDraft, error) {
+	if err := service.checkUpsert(data, now); err != nil {
+		return nil, err
+	}
+
+	storageModel, err := service.repository.Create(ctx, service.upsertToCore(data), userID, id, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create draft: %w", err)
+	}
+
+	return service.StorageToModel(storageModel), nil
+}
+
+func (service *serviceImpl) Update(ctx context.Context, data *models.ImproveRequestDraftUpsert, id uuid.UUID, now time.Time) (*models.ImproveRequestDraft, error) {
+	if err := service.checkUpsert(data, now); err != nil {
+		return nil, err
+	}
+
+	storageModel, err := service.repository.Update(ctx, service.upsertToCore(data), id, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update draft: %w", err)
+	}
+
+	return service.StorageToModel(storageModel), nil
+}
+
+func (service *serviceImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := service.repository.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete draft: %w", err)
+	}
+
+	return nil
+}
+
+func (service *serviceImpl) ListScheduled(ctx context.Context, now time.Time, limit int) ([]*models.ImproveRequestDraft, error) {
+	if err := validation.CheckMinMax("limit", limit, 1, MaxScheduledLimit); err != nil {
+		return nil, err
+	}
+
+	storageModels, err := service.repository.ListScheduled(ctx, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scheduled drafts: %w", err)
+	}
+
+	return service.storageToModels(storageModels), nil
+}
+
+func (service *serviceImpl) Unschedule(ctx context.Context, id uuid.UUID, now time.Time) (*models.ImproveRequestDraft, error) {
+	storageModel, err := service.repository.Unschedule(ctx, id, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unschedule draft: %w", err)
+	}
+
+	return service.StorageToModel(storageModel), nil
+}
+
+func (service *serviceImpl) storageToModels(storageModels []*drafts_storage.Model) []*models.ImproveRequestDraft {
+	results := make([]*models.ImproveRequestDraft, len(storageModels))
+	for i, storageModel := range storageModels {
+		results[i] = service.StorageToModel(storageModel)
+	}
+
+	return results
+}
+
+func (service *serviceImpl) StorageToModel(source *drafts_storage.Model) *models.ImproveRequestDraft {
+	if source == nil {
+		return nil
+	}
+
+	return &models.ImproveRequestDraft{
+		ID:        source.ID,
+		UserID:    source.UserID,
+		CreatedAt: source.CreatedAt,
+		UpdatedAt: source.UpdatedAt,
+		Title:     source.Title,
+		Content:   source.Content,
+		Language:  source.Language,
+		Tags:      source.Tags,
+		PublishAt: source.PublishAt,
+	}
+}
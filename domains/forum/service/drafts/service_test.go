@@ -0,0 +1,636 @@
+package drafts_service
+
+import (
+	"context"
+	"errors"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime   = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	updateTime = time.Date(2020, time.May, 4, 9, 0, 0, 0, time.UTC)
+	publishAt  = time.Date(2020, time.May, 5, 8, 0, 0, 0, time.UTC)
+	fooErr     = errors.New("it broken")
+)
+
+func TestDraftsService_Read(t *testing.T) {
+	data := []struct {
+		name string
+
+		id uuid.UUID
+
+		readData *drafts_storage.Model
+		readErr  error
+
+		expect    *models.ImproveRequestDraft
+		expectErr error
+	}{
+		{
+			name: "Success",
+			id:   test_utils.NumberUUID(1),
+			readData: &drafts_storage.Model{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				UpdatedAt: &updateTime,
+				Core: drafts_storage.Core{
+					Title:     "title",
+					Content:   "content",
+					Language:  "en",
+					Tags:      []uuid.UUID{test_utils.NumberUUID(100)},
+					PublishAt: &publishAt,
+				},
+			},
+			expect: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				UpdatedAt: &updateTime,
+				Title:     "title",
+				Content:   "content",
+				Language:  "en",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(100)},
+				PublishAt: &publishAt,
+			},
+		},
+		{
+			name:      "Error/RepositoryFailure",
+			id:        test_utils.NumberUUID(1),
+			readErr:   fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := drafts_storage.NewMockRepository(st)
+
+			repository.
+				On("Read", context.TODO(), d.id).
+				Return(d.readData, d.readErr)
+
+			service := NewService(repository)
+			res, err := service.Read(context.TODO(), d.id)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestDraftsService_List(t *testing.T) {
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+		limit  int
+		offset int
+
+		shouldCallRepository bool
+		listData             []*drafts_storage.Model
+		listTotal            int64
+		listErr              error
+
+		expect      []*models.ImproveRequestDraft
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name:                 "Success",
+			userID:               test_utils.NumberUUID(10),
+			limit:                10,
+			offset:               5,
+			shouldCallRepository: true,
+			listData: []*drafts_storage.Model{
+				{
+					ID:        test_utils.NumberUUID(1),
+					UserID:    test_utils.NumberUUID(10),
+					CreatedAt: baseTime,
+					Core: drafts_storage.Core{
+						Title: "title",
+						Tags:  []uuid.UUID{},
+					},
+				},
+			},
+			listTotal: 6,
+			expect: []*models.ImproveRequestDraft{
+				{
+					ID:        test_utils.NumberUUID(1),
+					UserID:    test_utils.NumberUUID(10),
+					CreatedAt: baseTime,
+					Title:     "title",
+					Tags:      []uuid.UUID{},
+				},
+			},
+			expectTotal: 6,
+		},
+		{
+			name:      "Error/LimitTooHigh",
+			userID:    test_utils.NumberUUID(10),
+			limit:     MaxListLimit + 1,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			userID:               test_utils.NumberUUID(10),
+			limit:                10,
+			shouldCallRepository: true,
+			listErr:              fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := drafts_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("List", context.TODO(), d.userID, d.limit, d.offset).
+					Return(d.listData, d.listTotal, d.listErr)
+			}
+
+			service := NewService(repository)
+			res, total, err := service.List(context.TODO(), d.userID, d.limit, d.offset)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+			require.Equal(st, d.expectTotal, total)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestDraftsService_Create(t *testing.T) {
+	data := []struct {
+		name string
+
+		data   *models.ImproveRequestDraftUpsert
+		userID uuid.UUID
+		id     uuid.UUID
+		now    time.Time
+
+		shouldCallRepository bool
+		createCore           *drafts_storage.Core
+		createData           *drafts_storage.Model
+		createErr            error
+
+		expect    *models.ImproveRequestDraft
+		expectErr error
+	}{
+		{
+			name: "Success",
+			data: &models.ImproveRequestDraftUpsert{
+				Title: "ti",
+			},
+			userID:               test_utils.NumberUUID(10),
+			id:                   test_utils.NumberUUID(1),
+			now:                  baseTime,
+			shouldCallRepository: true,
+			createCore: &drafts_storage.Core{
+				Title: "ti",
+				Tags:  []uuid.UUID{},
+			},
+			createData: &drafts_storage.Model{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Core: drafts_storage.Core{
+					Title: "ti",
+					Tags:  []uuid.UUID{},
+				},
+			},
+			expect: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "ti",
+				Tags:      []uuid.UUID{},
+			},
+		},
+		{
+			name: "Success/Scheduled",
+			data: &models.ImproveRequestDraftUpsert{
+				Title:     "title",
+				Content:   "content",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(100)},
+				PublishAt: &publishAt,
+			},
+			userID:               test_utils.NumberUUID(10),
+			id:                   test_utils.NumberUUID(1),
+			now:                  baseTime,
+			shouldCallRepository: true,
+			createCore: &drafts_storage.Core{
+				Title:     "title",
+				Content:   "content",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(100)},
+				PublishAt: &publishAt,
+			},
+			createData: &drafts_storage.Model{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Core: drafts_storage.Core{
+					Title:     "title",
+					Content:   "content",
+					Tags:      []uuid.UUID{test_utils.NumberUUID(100)},
+					PublishAt: &publishAt,
+				},
+			},
+			expect: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "title",
+				Content:   "content",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(100)},
+				PublishAt: &publishAt,
+			},
+		},
+		{
+			name:      "Error/NoData",
+			userID:    test_utils.NumberUUID(10),
+			id:        test_utils.NumberUUID(1),
+			now:       baseTime,
+			expectErr: validation.ErrNil,
+		},
+		{
+			name: "Error/ContentTooLong",
+			data: &models.ImproveRequestDraftUpsert{
+				Content: strings.Repeat("a", improve_request_service.MaxContentLength+1),
+			},
+			userID:    test_utils.NumberUUID(10),
+			id:        test_utils.NumberUUID(1),
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name: "Error/TooManyTags",
+			data: &models.ImproveRequestDraftUpsert{
+				Tags: make([]uuid.UUID, improve_request_service.MaxTags+1),
+			},
+			userID:    test_utils.NumberUUID(10),
+			id:        test_utils.NumberUUID(1),
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name: "Error/ScheduledInThePast",
+			data: &models.ImproveRequestDraftUpsert{
+				Title:     "title",
+				Content:   "content",
+				PublishAt: &baseTime,
+			},
+			userID:    test_utils.NumberUUID(10),
+			id:        test_utils.NumberUUID(1),
+			now:       updateTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name: "Error/ScheduledIncomplete",
+			data: &models.ImproveRequestDraftUpsert{
+				Title:     "ti",
+				Content:   "content",
+				PublishAt: &publishAt,
+			},
+			userID:    test_utils.NumberUUID(10),
+			id:        test_utils.NumberUUID(1),
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name: "Error/ScheduledWithoutContent",
+			data: &models.ImproveRequestDraftUpsert{
+				Title:     "title",
+				PublishAt: &publishAt,
+			},
+			userID:    test_utils.NumberUUID(10),
+			id:        test_utils.NumberUUID(1),
+			now:       baseTime,
+			expectErr: validation.ErrNil,
+		},
+		{
+			name: "Error/RepositoryFailure",
+			data: &models.ImproveRequestDraftUpsert{
+				Title: "ti",
+			},
+			userID:               test_utils.NumberUUID(10),
+			id:                   test_utils.NumberUUID(1),
+			now:                  baseTime,
+			shouldCallRepository: true,
+			createCore: &drafts_storage.Core{
+				Title: "ti",
+				Tags:  []uuid.UUID{},
+			},
+			createErr: fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := drafts_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("Create", context.TODO(), d.createCore, d.userID, d.id, d.now).
+					Return(d.createData, d.createErr)
+			}
+
+			service := NewService(repository)
+			res, err := service.Create(context.TODO(), d.data, d.userID, d.id, d.now)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestDraftsService_Update(t *testing.T) {
+	data := []struct {
+		name string
+
+		data *models.ImproveRequestDraftUpsert
+		id   uuid.UUID
+		now  time.Time
+
+		shouldCallRepository bool
+		updateCore           *drafts_storage.Core
+		updateData           *drafts_storage.Model
+		updateErr            error
+
+		expect    *models.ImproveRequestDraft
+		expectErr error
+	}{
+		{
+			name: "Success",
+			data: &models.ImproveRequestDraftUpsert{
+				Title:   "title",
+				Content: "content",
+			},
+			id:                   test_utils.NumberUUID(1),
+			now:                  updateTime,
+			shouldCallRepository: true,
+			updateCore: &drafts_storage.Core{
+				Title:   "title",
+				Content: "content",
+				Tags:    []uuid.UUID{},
+			},
+			updateData: &drafts_storage.Model{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				UpdatedAt: &updateTime,
+				Core: drafts_storage.Core{
+					Title:   "title",
+					Content: "content",
+					Tags:    []uuid.UUID{},
+				},
+			},
+			expect: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				UpdatedAt: &updateTime,
+				Title:     "title",
+				Content:   "content",
+				Tags:      []uuid.UUID{},
+			},
+		},
+		{
+			name: "Error/ScheduledIncomplete",
+			data: &models.ImproveRequestDraftUpsert{
+				Title:     "title",
+				Content:   "co",
+				PublishAt: &publishAt,
+			},
+			id:        test_utils.NumberUUID(1),
+			now:       updateTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name: "Error/RepositoryFailure",
+			data: &models.ImproveRequestDraftUpsert{
+				Title:   "title",
+				Content: "content",
+			},
+			id:                   test_utils.NumberUUID(1),
+			now:                  updateTime,
+			shouldCallRepository: true,
+			updateCore: &drafts_storage.Core{
+				Title:   "title",
+				Content: "content",
+				Tags:    []uuid.UUID{},
+			},
+			updateErr: fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := drafts_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("Update", context.TODO(), d.updateCore, d.id, d.now).
+					Return(d.updateData, d.updateErr)
+			}
+
+			service := NewService(repository)
+			res, err := service.Update(context.TODO(), d.data, d.id, d.now)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestDraftsService_Delete(t *testing.T) {
+	data := []struct {
+		name string
+
+		id uuid.UUID
+
+		deleteErr error
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+			id:   test_utils.NumberUUID(1),
+		},
+		{
+			name:      "Error/RepositoryFailure",
+			id:        test_utils.NumberUUID(1),
+			deleteErr: fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := drafts_storage.NewMockRepository(st)
+
+			repository.
+				On("Delete", context.TODO(), d.id).
+				Return(d.deleteErr)
+
+			service := NewService(repository)
+			test_utils.RequireError(st, d.expectErr, service.Delete(context.TODO(), d.id))
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestDraftsService_ListScheduled(t *testing.T) {
+	data := []struct {
+		name string
+
+		now   time.Time
+		limit int
+
+		shouldCallRepository bool
+		listData             []*drafts_storage.Model
+		listErr              error
+
+		expect    []*models.ImproveRequestDraft
+		expectErr error
+	}{
+		{
+			name:                 "Success",
+			now:                  publishAt,
+			limit:                10,
+			shouldCallRepository: true,
+			listData: []*drafts_storage.Model{
+				{
+					ID:        test_utils.NumberUUID(1),
+					UserID:    test_utils.NumberUUID(10),
+					CreatedAt: baseTime,
+					Core: drafts_storage.Core{
+						Title:     "title",
+						Content:   "content",
+						Tags:      []uuid.UUID{},
+						PublishAt: &publishAt,
+					},
+				},
+			},
+			expect: []*models.ImproveRequestDraft{
+				{
+					ID:        test_utils.NumberUUID(1),
+					UserID:    test_utils.NumberUUID(10),
+					CreatedAt: baseTime,
+					Title:     "title",
+					Content:   "content",
+					Tags:      []uuid.UUID{},
+					PublishAt: &publishAt,
+				},
+			},
+		},
+		{
+			name:      "Error/NoLimit",
+			now:       publishAt,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			now:                  publishAt,
+			limit:                10,
+			shouldCallRepository: true,
+			listErr:              fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := drafts_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("ListScheduled", context.TODO(), d.now, d.limit).
+					Return(d.listData, d.listErr)
+			}
+
+			service := NewService(repository)
+			res, err := service.ListScheduled(context.TODO(), d.now, d.limit)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestDraftsService_Unschedule(t *testing.T) {
+	data := []struct {
+		name string
+
+		id  uuid.UUID
+		now time.Time
+
+		unscheduleData *drafts_storage.Model
+		unscheduleErr  error
+
+		expect    *models.ImproveRequestDraft
+		expectErr error
+	}{
+		{
+			name: "Success",
+			id:   test_utils.NumberUUID(1),
+			now:  updateTime,
+			unscheduleData: &drafts_storage.Model{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				UpdatedAt: &updateTime,
+				Core: drafts_storage.Core{
+					Title: "title",
+					Tags:  []uuid.UUID{},
+				},
+			},
+			expect: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				UpdatedAt: &updateTime,
+				Title:     "title",
+				Tags:      []uuid.UUID{},
+			},
+		},
+		{
+			name:          "Error/RepositoryFailure",
+			id:            test_utils.NumberUUID(1),
+			now:           updateTime,
+			unscheduleErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := drafts_storage.NewMockRepository(st)
+
+			repository.
+				On("Unschedule", context.TODO(), d.id, d.now).
+				Return(d.unscheduleData, d.unscheduleErr)
+
+			service := NewService(repository)
+			res, err := service.Unschedule(context.TODO(), d.id, d.now)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
@@ -0,0 +1,262 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package duplicates_service
+
+import (
+	context "context"
+
+	"github.com/a-novel/agora-backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// Backfill provides a mock function with given fields: ctx, limit, now
+func (_m *MockService) Backfill(ctx context.Context, limit int, now time.Time) (int, error) {
+	ret := _m.Called(ctx, limit, now)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) (int, error)); ok {
+		return rf(ctx, limit, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) int); ok {
+		r0 = rf(ctx, limit, now)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time) error); ok {
+		r1 = rf(ctx, limit, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Backfill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Backfill'
+type MockService_Backfill_Call struct {
+	*mock.Call
+}
+
+// Backfill is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - now time.Time
+func (_e *MockService_Expecter) Backfill(ctx interface{}, limit interface{}, now interface{}) *MockService_Backfill_Call {
+	return &MockService_Backfill_Call{Call: _e.mock.On("Backfill", ctx, limit, now)}
+}
+
+func (_c *MockService_Backfill_Call) Run(run func(ctx context.Context, limit int, now time.Time)) *MockService_Backfill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Backfill_Call) Return(_a0 int, _a1 error) *MockService_Backfill_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Backfill_Call) RunAndReturn(run func(context.Context, int, time.Time) (int, error)) *MockService_Backfill_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Check provides a mock function with given fields: ctx, revision, now
+func (_m *MockService) Check(ctx context.Context, revision *models.ForumPostRevision, now time.Time) ([]*models.ForumDuplicateFlag, error) {
+	ret := _m.Called(ctx, revision, now)
+
+	var r0 []*models.ForumDuplicateFlag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ForumPostRevision, time.Time) ([]*models.ForumDuplicateFlag, error)); ok {
+		return rf(ctx, revision, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ForumPostRevision, time.Time) []*models.ForumDuplicateFlag); ok {
+		r0 = rf(ctx, revision, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ForumDuplicateFlag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ForumPostRevision, time.Time) error); ok {
+		r1 = rf(ctx, revision, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type MockService_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - ctx context.Context
+//   - revision *models.ForumPostRevision
+//   - now time.Time
+func (_e *MockService_Expecter) Check(ctx interface{}, revision interface{}, now interface{}) *MockService_Check_Call {
+	return &MockService_Check_Call{Call: _e.mock.On("Check", ctx, revision, now)}
+}
+
+func (_c *MockService_Check_Call) Run(run func(ctx context.Context, revision *models.ForumPostRevision, now time.Time)) *MockService_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.ForumPostRevision), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Check_Call) Return(_a0 []*models.ForumDuplicateFlag, _a1 error) *MockService_Check_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Check_Call) RunAndReturn(run func(context.Context, *models.ForumPostRevision, time.Time) ([]*models.ForumDuplicateFlag, error)) *MockService_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFlags provides a mock function with given fields: ctx, reviewed, limit, offset
+func (_m *MockService) ListFlags(ctx context.Context, reviewed bool, limit int, offset int) ([]*models.ForumDuplicateFlag, int64, error) {
+	ret := _m.Called(ctx, reviewed, limit, offset)
+
+	var r0 []*models.ForumDuplicateFlag
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool, int, int) ([]*models.ForumDuplicateFlag, int64, error)); ok {
+		return rf(ctx, reviewed, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool, int, int) []*models.ForumDuplicateFlag); ok {
+		r0 = rf(ctx, reviewed, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ForumDuplicateFlag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool, int, int) int64); ok {
+		r1 = rf(ctx, reviewed, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, bool, int, int) error); ok {
+		r2 = rf(ctx, reviewed, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockService_ListFlags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFlags'
+type MockService_ListFlags_Call struct {
+	*mock.Call
+}
+
+// ListFlags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reviewed bool
+//   - limit int
+//   - offset int
+func (_e *MockService_Expecter) ListFlags(ctx interface{}, reviewed interface{}, limit interface{}, offset interface{}) *MockService_ListFlags_Call {
+	return &MockService_ListFlags_Call{Call: _e.mock.On("ListFlags", ctx, reviewed, limit, offset)}
+}
+
+func (_c *MockService_ListFlags_Call) Run(run func(ctx context.Context, reviewed bool, limit int, offset int)) *MockService_ListFlags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockService_ListFlags_Call) Return(_a0 []*models.ForumDuplicateFlag, _a1 int64, _a2 error) *MockService_ListFlags_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockService_ListFlags_Call) RunAndReturn(run func(context.Context, bool, int, int) ([]*models.ForumDuplicateFlag, int64, error)) *MockService_ListFlags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Review provides a mock function with given fields: ctx, revisionID, originalRevisionID, reviewerID, now
+func (_m *MockService) Review(ctx context.Context, revisionID uuid.UUID, originalRevisionID uuid.UUID, reviewerID uuid.UUID, now time.Time) error {
+	ret := _m.Called(ctx, revisionID, originalRevisionID, reviewerID, now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, revisionID, originalRevisionID, reviewerID, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_Review_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Review'
+type MockService_Review_Call struct {
+	*mock.Call
+}
+
+// Review is a helper method to define mock.On call
+//   - ctx context.Context
+//   - revisionID uuid.UUID
+//   - originalRevisionID uuid.UUID
+//   - reviewerID uuid.UUID
+//   - now time.Time
+func (_e *MockService_Expecter) Review(ctx interface{}, revisionID interface{}, originalRevisionID interface{}, reviewerID interface{}, now interface{}) *MockService_Review_Call {
+	return &MockService_Review_Call{Call: _e.mock.On("Review", ctx, revisionID, originalRevisionID, reviewerID, now)}
+}
+
+func (_c *MockService_Review_Call) Run(run func(ctx context.Context, revisionID uuid.UUID, originalRevisionID uuid.UUID, reviewerID uuid.UUID, now time.Time)) *MockService_Review_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Review_Call) Return(_a0 error) *MockService_Review_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_Review_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) error) *MockService_Review_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockService(t mockConstructorTestingTNewMockService) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
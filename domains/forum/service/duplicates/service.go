@@ -0,0 +1,189 @@
+package duplicates_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-novel/agora-backend/framework/minhash"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"time"
+)
+
+var targetValues = []models.ForumPostTarget{
+	models.ForumPostTargetImproveRequest,
+	models.ForumPostTargetImproveSuggestion,
+}
+
+const (
+	// DuplicateThreshold is the minimum similarity for a revision to be flagged. Changing a couple of words in a
+	// short scene keeps the similarity around 0.7.
+	DuplicateThreshold = 0.5
+	// MaxCandidates is the maximum number of older revisions a new revision is compared with.
+	MaxCandidates = 100
+	MaxListLimit  = 100
+)
+
+// Service of the current layer. You can instantiate a new one with NewService.
+type Service interface {
+	// Check saves the fingerprint of a new revision, then flags it if it looks like an older revision from another
+	// user. Revisions of the same thread are never compared, since suggestions are expected to copy the request
+	// they improve. It returns the raised flags.
+	Check(ctx context.Context, revision *models.ForumPostRevision, now time.Time) ([]*models.ForumDuplicateFlag, error)
+	// Backfill runs Check on, at most, limit revisions that have no fingerprint yet, oldest first. It returns the
+	// number of processed revisions: once it is lower than limit, every revision has been fingerprinted.
+	Backfill(ctx context.Context, limit int, now time.Time) (int, error)
+
+	// ListFlags returns either the pending or the reviewed flags, most recent first.
+	// It also returns the total number of available results, to help with pagination.
+	ListFlags(ctx context.Context, reviewed bool, limit, offset int) ([]*models.ForumDuplicateFlag, int64, error)
+	// Review marks a flag as reviewed by the given moderator.
+	Review(ctx context.Context, revisionID, originalRevisionID, reviewerID uuid.UUID, now time.Time) error
+}
+
+type serviceImpl struct {
+	repository duplicates_storage.Repository
+}
+
+// NewService returns a new Service instance.
+// To use a mocked one, call NewMockService.
+func NewService(repository duplicates_storage.Repository) Service {
+	return &serviceImpl{repository: repository}
+}
+
+func (service *serviceImpl) Check(ctx context.Context, revision *models.ForumPostRevision, now time.Time) ([]*models.ForumDuplicateFlag, error) {
+	if revision == nil {
+		return nil, validation.NewErrNil("revision")
+	}
+	if err := validation.CheckRestricted("target", revision.Target, targetValues...); err != nil {
+		return nil, err
+	}
+
+	signature := minhash.Signature(revision.Content)
+	fingerprint := &duplicates_storage.Fingerprint{
+		RevisionID: revision.RevisionID,
+		PostID:     revision.PostID,
+		ThreadID:   revision.ThreadID,
+		UserID:     revision.UserID,
+		Target:     duplicates_storage.Target(revision.Target),
+		CreatedAt:  revision.CreatedAt,
+		Signature:  signature,
+		Bands:      minhash.Bands(signature),
+	}
+
+	if err := service.repository.Register(ctx, fingerprint); err != nil {
+		return nil, fmt.Errorf("failed to register fingerprint of revision %q: %w", revision.RevisionID, err)
+	}
+
+	flags := make([]*models.ForumDuplicateFlag, 0)
+	// Contents without words cannot be compared.
+	if len(signature) == 0 {
+		return flags, nil
+	}
+
+	candidates, err := service.repository.Candidates(
+		ctx, fingerprint.Bands, fingerprint.UserID, fingerprint.ThreadID, fingerprint.CreatedAt, MaxCandidates,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look for duplicates of revision %q: %w", revision.RevisionID, err)
+	}
+
+	storageFlags := make([]*duplicates_storage.Flag, 0)
+	for _, candidate := range candidates {
+		similarity := minhash.Similarity(signature, candidate.Signature)
+		if similarity < DuplicateThreshold {
+			continue
+		}
+
+		storageFlags = append(storageFlags, &duplicates_storage.Flag{
+			RevisionID:         fingerprint.RevisionID,
+			OriginalRevisionID: candidate.RevisionID,
+			CreatedAt:          now,
+			Similarity:         similarity,
+		})
+		flags = append(flags, &models.ForumDuplicateFlag{
+			CreatedAt:          now,
+			Similarity:         similarity,
+			RevisionID:         fingerprint.RevisionID,
+			PostID:             fingerprint.PostID,
+			ThreadID:           fingerprint.ThreadID,
+			UserID:             fingerprint.UserID,
+			Target:             revision.Target,
+			OriginalRevisionID: candidate.RevisionID,
+			OriginalPostID:     candidate.PostID,
+			OriginalThreadID:   candidate.ThreadID,
+			OriginalUserID:     candidate.UserID,
+			OriginalTarget:     models.ForumPostTarget(candidate.Target),
+		})
+	}
+
+	if err := service.repository.Flag(ctx, storageFlags); err != nil {
+		return nil, fmt.Errorf("failed to flag revision %q: %w", revision.RevisionID, err)
+	}
+
+	return flags, nil
+}
+
+func (service *serviceImpl) Backfill(ctx context.Context, limit int, now time.Time) (int, error) {
+	revisions, err := service.repository.Unfingerprinted(ctx, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list revisions without fingerprint: %w", err)
+	}
+
+	for _, revision := range revisions {
+		if _, err := service.Check(ctx, &models.ForumPostRevision{
+			RevisionID: revision.RevisionID,
+			PostID:     revision.PostID,
+			ThreadID:   revision.ThreadID,
+			UserID:     revision.UserID,
+			Target:     models.ForumPostTarget(revision.Target),
+			CreatedAt:  revision.CreatedAt,
+			Content:    revision.Content,
+		}, now); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(revisions), nil
+}
+
+func (service *serviceImpl) ListFlags(ctx context.Context, reviewed bool, limit, offset int) ([]*models.ForumDuplicateFlag, int64, error) {
+	if err := validation.CheckMinMax("limit", limit, 1, MaxListLimit); err != nil {
+		return nil, 0, err
+	}
+
+	storageModels, total, err := service.repository.ListFlags(ctx, reviewed, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list duplicate flags: %w", err)
+	}
+
+	flags := make([]*models.ForumDuplicateFlag, len(storageModels))
+	for i, storageModel := range storageModels {
+		flags[i] = &models.ForumDuplicateFlag{
+			CreatedAt:          storageModel.CreatedAt,
+			Similarity:         storageModel.Similarity,
+			RevisionID:         storageModel.RevisionID,
+			PostID:             storageModel.PostID,
+			ThreadID:           storageModel.ThreadID,
+			UserID:             storageModel.UserID,
+			Target:             models.ForumPostTarget(storageModel.Target),
+			OriginalRevisionID: storageModel.OriginalRevisionID,
+			OriginalPostID:     storageModel.OriginalPostID,
+			OriginalThreadID:   storageModel.OriginalThreadID,
+			OriginalUserID:     storageModel.OriginalUserID,
+			OriginalTarget:     models.ForumPostTarget(storageModel.OriginalTarget),
+			ReviewedAt:         storageModel.ReviewedAt,
+			ReviewedBy:         storageModel.ReviewedBy,
+		}
+	}
+
+	return flags, total, nil
+}
+
+func (service *serviceImpl) Review(ctx context.Context, revisionID, originalRevisionID, reviewerID uuid.UUID, now time.Time) error {
+	if _, err := service.repository.Review(ctx, revisionID, originalRevisionID, reviewerID, now); err != nil {
+		return fmt.Errorf("failed to review duplicate flag of revision %q: %w", revisionID, err)
+	}
+
+	return nil
+}
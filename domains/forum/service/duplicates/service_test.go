@@ -0,0 +1,506 @@
+package duplicates_service
+
+import (
+	"context"
+	"errors"
+	"github.com/a-novel/agora-backend/framework"
+	"github.com/a-novel/agora-backend/framework/minhash"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+const (
+	content = "Aussi, quand il rencontra Sombreval et sa fille traversant le cimetière, fut-il frappé d'un " +
+		"éblouissement qui ne venait pas seulement de la beauté nitescente de Calixte, marchant dans l'éclat " +
+		"solaire d'un jour d'été."
+	unrelatedContent = "Les trois Lois constituent les principes directeurs essentiels d'une grande partie des " +
+		"systèmes moraux du monde."
+)
+
+var (
+	baseTime   = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	updateTime = time.Date(2020, time.May, 4, 9, 0, 0, 0, time.UTC)
+	fooErr     = errors.New("it broken")
+
+	signature          = minhash.Signature(content)
+	unrelatedSignature = minhash.Signature(unrelatedContent)
+)
+
+func TestDuplicatesService_Check(t *testing.T) {
+	revision := &models.ForumPostRevision{
+		RevisionID: test_utils.NumberUUID(1001),
+		PostID:     test_utils.NumberUUID(1000),
+		ThreadID:   test_utils.NumberUUID(1000),
+		UserID:     test_utils.NumberUUID(100),
+		Target:     models.ForumPostTargetImproveRequest,
+		CreatedAt:  baseTime,
+		Content:    content,
+	}
+
+	fingerprint := &duplicates_storage.Fingerprint{
+		RevisionID: test_utils.NumberUUID(1001),
+		PostID:     test_utils.NumberUUID(1000),
+		ThreadID:   test_utils.NumberUUID(1000),
+		UserID:     test_utils.NumberUUID(100),
+		Target:     duplicates_storage.TargetImproveRequest,
+		CreatedAt:  baseTime,
+		Signature:  signature,
+		Bands:      minhash.Bands(signature),
+	}
+
+	candidates := []*duplicates_storage.Fingerprint{
+		{
+			RevisionID: test_utils.NumberUUID(2001),
+			PostID:     test_utils.NumberUUID(2000),
+			ThreadID:   test_utils.NumberUUID(3000),
+			UserID:     test_utils.NumberUUID(101),
+			Target:     duplicates_storage.TargetImproveSuggestion,
+			CreatedAt:  baseTime.Add(-time.Hour),
+			Signature:  signature,
+			Bands:      minhash.Bands(signature),
+		},
+		// Shares a band by chance, but the whole signature is different.
+		{
+			RevisionID: test_utils.NumberUUID(4000),
+			PostID:     test_utils.NumberUUID(4000),
+			ThreadID:   test_utils.NumberUUID(4000),
+			UserID:     test_utils.NumberUUID(102),
+			Target:     duplicates_storage.TargetImproveRequest,
+			CreatedAt:  baseTime.Add(-time.Hour),
+			Signature:  unrelatedSignature,
+			Bands:      minhash.Bands(unrelatedSignature),
+		},
+	}
+
+	data := []struct {
+		name string
+
+		revision *models.ForumPostRevision
+		now      time.Time
+
+		shouldCallRegister bool
+		expectFingerprint  *duplicates_storage.Fingerprint
+		registerErr        error
+
+		shouldCallCandidates bool
+		candidatesData       []*duplicates_storage.Fingerprint
+		candidatesErr        error
+
+		shouldCallFlag bool
+		expectFlags    []*duplicates_storage.Flag
+		flagErr        error
+
+		expect    []*models.ForumDuplicateFlag
+		expectErr error
+	}{
+		{
+			name:                 "Success",
+			revision:             revision,
+			now:                  updateTime,
+			shouldCallRegister:   true,
+			expectFingerprint:    fingerprint,
+			shouldCallCandidates: true,
+			candidatesData:       candidates,
+			shouldCallFlag:       true,
+			expectFlags: []*duplicates_storage.Flag{
+				{
+					RevisionID:         test_utils.NumberUUID(1001),
+					OriginalRevisionID: test_utils.NumberUUID(2001),
+					CreatedAt:          updateTime,
+					Similarity:         1,
+				},
+			},
+			expect: []*models.ForumDuplicateFlag{
+				{
+					CreatedAt:          updateTime,
+					Similarity:         1,
+					RevisionID:         test_utils.NumberUUID(1001),
+					PostID:             test_utils.NumberUUID(1000),
+					ThreadID:           test_utils.NumberUUID(1000),
+					UserID:             test_utils.NumberUUID(100),
+					Target:             models.ForumPostTargetImproveRequest,
+					OriginalRevisionID: test_utils.NumberUUID(2001),
+					OriginalPostID:     test_utils.NumberUUID(2000),
+					OriginalThreadID:   test_utils.NumberUUID(3000),
+					OriginalUserID:     test_utils.NumberUUID(101),
+					OriginalTarget:     models.ForumPostTargetImproveSuggestion,
+				},
+			},
+		},
+		{
+			name:                 "Success/NoDuplicates",
+			revision:             revision,
+			now:                  updateTime,
+			shouldCallRegister:   true,
+			expectFingerprint:    fingerprint,
+			shouldCallCandidates: true,
+			candidatesData:       candidates[1:],
+			shouldCallFlag:       true,
+			expectFlags:          []*duplicates_storage.Flag{},
+			expect:               []*models.ForumDuplicateFlag{},
+		},
+		{
+			name: "Success/NoWords",
+			revision: &models.ForumPostRevision{
+				RevisionID: test_utils.NumberUUID(1001),
+				PostID:     test_utils.NumberUUID(1000),
+				ThreadID:   test_utils.NumberUUID(1000),
+				UserID:     test_utils.NumberUUID(100),
+				Target:     models.ForumPostTargetImproveRequest,
+				CreatedAt:  baseTime,
+				Content:    "...",
+			},
+			now:                updateTime,
+			shouldCallRegister: true,
+			expectFingerprint: &duplicates_storage.Fingerprint{
+				RevisionID: test_utils.NumberUUID(1001),
+				PostID:     test_utils.NumberUUID(1000),
+				ThreadID:   test_utils.NumberUUID(1000),
+				UserID:     test_utils.NumberUUID(100),
+				Target:     duplicates_storage.TargetImproveRequest,
+				CreatedAt:  baseTime,
+				Bands:      []int64{},
+			},
+			expect: []*models.ForumDuplicateFlag{},
+		},
+		{
+			name:      "Error/NoRevision",
+			now:       updateTime,
+			expectErr: validation.ErrNil,
+		},
+		{
+			name: "Error/InvalidTarget",
+			revision: &models.ForumPostRevision{
+				RevisionID: test_utils.NumberUUID(1001),
+				Target:     "fake",
+				Content:    content,
+			},
+			now:       updateTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:               "Error/RegisterFailure",
+			revision:           revision,
+			now:                updateTime,
+			shouldCallRegister: true,
+			expectFingerprint:  fingerprint,
+			registerErr:        fooErr,
+			expectErr:          fooErr,
+		},
+		{
+			name:                 "Error/CandidatesFailure",
+			revision:             revision,
+			now:                  updateTime,
+			shouldCallRegister:   true,
+			expectFingerprint:    fingerprint,
+			shouldCallCandidates: true,
+			candidatesErr:        fooErr,
+			expectErr:            fooErr,
+		},
+		{
+			name:                 "Error/FlagFailure",
+			revision:             revision,
+			now:                  updateTime,
+			shouldCallRegister:   true,
+			expectFingerprint:    fingerprint,
+			shouldCallCandidates: true,
+			candidatesData:       candidates,
+			shouldCallFlag:       true,
+			expectFlags: []*duplicates_storage.Flag{
+				{
+					RevisionID:         test_utils.NumberUUID(1001),
+					OriginalRevisionID: test_utils.NumberUUID(2001),
+					CreatedAt:          updateTime,
+					Similarity:         1,
+				},
+			},
+			flagErr:   fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := duplicates_storage.NewMockRepository(st)
+
+			if d.shouldCallRegister {
+				repository.
+					On("Register", context.TODO(), d.expectFingerprint).
+					Return(d.registerErr)
+			}
+
+			if d.shouldCallCandidates {
+				repository.
+					On(
+						"Candidates", context.TODO(), d.expectFingerprint.Bands, d.expectFingerprint.UserID,
+						d.expectFingerprint.ThreadID, d.expectFingerprint.CreatedAt, MaxCandidates,
+					).
+					Return(d.candidatesData, d.candidatesErr)
+			}
+
+			if d.shouldCallFlag {
+				repository.
+					On("Flag", context.TODO(), d.expectFlags).
+					Return(d.flagErr)
+			}
+
+			service := NewService(repository)
+			res, err := service.Check(context.TODO(), d.revision, d.now)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			repository.AssertExpectations(st)
+		})
+	}
+}
+
+func TestDuplicatesService_Backfill(t *testing.T) {
+	data := []struct {
+		name string
+
+		limit int
+		now   time.Time
+
+		unfingerprintedData []*duplicates_storage.Revision
+		unfingerprintedErr  error
+
+		shouldCallRegister bool
+		registerErr        error
+
+		expect    int
+		expectErr error
+	}{
+		{
+			name:  "Success",
+			limit: 10,
+			now:   updateTime,
+			unfingerprintedData: []*duplicates_storage.Revision{
+				{
+					RevisionID: test_utils.NumberUUID(1001),
+					PostID:     test_utils.NumberUUID(1000),
+					ThreadID:   test_utils.NumberUUID(1000),
+					UserID:     test_utils.NumberUUID(100),
+					Target:     duplicates_storage.TargetImproveRequest,
+					CreatedAt:  baseTime,
+					Content:    "...",
+				},
+			},
+			shouldCallRegister: true,
+			expect:             1,
+		},
+		{
+			name:                "Success/NothingToDo",
+			limit:               10,
+			now:                 updateTime,
+			unfingerprintedData: []*duplicates_storage.Revision{},
+		},
+		{
+			name:               "Error/UnfingerprintedFailure",
+			limit:              10,
+			now:                updateTime,
+			unfingerprintedErr: fooErr,
+			expectErr:          fooErr,
+		},
+		{
+			name:  "Error/CheckFailure",
+			limit: 10,
+			now:   updateTime,
+			unfingerprintedData: []*duplicates_storage.Revision{
+				{
+					RevisionID: test_utils.NumberUUID(1001),
+					PostID:     test_utils.NumberUUID(1000),
+					ThreadID:   test_utils.NumberUUID(1000),
+					UserID:     test_utils.NumberUUID(100),
+					Target:     duplicates_storage.TargetImproveRequest,
+					CreatedAt:  baseTime,
+					Content:    "...",
+				},
+			},
+			shouldCallRegister: true,
+			registerErr:        fooErr,
+			expectErr:          fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := duplicates_storage.NewMockRepository(st)
+
+			repository.
+				On("Unfingerprinted", context.TODO(), d.limit).
+				Return(d.unfingerprintedData, d.unfingerprintedErr)
+
+			if d.shouldCallRegister {
+				repository.
+					On("Register", context.TODO(), &duplicates_storage.Fingerprint{
+						RevisionID: test_utils.NumberUUID(1001),
+						PostID:     test_utils.NumberUUID(1000),
+						ThreadID:   test_utils.NumberUUID(1000),
+						UserID:     test_utils.NumberUUID(100),
+						Target:     duplicates_storage.TargetImproveRequest,
+						CreatedAt:  baseTime,
+						Bands:      []int64{},
+					}).
+					Return(d.registerErr)
+			}
+
+			service := NewService(repository)
+			res, err := service.Backfill(context.TODO(), d.limit, d.now)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			repository.AssertExpectations(st)
+		})
+	}
+}
+
+func TestDuplicatesService_ListFlags(t *testing.T) {
+	data := []struct {
+		name string
+
+		reviewed bool
+		limit    int
+		offset   int
+
+		shouldCallRepository bool
+		repositoryData       []*duplicates_storage.FlagPreview
+		repositoryTotal      int64
+		repositoryErr        error
+
+		expect      []*models.ForumDuplicateFlag
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name:                 "Success",
+			reviewed:             true,
+			limit:                10,
+			offset:               20,
+			shouldCallRepository: true,
+			repositoryData: []*duplicates_storage.FlagPreview{
+				{
+					Flag: duplicates_storage.Flag{
+						RevisionID:         test_utils.NumberUUID(1001),
+						OriginalRevisionID: test_utils.NumberUUID(2001),
+						CreatedAt:          baseTime,
+						Similarity:         0.8,
+						ReviewedAt:         &updateTime,
+						ReviewedBy:         framework.ToPTR(test_utils.NumberUUID(200)),
+					},
+					PostID:           test_utils.NumberUUID(1000),
+					ThreadID:         test_utils.NumberUUID(1000),
+					UserID:           test_utils.NumberUUID(100),
+					Target:           duplicates_storage.TargetImproveRequest,
+					OriginalPostID:   test_utils.NumberUUID(2000),
+					OriginalThreadID: test_utils.NumberUUID(3000),
+					OriginalUserID:   test_utils.NumberUUID(101),
+					OriginalTarget:   duplicates_storage.TargetImproveSuggestion,
+				},
+			},
+			repositoryTotal: 21,
+			expect: []*models.ForumDuplicateFlag{
+				{
+					CreatedAt:          baseTime,
+					Similarity:         0.8,
+					RevisionID:         test_utils.NumberUUID(1001),
+					PostID:             test_utils.NumberUUID(1000),
+					ThreadID:           test_utils.NumberUUID(1000),
+					UserID:             test_utils.NumberUUID(100),
+					Target:             models.ForumPostTargetImproveRequest,
+					OriginalRevisionID: test_utils.NumberUUID(2001),
+					OriginalPostID:     test_utils.NumberUUID(2000),
+					OriginalThreadID:   test_utils.NumberUUID(3000),
+					OriginalUserID:     test_utils.NumberUUID(101),
+					OriginalTarget:     models.ForumPostTargetImproveSuggestion,
+					ReviewedAt:         &updateTime,
+					ReviewedBy:         framework.ToPTR(test_utils.NumberUUID(200)),
+				},
+			},
+			expectTotal: 21,
+		},
+		{
+			name:      "Error/LimitTooHigh",
+			limit:     MaxListLimit + 1,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			limit:                10,
+			shouldCallRepository: true,
+			repositoryErr:        fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := duplicates_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("ListFlags", context.TODO(), d.reviewed, d.limit, d.offset).
+					Return(d.repositoryData, d.repositoryTotal, d.repositoryErr)
+			}
+
+			service := NewService(repository)
+			res, total, err := service.ListFlags(context.TODO(), d.reviewed, d.limit, d.offset)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+			require.Equal(st, d.expectTotal, total)
+
+			repository.AssertExpectations(st)
+		})
+	}
+}
+
+func TestDuplicatesService_Review(t *testing.T) {
+	data := []struct {
+		name string
+
+		revisionID         uuid.UUID
+		originalRevisionID uuid.UUID
+		reviewerID         uuid.UUID
+		now                time.Time
+
+		repositoryErr error
+
+		expectErr error
+	}{
+		{
+			name:               "Success",
+			revisionID:         test_utils.NumberUUID(1001),
+			originalRevisionID: test_utils.NumberUUID(2001),
+			reviewerID:         test_utils.NumberUUID(200),
+			now:                updateTime,
+		},
+		{
+			name:               "Error/RepositoryFailure",
+			revisionID:         test_utils.NumberUUID(1001),
+			originalRevisionID: test_utils.NumberUUID(2001),
+			reviewerID:         test_utils.NumberUUID(200),
+			now:                updateTime,
+			repositoryErr:      fooErr,
+			expectErr:          fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := duplicates_storage.NewMockRepository(st)
+
+			repository.
+				On("Review", context.TODO(), d.revisionID, d.originalRevisionID, d.reviewerID, d.now).
+				Return(nil, d.repositoryErr)
+
+			service := NewService(repository)
+			err := service.Review(context.TODO(), d.revisionID, d.originalRevisionID, d.reviewerID, d.now)
+			test_utils.RequireError(st, d.expectErr, err)
+
+			repository.AssertExpectations(st)
+		})
+	}
+}
@@ -4,10 +4,12 @@ package improve_request_service
 
 import (
 	context "context"
+
 	"github.com/a-novel/agora-backend/domains/forum/storage/improve_request"
+	mock "github.com/stretchr/testify/mock"
+
 	"github.com/a-novel/agora-backend/models"
 
-	mock "github.com/stretchr/testify/mock"
 	time "time"
 
 	uuid "github.com/google/uuid"
@@ -26,25 +28,25 @@ func (_m *MockService) EXPECT() *MockService_Expecter {
 	return &MockService_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: ctx, userID, title, content, id, now
-func (_m *MockService) Create(ctx context.Context, userID uuid.UUID, title string, content string, id uuid.UUID, now time.Time) (*models.ImproveRequest, error) {
-	ret := _m.Called(ctx, userID, title, content, id, now)
+// Create provides a mock function with given fields: ctx, userID, title, content, lang, tags, id, now
+func (_m *MockService) Create(ctx context.Context, userID uuid.UUID, title string, content string, lang string, tags []uuid.UUID, id uuid.UUID, now time.Time) (*models.ImproveRequest, error) {
+	ret := _m.Called(ctx, userID, title, content, lang, tags, id, now)
 
 	var r0 *models.ImproveRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, uuid.UUID, time.Time) (*models.ImproveRequest, error)); ok {
-		return rf(ctx, userID, title, content, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, string, []uuid.UUID, uuid.UUID, time.Time) (*models.ImproveRequest, error)); ok {
+		return rf(ctx, userID, title, content, lang, tags, id, now)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, uuid.UUID, time.Time) *models.ImproveRequest); ok {
-		r0 = rf(ctx, userID, title, content, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, string, []uuid.UUID, uuid.UUID, time.Time) *models.ImproveRequest); ok {
+		r0 = rf(ctx, userID, title, content, lang, tags, id, now)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.ImproveRequest)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, uuid.UUID, time.Time) error); ok {
-		r1 = rf(ctx, userID, title, content, id, now)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, string, []uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, userID, title, content, lang, tags, id, now)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -62,15 +64,17 @@ type MockService_Create_Call struct {
 //   - userID uuid.UUID
 //   - title string
 //   - content string
+//   - lang string
+//   - tags []uuid.UUID
 //   - id uuid.UUID
 //   - now time.Time
-func (_e *MockService_Expecter) Create(ctx interface{}, userID interface{}, title interface{}, content interface{}, id interface{}, now interface{}) *MockService_Create_Call {
-	return &MockService_Create_Call{Call: _e.mock.On("Create", ctx, userID, title, content, id, now)}
+func (_e *MockService_Expecter) Create(ctx interface{}, userID interface{}, title interface{}, content interface{}, lang interface{}, tags interface{}, id interface{}, now interface{}) *MockService_Create_Call {
+	return &MockService_Create_Call{Call: _e.mock.On("Create", ctx, userID, title, content, lang, tags, id, now)}
 }
 
-func (_c *MockService_Create_Call) Run(run func(ctx context.Context, userID uuid.UUID, title string, content string, id uuid.UUID, now time.Time)) *MockService_Create_Call {
+func (_c *MockService_Create_Call) Run(run func(ctx context.Context, userID uuid.UUID, title string, content string, lang string, tags []uuid.UUID, id uuid.UUID, now time.Time)) *MockService_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].(uuid.UUID), args[5].(time.Time))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].(string), args[5].([]uuid.UUID), args[6].(uuid.UUID), args[7].(time.Time))
 	})
 	return _c
 }
@@ -80,30 +84,30 @@ func (_c *MockService_Create_Call) Return(_a0 *models.ImproveRequest, _a1 error)
 	return _c
 }
 
-func (_c *MockService_Create_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, uuid.UUID, time.Time) (*models.ImproveRequest, error)) *MockService_Create_Call {
+func (_c *MockService_Create_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, string, []uuid.UUID, uuid.UUID, time.Time) (*models.ImproveRequest, error)) *MockService_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateRevision provides a mock function with given fields: ctx, userID, sourceID, title, content, id, now
-func (_m *MockService) CreateRevision(ctx context.Context, userID uuid.UUID, sourceID uuid.UUID, title string, content string, id uuid.UUID, now time.Time) (*models.ImproveRequest, error) {
-	ret := _m.Called(ctx, userID, sourceID, title, content, id, now)
+// CreateRevision provides a mock function with given fields: ctx, userID, sourceID, title, content, lang, tags, id, now
+func (_m *MockService) CreateRevision(ctx context.Context, userID uuid.UUID, sourceID uuid.UUID, title string, content string, lang string, tags []uuid.UUID, id uuid.UUID, now time.Time) (*models.ImproveRequest, error) {
+	ret := _m.Called(ctx, userID, sourceID, title, content, lang, tags, id, now)
 
 	var r0 *models.ImproveRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, string, uuid.UUID, time.Time) (*models.ImproveRequest, error)); ok {
-		return rf(ctx, userID, sourceID, title, content, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, string, string, []uuid.UUID, uuid.UUID, time.Time) (*models.ImproveRequest, error)); ok {
+		return rf(ctx, userID, sourceID, title, content, lang, tags, id, now)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, string, uuid.UUID, time.Time) *models.ImproveRequest); ok {
-		r0 = rf(ctx, userID, sourceID, title, content, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, string, string, []uuid.UUID, uuid.UUID, time.Time) *models.ImproveRequest); ok {
+		r0 = rf(ctx, userID, sourceID, title, content, lang, tags, id, now)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.ImproveRequest)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string, string, uuid.UUID, time.Time) error); ok {
-		r1 = rf(ctx, userID, sourceID, title, content, id, now)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string, string, string, []uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, userID, sourceID, title, content, lang, tags, id, now)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -122,15 +126,17 @@ type MockService_CreateRevision_Call struct {
 //   - sourceID uuid.UUID
 //   - title string
 //   - content string
+//   - lang string
+//   - tags []uuid.UUID
 //   - id uuid.UUID
 //   - now time.Time
-func (_e *MockService_Expecter) CreateRevision(ctx interface{}, userID interface{}, sourceID interface{}, title interface{}, content interface{}, id interface{}, now interface{}) *MockService_CreateRevision_Call {
-	return &MockService_CreateRevision_Call{Call: _e.mock.On("CreateRevision", ctx, userID, sourceID, title, content, id, now)}
+func (_e *MockService_Expecter) CreateRevision(ctx interface{}, userID interface{}, sourceID interface{}, title interface{}, content interface{}, lang interface{}, tags interface{}, id interface{}, now interface{}) *MockService_CreateRevision_Call {
+	return &MockService_CreateRevision_Call{Call: _e.mock.On("CreateRevision", ctx, userID, sourceID, title, content, lang, tags, id, now)}
 }
 
-func (_c *MockService_CreateRevision_Call) Run(run func(ctx context.Context, userID uuid.UUID, sourceID uuid.UUID, title string, content string, id uuid.UUID, now time.Time)) *MockService_CreateRevision_Call {
+func (_c *MockService_CreateRevision_Call) Run(run func(ctx context.Context, userID uuid.UUID, sourceID uuid.UUID, title string, content string, lang string, tags []uuid.UUID, id uuid.UUID, now time.Time)) *MockService_CreateRevision_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string), args[4].(string), args[5].(uuid.UUID), args[6].(time.Time))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string), args[4].(string), args[5].(string), args[6].([]uuid.UUID), args[7].(uuid.UUID), args[8].(time.Time))
 	})
 	return _c
 }
@@ -140,18 +146,18 @@ func (_c *MockService_CreateRevision_Call) Return(_a0 *models.ImproveRequest, _a
 	return _c
 }
 
-func (_c *MockService_CreateRevision_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string, string, uuid.UUID, time.Time) (*models.ImproveRequest, error)) *MockService_CreateRevision_Call {
+func (_c *MockService_CreateRevision_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string, string, string, []uuid.UUID, uuid.UUID, time.Time) (*models.ImproveRequest, error)) *MockService_CreateRevision_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: ctx, requestID
-func (_m *MockService) Delete(ctx context.Context, requestID uuid.UUID) error {
-	ret := _m.Called(ctx, requestID)
+// Delete provides a mock function with given fields: ctx, requestID, now
+func (_m *MockService) Delete(ctx context.Context, requestID uuid.UUID, now time.Time) error {
+	ret := _m.Called(ctx, requestID, now)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, requestID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, requestID, now)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -167,13 +173,14 @@ type MockService_Delete_Call struct {
 // Delete is a helper method to define mock.On call
 //   - ctx context.Context
 //   - requestID uuid.UUID
-func (_e *MockService_Expecter) Delete(ctx interface{}, requestID interface{}) *MockService_Delete_Call {
-	return &MockService_Delete_Call{Call: _e.mock.On("Delete", ctx, requestID)}
+//   - now time.Time
+func (_e *MockService_Expecter) Delete(ctx interface{}, requestID interface{}, now interface{}) *MockService_Delete_Call {
+	return &MockService_Delete_Call{Call: _e.mock.On("Delete", ctx, requestID, now)}
 }
 
-func (_c *MockService_Delete_Call) Run(run func(ctx context.Context, requestID uuid.UUID)) *MockService_Delete_Call {
+func (_c *MockService_Delete_Call) Run(run func(ctx context.Context, requestID uuid.UUID, now time.Time)) *MockService_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
 	})
 	return _c
 }
@@ -183,30 +190,86 @@ func (_c *MockService_Delete_Call) Return(_a0 error) *MockService_Delete_Call {
 	return _c
 }
 
-func (_c *MockService_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockService_Delete_Call {
+func (_c *MockService_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) error) *MockService_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPreviews provides a mock function with given fields: ctx, ids
-func (_m *MockService) GetPreviews(ctx context.Context, ids []uuid.UUID) ([]*models.ImproveRequestPreview, error) {
-	ret := _m.Called(ctx, ids)
+// Facets provides a mock function with given fields: ctx, query, now
+func (_m *MockService) Facets(ctx context.Context, query models.ImproveRequestSearch, now time.Time) (*models.ImproveRequestSearchFacets, error) {
+	ret := _m.Called(ctx, query, now)
+
+	var r0 *models.ImproveRequestSearchFacets
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ImproveRequestSearch, time.Time) (*models.ImproveRequestSearchFacets, error)); ok {
+		return rf(ctx, query, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ImproveRequestSearch, time.Time) *models.ImproveRequestSearchFacets); ok {
+		r0 = rf(ctx, query, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestSearchFacets)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ImproveRequestSearch, time.Time) error); ok {
+		r1 = rf(ctx, query, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Facets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Facets'
+type MockService_Facets_Call struct {
+	*mock.Call
+}
+
+// Facets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query models.ImproveRequestSearch
+//   - now time.Time
+func (_e *MockService_Expecter) Facets(ctx interface{}, query interface{}, now interface{}) *MockService_Facets_Call {
+	return &MockService_Facets_Call{Call: _e.mock.On("Facets", ctx, query, now)}
+}
+
+func (_c *MockService_Facets_Call) Run(run func(ctx context.Context, query models.ImproveRequestSearch, now time.Time)) *MockService_Facets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ImproveRequestSearch), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Facets_Call) Return(_a0 *models.ImproveRequestSearchFacets, _a1 error) *MockService_Facets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Facets_Call) RunAndReturn(run func(context.Context, models.ImproveRequestSearch, time.Time) (*models.ImproveRequestSearchFacets, error)) *MockService_Facets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPreviews provides a mock function with given fields: ctx, ids, viewerID
+func (_m *MockService) GetPreviews(ctx context.Context, ids []uuid.UUID, viewerID *uuid.UUID) ([]*models.ImproveRequestPreview, error) {
+	ret := _m.Called(ctx, ids, viewerID)
 
 	var r0 []*models.ImproveRequestPreview
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]*models.ImproveRequestPreview, error)); ok {
-		return rf(ctx, ids)
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, *uuid.UUID) ([]*models.ImproveRequestPreview, error)); ok {
+		return rf(ctx, ids, viewerID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []*models.ImproveRequestPreview); ok {
-		r0 = rf(ctx, ids)
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, *uuid.UUID) []*models.ImproveRequestPreview); ok {
+		r0 = rf(ctx, ids, viewerID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.ImproveRequestPreview)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
-		r1 = rf(ctx, ids)
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID, *uuid.UUID) error); ok {
+		r1 = rf(ctx, ids, viewerID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -222,13 +285,14 @@ type MockService_GetPreviews_Call struct {
 // GetPreviews is a helper method to define mock.On call
 //   - ctx context.Context
 //   - ids []uuid.UUID
-func (_e *MockService_Expecter) GetPreviews(ctx interface{}, ids interface{}) *MockService_GetPreviews_Call {
-	return &MockService_GetPreviews_Call{Call: _e.mock.On("GetPreviews", ctx, ids)}
+//   - viewerID *uuid.UUID
+func (_e *MockService_Expecter) GetPreviews(ctx interface{}, ids interface{}, viewerID interface{}) *MockService_GetPreviews_Call {
+	return &MockService_GetPreviews_Call{Call: _e.mock.On("GetPreviews", ctx, ids, viewerID)}
 }
 
-func (_c *MockService_GetPreviews_Call) Run(run func(ctx context.Context, ids []uuid.UUID)) *MockService_GetPreviews_Call {
+func (_c *MockService_GetPreviews_Call) Run(run func(ctx context.Context, ids []uuid.UUID, viewerID *uuid.UUID)) *MockService_GetPreviews_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]uuid.UUID))
+		run(args[0].(context.Context), args[1].([]uuid.UUID), args[2].(*uuid.UUID))
 	})
 	return _c
 }
@@ -238,7 +302,7 @@ func (_c *MockService_GetPreviews_Call) Return(_a0 []*models.ImproveRequestPrevi
 	return _c
 }
 
-func (_c *MockService_GetPreviews_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]*models.ImproveRequestPreview, error)) *MockService_GetPreviews_Call {
+func (_c *MockService_GetPreviews_Call) RunAndReturn(run func(context.Context, []uuid.UUID, *uuid.UUID) ([]*models.ImproveRequestPreview, error)) *MockService_GetPreviews_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -298,6 +362,59 @@ func (_c *MockService_IsCreator_Call) RunAndReturn(run func(context.Context, uui
 	return _c
 }
 
+// Purge provides a mock function with given fields: ctx, deletedBefore
+func (_m *MockService) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	ret := _m.Called(ctx, deletedBefore)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, deletedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, deletedBefore)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, deletedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockService_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deletedBefore time.Time
+func (_e *MockService_Expecter) Purge(ctx interface{}, deletedBefore interface{}) *MockService_Purge_Call {
+	return &MockService_Purge_Call{Call: _e.mock.On("Purge", ctx, deletedBefore)}
+}
+
+func (_c *MockService_Purge_Call) Run(run func(ctx context.Context, deletedBefore time.Time)) *MockService_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Purge_Call) Return(_a0 int64, _a1 error) *MockService_Purge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Purge_Call) RunAndReturn(run func(context.Context, time.Time) (int64, error)) *MockService_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Read provides a mock function with given fields: ctx, id
 func (_m *MockService) Read(ctx context.Context, id uuid.UUID) (*models.ImproveRequest, error) {
 	ret := _m.Called(ctx, id)
@@ -353,6 +470,61 @@ func (_c *MockService_Read_Call) RunAndReturn(run func(context.Context, uuid.UUI
 	return _c
 }
 
+// ReadDeleted provides a mock function with given fields: ctx, id
+func (_m *MockService) ReadDeleted(ctx context.Context, id uuid.UUID) (*models.ImproveRequest, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *models.ImproveRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.ImproveRequest, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.ImproveRequest); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ReadDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDeleted'
+type MockService_ReadDeleted_Call struct {
+	*mock.Call
+}
+
+// ReadDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockService_Expecter) ReadDeleted(ctx interface{}, id interface{}) *MockService_ReadDeleted_Call {
+	return &MockService_ReadDeleted_Call{Call: _e.mock.On("ReadDeleted", ctx, id)}
+}
+
+func (_c *MockService_ReadDeleted_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockService_ReadDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_ReadDeleted_Call) Return(_a0 *models.ImproveRequest, _a1 error) *MockService_ReadDeleted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ReadDeleted_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.ImproveRequest, error)) *MockService_ReadDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReadRevisions provides a mock function with given fields: ctx, id
 func (_m *MockService) ReadRevisions(ctx context.Context, id uuid.UUID) ([]*models.ImproveRequest, error) {
 	ret := _m.Called(ctx, id)
@@ -408,6 +580,149 @@ func (_c *MockService_ReadRevisions_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// RefreshRankings provides a mock function with given fields: ctx
+func (_m *MockService) RefreshRankings(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_RefreshRankings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshRankings'
+type MockService_RefreshRankings_Call struct {
+	*mock.Call
+}
+
+// RefreshRankings is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockService_Expecter) RefreshRankings(ctx interface{}) *MockService_RefreshRankings_Call {
+	return &MockService_RefreshRankings_Call{Call: _e.mock.On("RefreshRankings", ctx)}
+}
+
+func (_c *MockService_RefreshRankings_Call) Run(run func(ctx context.Context)) *MockService_RefreshRankings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockService_RefreshRankings_Call) Return(_a0 error) *MockService_RefreshRankings_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_RefreshRankings_Call) RunAndReturn(run func(context.Context) error) *MockService_RefreshRankings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Related provides a mock function with given fields: ctx, id, limit, now
+func (_m *MockService) Related(ctx context.Context, id uuid.UUID, limit int, now time.Time) ([]*models.ImproveRequestPreview, error) {
+	ret := _m.Called(ctx, id, limit, now)
+
+	var r0 []*models.ImproveRequestPreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, time.Time) ([]*models.ImproveRequestPreview, error)); ok {
+		return rf(ctx, id, limit, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, time.Time) []*models.ImproveRequestPreview); ok {
+		r0 = rf(ctx, id, limit, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ImproveRequestPreview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, time.Time) error); ok {
+		r1 = rf(ctx, id, limit, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Related_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Related'
+type MockService_Related_Call struct {
+	*mock.Call
+}
+
+// Related is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - limit int
+//   - now time.Time
+func (_e *MockService_Expecter) Related(ctx interface{}, id interface{}, limit interface{}, now interface{}) *MockService_Related_Call {
+	return &MockService_Related_Call{Call: _e.mock.On("Related", ctx, id, limit, now)}
+}
+
+func (_c *MockService_Related_Call) Run(run func(ctx context.Context, id uuid.UUID, limit int, now time.Time)) *MockService_Related_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Related_Call) Return(_a0 []*models.ImproveRequestPreview, _a1 error) *MockService_Related_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Related_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, time.Time) ([]*models.ImproveRequestPreview, error)) *MockService_Related_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, requestID, deletedAfter
+func (_m *MockService) Restore(ctx context.Context, requestID uuid.UUID, deletedAfter time.Time) error {
+	ret := _m.Called(ctx, requestID, deletedAfter)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, requestID, deletedAfter)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockService_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestID uuid.UUID
+//   - deletedAfter time.Time
+func (_e *MockService_Expecter) Restore(ctx interface{}, requestID interface{}, deletedAfter interface{}) *MockService_Restore_Call {
+	return &MockService_Restore_Call{Call: _e.mock.On("Restore", ctx, requestID, deletedAfter)}
+}
+
+func (_c *MockService_Restore_Call) Run(run func(ctx context.Context, requestID uuid.UUID, deletedAfter time.Time)) *MockService_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Restore_Call) Return(_a0 error) *MockService_Restore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_Restore_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) error) *MockService_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Search provides a mock function with given fields: ctx, query, limit, offset
 func (_m *MockService) Search(ctx context.Context, query models.ImproveRequestSearch, limit int, offset int) ([]*models.ImproveRequestPreview, int64, error) {
 	ret := _m.Called(ctx, query, limit, offset)
@@ -448,7 +763,7 @@ type MockService_Search_Call struct {
 
 // Search is a helper method to define mock.On call
 //   - ctx context.Context
-//   - query ImproveRequestSearch
+//   - query models.ImproveRequestSearch
 //   - limit int
 //   - offset int
 func (_e *MockService_Expecter) Search(ctx interface{}, query interface{}, limit interface{}, offset interface{}) *MockService_Search_Call {
@@ -472,6 +787,70 @@ func (_c *MockService_Search_Call) RunAndReturn(run func(context.Context, models
 	return _c
 }
 
+// SearchAfter provides a mock function with given fields: ctx, query, cursor, limit
+func (_m *MockService) SearchAfter(ctx context.Context, query models.ImproveRequestSearch, cursor string, limit int) ([]*models.ImproveRequestPreview, string, error) {
+	ret := _m.Called(ctx, query, cursor, limit)
+
+	var r0 []*models.ImproveRequestPreview
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ImproveRequestSearch, string, int) ([]*models.ImproveRequestPreview, string, error)); ok {
+		return rf(ctx, query, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ImproveRequestSearch, string, int) []*models.ImproveRequestPreview); ok {
+		r0 = rf(ctx, query, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ImproveRequestPreview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ImproveRequestSearch, string, int) string); ok {
+		r1 = rf(ctx, query, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, models.ImproveRequestSearch, string, int) error); ok {
+		r2 = rf(ctx, query, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockService_SearchAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAfter'
+type MockService_SearchAfter_Call struct {
+	*mock.Call
+}
+
+// SearchAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query models.ImproveRequestSearch
+//   - cursor string
+//   - limit int
+func (_e *MockService_Expecter) SearchAfter(ctx interface{}, query interface{}, cursor interface{}, limit interface{}) *MockService_SearchAfter_Call {
+	return &MockService_SearchAfter_Call{Call: _e.mock.On("SearchAfter", ctx, query, cursor, limit)}
+}
+
+func (_c *MockService_SearchAfter_Call) Run(run func(ctx context.Context, query models.ImproveRequestSearch, cursor string, limit int)) *MockService_SearchAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ImproveRequestSearch), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockService_SearchAfter_Call) Return(_a0 []*models.ImproveRequestPreview, _a1 string, _a2 error) *MockService_SearchAfter_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockService_SearchAfter_Call) RunAndReturn(run func(context.Context, models.ImproveRequestSearch, string, int) ([]*models.ImproveRequestPreview, string, error)) *MockService_SearchAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StorageToModel provides a mock function with given fields: source
 func (_m *MockService) StorageToModel(source *improve_request_storage.Model) *models.ImproveRequest {
 	ret := _m.Called(source)
@@ -516,6 +895,62 @@ func (_c *MockService_StorageToModel_Call) RunAndReturn(run func(*improve_reques
 	return _c
 }
 
+// Suggest provides a mock function with given fields: ctx, query, limit
+func (_m *MockService) Suggest(ctx context.Context, query string, limit int) ([]string, error) {
+	ret := _m.Called(ctx, query, limit)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]string, error)); ok {
+		return rf(ctx, query, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []string); ok {
+		r0 = rf(ctx, query, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, query, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Suggest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Suggest'
+type MockService_Suggest_Call struct {
+	*mock.Call
+}
+
+// Suggest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - limit int
+func (_e *MockService_Expecter) Suggest(ctx interface{}, query interface{}, limit interface{}) *MockService_Suggest_Call {
+	return &MockService_Suggest_Call{Call: _e.mock.On("Suggest", ctx, query, limit)}
+}
+
+func (_c *MockService_Suggest_Call) Run(run func(ctx context.Context, query string, limit int)) *MockService_Suggest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockService_Suggest_Call) Return(_a0 []string, _a1 error) *MockService_Suggest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Suggest_Call) RunAndReturn(run func(context.Context, string, int) ([]string, error)) *MockService_Suggest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 type mockConstructorTestingTNewMockService interface {
 	mock.TestingT
 	Cleanup(func())
@@ -3,6 +3,7 @@ package improve_request_service
 import (
 	"context"
 	"fmt"
+	"github.com/a-novel/agora-backend/framework/language"
 	"github.com/a-novel/agora-backend/framework/validation"
 	"github.com/a-novel/agora-backend/models"
 	"github.com/google/uuid"
@@ -20,6 +21,10 @@ const (
 	MaxTitleLength   = 128
 	MinContentLength = 4
 	MaxContentLength = 4096
+	MaxTags          = 8
+	MaxReactions     = 8
+	MaxSuggestLimit  = 10
+	MaxRelatedLimit  = improve_request_storage.MaxRelated
 )
 
 // Service of the current layer. You can instantiate a new one with NewService.
@@ -30,24 +35,49 @@ type Service interface {
 	ReadRevisions(ctx context.Context, id uuid.UUID) ([]*models.ImproveRequest, error)
 
 	// Create creates a brand-new post. The returned model will have matching ImproveRequest.Source and ImproveRequest.ID.
-	Create(ctx context.Context, userID uuid.UUID, title, content string, id uuid.UUID, now time.Time) (*models.ImproveRequest, error)
+	// The language code is optional: it is detected from the content when empty.
+	Create(ctx context.Context, userID uuid.UUID, title, content, lang string, tags []uuid.UUID, id uuid.UUID, now time.Time) (*models.ImproveRequest, error)
 	// CreateRevision creates a new revision for a given post. The ID must be the one of the source post.
-	CreateRevision(ctx context.Context, userID, sourceID uuid.UUID, title, content string, id uuid.UUID, now time.Time) (*models.ImproveRequest, error)
+	// The language code is optional: it is detected from the content when empty.
+	CreateRevision(ctx context.Context, userID, sourceID uuid.UUID, title, content, lang string, tags []uuid.UUID, id uuid.UUID, now time.Time) (*models.ImproveRequest, error)
 
 	// Delete a single revision for a post. If the provided id is the source id, then all associated revisions will
-	// also be deleted.
-	Delete(ctx context.Context, requestID uuid.UUID) error
+	// also be deleted. Deleted revisions can be restored until they are purged.
+	Delete(ctx context.Context, requestID uuid.UUID, now time.Time) error
+	// ReadDeleted reads a single deleted post revision, based on its ID.
+	ReadDeleted(ctx context.Context, id uuid.UUID) (*models.ImproveRequest, error)
+	// Restore restores a revision deleted after the given time. If the provided id is the source id, then the
+	// revisions deleted along with it are also restored.
+	Restore(ctx context.Context, requestID uuid.UUID, deletedAfter time.Time) error
+	// Purge permanently removes the revisions deleted before the given time. It returns the number of removed
+	// revisions.
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
 
 	// Search returns a list of posts, matching the provided query. Results must be paginated using the limit and
 	// offset parameters.
 	// It also returns the total number of available results, to help with pagination.
 	Search(ctx context.Context, query models.ImproveRequestSearch, limit, offset int) ([]*models.ImproveRequestPreview, int64, error)
+	// SearchAfter returns the posts matching the query that come after the cursor, in the same order as Search.
+	// An empty cursor returns the first page. It also returns the cursor of the next page, empty on the last page.
+	SearchAfter(ctx context.Context, query models.ImproveRequestSearch, cursor string, limit int) ([]*models.ImproveRequestPreview, string, error)
+	// Facets returns aggregated counts over every post matching the query.
+	Facets(ctx context.Context, query models.ImproveRequestSearch, now time.Time) (*models.ImproveRequestSearchFacets, error)
+	// Suggest returns the titles of the posts that loosely resemble the query, to be used as "did you mean"
+	// alternatives when a search has no result.
+	Suggest(ctx context.Context, query string, limit int) ([]string, error)
+	// RefreshRankings recomputes the scores used by the time sensitive search orders.
+	RefreshRankings(ctx context.Context) error
 
 	// IsCreator returns whether the user is a creator of the improvement suggestion. ID can be the id of any revision.
 	// To only check if the user is the creator of the specific revision, set strict flag to true.
 	IsCreator(ctx context.Context, userID, postID uuid.UUID, strict bool) (bool, error)
 
-	GetPreviews(ctx context.Context, ids []uuid.UUID) ([]*models.ImproveRequestPreview, error)
+	// GetPreviews returns the previews of the given revisions. Requests that are not public are only returned to
+	// their authors and invited users, when viewerID is set.
+	GetPreviews(ctx context.Context, ids []uuid.UUID, viewerID *uuid.UUID) ([]*models.ImproveRequestPreview, error)
+	// Related returns the latest revision of the posts most similar to the given one, by decreasing similarity.
+	// ID can be the id of any revision.
+	Related(ctx context.Context, id uuid.UUID, limit int, now time.Time) ([]*models.ImproveRequestPreview, error)
 
 	// StorageToModel converts a storage model to a service model.
 	StorageToModel(source *improve_request_storage.Model) *models.ImproveRequest
@@ -55,12 +85,26 @@ type Service interface {
 
 type serviceImpl struct {
 	repository improve_request_storage.Repository
+	languages  language.Languages
 }
 
-// NewService returns a new Service instance.
+// NewService returns a new Service instance. Languages are the languages supported by the full text search.
 // To use a mocked one, call NewMockService.
-func NewService(repository improve_request_storage.Repository) Service {
-	return &serviceImpl{repository: repository}
+func NewService(repository improve_request_storage.Repository, languages language.Languages) Service {
+	return &serviceImpl{repository: repository, languages: languages}
+}
+
+// Resolve the text search configuration of a post. The language is detected from the content if not provided.
+func (service *serviceImpl) searchConfig(title, content, lang string) (string, error) {
+	if lang == "" {
+		return service.languages.Config(service.languages.Detect(title + "\n" + content)), nil
+	}
+
+	if !service.languages.IsSupported(lang) {
+		return "", validation.NewErrInvalidEntity("language", fmt.Sprintf("language %q is not supported", lang))
+	}
+
+	return service.languages.Config(lang), nil
 }
 
 func (service *serviceImpl) Read(ctx context.Context, id uuid.UUID) (*models.ImproveRequest, error) {
@@ -86,7 +130,7 @@ func (service *serviceImpl) ReadRevisions(ctx context.Context, id uuid.UUID) ([]
 	return serviceModels, nil
 }
 
-func (service *serviceImpl) Create(ctx context.Context, userID uuid.UUID, title, content string, id uuid.UUID, now time.Time) (*models.ImproveRequest, error) {
+func (service *serviceImpl) Create(ctx context.Context, userID uuid.UUID, title, content, lang string, tags []uuid.UUID, id uuid.UUID, now time.Time) (*models.ImproveRequest, error) {
 	if err := validation.CheckRequire("title", title); err != nil {
 		return nil, err
 	}
@@ -102,8 +146,16 @@ func (service *serviceImpl) Create(ctx context.Context, userID uuid.UUID, title,
 	if err := validation.CheckRegexp("title", title, titleRegexp); err != nil {
 		return nil, err
 	}
+	if err := validation.CheckMinMax("tags", tags, -1, MaxTags); err != nil {
+		return nil, err
+	}
 
-	storageModel, err := service.repository.Create(ctx, userID, title, content, id, now)
+	searchConfig, err := service.searchConfig(title, content, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	storageModel, err := service.repository.Create(ctx, userID, title, content, searchConfig, tags, id, now)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create improve request: %w", err)
 	}
@@ -111,7 +163,7 @@ func (service *serviceImpl) Create(ctx context.Context, userID uuid.UUID, title,
 	return service.StorageToModel(storageModel), nil
 }
 
-func (service *serviceImpl) CreateRevision(ctx context.Context, userID, sourceID uuid.UUID, title, content string, id uuid.UUID, now time.Time) (*models.ImproveRequest, error) {
+func (service *serviceImpl) CreateRevision(ctx context.Context, userID, sourceID uuid.UUID, title, content, lang string, tags []uuid.UUID, id uuid.UUID, now time.Time) (*models.ImproveRequest, error) {
 	if err := validation.CheckRequire("title", title); err != nil {
 		return nil, err
 	}
@@ -127,8 +179,16 @@ func (service *serviceImpl) CreateRevision(ctx context.Context, userID, sourceID
 	if err := validation.CheckRegexp("title", title, titleRegexp); err != nil {
 		return nil, err
 	}
+	if err := validation.CheckMinMax("tags", tags, -1, MaxTags); err != nil {
+		return nil, err
+	}
 
-	storageModel, err := service.repository.CreateRevision(ctx, userID, sourceID, title, content, id, now)
+	searchConfig, err := service.searchConfig(title, content, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	storageModel, err := service.repository.CreateRevision(ctx, userID, sourceID, title, content, searchConfig, tags, id, now)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create improve request: %w", err)
 	}
@@ -136,27 +196,89 @@ func (service *serviceImpl) CreateRevision(ctx context.Context, userID, sourceID
 	return service.StorageToModel(storageModel), nil
 }
 
-func (service *serviceImpl) Delete(ctx context.Context, requestID uuid.UUID) error {
-	if err := service.repository.Delete(ctx, requestID); err != nil {
+func (service *serviceImpl) Delete(ctx context.Context, requestID uuid.UUID, now time.Time) error {
+	if err := service.repository.Delete(ctx, requestID, now); err != nil {
 		return fmt.Errorf("failed to delete improve request: %w", err)
 	}
 
 	return nil
 }
 
-func (service *serviceImpl) Search(ctx context.Context, query models.ImproveRequestSearch, limit, offset int) ([]*models.ImproveRequestPreview, int64, error) {
+func (service *serviceImpl) ReadDeleted(ctx context.Context, id uuid.UUID) (*models.ImproveRequest, error) {
+	storageModel, err := service.repository.ReadDeleted(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted improve request: %w", err)
+	}
+
+	return service.StorageToModel(storageModel), nil
+}
+
+func (service *serviceImpl) Restore(ctx context.Context, requestID uuid.UUID, deletedAfter time.Time) error {
+	if err := service.repository.Restore(ctx, requestID, deletedAfter); err != nil {
+		return fmt.Errorf("failed to restore improve request: %w", err)
+	}
+
+	return nil
+}
+
+func (service *serviceImpl) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	purged, err := service.repository.Purge(ctx, deletedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge deleted improve requests: %w", err)
+	}
+
+	return purged, nil
+}
+
+func (service *serviceImpl) searchQueryToStorage(query models.ImproveRequestSearch) (improve_request_storage.SearchQuery, error) {
 	storageQuery := improve_request_storage.SearchQuery{
-		UserID: query.UserID,
-		Query:  query.Query,
+		UserID:    query.UserID,
+		Query:     query.Query,
+		Tags:      query.Tags,
+		Reactions: query.Reactions,
+		// Unsupported locales fall back to the default language, rather than failing the search.
+		Language: service.languages.Config(service.languages.FromLocale(query.Language)),
+	}
+
+	if err := validation.CheckMinMax("tags", query.Tags, -1, MaxTags); err != nil {
+		return storageQuery, err
+	}
+	if err := validation.CheckMinMax("reactions", query.Reactions, -1, MaxReactions); err != nil {
+		return storageQuery, err
+	}
+
+	for _, state := range query.States {
+		if err := validation.CheckRestricted(
+			"states", state,
+			models.ImproveRequestStateOpen,
+			models.ImproveRequestStateClosed,
+			models.ImproveRequestStateLocked,
+			models.ImproveRequestStateArchived,
+		); err != nil {
+			return storageQuery, err
+		}
+
+		storageQuery.States = append(storageQuery.States, string(state))
 	}
 
 	if query.Order != nil {
 		storageQuery.Order = &improve_request_storage.SearchQueryOrder{
 			Created: query.Order.Created,
 			Score:   query.Order.Score,
+			Best:    query.Order.Best,
+			Hot:     query.Order.Hot,
 		}
 	}
 
+	return storageQuery, nil
+}
+
+func (service *serviceImpl) Search(ctx context.Context, query models.ImproveRequestSearch, limit, offset int) ([]*models.ImproveRequestPreview, int64, error) {
+	storageQuery, err := service.searchQueryToStorage(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
 	storageModels, total, err := service.repository.Search(ctx, storageQuery, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to search improve requests: %w", err)
@@ -164,25 +286,105 @@ func (service *serviceImpl) Search(ctx context.Context, query models.ImproveRequ
 
 	serviceModels := make([]*models.ImproveRequestPreview, len(storageModels))
 	for i, storageModel := range storageModels {
-		serviceModels[i] = &models.ImproveRequestPreview{
-			ID:                       storageModel.ID,
-			Source:                   storageModel.Source,
-			CreatedAt:                storageModel.CreatedAt,
-			UserID:                   storageModel.UserID,
-			Title:                    storageModel.Title,
-			Content:                  storageModel.Content,
-			UpVotes:                  storageModel.UpVotes,
-			DownVotes:                storageModel.DownVotes,
-			RevisionCount:            storageModel.RevisionCount,
-			MoreRecentRevisions:      storageModel.MoreRecentRevisions,
-			SuggestionsCount:         storageModel.SuggestionsCount,
-			AcceptedSuggestionsCount: storageModel.AcceptedSuggestionsCount,
-		}
+		serviceModels[i] = service.previewStorageToModel(storageModel)
 	}
 
 	return serviceModels, total, nil
 }
 
+func (service *serviceImpl) SearchAfter(ctx context.Context, query models.ImproveRequestSearch, cursor string, limit int) ([]*models.ImproveRequestPreview, string, error) {
+	storageQuery, err := service.searchQueryToStorage(query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	storageModels, next, err := service.repository.SearchAfter(ctx, storageQuery, cursor, limit)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to search improve requests: %w", err)
+	}
+
+	serviceModels := make([]*models.ImproveRequestPreview, len(storageModels))
+	for i, storageModel := range storageModels {
+		serviceModels[i] = service.previewStorageToModel(storageModel)
+	}
+
+	return serviceModels, next, nil
+}
+
+func (service *serviceImpl) Facets(ctx context.Context, query models.ImproveRequestSearch, now time.Time) (*models.ImproveRequestSearchFacets, error) {
+	storageQuery, err := service.searchQueryToStorage(query)
+	if err != nil {
+		return nil, err
+	}
+
+	storageFacets, err := service.repository.Facets(ctx, storageQuery, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute improve requests facets: %w", err)
+	}
+
+	facets := &models.ImproveRequestSearchFacets{
+		Tags:    make([]*models.ImproveRequestTagFacet, len(storageFacets.Tags)),
+		Authors: make([]*models.ImproveRequestAuthorFacet, len(storageFacets.Authors)),
+		Accepted: models.ImproveRequestAcceptedFacet{
+			With:    storageFacets.Accepted.With,
+			Without: storageFacets.Accepted.Without,
+		},
+		CreatedAt: models.ImproveRequestCreatedAtFacet{
+			LastDay:   storageFacets.CreatedAt.LastDay,
+			LastWeek:  storageFacets.CreatedAt.LastWeek,
+			LastMonth: storageFacets.CreatedAt.LastMonth,
+			LastYear:  storageFacets.CreatedAt.LastYear,
+			Older:     storageFacets.CreatedAt.Older,
+		},
+		Revisions: models.ImproveRequestRevisionsFacet{
+			Single: storageFacets.Revisions.Single,
+			Few:    storageFacets.Revisions.Few,
+			Many:   storageFacets.Revisions.Many,
+		},
+	}
+	for i, tag := range storageFacets.Tags {
+		facets.Tags[i] = &models.ImproveRequestTagFacet{
+			ID:       tag.ID,
+			Category: models.ForumTagCategory(tag.Category),
+			Slug:     tag.Slug,
+			Name:     tag.Name,
+			Count:    tag.Count,
+		}
+	}
+	for i, author := range storageFacets.Authors {
+		facets.Authors[i] = &models.ImproveRequestAuthorFacet{
+			UserID: author.UserID,
+			Count:  author.Count,
+		}
+	}
+
+	return facets, nil
+}
+
+func (service *serviceImpl) Suggest(ctx context.Context, query string, limit int) ([]string, error) {
+	if err := validation.CheckRequire("query", query); err != nil {
+		return nil, err
+	}
+	if err := validation.CheckMinMax("limit", limit, 1, MaxSuggestLimit); err != nil {
+		return nil, err
+	}
+
+	suggestions, err := service.repository.Suggest(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest improve requests titles: %w", err)
+	}
+
+	return suggestions, nil
+}
+
+func (service *serviceImpl) RefreshRankings(ctx context.Context) error {
+	if err := service.repository.RefreshRankings(ctx); err != nil {
+		return fmt.Errorf("failed to refresh improve requests rankings: %w", err)
+	}
+
+	return nil
+}
+
 func (service *serviceImpl) IsCreator(ctx context.Context, userID, postID uuid.UUID, strict bool) (bool, error) {
 	ok, err := service.repository.IsCreator(ctx, userID, postID, strict)
 	if err != nil {
@@ -192,33 +394,57 @@ func (service *serviceImpl) IsCreator(ctx context.Context, userID, postID uuid.U
 	return ok, nil
 }
 
-func (service *serviceImpl) GetPreviews(ctx context.Context, ids []uuid.UUID) ([]*models.ImproveRequestPreview, error) {
-	storageModels, err := service.repository.GetPreviews(ctx, ids)
+func (service *serviceImpl) GetPreviews(ctx context.Context, ids []uuid.UUID, viewerID *uuid.UUID) ([]*models.ImproveRequestPreview, error) {
+	storageModels, err := service.repository.GetPreviews(ctx, ids, viewerID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get improve request previews: %w", err)
 	}
 
 	serviceModels := make([]*models.ImproveRequestPreview, len(storageModels))
 	for i, storageModel := range storageModels {
-		serviceModels[i] = &models.ImproveRequestPreview{
-			ID:                       storageModel.ID,
-			Source:                   storageModel.Source,
-			CreatedAt:                storageModel.CreatedAt,
-			UserID:                   storageModel.UserID,
-			Title:                    storageModel.Title,
-			Content:                  storageModel.Content,
-			UpVotes:                  storageModel.UpVotes,
-			DownVotes:                storageModel.DownVotes,
-			RevisionCount:            storageModel.RevisionCount,
-			MoreRecentRevisions:      storageModel.MoreRecentRevisions,
-			SuggestionsCount:         storageModel.SuggestionsCount,
-			AcceptedSuggestionsCount: storageModel.AcceptedSuggestionsCount,
-		}
+		serviceModels[i] = service.previewStorageToModel(storageModel)
 	}
 
 	return serviceModels, nil
 }
 
+func (service *serviceImpl) Related(ctx context.Context, id uuid.UUID, limit int, now time.Time) ([]*models.ImproveRequestPreview, error) {
+	if err := validation.CheckMinMax("limit", limit, 1, MaxRelatedLimit); err != nil {
+		return nil, err
+	}
+
+	storageModels, err := service.repository.Related(ctx, id, limit, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get related improve requests: %w", err)
+	}
+
+	serviceModels := make([]*models.ImproveRequestPreview, len(storageModels))
+	for i, storageModel := range storageModels {
+		serviceModels[i] = service.previewStorageToModel(storageModel)
+	}
+
+	return serviceModels, nil
+}
+
+func (service *serviceImpl) previewStorageToModel(source *improve_request_storage.Preview) *models.ImproveRequestPreview {
+	return &models.ImproveRequestPreview{
+		ID:                       source.ID,
+		Source:                   source.Source,
+		CreatedAt:                source.CreatedAt,
+		UserID:                   source.UserID,
+		Title:                    source.Title,
+		Content:                  source.Content,
+		UpVotes:                  source.UpVotes,
+		DownVotes:                source.DownVotes,
+		Tags:                     source.Tags,
+		Reactions:                source.Reactions,
+		RevisionCount:            source.RevisionCount,
+		MoreRecentRevisions:      source.MoreRecentRevisions,
+		SuggestionsCount:         source.SuggestionsCount,
+		AcceptedSuggestionsCount: source.AcceptedSuggestionsCount,
+	}
+}
+
 func (service *serviceImpl) StorageToModel(source *improve_request_storage.Model) *models.ImproveRequest {
 	if source == nil {
 		return nil
@@ -231,7 +457,10 @@ func (service *serviceImpl) StorageToModel(source *improve_request_storage.Model
 		UserID:    source.UserID,
 		Title:     source.Title,
 		Content:   source.Content,
+		Language:  service.languages.Code(source.Language),
 		UpVotes:   source.UpVotes,
 		DownVotes: source.DownVotes,
+		Tags:      source.Tags,
+		DeletedAt: source.DeletedAt,
 	}
 }
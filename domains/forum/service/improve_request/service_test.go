@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"github.com/a-novel/agora-backend/framework"
+	"github.com/a-novel/agora-backend/framework/language"
 	"github.com/a-novel/agora-backend/framework/validation"
 	"github.com/a-novel/agora-backend/models"
 	"github.com/google/uuid"
@@ -15,6 +16,11 @@ import (
 var (
 	baseTime = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
 	fooErr   = errors.New("it broken")
+
+	languages = language.Languages{
+		Default: "fr",
+		Configs: map[string]string{"fr": "french", "en": "english"},
+	}
 )
 
 func TestImproveRequestService_Read(t *testing.T) {
@@ -38,6 +44,7 @@ func TestImproveRequestService_Read(t *testing.T) {
 				UserID:    test_utils.NumberUUID(100),
 				Title:     "Dummy post",
 				Content:   "Foo bar qux.",
+				Language:  "french",
 				UpVotes:   10,
 				DownVotes: 3,
 			},
@@ -48,6 +55,7 @@ func TestImproveRequestService_Read(t *testing.T) {
 				UserID:    test_utils.NumberUUID(100),
 				Title:     "Dummy post",
 				Content:   "Foo bar qux.",
+				Language:  "fr",
 				UpVotes:   10,
 				DownVotes: 3,
 			},
@@ -67,7 +75,7 @@ func TestImproveRequestService_Read(t *testing.T) {
 				On("Read", context.TODO(), d.id).
 				Return(d.getData, d.getError)
 
-			service := NewService(repository)
+			service := NewService(repository, languages)
 
 			res, err := service.Read(context.TODO(), d.id)
 			test_utils.RequireError(t, d.expectErr, err)
@@ -100,6 +108,7 @@ func TestImproveRequestService_ReadRevisions(t *testing.T) {
 					UserID:    test_utils.NumberUUID(100),
 					Title:     "Dummy post updated",
 					Content:   "Foo bar qux.",
+					Language:  "french",
 					UpVotes:   2,
 					DownVotes: 0,
 				},
@@ -110,6 +119,7 @@ func TestImproveRequestService_ReadRevisions(t *testing.T) {
 					UserID:    test_utils.NumberUUID(100),
 					Title:     "Dummy post",
 					Content:   "Foo bar qux.",
+					Language:  "french",
 					UpVotes:   10,
 					DownVotes: 3,
 				},
@@ -122,6 +132,7 @@ func TestImproveRequestService_ReadRevisions(t *testing.T) {
 					UserID:    test_utils.NumberUUID(100),
 					Title:     "Dummy post updated",
 					Content:   "Foo bar qux.",
+					Language:  "fr",
 					UpVotes:   2,
 					DownVotes: 0,
 				},
@@ -132,6 +143,7 @@ func TestImproveRequestService_ReadRevisions(t *testing.T) {
 					UserID:    test_utils.NumberUUID(100),
 					Title:     "Dummy post",
 					Content:   "Foo bar qux.",
+					Language:  "fr",
 					UpVotes:   10,
 					DownVotes: 3,
 				},
@@ -152,7 +164,7 @@ func TestImproveRequestService_ReadRevisions(t *testing.T) {
 				On("ReadRevisions", context.TODO(), d.id).
 				Return(d.getData, d.getError)
 
-			service := NewService(repository)
+			service := NewService(repository, languages)
 
 			res, err := service.ReadRevisions(context.TODO(), d.id)
 			test_utils.RequireError(t, d.expectErr, err)
@@ -167,28 +179,33 @@ func TestImproveRequestService_Create(t *testing.T) {
 	data := []struct {
 		name string
 
-		userID  uuid.UUID
-		title   string
-		content string
-		id      uuid.UUID
-		now     time.Time
+		userID   uuid.UUID
+		title    string
+		content  string
+		language string
+		tags     []uuid.UUID
+		id       uuid.UUID
+		now      time.Time
 
 		createData  *improve_request_storage.Model
 		createError error
 
+		shouldCallRepositoryWithLanguage string
+
 		shouldCallRepository bool
 
 		expect    *models.ImproveRequest
 		expectErr error
 	}{
 		{
-			name:                 "Success",
-			userID:               test_utils.NumberUUID(100),
-			title:                "Dummy post",
-			content:              "Foo bar qux.",
-			id:                   test_utils.NumberUUID(1),
-			now:                  baseTime,
-			shouldCallRepository: true,
+			name:                             "Success",
+			userID:                           test_utils.NumberUUID(100),
+			title:                            "Dummy post",
+			content:                          "Foo bar qux.",
+			id:                               test_utils.NumberUUID(1),
+			now:                              baseTime,
+			shouldCallRepository:             true,
+			shouldCallRepositoryWithLanguage: "french",
 			createData: &improve_request_storage.Model{
 				ID:        test_utils.NumberUUID(1),
 				Source:    test_utils.NumberUUID(1),
@@ -196,6 +213,7 @@ func TestImproveRequestService_Create(t *testing.T) {
 				UserID:    test_utils.NumberUUID(100),
 				Title:     "Dummy post",
 				Content:   "Foo bar qux.",
+				Language:  "french",
 			},
 			expect: &models.ImproveRequest{
 				ID:        test_utils.NumberUUID(1),
@@ -204,8 +222,117 @@ func TestImproveRequestService_Create(t *testing.T) {
 				UserID:    test_utils.NumberUUID(100),
 				Title:     "Dummy post",
 				Content:   "Foo bar qux.",
+				Language:  "fr",
 			},
 		},
+		{
+			name:                             "Success/Tags",
+			userID:                           test_utils.NumberUUID(100),
+			title:                            "Dummy post",
+			content:                          "Foo bar qux.",
+			tags:                             []uuid.UUID{test_utils.NumberUUID(10), test_utils.NumberUUID(11)},
+			id:                               test_utils.NumberUUID(1),
+			now:                              baseTime,
+			shouldCallRepository:             true,
+			shouldCallRepositoryWithLanguage: "french",
+			createData: &improve_request_storage.Model{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(100),
+				Title:     "Dummy post",
+				Content:   "Foo bar qux.",
+				Language:  "french",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(10), test_utils.NumberUUID(11)},
+			},
+			expect: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(100),
+				Title:     "Dummy post",
+				Content:   "Foo bar qux.",
+				Language:  "fr",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(10), test_utils.NumberUUID(11)},
+			},
+		},
+		{
+			name:                             "Success/Language",
+			userID:                           test_utils.NumberUUID(100),
+			title:                            "Dummy post",
+			content:                          "Foo bar qux.",
+			language:                         "en",
+			id:                               test_utils.NumberUUID(1),
+			now:                              baseTime,
+			shouldCallRepository:             true,
+			shouldCallRepositoryWithLanguage: "english",
+			createData: &improve_request_storage.Model{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(100),
+				Title:     "Dummy post",
+				Content:   "Foo bar qux.",
+				Language:  "english",
+			},
+			expect: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(100),
+				Title:     "Dummy post",
+				Content:   "Foo bar qux.",
+				Language:  "en",
+			},
+		},
+		{
+			name:                             "Success/DetectLanguage",
+			userID:                           test_utils.NumberUUID(100),
+			title:                            "The lost hero",
+			content:                          "The hero was lost in the woods, and he could not find his way back.",
+			id:                               test_utils.NumberUUID(1),
+			now:                              baseTime,
+			shouldCallRepository:             true,
+			shouldCallRepositoryWithLanguage: "english",
+			createData: &improve_request_storage.Model{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(100),
+				Title:     "The lost hero",
+				Content:   "The hero was lost in the woods, and he could not find his way back.",
+				Language:  "english",
+			},
+			expect: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(100),
+				Title:     "The lost hero",
+				Content:   "The hero was lost in the woods, and he could not find his way back.",
+				Language:  "en",
+			},
+		},
+		{
+			name:      "Error/UnsupportedLanguage",
+			userID:    test_utils.NumberUUID(100),
+			title:     "Dummy post",
+			content:   "Foo bar qux.",
+			language:  "de",
+			id:        test_utils.NumberUUID(1),
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:      "Error/TooManyTags",
+			userID:    test_utils.NumberUUID(100),
+			title:     "Dummy post",
+			content:   "Foo bar qux.",
+			tags:      make([]uuid.UUID, MaxTags+1),
+			id:        test_utils.NumberUUID(1),
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
 		{
 			name:      "Error/NoTitle",
 			userID:    test_utils.NumberUUID(100),
@@ -250,15 +377,16 @@ func TestImproveRequestService_Create(t *testing.T) {
 			expectErr: validation.ErrInvalidEntity,
 		},
 		{
-			name:                 "Error/RepositoryFailure",
-			userID:               test_utils.NumberUUID(100),
-			title:                "Dummy post",
-			content:              "Foo bar qux.",
-			id:                   test_utils.NumberUUID(1),
-			now:                  baseTime,
-			shouldCallRepository: true,
-			createError:          fooErr,
-			expectErr:            fooErr,
+			name:                             "Error/RepositoryFailure",
+			userID:                           test_utils.NumberUUID(100),
+			title:                            "Dummy post",
+			content:                          "Foo bar qux.",
+			id:                               test_utils.NumberUUID(1),
+			now:                              baseTime,
+			shouldCallRepository:             true,
+			shouldCallRepositoryWithLanguage: "french",
+			createError:                      fooErr,
+			expectErr:                        fooErr,
 		},
 	}
 
@@ -268,13 +396,13 @@ func TestImproveRequestService_Create(t *testing.T) {
 
 			if d.shouldCallRepository {
 				repository.
-					On("Create", context.TODO(), d.userID, d.title, d.content, d.id, d.now).
+					On("Create", context.TODO(), d.userID, d.title, d.content, d.shouldCallRepositoryWithLanguage, d.tags, d.id, d.now).
 					Return(d.createData, d.createError)
 			}
 
-			service := NewService(repository)
+			service := NewService(repository, languages)
 
-			res, err := service.Create(context.TODO(), d.userID, d.title, d.content, d.id, d.now)
+			res, err := service.Create(context.TODO(), d.userID, d.title, d.content, d.language, d.tags, d.id, d.now)
 			test_utils.RequireError(st, d.expectErr, err)
 			require.Equal(st, d.expect, res)
 
@@ -291,26 +419,31 @@ func TestImproveRequestService_CreateRevision(t *testing.T) {
 		sourceID uuid.UUID
 		title    string
 		content  string
+		language string
+		tags     []uuid.UUID
 		id       uuid.UUID
 		now      time.Time
 
 		createData  *improve_request_storage.Model
 		createError error
 
+		shouldCallRepositoryWithLanguage string
+
 		shouldCallRepository bool
 
 		expect    *models.ImproveRequest
 		expectErr error
 	}{
 		{
-			name:                 "Success",
-			userID:               test_utils.NumberUUID(100),
-			sourceID:             test_utils.NumberUUID(2),
-			title:                "Dummy post",
-			content:              "Foo bar qux.",
-			id:                   test_utils.NumberUUID(1),
-			now:                  baseTime,
-			shouldCallRepository: true,
+			name:                             "Success",
+			userID:                           test_utils.NumberUUID(100),
+			sourceID:                         test_utils.NumberUUID(2),
+			title:                            "Dummy post",
+			content:                          "Foo bar qux.",
+			id:                               test_utils.NumberUUID(1),
+			now:                              baseTime,
+			shouldCallRepository:             true,
+			shouldCallRepositoryWithLanguage: "french",
 			createData: &improve_request_storage.Model{
 				ID:        test_utils.NumberUUID(1),
 				Source:    test_utils.NumberUUID(1),
@@ -318,6 +451,7 @@ func TestImproveRequestService_CreateRevision(t *testing.T) {
 				UserID:    test_utils.NumberUUID(100),
 				Title:     "Dummy post",
 				Content:   "Foo bar qux.",
+				Language:  "french",
 			},
 			expect: &models.ImproveRequest{
 				ID:        test_utils.NumberUUID(1),
@@ -326,8 +460,31 @@ func TestImproveRequestService_CreateRevision(t *testing.T) {
 				UserID:    test_utils.NumberUUID(100),
 				Title:     "Dummy post",
 				Content:   "Foo bar qux.",
+				Language:  "fr",
 			},
 		},
+		{
+			name:      "Error/UnsupportedLanguage",
+			userID:    test_utils.NumberUUID(100),
+			sourceID:  test_utils.NumberUUID(1),
+			title:     "Dummy post",
+			content:   "Foo bar qux.",
+			language:  "de",
+			id:        test_utils.NumberUUID(2),
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:      "Error/TooManyTags",
+			userID:    test_utils.NumberUUID(100),
+			sourceID:  test_utils.NumberUUID(1),
+			title:     "Dummy post",
+			content:   "Foo bar qux.",
+			tags:      make([]uuid.UUID, MaxTags+1),
+			id:        test_utils.NumberUUID(2),
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
 		{
 			name:      "Error/NoTitle",
 			userID:    test_utils.NumberUUID(100),
@@ -377,16 +534,17 @@ func TestImproveRequestService_CreateRevision(t *testing.T) {
 			expectErr: validation.ErrInvalidEntity,
 		},
 		{
-			name:                 "Error/RepositoryFailure",
-			userID:               test_utils.NumberUUID(100),
-			sourceID:             test_utils.NumberUUID(2),
-			title:                "Dummy post",
-			content:              "Foo bar qux.",
-			id:                   test_utils.NumberUUID(1),
-			now:                  baseTime,
-			shouldCallRepository: true,
-			createError:          fooErr,
-			expectErr:            fooErr,
+			name:                             "Error/RepositoryFailure",
+			userID:                           test_utils.NumberUUID(100),
+			sourceID:                         test_utils.NumberUUID(2),
+			title:                            "Dummy post",
+			content:                          "Foo bar qux.",
+			id:                               test_utils.NumberUUID(1),
+			now:                              baseTime,
+			shouldCallRepository:             true,
+			shouldCallRepositoryWithLanguage: "french",
+			createError:                      fooErr,
+			expectErr:                        fooErr,
 		},
 	}
 
@@ -396,13 +554,13 @@ func TestImproveRequestService_CreateRevision(t *testing.T) {
 
 			if d.shouldCallRepository {
 				repository.
-					On("CreateRevision", context.TODO(), d.userID, d.sourceID, d.title, d.content, d.id, d.now).
+					On("CreateRevision", context.TODO(), d.userID, d.sourceID, d.title, d.content, d.shouldCallRepositoryWithLanguage, d.tags, d.id, d.now).
 					Return(d.createData, d.createError)
 			}
 
-			service := NewService(repository)
+			service := NewService(repository, languages)
 
-			res, err := service.CreateRevision(context.TODO(), d.userID, d.sourceID, d.title, d.content, d.id, d.now)
+			res, err := service.CreateRevision(context.TODO(), d.userID, d.sourceID, d.title, d.content, d.language, d.tags, d.id, d.now)
 			test_utils.RequireError(t, d.expectErr, err)
 			require.Equal(t, d.expect, res)
 
@@ -437,12 +595,12 @@ func TestImproveRequestService_Delete(t *testing.T) {
 			repository := improve_request_storage.NewMockRepository(t)
 
 			repository.
-				On("Delete", context.TODO(), d.id).
+				On("Delete", context.TODO(), d.id, baseTime).
 				Return(d.deleteError)
 
-			service := NewService(repository)
+			service := NewService(repository, languages)
 
-			err := service.Delete(context.TODO(), d.id)
+			err := service.Delete(context.TODO(), d.id, baseTime)
 			test_utils.RequireError(t, d.expectErr, err)
 
 			require.True(st, repository.AssertExpectations(t))
@@ -450,6 +608,152 @@ func TestImproveRequestService_Delete(t *testing.T) {
 	}
 }
 
+func TestImproveRequestService_ReadDeleted(t *testing.T) {
+	data := []struct {
+		name string
+
+		id       uuid.UUID
+		getData  *improve_request_storage.Model
+		getError error
+
+		expect    *models.ImproveRequest
+		expectErr error
+	}{
+		{
+			name: "Success",
+			id:   test_utils.NumberUUID(1),
+			getData: &improve_request_storage.Model{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(100),
+				Title:     "Dummy post",
+				Content:   "Foo bar qux.",
+				Language:  "french",
+				DeletedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+			},
+			expect: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(100),
+				Title:     "Dummy post",
+				Content:   "Foo bar qux.",
+				Language:  "fr",
+				DeletedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+			},
+		},
+		{
+			name:      "Error/RepositoryFailure",
+			id:        test_utils.NumberUUID(1),
+			getError:  fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := improve_request_storage.NewMockRepository(st)
+			repository.
+				On("ReadDeleted", context.TODO(), d.id).
+				Return(d.getData, d.getError)
+
+			service := NewService(repository, languages)
+
+			res, err := service.ReadDeleted(context.TODO(), d.id)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestImproveRequestService_Restore(t *testing.T) {
+	data := []struct {
+		name string
+
+		id           uuid.UUID
+		deletedAfter time.Time
+
+		restoreError error
+		expectErr    error
+	}{
+		{
+			name:         "Success",
+			id:           test_utils.NumberUUID(1),
+			deletedAfter: baseTime,
+		},
+		{
+			name:         "Error/RepositoryFailure",
+			id:           test_utils.NumberUUID(1),
+			deletedAfter: baseTime,
+			restoreError: fooErr,
+			expectErr:    fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := improve_request_storage.NewMockRepository(st)
+			repository.
+				On("Restore", context.TODO(), d.id, d.deletedAfter).
+				Return(d.restoreError)
+
+			service := NewService(repository, languages)
+
+			err := service.Restore(context.TODO(), d.id, d.deletedAfter)
+			test_utils.RequireError(st, d.expectErr, err)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestImproveRequestService_Purge(t *testing.T) {
+	data := []struct {
+		name string
+
+		deletedBefore time.Time
+
+		purgeData  int64
+		purgeError error
+
+		expect    int64
+		expectErr error
+	}{
+		{
+			name:          "Success",
+			deletedBefore: baseTime,
+			purgeData:     3,
+			expect:        3,
+		},
+		{
+			name:          "Error/RepositoryFailure",
+			deletedBefore: baseTime,
+			purgeError:    fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := improve_request_storage.NewMockRepository(st)
+			repository.
+				On("Purge", context.TODO(), d.deletedBefore).
+				Return(d.purgeData, d.purgeError)
+
+			service := NewService(repository, languages)
+
+			res, err := service.Purge(context.TODO(), d.deletedBefore)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
 func TestImproveRequestService_Search(t *testing.T) {
 	data := []struct {
 		name string
@@ -470,14 +774,24 @@ func TestImproveRequestService_Search(t *testing.T) {
 		{
 			name: "Success",
 			query: models.ImproveRequestSearch{
-				UserID: framework.ToPTR(test_utils.NumberUUID(1)),
-				Query:  "foo bar",
+				UserID:    framework.ToPTR(test_utils.NumberUUID(1)),
+				Query:     "foo bar",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(10)},
+				Reactions: []string{"moving"},
+				States:    []models.ImproveRequestState{models.ImproveRequestStateOpen, models.ImproveRequestStateClosed},
+				Language:  "en-US,en;q=0.9",
+				Order:     &models.ImproveRequestSearchOrder{Best: true, Hot: true},
 			},
 			limit:  10,
 			offset: 20,
 			shouldCallRepositoryWithQuery: improve_request_storage.SearchQuery{
-				UserID: framework.ToPTR(test_utils.NumberUUID(1)),
-				Query:  "foo bar",
+				UserID:    framework.ToPTR(test_utils.NumberUUID(1)),
+				Query:     "foo bar",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(10)},
+				Reactions: []string{"moving"},
+				States:    []string{"open", "closed"},
+				Language:  "english",
+				Order:     &improve_request_storage.SearchQueryOrder{Best: true, Hot: true},
 			},
 			searchData: []*improve_request_storage.Preview{
 				{
@@ -489,6 +803,8 @@ func TestImproveRequestService_Search(t *testing.T) {
 					Content:             "Foo bar qux.",
 					UpVotes:             10,
 					DownVotes:           5,
+					Tags:                []uuid.UUID{test_utils.NumberUUID(10)},
+					Reactions:           map[string]int64{"moving": 3},
 					MoreRecentRevisions: 1,
 					RevisionCount:       10,
 				},
@@ -516,6 +832,8 @@ func TestImproveRequestService_Search(t *testing.T) {
 					Content:             "Foo bar qux.",
 					UpVotes:             10,
 					DownVotes:           5,
+					Tags:                []uuid.UUID{test_utils.NumberUUID(10)},
+					Reactions:           map[string]int64{"moving": 3},
 					MoreRecentRevisions: 1,
 					RevisionCount:       10,
 				},
@@ -543,8 +861,9 @@ func TestImproveRequestService_Search(t *testing.T) {
 			limit:  10,
 			offset: 20,
 			shouldCallRepositoryWithQuery: improve_request_storage.SearchQuery{
-				UserID: framework.ToPTR(test_utils.NumberUUID(1)),
-				Query:  "foo bar",
+				UserID:   framework.ToPTR(test_utils.NumberUUID(1)),
+				Query:    "foo bar",
+				Language: "french",
 			},
 			searchError: fooErr,
 			expectErr:   fooErr,
@@ -559,7 +878,7 @@ func TestImproveRequestService_Search(t *testing.T) {
 				On("Search", context.TODO(), d.shouldCallRepositoryWithQuery, d.limit, d.offset).
 				Return(d.searchData, d.searchCount, d.searchError)
 
-			service := NewService(repository)
+			service := NewService(repository, languages)
 
 			res, count, err := service.Search(context.TODO(), d.query, d.limit, d.offset)
 			test_utils.RequireError(t, d.expectErr, err)
@@ -571,6 +890,323 @@ func TestImproveRequestService_Search(t *testing.T) {
 	}
 }
 
+func TestImproveRequestService_SearchAfter(t *testing.T) {
+	data := []struct {
+		name string
+
+		query  models.ImproveRequestSearch
+		cursor string
+		limit  int
+
+		shouldCallRepositoryWithQuery improve_request_storage.SearchQuery
+		searchData                    []*improve_request_storage.Preview
+		searchNext                    string
+		searchError                   error
+
+		expect     []*models.ImproveRequestPreview
+		expectNext string
+		expectErr  error
+	}{
+		{
+			name: "Success",
+			query: models.ImproveRequestSearch{
+				Query: "foo bar",
+				Order: &models.ImproveRequestSearchOrder{Score: true},
+			},
+			cursor: "cursor-1",
+			limit:  10,
+			shouldCallRepositoryWithQuery: improve_request_storage.SearchQuery{
+				Query:    "foo bar",
+				Language: "french",
+				Order:    &improve_request_storage.SearchQueryOrder{Score: true},
+			},
+			searchData: []*improve_request_storage.Preview{
+				{
+					ID:            test_utils.NumberUUID(1),
+					Source:        test_utils.NumberUUID(2),
+					CreatedAt:     baseTime,
+					UserID:        test_utils.NumberUUID(1),
+					Title:         "Dummy post",
+					Content:       "Foo bar qux.",
+					UpVotes:       10,
+					DownVotes:     5,
+					RevisionCount: 1,
+				},
+			},
+			searchNext: "cursor-2",
+			expect: []*models.ImproveRequestPreview{
+				{
+					ID:            test_utils.NumberUUID(1),
+					Source:        test_utils.NumberUUID(2),
+					CreatedAt:     baseTime,
+					UserID:        test_utils.NumberUUID(1),
+					Title:         "Dummy post",
+					Content:       "Foo bar qux.",
+					UpVotes:       10,
+					DownVotes:     5,
+					RevisionCount: 1,
+				},
+			},
+			expectNext: "cursor-2",
+		},
+		{
+			name:   "Error/RepositoryFailure",
+			cursor: "cursor-1",
+			limit:  10,
+			shouldCallRepositoryWithQuery: improve_request_storage.SearchQuery{
+				Language: "french",
+			},
+			searchError: fooErr,
+			expectErr:   fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := improve_request_storage.NewMockRepository(t)
+
+			repository.
+				On("SearchAfter", context.TODO(), d.shouldCallRepositoryWithQuery, d.cursor, d.limit).
+				Return(d.searchData, d.searchNext, d.searchError)
+
+			service := NewService(repository, languages)
+
+			res, next, err := service.SearchAfter(context.TODO(), d.query, d.cursor, d.limit)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.EqualValues(t, d.expect, res)
+			require.Equal(t, d.expectNext, next)
+
+			require.True(st, repository.AssertExpectations(t))
+		})
+	}
+}
+
+func TestImproveRequestService_Facets(t *testing.T) {
+	data := []struct {
+		name string
+
+		query models.ImproveRequestSearch
+
+		shouldCallRepository          bool
+		shouldCallRepositoryWithQuery improve_request_storage.SearchQuery
+		facetsData                    *improve_request_storage.Facets
+		facetsError                   error
+
+		expect    *models.ImproveRequestSearchFacets
+		expectErr error
+	}{
+		{
+			name: "Success",
+			query: models.ImproveRequestSearch{
+				Query: "foo bar",
+				Tags:  []uuid.UUID{test_utils.NumberUUID(10)},
+			},
+			shouldCallRepository: true,
+			shouldCallRepositoryWithQuery: improve_request_storage.SearchQuery{
+				Query:    "foo bar",
+				Tags:     []uuid.UUID{test_utils.NumberUUID(10)},
+				Language: "french",
+			},
+			facetsData: &improve_request_storage.Facets{
+				Tags: []*improve_request_storage.TagFacet{
+					{
+						ID:       test_utils.NumberUUID(10),
+						Category: tags_storage.CategoryGenre,
+						Slug:     "fantasy",
+						Name:     "Fantasy",
+						Count:    12,
+					},
+					{
+						ID:       test_utils.NumberUUID(11),
+						Category: tags_storage.CategoryPOV,
+						Slug:     "first-person",
+						Name:     "First person",
+						Count:    3,
+					},
+				},
+				Authors: []*improve_request_storage.AuthorFacet{
+					{UserID: test_utils.NumberUUID(1), Count: 10},
+					{UserID: test_utils.NumberUUID(2), Count: 5},
+				},
+				Accepted:  improve_request_storage.AcceptedFacet{With: 4, Without: 11},
+				CreatedAt: improve_request_storage.CreatedAtFacet{LastDay: 1, LastWeek: 2, LastMonth: 3, LastYear: 4, Older: 5},
+				Revisions: improve_request_storage.RevisionsFacet{Single: 8, Few: 6, Many: 1},
+			},
+			expect: &models.ImproveRequestSearchFacets{
+				Tags: []*models.ImproveRequestTagFacet{
+					{
+						ID:       test_utils.NumberUUID(10),
+						Category: models.ForumTagCategoryGenre,
+						Slug:     "fantasy",
+						Name:     "Fantasy",
+						Count:    12,
+					},
+					{
+						ID:       test_utils.NumberUUID(11),
+						Category: models.ForumTagCategoryPOV,
+						Slug:     "first-person",
+						Name:     "First person",
+						Count:    3,
+					},
+				},
+				Authors: []*models.ImproveRequestAuthorFacet{
+					{UserID: test_utils.NumberUUID(1), Count: 10},
+					{UserID: test_utils.NumberUUID(2), Count: 5},
+				},
+				Accepted:  models.ImproveRequestAcceptedFacet{With: 4, Without: 11},
+				CreatedAt: models.ImproveRequestCreatedAtFacet{LastDay: 1, LastWeek: 2, LastMonth: 3, LastYear: 4, Older: 5},
+				Revisions: models.ImproveRequestRevisionsFacet{Single: 8, Few: 6, Many: 1},
+			},
+		},
+		{
+			name: "Error/TooManyTags",
+			query: models.ImproveRequestSearch{
+				Tags: make([]uuid.UUID, MaxTags+1),
+			},
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name: "Error/TooManyReactions",
+			query: models.ImproveRequestSearch{
+				Reactions: make([]string, MaxReactions+1),
+			},
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name: "Error/RepositoryFailure",
+			query: models.ImproveRequestSearch{
+				Query: "foo bar",
+			},
+			shouldCallRepository: true,
+			shouldCallRepositoryWithQuery: improve_request_storage.SearchQuery{
+				Query:    "foo bar",
+				Language: "french",
+			},
+			facetsError: fooErr,
+			expectErr:   fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := improve_request_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("Facets", context.TODO(), d.shouldCallRepositoryWithQuery, baseTime).
+					Return(d.facetsData, d.facetsError)
+			}
+
+			service := NewService(repository, languages)
+
+			res, err := service.Facets(context.TODO(), d.query, baseTime)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestImproveRequestService_Suggest(t *testing.T) {
+	data := []struct {
+		name string
+
+		query string
+		limit int
+
+		shouldCallRepository bool
+		suggestData          []string
+		suggestError         error
+
+		expect    []string
+		expectErr error
+	}{
+		{
+			name:                 "Success",
+			query:                "fascinasion",
+			limit:                5,
+			shouldCallRepository: true,
+			suggestData:          []string{"Fascination étrange"},
+			expect:               []string{"Fascination étrange"},
+		},
+		{
+			name:      "Error/NoQuery",
+			limit:     5,
+			expectErr: validation.ErrNil,
+		},
+		{
+			name:      "Error/LimitTooHigh",
+			query:     "fascinasion",
+			limit:     MaxSuggestLimit + 1,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			query:                "fascinasion",
+			limit:                5,
+			shouldCallRepository: true,
+			suggestError:         fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := improve_request_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("Suggest", context.TODO(), d.query, d.limit).
+					Return(d.suggestData, d.suggestError)
+			}
+
+			service := NewService(repository, languages)
+
+			res, err := service.Suggest(context.TODO(), d.query, d.limit)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestImproveRequestService_RefreshRankings(t *testing.T) {
+	data := []struct {
+		name string
+
+		refreshError error
+		expectErr    error
+	}{
+		{
+			name: "Success",
+		},
+		{
+			name:         "Error/RepositoryFailure",
+			refreshError: fooErr,
+			expectErr:    fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := improve_request_storage.NewMockRepository(st)
+
+			repository.
+				On("RefreshRankings", context.TODO()).
+				Return(d.refreshError)
+
+			service := NewService(repository, languages)
+
+			err := service.RefreshRankings(context.TODO())
+			test_utils.RequireError(st, d.expectErr, err)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
 func TestImproveRequestService_IsCreator(t *testing.T) {
 	data := []struct {
 		name string
@@ -610,7 +1246,7 @@ func TestImproveRequestService_IsCreator(t *testing.T) {
 				On("IsCreator", context.TODO(), d.userID, d.id, d.strict).
 				Return(d.getData, d.getError)
 
-			service := NewService(repository)
+			service := NewService(repository, languages)
 
 			res, err := service.IsCreator(context.TODO(), d.userID, d.id, d.strict)
 			test_utils.RequireError(t, d.expectErr, err)
@@ -625,7 +1261,8 @@ func TestImproveRequestService_GetPreviews(t *testing.T) {
 	data := []struct {
 		name string
 
-		ids []uuid.UUID
+		ids      []uuid.UUID
+		viewerID *uuid.UUID
 
 		searchData  []*improve_request_storage.Preview
 		searchError error
@@ -634,7 +1271,8 @@ func TestImproveRequestService_GetPreviews(t *testing.T) {
 		expectErr error
 	}{
 		{
-			name: "Success",
+			name:     "Success",
+			viewerID: framework.ToPTR(test_utils.NumberUUID(10)),
 			ids: []uuid.UUID{
 				test_utils.NumberUUID(1),
 				test_utils.NumberUUID(2),
@@ -708,12 +1346,101 @@ func TestImproveRequestService_GetPreviews(t *testing.T) {
 			repository := improve_request_storage.NewMockRepository(t)
 
 			repository.
-				On("GetPreviews", context.TODO(), d.ids).
+				On("GetPreviews", context.TODO(), d.ids, d.viewerID).
 				Return(d.searchData, d.searchError)
 
-			service := NewService(repository)
+			service := NewService(repository, languages)
+
+			res, err := service.GetPreviews(context.TODO(), d.ids, d.viewerID)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.EqualValues(t, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(t))
+		})
+	}
+}
+
+func TestImproveRequestService_Related(t *testing.T) {
+	data := []struct {
+		name string
+
+		id    uuid.UUID
+		limit int
+
+		shouldCallRepository bool
+		repositoryData       []*improve_request_storage.Preview
+		repositoryErr        error
+
+		expect    []*models.ImproveRequestPreview
+		expectErr error
+	}{
+		{
+			name:                 "Success",
+			id:                   test_utils.NumberUUID(1),
+			limit:                10,
+			shouldCallRepository: true,
+			repositoryData: []*improve_request_storage.Preview{
+				{
+					ID:            test_utils.NumberUUID(42),
+					Source:        test_utils.NumberUUID(666),
+					CreatedAt:     baseTime,
+					UserID:        test_utils.NumberUUID(12),
+					Title:         "Smart post",
+					Content:       "Cats taking a nap.",
+					UpVotes:       4,
+					DownVotes:     1,
+					RevisionCount: 4,
+				},
+			},
+			expect: []*models.ImproveRequestPreview{
+				{
+					ID:            test_utils.NumberUUID(42),
+					Source:        test_utils.NumberUUID(666),
+					CreatedAt:     baseTime,
+					UserID:        test_utils.NumberUUID(12),
+					Title:         "Smart post",
+					Content:       "Cats taking a nap.",
+					UpVotes:       4,
+					DownVotes:     1,
+					RevisionCount: 4,
+				},
+			},
+		},
+		{
+			name:      "Error/LimitTooLow",
+			id:        test_utils.NumberUUID(1),
+			limit:     0,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:      "Error/LimitTooHigh",
+			id:        test_utils.NumberUUID(1),
+			limit:     MaxRelatedLimit + 1,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			id:                   test_utils.NumberUUID(1),
+			limit:                10,
+			shouldCallRepository: true,
+			repositoryErr:        fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := improve_request_storage.NewMockRepository(t)
+
+			if d.shouldCallRepository {
+				repository.
+					On("Related", context.TODO(), d.id, d.limit, baseTime).
+					Return(d.repositoryData, d.repositoryErr)
+			}
+
+			service := NewService(repository, languages)
 
-			res, err := service.GetPreviews(context.TODO(), d.ids)
+			res, err := service.Related(context.TODO(), d.id, d.limit, baseTime)
 			test_utils.RequireError(t, d.expectErr, err)
 			require.EqualValues(t, d.expect, res)
 
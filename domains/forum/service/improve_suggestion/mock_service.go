@@ -4,10 +4,12 @@ package improve_suggestion_service
 
 import (
 	context "context"
+
 	"github.com/a-novel/agora-backend/domains/forum/storage/improve_suggestion"
+	mock "github.com/stretchr/testify/mock"
+
 	"github.com/a-novel/agora-backend/models"
 
-	mock "github.com/stretchr/testify/mock"
 	time "time"
 
 	uuid "github.com/google/uuid"
@@ -26,25 +28,25 @@ func (_m *MockService) EXPECT() *MockService_Expecter {
 	return &MockService_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: ctx, data, userID, sourceID, id, now
-func (_m *MockService) Create(ctx context.Context, data *models.ImproveSuggestionUpsert, userID uuid.UUID, sourceID uuid.UUID, id uuid.UUID, now time.Time) (*models.ImproveSuggestion, error) {
-	ret := _m.Called(ctx, data, userID, sourceID, id, now)
+// Create provides a mock function with given fields: ctx, data, userID, sourceID, id, revisionID, now
+func (_m *MockService) Create(ctx context.Context, data *models.ImproveSuggestionUpsert, userID uuid.UUID, sourceID uuid.UUID, id uuid.UUID, revisionID uuid.UUID, now time.Time) (*models.ImproveSuggestion, error) {
+	ret := _m.Called(ctx, data, userID, sourceID, id, revisionID, now)
 
 	var r0 *models.ImproveSuggestion
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.ImproveSuggestionUpsert, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) (*models.ImproveSuggestion, error)); ok {
-		return rf(ctx, data, userID, sourceID, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ImproveSuggestionUpsert, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) (*models.ImproveSuggestion, error)); ok {
+		return rf(ctx, data, userID, sourceID, id, revisionID, now)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *models.ImproveSuggestionUpsert, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) *models.ImproveSuggestion); ok {
-		r0 = rf(ctx, data, userID, sourceID, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ImproveSuggestionUpsert, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) *models.ImproveSuggestion); ok {
+		r0 = rf(ctx, data, userID, sourceID, id, revisionID, now)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.ImproveSuggestion)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *models.ImproveSuggestionUpsert, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) error); ok {
-		r1 = rf(ctx, data, userID, sourceID, id, now)
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ImproveSuggestionUpsert, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, data, userID, sourceID, id, revisionID, now)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,18 +61,19 @@ type MockService_Create_Call struct {
 
 // Create is a helper method to define mock.On call
 //   - ctx context.Context
-//   - data *ImproveSuggestionUpsert
+//   - data *models.ImproveSuggestionUpsert
 //   - userID uuid.UUID
 //   - sourceID uuid.UUID
 //   - id uuid.UUID
+//   - revisionID uuid.UUID
 //   - now time.Time
-func (_e *MockService_Expecter) Create(ctx interface{}, data interface{}, userID interface{}, sourceID interface{}, id interface{}, now interface{}) *MockService_Create_Call {
-	return &MockService_Create_Call{Call: _e.mock.On("Create", ctx, data, userID, sourceID, id, now)}
+func (_e *MockService_Expecter) Create(ctx interface{}, data interface{}, userID interface{}, sourceID interface{}, id interface{}, revisionID interface{}, now interface{}) *MockService_Create_Call {
+	return &MockService_Create_Call{Call: _e.mock.On("Create", ctx, data, userID, sourceID, id, revisionID, now)}
 }
 
-func (_c *MockService_Create_Call) Run(run func(ctx context.Context, data *models.ImproveSuggestionUpsert, userID uuid.UUID, sourceID uuid.UUID, id uuid.UUID, now time.Time)) *MockService_Create_Call {
+func (_c *MockService_Create_Call) Run(run func(ctx context.Context, data *models.ImproveSuggestionUpsert, userID uuid.UUID, sourceID uuid.UUID, id uuid.UUID, revisionID uuid.UUID, now time.Time)) *MockService_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*models.ImproveSuggestionUpsert), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(uuid.UUID), args[5].(time.Time))
+		run(args[0].(context.Context), args[1].(*models.ImproveSuggestionUpsert), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(uuid.UUID), args[5].(uuid.UUID), args[6].(time.Time))
 	})
 	return _c
 }
@@ -80,18 +83,18 @@ func (_c *MockService_Create_Call) Return(_a0 *models.ImproveSuggestion, _a1 err
 	return _c
 }
 
-func (_c *MockService_Create_Call) RunAndReturn(run func(context.Context, *models.ImproveSuggestionUpsert, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) (*models.ImproveSuggestion, error)) *MockService_Create_Call {
+func (_c *MockService_Create_Call) RunAndReturn(run func(context.Context, *models.ImproveSuggestionUpsert, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) (*models.ImproveSuggestion, error)) *MockService_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: ctx, id
-func (_m *MockService) Delete(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// Delete provides a mock function with given fields: ctx, id, now
+func (_m *MockService) Delete(ctx context.Context, id uuid.UUID, now time.Time) error {
+	ret := _m.Called(ctx, id, now)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, id, now)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -107,13 +110,14 @@ type MockService_Delete_Call struct {
 // Delete is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-func (_e *MockService_Expecter) Delete(ctx interface{}, id interface{}) *MockService_Delete_Call {
-	return &MockService_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+//   - now time.Time
+func (_e *MockService_Expecter) Delete(ctx interface{}, id interface{}, now interface{}) *MockService_Delete_Call {
+	return &MockService_Delete_Call{Call: _e.mock.On("Delete", ctx, id, now)}
 }
 
-func (_c *MockService_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockService_Delete_Call {
+func (_c *MockService_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID, now time.Time)) *MockService_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
 	})
 	return _c
 }
@@ -123,7 +127,7 @@ func (_c *MockService_Delete_Call) Return(_a0 error) *MockService_Delete_Call {
 	return _c
 }
 
-func (_c *MockService_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockService_Delete_Call {
+func (_c *MockService_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) error) *MockService_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -277,7 +281,7 @@ type MockService_List_Call struct {
 
 // List is a helper method to define mock.On call
 //   - ctx context.Context
-//   - query ImproveSuggestionsList
+//   - query models.ImproveSuggestionsList
 //   - limit int
 //   - offset int
 func (_e *MockService_Expecter) List(ctx interface{}, query interface{}, limit interface{}, offset interface{}) *MockService_List_Call {
@@ -301,6 +305,123 @@ func (_c *MockService_List_Call) RunAndReturn(run func(context.Context, models.I
 	return _c
 }
 
+// ListAfter provides a mock function with given fields: ctx, query, cursor, limit
+func (_m *MockService) ListAfter(ctx context.Context, query models.ImproveSuggestionsList, cursor string, limit int) ([]*models.ImproveSuggestion, string, error) {
+	ret := _m.Called(ctx, query, cursor, limit)
+
+	var r0 []*models.ImproveSuggestion
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ImproveSuggestionsList, string, int) ([]*models.ImproveSuggestion, string, error)); ok {
+		return rf(ctx, query, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ImproveSuggestionsList, string, int) []*models.ImproveSuggestion); ok {
+		r0 = rf(ctx, query, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ImproveSuggestion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ImproveSuggestionsList, string, int) string); ok {
+		r1 = rf(ctx, query, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, models.ImproveSuggestionsList, string, int) error); ok {
+		r2 = rf(ctx, query, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockService_ListAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAfter'
+type MockService_ListAfter_Call struct {
+	*mock.Call
+}
+
+// ListAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query models.ImproveSuggestionsList
+//   - cursor string
+//   - limit int
+func (_e *MockService_Expecter) ListAfter(ctx interface{}, query interface{}, cursor interface{}, limit interface{}) *MockService_ListAfter_Call {
+	return &MockService_ListAfter_Call{Call: _e.mock.On("ListAfter", ctx, query, cursor, limit)}
+}
+
+func (_c *MockService_ListAfter_Call) Run(run func(ctx context.Context, query models.ImproveSuggestionsList, cursor string, limit int)) *MockService_ListAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ImproveSuggestionsList), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockService_ListAfter_Call) Return(_a0 []*models.ImproveSuggestion, _a1 string, _a2 error) *MockService_ListAfter_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockService_ListAfter_Call) RunAndReturn(run func(context.Context, models.ImproveSuggestionsList, string, int) ([]*models.ImproveSuggestion, string, error)) *MockService_ListAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function with given fields: ctx, deletedBefore
+func (_m *MockService) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	ret := _m.Called(ctx, deletedBefore)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, deletedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, deletedBefore)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, deletedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockService_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deletedBefore time.Time
+func (_e *MockService_Expecter) Purge(ctx interface{}, deletedBefore interface{}) *MockService_Purge_Call {
+	return &MockService_Purge_Call{Call: _e.mock.On("Purge", ctx, deletedBefore)}
+}
+
+func (_c *MockService_Purge_Call) Run(run func(ctx context.Context, deletedBefore time.Time)) *MockService_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Purge_Call) Return(_a0 int64, _a1 error) *MockService_Purge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Purge_Call) RunAndReturn(run func(context.Context, time.Time) (int64, error)) *MockService_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Read provides a mock function with given fields: ctx, id
 func (_m *MockService) Read(ctx context.Context, id uuid.UUID) (*models.ImproveSuggestion, error) {
 	ret := _m.Called(ctx, id)
@@ -356,6 +477,172 @@ func (_c *MockService_Read_Call) RunAndReturn(run func(context.Context, uuid.UUI
 	return _c
 }
 
+// ReadDeleted provides a mock function with given fields: ctx, id
+func (_m *MockService) ReadDeleted(ctx context.Context, id uuid.UUID) (*models.ImproveSuggestion, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *models.ImproveSuggestion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.ImproveSuggestion, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.ImproveSuggestion); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveSuggestion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ReadDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDeleted'
+type MockService_ReadDeleted_Call struct {
+	*mock.Call
+}
+
+// ReadDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockService_Expecter) ReadDeleted(ctx interface{}, id interface{}) *MockService_ReadDeleted_Call {
+	return &MockService_ReadDeleted_Call{Call: _e.mock.On("ReadDeleted", ctx, id)}
+}
+
+func (_c *MockService_ReadDeleted_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockService_ReadDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_ReadDeleted_Call) Return(_a0 *models.ImproveSuggestion, _a1 error) *MockService_ReadDeleted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ReadDeleted_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.ImproveSuggestion, error)) *MockService_ReadDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadRevisions provides a mock function with given fields: ctx, id
+func (_m *MockService) ReadRevisions(ctx context.Context, id uuid.UUID) ([]*models.ImproveSuggestionRevision, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 []*models.ImproveSuggestionRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*models.ImproveSuggestionRevision, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*models.ImproveSuggestionRevision); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ImproveSuggestionRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ReadRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadRevisions'
+type MockService_ReadRevisions_Call struct {
+	*mock.Call
+}
+
+// ReadRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockService_Expecter) ReadRevisions(ctx interface{}, id interface{}) *MockService_ReadRevisions_Call {
+	return &MockService_ReadRevisions_Call{Call: _e.mock.On("ReadRevisions", ctx, id)}
+}
+
+func (_c *MockService_ReadRevisions_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockService_ReadRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_ReadRevisions_Call) Return(_a0 []*models.ImproveSuggestionRevision, _a1 error) *MockService_ReadRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ReadRevisions_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*models.ImproveSuggestionRevision, error)) *MockService_ReadRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, id, deletedAfter
+func (_m *MockService) Restore(ctx context.Context, id uuid.UUID, deletedAfter time.Time) (*models.ImproveSuggestion, error) {
+	ret := _m.Called(ctx, id, deletedAfter)
+
+	var r0 *models.ImproveSuggestion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) (*models.ImproveSuggestion, error)); ok {
+		return rf(ctx, id, deletedAfter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) *models.ImproveSuggestion); ok {
+		r0 = rf(ctx, id, deletedAfter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveSuggestion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, id, deletedAfter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockService_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - deletedAfter time.Time
+func (_e *MockService_Expecter) Restore(ctx interface{}, id interface{}, deletedAfter interface{}) *MockService_Restore_Call {
+	return &MockService_Restore_Call{Call: _e.mock.On("Restore", ctx, id, deletedAfter)}
+}
+
+func (_c *MockService_Restore_Call) Run(run func(ctx context.Context, id uuid.UUID, deletedAfter time.Time)) *MockService_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Restore_Call) Return(_a0 *models.ImproveSuggestion, _a1 error) *MockService_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Restore_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) (*models.ImproveSuggestion, error)) *MockService_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StorageToModel provides a mock function with given fields: source
 func (_m *MockService) StorageToModel(source *improve_suggestion_storage.Model) *models.ImproveSuggestion {
 	ret := _m.Called(source)
@@ -400,25 +687,25 @@ func (_c *MockService_StorageToModel_Call) RunAndReturn(run func(*improve_sugges
 	return _c
 }
 
-// Update provides a mock function with given fields: ctx, data, id, now
-func (_m *MockService) Update(ctx context.Context, data *models.ImproveSuggestionUpsert, id uuid.UUID, now time.Time) (*models.ImproveSuggestion, error) {
-	ret := _m.Called(ctx, data, id, now)
+// Update provides a mock function with given fields: ctx, data, id, revisionID, now
+func (_m *MockService) Update(ctx context.Context, data *models.ImproveSuggestionUpsert, id uuid.UUID, revisionID uuid.UUID, now time.Time) (*models.ImproveSuggestion, error) {
+	ret := _m.Called(ctx, data, id, revisionID, now)
 
 	var r0 *models.ImproveSuggestion
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.ImproveSuggestionUpsert, uuid.UUID, time.Time) (*models.ImproveSuggestion, error)); ok {
-		return rf(ctx, data, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ImproveSuggestionUpsert, uuid.UUID, uuid.UUID, time.Time) (*models.ImproveSuggestion, error)); ok {
+		return rf(ctx, data, id, revisionID, now)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *models.ImproveSuggestionUpsert, uuid.UUID, time.Time) *models.ImproveSuggestion); ok {
-		r0 = rf(ctx, data, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ImproveSuggestionUpsert, uuid.UUID, uuid.UUID, time.Time) *models.ImproveSuggestion); ok {
+		r0 = rf(ctx, data, id, revisionID, now)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.ImproveSuggestion)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *models.ImproveSuggestionUpsert, uuid.UUID, time.Time) error); ok {
-		r1 = rf(ctx, data, id, now)
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ImproveSuggestionUpsert, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, data, id, revisionID, now)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -433,16 +720,17 @@ type MockService_Update_Call struct {
 
 // Update is a helper method to define mock.On call
 //   - ctx context.Context
-//   - data *ImproveSuggestionUpsert
+//   - data *models.ImproveSuggestionUpsert
 //   - id uuid.UUID
+//   - revisionID uuid.UUID
 //   - now time.Time
-func (_e *MockService_Expecter) Update(ctx interface{}, data interface{}, id interface{}, now interface{}) *MockService_Update_Call {
-	return &MockService_Update_Call{Call: _e.mock.On("Update", ctx, data, id, now)}
+func (_e *MockService_Expecter) Update(ctx interface{}, data interface{}, id interface{}, revisionID interface{}, now interface{}) *MockService_Update_Call {
+	return &MockService_Update_Call{Call: _e.mock.On("Update", ctx, data, id, revisionID, now)}
 }
 
-func (_c *MockService_Update_Call) Run(run func(ctx context.Context, data *models.ImproveSuggestionUpsert, id uuid.UUID, now time.Time)) *MockService_Update_Call {
+func (_c *MockService_Update_Call) Run(run func(ctx context.Context, data *models.ImproveSuggestionUpsert, id uuid.UUID, revisionID uuid.UUID, now time.Time)) *MockService_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*models.ImproveSuggestionUpsert), args[2].(uuid.UUID), args[3].(time.Time))
+		run(args[0].(context.Context), args[1].(*models.ImproveSuggestionUpsert), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(time.Time))
 	})
 	return _c
 }
@@ -452,7 +740,7 @@ func (_c *MockService_Update_Call) Return(_a0 *models.ImproveSuggestion, _a1 err
 	return _c
 }
 
-func (_c *MockService_Update_Call) RunAndReturn(run func(context.Context, *models.ImproveSuggestionUpsert, uuid.UUID, time.Time) (*models.ImproveSuggestion, error)) *MockService_Update_Call {
+func (_c *MockService_Update_Call) RunAndReturn(run func(context.Context, *models.ImproveSuggestionUpsert, uuid.UUID, uuid.UUID, time.Time) (*models.ImproveSuggestion, error)) *MockService_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
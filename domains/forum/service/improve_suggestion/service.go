@@ -3,6 +3,7 @@ package improve_suggestion_service
 import (
 	"context"
 	"fmt"
+	"github.com/a-novel/agora-backend/framework/language"
 	"github.com/a-novel/agora-backend/framework/validation"
 	"github.com/a-novel/agora-backend/models"
 	"github.com/google/uuid"
@@ -26,12 +27,23 @@ const (
 type Service interface {
 	// Read returns the improvement suggestion with the given ID.
 	Read(ctx context.Context, id uuid.UUID) (*models.ImproveSuggestion, error)
-	// Create creates a new improvement suggestion for a given improvement request revision.
-	Create(ctx context.Context, data *models.ImproveSuggestionUpsert, userID, sourceID, id uuid.UUID, now time.Time) (*models.ImproveSuggestion, error)
-	// Update updates an existing improvement suggestion.
-	Update(ctx context.Context, data *models.ImproveSuggestionUpsert, id uuid.UUID, now time.Time) (*models.ImproveSuggestion, error)
-	// Delete deletes an existing improvement suggestion.
-	Delete(ctx context.Context, id uuid.UUID) error
+	// ReadRevisions returns every revision of the improvement suggestion with the given ID, most recent first.
+	ReadRevisions(ctx context.Context, id uuid.UUID) ([]*models.ImproveSuggestionRevision, error)
+	// Create creates a new improvement suggestion for a given improvement request revision. The initial content is
+	// saved as the first revision of the suggestion, under revisionID.
+	Create(ctx context.Context, data *models.ImproveSuggestionUpsert, userID, sourceID, id, revisionID uuid.UUID, now time.Time) (*models.ImproveSuggestion, error)
+	// Update updates an existing improvement suggestion. The new content is saved as a new revision, under
+	// revisionID.
+	Update(ctx context.Context, data *models.ImproveSuggestionUpsert, id, revisionID uuid.UUID, now time.Time) (*models.ImproveSuggestion, error)
+	// Delete deletes an existing improvement suggestion. Deleted suggestions can be restored until they are purged.
+	Delete(ctx context.Context, id uuid.UUID, now time.Time) error
+	// ReadDeleted returns the deleted improvement suggestion with the given ID.
+	ReadDeleted(ctx context.Context, id uuid.UUID) (*models.ImproveSuggestion, error)
+	// Restore restores an improvement suggestion deleted after the given time.
+	Restore(ctx context.Context, id uuid.UUID, deletedAfter time.Time) (*models.ImproveSuggestion, error)
+	// Purge permanently removes the suggestions deleted before the given time. It returns the number of removed
+	// suggestions.
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
 
 	// Validate validates an existing improvement suggestion.
 	Validate(ctx context.Context, validated bool, id uuid.UUID) (*models.ImproveSuggestion, error)
@@ -40,6 +52,9 @@ type Service interface {
 	// the limit and offset parameters.
 	// It also returns the total number of available results, to help with pagination.
 	List(ctx context.Context, query models.ImproveSuggestionsList, limit, offset int) ([]*models.ImproveSuggestion, int64, error)
+	// ListAfter returns the suggestions matching the query that come after the cursor, in the same order as List.
+	// An empty cursor returns the first page. It also returns the cursor of the next page, empty on the last page.
+	ListAfter(ctx context.Context, query models.ImproveSuggestionsList, cursor string, limit int) ([]*models.ImproveSuggestion, string, error)
 
 	// IsCreator returns whether the user is the creator of the improvement suggestion.
 	IsCreator(ctx context.Context, userID, postID uuid.UUID) (bool, error)
@@ -52,12 +67,13 @@ type Service interface {
 
 type serviceImpl struct {
 	repository improve_suggestion_storage.Repository
+	languages  language.Languages
 }
 
-// NewService returns a new Service instance.
+// NewService returns a new Service instance. Languages are used to parse search queries.
 // To use a mocked one, call NewMockService.
-func NewService(repository improve_suggestion_storage.Repository) Service {
-	return &serviceImpl{repository: repository}
+func NewService(repository improve_suggestion_storage.Repository, languages language.Languages) Service {
+	return &serviceImpl{repository: repository, languages: languages}
 }
 
 func (service *serviceImpl) Read(ctx context.Context, id uuid.UUID) (*models.ImproveSuggestion, error) {
@@ -69,7 +85,30 @@ func (service *serviceImpl) Read(ctx context.Context, id uuid.UUID) (*models.Imp
 	return service.StorageToModel(storageModel), nil
 }
 
-func (service *serviceImpl) Create(ctx context.Context, data *models.ImproveSuggestionUpsert, userID, sourceID, id uuid.UUID, now time.Time) (*models.ImproveSuggestion, error) {
+func (service *serviceImpl) ReadRevisions(ctx context.Context, id uuid.UUID) ([]*models.ImproveSuggestionRevision, error) {
+	storageModels, err := service.repository.ReadRevisions(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get improve suggestion revisions: %w", err)
+	}
+
+	revisions := make([]*models.ImproveSuggestionRevision, len(storageModels))
+	for i, storageModel := range storageModels {
+		revisions[i] = &models.ImproveSuggestionRevision{
+			ID:           storageModel.ID,
+			SuggestionID: storageModel.SuggestionID,
+			CreatedAt:    storageModel.CreatedAt,
+			UpVotes:      storageModel.UpVotes,
+			DownVotes:    storageModel.DownVotes,
+			RequestID:    storageModel.RequestID,
+			Title:        storageModel.Title,
+			Content:      storageModel.Content,
+		}
+	}
+
+	return revisions, nil
+}
+
+func (service *serviceImpl) Create(ctx context.Context, data *models.ImproveSuggestionUpsert, userID, sourceID, id, revisionID uuid.UUID, now time.Time) (*models.ImproveSuggestion, error) {
 	if err := validation.CheckRequire("data", data); err != nil {
 		return nil, err
 	}
@@ -93,7 +132,7 @@ func (service *serviceImpl) Create(ctx context.Context, data *models.ImproveSugg
 		RequestID: data.RequestID,
 		Title:     data.Title,
 		Content:   data.Content,
-	}, userID, sourceID, id, now)
+	}, userID, sourceID, id, revisionID, now)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create improve suggestion: %w", err)
 	}
@@ -101,7 +140,7 @@ func (service *serviceImpl) Create(ctx context.Context, data *models.ImproveSugg
 	return service.StorageToModel(storageModel), nil
 }
 
-func (service *serviceImpl) Update(ctx context.Context, data *models.ImproveSuggestionUpsert, id uuid.UUID, now time.Time) (*models.ImproveSuggestion, error) {
+func (service *serviceImpl) Update(ctx context.Context, data *models.ImproveSuggestionUpsert, id, revisionID uuid.UUID, now time.Time) (*models.ImproveSuggestion, error) {
 	if err := validation.CheckRequire("data", data); err != nil {
 		return nil, err
 	}
@@ -125,7 +164,7 @@ func (service *serviceImpl) Update(ctx context.Context, data *models.ImproveSugg
 		RequestID: data.RequestID,
 		Title:     data.Title,
 		Content:   data.Content,
-	}, id, now)
+	}, id, revisionID, now)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create improve suggestion: %w", err)
 	}
@@ -133,14 +172,41 @@ func (service *serviceImpl) Update(ctx context.Context, data *models.ImproveSugg
 	return service.StorageToModel(storageModel), nil
 }
 
-func (service *serviceImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	if err := service.repository.Delete(ctx, id); err != nil {
+func (service *serviceImpl) Delete(ctx context.Context, id uuid.UUID, now time.Time) error {
+	if err := service.repository.Delete(ctx, id, now); err != nil {
 		return fmt.Errorf("failed to delete improve suggestion: %w", err)
 	}
 
 	return nil
 }
 
+func (service *serviceImpl) ReadDeleted(ctx context.Context, id uuid.UUID) (*models.ImproveSuggestion, error) {
+	storageModel, err := service.repository.ReadDeleted(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted improve suggestion: %w", err)
+	}
+
+	return service.StorageToModel(storageModel), nil
+}
+
+func (service *serviceImpl) Restore(ctx context.Context, id uuid.UUID, deletedAfter time.Time) (*models.ImproveSuggestion, error) {
+	storageModel, err := service.repository.Restore(ctx, id, deletedAfter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore improve suggestion: %w", err)
+	}
+
+	return service.StorageToModel(storageModel), nil
+}
+
+func (service *serviceImpl) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	purged, err := service.repository.Purge(ctx, deletedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge deleted improve suggestions: %w", err)
+	}
+
+	return purged, nil
+}
+
 func (service *serviceImpl) Validate(ctx context.Context, validated bool, id uuid.UUID) (*models.ImproveSuggestion, error) {
 	storageModel, err := service.repository.Validate(ctx, validated, id)
 	if err != nil {
@@ -150,12 +216,15 @@ func (service *serviceImpl) Validate(ctx context.Context, validated bool, id uui
 	return service.StorageToModel(storageModel), nil
 }
 
-func (service *serviceImpl) List(ctx context.Context, query models.ImproveSuggestionsList, limit, offset int) ([]*models.ImproveSuggestion, int64, error) {
+func (service *serviceImpl) listQueryToStorage(query models.ImproveSuggestionsList) improve_suggestion_storage.ListQuery {
 	storageQuery := improve_suggestion_storage.ListQuery{
 		UserID:    query.UserID,
 		SourceID:  query.SourceID,
 		RequestID: query.RequestID,
 		Validated: query.Validated,
+		Reactions: query.Reactions,
+		Query:     query.Query,
+		Language:  service.languages.Config(service.languages.FromLocale(query.Language)),
 	}
 
 	if query.Order != nil {
@@ -165,7 +234,11 @@ func (service *serviceImpl) List(ctx context.Context, query models.ImproveSugges
 		}
 	}
 
-	storageModels, total, err := service.repository.List(ctx, storageQuery, limit, offset)
+	return storageQuery
+}
+
+func (service *serviceImpl) List(ctx context.Context, query models.ImproveSuggestionsList, limit, offset int) ([]*models.ImproveSuggestion, int64, error) {
+	storageModels, total, err := service.repository.List(ctx, service.listQueryToStorage(query), limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to list improve suggestions: %w", err)
 	}
@@ -178,6 +251,20 @@ func (service *serviceImpl) List(ctx context.Context, query models.ImproveSugges
 	return serviceModels, total, nil
 }
 
+func (service *serviceImpl) ListAfter(ctx context.Context, query models.ImproveSuggestionsList, cursor string, limit int) ([]*models.ImproveSuggestion, string, error) {
+	storageModels, next, err := service.repository.ListAfter(ctx, service.listQueryToStorage(query), cursor, limit)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list improve suggestions: %w", err)
+	}
+
+	serviceModels := make([]*models.ImproveSuggestion, len(storageModels))
+	for i, storageModel := range storageModels {
+		serviceModels[i] = service.StorageToModel(storageModel)
+	}
+
+	return serviceModels, next, nil
+}
+
 func (service *serviceImpl) IsCreator(ctx context.Context, userID, postID uuid.UUID) (bool, error) {
 	ok, err := service.repository.IsCreator(ctx, userID, postID)
 	if err != nil {
@@ -207,16 +294,19 @@ func (service *serviceImpl) StorageToModel(source *improve_suggestion_storage.Mo
 	}
 
 	return &models.ImproveSuggestion{
-		ID:        source.ID,
-		CreatedAt: source.CreatedAt,
-		UpdatedAt: source.UpdatedAt,
-		SourceID:  source.SourceID,
-		UserID:    source.UserID,
-		Validated: source.Validated,
-		UpVotes:   source.UpVotes,
-		DownVotes: source.DownVotes,
-		RequestID: source.RequestID,
-		Title:     source.Title,
-		Content:   source.Content,
+		ID:         source.ID,
+		CreatedAt:  source.CreatedAt,
+		UpdatedAt:  source.UpdatedAt,
+		SourceID:   source.SourceID,
+		UserID:     source.UserID,
+		Validated:  source.Validated,
+		RevisionID: source.RevisionID,
+		UpVotes:    source.UpVotes,
+		DownVotes:  source.DownVotes,
+		Reactions:  source.Reactions,
+		RequestID:  source.RequestID,
+		Title:      source.Title,
+		Content:    source.Content,
+		DeletedAt:  source.DeletedAt,
 	}
 }
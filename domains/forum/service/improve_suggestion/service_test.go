@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"github.com/a-novel/agora-backend/framework"
+	"github.com/a-novel/agora-backend/framework/language"
 	"github.com/a-novel/agora-backend/framework/validation"
 	"github.com/a-novel/agora-backend/models"
 	"github.com/google/uuid"
@@ -16,6 +17,10 @@ var (
 	baseTime   = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
 	updateTime = time.Date(2020, time.May, 4, 9, 0, 0, 0, time.UTC)
 	fooErr     = errors.New("it broken")
+	languages  = language.Languages{
+		Default: "fr",
+		Configs: map[string]string{"fr": "french", "en": "english"},
+	}
 )
 
 func TestImproveSuggestionService_Read(t *testing.T) {
@@ -76,7 +81,7 @@ func TestImproveSuggestionService_Read(t *testing.T) {
 				On("Read", context.TODO(), d.id).
 				Return(d.getData, d.getError)
 
-			service := NewService(repository)
+			service := NewService(repository, languages)
 
 			res, err := service.Read(context.TODO(), d.id)
 			test_utils.RequireError(t, d.expectErr, err)
@@ -87,15 +92,103 @@ func TestImproveSuggestionService_Read(t *testing.T) {
 	}
 }
 
-func TestImproveSuggestionService_Create(t *testing.T) {
+func TestImproveSuggestionService_ReadRevisions(t *testing.T) {
 	data := []struct {
 		name string
 
-		userID   uuid.UUID
-		sourceID uuid.UUID
-		data     *models.ImproveSuggestionUpsert
 		id       uuid.UUID
-		now      time.Time
+		getData  []*improve_suggestion_storage.Revision
+		getError error
+
+		expect    []*models.ImproveSuggestionRevision
+		expectErr error
+	}{
+		{
+			name: "Success",
+			id:   test_utils.NumberUUID(1000),
+			getData: []*improve_suggestion_storage.Revision{
+				{
+					ID:           test_utils.NumberUUID(2),
+					SuggestionID: test_utils.NumberUUID(1000),
+					CreatedAt:    updateTime,
+					UpVotes:      4,
+					Core: improve_suggestion_storage.Core{
+						RequestID: test_utils.NumberUUID(12),
+						Title:     "Dummy post",
+						Content:   "Foo bar qux, revised.",
+					},
+				},
+				{
+					ID:           test_utils.NumberUUID(1),
+					SuggestionID: test_utils.NumberUUID(1000),
+					CreatedAt:    baseTime,
+					UpVotes:      13,
+					DownVotes:    3,
+					Core: improve_suggestion_storage.Core{
+						RequestID: test_utils.NumberUUID(11),
+						Title:     "Dummy post",
+						Content:   "Foo bar qux.",
+					},
+				},
+			},
+			expect: []*models.ImproveSuggestionRevision{
+				{
+					ID:           test_utils.NumberUUID(2),
+					SuggestionID: test_utils.NumberUUID(1000),
+					CreatedAt:    updateTime,
+					UpVotes:      4,
+					RequestID:    test_utils.NumberUUID(12),
+					Title:        "Dummy post",
+					Content:      "Foo bar qux, revised.",
+				},
+				{
+					ID:           test_utils.NumberUUID(1),
+					SuggestionID: test_utils.NumberUUID(1000),
+					CreatedAt:    baseTime,
+					UpVotes:      13,
+					DownVotes:    3,
+					RequestID:    test_utils.NumberUUID(11),
+					Title:        "Dummy post",
+					Content:      "Foo bar qux.",
+				},
+			},
+		},
+		{
+			name:      "Error/RepositoryFailure",
+			id:        test_utils.NumberUUID(1000),
+			getError:  fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := improve_suggestion_storage.NewMockRepository(t)
+			repository.
+				On("ReadRevisions", context.TODO(), d.id).
+				Return(d.getData, d.getError)
+
+			service := NewService(repository, languages)
+
+			res, err := service.ReadRevisions(context.TODO(), d.id)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(t))
+		})
+	}
+}
+
+func TestImproveSuggestionService_Create(t *testing.T) {
+	data := []struct {
+		name string
+
+		userID     uuid.UUID
+		sourceID   uuid.UUID
+		data       *models.ImproveSuggestionUpsert
+		id         uuid.UUID
+		revisionID uuid.UUID
+		now        time.Time
 
 		shouldCallRepository     bool
 		shouldCallRepositoryWith *improve_suggestion_storage.Core
@@ -115,6 +208,7 @@ func TestImproveSuggestionService_Create(t *testing.T) {
 				Content:   "Foo bar qux.",
 			},
 			id:                   test_utils.NumberUUID(1),
+			revisionID:           test_utils.NumberUUID(2),
 			now:                  baseTime,
 			shouldCallRepository: true,
 			shouldCallRepositoryWith: &improve_suggestion_storage.Core{
@@ -123,14 +217,15 @@ func TestImproveSuggestionService_Create(t *testing.T) {
 				Content:   "Foo bar qux.",
 			},
 			createData: &improve_suggestion_storage.Model{
-				ID:        test_utils.NumberUUID(1),
-				CreatedAt: baseTime,
-				UpdatedAt: &updateTime,
-				SourceID:  test_utils.NumberUUID(10),
-				UserID:    test_utils.NumberUUID(100),
-				Validated: false,
-				UpVotes:   17,
-				DownVotes: 3,
+				ID:         test_utils.NumberUUID(1),
+				CreatedAt:  baseTime,
+				UpdatedAt:  &updateTime,
+				SourceID:   test_utils.NumberUUID(10),
+				UserID:     test_utils.NumberUUID(100),
+				Validated:  false,
+				RevisionID: test_utils.NumberUUID(2),
+				UpVotes:    17,
+				DownVotes:  3,
 				Core: improve_suggestion_storage.Core{
 					RequestID: test_utils.NumberUUID(11),
 					Title:     "Dummy post",
@@ -138,17 +233,18 @@ func TestImproveSuggestionService_Create(t *testing.T) {
 				},
 			},
 			expect: &models.ImproveSuggestion{
-				ID:        test_utils.NumberUUID(1),
-				CreatedAt: baseTime,
-				UpdatedAt: &updateTime,
-				SourceID:  test_utils.NumberUUID(10),
-				UserID:    test_utils.NumberUUID(100),
-				Validated: false,
-				UpVotes:   17,
-				DownVotes: 3,
-				RequestID: test_utils.NumberUUID(11),
-				Title:     "Dummy post",
-				Content:   "Foo bar qux.",
+				ID:         test_utils.NumberUUID(1),
+				CreatedAt:  baseTime,
+				UpdatedAt:  &updateTime,
+				SourceID:   test_utils.NumberUUID(10),
+				UserID:     test_utils.NumberUUID(100),
+				Validated:  false,
+				RevisionID: test_utils.NumberUUID(2),
+				UpVotes:    17,
+				DownVotes:  3,
+				RequestID:  test_utils.NumberUUID(11),
+				Title:      "Dummy post",
+				Content:    "Foo bar qux.",
 			},
 		},
 		{
@@ -159,9 +255,10 @@ func TestImproveSuggestionService_Create(t *testing.T) {
 				RequestID: test_utils.NumberUUID(11),
 				Content:   "Foo bar qux.",
 			},
-			id:        test_utils.NumberUUID(1),
-			now:       baseTime,
-			expectErr: validation.ErrNil,
+			id:         test_utils.NumberUUID(1),
+			revisionID: test_utils.NumberUUID(2),
+			now:        baseTime,
+			expectErr:  validation.ErrNil,
 		},
 		{
 			name:     "Error/NoContent",
@@ -171,9 +268,10 @@ func TestImproveSuggestionService_Create(t *testing.T) {
 				RequestID: test_utils.NumberUUID(11),
 				Title:     "Dummy post",
 			},
-			id:        test_utils.NumberUUID(1),
-			now:       baseTime,
-			expectErr: validation.ErrNil,
+			id:         test_utils.NumberUUID(1),
+			revisionID: test_utils.NumberUUID(2),
+			now:        baseTime,
+			expectErr:  validation.ErrNil,
 		},
 		{
 			name:     "Error/TitleTooShort",
@@ -184,9 +282,10 @@ func TestImproveSuggestionService_Create(t *testing.T) {
 				Title:     "D",
 				Content:   "Foo bar qux.",
 			},
-			id:        test_utils.NumberUUID(1),
-			now:       baseTime,
-			expectErr: validation.ErrInvalidEntity,
+			id:         test_utils.NumberUUID(1),
+			revisionID: test_utils.NumberUUID(2),
+			now:        baseTime,
+			expectErr:  validation.ErrInvalidEntity,
 		},
 		{
 			name:     "Error/ContentTooShort",
@@ -197,9 +296,10 @@ func TestImproveSuggestionService_Create(t *testing.T) {
 				Title:     "Dummy post",
 				Content:   "F",
 			},
-			id:        test_utils.NumberUUID(1),
-			now:       baseTime,
-			expectErr: validation.ErrInvalidEntity,
+			id:         test_utils.NumberUUID(1),
+			revisionID: test_utils.NumberUUID(2),
+			now:        baseTime,
+			expectErr:  validation.ErrInvalidEntity,
 		},
 		{
 			name:     "Error/TitleInvalid",
@@ -210,9 +310,10 @@ func TestImproveSuggestionService_Create(t *testing.T) {
 				Title:     "Dummy\n post",
 				Content:   "Foo bar qux.",
 			},
-			id:        test_utils.NumberUUID(1),
-			now:       baseTime,
-			expectErr: validation.ErrInvalidEntity,
+			id:         test_utils.NumberUUID(1),
+			revisionID: test_utils.NumberUUID(2),
+			now:        baseTime,
+			expectErr:  validation.ErrInvalidEntity,
 		},
 		{
 			name:     "Error/RepositoryFailure",
@@ -224,6 +325,7 @@ func TestImproveSuggestionService_Create(t *testing.T) {
 				Content:   "Foo bar qux.",
 			},
 			id:                   test_utils.NumberUUID(1),
+			revisionID:           test_utils.NumberUUID(2),
 			now:                  baseTime,
 			shouldCallRepository: true,
 			shouldCallRepositoryWith: &improve_suggestion_storage.Core{
@@ -242,13 +344,13 @@ func TestImproveSuggestionService_Create(t *testing.T) {
 
 			if d.shouldCallRepository {
 				repository.
-					On("Create", context.TODO(), d.shouldCallRepositoryWith, d.userID, d.sourceID, d.id, d.now).
+					On("Create", context.TODO(), d.shouldCallRepositoryWith, d.userID, d.sourceID, d.id, d.revisionID, d.now).
 					Return(d.createData, d.createError)
 			}
 
-			service := NewService(repository)
+			service := NewService(repository, languages)
 
-			res, err := service.Create(context.TODO(), d.data, d.userID, d.sourceID, d.id, d.now)
+			res, err := service.Create(context.TODO(), d.data, d.userID, d.sourceID, d.id, d.revisionID, d.now)
 			test_utils.RequireError(t, d.expectErr, err)
 			require.Equal(t, d.expect, res)
 
@@ -261,9 +363,10 @@ func TestImproveSuggestionService_Update(t *testing.T) {
 	data := []struct {
 		name string
 
-		data *models.ImproveSuggestionUpsert
-		id   uuid.UUID
-		now  time.Time
+		data       *models.ImproveSuggestionUpsert
+		id         uuid.UUID
+		revisionID uuid.UUID
+		now        time.Time
 
 		shouldCallRepository     bool
 		shouldCallRepositoryWith *improve_suggestion_storage.Core
@@ -281,6 +384,7 @@ func TestImproveSuggestionService_Update(t *testing.T) {
 				Content:   "Foo bar qux.",
 			},
 			id:                   test_utils.NumberUUID(1),
+			revisionID:           test_utils.NumberUUID(2),
 			now:                  baseTime,
 			shouldCallRepository: true,
 			shouldCallRepositoryWith: &improve_suggestion_storage.Core{
@@ -289,14 +393,15 @@ func TestImproveSuggestionService_Update(t *testing.T) {
 				Content:   "Foo bar qux.",
 			},
 			createData: &improve_suggestion_storage.Model{
-				ID:        test_utils.NumberUUID(1),
-				CreatedAt: baseTime,
-				UpdatedAt: &updateTime,
-				SourceID:  test_utils.NumberUUID(10),
-				UserID:    test_utils.NumberUUID(100),
-				Validated: false,
-				UpVotes:   17,
-				DownVotes: 3,
+				ID:         test_utils.NumberUUID(1),
+				CreatedAt:  baseTime,
+				UpdatedAt:  &updateTime,
+				SourceID:   test_utils.NumberUUID(10),
+				UserID:     test_utils.NumberUUID(100),
+				Validated:  false,
+				RevisionID: test_utils.NumberUUID(2),
+				UpVotes:    17,
+				DownVotes:  3,
 				Core: improve_suggestion_storage.Core{
 					RequestID: test_utils.NumberUUID(11),
 					Title:     "Dummy post",
@@ -304,17 +409,18 @@ func TestImproveSuggestionService_Update(t *testing.T) {
 				},
 			},
 			expect: &models.ImproveSuggestion{
-				ID:        test_utils.NumberUUID(1),
-				CreatedAt: baseTime,
-				UpdatedAt: &updateTime,
-				SourceID:  test_utils.NumberUUID(10),
-				UserID:    test_utils.NumberUUID(100),
-				Validated: false,
-				UpVotes:   17,
-				DownVotes: 3,
-				RequestID: test_utils.NumberUUID(11),
-				Title:     "Dummy post",
-				Content:   "Foo bar qux.",
+				ID:         test_utils.NumberUUID(1),
+				CreatedAt:  baseTime,
+				UpdatedAt:  &updateTime,
+				SourceID:   test_utils.NumberUUID(10),
+				UserID:     test_utils.NumberUUID(100),
+				Validated:  false,
+				RevisionID: test_utils.NumberUUID(2),
+				UpVotes:    17,
+				DownVotes:  3,
+				RequestID:  test_utils.NumberUUID(11),
+				Title:      "Dummy post",
+				Content:    "Foo bar qux.",
 			},
 		},
 		{
@@ -323,9 +429,10 @@ func TestImproveSuggestionService_Update(t *testing.T) {
 				RequestID: test_utils.NumberUUID(11),
 				Content:   "Foo bar qux.",
 			},
-			id:        test_utils.NumberUUID(1),
-			now:       baseTime,
-			expectErr: validation.ErrNil,
+			id:         test_utils.NumberUUID(1),
+			revisionID: test_utils.NumberUUID(2),
+			now:        baseTime,
+			expectErr:  validation.ErrNil,
 		},
 		{
 			name: "Error/NoContent",
@@ -333,9 +440,10 @@ func TestImproveSuggestionService_Update(t *testing.T) {
 				RequestID: test_utils.NumberUUID(11),
 				Title:     "Dummy post",
 			},
-			id:        test_utils.NumberUUID(1),
-			now:       baseTime,
-			expectErr: validation.ErrNil,
+			id:         test_utils.NumberUUID(1),
+			revisionID: test_utils.NumberUUID(2),
+			now:        baseTime,
+			expectErr:  validation.ErrNil,
 		},
 		{
 			name: "Error/TitleTooShort",
@@ -344,9 +452,10 @@ func TestImproveSuggestionService_Update(t *testing.T) {
 				Title:     "D",
 				Content:   "Foo bar qux.",
 			},
-			id:        test_utils.NumberUUID(1),
-			now:       baseTime,
-			expectErr: validation.ErrInvalidEntity,
+			id:         test_utils.NumberUUID(1),
+			revisionID: test_utils.NumberUUID(2),
+			now:        baseTime,
+			expectErr:  validation.ErrInvalidEntity,
 		},
 		{
 			name: "Error/ContentTooShort",
@@ -355,9 +464,10 @@ func TestImproveSuggestionService_Update(t *testing.T) {
 				Title:     "Dummy post",
 				Content:   "F",
 			},
-			id:        test_utils.NumberUUID(1),
-			now:       baseTime,
-			expectErr: validation.ErrInvalidEntity,
+			id:         test_utils.NumberUUID(1),
+			revisionID: test_utils.NumberUUID(2),
+			now:        baseTime,
+			expectErr:  validation.ErrInvalidEntity,
 		},
 		{
 			name: "Error/TitleInvalid",
@@ -366,9 +476,10 @@ func TestImproveSuggestionService_Update(t *testing.T) {
 				Title:     "Dummy\n post",
 				Content:   "Foo bar qux.",
 			},
-			id:        test_utils.NumberUUID(1),
-			now:       baseTime,
-			expectErr: validation.ErrInvalidEntity,
+			id:         test_utils.NumberUUID(1),
+			revisionID: test_utils.NumberUUID(2),
+			now:        baseTime,
+			expectErr:  validation.ErrInvalidEntity,
 		},
 		{
 			name: "Error/RepositoryFailure",
@@ -378,6 +489,7 @@ func TestImproveSuggestionService_Update(t *testing.T) {
 				Content:   "Foo bar qux.",
 			},
 			id:                   test_utils.NumberUUID(1),
+			revisionID:           test_utils.NumberUUID(2),
 			now:                  baseTime,
 			shouldCallRepository: true,
 			shouldCallRepositoryWith: &improve_suggestion_storage.Core{
@@ -396,13 +508,13 @@ func TestImproveSuggestionService_Update(t *testing.T) {
 
 			if d.shouldCallRepository {
 				repository.
-					On("Update", context.TODO(), d.shouldCallRepositoryWith, d.id, d.now).
+					On("Update", context.TODO(), d.shouldCallRepositoryWith, d.id, d.revisionID, d.now).
 					Return(d.createData, d.createError)
 			}
 
-			service := NewService(repository)
+			service := NewService(repository, languages)
 
-			res, err := service.Update(context.TODO(), d.data, d.id, d.now)
+			res, err := service.Update(context.TODO(), d.data, d.id, d.revisionID, d.now)
 			test_utils.RequireError(t, d.expectErr, err)
 			require.Equal(t, d.expect, res)
 
@@ -437,12 +549,12 @@ func TestImproveSuggestionService_Delete(t *testing.T) {
 			repository := improve_suggestion_storage.NewMockRepository(t)
 
 			repository.
-				On("Delete", context.TODO(), d.id).
+				On("Delete", context.TODO(), d.id, baseTime).
 				Return(d.deleteError)
 
-			service := NewService(repository)
+			service := NewService(repository, languages)
 
-			err := service.Delete(context.TODO(), d.id)
+			err := service.Delete(context.TODO(), d.id, baseTime)
 			test_utils.RequireError(t, d.expectErr, err)
 
 			require.True(st, repository.AssertExpectations(t))
@@ -450,6 +562,178 @@ func TestImproveSuggestionService_Delete(t *testing.T) {
 	}
 }
 
+func TestImproveSuggestionService_ReadDeleted(t *testing.T) {
+	data := []struct {
+		name string
+
+		id       uuid.UUID
+		getData  *improve_suggestion_storage.Model
+		getError error
+
+		expect    *models.ImproveSuggestion
+		expectErr error
+	}{
+		{
+			name: "Success",
+			id:   test_utils.NumberUUID(1),
+			getData: &improve_suggestion_storage.Model{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				DeletedAt: &updateTime,
+				Core: improve_suggestion_storage.Core{
+					RequestID: test_utils.NumberUUID(11),
+					Title:     "Dummy post",
+					Content:   "Foo bar qux.",
+				},
+			},
+			expect: &models.ImproveSuggestion{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				RequestID: test_utils.NumberUUID(11),
+				Title:     "Dummy post",
+				Content:   "Foo bar qux.",
+				DeletedAt: &updateTime,
+			},
+		},
+		{
+			name:      "Error/RepositoryFailure",
+			id:        test_utils.NumberUUID(1),
+			getError:  fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := improve_suggestion_storage.NewMockRepository(st)
+			repository.
+				On("ReadDeleted", context.TODO(), d.id).
+				Return(d.getData, d.getError)
+
+			service := NewService(repository, languages)
+
+			res, err := service.ReadDeleted(context.TODO(), d.id)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestImproveSuggestionService_Restore(t *testing.T) {
+	data := []struct {
+		name string
+
+		id           uuid.UUID
+		deletedAfter time.Time
+
+		restoreData  *improve_suggestion_storage.Model
+		restoreError error
+
+		expect    *models.ImproveSuggestion
+		expectErr error
+	}{
+		{
+			name:         "Success",
+			id:           test_utils.NumberUUID(1),
+			deletedAfter: baseTime,
+			restoreData: &improve_suggestion_storage.Model{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				Core: improve_suggestion_storage.Core{
+					RequestID: test_utils.NumberUUID(11),
+					Title:     "Dummy post",
+					Content:   "Foo bar qux.",
+				},
+			},
+			expect: &models.ImproveSuggestion{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				RequestID: test_utils.NumberUUID(11),
+				Title:     "Dummy post",
+				Content:   "Foo bar qux.",
+			},
+		},
+		{
+			name:         "Error/RepositoryFailure",
+			id:           test_utils.NumberUUID(1),
+			deletedAfter: baseTime,
+			restoreError: fooErr,
+			expectErr:    fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := improve_suggestion_storage.NewMockRepository(st)
+			repository.
+				On("Restore", context.TODO(), d.id, d.deletedAfter).
+				Return(d.restoreData, d.restoreError)
+
+			service := NewService(repository, languages)
+
+			res, err := service.Restore(context.TODO(), d.id, d.deletedAfter)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestImproveSuggestionService_Purge(t *testing.T) {
+	data := []struct {
+		name string
+
+		deletedBefore time.Time
+
+		purgeData  int64
+		purgeError error
+
+		expect    int64
+		expectErr error
+	}{
+		{
+			name:          "Success",
+			deletedBefore: baseTime,
+			purgeData:     2,
+			expect:        2,
+		},
+		{
+			name:          "Error/RepositoryFailure",
+			deletedBefore: baseTime,
+			purgeError:    fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := improve_suggestion_storage.NewMockRepository(st)
+			repository.
+				On("Purge", context.TODO(), d.deletedBefore).
+				Return(d.purgeData, d.purgeError)
+
+			service := NewService(repository, languages)
+
+			res, err := service.Purge(context.TODO(), d.deletedBefore)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
 func TestImproveSuggestionService_Validate(t *testing.T) {
 	data := []struct {
 		name string
@@ -512,7 +796,7 @@ func TestImproveSuggestionService_Validate(t *testing.T) {
 				On("Validate", context.TODO(), d.validated, d.id).
 				Return(d.validateData, d.validateError)
 
-			service := NewService(repository)
+			service := NewService(repository, languages)
 
 			res, err := service.Validate(context.TODO(), d.validated, d.id)
 			test_utils.RequireError(t, d.expectErr, err)
@@ -547,6 +831,9 @@ func TestImproveSuggestionService_List(t *testing.T) {
 				SourceID:  framework.ToPTR(test_utils.NumberUUID(10)),
 				RequestID: framework.ToPTR(test_utils.NumberUUID(11)),
 				Validated: framework.ToPTR(true),
+				Reactions: []string{"moving"},
+				Query:     "foo bar",
+				Language:  "en-US,en;q=0.9",
 			},
 			limit:  10,
 			offset: 20,
@@ -555,6 +842,9 @@ func TestImproveSuggestionService_List(t *testing.T) {
 				SourceID:  framework.ToPTR(test_utils.NumberUUID(10)),
 				RequestID: framework.ToPTR(test_utils.NumberUUID(11)),
 				Validated: framework.ToPTR(true),
+				Reactions: []string{"moving"},
+				Query:     "foo bar",
+				Language:  "english",
 			},
 			listData: []*improve_suggestion_storage.Model{
 				{
@@ -566,6 +856,7 @@ func TestImproveSuggestionService_List(t *testing.T) {
 					Validated: false,
 					UpVotes:   17,
 					DownVotes: 3,
+					Reactions: map[string]int64{"moving": 2},
 					Core: improve_suggestion_storage.Core{
 						RequestID: test_utils.NumberUUID(11),
 						Title:     "Dummy post",
@@ -599,6 +890,7 @@ func TestImproveSuggestionService_List(t *testing.T) {
 					Validated: false,
 					UpVotes:   17,
 					DownVotes: 3,
+					Reactions: map[string]int64{"moving": 2},
 					RequestID: test_utils.NumberUUID(11),
 					Title:     "Dummy post",
 					Content:   "Foo bar qux.",
@@ -634,6 +926,7 @@ func TestImproveSuggestionService_List(t *testing.T) {
 				SourceID:  framework.ToPTR(test_utils.NumberUUID(10)),
 				RequestID: framework.ToPTR(test_utils.NumberUUID(11)),
 				Validated: framework.ToPTR(true),
+				Language:  "french",
 			},
 			listError: fooErr,
 			expectErr: fooErr,
@@ -648,7 +941,7 @@ func TestImproveSuggestionService_List(t *testing.T) {
 				On("List", context.TODO(), d.shouldCallRepositoryWith, d.limit, d.offset).
 				Return(d.listData, d.listCount, d.listError)
 
-			service := NewService(repository)
+			service := NewService(repository, languages)
 
 			res, count, err := service.List(context.TODO(), d.query, d.limit, d.offset)
 			test_utils.RequireError(t, d.expectErr, err)
@@ -660,6 +953,99 @@ func TestImproveSuggestionService_List(t *testing.T) {
 	}
 }
 
+func TestImproveSuggestionService_ListAfter(t *testing.T) {
+	data := []struct {
+		name string
+
+		query  models.ImproveSuggestionsList
+		cursor string
+		limit  int
+
+		shouldCallRepositoryWith improve_suggestion_storage.ListQuery
+		listData                 []*improve_suggestion_storage.Model
+		listNext                 string
+		listError                error
+
+		expect     []*models.ImproveSuggestion
+		expectNext string
+		expectErr  error
+	}{
+		{
+			name: "Success",
+			query: models.ImproveSuggestionsList{
+				SourceID: framework.ToPTR(test_utils.NumberUUID(10)),
+				Order:    &models.ImproveSuggestionSearchOrder{Score: true},
+			},
+			cursor: "cursor-1",
+			limit:  10,
+			shouldCallRepositoryWith: improve_suggestion_storage.ListQuery{
+				SourceID: framework.ToPTR(test_utils.NumberUUID(10)),
+				Language: "french",
+				Order:    &improve_suggestion_storage.SearchQueryOrder{Score: true},
+			},
+			listData: []*improve_suggestion_storage.Model{
+				{
+					ID:        test_utils.NumberUUID(1),
+					CreatedAt: baseTime,
+					SourceID:  test_utils.NumberUUID(10),
+					UserID:    test_utils.NumberUUID(100),
+					UpVotes:   17,
+					DownVotes: 3,
+					Core: improve_suggestion_storage.Core{
+						RequestID: test_utils.NumberUUID(11),
+						Title:     "Dummy post",
+						Content:   "Foo bar qux.",
+					},
+				},
+			},
+			listNext: "cursor-2",
+			expect: []*models.ImproveSuggestion{
+				{
+					ID:        test_utils.NumberUUID(1),
+					CreatedAt: baseTime,
+					SourceID:  test_utils.NumberUUID(10),
+					UserID:    test_utils.NumberUUID(100),
+					UpVotes:   17,
+					DownVotes: 3,
+					RequestID: test_utils.NumberUUID(11),
+					Title:     "Dummy post",
+					Content:   "Foo bar qux.",
+				},
+			},
+			expectNext: "cursor-2",
+		},
+		{
+			name:   "Error/RepositoryFailure",
+			cursor: "cursor-1",
+			limit:  10,
+			shouldCallRepositoryWith: improve_suggestion_storage.ListQuery{
+				Language: "french",
+			},
+			listError: fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := improve_suggestion_storage.NewMockRepository(t)
+
+			repository.
+				On("ListAfter", context.TODO(), d.shouldCallRepositoryWith, d.cursor, d.limit).
+				Return(d.listData, d.listNext, d.listError)
+
+			service := NewService(repository, languages)
+
+			res, next, err := service.ListAfter(context.TODO(), d.query, d.cursor, d.limit)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+			require.Equal(t, d.expectNext, next)
+
+			require.True(st, repository.AssertExpectations(t))
+		})
+	}
+}
+
 func TestImproveSuggestionService_IsCreator(t *testing.T) {
 	data := []struct {
 		name string
@@ -695,7 +1081,7 @@ func TestImproveSuggestionService_IsCreator(t *testing.T) {
 				On("IsCreator", context.TODO(), d.userID, d.id).
 				Return(d.getData, d.getError)
 
-			service := NewService(repository)
+			service := NewService(repository, languages)
 
 			res, err := service.IsCreator(context.TODO(), d.userID, d.id)
 			test_utils.RequireError(t, d.expectErr, err)
@@ -804,7 +1190,7 @@ func TestImproveSuggestionService_GetPreviews(t *testing.T) {
 				On("GetPreviews", context.TODO(), d.ids).
 				Return(d.listData, d.listError)
 
-			service := NewService(repository)
+			service := NewService(repository, languages)
 
 			res, err := service.GetPreviews(context.TODO(), d.ids)
 			test_utils.RequireError(t, d.expectErr, err)
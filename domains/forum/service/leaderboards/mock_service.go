@@ -0,0 +1,145 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package leaderboards_service
+
+import (
+	context "context"
+
+	"github.com/a-novel/agora-backend/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function with given fields: ctx, board, period, limit, offset
+func (_m *MockService) List(ctx context.Context, board models.Leaderboard, period models.LeaderboardPeriod, limit int, offset int) ([]*models.LeaderboardEntry, int64, error) {
+	ret := _m.Called(ctx, board, period, limit, offset)
+
+	var r0 []*models.LeaderboardEntry
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Leaderboard, models.LeaderboardPeriod, int, int) ([]*models.LeaderboardEntry, int64, error)); ok {
+		return rf(ctx, board, period, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Leaderboard, models.LeaderboardPeriod, int, int) []*models.LeaderboardEntry); ok {
+		r0 = rf(ctx, board, period, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.LeaderboardEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Leaderboard, models.LeaderboardPeriod, int, int) int64); ok {
+		r1 = rf(ctx, board, period, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, models.Leaderboard, models.LeaderboardPeriod, int, int) error); ok {
+		r2 = rf(ctx, board, period, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - board models.Leaderboard
+//   - period models.LeaderboardPeriod
+//   - limit int
+//   - offset int
+func (_e *MockService_Expecter) List(ctx interface{}, board interface{}, period interface{}, limit interface{}, offset interface{}) *MockService_List_Call {
+	return &MockService_List_Call{Call: _e.mock.On("List", ctx, board, period, limit, offset)}
+}
+
+func (_c *MockService_List_Call) Run(run func(ctx context.Context, board models.Leaderboard, period models.LeaderboardPeriod, limit int, offset int)) *MockService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Leaderboard), args[2].(models.LeaderboardPeriod), args[3].(int), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *MockService_List_Call) Return(_a0 []*models.LeaderboardEntry, _a1 int64, _a2 error) *MockService_List_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockService_List_Call) RunAndReturn(run func(context.Context, models.Leaderboard, models.LeaderboardPeriod, int, int) ([]*models.LeaderboardEntry, int64, error)) *MockService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function with given fields: ctx
+func (_m *MockService) Refresh(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type MockService_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockService_Expecter) Refresh(ctx interface{}) *MockService_Refresh_Call {
+	return &MockService_Refresh_Call{Call: _e.mock.On("Refresh", ctx)}
+}
+
+func (_c *MockService_Refresh_Call) Run(run func(ctx context.Context)) *MockService_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockService_Refresh_Call) Return(_a0 error) *MockService_Refresh_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_Refresh_Call) RunAndReturn(run func(context.Context) error) *MockService_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockService(t mockConstructorTestingTNewMockService) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,76 @@
+package leaderboards_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+)
+
+const (
+	MaxListLimit = 100
+)
+
+var (
+	boardValues = []models.Leaderboard{
+		models.LeaderboardAcceptedSuggestions, models.LeaderboardSuggestionScore, models.LeaderboardRequests,
+	}
+	periodValues = []models.LeaderboardPeriod{models.LeaderboardPeriodMonth, models.LeaderboardPeriodAllTime}
+)
+
+// Service of the current layer. You can instantiate a new one with NewService.
+type Service interface {
+	// List returns the entries of a leaderboard, best ranked first, as of the last Refresh.
+	// It also returns the total number of available results, to help with pagination.
+	List(ctx context.Context, board models.Leaderboard, period models.LeaderboardPeriod, limit, offset int) ([]*models.LeaderboardEntry, int64, error)
+	// Refresh recomputes every leaderboard.
+	Refresh(ctx context.Context) error
+}
+
+type serviceImpl struct {
+	repository leaderboards_storage.Repository
+}
+
+// NewService returns a new Service instance.
+// To use a mocked one, call NewMockService.
+func NewService(repository leaderboards_storage.Repository) Service {
+	return &serviceImpl{repository: repository}
+}
+
+func (service *serviceImpl) List(ctx context.Context, board models.Leaderboard, period models.LeaderboardPeriod, limit, offset int) ([]*models.LeaderboardEntry, int64, error) {
+	if err := validation.CheckRestricted("board", board, boardValues...); err != nil {
+		return nil, 0, err
+	}
+	if err := validation.CheckRestricted("period", period, periodValues...); err != nil {
+		return nil, 0, err
+	}
+	if err := validation.CheckMinMax("limit", limit, 1, MaxListLimit); err != nil {
+		return nil, 0, err
+	}
+
+	storageModels, total, err := service.repository.List(
+		ctx, leaderboards_storage.Board(board), leaderboards_storage.Period(period), limit, offset,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list %s leaderboard for period %s: %w", board, period, err)
+	}
+
+	entries := make([]*models.LeaderboardEntry, len(storageModels))
+	for i, storageModel := range storageModels {
+		entries[i] = &models.LeaderboardEntry{
+			Rank:     storageModel.Rank,
+			TargetID: storageModel.TargetID,
+			Score:    storageModel.Score,
+		}
+	}
+
+	return entries, total, nil
+}
+
+func (service *serviceImpl) Refresh(ctx context.Context) error {
+	if err := service.repository.Refresh(ctx); err != nil {
+		return fmt.Errorf("failed to refresh leaderboards: %w", err)
+	}
+
+	return nil
+}
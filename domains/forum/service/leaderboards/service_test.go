@@ -0,0 +1,165 @@
+package leaderboards_service
+
+import (
+	"context"
+	"errors"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var (
+	fooErr = errors.New("it broken")
+)
+
+func TestLeaderboardsService_List(t *testing.T) {
+	data := []struct {
+		name string
+
+		board  models.Leaderboard
+		period models.LeaderboardPeriod
+		limit  int
+		offset int
+
+		shouldCallRepository bool
+		repositoryData       []*leaderboards_storage.Entry
+		repositoryCount      int64
+		repositoryErr        error
+
+		expect      []*models.LeaderboardEntry
+		expectCount int64
+		expectErr   error
+	}{
+		{
+			name:                 "Success",
+			board:                models.LeaderboardSuggestionScore,
+			period:               models.LeaderboardPeriodMonth,
+			limit:                10,
+			offset:               20,
+			shouldCallRepository: true,
+			repositoryData: []*leaderboards_storage.Entry{
+				{
+					Period:   leaderboards_storage.PeriodMonth,
+					Board:    leaderboards_storage.BoardSuggestionScore,
+					TargetID: test_utils.NumberUUID(100),
+					Score:    12,
+					Rank:     21,
+				},
+				{
+					Period:   leaderboards_storage.PeriodMonth,
+					Board:    leaderboards_storage.BoardSuggestionScore,
+					TargetID: test_utils.NumberUUID(101),
+					Score:    12,
+					Rank:     21,
+				},
+			},
+			repositoryCount: 200,
+			expect: []*models.LeaderboardEntry{
+				{Rank: 21, TargetID: test_utils.NumberUUID(100), Score: 12},
+				{Rank: 21, TargetID: test_utils.NumberUUID(101), Score: 12},
+			},
+			expectCount: 200,
+		},
+		{
+			name:                 "Success/NoEntries",
+			board:                models.LeaderboardRequests,
+			period:               models.LeaderboardPeriodAllTime,
+			limit:                10,
+			shouldCallRepository: true,
+			repositoryData:       []*leaderboards_storage.Entry{},
+			expect:               []*models.LeaderboardEntry{},
+		},
+		{
+			name:      "Error/InvalidBoard",
+			board:     models.Leaderboard("foo"),
+			period:    models.LeaderboardPeriodMonth,
+			limit:     10,
+			expectErr: validation.ErrNotAllowed,
+		},
+		{
+			name:      "Error/InvalidPeriod",
+			board:     models.LeaderboardAcceptedSuggestions,
+			period:    models.LeaderboardPeriod("foo"),
+			limit:     10,
+			expectErr: validation.ErrNotAllowed,
+		},
+		{
+			name:      "Error/LimitTooLow",
+			board:     models.LeaderboardAcceptedSuggestions,
+			period:    models.LeaderboardPeriodMonth,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:      "Error/LimitTooHigh",
+			board:     models.LeaderboardAcceptedSuggestions,
+			period:    models.LeaderboardPeriodMonth,
+			limit:     MaxListLimit + 1,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			board:                models.LeaderboardAcceptedSuggestions,
+			period:               models.LeaderboardPeriodMonth,
+			limit:                10,
+			shouldCallRepository: true,
+			repositoryErr:        fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := leaderboards_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On(
+						"List", context.TODO(),
+						leaderboards_storage.Board(d.board), leaderboards_storage.Period(d.period), d.limit, d.offset,
+					).
+					Return(d.repositoryData, d.repositoryCount, d.repositoryErr)
+			}
+
+			service := NewService(repository)
+			res, count, err := service.List(context.TODO(), d.board, d.period, d.limit, d.offset)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+			require.Equal(st, d.expectCount, count)
+
+			repository.AssertExpectations(st)
+		})
+	}
+}
+
+func TestLeaderboardsService_Refresh(t *testing.T) {
+	data := []struct {
+		name string
+
+		repositoryErr error
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+		},
+		{
+			name:          "Error/RepositoryFailure",
+			repositoryErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := leaderboards_storage.NewMockRepository(st)
+			repository.On("Refresh", context.TODO()).Return(d.repositoryErr)
+
+			service := NewService(repository)
+			err := service.Refresh(context.TODO())
+			test_utils.RequireError(st, d.expectErr, err)
+
+			repository.AssertExpectations(st)
+		})
+	}
+}
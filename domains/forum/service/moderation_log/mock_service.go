@@ -0,0 +1,167 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package moderation_log_service
+
+import (
+	context "context"
+
+	"github.com/a-novel/agora-backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function with given fields: ctx, query, limit, offset
+func (_m *MockService) List(ctx context.Context, query models.ModerationActionQuery, limit int, offset int) ([]*models.ModerationAction, int64, error) {
+	ret := _m.Called(ctx, query, limit, offset)
+
+	var r0 []*models.ModerationAction
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ModerationActionQuery, int, int) ([]*models.ModerationAction, int64, error)); ok {
+		return rf(ctx, query, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ModerationActionQuery, int, int) []*models.ModerationAction); ok {
+		r0 = rf(ctx, query, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ModerationAction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ModerationActionQuery, int, int) int64); ok {
+		r1 = rf(ctx, query, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, models.ModerationActionQuery, int, int) error); ok {
+		r2 = rf(ctx, query, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query models.ModerationActionQuery
+//   - limit int
+//   - offset int
+func (_e *MockService_Expecter) List(ctx interface{}, query interface{}, limit interface{}, offset interface{}) *MockService_List_Call {
+	return &MockService_List_Call{Call: _e.mock.On("List", ctx, query, limit, offset)}
+}
+
+func (_c *MockService_List_Call) Run(run func(ctx context.Context, query models.ModerationActionQuery, limit int, offset int)) *MockService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ModerationActionQuery), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockService_List_Call) Return(_a0 []*models.ModerationAction, _a1 int64, _a2 error) *MockService_List_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockService_List_Call) RunAndReturn(run func(context.Context, models.ModerationActionQuery, int, int) ([]*models.ModerationAction, int64, error)) *MockService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Log provides a mock function with given fields: ctx, moderatorID, action, target, targetID, note, id, now
+func (_m *MockService) Log(ctx context.Context, moderatorID *uuid.UUID, action models.ModerationActionType, target models.ModerationTarget, targetID uuid.UUID, note string, id uuid.UUID, now time.Time) (*models.ModerationAction, error) {
+	ret := _m.Called(ctx, moderatorID, action, target, targetID, note, id, now)
+
+	var r0 *models.ModerationAction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, models.ModerationActionType, models.ModerationTarget, uuid.UUID, string, uuid.UUID, time.Time) (*models.ModerationAction, error)); ok {
+		return rf(ctx, moderatorID, action, target, targetID, note, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *uuid.UUID, models.ModerationActionType, models.ModerationTarget, uuid.UUID, string, uuid.UUID, time.Time) *models.ModerationAction); ok {
+		r0 = rf(ctx, moderatorID, action, target, targetID, note, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ModerationAction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *uuid.UUID, models.ModerationActionType, models.ModerationTarget, uuid.UUID, string, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, moderatorID, action, target, targetID, note, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Log_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Log'
+type MockService_Log_Call struct {
+	*mock.Call
+}
+
+// Log is a helper method to define mock.On call
+//   - ctx context.Context
+//   - moderatorID *uuid.UUID
+//   - action models.ModerationActionType
+//   - target models.ModerationTarget
+//   - targetID uuid.UUID
+//   - note string
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *MockService_Expecter) Log(ctx interface{}, moderatorID interface{}, action interface{}, target interface{}, targetID interface{}, note interface{}, id interface{}, now interface{}) *MockService_Log_Call {
+	return &MockService_Log_Call{Call: _e.mock.On("Log", ctx, moderatorID, action, target, targetID, note, id, now)}
+}
+
+func (_c *MockService_Log_Call) Run(run func(ctx context.Context, moderatorID *uuid.UUID, action models.ModerationActionType, target models.ModerationTarget, targetID uuid.UUID, note string, id uuid.UUID, now time.Time)) *MockService_Log_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*uuid.UUID), args[2].(models.ModerationActionType), args[3].(models.ModerationTarget), args[4].(uuid.UUID), args[5].(string), args[6].(uuid.UUID), args[7].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Log_Call) Return(_a0 *models.ModerationAction, _a1 error) *MockService_Log_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Log_Call) RunAndReturn(run func(context.Context, *uuid.UUID, models.ModerationActionType, models.ModerationTarget, uuid.UUID, string, uuid.UUID, time.Time) (*models.ModerationAction, error)) *MockService_Log_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockService(t mockConstructorTestingTNewMockService) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,118 @@
+package moderation_log_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	MaxNoteLength = 512
+)
+
+// Service of the current layer. You can instantiate a new one with NewService.
+type Service interface {
+	// Log appends a new action to the moderation log. ModeratorID is nil for automatic actions.
+	Log(ctx context.Context, moderatorID *uuid.UUID, action models.ModerationActionType, target models.ModerationTarget, targetID uuid.UUID, note string, id uuid.UUID, now time.Time) (*models.ModerationAction, error)
+	// List returns the actions matching the query, most recent first.
+	List(ctx context.Context, query models.ModerationActionQuery, limit, offset int) ([]*models.ModerationAction, int64, error)
+}
+
+type serviceImpl struct {
+	repository moderation_log_storage.Repository
+}
+
+// NewService returns a new implementation of Service.
+func NewService(repository moderation_log_storage.Repository) Service {
+	return &serviceImpl{repository: repository}
+}
+
+func (service *serviceImpl) Log(ctx context.Context, moderatorID *uuid.UUID, action models.ModerationActionType, target models.ModerationTarget, targetID uuid.UUID, note string, id uuid.UUID, now time.Time) (*models.ModerationAction, error) {
+	if err := validation.CheckRestricted(
+		"action", action,
+		models.ModerationActionHide,
+		models.ModerationActionClaim,
+		models.ModerationActionResolve,
+		models.ModerationActionDismiss,
+		models.ModerationActionLock,
+		models.ModerationActionUnlock,
+		models.ModerationActionVoidVotes,
+	); err != nil {
+		return nil, err
+	}
+	if err := checkTarget(target); err != nil {
+		return nil, err
+	}
+	if err := validation.CheckMinMax("note", note, -1, MaxNoteLength); err != nil {
+		return nil, err
+	}
+
+	storageModel, err := service.repository.Create(ctx, &moderation_log_storage.Model{
+		ID:          id,
+		CreatedAt:   now,
+		ModeratorID: moderatorID,
+		Action:      moderation_log_storage.Action(action),
+		Target:      moderation_log_storage.Target(target),
+		TargetID:    targetID,
+		Note:        note,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to log moderation action: %w", err)
+	}
+
+	return service.storageToModel(storageModel), nil
+}
+
+func (service *serviceImpl) List(ctx context.Context, query models.ModerationActionQuery, limit, offset int) ([]*models.ModerationAction, int64, error) {
+	storageQuery := moderation_log_storage.ListQuery{
+		TargetID:    query.TargetID,
+		ModeratorID: query.ModeratorID,
+	}
+	if query.Target != nil {
+		if err := checkTarget(*query.Target); err != nil {
+			return nil, 0, err
+		}
+
+		storageQuery.Target = (*moderation_log_storage.Target)(query.Target)
+	}
+
+	storageModels, total, err := service.repository.List(ctx, storageQuery, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list moderation actions: %w", err)
+	}
+
+	actions := make([]*models.ModerationAction, len(storageModels))
+	for i, storageModel := range storageModels {
+		actions[i] = service.storageToModel(storageModel)
+	}
+
+	return actions, total, nil
+}
+
+func checkTarget(target models.ModerationTarget) error {
+	return validation.CheckRestricted(
+		"target", target,
+		models.ModerationTargetImproveRequest,
+		models.ModerationTargetImproveSuggestion,
+		models.ModerationTargetProfile,
+	)
+}
+
+func (service *serviceImpl) storageToModel(source *moderation_log_storage.Model) *models.ModerationAction {
+	if source == nil {
+		return nil
+	}
+
+	return &models.ModerationAction{
+		ID:          source.ID,
+		CreatedAt:   source.CreatedAt,
+		ModeratorID: source.ModeratorID,
+		Action:      models.ModerationActionType(source.Action),
+		Target:      models.ModerationTarget(source.Target),
+		TargetID:    source.TargetID,
+		Note:        source.Note,
+	}
+}
@@ -0,0 +1,234 @@
+package moderation_log_service
+
+import (
+	"context"
+	"errors"
+	"github.com/a-novel/agora-backend/framework"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	fooErr   = errors.New("it broken")
+)
+
+func TestModerationLogService_Log(t *testing.T) {
+	data := []struct {
+		name string
+
+		moderatorID *uuid.UUID
+		action      models.ModerationActionType
+		target      models.ModerationTarget
+		targetID    uuid.UUID
+		note        string
+		id          uuid.UUID
+		now         time.Time
+
+		shouldCallRepository bool
+		createData           *moderation_log_storage.Model
+		createErr            error
+
+		expect    *models.ModerationAction
+		expectErr error
+	}{
+		{
+			name:                 "Success",
+			moderatorID:          framework.ToPTR(test_utils.NumberUUID(100)),
+			action:               models.ModerationActionResolve,
+			target:               models.ModerationTargetImproveSuggestion,
+			targetID:             test_utils.NumberUUID(10),
+			note:                 "spam link",
+			id:                   test_utils.NumberUUID(1),
+			now:                  baseTime,
+			shouldCallRepository: true,
+			createData: &moderation_log_storage.Model{
+				ID:          test_utils.NumberUUID(1),
+				CreatedAt:   baseTime,
+				ModeratorID: framework.ToPTR(test_utils.NumberUUID(100)),
+				Action:      moderation_log_storage.ActionResolve,
+				Target:      moderation_log_storage.TargetImproveSuggestion,
+				TargetID:    test_utils.NumberUUID(10),
+				Note:        "spam link",
+			},
+			expect: &models.ModerationAction{
+				ID:          test_utils.NumberUUID(1),
+				CreatedAt:   baseTime,
+				ModeratorID: framework.ToPTR(test_utils.NumberUUID(100)),
+				Action:      models.ModerationActionResolve,
+				Target:      models.ModerationTargetImproveSuggestion,
+				TargetID:    test_utils.NumberUUID(10),
+				Note:        "spam link",
+			},
+		},
+		{
+			name:      "Error/UnknownAction",
+			action:    "ban",
+			target:    models.ModerationTargetProfile,
+			targetID:  test_utils.NumberUUID(10),
+			id:        test_utils.NumberUUID(1),
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:      "Error/UnknownTarget",
+			action:    models.ModerationActionHide,
+			target:    "comment",
+			targetID:  test_utils.NumberUUID(10),
+			id:        test_utils.NumberUUID(1),
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:      "Error/NoteTooLong",
+			action:    models.ModerationActionHide,
+			target:    models.ModerationTargetProfile,
+			targetID:  test_utils.NumberUUID(10),
+			note:      strings.Repeat("a", MaxNoteLength+1),
+			id:        test_utils.NumberUUID(1),
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			action:               models.ModerationActionHide,
+			target:               models.ModerationTargetProfile,
+			targetID:             test_utils.NumberUUID(10),
+			id:                   test_utils.NumberUUID(1),
+			now:                  baseTime,
+			shouldCallRepository: true,
+			createErr:            fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := moderation_log_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("Create", context.TODO(), &moderation_log_storage.Model{
+						ID:          d.id,
+						CreatedAt:   d.now,
+						ModeratorID: d.moderatorID,
+						Action:      moderation_log_storage.Action(d.action),
+						Target:      moderation_log_storage.Target(d.target),
+						TargetID:    d.targetID,
+						Note:        d.note,
+					}).
+					Return(d.createData, d.createErr)
+			}
+
+			service := NewService(repository)
+			res, err := service.Log(context.TODO(), d.moderatorID, d.action, d.target, d.targetID, d.note, d.id, d.now)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestModerationLogService_List(t *testing.T) {
+	data := []struct {
+		name string
+
+		query  models.ModerationActionQuery
+		limit  int
+		offset int
+
+		shouldCallRepository bool
+		expectQuery          moderation_log_storage.ListQuery
+		listData             []*moderation_log_storage.Model
+		listCount            int64
+		listErr              error
+
+		expect      []*models.ModerationAction
+		expectCount int64
+		expectErr   error
+	}{
+		{
+			name: "Success",
+			query: models.ModerationActionQuery{
+				Target:      framework.ToPTR(models.ModerationTargetImproveRequest),
+				TargetID:    framework.ToPTR(test_utils.NumberUUID(10)),
+				ModeratorID: framework.ToPTR(test_utils.NumberUUID(100)),
+			},
+			limit:                10,
+			shouldCallRepository: true,
+			expectQuery: moderation_log_storage.ListQuery{
+				Target:      framework.ToPTR(moderation_log_storage.TargetImproveRequest),
+				TargetID:    framework.ToPTR(test_utils.NumberUUID(10)),
+				ModeratorID: framework.ToPTR(test_utils.NumberUUID(100)),
+			},
+			listData: []*moderation_log_storage.Model{
+				{
+					ID:          test_utils.NumberUUID(1),
+					CreatedAt:   baseTime,
+					ModeratorID: framework.ToPTR(test_utils.NumberUUID(100)),
+					Action:      moderation_log_storage.ActionLock,
+					Target:      moderation_log_storage.TargetImproveRequest,
+					TargetID:    test_utils.NumberUUID(10),
+				},
+			},
+			listCount: 1,
+			expect: []*models.ModerationAction{
+				{
+					ID:          test_utils.NumberUUID(1),
+					CreatedAt:   baseTime,
+					ModeratorID: framework.ToPTR(test_utils.NumberUUID(100)),
+					Action:      models.ModerationActionLock,
+					Target:      models.ModerationTargetImproveRequest,
+					TargetID:    test_utils.NumberUUID(10),
+				},
+			},
+			expectCount: 1,
+		},
+		{
+			name:                 "Success/NoFilter",
+			limit:                10,
+			shouldCallRepository: true,
+			listData:             []*moderation_log_storage.Model{},
+			expect:               []*models.ModerationAction{},
+		},
+		{
+			name:      "Error/UnknownTarget",
+			query:     models.ModerationActionQuery{Target: framework.ToPTR(models.ModerationTarget("comment"))},
+			limit:     10,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			limit:                10,
+			shouldCallRepository: true,
+			listErr:              fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := moderation_log_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("List", context.TODO(), d.expectQuery, d.limit, d.offset).
+					Return(d.listData, d.listCount, d.listErr)
+			}
+
+			service := NewService(repository)
+			res, count, err := service.List(context.TODO(), d.query, d.limit, d.offset)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+			require.Equal(st, d.expectCount, count)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
@@ -0,0 +1,510 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package reports_service
+
+import (
+	context "context"
+
+	"github.com/a-novel/agora-backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// Claim provides a mock function with given fields: ctx, target, targetID, moderatorID, now
+func (_m *MockService) Claim(ctx context.Context, target models.ModerationTarget, targetID uuid.UUID, moderatorID uuid.UUID, now time.Time) (*models.ForumReportCase, error) {
+	ret := _m.Called(ctx, target, targetID, moderatorID, now)
+
+	var r0 *models.ForumReportCase
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ModerationTarget, uuid.UUID, uuid.UUID, time.Time) (*models.ForumReportCase, error)); ok {
+		return rf(ctx, target, targetID, moderatorID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ModerationTarget, uuid.UUID, uuid.UUID, time.Time) *models.ForumReportCase); ok {
+		r0 = rf(ctx, target, targetID, moderatorID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ForumReportCase)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ModerationTarget, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, target, targetID, moderatorID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Claim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Claim'
+type MockService_Claim_Call struct {
+	*mock.Call
+}
+
+// Claim is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target models.ModerationTarget
+//   - targetID uuid.UUID
+//   - moderatorID uuid.UUID
+//   - now time.Time
+func (_e *MockService_Expecter) Claim(ctx interface{}, target interface{}, targetID interface{}, moderatorID interface{}, now interface{}) *MockService_Claim_Call {
+	return &MockService_Claim_Call{Call: _e.mock.On("Claim", ctx, target, targetID, moderatorID, now)}
+}
+
+func (_c *MockService_Claim_Call) Run(run func(ctx context.Context, target models.ModerationTarget, targetID uuid.UUID, moderatorID uuid.UUID, now time.Time)) *MockService_Claim_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ModerationTarget), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Claim_Call) Return(_a0 *models.ForumReportCase, _a1 error) *MockService_Claim_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Claim_Call) RunAndReturn(run func(context.Context, models.ModerationTarget, uuid.UUID, uuid.UUID, time.Time) (*models.ForumReportCase, error)) *MockService_Claim_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, target, targetID, reporterID, reason, content, id, now
+func (_m *MockService) Create(ctx context.Context, target models.ModerationTarget, targetID uuid.UUID, reporterID uuid.UUID, reason models.ReportReason, content string, id uuid.UUID, now time.Time) (*models.ForumReportCase, error) {
+	ret := _m.Called(ctx, target, targetID, reporterID, reason, content, id, now)
+
+	var r0 *models.ForumReportCase
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ModerationTarget, uuid.UUID, uuid.UUID, models.ReportReason, string, uuid.UUID, time.Time) (*models.ForumReportCase, error)); ok {
+		return rf(ctx, target, targetID, reporterID, reason, content, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ModerationTarget, uuid.UUID, uuid.UUID, models.ReportReason, string, uuid.UUID, time.Time) *models.ForumReportCase); ok {
+		r0 = rf(ctx, target, targetID, reporterID, reason, content, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ForumReportCase)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ModerationTarget, uuid.UUID, uuid.UUID, models.ReportReason, string, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, target, targetID, reporterID, reason, content, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target models.ModerationTarget
+//   - targetID uuid.UUID
+//   - reporterID uuid.UUID
+//   - reason models.ReportReason
+//   - content string
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *MockService_Expecter) Create(ctx interface{}, target interface{}, targetID interface{}, reporterID interface{}, reason interface{}, content interface{}, id interface{}, now interface{}) *MockService_Create_Call {
+	return &MockService_Create_Call{Call: _e.mock.On("Create", ctx, target, targetID, reporterID, reason, content, id, now)}
+}
+
+func (_c *MockService_Create_Call) Run(run func(ctx context.Context, target models.ModerationTarget, targetID uuid.UUID, reporterID uuid.UUID, reason models.ReportReason, content string, id uuid.UUID, now time.Time)) *MockService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ModerationTarget), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(models.ReportReason), args[5].(string), args[6].(uuid.UUID), args[7].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Create_Call) Return(_a0 *models.ForumReportCase, _a1 error) *MockService_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Create_Call) RunAndReturn(run func(context.Context, models.ModerationTarget, uuid.UUID, uuid.UUID, models.ReportReason, string, uuid.UUID, time.Time) (*models.ForumReportCase, error)) *MockService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Dismiss provides a mock function with given fields: ctx, target, targetID, moderatorID, now
+func (_m *MockService) Dismiss(ctx context.Context, target models.ModerationTarget, targetID uuid.UUID, moderatorID uuid.UUID, now time.Time) (*models.ForumReportCase, error) {
+	ret := _m.Called(ctx, target, targetID, moderatorID, now)
+
+	var r0 *models.ForumReportCase
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ModerationTarget, uuid.UUID, uuid.UUID, time.Time) (*models.ForumReportCase, error)); ok {
+		return rf(ctx, target, targetID, moderatorID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ModerationTarget, uuid.UUID, uuid.UUID, time.Time) *models.ForumReportCase); ok {
+		r0 = rf(ctx, target, targetID, moderatorID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ForumReportCase)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ModerationTarget, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, target, targetID, moderatorID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Dismiss_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dismiss'
+type MockService_Dismiss_Call struct {
+	*mock.Call
+}
+
+// Dismiss is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target models.ModerationTarget
+//   - targetID uuid.UUID
+//   - moderatorID uuid.UUID
+//   - now time.Time
+func (_e *MockService_Expecter) Dismiss(ctx interface{}, target interface{}, targetID interface{}, moderatorID interface{}, now interface{}) *MockService_Dismiss_Call {
+	return &MockService_Dismiss_Call{Call: _e.mock.On("Dismiss", ctx, target, targetID, moderatorID, now)}
+}
+
+func (_c *MockService_Dismiss_Call) Run(run func(ctx context.Context, target models.ModerationTarget, targetID uuid.UUID, moderatorID uuid.UUID, now time.Time)) *MockService_Dismiss_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ModerationTarget), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Dismiss_Call) Return(_a0 *models.ForumReportCase, _a1 error) *MockService_Dismiss_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Dismiss_Call) RunAndReturn(run func(context.Context, models.ModerationTarget, uuid.UUID, uuid.UUID, time.Time) (*models.ForumReportCase, error)) *MockService_Dismiss_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Hide provides a mock function with given fields: ctx, target, targetID, now
+func (_m *MockService) Hide(ctx context.Context, target models.ModerationTarget, targetID uuid.UUID, now time.Time) (*models.ForumReportCase, error) {
+	ret := _m.Called(ctx, target, targetID, now)
+
+	var r0 *models.ForumReportCase
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ModerationTarget, uuid.UUID, time.Time) (*models.ForumReportCase, error)); ok {
+		return rf(ctx, target, targetID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ModerationTarget, uuid.UUID, time.Time) *models.ForumReportCase); ok {
+		r0 = rf(ctx, target, targetID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ForumReportCase)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ModerationTarget, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, target, targetID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Hide_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hide'
+type MockService_Hide_Call struct {
+	*mock.Call
+}
+
+// Hide is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target models.ModerationTarget
+//   - targetID uuid.UUID
+//   - now time.Time
+func (_e *MockService_Expecter) Hide(ctx interface{}, target interface{}, targetID interface{}, now interface{}) *MockService_Hide_Call {
+	return &MockService_Hide_Call{Call: _e.mock.On("Hide", ctx, target, targetID, now)}
+}
+
+func (_c *MockService_Hide_Call) Run(run func(ctx context.Context, target models.ModerationTarget, targetID uuid.UUID, now time.Time)) *MockService_Hide_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ModerationTarget), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Hide_Call) Return(_a0 *models.ForumReportCase, _a1 error) *MockService_Hide_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Hide_Call) RunAndReturn(run func(context.Context, models.ModerationTarget, uuid.UUID, time.Time) (*models.ForumReportCase, error)) *MockService_Hide_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCases provides a mock function with given fields: ctx, statuses, limit, offset
+func (_m *MockService) ListCases(ctx context.Context, statuses []models.ReportStatus, limit int, offset int) ([]*models.ForumReportCase, int64, error) {
+	ret := _m.Called(ctx, statuses, limit, offset)
+
+	var r0 []*models.ForumReportCase
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.ReportStatus, int, int) ([]*models.ForumReportCase, int64, error)); ok {
+		return rf(ctx, statuses, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []models.ReportStatus, int, int) []*models.ForumReportCase); ok {
+		r0 = rf(ctx, statuses, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ForumReportCase)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []models.ReportStatus, int, int) int64); ok {
+		r1 = rf(ctx, statuses, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []models.ReportStatus, int, int) error); ok {
+		r2 = rf(ctx, statuses, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockService_ListCases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCases'
+type MockService_ListCases_Call struct {
+	*mock.Call
+}
+
+// ListCases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - statuses []models.ReportStatus
+//   - limit int
+//   - offset int
+func (_e *MockService_Expecter) ListCases(ctx interface{}, statuses interface{}, limit interface{}, offset interface{}) *MockService_ListCases_Call {
+	return &MockService_ListCases_Call{Call: _e.mock.On("ListCases", ctx, statuses, limit, offset)}
+}
+
+func (_c *MockService_ListCases_Call) Run(run func(ctx context.Context, statuses []models.ReportStatus, limit int, offset int)) *MockService_ListCases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]models.ReportStatus), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockService_ListCases_Call) Return(_a0 []*models.ForumReportCase, _a1 int64, _a2 error) *MockService_ListCases_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockService_ListCases_Call) RunAndReturn(run func(context.Context, []models.ReportStatus, int, int) ([]*models.ForumReportCase, int64, error)) *MockService_ListCases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReports provides a mock function with given fields: ctx, target, targetID
+func (_m *MockService) ListReports(ctx context.Context, target models.ModerationTarget, targetID uuid.UUID) ([]*models.ForumReport, error) {
+	ret := _m.Called(ctx, target, targetID)
+
+	var r0 []*models.ForumReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ModerationTarget, uuid.UUID) ([]*models.ForumReport, error)); ok {
+		return rf(ctx, target, targetID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ModerationTarget, uuid.UUID) []*models.ForumReport); ok {
+		r0 = rf(ctx, target, targetID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ForumReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ModerationTarget, uuid.UUID) error); ok {
+		r1 = rf(ctx, target, targetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReports'
+type MockService_ListReports_Call struct {
+	*mock.Call
+}
+
+// ListReports is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target models.ModerationTarget
+//   - targetID uuid.UUID
+func (_e *MockService_Expecter) ListReports(ctx interface{}, target interface{}, targetID interface{}) *MockService_ListReports_Call {
+	return &MockService_ListReports_Call{Call: _e.mock.On("ListReports", ctx, target, targetID)}
+}
+
+func (_c *MockService_ListReports_Call) Run(run func(ctx context.Context, target models.ModerationTarget, targetID uuid.UUID)) *MockService_ListReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ModerationTarget), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_ListReports_Call) Return(_a0 []*models.ForumReport, _a1 error) *MockService_ListReports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListReports_Call) RunAndReturn(run func(context.Context, models.ModerationTarget, uuid.UUID) ([]*models.ForumReport, error)) *MockService_ListReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadCase provides a mock function with given fields: ctx, target, targetID
+func (_m *MockService) ReadCase(ctx context.Context, target models.ModerationTarget, targetID uuid.UUID) (*models.ForumReportCase, error) {
+	ret := _m.Called(ctx, target, targetID)
+
+	var r0 *models.ForumReportCase
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ModerationTarget, uuid.UUID) (*models.ForumReportCase, error)); ok {
+		return rf(ctx, target, targetID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ModerationTarget, uuid.UUID) *models.ForumReportCase); ok {
+		r0 = rf(ctx, target, targetID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ForumReportCase)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ModerationTarget, uuid.UUID) error); ok {
+		r1 = rf(ctx, target, targetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ReadCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadCase'
+type MockService_ReadCase_Call struct {
+	*mock.Call
+}
+
+// ReadCase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target models.ModerationTarget
+//   - targetID uuid.UUID
+func (_e *MockService_Expecter) ReadCase(ctx interface{}, target interface{}, targetID interface{}) *MockService_ReadCase_Call {
+	return &MockService_ReadCase_Call{Call: _e.mock.On("ReadCase", ctx, target, targetID)}
+}
+
+func (_c *MockService_ReadCase_Call) Run(run func(ctx context.Context, target models.ModerationTarget, targetID uuid.UUID)) *MockService_ReadCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ModerationTarget), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_ReadCase_Call) Return(_a0 *models.ForumReportCase, _a1 error) *MockService_ReadCase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ReadCase_Call) RunAndReturn(run func(context.Context, models.ModerationTarget, uuid.UUID) (*models.ForumReportCase, error)) *MockService_ReadCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resolve provides a mock function with given fields: ctx, target, targetID, moderatorID, now
+func (_m *MockService) Resolve(ctx context.Context, target models.ModerationTarget, targetID uuid.UUID, moderatorID uuid.UUID, now time.Time) (*models.ForumReportCase, error) {
+	ret := _m.Called(ctx, target, targetID, moderatorID, now)
+
+	var r0 *models.ForumReportCase
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ModerationTarget, uuid.UUID, uuid.UUID, time.Time) (*models.ForumReportCase, error)); ok {
+		return rf(ctx, target, targetID, moderatorID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ModerationTarget, uuid.UUID, uuid.UUID, time.Time) *models.ForumReportCase); ok {
+		r0 = rf(ctx, target, targetID, moderatorID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ForumReportCase)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ModerationTarget, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, target, targetID, moderatorID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Resolve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resolve'
+type MockService_Resolve_Call struct {
+	*mock.Call
+}
+
+// Resolve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target models.ModerationTarget
+//   - targetID uuid.UUID
+//   - moderatorID uuid.UUID
+//   - now time.Time
+func (_e *MockService_Expecter) Resolve(ctx interface{}, target interface{}, targetID interface{}, moderatorID interface{}, now interface{}) *MockService_Resolve_Call {
+	return &MockService_Resolve_Call{Call: _e.mock.On("Resolve", ctx, target, targetID, moderatorID, now)}
+}
+
+func (_c *MockService_Resolve_Call) Run(run func(ctx context.Context, target models.ModerationTarget, targetID uuid.UUID, moderatorID uuid.UUID, now time.Time)) *MockService_Resolve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ModerationTarget), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Resolve_Call) Return(_a0 *models.ForumReportCase, _a1 error) *MockService_Resolve_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Resolve_Call) RunAndReturn(run func(context.Context, models.ModerationTarget, uuid.UUID, uuid.UUID, time.Time) (*models.ForumReportCase, error)) *MockService_Resolve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockService(t mockConstructorTestingTNewMockService) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
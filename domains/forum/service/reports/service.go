@@ -0,0 +1,221 @@
+package reports_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	MaxContentLength = 1024
+)
+
+// Service of the current layer. You can instantiate a new one with NewService.
+type Service interface {
+	// Create saves a new report, and returns the updated case of the reported content. A content can only be
+	// reported once by the same user.
+	Create(ctx context.Context, target models.ModerationTarget, targetID, reporterID uuid.UUID, reason models.ReportReason, content string, id uuid.UUID, now time.Time) (*models.ForumReportCase, error)
+	// ListReports returns every report of a content, oldest first.
+	ListReports(ctx context.Context, target models.ModerationTarget, targetID uuid.UUID) ([]*models.ForumReport, error)
+	// ReadCase returns the case of a reported content.
+	ReadCase(ctx context.Context, target models.ModerationTarget, targetID uuid.UUID) (*models.ForumReportCase, error)
+	// ListCases returns the moderation queue, most reported contents first. Only the cases with one of the given
+	// statuses are returned, or every case if none is given.
+	ListCases(ctx context.Context, statuses []models.ReportStatus, limit, offset int) ([]*models.ForumReportCase, int64, error)
+
+	// Hide removes a reported content from public listings, until its case is dismissed.
+	Hide(ctx context.Context, target models.ModerationTarget, targetID uuid.UUID, now time.Time) (*models.ForumReportCase, error)
+	// Claim assigns a pending case to a moderator.
+	Claim(ctx context.Context, target models.ModerationTarget, targetID, moderatorID uuid.UUID, now time.Time) (*models.ForumReportCase, error)
+	// Resolve upholds a case. The content remains hidden.
+	Resolve(ctx context.Context, target models.ModerationTarget, targetID, moderatorID uuid.UUID, now time.Time) (*models.ForumReportCase, error)
+	// Dismiss rejects a case. The content is visible again.
+	Dismiss(ctx context.Context, target models.ModerationTarget, targetID, moderatorID uuid.UUID, now time.Time) (*models.ForumReportCase, error)
+}
+
+type serviceImpl struct {
+	repository reports_storage.Repository
+}
+
+// NewService returns a new implementation of Service.
+func NewService(repository reports_storage.Repository) Service {
+	return &serviceImpl{repository: repository}
+}
+
+func (service *serviceImpl) Create(ctx context.Context, target models.ModerationTarget, targetID, reporterID uuid.UUID, reason models.ReportReason, content string, id uuid.UUID, now time.Time) (*models.ForumReportCase, error) {
+	if err := CheckTarget(target); err != nil {
+		return nil, err
+	}
+	if err := validation.CheckRestricted(
+		"reason", reason,
+		models.ReportReasonSpam,
+		models.ReportReasonHarassment,
+		models.ReportReasonOffTopic,
+		models.ReportReasonPlagiarism,
+		models.ReportReasonInappropriate,
+		models.ReportReasonOther,
+	); err != nil {
+		return nil, err
+	}
+
+	// Reports without a category must be explained.
+	minContentLength := -1
+	if reason == models.ReportReasonOther {
+		minContentLength = 1
+	}
+	if err := validation.CheckMinMax("content", content, minContentLength, MaxContentLength); err != nil {
+		return nil, err
+	}
+
+	storageModel, err := service.repository.Create(ctx, &reports_storage.Model{
+		ID:         id,
+		CreatedAt:  now,
+		Target:     reports_storage.Target(target),
+		TargetID:   targetID,
+		ReporterID: reporterID,
+		Reason:     reports_storage.Reason(reason),
+		Content:    content,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create report: %w", err)
+	}
+
+	return service.caseToModel(storageModel), nil
+}
+
+func (service *serviceImpl) ListReports(ctx context.Context, target models.ModerationTarget, targetID uuid.UUID) ([]*models.ForumReport, error) {
+	storageModels, err := service.repository.ListReports(ctx, reports_storage.Target(target), targetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reports: %w", err)
+	}
+
+	reports := make([]*models.ForumReport, len(storageModels))
+	for i, storageModel := range storageModels {
+		reports[i] = service.reportToModel(storageModel)
+	}
+
+	return reports, nil
+}
+
+func (service *serviceImpl) ReadCase(ctx context.Context, target models.ModerationTarget, targetID uuid.UUID) (*models.ForumReportCase, error) {
+	storageModel, err := service.repository.ReadCase(ctx, reports_storage.Target(target), targetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read report case: %w", err)
+	}
+
+	return service.caseToModel(storageModel), nil
+}
+
+func (service *serviceImpl) ListCases(ctx context.Context, statuses []models.ReportStatus, limit, offset int) ([]*models.ForumReportCase, int64, error) {
+	storageStatuses := make([]reports_storage.Status, len(statuses))
+	for i, status := range statuses {
+		if err := validation.CheckRestricted(
+			"statuses", status,
+			models.ReportStatusPending,
+			models.ReportStatusClaimed,
+			models.ReportStatusResolved,
+			models.ReportStatusDismissed,
+		); err != nil {
+			return nil, 0, err
+		}
+
+		storageStatuses[i] = reports_storage.Status(status)
+	}
+
+	storageModels, total, err := service.repository.ListCases(ctx, storageStatuses, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list report cases: %w", err)
+	}
+
+	cases := make([]*models.ForumReportCase, len(storageModels))
+	for i, storageModel := range storageModels {
+		cases[i] = service.caseToModel(storageModel)
+	}
+
+	return cases, total, nil
+}
+
+func (service *serviceImpl) Hide(ctx context.Context, target models.ModerationTarget, targetID uuid.UUID, now time.Time) (*models.ForumReportCase, error) {
+	storageModel, err := service.repository.Hide(ctx, reports_storage.Target(target), targetID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hide reported content: %w", err)
+	}
+
+	return service.caseToModel(storageModel), nil
+}
+
+func (service *serviceImpl) Claim(ctx context.Context, target models.ModerationTarget, targetID, moderatorID uuid.UUID, now time.Time) (*models.ForumReportCase, error) {
+	storageModel, err := service.repository.Claim(ctx, reports_storage.Target(target), targetID, moderatorID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim report case: %w", err)
+	}
+
+	return service.caseToModel(storageModel), nil
+}
+
+func (service *serviceImpl) Resolve(ctx context.Context, target models.ModerationTarget, targetID, moderatorID uuid.UUID, now time.Time) (*models.ForumReportCase, error) {
+	storageModel, err := service.repository.Resolve(ctx, reports_storage.Target(target), targetID, moderatorID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve report case: %w", err)
+	}
+
+	return service.caseToModel(storageModel), nil
+}
+
+func (service *serviceImpl) Dismiss(ctx context.Context, target models.ModerationTarget, targetID, moderatorID uuid.UUID, now time.Time) (*models.ForumReportCase, error) {
+	storageModel, err := service.repository.Dismiss(ctx, reports_storage.Target(target), targetID, moderatorID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dismiss report case: %w", err)
+	}
+
+	return service.caseToModel(storageModel), nil
+}
+
+// CheckTarget returns validation.ErrInvalidEntity if the target cannot be reported.
+func CheckTarget(target models.ModerationTarget) error {
+	return validation.CheckRestricted(
+		"target", target,
+		models.ModerationTargetImproveRequest,
+		models.ModerationTargetImproveSuggestion,
+		models.ModerationTargetProfile,
+	)
+}
+
+func (service *serviceImpl) reportToModel(source *reports_storage.Model) *models.ForumReport {
+	if source == nil {
+		return nil
+	}
+
+	return &models.ForumReport{
+		ID:         source.ID,
+		CreatedAt:  source.CreatedAt,
+		Target:     models.ModerationTarget(source.Target),
+		TargetID:   source.TargetID,
+		ReporterID: source.ReporterID,
+		Reason:     models.ReportReason(source.Reason),
+		Content:    source.Content,
+	}
+}
+
+func (service *serviceImpl) caseToModel(source *reports_storage.Case) *models.ForumReportCase {
+	if source == nil {
+		return nil
+	}
+
+	return &models.ForumReportCase{
+		Target:    models.ModerationTarget(source.Target),
+		TargetID:  source.TargetID,
+		Status:    models.ReportStatus(source.Status),
+		Reports:   source.Reports,
+		CreatedAt: source.CreatedAt,
+		UpdatedAt: source.UpdatedAt,
+		ClaimedBy: source.ClaimedBy,
+		ClaimedAt: source.ClaimedAt,
+		ClosedBy:  source.ClosedBy,
+		ClosedAt:  source.ClosedAt,
+		HiddenAt:  source.HiddenAt,
+	}
+}
@@ -0,0 +1,482 @@
+package reports_service
+
+import (
+	"context"
+	"errors"
+	"github.com/a-novel/agora-backend/framework"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	fooErr   = errors.New("it broken")
+)
+
+func TestReportsService_Create(t *testing.T) {
+	data := []struct {
+		name string
+
+		target     models.ModerationTarget
+		targetID   uuid.UUID
+		reporterID uuid.UUID
+		reason     models.ReportReason
+		content    string
+		id         uuid.UUID
+		now        time.Time
+
+		shouldCallRepository bool
+		createData           *reports_storage.Case
+		createErr            error
+
+		expect    *models.ForumReportCase
+		expectErr error
+	}{
+		{
+			name:                 "Success",
+			target:               models.ModerationTargetImproveSuggestion,
+			targetID:             test_utils.NumberUUID(10),
+			reporterID:           test_utils.NumberUUID(100),
+			reason:               models.ReportReasonSpam,
+			id:                   test_utils.NumberUUID(1),
+			now:                  baseTime,
+			shouldCallRepository: true,
+			createData: &reports_storage.Case{
+				Target:    reports_storage.TargetImproveSuggestion,
+				TargetID:  test_utils.NumberUUID(10),
+				Status:    reports_storage.StatusPending,
+				Reports:   2,
+				CreatedAt: baseTime.Add(-time.Hour),
+				UpdatedAt: &baseTime,
+			},
+			expect: &models.ForumReportCase{
+				Target:    models.ModerationTargetImproveSuggestion,
+				TargetID:  test_utils.NumberUUID(10),
+				Status:    models.ReportStatusPending,
+				Reports:   2,
+				CreatedAt: baseTime.Add(-time.Hour),
+				UpdatedAt: &baseTime,
+			},
+		},
+		{
+			name:                 "Success/OtherWithContent",
+			target:               models.ModerationTargetProfile,
+			targetID:             test_utils.NumberUUID(10),
+			reporterID:           test_utils.NumberUUID(100),
+			reason:               models.ReportReasonOther,
+			content:              "impersonates another author",
+			id:                   test_utils.NumberUUID(1),
+			now:                  baseTime,
+			shouldCallRepository: true,
+			createData: &reports_storage.Case{
+				Target:    reports_storage.TargetProfile,
+				TargetID:  test_utils.NumberUUID(10),
+				Status:    reports_storage.StatusPending,
+				Reports:   1,
+				CreatedAt: baseTime,
+			},
+			expect: &models.ForumReportCase{
+				Target:    models.ModerationTargetProfile,
+				TargetID:  test_utils.NumberUUID(10),
+				Status:    models.ReportStatusPending,
+				Reports:   1,
+				CreatedAt: baseTime,
+			},
+		},
+		{
+			name:       "Error/UnknownTarget",
+			target:     "comment",
+			targetID:   test_utils.NumberUUID(10),
+			reporterID: test_utils.NumberUUID(100),
+			reason:     models.ReportReasonSpam,
+			id:         test_utils.NumberUUID(1),
+			now:        baseTime,
+			expectErr:  validation.ErrInvalidEntity,
+		},
+		{
+			name:       "Error/UnknownReason",
+			target:     models.ModerationTargetImproveRequest,
+			targetID:   test_utils.NumberUUID(10),
+			reporterID: test_utils.NumberUUID(100),
+			reason:     "boring",
+			id:         test_utils.NumberUUID(1),
+			now:        baseTime,
+			expectErr:  validation.ErrInvalidEntity,
+		},
+		{
+			name:       "Error/OtherWithoutContent",
+			target:     models.ModerationTargetImproveRequest,
+			targetID:   test_utils.NumberUUID(10),
+			reporterID: test_utils.NumberUUID(100),
+			reason:     models.ReportReasonOther,
+			id:         test_utils.NumberUUID(1),
+			now:        baseTime,
+			expectErr:  validation.ErrInvalidEntity,
+		},
+		{
+			name:       "Error/ContentTooLong",
+			target:     models.ModerationTargetImproveRequest,
+			targetID:   test_utils.NumberUUID(10),
+			reporterID: test_utils.NumberUUID(100),
+			reason:     models.ReportReasonSpam,
+			content:    strings.Repeat("a", MaxContentLength+1),
+			id:         test_utils.NumberUUID(1),
+			now:        baseTime,
+			expectErr:  validation.ErrInvalidEntity,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			target:               models.ModerationTargetImproveRequest,
+			targetID:             test_utils.NumberUUID(10),
+			reporterID:           test_utils.NumberUUID(100),
+			reason:               models.ReportReasonSpam,
+			id:                   test_utils.NumberUUID(1),
+			now:                  baseTime,
+			shouldCallRepository: true,
+			createErr:            fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := reports_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("Create", context.TODO(), &reports_storage.Model{
+						ID:         d.id,
+						CreatedAt:  d.now,
+						Target:     reports_storage.Target(d.target),
+						TargetID:   d.targetID,
+						ReporterID: d.reporterID,
+						Reason:     reports_storage.Reason(d.reason),
+						Content:    d.content,
+					}).
+					Return(d.createData, d.createErr)
+			}
+
+			service := NewService(repository)
+			res, err := service.Create(context.TODO(), d.target, d.targetID, d.reporterID, d.reason, d.content, d.id, d.now)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestReportsService_ListReports(t *testing.T) {
+	data := []struct {
+		name string
+
+		target   models.ModerationTarget
+		targetID uuid.UUID
+
+		listData []*reports_storage.Model
+		listErr  error
+
+		expect    []*models.ForumReport
+		expectErr error
+	}{
+		{
+			name:     "Success",
+			target:   models.ModerationTargetImproveRequest,
+			targetID: test_utils.NumberUUID(10),
+			listData: []*reports_storage.Model{
+				{
+					ID:         test_utils.NumberUUID(1),
+					CreatedAt:  baseTime,
+					Target:     reports_storage.TargetImproveRequest,
+					TargetID:   test_utils.NumberUUID(10),
+					ReporterID: test_utils.NumberUUID(100),
+					Reason:     reports_storage.ReasonHarassment,
+					Content:    "rude",
+				},
+			},
+			expect: []*models.ForumReport{
+				{
+					ID:         test_utils.NumberUUID(1),
+					CreatedAt:  baseTime,
+					Target:     models.ModerationTargetImproveRequest,
+					TargetID:   test_utils.NumberUUID(10),
+					ReporterID: test_utils.NumberUUID(100),
+					Reason:     models.ReportReasonHarassment,
+					Content:    "rude",
+				},
+			},
+		},
+		{
+			name:     "Success/NoResults",
+			target:   models.ModerationTargetImproveRequest,
+			targetID: test_utils.NumberUUID(10),
+			listData: []*reports_storage.Model{},
+			expect:   []*models.ForumReport{},
+		},
+		{
+			name:      "Error/RepositoryFailure",
+			target:    models.ModerationTargetImproveRequest,
+			targetID:  test_utils.NumberUUID(10),
+			listErr:   fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := reports_storage.NewMockRepository(st)
+
+			repository.
+				On("ListReports", context.TODO(), reports_storage.Target(d.target), d.targetID).
+				Return(d.listData, d.listErr)
+
+			service := NewService(repository)
+			res, err := service.ListReports(context.TODO(), d.target, d.targetID)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestReportsService_ListCases(t *testing.T) {
+	data := []struct {
+		name string
+
+		statuses []models.ReportStatus
+		limit    int
+		offset   int
+
+		shouldCallRepository bool
+		listData             []*reports_storage.Case
+		listCount            int64
+		listErr              error
+
+		expect      []*models.ForumReportCase
+		expectCount int64
+		expectErr   error
+	}{
+		{
+			name:                 "Success",
+			statuses:             []models.ReportStatus{models.ReportStatusPending, models.ReportStatusClaimed},
+			limit:                10,
+			shouldCallRepository: true,
+			listData: []*reports_storage.Case{
+				{
+					Target:    reports_storage.TargetProfile,
+					TargetID:  test_utils.NumberUUID(10),
+					Status:    reports_storage.StatusClaimed,
+					Reports:   4,
+					CreatedAt: baseTime,
+					ClaimedBy: framework.ToPTR(test_utils.NumberUUID(100)),
+					ClaimedAt: &baseTime,
+					HiddenAt:  &baseTime,
+				},
+			},
+			listCount: 1,
+			expect: []*models.ForumReportCase{
+				{
+					Target:    models.ModerationTargetProfile,
+					TargetID:  test_utils.NumberUUID(10),
+					Status:    models.ReportStatusClaimed,
+					Reports:   4,
+					CreatedAt: baseTime,
+					ClaimedBy: framework.ToPTR(test_utils.NumberUUID(100)),
+					ClaimedAt: &baseTime,
+					HiddenAt:  &baseTime,
+				},
+			},
+			expectCount: 1,
+		},
+		{
+			name:                 "Success/AllStatuses",
+			limit:                10,
+			shouldCallRepository: true,
+			listData:             []*reports_storage.Case{},
+			expect:               []*models.ForumReportCase{},
+		},
+		{
+			name:      "Error/UnknownStatus",
+			statuses:  []models.ReportStatus{models.ReportStatusPending, "archived"},
+			limit:     10,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			limit:                10,
+			shouldCallRepository: true,
+			listErr:              fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := reports_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				storageStatuses := make([]reports_storage.Status, len(d.statuses))
+				for i, status := range d.statuses {
+					storageStatuses[i] = reports_storage.Status(status)
+				}
+
+				repository.
+					On("ListCases", context.TODO(), storageStatuses, d.limit, d.offset).
+					Return(d.listData, d.listCount, d.listErr)
+			}
+
+			service := NewService(repository)
+			res, count, err := service.ListCases(context.TODO(), d.statuses, d.limit, d.offset)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+			require.Equal(st, d.expectCount, count)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestReportsService_UpdateCase(t *testing.T) {
+	moderatorID := test_utils.NumberUUID(100)
+
+	data := []struct {
+		name string
+
+		method string
+
+		updateData *reports_storage.Case
+		updateErr  error
+
+		expect    *models.ForumReportCase
+		expectErr error
+	}{
+		{
+			name:   "Claim",
+			method: "Claim",
+			updateData: &reports_storage.Case{
+				Target:    reports_storage.TargetImproveRequest,
+				TargetID:  test_utils.NumberUUID(10),
+				Status:    reports_storage.StatusClaimed,
+				Reports:   1,
+				CreatedAt: baseTime,
+				UpdatedAt: &baseTime,
+				ClaimedBy: &moderatorID,
+				ClaimedAt: &baseTime,
+			},
+			expect: &models.ForumReportCase{
+				Target:    models.ModerationTargetImproveRequest,
+				TargetID:  test_utils.NumberUUID(10),
+				Status:    models.ReportStatusClaimed,
+				Reports:   1,
+				CreatedAt: baseTime,
+				UpdatedAt: &baseTime,
+				ClaimedBy: &moderatorID,
+				ClaimedAt: &baseTime,
+			},
+		},
+		{
+			name:   "Resolve",
+			method: "Resolve",
+			updateData: &reports_storage.Case{
+				Target:    reports_storage.TargetImproveRequest,
+				TargetID:  test_utils.NumberUUID(10),
+				Status:    reports_storage.StatusResolved,
+				Reports:   1,
+				CreatedAt: baseTime,
+				UpdatedAt: &baseTime,
+				ClosedBy:  &moderatorID,
+				ClosedAt:  &baseTime,
+				HiddenAt:  &baseTime,
+			},
+			expect: &models.ForumReportCase{
+				Target:    models.ModerationTargetImproveRequest,
+				TargetID:  test_utils.NumberUUID(10),
+				Status:    models.ReportStatusResolved,
+				Reports:   1,
+				CreatedAt: baseTime,
+				UpdatedAt: &baseTime,
+				ClosedBy:  &moderatorID,
+				ClosedAt:  &baseTime,
+				HiddenAt:  &baseTime,
+			},
+		},
+		{
+			name:   "Dismiss",
+			method: "Dismiss",
+			updateData: &reports_storage.Case{
+				Target:    reports_storage.TargetImproveRequest,
+				TargetID:  test_utils.NumberUUID(10),
+				Status:    reports_storage.StatusDismissed,
+				Reports:   1,
+				CreatedAt: baseTime,
+				UpdatedAt: &baseTime,
+				ClosedBy:  &moderatorID,
+				ClosedAt:  &baseTime,
+			},
+			expect: &models.ForumReportCase{
+				Target:    models.ModerationTargetImproveRequest,
+				TargetID:  test_utils.NumberUUID(10),
+				Status:    models.ReportStatusDismissed,
+				Reports:   1,
+				CreatedAt: baseTime,
+				UpdatedAt: &baseTime,
+				ClosedBy:  &moderatorID,
+				ClosedAt:  &baseTime,
+			},
+		},
+		{
+			name:      "Claim/Error/RepositoryFailure",
+			method:    "Claim",
+			updateErr: fooErr,
+			expectErr: fooErr,
+		},
+		{
+			name:      "Resolve/Error/RepositoryFailure",
+			method:    "Resolve",
+			updateErr: fooErr,
+			expectErr: fooErr,
+		},
+		{
+			name:      "Dismiss/Error/RepositoryFailure",
+			method:    "Dismiss",
+			updateErr: fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := reports_storage.NewMockRepository(st)
+
+			repository.
+				On(d.method, context.TODO(), reports_storage.TargetImproveRequest, test_utils.NumberUUID(10), moderatorID, baseTime).
+				Return(d.updateData, d.updateErr)
+
+			service := NewService(repository)
+
+			var (
+				res *models.ForumReportCase
+				err error
+			)
+			switch d.method {
+			case "Claim":
+				res, err = service.Claim(context.TODO(), models.ModerationTargetImproveRequest, test_utils.NumberUUID(10), moderatorID, baseTime)
+			case "Resolve":
+				res, err = service.Resolve(context.TODO(), models.ModerationTargetImproveRequest, test_utils.NumberUUID(10), moderatorID, baseTime)
+			case "Dismiss":
+				res, err = service.Dismiss(context.TODO(), models.ModerationTargetImproveRequest, test_utils.NumberUUID(10), moderatorID, baseTime)
+			}
+
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
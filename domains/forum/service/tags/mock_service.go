@@ -0,0 +1,300 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package tags_service
+
+import (
+	context "context"
+
+	"github.com/a-novel/agora-backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	"github.com/a-novel/agora-backend/domains/forum/storage/tags"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, data, id, now
+func (_m *MockService) Create(ctx context.Context, data *models.ForumTagCreate, id uuid.UUID, now time.Time) (*models.ForumTag, error) {
+	ret := _m.Called(ctx, data, id, now)
+
+	var r0 *models.ForumTag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ForumTagCreate, uuid.UUID, time.Time) (*models.ForumTag, error)); ok {
+		return rf(ctx, data, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ForumTagCreate, uuid.UUID, time.Time) *models.ForumTag); ok {
+		r0 = rf(ctx, data, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ForumTag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ForumTagCreate, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, data, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *models.ForumTagCreate
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *MockService_Expecter) Create(ctx interface{}, data interface{}, id interface{}, now interface{}) *MockService_Create_Call {
+	return &MockService_Create_Call{Call: _e.mock.On("Create", ctx, data, id, now)}
+}
+
+func (_c *MockService_Create_Call) Run(run func(ctx context.Context, data *models.ForumTagCreate, id uuid.UUID, now time.Time)) *MockService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.ForumTagCreate), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Create_Call) Return(_a0 *models.ForumTag, _a1 error) *MockService_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Create_Call) RunAndReturn(run func(context.Context, *models.ForumTagCreate, uuid.UUID, time.Time) (*models.ForumTag, error)) *MockService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockService) Delete(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockService_Expecter) Delete(ctx interface{}, id interface{}) *MockService_Delete_Call {
+	return &MockService_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockService_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_Delete_Call) Return(_a0 error) *MockService_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, category
+func (_m *MockService) List(ctx context.Context, category *models.ForumTagCategory) ([]*models.ForumTag, error) {
+	ret := _m.Called(ctx, category)
+
+	var r0 []*models.ForumTag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ForumTagCategory) ([]*models.ForumTag, error)); ok {
+		return rf(ctx, category)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ForumTagCategory) []*models.ForumTag); ok {
+		r0 = rf(ctx, category)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ForumTag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ForumTagCategory) error); ok {
+		r1 = rf(ctx, category)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category *models.ForumTagCategory
+func (_e *MockService_Expecter) List(ctx interface{}, category interface{}) *MockService_List_Call {
+	return &MockService_List_Call{Call: _e.mock.On("List", ctx, category)}
+}
+
+func (_c *MockService_List_Call) Run(run func(ctx context.Context, category *models.ForumTagCategory)) *MockService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.ForumTagCategory))
+	})
+	return _c
+}
+
+func (_c *MockService_List_Call) Return(_a0 []*models.ForumTag, _a1 error) *MockService_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_List_Call) RunAndReturn(run func(context.Context, *models.ForumTagCategory) ([]*models.ForumTag, error)) *MockService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function with given fields: ctx, query, category, limit
+func (_m *MockService) Search(ctx context.Context, query string, category *models.ForumTagCategory, limit int) ([]*models.ForumTag, error) {
+	ret := _m.Called(ctx, query, category, limit)
+
+	var r0 []*models.ForumTag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.ForumTagCategory, int) ([]*models.ForumTag, error)); ok {
+		return rf(ctx, query, category, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *models.ForumTagCategory, int) []*models.ForumTag); ok {
+		r0 = rf(ctx, query, category, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ForumTag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *models.ForumTagCategory, int) error); ok {
+		r1 = rf(ctx, query, category, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockService_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - category *models.ForumTagCategory
+//   - limit int
+func (_e *MockService_Expecter) Search(ctx interface{}, query interface{}, category interface{}, limit interface{}) *MockService_Search_Call {
+	return &MockService_Search_Call{Call: _e.mock.On("Search", ctx, query, category, limit)}
+}
+
+func (_c *MockService_Search_Call) Run(run func(ctx context.Context, query string, category *models.ForumTagCategory, limit int)) *MockService_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*models.ForumTagCategory), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockService_Search_Call) Return(_a0 []*models.ForumTag, _a1 error) *MockService_Search_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Search_Call) RunAndReturn(run func(context.Context, string, *models.ForumTagCategory, int) ([]*models.ForumTag, error)) *MockService_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StorageToModel provides a mock function with given fields: source
+func (_m *MockService) StorageToModel(source *tags_storage.Model) *models.ForumTag {
+	ret := _m.Called(source)
+
+	var r0 *models.ForumTag
+	if rf, ok := ret.Get(0).(func(*tags_storage.Model) *models.ForumTag); ok {
+		r0 = rf(source)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ForumTag)
+		}
+	}
+
+	return r0
+}
+
+// MockService_StorageToModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StorageToModel'
+type MockService_StorageToModel_Call struct {
+	*mock.Call
+}
+
+// StorageToModel is a helper method to define mock.On call
+//   - source *tags_storage.Model
+func (_e *MockService_Expecter) StorageToModel(source interface{}) *MockService_StorageToModel_Call {
+	return &MockService_StorageToModel_Call{Call: _e.mock.On("StorageToModel", source)}
+}
+
+func (_c *MockService_StorageToModel_Call) Run(run func(source *tags_storage.Model)) *MockService_StorageToModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*tags_storage.Model))
+	})
+	return _c
+}
+
+func (_c *MockService_StorageToModel_Call) Return(_a0 *models.ForumTag) *MockService_StorageToModel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_StorageToModel_Call) RunAndReturn(run func(*tags_storage.Model) *models.ForumTag) *MockService_StorageToModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockService(t mockConstructorTestingTNewMockService) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
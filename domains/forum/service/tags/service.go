@@ -0,0 +1,177 @@
+package tags_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"regexp"
+	"time"
+)
+
+var (
+	slugRegexp = regexp.MustCompile(`^[a-z\d]+(-[a-z\d]+)*$`)
+	// Just prevent line breaks in name.
+	nameRegexp = regexp.MustCompile(`^[^\n\r]+$`)
+
+	categoryValues = []models.ForumTagCategory{
+		models.ForumTagCategoryGenre,
+		models.ForumTagCategoryPOV,
+		models.ForumTagCategoryTense,
+		models.ForumTagCategorySceneType,
+	}
+)
+
+const (
+	MaxSlugLength   = 64
+	MaxNameLength   = 64
+	MaxSearchLength = 64
+	MaxSearchLimit  = 20
+)
+
+// Service of the current layer. You can instantiate a new one with NewService.
+type Service interface {
+	// Create creates a new tag.
+	Create(ctx context.Context, data *models.ForumTagCreate, id uuid.UUID, now time.Time) (*models.ForumTag, error)
+	// Delete removes a tag, and detaches it from every post.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// List returns every available tag, optionally restricted to a single category.
+	List(ctx context.Context, category *models.ForumTagCategory) ([]*models.ForumTag, error)
+	// Search returns the tags matching the query, best matches first.
+	Search(ctx context.Context, query string, category *models.ForumTagCategory, limit int) ([]*models.ForumTag, error)
+
+	// StorageToModel converts a storage model to a service model.
+	StorageToModel(source *tags_storage.Model) *models.ForumTag
+}
+
+type serviceImpl struct {
+	repository tags_storage.Repository
+}
+
+// NewService returns a new Service instance.
+// To use a mocked one, call NewMockService.
+func NewService(repository tags_storage.Repository) Service {
+	return &serviceImpl{repository: repository}
+}
+
+func (service *serviceImpl) Create(ctx context.Context, data *models.ForumTagCreate, id uuid.UUID, now time.Time) (*models.ForumTag, error) {
+	if err := validation.CheckRequire("data", data); err != nil {
+		return nil, err
+	}
+	if err := validation.CheckRestricted("category", data.Category, categoryValues...); err != nil {
+		return nil, err
+	}
+	if err := validation.CheckRequire("slug", data.Slug); err != nil {
+		return nil, err
+	}
+	if err := validation.CheckRequire("name", data.Name); err != nil {
+		return nil, err
+	}
+	if err := validation.CheckMinMax("slug", data.Slug, 1, MaxSlugLength); err != nil {
+		return nil, err
+	}
+	if err := validation.CheckMinMax("name", data.Name, 1, MaxNameLength); err != nil {
+		return nil, err
+	}
+	if err := validation.CheckRegexp("slug", data.Slug, slugRegexp); err != nil {
+		return nil, err
+	}
+	if err := validation.CheckRegexp("name", data.Name, nameRegexp); err != nil {
+		return nil, err
+	}
+
+	storageModel, err := service.repository.Create(ctx, &tags_storage.Core{
+		Category: tags_storage.Category(data.Category),
+		Slug:     data.Slug,
+		Name:     data.Name,
+	}, id, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	return service.StorageToModel(storageModel), nil
+}
+
+func (service *serviceImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := service.repository.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	return nil
+}
+
+func (service *serviceImpl) List(ctx context.Context, category *models.ForumTagCategory) ([]*models.ForumTag, error) {
+	storageCategory, err := service.parseCategory(category)
+	if err != nil {
+		return nil, err
+	}
+
+	storageModels, err := service.repository.List(ctx, storageCategory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	return service.storageToModels(storageModels), nil
+}
+
+func (service *serviceImpl) Search(ctx context.Context, query string, category *models.ForumTagCategory, limit int) ([]*models.ForumTag, error) {
+	if err := validation.CheckRequire("query", query); err != nil {
+		return nil, err
+	}
+	if err := validation.CheckMinMax("query", query, 1, MaxSearchLength); err != nil {
+		return nil, err
+	}
+	if err := validation.CheckMinMax("limit", limit, 1, MaxSearchLimit); err != nil {
+		return nil, err
+	}
+
+	storageCategory, err := service.parseCategory(category)
+	if err != nil {
+		return nil, err
+	}
+
+	storageModels, err := service.repository.Search(ctx, query, storageCategory, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search tags: %w", err)
+	}
+
+	return service.storageToModels(storageModels), nil
+}
+
+func (service *serviceImpl) parseCategory(category *models.ForumTagCategory) (*tags_storage.Category, error) {
+	if category == nil {
+		return nil, nil
+	}
+
+	if err := validation.CheckRestricted("category", *category, categoryValues...); err != nil {
+		return nil, err
+	}
+
+	storageCategory := tags_storage.Category(*category)
+	return &storageCategory, nil
+}
+
+func (service *serviceImpl) storageToModels(storageModels []*tags_storage.Model) []*models.ForumTag {
+	results := make([]*models.ForumTag, len(storageModels))
+	for i, storageModel := range storageModels {
+		results[i] = service.StorageToModel(storageModel)
+	}
+
+	return results
+}
+
+func (service *serviceImpl) StorageToModel(source *tags_storage.Model) *models.ForumTag {
+	if source == nil {
+		return nil
+	}
+
+	return &models.ForumTag{
+		ID:        source.ID,
+		CreatedAt: source.CreatedAt,
+		Category:  models.ForumTagCategory(source.Category),
+		Slug:      source.Slug,
+		Name:      source.Name,
+	}
+}
@@ -0,0 +1,404 @@
+package tags_service
+
+import (
+	"context"
+	"errors"
+	"github.com/a-novel/agora-backend/framework"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	fooErr   = errors.New("it broken")
+)
+
+func TestTagsService_Create(t *testing.T) {
+	data := []struct {
+		name string
+
+		data *models.ForumTagCreate
+		id   uuid.UUID
+		now  time.Time
+
+		shouldCallRepository bool
+		createData           *tags_storage.Model
+		createErr            error
+
+		expect    *models.ForumTag
+		expectErr error
+	}{
+		{
+			name: "Success",
+			data: &models.ForumTagCreate{
+				Category: models.ForumTagCategoryGenre,
+				Slug:     "fantasy",
+				Name:     "Fantasy",
+			},
+			id:                   test_utils.NumberUUID(1),
+			now:                  baseTime,
+			shouldCallRepository: true,
+			createData: &tags_storage.Model{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				Core: tags_storage.Core{
+					Category: tags_storage.CategoryGenre,
+					Slug:     "fantasy",
+					Name:     "Fantasy",
+				},
+			},
+			expect: &models.ForumTag{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				Category:  models.ForumTagCategoryGenre,
+				Slug:      "fantasy",
+				Name:      "Fantasy",
+			},
+		},
+		{
+			name: "Error/InvalidCategory",
+			data: &models.ForumTagCreate{
+				Category: "foo",
+				Slug:     "fantasy",
+				Name:     "Fantasy",
+			},
+			id:        test_utils.NumberUUID(1),
+			now:       baseTime,
+			expectErr: validation.ErrNotAllowed,
+		},
+		{
+			name: "Error/NoSlug",
+			data: &models.ForumTagCreate{
+				Category: models.ForumTagCategoryGenre,
+				Name:     "Fantasy",
+			},
+			id:        test_utils.NumberUUID(1),
+			now:       baseTime,
+			expectErr: validation.ErrNil,
+		},
+		{
+			name: "Error/NoName",
+			data: &models.ForumTagCreate{
+				Category: models.ForumTagCategoryGenre,
+				Slug:     "fantasy",
+			},
+			id:        test_utils.NumberUUID(1),
+			now:       baseTime,
+			expectErr: validation.ErrNil,
+		},
+		{
+			name: "Error/SlugTooLong",
+			data: &models.ForumTagCreate{
+				Category: models.ForumTagCategoryGenre,
+				Slug:     strings.Repeat("a", MaxSlugLength+1),
+				Name:     "Fantasy",
+			},
+			id:        test_utils.NumberUUID(1),
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name: "Error/NameTooLong",
+			data: &models.ForumTagCreate{
+				Category: models.ForumTagCategoryGenre,
+				Slug:     "fantasy",
+				Name:     strings.Repeat("a", MaxNameLength+1),
+			},
+			id:        test_utils.NumberUUID(1),
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name: "Error/InvalidSlug",
+			data: &models.ForumTagCreate{
+				Category: models.ForumTagCategoryGenre,
+				Slug:     "Dark Fantasy",
+				Name:     "Fantasy",
+			},
+			id:        test_utils.NumberUUID(1),
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name: "Error/InvalidName",
+			data: &models.ForumTagCreate{
+				Category: models.ForumTagCategoryGenre,
+				Slug:     "fantasy",
+				Name:     "Dark\nFantasy",
+			},
+			id:        test_utils.NumberUUID(1),
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name: "Error/RepositoryFailure",
+			data: &models.ForumTagCreate{
+				Category: models.ForumTagCategoryGenre,
+				Slug:     "fantasy",
+				Name:     "Fantasy",
+			},
+			id:                   test_utils.NumberUUID(1),
+			now:                  baseTime,
+			shouldCallRepository: true,
+			createErr:            fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := tags_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("Create", context.TODO(), &tags_storage.Core{
+						Category: tags_storage.Category(d.data.Category),
+						Slug:     d.data.Slug,
+						Name:     d.data.Name,
+					}, d.id, d.now).
+					Return(d.createData, d.createErr)
+			}
+
+			service := NewService(repository)
+			res, err := service.Create(context.TODO(), d.data, d.id, d.now)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestTagsService_Delete(t *testing.T) {
+	data := []struct {
+		name string
+
+		id uuid.UUID
+
+		deleteErr error
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+			id:   test_utils.NumberUUID(1),
+		},
+		{
+			name:      "Error/RepositoryFailure",
+			id:        test_utils.NumberUUID(1),
+			deleteErr: fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := tags_storage.NewMockRepository(st)
+
+			repository.
+				On("Delete", context.TODO(), d.id).
+				Return(d.deleteErr)
+
+			service := NewService(repository)
+			err := service.Delete(context.TODO(), d.id)
+			test_utils.RequireError(st, d.expectErr, err)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestTagsService_List(t *testing.T) {
+	data := []struct {
+		name string
+
+		category *models.ForumTagCategory
+
+		shouldCallRepository bool
+		listData             []*tags_storage.Model
+		listErr              error
+
+		expect    []*models.ForumTag
+		expectErr error
+	}{
+		{
+			name:                 "Success",
+			shouldCallRepository: true,
+			listData: []*tags_storage.Model{
+				{
+					ID:        test_utils.NumberUUID(1),
+					CreatedAt: baseTime,
+					Core: tags_storage.Core{
+						Category: tags_storage.CategoryGenre,
+						Slug:     "fantasy",
+						Name:     "Fantasy",
+					},
+				},
+				{
+					ID:        test_utils.NumberUUID(2),
+					CreatedAt: baseTime,
+					Core: tags_storage.Core{
+						Category: tags_storage.CategoryPOV,
+						Slug:     "first-person",
+						Name:     "First person",
+					},
+				},
+			},
+			expect: []*models.ForumTag{
+				{
+					ID:        test_utils.NumberUUID(1),
+					CreatedAt: baseTime,
+					Category:  models.ForumTagCategoryGenre,
+					Slug:      "fantasy",
+					Name:      "Fantasy",
+				},
+				{
+					ID:        test_utils.NumberUUID(2),
+					CreatedAt: baseTime,
+					Category:  models.ForumTagCategoryPOV,
+					Slug:      "first-person",
+					Name:      "First person",
+				},
+			},
+		},
+		{
+			name:                 "Success/Category",
+			category:             framework.ToPTR(models.ForumTagCategoryGenre),
+			shouldCallRepository: true,
+			listData:             []*tags_storage.Model{},
+			expect:               []*models.ForumTag{},
+		},
+		{
+			name:      "Error/InvalidCategory",
+			category:  framework.ToPTR(models.ForumTagCategory("foo")),
+			expectErr: validation.ErrNotAllowed,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			shouldCallRepository: true,
+			listErr:              fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := tags_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("List", context.TODO(), mock.Anything).
+					Return(d.listData, d.listErr)
+			}
+
+			service := NewService(repository)
+			res, err := service.List(context.TODO(), d.category)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestTagsService_Search(t *testing.T) {
+	data := []struct {
+		name string
+
+		query    string
+		category *models.ForumTagCategory
+		limit    int
+
+		shouldCallRepository bool
+		searchData           []*tags_storage.Model
+		searchErr            error
+
+		expect    []*models.ForumTag
+		expectErr error
+	}{
+		{
+			name:                 "Success",
+			query:                "fan",
+			category:             framework.ToPTR(models.ForumTagCategoryGenre),
+			limit:                10,
+			shouldCallRepository: true,
+			searchData: []*tags_storage.Model{
+				{
+					ID:        test_utils.NumberUUID(1),
+					CreatedAt: baseTime,
+					Core: tags_storage.Core{
+						Category: tags_storage.CategoryGenre,
+						Slug:     "fantasy",
+						Name:     "Fantasy",
+					},
+				},
+			},
+			expect: []*models.ForumTag{
+				{
+					ID:        test_utils.NumberUUID(1),
+					CreatedAt: baseTime,
+					Category:  models.ForumTagCategoryGenre,
+					Slug:      "fantasy",
+					Name:      "Fantasy",
+				},
+			},
+		},
+		{
+			name:      "Error/NoQuery",
+			limit:     10,
+			expectErr: validation.ErrNil,
+		},
+		{
+			name:      "Error/QueryTooLong",
+			query:     strings.Repeat("a", MaxSearchLength+1),
+			limit:     10,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:      "Error/LimitTooHigh",
+			query:     "fan",
+			limit:     MaxSearchLimit + 1,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:      "Error/InvalidCategory",
+			query:     "fan",
+			category:  framework.ToPTR(models.ForumTagCategory("foo")),
+			limit:     10,
+			expectErr: validation.ErrNotAllowed,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			query:                "fan",
+			limit:                10,
+			shouldCallRepository: true,
+			searchErr:            fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := tags_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("Search", context.TODO(), d.query, mock.Anything, d.limit).
+					Return(d.searchData, d.searchErr)
+			}
+
+			service := NewService(repository)
+			res, err := service.Search(context.TODO(), d.query, d.category, d.limit)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
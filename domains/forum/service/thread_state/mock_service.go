@@ -0,0 +1,211 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package thread_state_service
+
+import (
+	context "context"
+
+	"github.com/a-novel/agora-backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// AutoClose provides a mock function with given fields: ctx, minAccepted, inactivity, now
+func (_m *MockService) AutoClose(ctx context.Context, minAccepted int, inactivity time.Duration, now time.Time) (int64, error) {
+	ret := _m.Called(ctx, minAccepted, inactivity, now)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Duration, time.Time) (int64, error)); ok {
+		return rf(ctx, minAccepted, inactivity, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Duration, time.Time) int64); ok {
+		r0 = rf(ctx, minAccepted, inactivity, now)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Duration, time.Time) error); ok {
+		r1 = rf(ctx, minAccepted, inactivity, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_AutoClose_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AutoClose'
+type MockService_AutoClose_Call struct {
+	*mock.Call
+}
+
+// AutoClose is a helper method to define mock.On call
+//   - ctx context.Context
+//   - minAccepted int
+//   - inactivity time.Duration
+//   - now time.Time
+func (_e *MockService_Expecter) AutoClose(ctx interface{}, minAccepted interface{}, inactivity interface{}, now interface{}) *MockService_AutoClose_Call {
+	return &MockService_AutoClose_Call{Call: _e.mock.On("AutoClose", ctx, minAccepted, inactivity, now)}
+}
+
+func (_c *MockService_AutoClose_Call) Run(run func(ctx context.Context, minAccepted int, inactivity time.Duration, now time.Time)) *MockService_AutoClose_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(time.Duration), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_AutoClose_Call) Return(_a0 int64, _a1 error) *MockService_AutoClose_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_AutoClose_Call) RunAndReturn(run func(context.Context, int, time.Duration, time.Time) (int64, error)) *MockService_AutoClose_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Read provides a mock function with given fields: ctx, source
+func (_m *MockService) Read(ctx context.Context, source uuid.UUID) (*models.ImproveRequestThreadState, error) {
+	ret := _m.Called(ctx, source)
+
+	var r0 *models.ImproveRequestThreadState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.ImproveRequestThreadState, error)); ok {
+		return rf(ctx, source)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.ImproveRequestThreadState); ok {
+		r0 = rf(ctx, source)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestThreadState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, source)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Read'
+type MockService_Read_Call struct {
+	*mock.Call
+}
+
+// Read is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+func (_e *MockService_Expecter) Read(ctx interface{}, source interface{}) *MockService_Read_Call {
+	return &MockService_Read_Call{Call: _e.mock.On("Read", ctx, source)}
+}
+
+func (_c *MockService_Read_Call) Run(run func(ctx context.Context, source uuid.UUID)) *MockService_Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_Read_Call) Return(_a0 *models.ImproveRequestThreadState, _a1 error) *MockService_Read_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Read_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.ImproveRequestThreadState, error)) *MockService_Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, source, state, updatedBy, reason, now
+func (_m *MockService) Update(ctx context.Context, source uuid.UUID, state models.ImproveRequestState, updatedBy *uuid.UUID, reason string, now time.Time) (*models.ImproveRequestThreadState, error) {
+	ret := _m.Called(ctx, source, state, updatedBy, reason, now)
+
+	var r0 *models.ImproveRequestThreadState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ImproveRequestState, *uuid.UUID, string, time.Time) (*models.ImproveRequestThreadState, error)); ok {
+		return rf(ctx, source, state, updatedBy, reason, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ImproveRequestState, *uuid.UUID, string, time.Time) *models.ImproveRequestThreadState); ok {
+		r0 = rf(ctx, source, state, updatedBy, reason, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestThreadState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.ImproveRequestState, *uuid.UUID, string, time.Time) error); ok {
+		r1 = rf(ctx, source, state, updatedBy, reason, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - state models.ImproveRequestState
+//   - updatedBy *uuid.UUID
+//   - reason string
+//   - now time.Time
+func (_e *MockService_Expecter) Update(ctx interface{}, source interface{}, state interface{}, updatedBy interface{}, reason interface{}, now interface{}) *MockService_Update_Call {
+	return &MockService_Update_Call{Call: _e.mock.On("Update", ctx, source, state, updatedBy, reason, now)}
+}
+
+func (_c *MockService_Update_Call) Run(run func(ctx context.Context, source uuid.UUID, state models.ImproveRequestState, updatedBy *uuid.UUID, reason string, now time.Time)) *MockService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.ImproveRequestState), args[3].(*uuid.UUID), args[4].(string), args[5].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Update_Call) Return(_a0 *models.ImproveRequestThreadState, _a1 error) *MockService_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Update_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.ImproveRequestState, *uuid.UUID, string, time.Time) (*models.ImproveRequestThreadState, error)) *MockService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockService(t mockConstructorTestingTNewMockService) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,104 @@
+package thread_state_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	MaxReasonLength = 512
+)
+
+// Service of the current layer. You can instantiate a new one with NewService.
+type Service interface {
+	// Read returns the state of a request, based on the ID of its first revision. Requests whose state was never
+	// changed are open.
+	Read(ctx context.Context, source uuid.UUID) (*models.ImproveRequestThreadState, error)
+	// Update sets the state of a request. UpdatedBy is nil for automatic changes.
+	Update(ctx context.Context, source uuid.UUID, state models.ImproveRequestState, updatedBy *uuid.UUID, reason string, now time.Time) (*models.ImproveRequestThreadState, error)
+	// AutoClose closes the open requests that have at least minAccepted validated suggestions, or no activity for
+	// the given duration. A criterion is ignored when set to 0. It returns the number of closed requests.
+	AutoClose(ctx context.Context, minAccepted int, inactivity time.Duration, now time.Time) (int64, error)
+}
+
+type serviceImpl struct {
+	repository thread_state_storage.Repository
+}
+
+// NewService returns a new implementation of Service.
+func NewService(repository thread_state_storage.Repository) Service {
+	return &serviceImpl{repository: repository}
+}
+
+func (service *serviceImpl) Read(ctx context.Context, source uuid.UUID) (*models.ImproveRequestThreadState, error) {
+	storageModel, err := service.repository.Read(ctx, source)
+	if errors.Is(err, validation.ErrNotFound) {
+		return &models.ImproveRequestThreadState{Source: source, State: models.ImproveRequestStateOpen}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read thread state: %w", err)
+	}
+
+	return service.storageToModel(storageModel), nil
+}
+
+func (service *serviceImpl) Update(ctx context.Context, source uuid.UUID, state models.ImproveRequestState, updatedBy *uuid.UUID, reason string, now time.Time) (*models.ImproveRequestThreadState, error) {
+	if err := CheckState(state); err != nil {
+		return nil, err
+	}
+	if err := validation.CheckMinMax("reason", reason, -1, MaxReasonLength); err != nil {
+		return nil, err
+	}
+
+	storageModel, err := service.repository.Update(ctx, source, thread_state_storage.State(state), updatedBy, reason, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update thread state: %w", err)
+	}
+
+	return service.storageToModel(storageModel), nil
+}
+
+func (service *serviceImpl) AutoClose(ctx context.Context, minAccepted int, inactivity time.Duration, now time.Time) (int64, error) {
+	var inactiveSince *time.Time
+	if inactivity > 0 {
+		cutoff := now.Add(-inactivity)
+		inactiveSince = &cutoff
+	}
+
+	closed, err := service.repository.AutoClose(ctx, minAccepted, inactiveSince, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to close inactive threads: %w", err)
+	}
+
+	return closed, nil
+}
+
+// CheckState returns validation.ErrInvalidEntity if the state is unknown.
+func CheckState(state models.ImproveRequestState) error {
+	return validation.CheckRestricted(
+		"state", state,
+		models.ImproveRequestStateOpen,
+		models.ImproveRequestStateClosed,
+		models.ImproveRequestStateLocked,
+		models.ImproveRequestStateArchived,
+	)
+}
+
+func (service *serviceImpl) storageToModel(source *thread_state_storage.Model) *models.ImproveRequestThreadState {
+	if source == nil {
+		return nil
+	}
+
+	return &models.ImproveRequestThreadState{
+		Source:    source.Source,
+		State:     models.ImproveRequestState(source.State),
+		UpdatedAt: &source.UpdatedAt,
+		UpdatedBy: source.UpdatedBy,
+		Reason:    source.Reason,
+	}
+}
@@ -0,0 +1,233 @@
+package thread_state_service
+
+import (
+	"context"
+	"errors"
+	"github.com/a-novel/agora-backend/framework"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	fooErr   = errors.New("it broken")
+)
+
+func TestThreadStateService_Read(t *testing.T) {
+	data := []struct {
+		name string
+
+		source uuid.UUID
+
+		readData *thread_state_storage.Model
+		readErr  error
+
+		expect    *models.ImproveRequestThreadState
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1),
+			readData: &thread_state_storage.Model{
+				Source:    test_utils.NumberUUID(1),
+				State:     thread_state_storage.StateLocked,
+				UpdatedAt: baseTime,
+				UpdatedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+				Reason:    "spam",
+			},
+			expect: &models.ImproveRequestThreadState{
+				Source:    test_utils.NumberUUID(1),
+				State:     models.ImproveRequestStateLocked,
+				UpdatedAt: &baseTime,
+				UpdatedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+				Reason:    "spam",
+			},
+		},
+		{
+			name:    "Success/DefaultsToOpen",
+			source:  test_utils.NumberUUID(1),
+			readErr: validation.ErrNotFound,
+			expect: &models.ImproveRequestThreadState{
+				Source: test_utils.NumberUUID(1),
+				State:  models.ImproveRequestStateOpen,
+			},
+		},
+		{
+			name:      "Error/RepositoryFailure",
+			source:    test_utils.NumberUUID(1),
+			readErr:   fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := thread_state_storage.NewMockRepository(st)
+
+			repository.
+				On("Read", context.TODO(), d.source).
+				Return(d.readData, d.readErr)
+
+			service := NewService(repository)
+			res, err := service.Read(context.TODO(), d.source)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestThreadStateService_Update(t *testing.T) {
+	data := []struct {
+		name string
+
+		source    uuid.UUID
+		state     models.ImproveRequestState
+		updatedBy *uuid.UUID
+		reason    string
+		now       time.Time
+
+		shouldCallRepository bool
+		updateData           *thread_state_storage.Model
+		updateErr            error
+
+		expect    *models.ImproveRequestThreadState
+		expectErr error
+	}{
+		{
+			name:                 "Success",
+			source:               test_utils.NumberUUID(1),
+			state:                models.ImproveRequestStateArchived,
+			updatedBy:            framework.ToPTR(test_utils.NumberUUID(100)),
+			reason:               "done",
+			now:                  baseTime,
+			shouldCallRepository: true,
+			updateData: &thread_state_storage.Model{
+				Source:    test_utils.NumberUUID(1),
+				State:     thread_state_storage.StateArchived,
+				UpdatedAt: baseTime,
+				UpdatedBy: framework.ToPTR(test_utils.NumberUUID(100)),
+				Reason:    "done",
+			},
+			expect: &models.ImproveRequestThreadState{
+				Source:    test_utils.NumberUUID(1),
+				State:     models.ImproveRequestStateArchived,
+				UpdatedAt: &baseTime,
+				UpdatedBy: framework.ToPTR(test_utils.NumberUUID(100)),
+				Reason:    "done",
+			},
+		},
+		{
+			name:      "Error/UnknownState",
+			source:    test_utils.NumberUUID(1),
+			state:     "frozen",
+			updatedBy: framework.ToPTR(test_utils.NumberUUID(100)),
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:      "Error/ReasonTooLong",
+			source:    test_utils.NumberUUID(1),
+			state:     models.ImproveRequestStateClosed,
+			updatedBy: framework.ToPTR(test_utils.NumberUUID(100)),
+			reason:    strings.Repeat("a", MaxReasonLength+1),
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			source:               test_utils.NumberUUID(1),
+			state:                models.ImproveRequestStateClosed,
+			updatedBy:            framework.ToPTR(test_utils.NumberUUID(100)),
+			now:                  baseTime,
+			shouldCallRepository: true,
+			updateErr:            fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := thread_state_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("Update", context.TODO(), d.source, thread_state_storage.State(d.state), d.updatedBy, d.reason, d.now).
+					Return(d.updateData, d.updateErr)
+			}
+
+			service := NewService(repository)
+			res, err := service.Update(context.TODO(), d.source, d.state, d.updatedBy, d.reason, d.now)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestThreadStateService_AutoClose(t *testing.T) {
+	data := []struct {
+		name string
+
+		minAccepted int
+		inactivity  time.Duration
+		now         time.Time
+
+		expectInactiveSince *time.Time
+		autoCloseData       int64
+		autoCloseErr        error
+
+		expect    int64
+		expectErr error
+	}{
+		{
+			name:                "Success",
+			minAccepted:         5,
+			inactivity:          time.Hour,
+			now:                 baseTime,
+			expectInactiveSince: framework.ToPTR(baseTime.Add(-time.Hour)),
+			autoCloseData:       3,
+			expect:              3,
+		},
+		{
+			name:          "Success/NoInactivity",
+			minAccepted:   5,
+			now:           baseTime,
+			autoCloseData: 1,
+			expect:        1,
+		},
+		{
+			name:                "Error/RepositoryFailure",
+			minAccepted:         5,
+			inactivity:          time.Hour,
+			now:                 baseTime,
+			expectInactiveSince: framework.ToPTR(baseTime.Add(-time.Hour)),
+			autoCloseErr:        fooErr,
+			expectErr:           fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := thread_state_storage.NewMockRepository(st)
+
+			repository.
+				On("AutoClose", context.TODO(), d.minAccepted, d.expectInactiveSince, d.now).
+				Return(d.autoCloseData, d.autoCloseErr)
+
+			service := NewService(repository)
+			res, err := service.AutoClose(context.TODO(), d.minAccepted, d.inactivity, d.now)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
@@ -0,0 +1,583 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package visibility_service
+
+import (
+	context "context"
+
+	"github.com/a-novel/agora-backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// CreateShareToken provides a mock function with given fields: ctx, source, id, now
+func (_m *MockService) CreateShareToken(ctx context.Context, source uuid.UUID, id uuid.UUID, now time.Time) (*models.ImproveRequestShareLink, error) {
+	ret := _m.Called(ctx, source, id, now)
+
+	var r0 *models.ImproveRequestShareLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) (*models.ImproveRequestShareLink, error)); ok {
+		return rf(ctx, source, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) *models.ImproveRequestShareLink); ok {
+		r0 = rf(ctx, source, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestShareLink)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, source, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_CreateShareToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateShareToken'
+type MockService_CreateShareToken_Call struct {
+	*mock.Call
+}
+
+// CreateShareToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *MockService_Expecter) CreateShareToken(ctx interface{}, source interface{}, id interface{}, now interface{}) *MockService_CreateShareToken_Call {
+	return &MockService_CreateShareToken_Call{Call: _e.mock.On("CreateShareToken", ctx, source, id, now)}
+}
+
+func (_c *MockService_CreateShareToken_Call) Run(run func(ctx context.Context, source uuid.UUID, id uuid.UUID, now time.Time)) *MockService_CreateShareToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_CreateShareToken_Call) Return(_a0 *models.ImproveRequestShareLink, _a1 error) *MockService_CreateShareToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_CreateShareToken_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, time.Time) (*models.ImproveRequestShareLink, error)) *MockService_CreateShareToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Invite provides a mock function with given fields: ctx, source, userID, now
+func (_m *MockService) Invite(ctx context.Context, source uuid.UUID, userID uuid.UUID, now time.Time) (*models.ImproveRequestInvite, error) {
+	ret := _m.Called(ctx, source, userID, now)
+
+	var r0 *models.ImproveRequestInvite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) (*models.ImproveRequestInvite, error)); ok {
+		return rf(ctx, source, userID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) *models.ImproveRequestInvite); ok {
+		r0 = rf(ctx, source, userID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestInvite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, source, userID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Invite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Invite'
+type MockService_Invite_Call struct {
+	*mock.Call
+}
+
+// Invite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - userID uuid.UUID
+//   - now time.Time
+func (_e *MockService_Expecter) Invite(ctx interface{}, source interface{}, userID interface{}, now interface{}) *MockService_Invite_Call {
+	return &MockService_Invite_Call{Call: _e.mock.On("Invite", ctx, source, userID, now)}
+}
+
+func (_c *MockService_Invite_Call) Run(run func(ctx context.Context, source uuid.UUID, userID uuid.UUID, now time.Time)) *MockService_Invite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Invite_Call) Return(_a0 *models.ImproveRequestInvite, _a1 error) *MockService_Invite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Invite_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, time.Time) (*models.ImproveRequestInvite, error)) *MockService_Invite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsInvited provides a mock function with given fields: ctx, source, userID
+func (_m *MockService) IsInvited(ctx context.Context, source uuid.UUID, userID uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, source, userID)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, source, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(ctx, source, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, source, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_IsInvited_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsInvited'
+type MockService_IsInvited_Call struct {
+	*mock.Call
+}
+
+// IsInvited is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockService_Expecter) IsInvited(ctx interface{}, source interface{}, userID interface{}) *MockService_IsInvited_Call {
+	return &MockService_IsInvited_Call{Call: _e.mock.On("IsInvited", ctx, source, userID)}
+}
+
+func (_c *MockService_IsInvited_Call) Run(run func(ctx context.Context, source uuid.UUID, userID uuid.UUID)) *MockService_IsInvited_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_IsInvited_Call) Return(_a0 bool, _a1 error) *MockService_IsInvited_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_IsInvited_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (bool, error)) *MockService_IsInvited_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListInvites provides a mock function with given fields: ctx, source, limit, offset
+func (_m *MockService) ListInvites(ctx context.Context, source uuid.UUID, limit int, offset int) ([]*models.ImproveRequestInvite, int64, error) {
+	ret := _m.Called(ctx, source, limit, offset)
+
+	var r0 []*models.ImproveRequestInvite
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]*models.ImproveRequestInvite, int64, error)); ok {
+		return rf(ctx, source, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []*models.ImproveRequestInvite); ok {
+		r0 = rf(ctx, source, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ImproveRequestInvite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) int64); ok {
+		r1 = rf(ctx, source, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r2 = rf(ctx, source, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockService_ListInvites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInvites'
+type MockService_ListInvites_Call struct {
+	*mock.Call
+}
+
+// ListInvites is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - limit int
+//   - offset int
+func (_e *MockService_Expecter) ListInvites(ctx interface{}, source interface{}, limit interface{}, offset interface{}) *MockService_ListInvites_Call {
+	return &MockService_ListInvites_Call{Call: _e.mock.On("ListInvites", ctx, source, limit, offset)}
+}
+
+func (_c *MockService_ListInvites_Call) Run(run func(ctx context.Context, source uuid.UUID, limit int, offset int)) *MockService_ListInvites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockService_ListInvites_Call) Return(_a0 []*models.ImproveRequestInvite, _a1 int64, _a2 error) *MockService_ListInvites_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockService_ListInvites_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, int) ([]*models.ImproveRequestInvite, int64, error)) *MockService_ListInvites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListShareTokens provides a mock function with given fields: ctx, source
+func (_m *MockService) ListShareTokens(ctx context.Context, source uuid.UUID) ([]*models.ImproveRequestShareToken, error) {
+	ret := _m.Called(ctx, source)
+
+	var r0 []*models.ImproveRequestShareToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*models.ImproveRequestShareToken, error)); ok {
+		return rf(ctx, source)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*models.ImproveRequestShareToken); ok {
+		r0 = rf(ctx, source)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ImproveRequestShareToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, source)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListShareTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListShareTokens'
+type MockService_ListShareTokens_Call struct {
+	*mock.Call
+}
+
+// ListShareTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+func (_e *MockService_Expecter) ListShareTokens(ctx interface{}, source interface{}) *MockService_ListShareTokens_Call {
+	return &MockService_ListShareTokens_Call{Call: _e.mock.On("ListShareTokens", ctx, source)}
+}
+
+func (_c *MockService_ListShareTokens_Call) Run(run func(ctx context.Context, source uuid.UUID)) *MockService_ListShareTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_ListShareTokens_Call) Return(_a0 []*models.ImproveRequestShareToken, _a1 error) *MockService_ListShareTokens_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListShareTokens_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*models.ImproveRequestShareToken, error)) *MockService_ListShareTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Read provides a mock function with given fields: ctx, source
+func (_m *MockService) Read(ctx context.Context, source uuid.UUID) (*models.ImproveRequestAccess, error) {
+	ret := _m.Called(ctx, source)
+
+	var r0 *models.ImproveRequestAccess
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.ImproveRequestAccess, error)); ok {
+		return rf(ctx, source)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.ImproveRequestAccess); ok {
+		r0 = rf(ctx, source)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestAccess)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, source)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Read'
+type MockService_Read_Call struct {
+	*mock.Call
+}
+
+// Read is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+func (_e *MockService_Expecter) Read(ctx interface{}, source interface{}) *MockService_Read_Call {
+	return &MockService_Read_Call{Call: _e.mock.On("Read", ctx, source)}
+}
+
+func (_c *MockService_Read_Call) Run(run func(ctx context.Context, source uuid.UUID)) *MockService_Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_Read_Call) Return(_a0 *models.ImproveRequestAccess, _a1 error) *MockService_Read_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Read_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.ImproveRequestAccess, error)) *MockService_Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeShareToken provides a mock function with given fields: ctx, source, id
+func (_m *MockService) RevokeShareToken(ctx context.Context, source uuid.UUID, id uuid.UUID) error {
+	ret := _m.Called(ctx, source, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, source, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_RevokeShareToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeShareToken'
+type MockService_RevokeShareToken_Call struct {
+	*mock.Call
+}
+
+// RevokeShareToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - id uuid.UUID
+func (_e *MockService_Expecter) RevokeShareToken(ctx interface{}, source interface{}, id interface{}) *MockService_RevokeShareToken_Call {
+	return &MockService_RevokeShareToken_Call{Call: _e.mock.On("RevokeShareToken", ctx, source, id)}
+}
+
+func (_c *MockService_RevokeShareToken_Call) Run(run func(ctx context.Context, source uuid.UUID, id uuid.UUID)) *MockService_RevokeShareToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_RevokeShareToken_Call) Return(_a0 error) *MockService_RevokeShareToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_RevokeShareToken_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockService_RevokeShareToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Uninvite provides a mock function with given fields: ctx, source, userID
+func (_m *MockService) Uninvite(ctx context.Context, source uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, source, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, source, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_Uninvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Uninvite'
+type MockService_Uninvite_Call struct {
+	*mock.Call
+}
+
+// Uninvite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockService_Expecter) Uninvite(ctx interface{}, source interface{}, userID interface{}) *MockService_Uninvite_Call {
+	return &MockService_Uninvite_Call{Call: _e.mock.On("Uninvite", ctx, source, userID)}
+}
+
+func (_c *MockService_Uninvite_Call) Run(run func(ctx context.Context, source uuid.UUID, userID uuid.UUID)) *MockService_Uninvite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockService_Uninvite_Call) Return(_a0 error) *MockService_Uninvite_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_Uninvite_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockService_Uninvite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, source, visibility, now
+func (_m *MockService) Update(ctx context.Context, source uuid.UUID, visibility models.ImproveRequestVisibility, now time.Time) (*models.ImproveRequestAccess, error) {
+	ret := _m.Called(ctx, source, visibility, now)
+
+	var r0 *models.ImproveRequestAccess
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ImproveRequestVisibility, time.Time) (*models.ImproveRequestAccess, error)); ok {
+		return rf(ctx, source, visibility, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ImproveRequestVisibility, time.Time) *models.ImproveRequestAccess); ok {
+		r0 = rf(ctx, source, visibility, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ImproveRequestAccess)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.ImproveRequestVisibility, time.Time) error); ok {
+		r1 = rf(ctx, source, visibility, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - visibility models.ImproveRequestVisibility
+//   - now time.Time
+func (_e *MockService_Expecter) Update(ctx interface{}, source interface{}, visibility interface{}, now interface{}) *MockService_Update_Call {
+	return &MockService_Update_Call{Call: _e.mock.On("Update", ctx, source, visibility, now)}
+}
+
+func (_c *MockService_Update_Call) Run(run func(ctx context.Context, source uuid.UUID, visibility models.ImproveRequestVisibility, now time.Time)) *MockService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.ImproveRequestVisibility), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Update_Call) Return(_a0 *models.ImproveRequestAccess, _a1 error) *MockService_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Update_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.ImproveRequestVisibility, time.Time) (*models.ImproveRequestAccess, error)) *MockService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyShareToken provides a mock function with given fields: ctx, source, token
+func (_m *MockService) VerifyShareToken(ctx context.Context, source uuid.UUID, token string) (bool, error) {
+	ret := _m.Called(ctx, source, token)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (bool, error)); ok {
+		return rf(ctx, source, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) bool); ok {
+		r0 = rf(ctx, source, token)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, source, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_VerifyShareToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyShareToken'
+type MockService_VerifyShareToken_Call struct {
+	*mock.Call
+}
+
+// VerifyShareToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - token string
+func (_e *MockService_Expecter) VerifyShareToken(ctx interface{}, source interface{}, token interface{}) *MockService_VerifyShareToken_Call {
+	return &MockService_VerifyShareToken_Call{Call: _e.mock.On("VerifyShareToken", ctx, source, token)}
+}
+
+func (_c *MockService_VerifyShareToken_Call) Run(run func(ctx context.Context, source uuid.UUID, token string)) *MockService_VerifyShareToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_VerifyShareToken_Call) Return(_a0 bool, _a1 error) *MockService_VerifyShareToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_VerifyShareToken_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (bool, error)) *MockService_VerifyShareToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockService(t mockConstructorTestingTNewMockService) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
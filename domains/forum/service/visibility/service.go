@@ -0,0 +1,256 @@
+package visibility_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+const (
+	MaxInvitesLimit = 100
+)
+
+// ShareTokenSeparator splits the ID of a share token from its secret code.
+const ShareTokenSeparator = "."
+
+// Service of the current layer. You can instantiate a new one with NewService.
+type Service interface {
+	// Read returns the visibility of a request, based on the ID of its first revision. Requests are public
+	// by default.
+	Read(ctx context.Context, source uuid.UUID) (*models.ImproveRequestAccess, error)
+	// Update sets the visibility of a request.
+	Update(ctx context.Context, source uuid.UUID, visibility models.ImproveRequestVisibility, now time.Time) (*models.ImproveRequestAccess, error)
+
+	// IsInvited returns whether a user was invited to read a request.
+	IsInvited(ctx context.Context, source, userID uuid.UUID) (bool, error)
+	// ListInvites returns the users invited to read a request, most recent first.
+	// It also returns the total number of available results, to help with pagination.
+	ListInvites(ctx context.Context, source uuid.UUID, limit, offset int) ([]*models.ImproveRequestInvite, int64, error)
+	// Invite allows a user to read a request.
+	Invite(ctx context.Context, source, userID uuid.UUID, now time.Time) (*models.ImproveRequestInvite, error)
+	// Uninvite removes the invitation of a user.
+	Uninvite(ctx context.Context, source, userID uuid.UUID) error
+
+	// ListShareTokens returns the share tokens of a request, most recent first.
+	ListShareTokens(ctx context.Context, source uuid.UUID) ([]*models.ImproveRequestShareToken, error)
+	// CreateShareToken creates a new share token for a request. The returned token is the only occurrence of the
+	// secret code, which is stored hashed.
+	CreateShareToken(ctx context.Context, source, id uuid.UUID, now time.Time) (*models.ImproveRequestShareLink, error)
+	// RevokeShareToken deletes a share token of a request, so it can no longer be used.
+	RevokeShareToken(ctx context.Context, source, id uuid.UUID) error
+	// VerifyShareToken returns whether a token, as returned by CreateShareToken, grants access to a request.
+	// Malformed and revoked tokens are simply rejected.
+	VerifyShareToken(ctx context.Context, source uuid.UUID, token string) (bool, error)
+}
+
+type serviceImpl struct {
+	repository visibility_storage.Repository
+
+	generateCode func() (string, string, error)
+	verifyCode   func(code string, encrypted string) (bool, error)
+}
+
+// NewService returns a new implementation of Service.
+//
+//	visibility_service.NewService(
+//	 	repository,
+//	  	security.GenerateCode,
+//	  	security.VerifyCode,
+//	)
+func NewService(
+	repository visibility_storage.Repository,
+	generateCode func() (string, string, error),
+	verifyCode func(code string, encrypted string) (bool, error),
+) Service {
+	return &serviceImpl{
+		repository:   repository,
+		generateCode: generateCode,
+		verifyCode:   verifyCode,
+	}
+}
+
+func (service *serviceImpl) Read(ctx context.Context, source uuid.UUID) (*models.ImproveRequestAccess, error) {
+	storageModel, err := service.repository.ReadAccess(ctx, source)
+	if errors.Is(err, validation.ErrNotFound) {
+		return &models.ImproveRequestAccess{Source: source, Visibility: models.ImproveRequestVisibilityPublic}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read visibility: %w", err)
+	}
+
+	return service.accessToModel(storageModel), nil
+}
+
+func (service *serviceImpl) Update(ctx context.Context, source uuid.UUID, visibility models.ImproveRequestVisibility, now time.Time) (*models.ImproveRequestAccess, error) {
+	switch visibility {
+	case models.ImproveRequestVisibilityPublic,
+		models.ImproveRequestVisibilityUnlisted,
+		models.ImproveRequestVisibilityRestricted:
+	default:
+		return nil, validation.NewErrInvalidEntity("visibility", fmt.Sprintf("unknown visibility %q", visibility))
+	}
+
+	storageModel, err := service.repository.UpdateAccess(ctx, source, visibility_storage.Visibility(visibility), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update visibility: %w", err)
+	}
+
+	return service.accessToModel(storageModel), nil
+}
+
+func (service *serviceImpl) IsInvited(ctx context.Context, source, userID uuid.UUID) (bool, error) {
+	ok, err := service.repository.IsInvited(ctx, source, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check invite: %w", err)
+	}
+
+	return ok, nil
+}
+
+func (service *serviceImpl) ListInvites(ctx context.Context, source uuid.UUID, limit, offset int) ([]*models.ImproveRequestInvite, int64, error) {
+	if err := validation.CheckMinMax("limit", limit, 1, MaxInvitesLimit); err != nil {
+		return nil, 0, err
+	}
+
+	storageModels, total, err := service.repository.ListInvites(ctx, source, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list invites: %w", err)
+	}
+
+	results := make([]*models.ImproveRequestInvite, len(storageModels))
+	for i, storageModel := range storageModels {
+		results[i] = service.inviteToModel(storageModel)
+	}
+
+	return results, total, nil
+}
+
+func (service *serviceImpl) Invite(ctx context.Context, source, userID uuid.UUID, now time.Time) (*models.ImproveRequestInvite, error) {
+	storageModel, err := service.repository.Invite(ctx, source, userID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invite user: %w", err)
+	}
+
+	return service.inviteToModel(storageModel), nil
+}
+
+func (service *serviceImpl) Uninvite(ctx context.Context, source, userID uuid.UUID) error {
+	if err := service.repository.Uninvite(ctx, source, userID); err != nil {
+		return fmt.Errorf("failed to uninvite user: %w", err)
+	}
+
+	return nil
+}
+
+func (service *serviceImpl) ListShareTokens(ctx context.Context, source uuid.UUID) ([]*models.ImproveRequestShareToken, error) {
+	storageModels, err := service.repository.ListShareTokens(ctx, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list share tokens: %w", err)
+	}
+
+	results := make([]*models.ImproveRequestShareToken, len(storageModels))
+	for i, storageModel := range storageModels {
+		results[i] = service.shareTokenToModel(storageModel)
+	}
+
+	return results, nil
+}
+
+func (service *serviceImpl) CreateShareToken(ctx context.Context, source, id uuid.UUID, now time.Time) (*models.ImproveRequestShareLink, error) {
+	publicCode, privateCode, err := service.generateCode()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate share code: %w", err)
+	}
+
+	storageModel, err := service.repository.CreateShareToken(ctx, source, privateCode, id, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create share token: %w", err)
+	}
+
+	return &models.ImproveRequestShareLink{
+		ImproveRequestShareToken: *service.shareTokenToModel(storageModel),
+		Token:                    storageModel.ID.String() + ShareTokenSeparator + publicCode,
+	}, nil
+}
+
+func (service *serviceImpl) RevokeShareToken(ctx context.Context, source, id uuid.UUID) error {
+	if err := service.repository.DeleteShareToken(ctx, source, id); err != nil {
+		return fmt.Errorf("failed to revoke share token: %w", err)
+	}
+
+	return nil
+}
+
+func (service *serviceImpl) VerifyShareToken(ctx context.Context, source uuid.UUID, token string) (bool, error) {
+	rawID, code, ok := strings.Cut(token, ShareTokenSeparator)
+	if !ok || code == "" {
+		return false, nil
+	}
+
+	id, err := uuid.Parse(rawID)
+	if err != nil {
+		return false, nil
+	}
+
+	storageModel, err := service.repository.ReadShareToken(ctx, id)
+	if errors.Is(err, validation.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to read share token: %w", err)
+	}
+
+	if storageModel.Source != source {
+		return false, nil
+	}
+
+	ok, err = service.verifyCode(code, storageModel.Code)
+	if err != nil {
+		return false, fmt.Errorf("failed to verify share code: %w", err)
+	}
+
+	return ok, nil
+}
+
+func (service *serviceImpl) accessToModel(source *visibility_storage.Access) *models.ImproveRequestAccess {
+	if source == nil {
+		return nil
+	}
+
+	updatedAt := source.UpdatedAt
+
+	return &models.ImproveRequestAccess{
+		Source:     source.Source,
+		Visibility: models.ImproveRequestVisibility(source.Visibility),
+		UpdatedAt:  &updatedAt,
+	}
+}
+
+func (service *serviceImpl) inviteToModel(source *visibility_storage.Invite) *models.ImproveRequestInvite {
+	if source == nil {
+		return nil
+	}
+
+	return &models.ImproveRequestInvite{
+		Source:    source.Source,
+		UserID:    source.UserID,
+		CreatedAt: source.CreatedAt,
+	}
+}
+
+func (service *serviceImpl) shareTokenToModel(source *visibility_storage.ShareToken) *models.ImproveRequestShareToken {
+	if source == nil {
+		return nil
+	}
+
+	return &models.ImproveRequestShareToken{
+		ID:        source.ID,
+		Source:    source.Source,
+		CreatedAt: source.CreatedAt,
+	}
+}
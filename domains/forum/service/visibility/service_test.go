@@ -0,0 +1,520 @@
+package visibility_service
+
+import (
+	"context"
+	"errors"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	fooErr   = errors.New("it broken")
+)
+
+func TestVisibilityService_Read(t *testing.T) {
+	data := []struct {
+		name string
+
+		source uuid.UUID
+
+		readData *visibility_storage.Access
+		readErr  error
+
+		expect    *models.ImproveRequestAccess
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1),
+			readData: &visibility_storage.Access{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: visibility_storage.VisibilityUnlisted,
+				UpdatedAt:  baseTime,
+			},
+			expect: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityUnlisted,
+				UpdatedAt:  &baseTime,
+			},
+		},
+		{
+			name:    "Success/DefaultsToPublic",
+			source:  test_utils.NumberUUID(1),
+			readErr: validation.ErrNotFound,
+			expect: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityPublic,
+			},
+		},
+		{
+			name:      "Error/RepositoryFailure",
+			source:    test_utils.NumberUUID(1),
+			readErr:   fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := visibility_storage.NewMockRepository(st)
+
+			repository.
+				On("ReadAccess", context.TODO(), d.source).
+				Return(d.readData, d.readErr)
+
+			service := NewService(repository, nil, nil)
+			res, err := service.Read(context.TODO(), d.source)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestVisibilityService_Update(t *testing.T) {
+	data := []struct {
+		name string
+
+		source     uuid.UUID
+		visibility models.ImproveRequestVisibility
+		now        time.Time
+
+		shouldCallRepository bool
+		updateData           *visibility_storage.Access
+		updateErr            error
+
+		expect    *models.ImproveRequestAccess
+		expectErr error
+	}{
+		{
+			name:                 "Success",
+			source:               test_utils.NumberUUID(1),
+			visibility:           models.ImproveRequestVisibilityRestricted,
+			now:                  baseTime,
+			shouldCallRepository: true,
+			updateData: &visibility_storage.Access{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: visibility_storage.VisibilityRestricted,
+				UpdatedAt:  baseTime,
+			},
+			expect: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityRestricted,
+				UpdatedAt:  &baseTime,
+			},
+		},
+		{
+			name:       "Error/UnknownVisibility",
+			source:     test_utils.NumberUUID(1),
+			visibility: "secret",
+			now:        baseTime,
+			expectErr:  validation.ErrInvalidEntity,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			source:               test_utils.NumberUUID(1),
+			visibility:           models.ImproveRequestVisibilityPublic,
+			now:                  baseTime,
+			shouldCallRepository: true,
+			updateErr:            fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := visibility_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("UpdateAccess", context.TODO(), d.source, visibility_storage.Visibility(d.visibility), d.now).
+					Return(d.updateData, d.updateErr)
+			}
+
+			service := NewService(repository, nil, nil)
+			res, err := service.Update(context.TODO(), d.source, d.visibility, d.now)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestVisibilityService_ListInvites(t *testing.T) {
+	data := []struct {
+		name string
+
+		source uuid.UUID
+		limit  int
+		offset int
+
+		shouldCallRepository bool
+		listData             []*visibility_storage.Invite
+		listTotal            int64
+		listErr              error
+
+		expect      []*models.ImproveRequestInvite
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name:                 "Success",
+			source:               test_utils.NumberUUID(1),
+			limit:                10,
+			offset:               5,
+			shouldCallRepository: true,
+			listData: []*visibility_storage.Invite{
+				{Source: test_utils.NumberUUID(1), UserID: test_utils.NumberUUID(10), CreatedAt: baseTime},
+			},
+			listTotal: 6,
+			expect: []*models.ImproveRequestInvite{
+				{Source: test_utils.NumberUUID(1), UserID: test_utils.NumberUUID(10), CreatedAt: baseTime},
+			},
+			expectTotal: 6,
+		},
+		{
+			name:      "Error/LimitTooHigh",
+			source:    test_utils.NumberUUID(1),
+			limit:     MaxInvitesLimit + 1,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			source:               test_utils.NumberUUID(1),
+			limit:                10,
+			shouldCallRepository: true,
+			listErr:              fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := visibility_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("ListInvites", context.TODO(), d.source, d.limit, d.offset).
+					Return(d.listData, d.listTotal, d.listErr)
+			}
+
+			service := NewService(repository, nil, nil)
+			res, total, err := service.ListInvites(context.TODO(), d.source, d.limit, d.offset)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+			require.Equal(st, d.expectTotal, total)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestVisibilityService_Invite(t *testing.T) {
+	data := []struct {
+		name string
+
+		source uuid.UUID
+		userID uuid.UUID
+		now    time.Time
+
+		inviteData *visibility_storage.Invite
+		inviteErr  error
+
+		expect    *models.ImproveRequestInvite
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1),
+			userID: test_utils.NumberUUID(10),
+			now:    baseTime,
+			inviteData: &visibility_storage.Invite{
+				Source:    test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+			},
+			expect: &models.ImproveRequestInvite{
+				Source:    test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+			},
+		},
+		{
+			name:      "Error/RepositoryFailure",
+			source:    test_utils.NumberUUID(1),
+			userID:    test_utils.NumberUUID(10),
+			now:       baseTime,
+			inviteErr: fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := visibility_storage.NewMockRepository(st)
+
+			repository.
+				On("Invite", context.TODO(), d.source, d.userID, d.now).
+				Return(d.inviteData, d.inviteErr)
+
+			service := NewService(repository, nil, nil)
+			res, err := service.Invite(context.TODO(), d.source, d.userID, d.now)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestVisibilityService_CreateShareToken(t *testing.T) {
+	data := []struct {
+		name string
+
+		source uuid.UUID
+		id     uuid.UUID
+		now    time.Time
+
+		generateCodePublic  string
+		generateCodePrivate string
+		generateCodeErr     error
+
+		shouldCallRepository bool
+		createData           *visibility_storage.ShareToken
+		createErr            error
+
+		expect    *models.ImproveRequestShareLink
+		expectErr error
+	}{
+		{
+			name:                 "Success",
+			source:               test_utils.NumberUUID(1),
+			id:                   test_utils.NumberUUID(2),
+			now:                  baseTime,
+			generateCodePublic:   "public-code",
+			generateCodePrivate:  "private-code",
+			shouldCallRepository: true,
+			createData: &visibility_storage.ShareToken{
+				ID:        test_utils.NumberUUID(2),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				Code:      "private-code",
+			},
+			expect: &models.ImproveRequestShareLink{
+				ImproveRequestShareToken: models.ImproveRequestShareToken{
+					ID:        test_utils.NumberUUID(2),
+					Source:    test_utils.NumberUUID(1),
+					CreatedAt: baseTime,
+				},
+				Token: test_utils.NumberUUID(2).String() + ".public-code",
+			},
+		},
+		{
+			name:            "Error/GenerateCodeFailure",
+			source:          test_utils.NumberUUID(1),
+			id:              test_utils.NumberUUID(2),
+			now:             baseTime,
+			generateCodeErr: fooErr,
+			expectErr:       fooErr,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			source:               test_utils.NumberUUID(1),
+			id:                   test_utils.NumberUUID(2),
+			now:                  baseTime,
+			generateCodePublic:   "public-code",
+			generateCodePrivate:  "private-code",
+			shouldCallRepository: true,
+			createErr:            fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := visibility_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("CreateShareToken", context.TODO(), d.source, d.generateCodePrivate, d.id, d.now).
+					Return(d.createData, d.createErr)
+			}
+
+			service := NewService(
+				repository,
+				test_utils.GetSecurityGenerateCode(d.generateCodePublic, d.generateCodePrivate, d.generateCodeErr),
+				nil,
+			)
+			res, err := service.CreateShareToken(context.TODO(), d.source, d.id, d.now)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestVisibilityService_RevokeShareToken(t *testing.T) {
+	data := []struct {
+		name string
+
+		source uuid.UUID
+		id     uuid.UUID
+
+		deleteErr error
+
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1),
+			id:     test_utils.NumberUUID(2),
+		},
+		{
+			name:      "Error/RepositoryFailure",
+			source:    test_utils.NumberUUID(1),
+			id:        test_utils.NumberUUID(2),
+			deleteErr: fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := visibility_storage.NewMockRepository(st)
+
+			repository.
+				On("DeleteShareToken", context.TODO(), d.source, d.id).
+				Return(d.deleteErr)
+
+			service := NewService(repository, nil, nil)
+			test_utils.RequireError(st, d.expectErr, service.RevokeShareToken(context.TODO(), d.source, d.id))
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestVisibilityService_VerifyShareToken(t *testing.T) {
+	data := []struct {
+		name string
+
+		source uuid.UUID
+		token  string
+
+		shouldCallRepository bool
+		readData             *visibility_storage.ShareToken
+		readErr              error
+
+		verifyCodeOK  bool
+		verifyCodeErr error
+
+		expect    bool
+		expectErr error
+	}{
+		{
+			name:                 "Success",
+			source:               test_utils.NumberUUID(1),
+			token:                test_utils.NumberUUID(2).String() + ".public-code",
+			shouldCallRepository: true,
+			readData: &visibility_storage.ShareToken{
+				ID:        test_utils.NumberUUID(2),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				Code:      "private-code",
+			},
+			verifyCodeOK: true,
+			expect:       true,
+		},
+		{
+			name:                 "Success/WrongCode",
+			source:               test_utils.NumberUUID(1),
+			token:                test_utils.NumberUUID(2).String() + ".public-code",
+			shouldCallRepository: true,
+			readData: &visibility_storage.ShareToken{
+				ID:        test_utils.NumberUUID(2),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				Code:      "private-code",
+			},
+		},
+		{
+			name:                 "Success/OtherSource",
+			source:               test_utils.NumberUUID(3),
+			token:                test_utils.NumberUUID(2).String() + ".public-code",
+			shouldCallRepository: true,
+			readData: &visibility_storage.ShareToken{
+				ID:        test_utils.NumberUUID(2),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				Code:      "private-code",
+			},
+			verifyCodeOK: true,
+		},
+		{
+			name:                 "Success/Revoked",
+			source:               test_utils.NumberUUID(1),
+			token:                test_utils.NumberUUID(2).String() + ".public-code",
+			shouldCallRepository: true,
+			readErr:              validation.ErrNotFound,
+		},
+		{
+			name:   "Success/MissingCode",
+			source: test_utils.NumberUUID(1),
+			token:  test_utils.NumberUUID(2).String(),
+		},
+		{
+			name:   "Success/MalformedID",
+			source: test_utils.NumberUUID(1),
+			token:  "foo.public-code",
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			source:               test_utils.NumberUUID(1),
+			token:                test_utils.NumberUUID(2).String() + ".public-code",
+			shouldCallRepository: true,
+			readErr:              fooErr,
+			expectErr:            fooErr,
+		},
+		{
+			name:                 "Error/VerifyCodeFailure",
+			source:               test_utils.NumberUUID(1),
+			token:                test_utils.NumberUUID(2).String() + ".public-code",
+			shouldCallRepository: true,
+			readData: &visibility_storage.ShareToken{
+				ID:        test_utils.NumberUUID(2),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				Code:      "private-code",
+			},
+			verifyCodeErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := visibility_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("ReadShareToken", context.TODO(), test_utils.NumberUUID(2)).
+					Return(d.readData, d.readErr)
+			}
+
+			service := NewService(repository, nil, test_utils.GetSecurityVerifyCode(d.verifyCodeOK, d.verifyCodeErr))
+			res, err := service.VerifyShareToken(context.TODO(), d.source, d.token)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
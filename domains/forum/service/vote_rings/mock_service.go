@@ -0,0 +1,221 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package vote_rings_service
+
+import (
+	context "context"
+
+	"github.com/a-novel/agora-backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// Detect provides a mock function with given fields: ctx, query, now
+func (_m *MockService) Detect(ctx context.Context, query models.VoteRingDetection, now time.Time) ([]*models.VoteRingFlag, error) {
+	ret := _m.Called(ctx, query, now)
+
+	var r0 []*models.VoteRingFlag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.VoteRingDetection, time.Time) ([]*models.VoteRingFlag, error)); ok {
+		return rf(ctx, query, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.VoteRingDetection, time.Time) []*models.VoteRingFlag); ok {
+		r0 = rf(ctx, query, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.VoteRingFlag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.VoteRingDetection, time.Time) error); ok {
+		r1 = rf(ctx, query, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Detect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Detect'
+type MockService_Detect_Call struct {
+	*mock.Call
+}
+
+// Detect is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query models.VoteRingDetection
+//   - now time.Time
+func (_e *MockService_Expecter) Detect(ctx interface{}, query interface{}, now interface{}) *MockService_Detect_Call {
+	return &MockService_Detect_Call{Call: _e.mock.On("Detect", ctx, query, now)}
+}
+
+func (_c *MockService_Detect_Call) Run(run func(ctx context.Context, query models.VoteRingDetection, now time.Time)) *MockService_Detect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.VoteRingDetection), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Detect_Call) Return(_a0 []*models.VoteRingFlag, _a1 error) *MockService_Detect_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Detect_Call) RunAndReturn(run func(context.Context, models.VoteRingDetection, time.Time) ([]*models.VoteRingFlag, error)) *MockService_Detect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFlags provides a mock function with given fields: ctx, reviewed, limit, offset
+func (_m *MockService) ListFlags(ctx context.Context, reviewed bool, limit int, offset int) ([]*models.VoteRingFlag, int64, error) {
+	ret := _m.Called(ctx, reviewed, limit, offset)
+
+	var r0 []*models.VoteRingFlag
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool, int, int) ([]*models.VoteRingFlag, int64, error)); ok {
+		return rf(ctx, reviewed, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool, int, int) []*models.VoteRingFlag); ok {
+		r0 = rf(ctx, reviewed, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.VoteRingFlag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool, int, int) int64); ok {
+		r1 = rf(ctx, reviewed, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, bool, int, int) error); ok {
+		r2 = rf(ctx, reviewed, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockService_ListFlags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFlags'
+type MockService_ListFlags_Call struct {
+	*mock.Call
+}
+
+// ListFlags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reviewed bool
+//   - limit int
+//   - offset int
+func (_e *MockService_Expecter) ListFlags(ctx interface{}, reviewed interface{}, limit interface{}, offset interface{}) *MockService_ListFlags_Call {
+	return &MockService_ListFlags_Call{Call: _e.mock.On("ListFlags", ctx, reviewed, limit, offset)}
+}
+
+func (_c *MockService_ListFlags_Call) Run(run func(ctx context.Context, reviewed bool, limit int, offset int)) *MockService_ListFlags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockService_ListFlags_Call) Return(_a0 []*models.VoteRingFlag, _a1 int64, _a2 error) *MockService_ListFlags_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockService_ListFlags_Call) RunAndReturn(run func(context.Context, bool, int, int) ([]*models.VoteRingFlag, int64, error)) *MockService_ListFlags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Review provides a mock function with given fields: ctx, authorID, voterID, reviewerID, void, now
+func (_m *MockService) Review(ctx context.Context, authorID uuid.UUID, voterID uuid.UUID, reviewerID uuid.UUID, void bool, now time.Time) (*models.VoteRingFlag, error) {
+	ret := _m.Called(ctx, authorID, voterID, reviewerID, void, now)
+
+	var r0 *models.VoteRingFlag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, bool, time.Time) (*models.VoteRingFlag, error)); ok {
+		return rf(ctx, authorID, voterID, reviewerID, void, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, bool, time.Time) *models.VoteRingFlag); ok {
+		r0 = rf(ctx, authorID, voterID, reviewerID, void, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.VoteRingFlag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, bool, time.Time) error); ok {
+		r1 = rf(ctx, authorID, voterID, reviewerID, void, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Review_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Review'
+type MockService_Review_Call struct {
+	*mock.Call
+}
+
+// Review is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorID uuid.UUID
+//   - voterID uuid.UUID
+//   - reviewerID uuid.UUID
+//   - void bool
+//   - now time.Time
+func (_e *MockService_Expecter) Review(ctx interface{}, authorID interface{}, voterID interface{}, reviewerID interface{}, void interface{}, now interface{}) *MockService_Review_Call {
+	return &MockService_Review_Call{Call: _e.mock.On("Review", ctx, authorID, voterID, reviewerID, void, now)}
+}
+
+func (_c *MockService_Review_Call) Run(run func(ctx context.Context, authorID uuid.UUID, voterID uuid.UUID, reviewerID uuid.UUID, void bool, now time.Time)) *MockService_Review_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(bool), args[5].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_Review_Call) Return(_a0 *models.VoteRingFlag, _a1 error) *MockService_Review_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Review_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, bool, time.Time) (*models.VoteRingFlag, error)) *MockService_Review_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockService(t mockConstructorTestingTNewMockService) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,109 @@
+package vote_rings_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	MaxListLimit = 100
+)
+
+// Service of the current layer. You can instantiate a new one with NewService.
+type Service interface {
+	// Detect looks for voting rings among the recent votes, and flags the suspicious voters for moderation. It
+	// returns the newly raised flags.
+	Detect(ctx context.Context, query models.VoteRingDetection, now time.Time) ([]*models.VoteRingFlag, error)
+	// ListFlags returns either the pending or the reviewed flags, most recent first.
+	// It also returns the total number of available results, to help with pagination.
+	ListFlags(ctx context.Context, reviewed bool, limit, offset int) ([]*models.VoteRingFlag, int64, error)
+	// Review marks a flag as reviewed by the given moderator. If void is true, the votes of the voter on the posts
+	// of the author are removed.
+	Review(ctx context.Context, authorID, voterID, reviewerID uuid.UUID, void bool, now time.Time) (*models.VoteRingFlag, error)
+}
+
+type serviceImpl struct {
+	repository vote_rings_storage.Repository
+}
+
+// NewService returns a new Service instance.
+// To use a mocked one, call NewMockService.
+func NewService(repository vote_rings_storage.Repository) Service {
+	return &serviceImpl{repository: repository}
+}
+
+func (service *serviceImpl) Detect(ctx context.Context, query models.VoteRingDetection, now time.Time) ([]*models.VoteRingFlag, error) {
+	if query.MaxAccountAge <= 0 {
+		return nil, validation.NewErrInvalidEntity("maxAccountAge", "it must be a positive duration")
+	}
+	if err := validation.CheckMinMax("minVotes", query.MinVotes, 1, -1); err != nil {
+		return nil, err
+	}
+	if err := validation.CheckMinMax("minVoters", query.MinVoters, 1, -1); err != nil {
+		return nil, err
+	}
+
+	storageModels, err := service.repository.Detect(ctx, vote_rings_storage.DetectQuery{
+		Since:         query.Since,
+		MaxAccountAge: query.MaxAccountAge,
+		MinVotes:      query.MinVotes,
+		MinVoters:     query.MinVoters,
+	}, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect voting rings: %w", err)
+	}
+
+	flags := make([]*models.VoteRingFlag, len(storageModels))
+	for i, storageModel := range storageModels {
+		flags[i] = service.storageToModel(storageModel)
+	}
+
+	return flags, nil
+}
+
+func (service *serviceImpl) ListFlags(ctx context.Context, reviewed bool, limit, offset int) ([]*models.VoteRingFlag, int64, error) {
+	if err := validation.CheckMinMax("limit", limit, 1, MaxListLimit); err != nil {
+		return nil, 0, err
+	}
+
+	storageModels, total, err := service.repository.ListFlags(ctx, reviewed, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list vote ring flags: %w", err)
+	}
+
+	flags := make([]*models.VoteRingFlag, len(storageModels))
+	for i, storageModel := range storageModels {
+		flags[i] = service.storageToModel(storageModel)
+	}
+
+	return flags, total, nil
+}
+
+func (service *serviceImpl) Review(ctx context.Context, authorID, voterID, reviewerID uuid.UUID, void bool, now time.Time) (*models.VoteRingFlag, error) {
+	storageModel, err := service.repository.Review(ctx, authorID, voterID, reviewerID, void, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to review vote ring flag of voter %q on author %q: %w", voterID, authorID, err)
+	}
+
+	return service.storageToModel(storageModel), nil
+}
+
+func (service *serviceImpl) storageToModel(source *vote_rings_storage.Flag) *models.VoteRingFlag {
+	if source == nil {
+		return nil
+	}
+
+	return &models.VoteRingFlag{
+		AuthorID:   source.AuthorID,
+		VoterID:    source.VoterID,
+		CreatedAt:  source.CreatedAt,
+		Votes:      source.Votes,
+		ReviewedAt: source.ReviewedAt,
+		ReviewedBy: source.ReviewedBy,
+		Voided:     source.Voided,
+	}
+}
@@ -0,0 +1,309 @@
+package vote_rings_service
+
+import (
+	"context"
+	"errors"
+	"github.com/a-novel/agora-backend/framework"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime   = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	updateTime = time.Date(2020, time.May, 4, 9, 0, 0, 0, time.UTC)
+	fooErr     = errors.New("it broken")
+)
+
+func TestVoteRingsService_Detect(t *testing.T) {
+	data := []struct {
+		name string
+
+		query models.VoteRingDetection
+		now   time.Time
+
+		shouldCallRepository bool
+		repositoryData       []*vote_rings_storage.Flag
+		repositoryErr        error
+
+		expect    []*models.VoteRingFlag
+		expectErr error
+	}{
+		{
+			name: "Success",
+			query: models.VoteRingDetection{
+				Since:         baseTime.Add(-24 * time.Hour),
+				MaxAccountAge: 72 * time.Hour,
+				MinVotes:      3,
+				MinVoters:     2,
+			},
+			now:                  baseTime,
+			shouldCallRepository: true,
+			repositoryData: []*vote_rings_storage.Flag{
+				{
+					AuthorID:  test_utils.NumberUUID(100),
+					VoterID:   test_utils.NumberUUID(200),
+					CreatedAt: baseTime,
+					Votes:     3,
+				},
+				{
+					AuthorID:  test_utils.NumberUUID(100),
+					VoterID:   test_utils.NumberUUID(201),
+					CreatedAt: baseTime,
+					Votes:     4,
+				},
+			},
+			expect: []*models.VoteRingFlag{
+				{
+					AuthorID:  test_utils.NumberUUID(100),
+					VoterID:   test_utils.NumberUUID(200),
+					CreatedAt: baseTime,
+					Votes:     3,
+				},
+				{
+					AuthorID:  test_utils.NumberUUID(100),
+					VoterID:   test_utils.NumberUUID(201),
+					CreatedAt: baseTime,
+					Votes:     4,
+				},
+			},
+		},
+		{
+			name: "Success/NoFlags",
+			query: models.VoteRingDetection{
+				Since:         baseTime.Add(-24 * time.Hour),
+				MaxAccountAge: 72 * time.Hour,
+				MinVotes:      3,
+				MinVoters:     2,
+			},
+			now:                  baseTime,
+			shouldCallRepository: true,
+			repositoryData:       []*vote_rings_storage.Flag{},
+			expect:               []*models.VoteRingFlag{},
+		},
+		{
+			name: "Error/NoMaxAccountAge",
+			query: models.VoteRingDetection{
+				Since:     baseTime.Add(-24 * time.Hour),
+				MinVotes:  3,
+				MinVoters: 2,
+			},
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name: "Error/NoMinVotes",
+			query: models.VoteRingDetection{
+				Since:         baseTime.Add(-24 * time.Hour),
+				MaxAccountAge: 72 * time.Hour,
+				MinVoters:     2,
+			},
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name: "Error/NoMinVoters",
+			query: models.VoteRingDetection{
+				Since:         baseTime.Add(-24 * time.Hour),
+				MaxAccountAge: 72 * time.Hour,
+				MinVotes:      3,
+			},
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name: "Error/RepositoryFailure",
+			query: models.VoteRingDetection{
+				Since:         baseTime.Add(-24 * time.Hour),
+				MaxAccountAge: 72 * time.Hour,
+				MinVotes:      3,
+				MinVoters:     2,
+			},
+			now:                  baseTime,
+			shouldCallRepository: true,
+			repositoryErr:        fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := vote_rings_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("Detect", context.TODO(), vote_rings_storage.DetectQuery{
+						Since:         d.query.Since,
+						MaxAccountAge: d.query.MaxAccountAge,
+						MinVotes:      d.query.MinVotes,
+						MinVoters:     d.query.MinVoters,
+					}, d.now).
+					Return(d.repositoryData, d.repositoryErr)
+			}
+
+			service := NewService(repository)
+			res, err := service.Detect(context.TODO(), d.query, d.now)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			repository.AssertExpectations(st)
+		})
+	}
+}
+
+func TestVoteRingsService_ListFlags(t *testing.T) {
+	data := []struct {
+		name string
+
+		reviewed bool
+		limit    int
+		offset   int
+
+		shouldCallRepository bool
+		repositoryData       []*vote_rings_storage.Flag
+		repositoryTotal      int64
+		repositoryErr        error
+
+		expect      []*models.VoteRingFlag
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name:                 "Success",
+			reviewed:             true,
+			limit:                10,
+			offset:               20,
+			shouldCallRepository: true,
+			repositoryData: []*vote_rings_storage.Flag{
+				{
+					AuthorID:   test_utils.NumberUUID(100),
+					VoterID:    test_utils.NumberUUID(200),
+					CreatedAt:  baseTime,
+					Votes:      3,
+					ReviewedAt: &updateTime,
+					ReviewedBy: framework.ToPTR(test_utils.NumberUUID(300)),
+					Voided:     true,
+				},
+			},
+			repositoryTotal: 21,
+			expect: []*models.VoteRingFlag{
+				{
+					AuthorID:   test_utils.NumberUUID(100),
+					VoterID:    test_utils.NumberUUID(200),
+					CreatedAt:  baseTime,
+					Votes:      3,
+					ReviewedAt: &updateTime,
+					ReviewedBy: framework.ToPTR(test_utils.NumberUUID(300)),
+					Voided:     true,
+				},
+			},
+			expectTotal: 21,
+		},
+		{
+			name:      "Error/LimitTooHigh",
+			limit:     MaxListLimit + 1,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			limit:                10,
+			shouldCallRepository: true,
+			repositoryErr:        fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := vote_rings_storage.NewMockRepository(st)
+
+			if d.shouldCallRepository {
+				repository.
+					On("ListFlags", context.TODO(), d.reviewed, d.limit, d.offset).
+					Return(d.repositoryData, d.repositoryTotal, d.repositoryErr)
+			}
+
+			service := NewService(repository)
+			res, total, err := service.ListFlags(context.TODO(), d.reviewed, d.limit, d.offset)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+			require.Equal(st, d.expectTotal, total)
+
+			repository.AssertExpectations(st)
+		})
+	}
+}
+
+func TestVoteRingsService_Review(t *testing.T) {
+	data := []struct {
+		name string
+
+		authorID   uuid.UUID
+		voterID    uuid.UUID
+		reviewerID uuid.UUID
+		void       bool
+		now        time.Time
+
+		repositoryData *vote_rings_storage.Flag
+		repositoryErr  error
+
+		expect    *models.VoteRingFlag
+		expectErr error
+	}{
+		{
+			name:       "Success",
+			authorID:   test_utils.NumberUUID(100),
+			voterID:    test_utils.NumberUUID(200),
+			reviewerID: test_utils.NumberUUID(300),
+			void:       true,
+			now:        updateTime,
+			repositoryData: &vote_rings_storage.Flag{
+				AuthorID:   test_utils.NumberUUID(100),
+				VoterID:    test_utils.NumberUUID(200),
+				CreatedAt:  baseTime,
+				Votes:      3,
+				ReviewedAt: &updateTime,
+				ReviewedBy: framework.ToPTR(test_utils.NumberUUID(300)),
+				Voided:     true,
+			},
+			expect: &models.VoteRingFlag{
+				AuthorID:   test_utils.NumberUUID(100),
+				VoterID:    test_utils.NumberUUID(200),
+				CreatedAt:  baseTime,
+				Votes:      3,
+				ReviewedAt: &updateTime,
+				ReviewedBy: framework.ToPTR(test_utils.NumberUUID(300)),
+				Voided:     true,
+			},
+		},
+		{
+			name:          "Error/RepositoryFailure",
+			authorID:      test_utils.NumberUUID(100),
+			voterID:       test_utils.NumberUUID(200),
+			reviewerID:    test_utils.NumberUUID(300),
+			now:           updateTime,
+			repositoryErr: fooErr,
+			expectErr:     fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := vote_rings_storage.NewMockRepository(st)
+
+			repository.
+				On("Review", context.TODO(), d.authorID, d.voterID, d.reviewerID, d.void, d.now).
+				Return(d.repositoryData, d.repositoryErr)
+
+			service := NewService(repository)
+			res, err := service.Review(context.TODO(), d.authorID, d.voterID, d.reviewerID, d.void, d.now)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			repository.AssertExpectations(st)
+		})
+	}
+}
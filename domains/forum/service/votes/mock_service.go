@@ -4,13 +4,15 @@ package votes_service
 
 import (
 	context "context"
-	"github.com/a-novel/agora-backend/domains/forum/storage/votes"
-	"github.com/a-novel/agora-backend/models"
-	time "time"
 
+	"github.com/a-novel/agora-backend/models"
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
+
+	"github.com/a-novel/agora-backend/domains/forum/storage/votes"
 )
 
 // MockService is an autogenerated mock type for the Service type
@@ -26,6 +28,180 @@ func (_m *MockService) EXPECT() *MockService_Expecter {
 	return &MockService_Expecter{mock: &_m.Mock}
 }
 
+// CountRecent provides a mock function with given fields: ctx, userID, since
+func (_m *MockService) CountRecent(ctx context.Context, userID uuid.UUID, since time.Time) (int64, error) {
+	ret := _m.Called(ctx, userID, since)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) (int64, error)); ok {
+		return rf(ctx, userID, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) int64); ok {
+		r0 = rf(ctx, userID, since)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, userID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_CountRecent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountRecent'
+type MockService_CountRecent_Call struct {
+	*mock.Call
+}
+
+// CountRecent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - since time.Time
+func (_e *MockService_Expecter) CountRecent(ctx interface{}, userID interface{}, since interface{}) *MockService_CountRecent_Call {
+	return &MockService_CountRecent_Call{Call: _e.mock.On("CountRecent", ctx, userID, since)}
+}
+
+func (_c *MockService_CountRecent_Call) Run(run func(ctx context.Context, userID uuid.UUID, since time.Time)) *MockService_CountRecent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_CountRecent_Call) Return(_a0 int64, _a1 error) *MockService_CountRecent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_CountRecent_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) (int64, error)) *MockService_CountRecent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DriftedCounters provides a mock function with given fields: ctx, target, limit
+func (_m *MockService) DriftedCounters(ctx context.Context, target models.VoteTarget, limit int) ([]*models.VoteCounterDrift, int64, error) {
+	ret := _m.Called(ctx, target, limit)
+
+	var r0 []*models.VoteCounterDrift
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.VoteTarget, int) ([]*models.VoteCounterDrift, int64, error)); ok {
+		return rf(ctx, target, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.VoteTarget, int) []*models.VoteCounterDrift); ok {
+		r0 = rf(ctx, target, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.VoteCounterDrift)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.VoteTarget, int) int64); ok {
+		r1 = rf(ctx, target, limit)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, models.VoteTarget, int) error); ok {
+		r2 = rf(ctx, target, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockService_DriftedCounters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DriftedCounters'
+type MockService_DriftedCounters_Call struct {
+	*mock.Call
+}
+
+// DriftedCounters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target models.VoteTarget
+//   - limit int
+func (_e *MockService_Expecter) DriftedCounters(ctx interface{}, target interface{}, limit interface{}) *MockService_DriftedCounters_Call {
+	return &MockService_DriftedCounters_Call{Call: _e.mock.On("DriftedCounters", ctx, target, limit)}
+}
+
+func (_c *MockService_DriftedCounters_Call) Run(run func(ctx context.Context, target models.VoteTarget, limit int)) *MockService_DriftedCounters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.VoteTarget), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockService_DriftedCounters_Call) Return(_a0 []*models.VoteCounterDrift, _a1 int64, _a2 error) *MockService_DriftedCounters_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockService_DriftedCounters_Call) RunAndReturn(run func(context.Context, models.VoteTarget, int) ([]*models.VoteCounterDrift, int64, error)) *MockService_DriftedCounters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReactions provides a mock function with given fields: ctx, postID, userID, target
+func (_m *MockService) GetReactions(ctx context.Context, postID uuid.UUID, userID uuid.UUID, target models.VoteTarget) ([]string, error) {
+	ret := _m.Called(ctx, postID, userID, target)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.VoteTarget) ([]string, error)); ok {
+		return rf(ctx, postID, userID, target)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.VoteTarget) []string); ok {
+		r0 = rf(ctx, postID, userID, target)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.VoteTarget) error); ok {
+		r1 = rf(ctx, postID, userID, target)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetReactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReactions'
+type MockService_GetReactions_Call struct {
+	*mock.Call
+}
+
+// GetReactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID uuid.UUID
+//   - userID uuid.UUID
+//   - target models.VoteTarget
+func (_e *MockService_Expecter) GetReactions(ctx interface{}, postID interface{}, userID interface{}, target interface{}) *MockService_GetReactions_Call {
+	return &MockService_GetReactions_Call{Call: _e.mock.On("GetReactions", ctx, postID, userID, target)}
+}
+
+func (_c *MockService_GetReactions_Call) Run(run func(ctx context.Context, postID uuid.UUID, userID uuid.UUID, target models.VoteTarget)) *MockService_GetReactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.VoteTarget))
+	})
+	return _c
+}
+
+func (_c *MockService_GetReactions_Call) Return(_a0 []string, _a1 error) *MockService_GetReactions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetReactions_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.VoteTarget) ([]string, error)) *MockService_GetReactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetVotedPosts provides a mock function with given fields: ctx, userID, target, limit, offset
 func (_m *MockService) GetVotedPosts(ctx context.Context, userID uuid.UUID, target models.VoteTarget, limit int, offset int) ([]*models.VotedPost, int64, error) {
 	ret := _m.Called(ctx, userID, target, limit, offset)
@@ -67,7 +243,7 @@ type MockService_GetVotedPosts_Call struct {
 // GetVotedPosts is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID uuid.UUID
-//   - target VoteTarget
+//   - target models.VoteTarget
 //   - limit int
 //   - offset int
 func (_e *MockService_Expecter) GetVotedPosts(ctx interface{}, userID interface{}, target interface{}, limit interface{}, offset interface{}) *MockService_GetVotedPosts_Call {
@@ -91,6 +267,71 @@ func (_c *MockService_GetVotedPosts_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetVotedPostsAfter provides a mock function with given fields: ctx, userID, target, cursor, limit
+func (_m *MockService) GetVotedPostsAfter(ctx context.Context, userID uuid.UUID, target models.VoteTarget, cursor string, limit int) ([]*models.VotedPost, string, error) {
+	ret := _m.Called(ctx, userID, target, cursor, limit)
+
+	var r0 []*models.VotedPost
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.VoteTarget, string, int) ([]*models.VotedPost, string, error)); ok {
+		return rf(ctx, userID, target, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.VoteTarget, string, int) []*models.VotedPost); ok {
+		r0 = rf(ctx, userID, target, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.VotedPost)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.VoteTarget, string, int) string); ok {
+		r1 = rf(ctx, userID, target, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, models.VoteTarget, string, int) error); ok {
+		r2 = rf(ctx, userID, target, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockService_GetVotedPostsAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVotedPostsAfter'
+type MockService_GetVotedPostsAfter_Call struct {
+	*mock.Call
+}
+
+// GetVotedPostsAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - target models.VoteTarget
+//   - cursor string
+//   - limit int
+func (_e *MockService_Expecter) GetVotedPostsAfter(ctx interface{}, userID interface{}, target interface{}, cursor interface{}, limit interface{}) *MockService_GetVotedPostsAfter_Call {
+	return &MockService_GetVotedPostsAfter_Call{Call: _e.mock.On("GetVotedPostsAfter", ctx, userID, target, cursor, limit)}
+}
+
+func (_c *MockService_GetVotedPostsAfter_Call) Run(run func(ctx context.Context, userID uuid.UUID, target models.VoteTarget, cursor string, limit int)) *MockService_GetVotedPostsAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.VoteTarget), args[3].(string), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *MockService_GetVotedPostsAfter_Call) Return(_a0 []*models.VotedPost, _a1 string, _a2 error) *MockService_GetVotedPostsAfter_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockService_GetVotedPostsAfter_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.VoteTarget, string, int) ([]*models.VotedPost, string, error)) *MockService_GetVotedPostsAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HasVoted provides a mock function with given fields: ctx, postID, userID, target
 func (_m *MockService) HasVoted(ctx context.Context, postID uuid.UUID, userID uuid.UUID, target models.VoteTarget) (models.VoteValue, error) {
 	ret := _m.Called(ctx, postID, userID, target)
@@ -124,7 +365,7 @@ type MockService_HasVoted_Call struct {
 //   - ctx context.Context
 //   - postID uuid.UUID
 //   - userID uuid.UUID
-//   - target VoteTarget
+//   - target models.VoteTarget
 func (_e *MockService_Expecter) HasVoted(ctx interface{}, postID interface{}, userID interface{}, target interface{}) *MockService_HasVoted_Call {
 	return &MockService_HasVoted_Call{Call: _e.mock.On("HasVoted", ctx, postID, userID, target)}
 }
@@ -146,6 +387,122 @@ func (_c *MockService_HasVoted_Call) RunAndReturn(run func(context.Context, uuid
 	return _c
 }
 
+// React provides a mock function with given fields: ctx, postID, userID, target, reaction, active, now
+func (_m *MockService) React(ctx context.Context, postID uuid.UUID, userID uuid.UUID, target models.VoteTarget, reaction string, active bool, now time.Time) ([]string, error) {
+	ret := _m.Called(ctx, postID, userID, target, reaction, active, now)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.VoteTarget, string, bool, time.Time) ([]string, error)); ok {
+		return rf(ctx, postID, userID, target, reaction, active, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.VoteTarget, string, bool, time.Time) []string); ok {
+		r0 = rf(ctx, postID, userID, target, reaction, active, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.VoteTarget, string, bool, time.Time) error); ok {
+		r1 = rf(ctx, postID, userID, target, reaction, active, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_React_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'React'
+type MockService_React_Call struct {
+	*mock.Call
+}
+
+// React is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID uuid.UUID
+//   - userID uuid.UUID
+//   - target models.VoteTarget
+//   - reaction string
+//   - active bool
+//   - now time.Time
+func (_e *MockService_Expecter) React(ctx interface{}, postID interface{}, userID interface{}, target interface{}, reaction interface{}, active interface{}, now interface{}) *MockService_React_Call {
+	return &MockService_React_Call{Call: _e.mock.On("React", ctx, postID, userID, target, reaction, active, now)}
+}
+
+func (_c *MockService_React_Call) Run(run func(ctx context.Context, postID uuid.UUID, userID uuid.UUID, target models.VoteTarget, reaction string, active bool, now time.Time)) *MockService_React_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.VoteTarget), args[4].(string), args[5].(bool), args[6].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_React_Call) Return(_a0 []string, _a1 error) *MockService_React_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_React_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.VoteTarget, string, bool, time.Time) ([]string, error)) *MockService_React_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReconcileCounters provides a mock function with given fields: ctx, target, batchSize
+func (_m *MockService) ReconcileCounters(ctx context.Context, target models.VoteTarget, batchSize int) (*models.VoteCountersReport, error) {
+	ret := _m.Called(ctx, target, batchSize)
+
+	var r0 *models.VoteCountersReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.VoteTarget, int) (*models.VoteCountersReport, error)); ok {
+		return rf(ctx, target, batchSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.VoteTarget, int) *models.VoteCountersReport); ok {
+		r0 = rf(ctx, target, batchSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.VoteCountersReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.VoteTarget, int) error); ok {
+		r1 = rf(ctx, target, batchSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ReconcileCounters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReconcileCounters'
+type MockService_ReconcileCounters_Call struct {
+	*mock.Call
+}
+
+// ReconcileCounters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target models.VoteTarget
+//   - batchSize int
+func (_e *MockService_Expecter) ReconcileCounters(ctx interface{}, target interface{}, batchSize interface{}) *MockService_ReconcileCounters_Call {
+	return &MockService_ReconcileCounters_Call{Call: _e.mock.On("ReconcileCounters", ctx, target, batchSize)}
+}
+
+func (_c *MockService_ReconcileCounters_Call) Run(run func(ctx context.Context, target models.VoteTarget, batchSize int)) *MockService_ReconcileCounters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.VoteTarget), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockService_ReconcileCounters_Call) Return(_a0 *models.VoteCountersReport, _a1 error) *MockService_ReconcileCounters_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ReconcileCounters_Call) RunAndReturn(run func(context.Context, models.VoteTarget, int) (*models.VoteCountersReport, error)) *MockService_ReconcileCounters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StorageToModel provides a mock function with given fields: source
 func (_m *MockService) StorageToModel(source *votes_storage.Model) *models.Vote {
 	ret := _m.Called(source)
@@ -214,7 +571,7 @@ func (_m *MockService) Vote(ctx context.Context, postID uuid.UUID, userID uuid.U
 	return r0, r1
 }
 
-// MockService_Vote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VoteValue'
+// MockService_Vote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Vote'
 type MockService_Vote_Call struct {
 	*mock.Call
 }
@@ -223,11 +580,11 @@ type MockService_Vote_Call struct {
 //   - ctx context.Context
 //   - postID uuid.UUID
 //   - userID uuid.UUID
-//   - target VoteTarget
-//   - vote Vote
+//   - target models.VoteTarget
+//   - vote models.VoteValue
 //   - now time.Time
 func (_e *MockService_Expecter) Vote(ctx interface{}, postID interface{}, userID interface{}, target interface{}, vote interface{}, now interface{}) *MockService_Vote_Call {
-	return &MockService_Vote_Call{Call: _e.mock.On("VoteValue", ctx, postID, userID, target, vote, now)}
+	return &MockService_Vote_Call{Call: _e.mock.On("Vote", ctx, postID, userID, target, vote, now)}
 }
 
 func (_c *MockService_Vote_Call) Run(run func(ctx context.Context, postID uuid.UUID, userID uuid.UUID, target models.VoteTarget, vote models.VoteValue, now time.Time)) *MockService_Vote_Call {
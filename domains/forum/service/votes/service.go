@@ -6,10 +6,17 @@ import (
 	"github.com/a-novel/agora-backend/framework/validation"
 	"github.com/a-novel/agora-backend/models"
 	"github.com/google/uuid"
+	"regexp"
 	"time"
 )
 
+const (
+	MaxReactionLength = 32
+)
+
 var (
+	reactionRegexp = regexp.MustCompile(`^[a-z\d]+(-[a-z\d]+)*$`)
+
 	voteValues   = []models.VoteValue{models.VoteUp, models.VoteDown, models.NoVote}
 	targetValues = []models.VoteTarget{models.VoteTargetImproveRequest, models.VoteTargetImproveSuggestion}
 )
@@ -21,10 +28,26 @@ type Service interface {
 	// HasVoted returns whether the user has voted for the targeted post. It returns VoteUp or VoteDown if the user
 	// has voted, NoVote otherwise.
 	HasVoted(ctx context.Context, postID, userID uuid.UUID, target models.VoteTarget) (models.VoteValue, error)
+	// React adds or removes (if active is false) a reaction of the user on the targeted post. It returns every
+	// reaction the user left on the post afterwards.
+	React(ctx context.Context, postID, userID uuid.UUID, target models.VoteTarget, reaction string, active bool, now time.Time) ([]string, error)
+	// GetReactions returns the reactions the user left on the targeted post, in alphabetical order.
+	GetReactions(ctx context.Context, postID, userID uuid.UUID, target models.VoteTarget) ([]string, error)
+	// CountRecent returns the number of times the user cast, changed or cancelled a vote since the given time.
+	CountRecent(ctx context.Context, userID uuid.UUID, since time.Time) (int64, error)
 	// GetVotedPosts returns the IDs of the posts that the user has voted for, for a specific target. Results must be
 	// paginated using the limit and offset parameters.
 	// It also returns the total number of available results, to help with pagination.
 	GetVotedPosts(ctx context.Context, userID uuid.UUID, target models.VoteTarget, limit, offset int) ([]*models.VotedPost, int64, error)
+	// GetVotedPostsAfter returns the voted posts that come after the cursor, in the same order as GetVotedPosts.
+	// An empty cursor returns the first page. It also returns the cursor of the next page, empty on the last page.
+	GetVotedPostsAfter(ctx context.Context, userID uuid.UUID, target models.VoteTarget, cursor string, limit int) ([]*models.VotedPost, string, error)
+	// DriftedCounters returns, at most, limit posts of the target which vote counters do not match the votes cast
+	// on them. It also returns the total number of such posts.
+	DriftedCounters(ctx context.Context, target models.VoteTarget, limit int) ([]*models.VoteCounterDrift, int64, error)
+	// ReconcileCounters recomputes the vote counters of every post of the target from the votes table, batchSize
+	// posts at a time, and returns how many of them had drifted.
+	ReconcileCounters(ctx context.Context, target models.VoteTarget, batchSize int) (*models.VoteCountersReport, error)
 
 	// StorageToModel converts a storage model to a service model.
 	StorageToModel(source *votes_storage.Model) *models.Vote
@@ -71,6 +94,52 @@ func (serviceImpl *serviceImpl) HasVoted(ctx context.Context, postID, userID uui
 	return models.VoteValue(storageModel), nil
 }
 
+func (serviceImpl *serviceImpl) React(ctx context.Context, postID, userID uuid.UUID, target models.VoteTarget, reaction string, active bool, now time.Time) ([]string, error) {
+	if err := validation.CheckRestricted("target", target, targetValues...); err != nil {
+		return nil, err
+	}
+	if err := validation.CheckMinMax("reaction", reaction, 1, MaxReactionLength); err != nil {
+		return nil, err
+	}
+	if err := validation.CheckRegexp("reaction", reaction, reactionRegexp); err != nil {
+		return nil, err
+	}
+
+	if active {
+		if err := serviceImpl.repository.React(ctx, postID, userID, votes_storage.Target(target), reaction, now); err != nil {
+			return nil, fmt.Errorf("failed to add reaction: %w", err)
+		}
+	} else {
+		if err := serviceImpl.repository.Unreact(ctx, postID, userID, votes_storage.Target(target), reaction); err != nil {
+			return nil, fmt.Errorf("failed to remove reaction: %w", err)
+		}
+	}
+
+	return serviceImpl.GetReactions(ctx, postID, userID, target)
+}
+
+func (serviceImpl *serviceImpl) GetReactions(ctx context.Context, postID, userID uuid.UUID, target models.VoteTarget) ([]string, error) {
+	if err := validation.CheckRestricted("target", target, targetValues...); err != nil {
+		return nil, err
+	}
+
+	reactions, err := serviceImpl.repository.GetReactions(ctx, postID, userID, votes_storage.Target(target))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reactions: %w", err)
+	}
+
+	return reactions, nil
+}
+
+func (serviceImpl *serviceImpl) CountRecent(ctx context.Context, userID uuid.UUID, since time.Time) (int64, error) {
+	count, err := serviceImpl.repository.CountRecent(ctx, userID, since)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count recent votes: %w", err)
+	}
+
+	return count, nil
+}
+
 func (serviceImpl *serviceImpl) GetVotedPosts(ctx context.Context, userID uuid.UUID, target models.VoteTarget, limit, offset int) ([]*models.VotedPost, int64, error) {
 	if err := validation.CheckRestricted("target", target, targetValues...); err != nil {
 		return nil, 0, err
@@ -81,16 +150,86 @@ func (serviceImpl *serviceImpl) GetVotedPosts(ctx context.Context, userID uuid.U
 		return nil, 0, fmt.Errorf("failed to get voted posts: %w", err)
 	}
 
+	return votedPostsStorageToModel(storageModels), total, nil
+}
+
+func (serviceImpl *serviceImpl) GetVotedPostsAfter(ctx context.Context, userID uuid.UUID, target models.VoteTarget, cursor string, limit int) ([]*models.VotedPost, string, error) {
+	if err := validation.CheckRestricted("target", target, targetValues...); err != nil {
+		return nil, "", err
+	}
+
+	storageModels, next, err := serviceImpl.repository.GetVotedPostsAfter(ctx, userID, votes_storage.Target(target), cursor, limit)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get voted posts: %w", err)
+	}
+
+	return votedPostsStorageToModel(storageModels), next, nil
+}
+
+func (serviceImpl *serviceImpl) DriftedCounters(ctx context.Context, target models.VoteTarget, limit int) ([]*models.VoteCounterDrift, int64, error) {
+	if err := validation.CheckRestricted("target", target, targetValues...); err != nil {
+		return nil, 0, err
+	}
+
+	storageModels, total, err := serviceImpl.repository.DriftedCounters(ctx, votes_storage.Target(target), limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to check vote counters: %w", err)
+	}
+
+	drifts := make([]*models.VoteCounterDrift, len(storageModels))
+	for i, storageModel := range storageModels {
+		drifts[i] = &models.VoteCounterDrift{
+			PostID:            storageModel.PostID,
+			UpVotes:           storageModel.UpVotes,
+			DownVotes:         storageModel.DownVotes,
+			ExpectedUpVotes:   storageModel.ExpectedUpVotes,
+			ExpectedDownVotes: storageModel.ExpectedDownVotes,
+		}
+	}
+
+	return drifts, total, nil
+}
+
+func (serviceImpl *serviceImpl) ReconcileCounters(ctx context.Context, target models.VoteTarget, batchSize int) (*models.VoteCountersReport, error) {
+	if err := validation.CheckRestricted("target", target, targetValues...); err != nil {
+		return nil, err
+	}
+	if err := validation.CheckMinMax("batchSize", batchSize, 1, -1); err != nil {
+		return nil, err
+	}
+
+	report := &models.VoteCountersReport{Target: target}
+	after := uuid.Nil
+
+	for {
+		last, count, repaired, err := serviceImpl.repository.RepairCounters(ctx, votes_storage.Target(target), after, batchSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to repair vote counters after post %q: %w", after, err)
+		}
+
+		report.Scanned += int64(count)
+		report.Repaired += repaired
+
+		if count < batchSize {
+			return report, nil
+		}
+
+		after = last
+	}
+}
+
+func votedPostsStorageToModel(storageModels []*votes_storage.VotedPost) []*models.VotedPost {
 	posts := make([]*models.VotedPost, len(storageModels))
 	for i, storageModel := range storageModels {
 		posts[i] = &models.VotedPost{
-			PostID:    storageModel.PostID,
-			UpdatedAt: storageModel.UpdatedAt,
-			Vote:      models.VoteValue(storageModel.Vote),
+			PostID:     storageModel.PostID,
+			UpdatedAt:  storageModel.UpdatedAt,
+			Vote:       models.VoteValue(storageModel.Vote),
+			RevisionID: storageModel.RevisionID,
 		}
 	}
 
-	return posts, total, nil
+	return posts
 }
 
 func (serviceImpl *serviceImpl) StorageToModel(source *votes_storage.Model) *models.Vote {
@@ -99,10 +238,11 @@ func (serviceImpl *serviceImpl) StorageToModel(source *votes_storage.Model) *mod
 	}
 
 	return &models.Vote{
-		UpdatedAt: source.UpdatedAt,
-		PostID:    source.PostID,
-		UserID:    source.UserID,
-		Target:    models.VoteTarget(source.Target),
-		Vote:      models.VoteValue(source.Vote),
+		UpdatedAt:  source.UpdatedAt,
+		PostID:     source.PostID,
+		UserID:     source.UserID,
+		Target:     models.VoteTarget(source.Target),
+		Vote:       models.VoteValue(source.Vote),
+		RevisionID: source.RevisionID,
 	}
 }
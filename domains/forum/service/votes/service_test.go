@@ -3,10 +3,12 @@ package votes_service
 import (
 	"context"
 	"errors"
+	"github.com/a-novel/agora-backend/framework"
 	"github.com/a-novel/agora-backend/framework/validation"
 	"github.com/a-novel/agora-backend/models"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	"strings"
 	"testing"
 	"time"
 )
@@ -157,6 +159,271 @@ func TestVotesService_HasVoted(t *testing.T) {
 	}
 }
 
+func TestVotesService_React(t *testing.T) {
+	data := []struct {
+		name string
+
+		postID   uuid.UUID
+		userID   uuid.UUID
+		target   models.VoteTarget
+		reaction string
+		active   bool
+		now      time.Time
+
+		shouldCallReact        bool
+		shouldCallUnreact      bool
+		shouldCallGetReactions bool
+		reactErr               error
+		getReactionsData       []string
+		getReactionsErr        error
+
+		expect    []string
+		expectErr error
+	}{
+		{
+			name:                   "Success/Add",
+			postID:                 test_utils.NumberUUID(1),
+			userID:                 test_utils.NumberUUID(2),
+			target:                 models.VoteTargetImproveSuggestion,
+			reaction:               "great-dialogue",
+			active:                 true,
+			now:                    baseTime,
+			shouldCallReact:        true,
+			shouldCallGetReactions: true,
+			getReactionsData:       []string{"great-dialogue", "moving"},
+			expect:                 []string{"great-dialogue", "moving"},
+		},
+		{
+			name:                   "Success/Remove",
+			postID:                 test_utils.NumberUUID(1),
+			userID:                 test_utils.NumberUUID(2),
+			target:                 models.VoteTargetImproveSuggestion,
+			reaction:               "great-dialogue",
+			now:                    baseTime,
+			shouldCallUnreact:      true,
+			shouldCallGetReactions: true,
+			getReactionsData:       []string{"moving"},
+			expect:                 []string{"moving"},
+		},
+		{
+			name:      "Error/InvalidTarget",
+			postID:    test_utils.NumberUUID(1),
+			userID:    test_utils.NumberUUID(2),
+			target:    models.VoteTarget("foo"),
+			reaction:  "moving",
+			active:    true,
+			now:       baseTime,
+			expectErr: validation.ErrNotAllowed,
+		},
+		{
+			name:      "Error/NoReaction",
+			postID:    test_utils.NumberUUID(1),
+			userID:    test_utils.NumberUUID(2),
+			target:    models.VoteTargetImproveSuggestion,
+			active:    true,
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:      "Error/ReactionTooLong",
+			postID:    test_utils.NumberUUID(1),
+			userID:    test_utils.NumberUUID(2),
+			target:    models.VoteTargetImproveSuggestion,
+			reaction:  strings.Repeat("a", MaxReactionLength+1),
+			active:    true,
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:      "Error/InvalidReaction",
+			postID:    test_utils.NumberUUID(1),
+			userID:    test_utils.NumberUUID(2),
+			target:    models.VoteTargetImproveSuggestion,
+			reaction:  "Great Dialogue",
+			active:    true,
+			now:       baseTime,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:            "Error/ReactFailure",
+			postID:          test_utils.NumberUUID(1),
+			userID:          test_utils.NumberUUID(2),
+			target:          models.VoteTargetImproveSuggestion,
+			reaction:        "moving",
+			active:          true,
+			now:             baseTime,
+			shouldCallReact: true,
+			reactErr:        fooErr,
+			expectErr:       fooErr,
+		},
+		{
+			name:              "Error/UnreactFailure",
+			postID:            test_utils.NumberUUID(1),
+			userID:            test_utils.NumberUUID(2),
+			target:            models.VoteTargetImproveSuggestion,
+			reaction:          "moving",
+			now:               baseTime,
+			shouldCallUnreact: true,
+			reactErr:          fooErr,
+			expectErr:         fooErr,
+		},
+		{
+			name:                   "Error/GetReactionsFailure",
+			postID:                 test_utils.NumberUUID(1),
+			userID:                 test_utils.NumberUUID(2),
+			target:                 models.VoteTargetImproveSuggestion,
+			reaction:               "moving",
+			active:                 true,
+			now:                    baseTime,
+			shouldCallReact:        true,
+			shouldCallGetReactions: true,
+			getReactionsErr:        fooErr,
+			expectErr:              fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := votes_storage.NewMockRepository(st)
+
+			if d.shouldCallReact {
+				repository.
+					On("React", context.TODO(), d.postID, d.userID, votes_storage.Target(d.target), d.reaction, d.now).
+					Return(d.reactErr)
+			}
+
+			if d.shouldCallUnreact {
+				repository.
+					On("Unreact", context.TODO(), d.postID, d.userID, votes_storage.Target(d.target), d.reaction).
+					Return(d.reactErr)
+			}
+
+			if d.shouldCallGetReactions {
+				repository.
+					On("GetReactions", context.TODO(), d.postID, d.userID, votes_storage.Target(d.target)).
+					Return(d.getReactionsData, d.getReactionsErr)
+			}
+
+			service := NewService(repository)
+
+			res, err := service.React(context.TODO(), d.postID, d.userID, d.target, d.reaction, d.active, d.now)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestVotesService_GetReactions(t *testing.T) {
+	data := []struct {
+		name string
+
+		postID uuid.UUID
+		userID uuid.UUID
+		target models.VoteTarget
+
+		shouldCallRepository bool
+		repositoryData       []string
+		repositoryErr        error
+
+		expect    []string
+		expectErr error
+	}{
+		{
+			name:                 "Success",
+			postID:               test_utils.NumberUUID(1),
+			userID:               test_utils.NumberUUID(2),
+			target:               models.VoteTargetImproveRequest,
+			shouldCallRepository: true,
+			repositoryData:       []string{"confusing"},
+			expect:               []string{"confusing"},
+		},
+		{
+			name:      "Error/InvalidTarget",
+			postID:    test_utils.NumberUUID(1),
+			userID:    test_utils.NumberUUID(2),
+			target:    models.VoteTarget("foo"),
+			expectErr: validation.ErrNotAllowed,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			postID:               test_utils.NumberUUID(1),
+			userID:               test_utils.NumberUUID(2),
+			target:               models.VoteTargetImproveRequest,
+			shouldCallRepository: true,
+			repositoryErr:        fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := votes_storage.NewMockRepository(st)
+			if d.shouldCallRepository {
+				repository.
+					On("GetReactions", context.TODO(), d.postID, d.userID, votes_storage.Target(d.target)).
+					Return(d.repositoryData, d.repositoryErr)
+			}
+
+			service := NewService(repository)
+
+			res, err := service.GetReactions(context.TODO(), d.postID, d.userID, d.target)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestVotesService_CountRecent(t *testing.T) {
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+		since  time.Time
+
+		countData int64
+		countErr  error
+
+		expect    int64
+		expectErr error
+	}{
+		{
+			name:      "Success",
+			userID:    test_utils.NumberUUID(1),
+			since:     baseTime,
+			countData: 12,
+			expect:    12,
+		},
+		{
+			name:      "Error/RepositoryFailure",
+			userID:    test_utils.NumberUUID(1),
+			since:     baseTime,
+			countErr:  fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := votes_storage.NewMockRepository(st)
+			repository.
+				On("CountRecent", context.TODO(), d.userID, d.since).
+				Return(d.countData, d.countErr)
+
+			service := NewService(repository)
+
+			res, err := service.CountRecent(context.TODO(), d.userID, d.since)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
 func TestVotesService_GetVotedPosts(t *testing.T) {
 	data := []struct {
 		name string
@@ -260,3 +527,263 @@ func TestVotesService_GetVotedPosts(t *testing.T) {
 		})
 	}
 }
+
+func TestVotesService_GetVotedPostsAfter(t *testing.T) {
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+		target models.VoteTarget
+		cursor string
+		limit  int
+
+		shouldCallRepository bool
+		votedPostsData       []*votes_storage.VotedPost
+		votedPostsNext       string
+		votedPostsErr        error
+
+		expect     []*models.VotedPost
+		expectNext string
+		expectErr  error
+	}{
+		{
+			name:                 "Success",
+			userID:               test_utils.NumberUUID(1),
+			target:               models.VoteTargetImproveRequest,
+			cursor:               "cursor-1",
+			limit:                10,
+			shouldCallRepository: true,
+			votedPostsData: []*votes_storage.VotedPost{
+				{
+					PostID:    test_utils.NumberUUID(1),
+					UpdatedAt: baseTime,
+					Vote:      votes_storage.VoteUp,
+				},
+			},
+			votedPostsNext: "cursor-2",
+			expect: []*models.VotedPost{
+				{
+					PostID:    test_utils.NumberUUID(1),
+					UpdatedAt: baseTime,
+					Vote:      models.VoteUp,
+				},
+			},
+			expectNext: "cursor-2",
+		},
+		{
+			name:      "Error/InvalidTarget",
+			userID:    test_utils.NumberUUID(1),
+			target:    models.VoteTarget("foo"),
+			cursor:    "cursor-1",
+			limit:     10,
+			expectErr: validation.ErrNotAllowed,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			userID:               test_utils.NumberUUID(1),
+			target:               models.VoteTargetImproveRequest,
+			cursor:               "cursor-1",
+			limit:                10,
+			shouldCallRepository: true,
+			votedPostsErr:        fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := votes_storage.NewMockRepository(st)
+			if d.shouldCallRepository {
+				repository.
+					On("GetVotedPostsAfter", context.TODO(), d.userID, votes_storage.Target(d.target), d.cursor, d.limit).
+					Return(d.votedPostsData, d.votedPostsNext, d.votedPostsErr)
+			}
+
+			service := NewService(repository)
+
+			res, next, err := service.GetVotedPostsAfter(context.TODO(), d.userID, d.target, d.cursor, d.limit)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+			require.Equal(st, d.expectNext, next)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
+func TestVotesService_DriftedCounters(t *testing.T) {
+	data := []struct {
+		name string
+
+		target models.VoteTarget
+		limit  int
+
+		shouldCallRepository bool
+		driftedData          []*votes_storage.CounterDrift
+		driftedCount         int64
+		driftedErr           error
+
+		expect      []*models.VoteCounterDrift
+		expectCount int64
+		expectErr   error
+	}{
+		{
+			name:                 "Success",
+			target:               models.VoteTargetImproveSuggestion,
+			limit:                10,
+			shouldCallRepository: true,
+			driftedData: []*votes_storage.CounterDrift{
+				{
+					PostID:            test_utils.NumberUUID(1),
+					UpVotes:           framework.ToPTR(int64(3)),
+					DownVotes:         nil,
+					ExpectedUpVotes:   2,
+					ExpectedDownVotes: 1,
+				},
+			},
+			driftedCount: 12,
+			expect: []*models.VoteCounterDrift{
+				{
+					PostID:            test_utils.NumberUUID(1),
+					UpVotes:           framework.ToPTR(int64(3)),
+					DownVotes:         nil,
+					ExpectedUpVotes:   2,
+					ExpectedDownVotes: 1,
+				},
+			},
+			expectCount: 12,
+		},
+		{
+			name:                 "Success/NoDrift",
+			target:               models.VoteTargetImproveRequest,
+			limit:                10,
+			shouldCallRepository: true,
+			driftedData:          []*votes_storage.CounterDrift{},
+			expect:               []*models.VoteCounterDrift{},
+		},
+		{
+			name:      "Error/InvalidTarget",
+			target:    models.VoteTarget("foo"),
+			limit:     10,
+			expectErr: validation.ErrNotAllowed,
+		},
+		{
+			name:                 "Error/RepositoryFailure",
+			target:               models.VoteTargetImproveRequest,
+			limit:                10,
+			shouldCallRepository: true,
+			driftedErr:           fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := votes_storage.NewMockRepository(st)
+			if d.shouldCallRepository {
+				repository.
+					On("DriftedCounters", context.TODO(), votes_storage.Target(d.target), d.limit).
+					Return(d.driftedData, d.driftedCount, d.driftedErr)
+			}
+
+			service := NewService(repository)
+
+			res, count, err := service.DriftedCounters(context.TODO(), d.target, d.limit)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+			require.Equal(st, d.expectCount, count)
+
+			repository.AssertExpectations(st)
+		})
+	}
+}
+
+func TestVotesService_ReconcileCounters(t *testing.T) {
+	type repairCall struct {
+		after    uuid.UUID
+		last     uuid.UUID
+		count    int
+		repaired int64
+		err      error
+	}
+
+	data := []struct {
+		name string
+
+		target    models.VoteTarget
+		batchSize int
+
+		repairCalls []repairCall
+
+		expect    *models.VoteCountersReport
+		expectErr error
+	}{
+		{
+			name:      "Success",
+			target:    models.VoteTargetImproveRequest,
+			batchSize: 2,
+			repairCalls: []repairCall{
+				{after: uuid.Nil, last: test_utils.NumberUUID(2), count: 2, repaired: 1},
+				{after: test_utils.NumberUUID(2), last: test_utils.NumberUUID(4), count: 2, repaired: 0},
+				{after: test_utils.NumberUUID(4), last: test_utils.NumberUUID(5), count: 1, repaired: 1},
+			},
+			expect: &models.VoteCountersReport{
+				Target:   models.VoteTargetImproveRequest,
+				Scanned:  5,
+				Repaired: 2,
+			},
+		},
+		{
+			name:      "Success/Empty",
+			target:    models.VoteTargetImproveSuggestion,
+			batchSize: 2,
+			repairCalls: []repairCall{
+				{after: uuid.Nil, last: uuid.Nil},
+			},
+			expect: &models.VoteCountersReport{
+				Target: models.VoteTargetImproveSuggestion,
+			},
+		},
+		{
+			name:      "Error/InvalidTarget",
+			target:    models.VoteTarget("foo"),
+			batchSize: 2,
+			expectErr: validation.ErrNotAllowed,
+		},
+		{
+			name:      "Error/InvalidBatchSize",
+			target:    models.VoteTargetImproveRequest,
+			batchSize: 0,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:      "Error/RepositoryFailure",
+			target:    models.VoteTargetImproveRequest,
+			batchSize: 2,
+			repairCalls: []repairCall{
+				{after: uuid.Nil, last: test_utils.NumberUUID(2), count: 2, repaired: 1},
+				{after: test_utils.NumberUUID(2), err: fooErr},
+			},
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := votes_storage.NewMockRepository(st)
+			for _, call := range d.repairCalls {
+				repository.
+					On("RepairCounters", context.TODO(), votes_storage.Target(d.target), call.after, d.batchSize).
+					Return(call.last, call.count, call.repaired, call.err)
+			}
+
+			service := NewService(repository)
+
+			res, err := service.ReconcileCounters(context.TODO(), d.target, d.batchSize)
+			test_utils.RequireError(st, d.expectErr, err)
+			require.Equal(st, d.expect, res)
+
+			repository.AssertExpectations(st)
+		})
+	}
+}
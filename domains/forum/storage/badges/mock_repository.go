@@ -0,0 +1,262 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package badges_storage
+
+import (
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// Award provides a mock function with given fields: ctx, userID, badges, now
+func (_m *MockRepository) Award(ctx context.Context, userID uuid.UUID, badges []Badge, now time.Time) ([]*Model, error) {
+	ret := _m.Called(ctx, userID, badges, now)
+
+	var r0 []*Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []Badge, time.Time) ([]*Model, error)); ok {
+		return rf(ctx, userID, badges, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []Badge, time.Time) []*Model); ok {
+		r0 = rf(ctx, userID, badges, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []Badge, time.Time) error); ok {
+		r1 = rf(ctx, userID, badges, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Award_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Award'
+type MockRepository_Award_Call struct {
+	*mock.Call
+}
+
+// Award is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - badges []Badge
+//   - now time.Time
+func (_e *MockRepository_Expecter) Award(ctx interface{}, userID interface{}, badges interface{}, now interface{}) *MockRepository_Award_Call {
+	return &MockRepository_Award_Call{Call: _e.mock.On("Award", ctx, userID, badges, now)}
+}
+
+func (_c *MockRepository_Award_Call) Run(run func(ctx context.Context, userID uuid.UUID, badges []Badge, now time.Time)) *MockRepository_Award_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]Badge), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Award_Call) Return(_a0 []*Model, _a1 error) *MockRepository_Award_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Award_Call) RunAndReturn(run func(context.Context, uuid.UUID, []Badge, time.Time) ([]*Model, error)) *MockRepository_Award_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, userID
+func (_m *MockRepository) List(ctx context.Context, userID uuid.UUID) ([]*Model, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []*Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*Model, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*Model); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockRepository_Expecter) List(ctx interface{}, userID interface{}) *MockRepository_List_Call {
+	return &MockRepository_List_Call{Call: _e.mock.On("List", ctx, userID)}
+}
+
+func (_c *MockRepository_List_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_List_Call) Return(_a0 []*Model, _a1 error) *MockRepository_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_List_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*Model, error)) *MockRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActive provides a mock function with given fields: ctx, since
+func (_m *MockRepository) ListActive(ctx context.Context, since time.Time) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, since)
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]uuid.UUID, error)); ok {
+		return rf(ctx, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []uuid.UUID); ok {
+		r0 = rf(ctx, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ListActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActive'
+type MockRepository_ListActive_Call struct {
+	*mock.Call
+}
+
+// ListActive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since time.Time
+func (_e *MockRepository_Expecter) ListActive(ctx interface{}, since interface{}) *MockRepository_ListActive_Call {
+	return &MockRepository_ListActive_Call{Call: _e.mock.On("ListActive", ctx, since)}
+}
+
+func (_c *MockRepository_ListActive_Call) Run(run func(ctx context.Context, since time.Time)) *MockRepository_ListActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListActive_Call) Return(_a0 []uuid.UUID, _a1 error) *MockRepository_ListActive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ListActive_Call) RunAndReturn(run func(context.Context, time.Time) ([]uuid.UUID, error)) *MockRepository_ListActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadActivity provides a mock function with given fields: ctx, userID
+func (_m *MockRepository) ReadActivity(ctx context.Context, userID uuid.UUID) (*Activity, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*Activity, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *Activity); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ReadActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadActivity'
+type MockRepository_ReadActivity_Call struct {
+	*mock.Call
+}
+
+// ReadActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockRepository_Expecter) ReadActivity(ctx interface{}, userID interface{}) *MockRepository_ReadActivity_Call {
+	return &MockRepository_ReadActivity_Call{Call: _e.mock.On("ReadActivity", ctx, userID)}
+}
+
+func (_c *MockRepository_ReadActivity_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockRepository_ReadActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ReadActivity_Call) Return(_a0 *Activity, _a1 error) *MockRepository_ReadActivity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ReadActivity_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*Activity, error)) *MockRepository_ReadActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockRepository(t mockConstructorTestingTNewMockRepository) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,39 @@
+package badges_storage
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// Badge is an achievement, awarded to users for their activity on the forum.
+type Badge string
+
+const (
+	// BadgeFirstAcceptedSuggestion is awarded once a suggestion of the user is accepted.
+	BadgeFirstAcceptedSuggestion Badge = "first_accepted_suggestion"
+	// BadgePersistentWriter is awarded once the user published many revisions of the same improvement request.
+	BadgePersistentWriter Badge = "persistent_writer"
+	// BadgeHundredUpVotes is awarded once the posts of the user received enough up votes.
+	BadgeHundredUpVotes Badge = "hundred_up_votes"
+)
+
+// Model is the database model for the forum_user_badges table.
+type Model struct {
+	bun.BaseModel `bun:"table:forum_user_badges,alias:user_badge"`
+
+	UserID uuid.UUID `json:"user_id" bun:"user_id,pk,type:uuid"`
+	Badge  Badge     `json:"badge" bun:"badge,pk"`
+	// AwardedAt stores the time at which the user earned the badge.
+	AwardedAt time.Time `json:"awarded_at" bun:"awarded_at,notnull"`
+}
+
+// Activity sums up the forum activity of a user, badges are awarded for. Deleted posts are ignored.
+type Activity struct {
+	// AcceptedSuggestions is the number of suggestions of the user that were accepted.
+	AcceptedSuggestions int64 `json:"accepted_suggestions" bun:"accepted_suggestions"`
+	// MaxRevisions is the highest number of revisions the user published for a single improvement request.
+	MaxRevisions int64 `json:"max_revisions" bun:"max_revisions"`
+	// UpVotes is the number of up votes received by the posts of the user.
+	UpVotes int64 `json:"up_votes" bun:"up_votes"`
+}
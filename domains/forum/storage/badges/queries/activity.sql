@@ -0,0 +1,20 @@
+/* Deleted posts do not count towards badges. */
+SELECT (
+           SELECT COUNT(*)
+           FROM improve_suggestions
+           WHERE user_id = ?0 AND validated = TRUE AND deleted_at IS NULL
+       ) AS accepted_suggestions,
+       (
+           SELECT COALESCE(MAX(revisions), 0)
+           FROM (
+               SELECT COUNT(*) AS revisions
+               FROM improve_requests
+               WHERE user_id = ?0 AND deleted_at IS NULL
+               GROUP BY source
+           ) AS scenes
+       ) AS max_revisions,
+       (
+           SELECT COALESCE(SUM(up_votes), 0) FROM improve_requests WHERE user_id = ?0 AND deleted_at IS NULL
+       ) + (
+           SELECT COALESCE(SUM(up_votes), 0) FROM improve_suggestions WHERE user_id = ?0 AND deleted_at IS NULL
+       ) AS up_votes;
@@ -0,0 +1,13 @@
+/*
+Users whose badges may have changed since the given time: they received an up vote, had a suggestion accepted, or
+published a new revision.
+*/
+SELECT user_id
+FROM reputation_events
+WHERE created_at >= ?0
+  AND event IN ('vote_received', 'suggestion_accepted')
+  AND points > 0
+UNION
+SELECT user_id
+FROM improve_requests
+WHERE created_at >= ?0;
@@ -0,0 +1,9 @@
+package badges_queries
+
+import _ "embed"
+
+//go:embed list_active.sql
+var ListActiveQuery string
+
+//go:embed activity.sql
+var ActivityQuery string
@@ -0,0 +1,87 @@
+package badges_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// Repository of the current layer. You can instantiate a new one with NewRepository.
+type Repository interface {
+	// ListActive returns the users that received an up vote, had a suggestion accepted, or published a revision
+	// since the given time.
+	ListActive(ctx context.Context, since time.Time) ([]uuid.UUID, error)
+	// ReadActivity sums up the forum activity of a user.
+	ReadActivity(ctx context.Context, userID uuid.UUID) (*Activity, error)
+
+	// Award gives the badges to the user. Badges the user already has are kept untouched. It returns the newly
+	// awarded badges.
+	Award(ctx context.Context, userID uuid.UUID, badges []Badge, now time.Time) ([]*Model, error)
+	// List returns the badges of a user, in the order they were awarded.
+	List(ctx context.Context, userID uuid.UUID) ([]*Model, error)
+}
+
+// NewRepository returns a new Repository instance.
+// To use a mocked one, call NewMockRepository.
+func NewRepository(db bun.IDB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+type repositoryImpl struct {
+	db bun.IDB
+}
+
+func (repository *repositoryImpl) ListActive(ctx context.Context, since time.Time) ([]uuid.UUID, error) {
+	users := make([]uuid.UUID, 0)
+	if err := repository.db.NewRaw(badges_queries.ListActiveQuery, since).Scan(ctx, &users); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return users, nil
+}
+
+func (repository *repositoryImpl) ReadActivity(ctx context.Context, userID uuid.UUID) (*Activity, error) {
+	activity := new(Activity)
+	if err := repository.db.NewRaw(badges_queries.ActivityQuery, userID).Scan(ctx, activity); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return activity, nil
+}
+
+func (repository *repositoryImpl) Award(ctx context.Context, userID uuid.UUID, badges []Badge, now time.Time) ([]*Model, error) {
+	awarded := make([]*Model, 0)
+	if len(badges) == 0 {
+		return awarded, nil
+	}
+
+	entries := make([]*Model, len(badges))
+	for i, badge := range badges {
+		entries[i] = &Model{UserID: userID, Badge: badge, AwardedAt: now}
+	}
+
+	if err := repository.db.NewInsert().
+		Model(&entries).
+		On("CONFLICT (user_id, badge) DO NOTHING").
+		Returning("*").
+		Scan(ctx, &awarded); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return awarded, nil
+}
+
+func (repository *repositoryImpl) List(ctx context.Context, userID uuid.UUID) ([]*Model, error) {
+	badges := make([]*Model, 0)
+	if err := repository.db.NewSelect().
+		Model(&badges).
+		Where("user_badge.user_id = ?", userID).
+		Order("user_badge.awarded_at", "user_badge.badge").
+		Scan(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return badges, nil
+}
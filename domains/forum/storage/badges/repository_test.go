@@ -0,0 +1,297 @@
+package badges_storage
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"sort"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime   = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	updateTime = time.Date(2020, time.May, 4, 9, 0, 0, 0, time.UTC)
+)
+
+func revisionFixture(id, source, userID int, createdAt time.Time, upVotes int64, deleted bool) *improve_request_storage.Model {
+	model := &improve_request_storage.Model{
+		ID:        test_utils.NumberUUID(id),
+		Source:    test_utils.NumberUUID(source),
+		CreatedAt: createdAt,
+		UserID:    test_utils.NumberUUID(userID),
+		Title:     "Test",
+		Content:   "Dummy content.",
+		UpVotes:   upVotes,
+	}
+
+	if deleted {
+		model.DeletedAt = &createdAt
+	}
+
+	return model
+}
+
+func suggestionFixture(id, userID int, validated bool, upVotes int64, deleted bool) *improve_suggestion_storage.Model {
+	model := &improve_suggestion_storage.Model{
+		ID:        test_utils.NumberUUID(id),
+		CreatedAt: baseTime.Add(-48 * time.Hour),
+		SourceID:  test_utils.NumberUUID(10),
+		UserID:    test_utils.NumberUUID(userID),
+		Validated: validated,
+		UpVotes:   upVotes,
+		Core: improve_suggestion_storage.Core{
+			RequestID: test_utils.NumberUUID(10),
+			Title:     "Test",
+			Content:   "Dummy content.",
+		},
+	}
+
+	if deleted {
+		model.DeletedAt = &baseTime
+	}
+
+	return model
+}
+
+func eventFixture(id, userID int, event reputation_storage.Event, points int, createdAt time.Time) *reputation_storage.Model {
+	return &reputation_storage.Model{
+		ID:        test_utils.NumberUUID(id),
+		CreatedAt: createdAt,
+		UserID:    test_utils.NumberUUID(userID),
+		Event:     event,
+		SourceID:  test_utils.NumberUUID(10),
+		Points:    points,
+	}
+}
+
+var Fixtures = []interface{}{
+	// User 100 published 10 revisions of request 10, the last one recently.
+	revisionFixture(10, 10, 100, baseTime.Add(-48*time.Hour), 3, false),
+	revisionFixture(11, 10, 100, baseTime.Add(-47*time.Hour), 0, false),
+	revisionFixture(12, 10, 100, baseTime.Add(-46*time.Hour), 0, false),
+	revisionFixture(13, 10, 100, baseTime.Add(-45*time.Hour), 0, false),
+	revisionFixture(14, 10, 100, baseTime.Add(-44*time.Hour), 0, false),
+	revisionFixture(15, 10, 100, baseTime.Add(-43*time.Hour), 0, false),
+	revisionFixture(16, 10, 100, baseTime.Add(-42*time.Hour), 0, false),
+	revisionFixture(17, 10, 100, baseTime.Add(-41*time.Hour), 0, false),
+	revisionFixture(18, 10, 100, baseTime.Add(-40*time.Hour), 0, false),
+	revisionFixture(19, 10, 100, baseTime, 0, false),
+	// Deleted revisions are ignored.
+	revisionFixture(30, 30, 100, baseTime.Add(-48*time.Hour), 2, false),
+	revisionFixture(31, 30, 100, baseTime.Add(-48*time.Hour), 20, true),
+
+	suggestionFixture(40, 101, true, 60, false),
+	suggestionFixture(41, 101, true, 50, true),
+	suggestionFixture(42, 101, false, 45, false),
+
+	eventFixture(50, 102, reputation_storage.EventVoteReceived, 1, baseTime),
+	// Only events that may earn a badge are considered.
+	eventFixture(51, 103, reputation_storage.EventVoteReceived, -1, baseTime),
+	eventFixture(52, 104, reputation_storage.EventSuggestionAccepted, 10, baseTime.Add(-48*time.Hour)),
+	eventFixture(53, 105, reputation_storage.EventReportUpheld, -20, baseTime),
+
+	&Model{
+		UserID:    test_utils.NumberUUID(100),
+		Badge:     BadgePersistentWriter,
+		AwardedAt: baseTime,
+	},
+}
+
+func TestBadgesRepository_ListActive(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		since time.Time
+
+		expect    []uuid.UUID
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			since:  baseTime.Add(-time.Hour),
+			expect: []uuid.UUID{test_utils.NumberUUID(100), test_utils.NumberUUID(102)},
+		},
+		{
+			name:   "Success/NoActivity",
+			since:  updateTime,
+			expect: []uuid.UUID{},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.ListActive(ctx, d.since)
+				test_utils.RequireError(st, d.expectErr, err)
+				sort.Slice(res, func(i, j int) bool {
+					return res[i].String() < res[j].String()
+				})
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestBadgesRepository_ReadActivity(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+
+		expect    *Activity
+		expectErr error
+	}{
+		{
+			name:   "Success/Writer",
+			userID: test_utils.NumberUUID(100),
+			expect: &Activity{MaxRevisions: 10, UpVotes: 5},
+		},
+		{
+			name:   "Success/Suggester",
+			userID: test_utils.NumberUUID(101),
+			expect: &Activity{AcceptedSuggestions: 1, UpVotes: 105},
+		},
+		{
+			name:   "Success/NoActivity",
+			userID: test_utils.NumberUUID(999),
+			expect: &Activity{},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.ReadActivity(ctx, d.userID)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestBadgesRepository_Award(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+		badges []Badge
+		now    time.Time
+
+		expect     []*Model
+		expectList []*Model
+		expectErr  error
+	}{
+		{
+			name:   "Success",
+			userID: test_utils.NumberUUID(100),
+			badges: []Badge{BadgePersistentWriter, BadgeHundredUpVotes},
+			now:    updateTime,
+			expect: []*Model{
+				{UserID: test_utils.NumberUUID(100), Badge: BadgeHundredUpVotes, AwardedAt: updateTime},
+			},
+			expectList: []*Model{
+				{UserID: test_utils.NumberUUID(100), Badge: BadgePersistentWriter, AwardedAt: baseTime},
+				{UserID: test_utils.NumberUUID(100), Badge: BadgeHundredUpVotes, AwardedAt: updateTime},
+			},
+		},
+		{
+			name:   "Success/AlreadyAwarded",
+			userID: test_utils.NumberUUID(100),
+			badges: []Badge{BadgePersistentWriter},
+			now:    updateTime,
+			expect: []*Model{},
+			expectList: []*Model{
+				{UserID: test_utils.NumberUUID(100), Badge: BadgePersistentWriter, AwardedAt: baseTime},
+			},
+		},
+		{
+			name:       "Success/NoBadges",
+			userID:     test_utils.NumberUUID(101),
+			now:        updateTime,
+			expect:     []*Model{},
+			expectList: []*Model{},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.BeginTx(ctx, nil)
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+
+				res, err := repository.Award(ctx, d.userID, d.badges, d.now)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+
+				list, err := repository.List(ctx, d.userID)
+				require.NoError(st, err)
+				require.Equal(st, d.expectList, list)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestBadgesRepository_List(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+
+		expect    []*Model
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			userID: test_utils.NumberUUID(100),
+			expect: []*Model{
+				{UserID: test_utils.NumberUUID(100), Badge: BadgePersistentWriter, AwardedAt: baseTime},
+			},
+		},
+		{
+			name:   "Success/NoBadges",
+			userID: test_utils.NumberUUID(101),
+			expect: []*Model{},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.List(ctx, d.userID)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,423 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package collaborator_storage
+
+import (
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// Accept provides a mock function with given fields: ctx, source, userID, now
+func (_m *MockRepository) Accept(ctx context.Context, source uuid.UUID, userID uuid.UUID, now time.Time) (*Model, error) {
+	ret := _m.Called(ctx, source, userID, now)
+
+	var r0 *Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) (*Model, error)); ok {
+		return rf(ctx, source, userID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) *Model); ok {
+		r0 = rf(ctx, source, userID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, source, userID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Accept_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Accept'
+type MockRepository_Accept_Call struct {
+	*mock.Call
+}
+
+// Accept is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - userID uuid.UUID
+//   - now time.Time
+func (_e *MockRepository_Expecter) Accept(ctx interface{}, source interface{}, userID interface{}, now interface{}) *MockRepository_Accept_Call {
+	return &MockRepository_Accept_Call{Call: _e.mock.On("Accept", ctx, source, userID, now)}
+}
+
+func (_c *MockRepository_Accept_Call) Run(run func(ctx context.Context, source uuid.UUID, userID uuid.UUID, now time.Time)) *MockRepository_Accept_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Accept_Call) Return(_a0 *Model, _a1 error) *MockRepository_Accept_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Accept_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, time.Time) (*Model, error)) *MockRepository_Accept_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, source, userID
+func (_m *MockRepository) Delete(ctx context.Context, source uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, source, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, source, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockRepository_Expecter) Delete(ctx interface{}, source interface{}, userID interface{}) *MockRepository_Delete_Call {
+	return &MockRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, source, userID)}
+}
+
+func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, source uuid.UUID, userID uuid.UUID)) *MockRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Delete_Call) Return(_a0 error) *MockRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Invite provides a mock function with given fields: ctx, source, userID, invitedBy, role, now
+func (_m *MockRepository) Invite(ctx context.Context, source uuid.UUID, userID uuid.UUID, invitedBy uuid.UUID, role Role, now time.Time) (*Model, error) {
+	ret := _m.Called(ctx, source, userID, invitedBy, role, now)
+
+	var r0 *Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, Role, time.Time) (*Model, error)); ok {
+		return rf(ctx, source, userID, invitedBy, role, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, Role, time.Time) *Model); ok {
+		r0 = rf(ctx, source, userID, invitedBy, role, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, Role, time.Time) error); ok {
+		r1 = rf(ctx, source, userID, invitedBy, role, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Invite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Invite'
+type MockRepository_Invite_Call struct {
+	*mock.Call
+}
+
+// Invite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - userID uuid.UUID
+//   - invitedBy uuid.UUID
+//   - role Role
+//   - now time.Time
+func (_e *MockRepository_Expecter) Invite(ctx interface{}, source interface{}, userID interface{}, invitedBy interface{}, role interface{}, now interface{}) *MockRepository_Invite_Call {
+	return &MockRepository_Invite_Call{Call: _e.mock.On("Invite", ctx, source, userID, invitedBy, role, now)}
+}
+
+func (_c *MockRepository_Invite_Call) Run(run func(ctx context.Context, source uuid.UUID, userID uuid.UUID, invitedBy uuid.UUID, role Role, now time.Time)) *MockRepository_Invite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(Role), args[5].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Invite_Call) Return(_a0 *Model, _a1 error) *MockRepository_Invite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Invite_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, Role, time.Time) (*Model, error)) *MockRepository_Invite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, source
+func (_m *MockRepository) List(ctx context.Context, source uuid.UUID) ([]*Model, error) {
+	ret := _m.Called(ctx, source)
+
+	var r0 []*Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*Model, error)); ok {
+		return rf(ctx, source)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*Model); ok {
+		r0 = rf(ctx, source)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, source)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+func (_e *MockRepository_Expecter) List(ctx interface{}, source interface{}) *MockRepository_List_Call {
+	return &MockRepository_List_Call{Call: _e.mock.On("List", ctx, source)}
+}
+
+func (_c *MockRepository_List_Call) Run(run func(ctx context.Context, source uuid.UUID)) *MockRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_List_Call) Return(_a0 []*Model, _a1 error) *MockRepository_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_List_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*Model, error)) *MockRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPending provides a mock function with given fields: ctx, userID
+func (_m *MockRepository) ListPending(ctx context.Context, userID uuid.UUID) ([]*Model, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []*Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*Model, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*Model); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ListPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPending'
+type MockRepository_ListPending_Call struct {
+	*mock.Call
+}
+
+// ListPending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockRepository_Expecter) ListPending(ctx interface{}, userID interface{}) *MockRepository_ListPending_Call {
+	return &MockRepository_ListPending_Call{Call: _e.mock.On("ListPending", ctx, userID)}
+}
+
+func (_c *MockRepository_ListPending_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockRepository_ListPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListPending_Call) Return(_a0 []*Model, _a1 error) *MockRepository_ListPending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ListPending_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*Model, error)) *MockRepository_ListPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Read provides a mock function with given fields: ctx, source, userID
+func (_m *MockRepository) Read(ctx context.Context, source uuid.UUID, userID uuid.UUID) (*Model, error) {
+	ret := _m.Called(ctx, source, userID)
+
+	var r0 *Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*Model, error)); ok {
+		return rf(ctx, source, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *Model); ok {
+		r0 = rf(ctx, source, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, source, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Read'
+type MockRepository_Read_Call struct {
+	*mock.Call
+}
+
+// Read is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockRepository_Expecter) Read(ctx interface{}, source interface{}, userID interface{}) *MockRepository_Read_Call {
+	return &MockRepository_Read_Call{Call: _e.mock.On("Read", ctx, source, userID)}
+}
+
+func (_c *MockRepository_Read_Call) Run(run func(ctx context.Context, source uuid.UUID, userID uuid.UUID)) *MockRepository_Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Read_Call) Return(_a0 *Model, _a1 error) *MockRepository_Read_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Read_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*Model, error)) *MockRepository_Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRole provides a mock function with given fields: ctx, source, userID, role
+func (_m *MockRepository) UpdateRole(ctx context.Context, source uuid.UUID, userID uuid.UUID, role Role) (*Model, error) {
+	ret := _m.Called(ctx, source, userID, role)
+
+	var r0 *Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, Role) (*Model, error)); ok {
+		return rf(ctx, source, userID, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, Role) *Model); ok {
+		r0 = rf(ctx, source, userID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, Role) error); ok {
+		r1 = rf(ctx, source, userID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
+type MockRepository_UpdateRole_Call struct {
+	*mock.Call
+}
+
+// UpdateRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - userID uuid.UUID
+//   - role Role
+func (_e *MockRepository_Expecter) UpdateRole(ctx interface{}, source interface{}, userID interface{}, role interface{}) *MockRepository_UpdateRole_Call {
+	return &MockRepository_UpdateRole_Call{Call: _e.mock.On("UpdateRole", ctx, source, userID, role)}
+}
+
+func (_c *MockRepository_UpdateRole_Call) Run(run func(ctx context.Context, source uuid.UUID, userID uuid.UUID, role Role)) *MockRepository_UpdateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(Role))
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateRole_Call) Return(_a0 *Model, _a1 error) *MockRepository_UpdateRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_UpdateRole_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, Role) (*Model, error)) *MockRepository_UpdateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockRepository(t mockConstructorTestingTNewMockRepository) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,38 @@
+package collaborator_storage
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// Role sets what a collaborator can do on an improvement request (improve_request_storage.Model).
+type Role string
+
+const (
+	// RoleOwner collaborators have the same rights as the creator of the request: they can publish revisions,
+	// delete any of them, and manage visibility and other collaborators.
+	RoleOwner Role = "owner"
+	// RoleEditor collaborators can publish new revisions of the request.
+	RoleEditor Role = "editor"
+)
+
+// Model is the database model for the improve_request_collaborators table. The creator of a request is its
+// implicit owner, and is never stored in this table.
+type Model struct {
+	bun.BaseModel `bun:"table:improve_request_collaborators,alias:improve_request_collaborators"`
+
+	// Source is the ID of the first revision of the request.
+	Source uuid.UUID `json:"source" bun:"source,pk,type:uuid"`
+	// UserID is the ID of the collaborator.
+	UserID uuid.UUID `json:"user_id" bun:"user_id,pk,type:uuid"`
+	// Role of the collaborator.
+	Role Role `json:"role" bun:"role"`
+	// InvitedBy is the ID of the user who sent the invitation.
+	InvitedBy uuid.UUID `json:"invited_by" bun:"invited_by,type:uuid"`
+	// CreatedAt stores the time at which the user was invited.
+	CreatedAt time.Time `json:"created_at" bun:"created_at,notnull"`
+	// AcceptedAt stores the time at which the user accepted the invitation. The collaborator has no rights
+	// until then.
+	AcceptedAt *time.Time `json:"accepted_at" bun:"accepted_at"`
+}
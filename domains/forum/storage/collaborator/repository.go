@@ -0,0 +1,144 @@
+package collaborator_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// Repository of the current layer. You can instantiate a new one with NewRepository.
+type Repository interface {
+	// Read returns the collaboration of a user on a request, whether it was accepted or not.
+	Read(ctx context.Context, source, userID uuid.UUID) (*Model, error)
+	// List returns every collaborator of a request, including pending invitations, oldest first.
+	List(ctx context.Context, source uuid.UUID) ([]*Model, error)
+	// ListPending returns the invitations a user has not answered yet, most recent first.
+	ListPending(ctx context.Context, userID uuid.UUID) ([]*Model, error)
+	// Invite creates a pending collaboration for a user.
+	Invite(ctx context.Context, source, userID, invitedBy uuid.UUID, role Role, now time.Time) (*Model, error)
+	// Accept confirms a pending invitation. It returns validation.ErrNotFound if the user has no pending
+	// invitation for this request.
+	Accept(ctx context.Context, source, userID uuid.UUID, now time.Time) (*Model, error)
+	// UpdateRole changes the role of an existing collaborator.
+	UpdateRole(ctx context.Context, source, userID uuid.UUID, role Role) (*Model, error)
+	// Delete removes a collaborator, or declines a pending invitation.
+	Delete(ctx context.Context, source, userID uuid.UUID) error
+}
+
+// NewRepository returns a new Repository instance.
+// To use a mocked one, call NewMockRepository.
+func NewRepository(db bun.IDB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+type repositoryImpl struct {
+	db bun.IDB
+}
+
+func (repository *repositoryImpl) Read(ctx context.Context, source, userID uuid.UUID) (*Model, error) {
+	model := &Model{Source: source, UserID: userID}
+	if err := repository.db.NewSelect().Model(model).WherePK().Scan(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+func (repository *repositoryImpl) List(ctx context.Context, source uuid.UUID) ([]*Model, error) {
+	results := make([]*Model, 0)
+
+	if err := repository.db.NewSelect().
+		Model(&results).
+		Where("source = ?", source).
+		Order("created_at", "user_id").
+		Scan(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return results, nil
+}
+
+func (repository *repositoryImpl) ListPending(ctx context.Context, userID uuid.UUID) ([]*Model, error) {
+	results := make([]*Model, 0)
+
+	if err := repository.db.NewSelect().
+		Model(&results).
+		Where("user_id = ?", userID).
+		Where("accepted_at IS NULL").
+		Order("created_at DESC", "source").
+		Scan(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return results, nil
+}
+
+func (repository *repositoryImpl) Invite(ctx context.Context, source, userID, invitedBy uuid.UUID, role Role, now time.Time) (*Model, error) {
+	model := &Model{
+		Source:    source,
+		UserID:    userID,
+		Role:      role,
+		InvitedBy: invitedBy,
+		CreatedAt: now,
+	}
+
+	if _, err := repository.db.NewInsert().Model(model).Returning("*").Exec(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+func (repository *repositoryImpl) Accept(ctx context.Context, source, userID uuid.UUID, now time.Time) (*Model, error) {
+	model := &Model{Source: source, UserID: userID, AcceptedAt: &now}
+
+	res, err := repository.db.NewUpdate().
+		Model(model).
+		Column("accepted_at").
+		WherePK().
+		Where("accepted_at IS NULL").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	if err := validation.ForceRowsUpdate(res); err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
+
+func (repository *repositoryImpl) UpdateRole(ctx context.Context, source, userID uuid.UUID, role Role) (*Model, error) {
+	model := &Model{Source: source, UserID: userID, Role: role}
+
+	res, err := repository.db.NewUpdate().
+		Model(model).
+		Column("role").
+		WherePK().
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	if err := validation.ForceRowsUpdate(res); err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
+
+func (repository *repositoryImpl) Delete(ctx context.Context, source, userID uuid.UUID) error {
+	model := &Model{Source: source, UserID: userID}
+
+	res, err := repository.db.NewDelete().Model(model).WherePK().Exec(ctx)
+	if err != nil {
+		return validation.HandlePGError(err)
+	}
+
+	return validation.ForceRowsUpdate(res)
+}
@@ -0,0 +1,387 @@
+package collaborator_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime   = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	updateTime = time.Date(2020, time.May, 4, 9, 0, 0, 0, time.UTC)
+)
+
+var Fixtures = []interface{}{
+	&Model{
+		Source:     test_utils.NumberUUID(1000),
+		UserID:     test_utils.NumberUUID(100),
+		Role:       RoleEditor,
+		InvitedBy:  test_utils.NumberUUID(10),
+		CreatedAt:  baseTime,
+		AcceptedAt: &baseTime,
+	},
+	&Model{
+		Source:    test_utils.NumberUUID(1000),
+		UserID:    test_utils.NumberUUID(101),
+		Role:      RoleOwner,
+		InvitedBy: test_utils.NumberUUID(10),
+		CreatedAt: updateTime,
+	},
+	&Model{
+		Source:    test_utils.NumberUUID(1001),
+		UserID:    test_utils.NumberUUID(101),
+		Role:      RoleEditor,
+		InvitedBy: test_utils.NumberUUID(11),
+		CreatedAt: baseTime,
+	},
+}
+
+func TestCollaboratorRepository_Read(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		source uuid.UUID
+		userID uuid.UUID
+
+		expect    *Model
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1000),
+			userID: test_utils.NumberUUID(100),
+			expect: Fixtures[0].(*Model),
+		},
+		{
+			name:   "Success/Pending",
+			source: test_utils.NumberUUID(1000),
+			userID: test_utils.NumberUUID(101),
+			expect: Fixtures[1].(*Model),
+		},
+		{
+			name:      "Error/NotFound",
+			source:    test_utils.NumberUUID(1001),
+			userID:    test_utils.NumberUUID(100),
+			expectErr: validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				repository := NewRepository(tx)
+				res, err := repository.Read(ctx, d.source, d.userID)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestCollaboratorRepository_List(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		source uuid.UUID
+
+		expect    []*Model
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1000),
+			expect: []*Model{Fixtures[0].(*Model), Fixtures[1].(*Model)},
+		},
+		{
+			name:   "Success/NoResults",
+			source: test_utils.NumberUUID(1002),
+			expect: []*Model{},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				repository := NewRepository(tx)
+				res, err := repository.List(ctx, d.source)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestCollaboratorRepository_ListPending(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+
+		expect    []*Model
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			userID: test_utils.NumberUUID(101),
+			expect: []*Model{Fixtures[1].(*Model), Fixtures[2].(*Model)},
+		},
+		{
+			name:   "Success/OnlyAccepted",
+			userID: test_utils.NumberUUID(100),
+			expect: []*Model{},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				repository := NewRepository(tx)
+				res, err := repository.ListPending(ctx, d.userID)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestCollaboratorRepository_Invite(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		source    uuid.UUID
+		userID    uuid.UUID
+		invitedBy uuid.UUID
+		role      Role
+		now       time.Time
+
+		expect    *Model
+		expectErr error
+	}{
+		{
+			name:      "Success",
+			source:    test_utils.NumberUUID(1001),
+			userID:    test_utils.NumberUUID(100),
+			invitedBy: test_utils.NumberUUID(11),
+			role:      RoleOwner,
+			now:       updateTime,
+			expect: &Model{
+				Source:    test_utils.NumberUUID(1001),
+				UserID:    test_utils.NumberUUID(100),
+				Role:      RoleOwner,
+				InvitedBy: test_utils.NumberUUID(11),
+				CreatedAt: updateTime,
+			},
+		},
+		{
+			name:      "Error/AlreadyInvited",
+			source:    test_utils.NumberUUID(1000),
+			userID:    test_utils.NumberUUID(100),
+			invitedBy: test_utils.NumberUUID(10),
+			role:      RoleEditor,
+			now:       updateTime,
+			expectErr: validation.ErrUniqConstraintViolation,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				res, err := repository.Invite(ctx, d.source, d.userID, d.invitedBy, d.role, d.now)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestCollaboratorRepository_Accept(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		source uuid.UUID
+		userID uuid.UUID
+		now    time.Time
+
+		expect    *Model
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1000),
+			userID: test_utils.NumberUUID(101),
+			now:    updateTime,
+			expect: &Model{
+				Source:     test_utils.NumberUUID(1000),
+				UserID:     test_utils.NumberUUID(101),
+				Role:       RoleOwner,
+				InvitedBy:  test_utils.NumberUUID(10),
+				CreatedAt:  updateTime,
+				AcceptedAt: &updateTime,
+			},
+		},
+		{
+			name:      "Error/AlreadyAccepted",
+			source:    test_utils.NumberUUID(1000),
+			userID:    test_utils.NumberUUID(100),
+			now:       updateTime,
+			expectErr: validation.ErrNotFound,
+		},
+		{
+			name:      "Error/NotFound",
+			source:    test_utils.NumberUUID(1001),
+			userID:    test_utils.NumberUUID(100),
+			now:       updateTime,
+			expectErr: validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				res, err := repository.Accept(ctx, d.source, d.userID, d.now)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestCollaboratorRepository_UpdateRole(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		source uuid.UUID
+		userID uuid.UUID
+		role   Role
+
+		expect    *Model
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1000),
+			userID: test_utils.NumberUUID(100),
+			role:   RoleOwner,
+			expect: &Model{
+				Source:     test_utils.NumberUUID(1000),
+				UserID:     test_utils.NumberUUID(100),
+				Role:       RoleOwner,
+				InvitedBy:  test_utils.NumberUUID(10),
+				CreatedAt:  baseTime,
+				AcceptedAt: &baseTime,
+			},
+		},
+		{
+			name:      "Error/NotFound",
+			source:    test_utils.NumberUUID(1001),
+			userID:    test_utils.NumberUUID(100),
+			role:      RoleOwner,
+			expectErr: validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				res, err := repository.UpdateRole(ctx, d.source, d.userID, d.role)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestCollaboratorRepository_Delete(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		source uuid.UUID
+		userID uuid.UUID
+
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1000),
+			userID: test_utils.NumberUUID(100),
+		},
+		{
+			name:   "Success/Pending",
+			source: test_utils.NumberUUID(1001),
+			userID: test_utils.NumberUUID(101),
+		},
+		{
+			name:      "Error/NotFound",
+			source:    test_utils.NumberUUID(1001),
+			userID:    test_utils.NumberUUID(100),
+			expectErr: validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				test_utils.RequireError(st, d.expectErr, repository.Delete(ctx, d.source, d.userID))
+			})
+		}
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,315 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package critique_storage
+
+import (
+	context "context"
+
+	uuid "github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// Rate provides a mock function with given fields: ctx, suggestionID, ratings
+func (_m *MockRepository) Rate(ctx context.Context, suggestionID uuid.UUID, ratings []*Rating) ([]*Rating, error) {
+	ret := _m.Called(ctx, suggestionID, ratings)
+
+	var r0 []*Rating
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []*Rating) ([]*Rating, error)); ok {
+		return rf(ctx, suggestionID, ratings)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []*Rating) []*Rating); ok {
+		r0 = rf(ctx, suggestionID, ratings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Rating)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []*Rating) error); ok {
+		r1 = rf(ctx, suggestionID, ratings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Rate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rate'
+type MockRepository_Rate_Call struct {
+	*mock.Call
+}
+
+// Rate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - suggestionID uuid.UUID
+//   - ratings []*Rating
+func (_e *MockRepository_Expecter) Rate(ctx interface{}, suggestionID interface{}, ratings interface{}) *MockRepository_Rate_Call {
+	return &MockRepository_Rate_Call{Call: _e.mock.On("Rate", ctx, suggestionID, ratings)}
+}
+
+func (_c *MockRepository_Rate_Call) Run(run func(ctx context.Context, suggestionID uuid.UUID, ratings []*Rating)) *MockRepository_Rate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]*Rating))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Rate_Call) Return(_a0 []*Rating, _a1 error) *MockRepository_Rate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Rate_Call) RunAndReturn(run func(context.Context, uuid.UUID, []*Rating) ([]*Rating, error)) *MockRepository_Rate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadAspects provides a mock function with given fields: ctx, source
+func (_m *MockRepository) ReadAspects(ctx context.Context, source uuid.UUID) ([]Aspect, error) {
+	ret := _m.Called(ctx, source)
+
+	var r0 []Aspect
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]Aspect, error)); ok {
+		return rf(ctx, source)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []Aspect); ok {
+		r0 = rf(ctx, source)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Aspect)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, source)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ReadAspects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadAspects'
+type MockRepository_ReadAspects_Call struct {
+	*mock.Call
+}
+
+// ReadAspects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+func (_e *MockRepository_Expecter) ReadAspects(ctx interface{}, source interface{}) *MockRepository_ReadAspects_Call {
+	return &MockRepository_ReadAspects_Call{Call: _e.mock.On("ReadAspects", ctx, source)}
+}
+
+func (_c *MockRepository_ReadAspects_Call) Run(run func(ctx context.Context, source uuid.UUID)) *MockRepository_ReadAspects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ReadAspects_Call) Return(_a0 []Aspect, _a1 error) *MockRepository_ReadAspects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ReadAspects_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]Aspect, error)) *MockRepository_ReadAspects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadRatings provides a mock function with given fields: ctx, suggestionID
+func (_m *MockRepository) ReadRatings(ctx context.Context, suggestionID uuid.UUID) ([]*Rating, error) {
+	ret := _m.Called(ctx, suggestionID)
+
+	var r0 []*Rating
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*Rating, error)); ok {
+		return rf(ctx, suggestionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*Rating); ok {
+		r0 = rf(ctx, suggestionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Rating)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, suggestionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ReadRatings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadRatings'
+type MockRepository_ReadRatings_Call struct {
+	*mock.Call
+}
+
+// ReadRatings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - suggestionID uuid.UUID
+func (_e *MockRepository_Expecter) ReadRatings(ctx interface{}, suggestionID interface{}) *MockRepository_ReadRatings_Call {
+	return &MockRepository_ReadRatings_Call{Call: _e.mock.On("ReadRatings", ctx, suggestionID)}
+}
+
+func (_c *MockRepository_ReadRatings_Call) Run(run func(ctx context.Context, suggestionID uuid.UUID)) *MockRepository_ReadRatings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ReadRatings_Call) Return(_a0 []*Rating, _a1 error) *MockRepository_ReadRatings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ReadRatings_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*Rating, error)) *MockRepository_ReadRatings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Summarize provides a mock function with given fields: ctx, source
+func (_m *MockRepository) Summarize(ctx context.Context, source uuid.UUID) ([]*Summary, error) {
+	ret := _m.Called(ctx, source)
+
+	var r0 []*Summary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*Summary, error)); ok {
+		return rf(ctx, source)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*Summary); ok {
+		r0 = rf(ctx, source)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Summary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, source)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Summarize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Summarize'
+type MockRepository_Summarize_Call struct {
+	*mock.Call
+}
+
+// Summarize is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+func (_e *MockRepository_Expecter) Summarize(ctx interface{}, source interface{}) *MockRepository_Summarize_Call {
+	return &MockRepository_Summarize_Call{Call: _e.mock.On("Summarize", ctx, source)}
+}
+
+func (_c *MockRepository_Summarize_Call) Run(run func(ctx context.Context, source uuid.UUID)) *MockRepository_Summarize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Summarize_Call) Return(_a0 []*Summary, _a1 error) *MockRepository_Summarize_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Summarize_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*Summary, error)) *MockRepository_Summarize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAspects provides a mock function with given fields: ctx, source, aspects
+func (_m *MockRepository) UpdateAspects(ctx context.Context, source uuid.UUID, aspects []Aspect) ([]Aspect, error) {
+	ret := _m.Called(ctx, source, aspects)
+
+	var r0 []Aspect
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []Aspect) ([]Aspect, error)); ok {
+		return rf(ctx, source, aspects)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []Aspect) []Aspect); ok {
+		r0 = rf(ctx, source, aspects)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Aspect)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []Aspect) error); ok {
+		r1 = rf(ctx, source, aspects)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_UpdateAspects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAspects'
+type MockRepository_UpdateAspects_Call struct {
+	*mock.Call
+}
+
+// UpdateAspects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - aspects []Aspect
+func (_e *MockRepository_Expecter) UpdateAspects(ctx interface{}, source interface{}, aspects interface{}) *MockRepository_UpdateAspects_Call {
+	return &MockRepository_UpdateAspects_Call{Call: _e.mock.On("UpdateAspects", ctx, source, aspects)}
+}
+
+func (_c *MockRepository_UpdateAspects_Call) Run(run func(ctx context.Context, source uuid.UUID, aspects []Aspect)) *MockRepository_UpdateAspects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]Aspect))
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateAspects_Call) Return(_a0 []Aspect, _a1 error) *MockRepository_UpdateAspects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_UpdateAspects_Call) RunAndReturn(run func(context.Context, uuid.UUID, []Aspect) ([]Aspect, error)) *MockRepository_UpdateAspects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockRepository(t mockConstructorTestingTNewMockRepository) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,48 @@
+package critique_storage
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// Aspect is a facet of a scene, the owners of an improvement request can ask feedback on.
+type Aspect string
+
+const (
+	AspectPacing   Aspect = "pacing"
+	AspectDialogue Aspect = "dialogue"
+	AspectPOV      Aspect = "pov"
+	AspectGrammar  Aspect = "grammar"
+	AspectTone     Aspect = "tone"
+)
+
+// RequestAspect is the database model for the improve_request_aspects table. It lists the aspects the owners of an
+// improvement request want feedback on. It applies to every revision of the request.
+type RequestAspect struct {
+	bun.BaseModel `bun:"table:improve_request_aspects,alias:improve_request_aspects"`
+
+	// Source is the ID of the first revision of the request.
+	Source uuid.UUID `json:"source" bun:"source,pk,type:uuid"`
+	Aspect Aspect    `json:"aspect" bun:"aspect,pk"`
+}
+
+// Rating is the database model for the improve_suggestion_ratings table. It holds the rating given by the author
+// of a suggestion, on one aspect of the improvement request.
+type Rating struct {
+	bun.BaseModel `bun:"table:improve_suggestion_ratings,alias:improve_suggestion_ratings"`
+
+	// SuggestionID is the ID of the suggestion the rating is attached to.
+	SuggestionID uuid.UUID `json:"suggestion_id" bun:"suggestion_id,pk,type:uuid"`
+	Aspect       Aspect    `json:"aspect" bun:"aspect,pk"`
+	// Rating goes from 1 to 5.
+	Rating int `json:"rating" bun:"rating"`
+	// Note is an optional comment, explaining the rating.
+	Note string `json:"note" bun:"note"`
+}
+
+// Summary aggregates the ratings received by an improvement request, on one aspect.
+type Summary struct {
+	Aspect  Aspect  `json:"aspect" bun:"aspect"`
+	Average float64 `json:"average" bun:"average"`
+	Count   int64   `json:"count" bun:"count"`
+}
@@ -0,0 +1,161 @@
+package critique_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// Repository of the current layer. You can instantiate a new one with NewRepository.
+type Repository interface {
+	// ReadAspects returns the aspects requested on an improvement request, based on the ID of its first revision.
+	ReadAspects(ctx context.Context, source uuid.UUID) ([]Aspect, error)
+	// UpdateAspects replaces the aspects requested on an improvement request. It returns the new aspects.
+	UpdateAspects(ctx context.Context, source uuid.UUID, aspects []Aspect) ([]Aspect, error)
+	// ReadRatings returns the ratings attached to a suggestion.
+	ReadRatings(ctx context.Context, suggestionID uuid.UUID) ([]*Rating, error)
+	// Rate replaces the ratings attached to a suggestion. It returns the new ratings.
+	Rate(ctx context.Context, suggestionID uuid.UUID, ratings []*Rating) ([]*Rating, error)
+	// Summarize aggregates the ratings of the suggestions of an improvement request, for every aspect currently
+	// requested. Deleted suggestions are ignored.
+	Summarize(ctx context.Context, source uuid.UUID) ([]*Summary, error)
+}
+
+// NewRepository returns a new Repository instance.
+// To use a mocked one, call NewMockRepository.
+func NewRepository(db bun.IDB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+type repositoryImpl struct {
+	db bun.IDB
+}
+
+func (repository *repositoryImpl) readAspects(ctx context.Context, db bun.IDB, source uuid.UUID) ([]Aspect, error) {
+	aspects := make([]Aspect, 0)
+	if err := db.NewSelect().
+		Model((*RequestAspect)(nil)).
+		Column("aspect").
+		Where("source = ?", source).
+		Order("aspect").
+		Scan(ctx, &aspects); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return aspects, nil
+}
+
+func (repository *repositoryImpl) readRatings(ctx context.Context, db bun.IDB, suggestionID uuid.UUID) ([]*Rating, error) {
+	ratings := make([]*Rating, 0)
+	if err := db.NewSelect().
+		Model(&ratings).
+		Where("suggestion_id = ?", suggestionID).
+		Order("aspect").
+		Scan(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return ratings, nil
+}
+
+func (repository *repositoryImpl) ReadAspects(ctx context.Context, source uuid.UUID) ([]Aspect, error) {
+	return repository.readAspects(ctx, repository.db, source)
+}
+
+func (repository *repositoryImpl) UpdateAspects(ctx context.Context, source uuid.UUID, aspects []Aspect) ([]Aspect, error) {
+	var res []Aspect
+
+	err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().
+			Model((*RequestAspect)(nil)).
+			Where("source = ?", source).
+			Exec(ctx); err != nil {
+			return validation.HandlePGError(err)
+		}
+
+		if len(aspects) > 0 {
+			entries := make([]*RequestAspect, len(aspects))
+			for i, aspect := range aspects {
+				entries[i] = &RequestAspect{Source: source, Aspect: aspect}
+			}
+
+			if _, err := tx.NewInsert().Model(&entries).On("CONFLICT DO NOTHING").Exec(ctx); err != nil {
+				return validation.HandlePGError(err)
+			}
+		}
+
+		var err error
+		res, err = repository.readAspects(ctx, tx, source)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (repository *repositoryImpl) ReadRatings(ctx context.Context, suggestionID uuid.UUID) ([]*Rating, error) {
+	return repository.readRatings(ctx, repository.db, suggestionID)
+}
+
+func (repository *repositoryImpl) Rate(ctx context.Context, suggestionID uuid.UUID, ratings []*Rating) ([]*Rating, error) {
+	var res []*Rating
+
+	err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().
+			Model((*Rating)(nil)).
+			Where("suggestion_id = ?", suggestionID).
+			Exec(ctx); err != nil {
+			return validation.HandlePGError(err)
+		}
+
+		if len(ratings) > 0 {
+			entries := make([]*Rating, len(ratings))
+			for i, rating := range ratings {
+				entries[i] = &Rating{SuggestionID: suggestionID, Aspect: rating.Aspect, Rating: rating.Rating, Note: rating.Note}
+			}
+
+			if _, err := tx.NewInsert().Model(&entries).Exec(ctx); err != nil {
+				return validation.HandlePGError(err)
+			}
+		}
+
+		var err error
+		res, err = repository.readRatings(ctx, tx, suggestionID)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (repository *repositoryImpl) Summarize(ctx context.Context, source uuid.UUID) ([]*Summary, error) {
+	summaries := make([]*Summary, 0)
+
+	if err := repository.db.NewSelect().
+		ColumnExpr("improve_suggestion_ratings.aspect").
+		ColumnExpr("AVG(improve_suggestion_ratings.rating)::FLOAT AS average").
+		ColumnExpr("COUNT(*) AS count").
+		TableExpr("improve_suggestion_ratings").
+		Join("JOIN improve_suggestions ON improve_suggestions.id = improve_suggestion_ratings.suggestion_id").
+		// Ratings on aspects that are no longer requested are kept, but not summarized.
+		Join(
+			"JOIN improve_request_aspects ON improve_request_aspects.source = improve_suggestions.source_id "+
+				"AND improve_request_aspects.aspect = improve_suggestion_ratings.aspect",
+		).
+		Where("improve_suggestions.source_id = ?", source).
+		Where("improve_suggestions.deleted_at IS NULL").
+		GroupExpr("improve_suggestion_ratings.aspect").
+		OrderExpr("improve_suggestion_ratings.aspect").
+		Scan(ctx, &summaries); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return summaries, nil
+}
@@ -0,0 +1,268 @@
+package critique_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime   = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	deleteTime = time.Date(2020, time.May, 4, 12, 0, 0, 0, time.UTC)
+)
+
+var Fixtures = []interface{}{
+	&improve_request_storage.Model{
+		ID:        test_utils.NumberUUID(1000),
+		CreatedAt: baseTime,
+		Source:    test_utils.NumberUUID(1000),
+		UserID:    test_utils.NumberUUID(200),
+		Title:     "Test",
+		Content:   "Dummy content.",
+	},
+	&improve_suggestion_storage.Model{
+		ID:        test_utils.NumberUUID(2000),
+		CreatedAt: baseTime,
+		SourceID:  test_utils.NumberUUID(1000),
+		UserID:    test_utils.NumberUUID(201),
+		Core: improve_suggestion_storage.Core{
+			RequestID: test_utils.NumberUUID(1000),
+			Title:     "Test",
+			Content:   "Smart content.",
+		},
+	},
+	&improve_suggestion_storage.Model{
+		ID:        test_utils.NumberUUID(2001),
+		CreatedAt: baseTime,
+		SourceID:  test_utils.NumberUUID(1000),
+		UserID:    test_utils.NumberUUID(202),
+		Core: improve_suggestion_storage.Core{
+			RequestID: test_utils.NumberUUID(1000),
+			Title:     "Test",
+			Content:   "Another smart content.",
+		},
+	},
+	&improve_suggestion_storage.Model{
+		ID:        test_utils.NumberUUID(2002),
+		CreatedAt: baseTime,
+		SourceID:  test_utils.NumberUUID(1000),
+		UserID:    test_utils.NumberUUID(203),
+		DeletedAt: &deleteTime,
+		Core: improve_suggestion_storage.Core{
+			RequestID: test_utils.NumberUUID(1000),
+			Title:     "Test",
+			Content:   "Deleted content.",
+		},
+	},
+	&RequestAspect{Source: test_utils.NumberUUID(1000), Aspect: AspectTone},
+	&RequestAspect{Source: test_utils.NumberUUID(1000), Aspect: AspectPacing},
+	&Rating{SuggestionID: test_utils.NumberUUID(2000), Aspect: AspectPacing, Rating: 2, Note: "Too slow."},
+	&Rating{SuggestionID: test_utils.NumberUUID(2000), Aspect: AspectTone, Rating: 4},
+	&Rating{SuggestionID: test_utils.NumberUUID(2001), Aspect: AspectPacing, Rating: 3},
+	// No longer requested.
+	&Rating{SuggestionID: test_utils.NumberUUID(2001), Aspect: AspectGrammar, Rating: 1},
+	// Deleted suggestion.
+	&Rating{SuggestionID: test_utils.NumberUUID(2002), Aspect: AspectTone, Rating: 1},
+}
+
+func TestCritiqueRepository_ReadAspects(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		source uuid.UUID
+
+		expect    []Aspect
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1000),
+			expect: []Aspect{AspectPacing, AspectTone},
+		},
+		{
+			name:   "Success/NoAspects",
+			source: test_utils.NumberUUID(1001),
+			expect: []Aspect{},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				repository := NewRepository(tx)
+				res, err := repository.ReadAspects(ctx, d.source)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestCritiqueRepository_UpdateAspects(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		source  uuid.UUID
+		aspects []Aspect
+
+		expect    []Aspect
+		expectErr error
+	}{
+		{
+			name:    "Success",
+			source:  test_utils.NumberUUID(1000),
+			aspects: []Aspect{AspectGrammar, AspectDialogue, AspectTone},
+			expect:  []Aspect{AspectDialogue, AspectGrammar, AspectTone},
+		},
+		{
+			name:    "Success/New",
+			source:  test_utils.NumberUUID(1001),
+			aspects: []Aspect{AspectPOV},
+			expect:  []Aspect{AspectPOV},
+		},
+		{
+			name:    "Success/Clear",
+			source:  test_utils.NumberUUID(1000),
+			aspects: nil,
+			expect:  []Aspect{},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.BeginTx(ctx, nil)
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				res, err := repository.UpdateAspects(ctx, d.source, d.aspects)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+
+				if d.expectErr == nil {
+					stored, err := repository.ReadAspects(ctx, d.source)
+					require.NoError(st, err)
+					require.Equal(st, d.expect, stored)
+				}
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestCritiqueRepository_Rate(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		suggestionID uuid.UUID
+		ratings      []*Rating
+
+		expect    []*Rating
+		expectErr error
+	}{
+		{
+			name:         "Success",
+			suggestionID: test_utils.NumberUUID(2000),
+			ratings: []*Rating{
+				{Aspect: AspectTone, Rating: 5, Note: "Perfect."},
+			},
+			expect: []*Rating{
+				{SuggestionID: test_utils.NumberUUID(2000), Aspect: AspectTone, Rating: 5, Note: "Perfect."},
+			},
+		},
+		{
+			name:         "Success/Clear",
+			suggestionID: test_utils.NumberUUID(2000),
+			expect:       []*Rating{},
+		},
+		{
+			name:         "Error/InvalidRating",
+			suggestionID: test_utils.NumberUUID(2000),
+			ratings: []*Rating{
+				{Aspect: AspectTone, Rating: 6},
+			},
+			expectErr: validation.ErrConstraintViolation,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.BeginTx(ctx, nil)
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				res, err := repository.Rate(ctx, d.suggestionID, d.ratings)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+
+				if d.expectErr == nil {
+					stored, err := repository.ReadRatings(ctx, d.suggestionID)
+					require.NoError(st, err)
+					require.Equal(st, d.expect, stored)
+				}
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestCritiqueRepository_Summarize(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		source uuid.UUID
+
+		expect    []*Summary
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1000),
+			expect: []*Summary{
+				{Aspect: AspectPacing, Average: 2.5, Count: 2},
+				{Aspect: AspectTone, Average: 4, Count: 1},
+			},
+		},
+		{
+			name:   "Success/NoRatings",
+			source: test_utils.NumberUUID(1001),
+			expect: []*Summary{},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				repository := NewRepository(tx)
+				res, err := repository.Summarize(ctx, d.source)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
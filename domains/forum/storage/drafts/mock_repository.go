@@ -0,0 +1,429 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package drafts_storage
+
+import (
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, data, userID, id, now
+func (_m *MockRepository) Create(ctx context.Context, data *Core, userID uuid.UUID, id uuid.UUID, now time.Time) (*Model, error) {
+	ret := _m.Called(ctx, data, userID, id, now)
+
+	var r0 *Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Core, uuid.UUID, uuid.UUID, time.Time) (*Model, error)); ok {
+		return rf(ctx, data, userID, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *Core, uuid.UUID, uuid.UUID, time.Time) *Model); ok {
+		r0 = rf(ctx, data, userID, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *Core, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, data, userID, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *Core
+//   - userID uuid.UUID
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *MockRepository_Expecter) Create(ctx interface{}, data interface{}, userID interface{}, id interface{}, now interface{}) *MockRepository_Create_Call {
+	return &MockRepository_Create_Call{Call: _e.mock.On("Create", ctx, data, userID, id, now)}
+}
+
+func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, data *Core, userID uuid.UUID, id uuid.UUID, now time.Time)) *MockRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Core), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Create_Call) Return(_a0 *Model, _a1 error) *MockRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Create_Call) RunAndReturn(run func(context.Context, *Core, uuid.UUID, uuid.UUID, time.Time) (*Model, error)) *MockRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockRepository_Delete_Call {
+	return &MockRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Delete_Call) Return(_a0 error) *MockRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, userID, limit, offset
+func (_m *MockRepository) List(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]*Model, int64, error) {
+	ret := _m.Called(ctx, userID, limit, offset)
+
+	var r0 []*Model
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]*Model, int64, error)); ok {
+		return rf(ctx, userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []*Model); ok {
+		r0 = rf(ctx, userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) int64); ok {
+		r1 = rf(ctx, userID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r2 = rf(ctx, userID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - limit int
+//   - offset int
+func (_e *MockRepository_Expecter) List(ctx interface{}, userID interface{}, limit interface{}, offset interface{}) *MockRepository_List_Call {
+	return &MockRepository_List_Call{Call: _e.mock.On("List", ctx, userID, limit, offset)}
+}
+
+func (_c *MockRepository_List_Call) Run(run func(ctx context.Context, userID uuid.UUID, limit int, offset int)) *MockRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_List_Call) Return(_a0 []*Model, _a1 int64, _a2 error) *MockRepository_List_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRepository_List_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, int) ([]*Model, int64, error)) *MockRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListScheduled provides a mock function with given fields: ctx, now, limit
+func (_m *MockRepository) ListScheduled(ctx context.Context, now time.Time, limit int) ([]*Model, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	var r0 []*Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*Model, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*Model); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ListScheduled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListScheduled'
+type MockRepository_ListScheduled_Call struct {
+	*mock.Call
+}
+
+// ListScheduled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int
+func (_e *MockRepository_Expecter) ListScheduled(ctx interface{}, now interface{}, limit interface{}) *MockRepository_ListScheduled_Call {
+	return &MockRepository_ListScheduled_Call{Call: _e.mock.On("ListScheduled", ctx, now, limit)}
+}
+
+func (_c *MockRepository_ListScheduled_Call) Run(run func(ctx context.Context, now time.Time, limit int)) *MockRepository_ListScheduled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListScheduled_Call) Return(_a0 []*Model, _a1 error) *MockRepository_ListScheduled_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ListScheduled_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]*Model, error)) *MockRepository_ListScheduled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Read provides a mock function with given fields: ctx, id
+func (_m *MockRepository) Read(ctx context.Context, id uuid.UUID) (*Model, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*Model, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *Model); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Read'
+type MockRepository_Read_Call struct {
+	*mock.Call
+}
+
+// Read is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockRepository_Expecter) Read(ctx interface{}, id interface{}) *MockRepository_Read_Call {
+	return &MockRepository_Read_Call{Call: _e.mock.On("Read", ctx, id)}
+}
+
+func (_c *MockRepository_Read_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockRepository_Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Read_Call) Return(_a0 *Model, _a1 error) *MockRepository_Read_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Read_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*Model, error)) *MockRepository_Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unschedule provides a mock function with given fields: ctx, id, now
+func (_m *MockRepository) Unschedule(ctx context.Context, id uuid.UUID, now time.Time) (*Model, error) {
+	ret := _m.Called(ctx, id, now)
+
+	var r0 *Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) (*Model, error)); ok {
+		return rf(ctx, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) *Model); ok {
+		r0 = rf(ctx, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Unschedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unschedule'
+type MockRepository_Unschedule_Call struct {
+	*mock.Call
+}
+
+// Unschedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *MockRepository_Expecter) Unschedule(ctx interface{}, id interface{}, now interface{}) *MockRepository_Unschedule_Call {
+	return &MockRepository_Unschedule_Call{Call: _e.mock.On("Unschedule", ctx, id, now)}
+}
+
+func (_c *MockRepository_Unschedule_Call) Run(run func(ctx context.Context, id uuid.UUID, now time.Time)) *MockRepository_Unschedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Unschedule_Call) Return(_a0 *Model, _a1 error) *MockRepository_Unschedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Unschedule_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) (*Model, error)) *MockRepository_Unschedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, data, id, now
+func (_m *MockRepository) Update(ctx context.Context, data *Core, id uuid.UUID, now time.Time) (*Model, error) {
+	ret := _m.Called(ctx, data, id, now)
+
+	var r0 *Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Core, uuid.UUID, time.Time) (*Model, error)); ok {
+		return rf(ctx, data, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *Core, uuid.UUID, time.Time) *Model); ok {
+		r0 = rf(ctx, data, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *Core, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, data, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data *Core
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *MockRepository_Expecter) Update(ctx interface{}, data interface{}, id interface{}, now interface{}) *MockRepository_Update_Call {
+	return &MockRepository_Update_Call{Call: _e.mock.On("Update", ctx, data, id, now)}
+}
+
+func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, data *Core, id uuid.UUID, now time.Time)) *MockRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Core), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Update_Call) Return(_a0 *Model, _a1 error) *MockRepository_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Update_Call) RunAndReturn(run func(context.Context, *Core, uuid.UUID, time.Time) (*Model, error)) *MockRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockRepository(t mockConstructorTestingTNewMockRepository) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
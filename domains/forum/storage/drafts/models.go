@@ -0,0 +1,41 @@
+package drafts_storage
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// Model is the database model for the improve_request_drafts table.
+// A draft holds an improvement request (improve_request_storage.Model) that is not published yet. It is only
+// visible to its author, who can edit it over several sessions before publishing it.
+type Model struct {
+	bun.BaseModel `bun:"table:improve_request_drafts,alias:improve_request_drafts"`
+
+	// ID of the draft.
+	ID uuid.UUID `json:"id" bun:"id,pk,type:uuid"`
+	// UserID is the ID of the author of the draft.
+	UserID uuid.UUID `json:"user_id" bun:"user_id,type:uuid"`
+	// CreatedAt stores the time at which the draft was created.
+	CreatedAt time.Time `json:"created_at" bun:"created_at,notnull"`
+	// UpdatedAt stores the time at which the draft was last saved.
+	UpdatedAt *time.Time `json:"updated_at" bun:"updated_at"`
+
+	Core
+}
+
+// Core contains the explicitly editable data of the current model.
+type Core struct {
+	// Title of the future request. It may be incomplete.
+	Title string `json:"title" bun:"title"`
+	// Content of the future request. It may be incomplete.
+	Content string `json:"content" bun:"content"`
+	// Language is the code of the language the request is written in. It is detected on publication when empty.
+	Language string `json:"language" bun:"language"`
+	// Tags are the IDs of the tags to attach to the request, once published.
+	Tags []uuid.UUID `json:"tags" bun:"tags,type:uuid[],array"`
+
+	// PublishAt is the time at which the draft is automatically published. The draft is only published manually
+	// when empty.
+	PublishAt *time.Time `json:"publish_at" bun:"publish_at"`
+}
@@ -0,0 +1,150 @@
+package drafts_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// Repository of the current layer. You can instantiate a new one with NewRepository.
+type Repository interface {
+	// Read reads a single draft, based on its ID.
+	Read(ctx context.Context, id uuid.UUID) (*Model, error)
+	// List returns the drafts of a user, most recently saved first.
+	// It also returns the total number of available results, to help with pagination.
+	List(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*Model, int64, error)
+
+	// Create creates a new draft.
+	Create(ctx context.Context, data *Core, userID, id uuid.UUID, now time.Time) (*Model, error)
+	// Update overwrites the content of a draft.
+	Update(ctx context.Context, data *Core, id uuid.UUID, now time.Time) (*Model, error)
+	// Delete removes a draft.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListScheduled returns the drafts which publication time is over, oldest first.
+	ListScheduled(ctx context.Context, now time.Time, limit int) ([]*Model, error)
+	// Unschedule cancels the automatic publication of a draft.
+	Unschedule(ctx context.Context, id uuid.UUID, now time.Time) (*Model, error)
+}
+
+// NewRepository returns a new Repository instance.
+// To use a mocked one, call NewMockRepository.
+func NewRepository(db bun.IDB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+type repositoryImpl struct {
+	db bun.IDB
+}
+
+func (repository *repositoryImpl) Read(ctx context.Context, id uuid.UUID) (*Model, error) {
+	model := &Model{ID: id}
+	if err := repository.db.NewSelect().Model(model).WherePK().Scan(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+func (repository *repositoryImpl) List(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*Model, int64, error) {
+	results := make([]*Model, 0)
+
+	count, err := repository.db.NewSelect().
+		Model(&results).
+		Where("user_id = ?", userID).
+		OrderExpr("COALESCE(updated_at, created_at) DESC, id").
+		Limit(limit).
+		Offset(offset).
+		ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, validation.HandlePGError(err)
+	}
+
+	return results, int64(count), nil
+}
+
+func (repository *repositoryImpl) Create(ctx context.Context, data *Core, userID, id uuid.UUID, now time.Time) (*Model, error) {
+	model := &Model{
+		ID:        id,
+		UserID:    userID,
+		CreatedAt: now,
+		Core:      *data,
+	}
+
+	if _, err := repository.db.NewInsert().Model(model).Returning("*").Exec(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+func (repository *repositoryImpl) Update(ctx context.Context, data *Core, id uuid.UUID, now time.Time) (*Model, error) {
+	model := &Model{ID: id, UpdatedAt: &now, Core: *data}
+
+	res, err := repository.db.NewUpdate().Model(model).
+		WherePK().
+		Column(
+			"title",
+			"content",
+			"language",
+			"tags",
+			"publish_at",
+			"updated_at",
+		).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+	if err := validation.ForceRowsUpdate(res); err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
+
+func (repository *repositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	model := &Model{ID: id}
+
+	res, err := repository.db.NewDelete().Model(model).WherePK().Exec(ctx)
+	if err != nil {
+		return validation.HandlePGError(err)
+	}
+
+	return validation.ForceRowsUpdate(res)
+}
+
+func (repository *repositoryImpl) ListScheduled(ctx context.Context, now time.Time, limit int) ([]*Model, error) {
+	results := make([]*Model, 0)
+
+	if err := repository.db.NewSelect().
+		Model(&results).
+		Where("publish_at <= ?", now).
+		Order("publish_at", "id").
+		Limit(limit).
+		Scan(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return results, nil
+}
+
+func (repository *repositoryImpl) Unschedule(ctx context.Context, id uuid.UUID, now time.Time) (*Model, error) {
+	model := &Model{ID: id, UpdatedAt: &now}
+
+	res, err := repository.db.NewUpdate().Model(model).
+		WherePK().
+		Column("publish_at", "updated_at").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+	if err := validation.ForceRowsUpdate(res); err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
@@ -0,0 +1,424 @@
+package drafts_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime   = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	updateTime = time.Date(2020, time.May, 4, 9, 0, 0, 0, time.UTC)
+	publishAt  = time.Date(2020, time.May, 4, 8, 30, 0, 0, time.UTC)
+	laterTime  = time.Date(2020, time.May, 5, 8, 0, 0, 0, time.UTC)
+)
+
+var Fixtures = []interface{}{
+	&Model{
+		ID:        test_utils.NumberUUID(1000),
+		UserID:    test_utils.NumberUUID(100),
+		CreatedAt: baseTime,
+		Core: Core{
+			Title:   "Work in progress",
+			Content: "It was a dark",
+			Tags:    []uuid.UUID{},
+		},
+	},
+	&Model{
+		ID:        test_utils.NumberUUID(1001),
+		UserID:    test_utils.NumberUUID(100),
+		CreatedAt: baseTime,
+		UpdatedAt: &updateTime,
+		Core: Core{
+			Title:     "Scheduled scene",
+			Content:   "It was a dark and stormy night.",
+			Language:  "en",
+			Tags:      []uuid.UUID{test_utils.NumberUUID(10)},
+			PublishAt: &publishAt,
+		},
+	},
+	&Model{
+		ID:        test_utils.NumberUUID(1002),
+		UserID:    test_utils.NumberUUID(101),
+		CreatedAt: baseTime,
+		Core: Core{
+			Title:     "Scheduled later",
+			Content:   "Once upon a time.",
+			Tags:      []uuid.UUID{},
+			PublishAt: &laterTime,
+		},
+	},
+}
+
+func TestDraftsRepository_Read(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		id uuid.UUID
+
+		expect    *Model
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			id:     test_utils.NumberUUID(1001),
+			expect: Fixtures[1].(*Model),
+		},
+		{
+			name:      "Error/NotFound",
+			id:        test_utils.NumberUUID(10),
+			expectErr: validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				repository := NewRepository(tx)
+				res, err := repository.Read(ctx, d.id)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestDraftsRepository_List(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+		limit  int
+		offset int
+
+		expect      []*Model
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name:        "Success",
+			userID:      test_utils.NumberUUID(100),
+			limit:       10,
+			expect:      []*Model{Fixtures[1].(*Model), Fixtures[0].(*Model)},
+			expectTotal: 2,
+		},
+		{
+			name:        "Success/Paginated",
+			userID:      test_utils.NumberUUID(100),
+			limit:       1,
+			offset:      1,
+			expect:      []*Model{Fixtures[0].(*Model)},
+			expectTotal: 2,
+		},
+		{
+			name:   "Success/NoResults",
+			userID: test_utils.NumberUUID(102),
+			limit:  10,
+			expect: []*Model{},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				repository := NewRepository(tx)
+				res, total, err := repository.List(ctx, d.userID, d.limit, d.offset)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+				require.Equal(st, d.expectTotal, total)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestDraftsRepository_Create(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		data   *Core
+		userID uuid.UUID
+		id     uuid.UUID
+		now    time.Time
+
+		expect    *Model
+		expectErr error
+	}{
+		{
+			name: "Success",
+			data: &Core{
+				Title: "New draft",
+				Tags:  []uuid.UUID{},
+			},
+			userID: test_utils.NumberUUID(100),
+			id:     test_utils.NumberUUID(1),
+			now:    baseTime,
+			expect: &Model{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(100),
+				CreatedAt: baseTime,
+				Core: Core{
+					Title: "New draft",
+					Tags:  []uuid.UUID{},
+				},
+			},
+		},
+		{
+			name: "Error/AlreadyExists",
+			data: &Core{
+				Title: "New draft",
+				Tags:  []uuid.UUID{},
+			},
+			userID:    test_utils.NumberUUID(100),
+			id:        test_utils.NumberUUID(1000),
+			now:       baseTime,
+			expectErr: validation.ErrUniqConstraintViolation,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				res, err := repository.Create(ctx, d.data, d.userID, d.id, d.now)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestDraftsRepository_Update(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		data *Core
+		id   uuid.UUID
+		now  time.Time
+
+		expect    *Model
+		expectErr error
+	}{
+		{
+			name: "Success",
+			data: &Core{
+				Title:     "Work in progress",
+				Content:   "It was a dark and stormy night.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(10)},
+				PublishAt: &laterTime,
+			},
+			id:  test_utils.NumberUUID(1000),
+			now: updateTime,
+			expect: &Model{
+				ID:        test_utils.NumberUUID(1000),
+				UserID:    test_utils.NumberUUID(100),
+				CreatedAt: baseTime,
+				UpdatedAt: &updateTime,
+				Core: Core{
+					Title:     "Work in progress",
+					Content:   "It was a dark and stormy night.",
+					Tags:      []uuid.UUID{test_utils.NumberUUID(10)},
+					PublishAt: &laterTime,
+				},
+			},
+		},
+		{
+			name: "Error/NotFound",
+			data: &Core{
+				Title: "Work in progress",
+				Tags:  []uuid.UUID{},
+			},
+			id:        test_utils.NumberUUID(10),
+			now:       updateTime,
+			expectErr: validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				res, err := repository.Update(ctx, d.data, d.id, d.now)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestDraftsRepository_Delete(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		id uuid.UUID
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+			id:   test_utils.NumberUUID(1000),
+		},
+		{
+			name:      "Error/NotFound",
+			id:        test_utils.NumberUUID(10),
+			expectErr: validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				test_utils.RequireError(st, d.expectErr, repository.Delete(ctx, d.id))
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestDraftsRepository_ListScheduled(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		now   time.Time
+		limit int
+
+		expect    []*Model
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			now:    updateTime,
+			limit:  10,
+			expect: []*Model{Fixtures[1].(*Model)},
+		},
+		{
+			name:   "Success/Limit",
+			now:    laterTime,
+			limit:  1,
+			expect: []*Model{Fixtures[1].(*Model)},
+		},
+		{
+			name:   "Success/Every",
+			now:    laterTime,
+			limit:  10,
+			expect: []*Model{Fixtures[1].(*Model), Fixtures[2].(*Model)},
+		},
+		{
+			name:   "Success/NoResults",
+			now:    baseTime,
+			limit:  10,
+			expect: []*Model{},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				repository := NewRepository(tx)
+				res, err := repository.ListScheduled(ctx, d.now, d.limit)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestDraftsRepository_Unschedule(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		id  uuid.UUID
+		now time.Time
+
+		expect    *Model
+		expectErr error
+	}{
+		{
+			name: "Success",
+			id:   test_utils.NumberUUID(1002),
+			now:  updateTime,
+			expect: &Model{
+				ID:        test_utils.NumberUUID(1002),
+				UserID:    test_utils.NumberUUID(101),
+				CreatedAt: baseTime,
+				UpdatedAt: &updateTime,
+				Core: Core{
+					Title:   "Scheduled later",
+					Content: "Once upon a time.",
+					Tags:    []uuid.UUID{},
+				},
+			},
+		},
+		{
+			name:      "Error/NotFound",
+			id:        test_utils.NumberUUID(10),
+			now:       updateTime,
+			expectErr: validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				res, err := repository.Unschedule(ctx, d.id, d.now)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,362 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package duplicates_storage
+
+import (
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// Candidates provides a mock function with given fields: ctx, bands, userID, threadID, before, limit
+func (_m *MockRepository) Candidates(ctx context.Context, bands []int64, userID uuid.UUID, threadID uuid.UUID, before time.Time, limit int) ([]*Fingerprint, error) {
+	ret := _m.Called(ctx, bands, userID, threadID, before, limit)
+
+	var r0 []*Fingerprint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, uuid.UUID, uuid.UUID, time.Time, int) ([]*Fingerprint, error)); ok {
+		return rf(ctx, bands, userID, threadID, before, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, uuid.UUID, uuid.UUID, time.Time, int) []*Fingerprint); ok {
+		r0 = rf(ctx, bands, userID, threadID, before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Fingerprint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64, uuid.UUID, uuid.UUID, time.Time, int) error); ok {
+		r1 = rf(ctx, bands, userID, threadID, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Candidates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Candidates'
+type MockRepository_Candidates_Call struct {
+	*mock.Call
+}
+
+// Candidates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bands []int64
+//   - userID uuid.UUID
+//   - threadID uuid.UUID
+//   - before time.Time
+//   - limit int
+func (_e *MockRepository_Expecter) Candidates(ctx interface{}, bands interface{}, userID interface{}, threadID interface{}, before interface{}, limit interface{}) *MockRepository_Candidates_Call {
+	return &MockRepository_Candidates_Call{Call: _e.mock.On("Candidates", ctx, bands, userID, threadID, before, limit)}
+}
+
+func (_c *MockRepository_Candidates_Call) Run(run func(ctx context.Context, bands []int64, userID uuid.UUID, threadID uuid.UUID, before time.Time, limit int)) *MockRepository_Candidates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(time.Time), args[5].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Candidates_Call) Return(_a0 []*Fingerprint, _a1 error) *MockRepository_Candidates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Candidates_Call) RunAndReturn(run func(context.Context, []int64, uuid.UUID, uuid.UUID, time.Time, int) ([]*Fingerprint, error)) *MockRepository_Candidates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Flag provides a mock function with given fields: ctx, flags
+func (_m *MockRepository) Flag(ctx context.Context, flags []*Flag) error {
+	ret := _m.Called(ctx, flags)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*Flag) error); ok {
+		r0 = rf(ctx, flags)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Flag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flag'
+type MockRepository_Flag_Call struct {
+	*mock.Call
+}
+
+// Flag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flags []*Flag
+func (_e *MockRepository_Expecter) Flag(ctx interface{}, flags interface{}) *MockRepository_Flag_Call {
+	return &MockRepository_Flag_Call{Call: _e.mock.On("Flag", ctx, flags)}
+}
+
+func (_c *MockRepository_Flag_Call) Run(run func(ctx context.Context, flags []*Flag)) *MockRepository_Flag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*Flag))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Flag_Call) Return(_a0 error) *MockRepository_Flag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Flag_Call) RunAndReturn(run func(context.Context, []*Flag) error) *MockRepository_Flag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFlags provides a mock function with given fields: ctx, reviewed, limit, offset
+func (_m *MockRepository) ListFlags(ctx context.Context, reviewed bool, limit int, offset int) ([]*FlagPreview, int64, error) {
+	ret := _m.Called(ctx, reviewed, limit, offset)
+
+	var r0 []*FlagPreview
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool, int, int) ([]*FlagPreview, int64, error)); ok {
+		return rf(ctx, reviewed, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool, int, int) []*FlagPreview); ok {
+		r0 = rf(ctx, reviewed, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*FlagPreview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool, int, int) int64); ok {
+		r1 = rf(ctx, reviewed, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, bool, int, int) error); ok {
+		r2 = rf(ctx, reviewed, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRepository_ListFlags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFlags'
+type MockRepository_ListFlags_Call struct {
+	*mock.Call
+}
+
+// ListFlags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reviewed bool
+//   - limit int
+//   - offset int
+func (_e *MockRepository_Expecter) ListFlags(ctx interface{}, reviewed interface{}, limit interface{}, offset interface{}) *MockRepository_ListFlags_Call {
+	return &MockRepository_ListFlags_Call{Call: _e.mock.On("ListFlags", ctx, reviewed, limit, offset)}
+}
+
+func (_c *MockRepository_ListFlags_Call) Run(run func(ctx context.Context, reviewed bool, limit int, offset int)) *MockRepository_ListFlags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListFlags_Call) Return(_a0 []*FlagPreview, _a1 int64, _a2 error) *MockRepository_ListFlags_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRepository_ListFlags_Call) RunAndReturn(run func(context.Context, bool, int, int) ([]*FlagPreview, int64, error)) *MockRepository_ListFlags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Register provides a mock function with given fields: ctx, fingerprint
+func (_m *MockRepository) Register(ctx context.Context, fingerprint *Fingerprint) error {
+	ret := _m.Called(ctx, fingerprint)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Fingerprint) error); ok {
+		r0 = rf(ctx, fingerprint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type MockRepository_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fingerprint *Fingerprint
+func (_e *MockRepository_Expecter) Register(ctx interface{}, fingerprint interface{}) *MockRepository_Register_Call {
+	return &MockRepository_Register_Call{Call: _e.mock.On("Register", ctx, fingerprint)}
+}
+
+func (_c *MockRepository_Register_Call) Run(run func(ctx context.Context, fingerprint *Fingerprint)) *MockRepository_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Fingerprint))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Register_Call) Return(_a0 error) *MockRepository_Register_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Register_Call) RunAndReturn(run func(context.Context, *Fingerprint) error) *MockRepository_Register_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Review provides a mock function with given fields: ctx, revisionID, originalRevisionID, reviewerID, now
+func (_m *MockRepository) Review(ctx context.Context, revisionID uuid.UUID, originalRevisionID uuid.UUID, reviewerID uuid.UUID, now time.Time) (*Flag, error) {
+	ret := _m.Called(ctx, revisionID, originalRevisionID, reviewerID, now)
+
+	var r0 *Flag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) (*Flag, error)); ok {
+		return rf(ctx, revisionID, originalRevisionID, reviewerID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) *Flag); ok {
+		r0 = rf(ctx, revisionID, originalRevisionID, reviewerID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Flag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, revisionID, originalRevisionID, reviewerID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Review_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Review'
+type MockRepository_Review_Call struct {
+	*mock.Call
+}
+
+// Review is a helper method to define mock.On call
+//   - ctx context.Context
+//   - revisionID uuid.UUID
+//   - originalRevisionID uuid.UUID
+//   - reviewerID uuid.UUID
+//   - now time.Time
+func (_e *MockRepository_Expecter) Review(ctx interface{}, revisionID interface{}, originalRevisionID interface{}, reviewerID interface{}, now interface{}) *MockRepository_Review_Call {
+	return &MockRepository_Review_Call{Call: _e.mock.On("Review", ctx, revisionID, originalRevisionID, reviewerID, now)}
+}
+
+func (_c *MockRepository_Review_Call) Run(run func(ctx context.Context, revisionID uuid.UUID, originalRevisionID uuid.UUID, reviewerID uuid.UUID, now time.Time)) *MockRepository_Review_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Review_Call) Return(_a0 *Flag, _a1 error) *MockRepository_Review_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Review_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) (*Flag, error)) *MockRepository_Review_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unfingerprinted provides a mock function with given fields: ctx, limit
+func (_m *MockRepository) Unfingerprinted(ctx context.Context, limit int) ([]*Revision, error) {
+	ret := _m.Called(ctx, limit)
+
+	var r0 []*Revision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*Revision, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*Revision); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Revision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Unfingerprinted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unfingerprinted'
+type MockRepository_Unfingerprinted_Call struct {
+	*mock.Call
+}
+
+// Unfingerprinted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockRepository_Expecter) Unfingerprinted(ctx interface{}, limit interface{}) *MockRepository_Unfingerprinted_Call {
+	return &MockRepository_Unfingerprinted_Call{Call: _e.mock.On("Unfingerprinted", ctx, limit)}
+}
+
+func (_c *MockRepository_Unfingerprinted_Call) Run(run func(ctx context.Context, limit int)) *MockRepository_Unfingerprinted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Unfingerprinted_Call) Return(_a0 []*Revision, _a1 error) *MockRepository_Unfingerprinted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Unfingerprinted_Call) RunAndReturn(run func(context.Context, int) ([]*Revision, error)) *MockRepository_Unfingerprinted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockRepository(t mockConstructorTestingTNewMockRepository) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,91 @@
+package duplicates_storage
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// Target specifies the type of post a fingerprint was computed for.
+type Target string
+
+const (
+	// TargetImproveRequest is the target for revisions of improvement requests.
+	TargetImproveRequest Target = "improve_request"
+	// TargetImproveSuggestion is the target for revisions of improvement suggestions.
+	TargetImproveSuggestion Target = "improve_suggestion"
+)
+
+// Fingerprint is the database model for the forum_fingerprints table.
+// It holds the MinHash signature of the content of a post revision, so it can be compared with the rest of the
+// corpus without reading the content again.
+type Fingerprint struct {
+	bun.BaseModel `bun:"table:forum_fingerprints,alias:fingerprint"`
+
+	// RevisionID is the ID of the fingerprinted revision.
+	RevisionID uuid.UUID `json:"revision_id" bun:"revision_id,pk,type:uuid"`
+	// PostID is the ID of the post the revision belongs to: the source of an improvement request, or the ID of an
+	// improvement suggestion.
+	PostID uuid.UUID `json:"post_id" bun:"post_id,type:uuid"`
+	// ThreadID is the source of the improvement request the post belongs to. Posts of a same thread are expected
+	// to share most of their content, so they are never compared with each other.
+	ThreadID uuid.UUID `json:"thread_id" bun:"thread_id,type:uuid"`
+	// UserID is the ID of the author of the revision.
+	UserID uuid.UUID `json:"user_id" bun:"user_id,type:uuid"`
+	// Target is the type of the post.
+	Target Target `json:"target" bun:"target"`
+	// CreatedAt is the creation time of the revision.
+	CreatedAt time.Time `json:"created_at" bun:"created_at,notnull"`
+
+	// Signature is the MinHash signature of the revision content.
+	Signature []int64 `json:"signature" bun:"signature,type:bigint[],array"`
+	// Bands are the hashes of the signature bands, used to look for similar revisions.
+	Bands []int64 `json:"bands" bun:"bands,type:bigint[],array"`
+}
+
+// Flag is the database model for the forum_duplicate_flags table.
+// A flag is raised when a revision looks like a copy of an older revision from another user. It remains pending
+// until a moderator reviews it.
+type Flag struct {
+	bun.BaseModel `bun:"table:forum_duplicate_flags,alias:flag"`
+
+	// RevisionID is the ID of the suspicious revision.
+	RevisionID uuid.UUID `json:"revision_id" bun:"revision_id,pk,type:uuid"`
+	// OriginalRevisionID is the ID of the older revision it looks like.
+	OriginalRevisionID uuid.UUID `json:"original_revision_id" bun:"original_revision_id,pk,type:uuid"`
+	// CreatedAt stores the time at which the flag was raised.
+	CreatedAt time.Time `json:"created_at" bun:"created_at,notnull"`
+	// Similarity is the estimated similarity of both revisions, between 0 and 1.
+	Similarity float64 `json:"similarity" bun:"similarity"`
+
+	// ReviewedAt stores the time at which a moderator reviewed the flag. It is nil while the flag is pending.
+	ReviewedAt *time.Time `json:"reviewed_at" bun:"reviewed_at"`
+	// ReviewedBy is the ID of the moderator who reviewed the flag.
+	ReviewedBy *uuid.UUID `json:"reviewed_by" bun:"reviewed_by,type:uuid"`
+}
+
+// FlagPreview is a Flag, along with the information required to locate both revisions.
+type FlagPreview struct {
+	Flag `bun:",extend"`
+
+	PostID   uuid.UUID `json:"post_id" bun:"post_id,type:uuid"`
+	ThreadID uuid.UUID `json:"thread_id" bun:"thread_id,type:uuid"`
+	UserID   uuid.UUID `json:"user_id" bun:"user_id,type:uuid"`
+	Target   Target    `json:"target" bun:"target"`
+
+	OriginalPostID   uuid.UUID `json:"original_post_id" bun:"original_post_id,type:uuid"`
+	OriginalThreadID uuid.UUID `json:"original_thread_id" bun:"original_thread_id,type:uuid"`
+	OriginalUserID   uuid.UUID `json:"original_user_id" bun:"original_user_id,type:uuid"`
+	OriginalTarget   Target    `json:"original_target" bun:"original_target"`
+}
+
+// Revision is a post revision, as required to compute its fingerprint.
+type Revision struct {
+	RevisionID uuid.UUID `json:"revision_id" bun:"revision_id,type:uuid"`
+	PostID     uuid.UUID `json:"post_id" bun:"post_id,type:uuid"`
+	ThreadID   uuid.UUID `json:"thread_id" bun:"thread_id,type:uuid"`
+	UserID     uuid.UUID `json:"user_id" bun:"user_id,type:uuid"`
+	Target     Target    `json:"target" bun:"target"`
+	CreatedAt  time.Time `json:"created_at" bun:"created_at"`
+	Content    string    `json:"content" bun:"content"`
+}
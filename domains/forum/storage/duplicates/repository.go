@@ -0,0 +1,166 @@
+package duplicates_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"time"
+)
+
+// Repository of the current layer. You can instantiate a new one with NewRepository.
+type Repository interface {
+	// Register saves the fingerprint of a revision. Registering a revision again replaces its fingerprint.
+	Register(ctx context.Context, fingerprint *Fingerprint) error
+	// Candidates returns the fingerprints that share at least one band with the given ones, oldest first. Only
+	// revisions created before the given time are returned, and revisions from the given user or thread are
+	// ignored.
+	Candidates(ctx context.Context, bands []int64, userID, threadID uuid.UUID, before time.Time, limit int) ([]*Fingerprint, error)
+	// Flag raises flags for moderation. Flags that were already raised for the same pair of revisions are kept
+	// untouched.
+	Flag(ctx context.Context, flags []*Flag) error
+	// ListFlags returns either the pending or the reviewed flags, most recent first. Results must be paginated
+	// using the limit and offset parameters.
+	// It also returns the total number of available results, to help with pagination.
+	ListFlags(ctx context.Context, reviewed bool, limit, offset int) ([]*FlagPreview, int64, error)
+	// Review marks a flag as reviewed by the given moderator.
+	Review(ctx context.Context, revisionID, originalRevisionID, reviewerID uuid.UUID, now time.Time) (*Flag, error)
+	// Unfingerprinted returns, at most, limit revisions that have no fingerprint yet, oldest first.
+	Unfingerprinted(ctx context.Context, limit int) ([]*Revision, error)
+}
+
+// NewRepository returns a new Repository instance.
+// To use a mocked one, call NewMockRepository.
+func NewRepository(db bun.IDB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+type repositoryImpl struct {
+	db bun.IDB
+}
+
+func (repository *repositoryImpl) Register(ctx context.Context, fingerprint *Fingerprint) error {
+	if _, err := repository.db.NewInsert().
+		Model(fingerprint).
+		On("CONFLICT (revision_id) DO UPDATE").
+		Set("signature = EXCLUDED.signature").
+		Set("bands = EXCLUDED.bands").
+		Exec(ctx); err != nil {
+		return validation.HandlePGError(err)
+	}
+
+	return nil
+}
+
+func (repository *repositoryImpl) Candidates(ctx context.Context, bands []int64, userID, threadID uuid.UUID, before time.Time, limit int) ([]*Fingerprint, error) {
+	results := make([]*Fingerprint, 0)
+
+	if err := repository.db.NewSelect().
+		Model(&results).
+		Where("bands && ?", pgdialect.Array(bands)).
+		Where("user_id <> ?", userID).
+		Where("thread_id <> ?", threadID).
+		Where("created_at < ?", before).
+		Order("created_at", "revision_id").
+		Limit(limit).
+		Scan(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return results, nil
+}
+
+func (repository *repositoryImpl) Flag(ctx context.Context, flags []*Flag) error {
+	if len(flags) == 0 {
+		return nil
+	}
+
+	if _, err := repository.db.NewInsert().Model(&flags).On("CONFLICT DO NOTHING").Exec(ctx); err != nil {
+		return validation.HandlePGError(err)
+	}
+
+	return nil
+}
+
+func (repository *repositoryImpl) ListFlags(ctx context.Context, reviewed bool, limit, offset int) ([]*FlagPreview, int64, error) {
+	results := make([]*FlagPreview, 0)
+
+	query := repository.db.NewSelect().
+		Model(&results).
+		ColumnExpr("flag.*").
+		ColumnExpr("post.post_id, post.thread_id, post.user_id, post.target").
+		ColumnExpr("original.post_id AS original_post_id, original.thread_id AS original_thread_id").
+		ColumnExpr("original.user_id AS original_user_id, original.target AS original_target").
+		Join("JOIN forum_fingerprints AS post ON post.revision_id = flag.revision_id").
+		Join("JOIN forum_fingerprints AS original ON original.revision_id = flag.original_revision_id").
+		OrderExpr("flag.created_at DESC, flag.revision_id, flag.original_revision_id").
+		Limit(limit).
+		Offset(offset)
+
+	if reviewed {
+		query = query.Where("flag.reviewed_at IS NOT NULL")
+	} else {
+		query = query.Where("flag.reviewed_at IS NULL")
+	}
+
+	count, err := query.ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, validation.HandlePGError(err)
+	}
+
+	return results, int64(count), nil
+}
+
+func (repository *repositoryImpl) Review(ctx context.Context, revisionID, originalRevisionID, reviewerID uuid.UUID, now time.Time) (*Flag, error) {
+	model := &Flag{
+		RevisionID:         revisionID,
+		OriginalRevisionID: originalRevisionID,
+		ReviewedAt:         &now,
+		ReviewedBy:         &reviewerID,
+	}
+
+	res, err := repository.db.NewUpdate().
+		Model(model).
+		Column("reviewed_at", "reviewed_by").
+		WherePK().
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	if err := validation.ForceRowsUpdate(res); err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
+
+func (repository *repositoryImpl) Unfingerprinted(ctx context.Context, limit int) ([]*Revision, error) {
+	requests := repository.db.NewSelect().
+		TableExpr("improve_requests AS revision").
+		ColumnExpr("revision.id AS revision_id, revision.source AS post_id, revision.source AS thread_id").
+		ColumnExpr("revision.user_id, ?::text AS target", TargetImproveRequest).
+		ColumnExpr("revision.created_at, revision.content")
+
+	suggestions := repository.db.NewSelect().
+		TableExpr("improve_suggestion_revisions AS revision").
+		Join("JOIN improve_suggestions AS suggestion ON suggestion.id = revision.suggestion_id").
+		ColumnExpr("revision.id AS revision_id, suggestion.id AS post_id, suggestion.source_id AS thread_id").
+		ColumnExpr("suggestion.user_id, ?::text AS target", TargetImproveSuggestion).
+		ColumnExpr("revision.created_at, revision.content")
+
+	results := make([]*Revision, 0)
+	if err := repository.db.NewSelect().
+		TableExpr("(?) AS revisions", requests.UnionAll(suggestions)).
+		ColumnExpr("revisions.*").
+		Where("NOT EXISTS (SELECT 1 FROM forum_fingerprints AS fingerprint WHERE fingerprint.revision_id = revisions.revision_id)").
+		OrderExpr("revisions.created_at, revisions.revision_id").
+		Limit(limit).
+		Scan(ctx, &results); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,546 @@
+package duplicates_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime   = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	updateTime = time.Date(2020, time.May, 4, 9, 0, 0, 0, time.UTC)
+	reviewerID = test_utils.NumberUUID(200)
+)
+
+var Fixtures = []interface{}{
+	&Fingerprint{
+		RevisionID: test_utils.NumberUUID(1000),
+		PostID:     test_utils.NumberUUID(1000),
+		ThreadID:   test_utils.NumberUUID(1000),
+		UserID:     test_utils.NumberUUID(100),
+		Target:     TargetImproveRequest,
+		CreatedAt:  baseTime,
+		Signature:  []int64{1, 2, 3, 4},
+		Bands:      []int64{10, 20},
+	},
+	// Same thread, from another user.
+	&Fingerprint{
+		RevisionID: test_utils.NumberUUID(2000),
+		PostID:     test_utils.NumberUUID(2000),
+		ThreadID:   test_utils.NumberUUID(1000),
+		UserID:     test_utils.NumberUUID(101),
+		Target:     TargetImproveSuggestion,
+		CreatedAt:  baseTime.Add(time.Minute),
+		Signature:  []int64{1, 2, 3, 5},
+		Bands:      []int64{10, 21},
+	},
+	&Fingerprint{
+		RevisionID: test_utils.NumberUUID(1001),
+		PostID:     test_utils.NumberUUID(1001),
+		ThreadID:   test_utils.NumberUUID(1001),
+		UserID:     test_utils.NumberUUID(102),
+		Target:     TargetImproveRequest,
+		CreatedAt:  baseTime.Add(2 * time.Minute),
+		Signature:  []int64{1, 2, 6, 7},
+		Bands:      []int64{10, 22},
+	},
+	&Fingerprint{
+		RevisionID: test_utils.NumberUUID(1002),
+		PostID:     test_utils.NumberUUID(1002),
+		ThreadID:   test_utils.NumberUUID(1002),
+		UserID:     test_utils.NumberUUID(103),
+		Target:     TargetImproveRequest,
+		CreatedAt:  baseTime.Add(3 * time.Minute),
+		Signature:  []int64{8, 9, 6, 7},
+		Bands:      []int64{11, 22},
+	},
+	&Flag{
+		RevisionID:         test_utils.NumberUUID(1001),
+		OriginalRevisionID: test_utils.NumberUUID(1000),
+		CreatedAt:          baseTime.Add(2 * time.Minute),
+		Similarity:         0.5,
+	},
+	&Flag{
+		RevisionID:         test_utils.NumberUUID(1002),
+		OriginalRevisionID: test_utils.NumberUUID(1001),
+		CreatedAt:          baseTime.Add(3 * time.Minute),
+		Similarity:         0.5,
+		ReviewedAt:         &updateTime,
+		ReviewedBy:         &reviewerID,
+	},
+}
+
+func getRevisionIDs(fingerprints []*Fingerprint) []uuid.UUID {
+	ids := make([]uuid.UUID, len(fingerprints))
+	for i, fingerprint := range fingerprints {
+		ids[i] = fingerprint.RevisionID
+	}
+	return ids
+}
+
+func TestDuplicatesRepository_Register(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		fingerprint *Fingerprint
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+			fingerprint: &Fingerprint{
+				RevisionID: test_utils.NumberUUID(3000),
+				PostID:     test_utils.NumberUUID(3000),
+				ThreadID:   test_utils.NumberUUID(3000),
+				UserID:     test_utils.NumberUUID(100),
+				Target:     TargetImproveRequest,
+				CreatedAt:  updateTime,
+				Signature:  []int64{1, 2, 3, 4},
+				Bands:      []int64{10, 20},
+			},
+		},
+		{
+			name: "Success/Replace",
+			fingerprint: &Fingerprint{
+				RevisionID: test_utils.NumberUUID(1000),
+				PostID:     test_utils.NumberUUID(1000),
+				ThreadID:   test_utils.NumberUUID(1000),
+				UserID:     test_utils.NumberUUID(100),
+				Target:     TargetImproveRequest,
+				CreatedAt:  baseTime,
+				Signature:  []int64{4, 3, 2, 1},
+				Bands:      []int64{30, 40},
+			},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				test_utils.RequireError(st, d.expectErr, repository.Register(ctx, d.fingerprint))
+
+				if d.expectErr == nil {
+					stored := &Fingerprint{RevisionID: d.fingerprint.RevisionID}
+					require.NoError(st, stx.NewSelect().Model(stored).WherePK().Scan(ctx))
+					require.Equal(st, d.fingerprint.Signature, stored.Signature)
+					require.Equal(st, d.fingerprint.Bands, stored.Bands)
+				}
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestDuplicatesRepository_Candidates(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		bands    []int64
+		userID   uuid.UUID
+		threadID uuid.UUID
+		before   time.Time
+		limit    int
+
+		expect    []uuid.UUID
+		expectErr error
+	}{
+		{
+			name:     "Success",
+			bands:    []int64{10, 50},
+			userID:   test_utils.NumberUUID(104),
+			threadID: test_utils.NumberUUID(3000),
+			before:   updateTime,
+			limit:    10,
+			expect: []uuid.UUID{
+				test_utils.NumberUUID(1000),
+				test_utils.NumberUUID(2000),
+				test_utils.NumberUUID(1001),
+			},
+		},
+		{
+			name:     "Success/IgnoreUser",
+			bands:    []int64{10, 50},
+			userID:   test_utils.NumberUUID(100),
+			threadID: test_utils.NumberUUID(3000),
+			before:   updateTime,
+			limit:    10,
+			expect: []uuid.UUID{
+				test_utils.NumberUUID(2000),
+				test_utils.NumberUUID(1001),
+			},
+		},
+		{
+			name:     "Success/IgnoreThread",
+			bands:    []int64{10, 50},
+			userID:   test_utils.NumberUUID(104),
+			threadID: test_utils.NumberUUID(1000),
+			before:   updateTime,
+			limit:    10,
+			expect:   []uuid.UUID{test_utils.NumberUUID(1001)},
+		},
+		{
+			name:     "Success/OnlyOlderRevisions",
+			bands:    []int64{10, 50},
+			userID:   test_utils.NumberUUID(104),
+			threadID: test_utils.NumberUUID(3000),
+			before:   baseTime.Add(2 * time.Minute),
+			limit:    10,
+			expect: []uuid.UUID{
+				test_utils.NumberUUID(1000),
+				test_utils.NumberUUID(2000),
+			},
+		},
+		{
+			name:     "Success/Limit",
+			bands:    []int64{10, 50},
+			userID:   test_utils.NumberUUID(104),
+			threadID: test_utils.NumberUUID(3000),
+			before:   updateTime,
+			limit:    1,
+			expect:   []uuid.UUID{test_utils.NumberUUID(1000)},
+		},
+		{
+			name:     "Success/NoMatch",
+			bands:    []int64{50, 60},
+			userID:   test_utils.NumberUUID(104),
+			threadID: test_utils.NumberUUID(3000),
+			before:   updateTime,
+			limit:    10,
+			expect:   []uuid.UUID{},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.Candidates(ctx, d.bands, d.userID, d.threadID, d.before, d.limit)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, getRevisionIDs(res))
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestDuplicatesRepository_Flag(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		flags []*Flag
+
+		expectPending int64
+		expectErr     error
+	}{
+		{
+			name: "Success",
+			flags: []*Flag{
+				{
+					RevisionID:         test_utils.NumberUUID(1002),
+					OriginalRevisionID: test_utils.NumberUUID(1000),
+					CreatedAt:          updateTime,
+					Similarity:         0.6,
+				},
+			},
+			expectPending: 2,
+		},
+		{
+			name: "Success/AlreadyFlagged",
+			flags: []*Flag{
+				{
+					RevisionID:         test_utils.NumberUUID(1002),
+					OriginalRevisionID: test_utils.NumberUUID(1001),
+					CreatedAt:          updateTime,
+					Similarity:         0.6,
+				},
+			},
+			expectPending: 1,
+		},
+		{
+			name:          "Success/NoFlags",
+			expectPending: 1,
+		},
+		{
+			name: "Error/UnknownRevision",
+			flags: []*Flag{
+				{
+					RevisionID:         test_utils.NumberUUID(10),
+					OriginalRevisionID: test_utils.NumberUUID(1000),
+					CreatedAt:          updateTime,
+					Similarity:         0.6,
+				},
+			},
+			expectErr: validation.ErrConstraintViolation,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				test_utils.RequireError(st, d.expectErr, repository.Flag(ctx, d.flags))
+
+				if d.expectErr == nil {
+					_, total, err := repository.ListFlags(ctx, false, 10, 0)
+					require.NoError(st, err)
+					require.Equal(st, d.expectPending, total)
+				}
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestDuplicatesRepository_ListFlags(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		reviewed bool
+		limit    int
+		offset   int
+
+		expect      []*FlagPreview
+		expectCount int64
+		expectErr   error
+	}{
+		{
+			name:  "Success/Pending",
+			limit: 10,
+			expect: []*FlagPreview{
+				{
+					Flag: Flag{
+						RevisionID:         test_utils.NumberUUID(1001),
+						OriginalRevisionID: test_utils.NumberUUID(1000),
+						CreatedAt:          baseTime.Add(2 * time.Minute),
+						Similarity:         0.5,
+					},
+					PostID:           test_utils.NumberUUID(1001),
+					ThreadID:         test_utils.NumberUUID(1001),
+					UserID:           test_utils.NumberUUID(102),
+					Target:           TargetImproveRequest,
+					OriginalPostID:   test_utils.NumberUUID(1000),
+					OriginalThreadID: test_utils.NumberUUID(1000),
+					OriginalUserID:   test_utils.NumberUUID(100),
+					OriginalTarget:   TargetImproveRequest,
+				},
+			},
+			expectCount: 1,
+		},
+		{
+			name:     "Success/Reviewed",
+			reviewed: true,
+			limit:    10,
+			expect: []*FlagPreview{
+				{
+					Flag: Flag{
+						RevisionID:         test_utils.NumberUUID(1002),
+						OriginalRevisionID: test_utils.NumberUUID(1001),
+						CreatedAt:          baseTime.Add(3 * time.Minute),
+						Similarity:         0.5,
+						ReviewedAt:         &updateTime,
+						ReviewedBy:         &reviewerID,
+					},
+					PostID:           test_utils.NumberUUID(1002),
+					ThreadID:         test_utils.NumberUUID(1002),
+					UserID:           test_utils.NumberUUID(103),
+					Target:           TargetImproveRequest,
+					OriginalPostID:   test_utils.NumberUUID(1001),
+					OriginalThreadID: test_utils.NumberUUID(1001),
+					OriginalUserID:   test_utils.NumberUUID(102),
+					OriginalTarget:   TargetImproveRequest,
+				},
+			},
+			expectCount: 1,
+		},
+		{
+			name:        "Success/Paginate",
+			limit:       10,
+			offset:      1,
+			expect:      []*FlagPreview{},
+			expectCount: 1,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, count, err := repository.ListFlags(ctx, d.reviewed, d.limit, d.offset)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+				require.Equal(st, d.expectCount, count)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestDuplicatesRepository_Review(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		revisionID         uuid.UUID
+		originalRevisionID uuid.UUID
+		reviewerID         uuid.UUID
+		now                time.Time
+
+		expect    *Flag
+		expectErr error
+	}{
+		{
+			name:               "Success",
+			revisionID:         test_utils.NumberUUID(1001),
+			originalRevisionID: test_utils.NumberUUID(1000),
+			reviewerID:         test_utils.NumberUUID(200),
+			now:                updateTime,
+			expect: &Flag{
+				RevisionID:         test_utils.NumberUUID(1001),
+				OriginalRevisionID: test_utils.NumberUUID(1000),
+				CreatedAt:          baseTime.Add(2 * time.Minute),
+				Similarity:         0.5,
+				ReviewedAt:         &updateTime,
+				ReviewedBy:         &reviewerID,
+			},
+		},
+		{
+			name:               "Error/NotFound",
+			revisionID:         test_utils.NumberUUID(1000),
+			originalRevisionID: test_utils.NumberUUID(1001),
+			reviewerID:         test_utils.NumberUUID(200),
+			now:                updateTime,
+			expectErr:          validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				res, err := repository.Review(ctx, d.revisionID, d.originalRevisionID, d.reviewerID, d.now)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestDuplicatesRepository_Unfingerprinted(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := test_utils.Concat(Fixtures, []interface{}{
+		// Already fingerprinted.
+		&improve_request_storage.Model{
+			ID:        test_utils.NumberUUID(1000),
+			CreatedAt: baseTime,
+			Source:    test_utils.NumberUUID(1000),
+			UserID:    test_utils.NumberUUID(100),
+			Title:     "Test",
+			Content:   "Dummy content.",
+		},
+		&improve_request_storage.Model{
+			ID:        test_utils.NumberUUID(1003),
+			CreatedAt: baseTime.Add(5 * time.Minute),
+			Source:    test_utils.NumberUUID(1000),
+			UserID:    test_utils.NumberUUID(100),
+			Title:     "Test",
+			Content:   "Dummy content updated.",
+		},
+		&improve_suggestion_storage.Model{
+			ID:         test_utils.NumberUUID(2001),
+			CreatedAt:  baseTime,
+			SourceID:   test_utils.NumberUUID(1000),
+			UserID:     test_utils.NumberUUID(101),
+			RevisionID: test_utils.NumberUUID(2101),
+			Core: improve_suggestion_storage.Core{
+				RequestID: test_utils.NumberUUID(1000),
+				Title:     "Test",
+				Content:   "Smart content.",
+			},
+		},
+		&improve_suggestion_storage.Revision{
+			ID:           test_utils.NumberUUID(2101),
+			SuggestionID: test_utils.NumberUUID(2001),
+			CreatedAt:    baseTime.Add(4 * time.Minute),
+			Core: improve_suggestion_storage.Core{
+				RequestID: test_utils.NumberUUID(1000),
+				Title:     "Test",
+				Content:   "Smart content.",
+			},
+		},
+	})
+
+	err := test_utils.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		res, err := repository.Unfingerprinted(ctx, 10)
+		require.NoError(t, err)
+		require.Equal(t, []*Revision{
+			{
+				RevisionID: test_utils.NumberUUID(2101),
+				PostID:     test_utils.NumberUUID(2001),
+				ThreadID:   test_utils.NumberUUID(1000),
+				UserID:     test_utils.NumberUUID(101),
+				Target:     TargetImproveSuggestion,
+				CreatedAt:  baseTime.Add(4 * time.Minute),
+				Content:    "Smart content.",
+			},
+			{
+				RevisionID: test_utils.NumberUUID(1003),
+				PostID:     test_utils.NumberUUID(1000),
+				ThreadID:   test_utils.NumberUUID(1000),
+				UserID:     test_utils.NumberUUID(100),
+				Target:     TargetImproveRequest,
+				CreatedAt:  baseTime.Add(5 * time.Minute),
+				Content:    "Dummy content updated.",
+			},
+		}, res)
+
+		res, err = repository.Unfingerprinted(ctx, 1)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.Equal(t, test_utils.NumberUUID(2101), res[0].RevisionID)
+	})
+	require.NoError(t, err)
+}
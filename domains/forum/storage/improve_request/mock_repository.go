@@ -24,25 +24,25 @@ func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
 	return &MockRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: ctx, userID, title, content, id, now
-func (_m *MockRepository) Create(ctx context.Context, userID uuid.UUID, title string, content string, id uuid.UUID, now time.Time) (*Model, error) {
-	ret := _m.Called(ctx, userID, title, content, id, now)
+// Create provides a mock function with given fields: ctx, userID, title, content, language, tags, id, now
+func (_m *MockRepository) Create(ctx context.Context, userID uuid.UUID, title string, content string, language string, tags []uuid.UUID, id uuid.UUID, now time.Time) (*Model, error) {
+	ret := _m.Called(ctx, userID, title, content, language, tags, id, now)
 
 	var r0 *Model
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, uuid.UUID, time.Time) (*Model, error)); ok {
-		return rf(ctx, userID, title, content, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, string, []uuid.UUID, uuid.UUID, time.Time) (*Model, error)); ok {
+		return rf(ctx, userID, title, content, language, tags, id, now)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, uuid.UUID, time.Time) *Model); ok {
-		r0 = rf(ctx, userID, title, content, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, string, []uuid.UUID, uuid.UUID, time.Time) *Model); ok {
+		r0 = rf(ctx, userID, title, content, language, tags, id, now)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*Model)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, uuid.UUID, time.Time) error); ok {
-		r1 = rf(ctx, userID, title, content, id, now)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, string, []uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, userID, title, content, language, tags, id, now)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -60,15 +60,17 @@ type MockRepository_Create_Call struct {
 //   - userID uuid.UUID
 //   - title string
 //   - content string
+//   - language string
+//   - tags []uuid.UUID
 //   - id uuid.UUID
 //   - now time.Time
-func (_e *MockRepository_Expecter) Create(ctx interface{}, userID interface{}, title interface{}, content interface{}, id interface{}, now interface{}) *MockRepository_Create_Call {
-	return &MockRepository_Create_Call{Call: _e.mock.On("Create", ctx, userID, title, content, id, now)}
+func (_e *MockRepository_Expecter) Create(ctx interface{}, userID interface{}, title interface{}, content interface{}, language interface{}, tags interface{}, id interface{}, now interface{}) *MockRepository_Create_Call {
+	return &MockRepository_Create_Call{Call: _e.mock.On("Create", ctx, userID, title, content, language, tags, id, now)}
 }
 
-func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, userID uuid.UUID, title string, content string, id uuid.UUID, now time.Time)) *MockRepository_Create_Call {
+func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, userID uuid.UUID, title string, content string, language string, tags []uuid.UUID, id uuid.UUID, now time.Time)) *MockRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].(uuid.UUID), args[5].(time.Time))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].(string), args[5].([]uuid.UUID), args[6].(uuid.UUID), args[7].(time.Time))
 	})
 	return _c
 }
@@ -78,30 +80,30 @@ func (_c *MockRepository_Create_Call) Return(_a0 *Model, _a1 error) *MockReposit
 	return _c
 }
 
-func (_c *MockRepository_Create_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, uuid.UUID, time.Time) (*Model, error)) *MockRepository_Create_Call {
+func (_c *MockRepository_Create_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, string, []uuid.UUID, uuid.UUID, time.Time) (*Model, error)) *MockRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateRevision provides a mock function with given fields: ctx, userID, sourceID, title, content, id, now
-func (_m *MockRepository) CreateRevision(ctx context.Context, userID uuid.UUID, sourceID uuid.UUID, title string, content string, id uuid.UUID, now time.Time) (*Model, error) {
-	ret := _m.Called(ctx, userID, sourceID, title, content, id, now)
+// CreateRevision provides a mock function with given fields: ctx, userID, sourceID, title, content, language, tags, id, now
+func (_m *MockRepository) CreateRevision(ctx context.Context, userID uuid.UUID, sourceID uuid.UUID, title string, content string, language string, tags []uuid.UUID, id uuid.UUID, now time.Time) (*Model, error) {
+	ret := _m.Called(ctx, userID, sourceID, title, content, language, tags, id, now)
 
 	var r0 *Model
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, string, uuid.UUID, time.Time) (*Model, error)); ok {
-		return rf(ctx, userID, sourceID, title, content, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, string, string, []uuid.UUID, uuid.UUID, time.Time) (*Model, error)); ok {
+		return rf(ctx, userID, sourceID, title, content, language, tags, id, now)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, string, uuid.UUID, time.Time) *Model); ok {
-		r0 = rf(ctx, userID, sourceID, title, content, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, string, string, []uuid.UUID, uuid.UUID, time.Time) *Model); ok {
+		r0 = rf(ctx, userID, sourceID, title, content, language, tags, id, now)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*Model)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string, string, uuid.UUID, time.Time) error); ok {
-		r1 = rf(ctx, userID, sourceID, title, content, id, now)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string, string, string, []uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, userID, sourceID, title, content, language, tags, id, now)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -120,15 +122,17 @@ type MockRepository_CreateRevision_Call struct {
 //   - sourceID uuid.UUID
 //   - title string
 //   - content string
+//   - language string
+//   - tags []uuid.UUID
 //   - id uuid.UUID
 //   - now time.Time
-func (_e *MockRepository_Expecter) CreateRevision(ctx interface{}, userID interface{}, sourceID interface{}, title interface{}, content interface{}, id interface{}, now interface{}) *MockRepository_CreateRevision_Call {
-	return &MockRepository_CreateRevision_Call{Call: _e.mock.On("CreateRevision", ctx, userID, sourceID, title, content, id, now)}
+func (_e *MockRepository_Expecter) CreateRevision(ctx interface{}, userID interface{}, sourceID interface{}, title interface{}, content interface{}, language interface{}, tags interface{}, id interface{}, now interface{}) *MockRepository_CreateRevision_Call {
+	return &MockRepository_CreateRevision_Call{Call: _e.mock.On("CreateRevision", ctx, userID, sourceID, title, content, language, tags, id, now)}
 }
 
-func (_c *MockRepository_CreateRevision_Call) Run(run func(ctx context.Context, userID uuid.UUID, sourceID uuid.UUID, title string, content string, id uuid.UUID, now time.Time)) *MockRepository_CreateRevision_Call {
+func (_c *MockRepository_CreateRevision_Call) Run(run func(ctx context.Context, userID uuid.UUID, sourceID uuid.UUID, title string, content string, language string, tags []uuid.UUID, id uuid.UUID, now time.Time)) *MockRepository_CreateRevision_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string), args[4].(string), args[5].(uuid.UUID), args[6].(time.Time))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string), args[4].(string), args[5].(string), args[6].([]uuid.UUID), args[7].(uuid.UUID), args[8].(time.Time))
 	})
 	return _c
 }
@@ -138,18 +142,18 @@ func (_c *MockRepository_CreateRevision_Call) Return(_a0 *Model, _a1 error) *Moc
 	return _c
 }
 
-func (_c *MockRepository_CreateRevision_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string, string, uuid.UUID, time.Time) (*Model, error)) *MockRepository_CreateRevision_Call {
+func (_c *MockRepository_CreateRevision_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string, string, string, []uuid.UUID, uuid.UUID, time.Time) (*Model, error)) *MockRepository_CreateRevision_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: ctx, requestID
-func (_m *MockRepository) Delete(ctx context.Context, requestID uuid.UUID) error {
-	ret := _m.Called(ctx, requestID)
+// Delete provides a mock function with given fields: ctx, requestID, now
+func (_m *MockRepository) Delete(ctx context.Context, requestID uuid.UUID, now time.Time) error {
+	ret := _m.Called(ctx, requestID, now)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, requestID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, requestID, now)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -165,13 +169,14 @@ type MockRepository_Delete_Call struct {
 // Delete is a helper method to define mock.On call
 //   - ctx context.Context
 //   - requestID uuid.UUID
-func (_e *MockRepository_Expecter) Delete(ctx interface{}, requestID interface{}) *MockRepository_Delete_Call {
-	return &MockRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, requestID)}
+//   - now time.Time
+func (_e *MockRepository_Expecter) Delete(ctx interface{}, requestID interface{}, now interface{}) *MockRepository_Delete_Call {
+	return &MockRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, requestID, now)}
 }
 
-func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, requestID uuid.UUID)) *MockRepository_Delete_Call {
+func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, requestID uuid.UUID, now time.Time)) *MockRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
 	})
 	return _c
 }
@@ -181,30 +186,86 @@ func (_c *MockRepository_Delete_Call) Return(_a0 error) *MockRepository_Delete_C
 	return _c
 }
 
-func (_c *MockRepository_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockRepository_Delete_Call {
+func (_c *MockRepository_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) error) *MockRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Facets provides a mock function with given fields: ctx, query, now
+func (_m *MockRepository) Facets(ctx context.Context, query SearchQuery, now time.Time) (*Facets, error) {
+	ret := _m.Called(ctx, query, now)
+
+	var r0 *Facets
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, SearchQuery, time.Time) (*Facets, error)); ok {
+		return rf(ctx, query, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, SearchQuery, time.Time) *Facets); ok {
+		r0 = rf(ctx, query, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Facets)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, SearchQuery, time.Time) error); ok {
+		r1 = rf(ctx, query, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Facets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Facets'
+type MockRepository_Facets_Call struct {
+	*mock.Call
+}
+
+// Facets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query SearchQuery
+//   - now time.Time
+func (_e *MockRepository_Expecter) Facets(ctx interface{}, query interface{}, now interface{}) *MockRepository_Facets_Call {
+	return &MockRepository_Facets_Call{Call: _e.mock.On("Facets", ctx, query, now)}
+}
+
+func (_c *MockRepository_Facets_Call) Run(run func(ctx context.Context, query SearchQuery, now time.Time)) *MockRepository_Facets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(SearchQuery), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Facets_Call) Return(_a0 *Facets, _a1 error) *MockRepository_Facets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Facets_Call) RunAndReturn(run func(context.Context, SearchQuery, time.Time) (*Facets, error)) *MockRepository_Facets_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPreviews provides a mock function with given fields: ctx, ids
-func (_m *MockRepository) GetPreviews(ctx context.Context, ids []uuid.UUID) ([]*Preview, error) {
-	ret := _m.Called(ctx, ids)
+// GetPreviews provides a mock function with given fields: ctx, ids, viewerID
+func (_m *MockRepository) GetPreviews(ctx context.Context, ids []uuid.UUID, viewerID *uuid.UUID) ([]*Preview, error) {
+	ret := _m.Called(ctx, ids, viewerID)
 
 	var r0 []*Preview
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]*Preview, error)); ok {
-		return rf(ctx, ids)
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, *uuid.UUID) ([]*Preview, error)); ok {
+		return rf(ctx, ids, viewerID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []*Preview); ok {
-		r0 = rf(ctx, ids)
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, *uuid.UUID) []*Preview); ok {
+		r0 = rf(ctx, ids, viewerID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*Preview)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
-		r1 = rf(ctx, ids)
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID, *uuid.UUID) error); ok {
+		r1 = rf(ctx, ids, viewerID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -220,13 +281,14 @@ type MockRepository_GetPreviews_Call struct {
 // GetPreviews is a helper method to define mock.On call
 //   - ctx context.Context
 //   - ids []uuid.UUID
-func (_e *MockRepository_Expecter) GetPreviews(ctx interface{}, ids interface{}) *MockRepository_GetPreviews_Call {
-	return &MockRepository_GetPreviews_Call{Call: _e.mock.On("GetPreviews", ctx, ids)}
+//   - viewerID *uuid.UUID
+func (_e *MockRepository_Expecter) GetPreviews(ctx interface{}, ids interface{}, viewerID interface{}) *MockRepository_GetPreviews_Call {
+	return &MockRepository_GetPreviews_Call{Call: _e.mock.On("GetPreviews", ctx, ids, viewerID)}
 }
 
-func (_c *MockRepository_GetPreviews_Call) Run(run func(ctx context.Context, ids []uuid.UUID)) *MockRepository_GetPreviews_Call {
+func (_c *MockRepository_GetPreviews_Call) Run(run func(ctx context.Context, ids []uuid.UUID, viewerID *uuid.UUID)) *MockRepository_GetPreviews_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]uuid.UUID))
+		run(args[0].(context.Context), args[1].([]uuid.UUID), args[2].(*uuid.UUID))
 	})
 	return _c
 }
@@ -236,7 +298,7 @@ func (_c *MockRepository_GetPreviews_Call) Return(_a0 []*Preview, _a1 error) *Mo
 	return _c
 }
 
-func (_c *MockRepository_GetPreviews_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]*Preview, error)) *MockRepository_GetPreviews_Call {
+func (_c *MockRepository_GetPreviews_Call) RunAndReturn(run func(context.Context, []uuid.UUID, *uuid.UUID) ([]*Preview, error)) *MockRepository_GetPreviews_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -296,6 +358,59 @@ func (_c *MockRepository_IsCreator_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// Purge provides a mock function with given fields: ctx, deletedBefore
+func (_m *MockRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	ret := _m.Called(ctx, deletedBefore)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, deletedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, deletedBefore)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, deletedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deletedBefore time.Time
+func (_e *MockRepository_Expecter) Purge(ctx interface{}, deletedBefore interface{}) *MockRepository_Purge_Call {
+	return &MockRepository_Purge_Call{Call: _e.mock.On("Purge", ctx, deletedBefore)}
+}
+
+func (_c *MockRepository_Purge_Call) Run(run func(ctx context.Context, deletedBefore time.Time)) *MockRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Purge_Call) Return(_a0 int64, _a1 error) *MockRepository_Purge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Purge_Call) RunAndReturn(run func(context.Context, time.Time) (int64, error)) *MockRepository_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Read provides a mock function with given fields: ctx, id
 func (_m *MockRepository) Read(ctx context.Context, id uuid.UUID) (*Model, error) {
 	ret := _m.Called(ctx, id)
@@ -351,6 +466,61 @@ func (_c *MockRepository_Read_Call) RunAndReturn(run func(context.Context, uuid.
 	return _c
 }
 
+// ReadDeleted provides a mock function with given fields: ctx, id
+func (_m *MockRepository) ReadDeleted(ctx context.Context, id uuid.UUID) (*Model, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*Model, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *Model); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ReadDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDeleted'
+type MockRepository_ReadDeleted_Call struct {
+	*mock.Call
+}
+
+// ReadDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockRepository_Expecter) ReadDeleted(ctx interface{}, id interface{}) *MockRepository_ReadDeleted_Call {
+	return &MockRepository_ReadDeleted_Call{Call: _e.mock.On("ReadDeleted", ctx, id)}
+}
+
+func (_c *MockRepository_ReadDeleted_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockRepository_ReadDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ReadDeleted_Call) Return(_a0 *Model, _a1 error) *MockRepository_ReadDeleted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ReadDeleted_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*Model, error)) *MockRepository_ReadDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReadRevisions provides a mock function with given fields: ctx, id
 func (_m *MockRepository) ReadRevisions(ctx context.Context, id uuid.UUID) ([]*Model, error) {
 	ret := _m.Called(ctx, id)
@@ -406,6 +576,149 @@ func (_c *MockRepository_ReadRevisions_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// RefreshRankings provides a mock function with given fields: ctx
+func (_m *MockRepository) RefreshRankings(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_RefreshRankings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshRankings'
+type MockRepository_RefreshRankings_Call struct {
+	*mock.Call
+}
+
+// RefreshRankings is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRepository_Expecter) RefreshRankings(ctx interface{}) *MockRepository_RefreshRankings_Call {
+	return &MockRepository_RefreshRankings_Call{Call: _e.mock.On("RefreshRankings", ctx)}
+}
+
+func (_c *MockRepository_RefreshRankings_Call) Run(run func(ctx context.Context)) *MockRepository_RefreshRankings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockRepository_RefreshRankings_Call) Return(_a0 error) *MockRepository_RefreshRankings_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_RefreshRankings_Call) RunAndReturn(run func(context.Context) error) *MockRepository_RefreshRankings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Related provides a mock function with given fields: ctx, id, limit, now
+func (_m *MockRepository) Related(ctx context.Context, id uuid.UUID, limit int, now time.Time) ([]*Preview, error) {
+	ret := _m.Called(ctx, id, limit, now)
+
+	var r0 []*Preview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, time.Time) ([]*Preview, error)); ok {
+		return rf(ctx, id, limit, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, time.Time) []*Preview); ok {
+		r0 = rf(ctx, id, limit, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Preview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, time.Time) error); ok {
+		r1 = rf(ctx, id, limit, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Related_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Related'
+type MockRepository_Related_Call struct {
+	*mock.Call
+}
+
+// Related is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - limit int
+//   - now time.Time
+func (_e *MockRepository_Expecter) Related(ctx interface{}, id interface{}, limit interface{}, now interface{}) *MockRepository_Related_Call {
+	return &MockRepository_Related_Call{Call: _e.mock.On("Related", ctx, id, limit, now)}
+}
+
+func (_c *MockRepository_Related_Call) Run(run func(ctx context.Context, id uuid.UUID, limit int, now time.Time)) *MockRepository_Related_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Related_Call) Return(_a0 []*Preview, _a1 error) *MockRepository_Related_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Related_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, time.Time) ([]*Preview, error)) *MockRepository_Related_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, requestID, deletedAfter
+func (_m *MockRepository) Restore(ctx context.Context, requestID uuid.UUID, deletedAfter time.Time) error {
+	ret := _m.Called(ctx, requestID, deletedAfter)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, requestID, deletedAfter)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestID uuid.UUID
+//   - deletedAfter time.Time
+func (_e *MockRepository_Expecter) Restore(ctx interface{}, requestID interface{}, deletedAfter interface{}) *MockRepository_Restore_Call {
+	return &MockRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, requestID, deletedAfter)}
+}
+
+func (_c *MockRepository_Restore_Call) Run(run func(ctx context.Context, requestID uuid.UUID, deletedAfter time.Time)) *MockRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Restore_Call) Return(_a0 error) *MockRepository_Restore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Restore_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) error) *MockRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Search provides a mock function with given fields: ctx, query, limit, offset
 func (_m *MockRepository) Search(ctx context.Context, query SearchQuery, limit int, offset int) ([]*Preview, int64, error) {
 	ret := _m.Called(ctx, query, limit, offset)
@@ -470,6 +783,126 @@ func (_c *MockRepository_Search_Call) RunAndReturn(run func(context.Context, Sea
 	return _c
 }
 
+// SearchAfter provides a mock function with given fields: ctx, query, cursor, limit
+func (_m *MockRepository) SearchAfter(ctx context.Context, query SearchQuery, cursor string, limit int) ([]*Preview, string, error) {
+	ret := _m.Called(ctx, query, cursor, limit)
+
+	var r0 []*Preview
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, SearchQuery, string, int) ([]*Preview, string, error)); ok {
+		return rf(ctx, query, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, SearchQuery, string, int) []*Preview); ok {
+		r0 = rf(ctx, query, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Preview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, SearchQuery, string, int) string); ok {
+		r1 = rf(ctx, query, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, SearchQuery, string, int) error); ok {
+		r2 = rf(ctx, query, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRepository_SearchAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAfter'
+type MockRepository_SearchAfter_Call struct {
+	*mock.Call
+}
+
+// SearchAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query SearchQuery
+//   - cursor string
+//   - limit int
+func (_e *MockRepository_Expecter) SearchAfter(ctx interface{}, query interface{}, cursor interface{}, limit interface{}) *MockRepository_SearchAfter_Call {
+	return &MockRepository_SearchAfter_Call{Call: _e.mock.On("SearchAfter", ctx, query, cursor, limit)}
+}
+
+func (_c *MockRepository_SearchAfter_Call) Run(run func(ctx context.Context, query SearchQuery, cursor string, limit int)) *MockRepository_SearchAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(SearchQuery), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_SearchAfter_Call) Return(_a0 []*Preview, _a1 string, _a2 error) *MockRepository_SearchAfter_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRepository_SearchAfter_Call) RunAndReturn(run func(context.Context, SearchQuery, string, int) ([]*Preview, string, error)) *MockRepository_SearchAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Suggest provides a mock function with given fields: ctx, query, limit
+func (_m *MockRepository) Suggest(ctx context.Context, query string, limit int) ([]string, error) {
+	ret := _m.Called(ctx, query, limit)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]string, error)); ok {
+		return rf(ctx, query, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []string); ok {
+		r0 = rf(ctx, query, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, query, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Suggest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Suggest'
+type MockRepository_Suggest_Call struct {
+	*mock.Call
+}
+
+// Suggest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - limit int
+func (_e *MockRepository_Expecter) Suggest(ctx interface{}, query interface{}, limit interface{}) *MockRepository_Suggest_Call {
+	return &MockRepository_Suggest_Call{Call: _e.mock.On("Suggest", ctx, query, limit)}
+}
+
+func (_c *MockRepository_Suggest_Call) Run(run func(ctx context.Context, query string, limit int)) *MockRepository_Suggest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Suggest_Call) Return(_a0 []string, _a1 error) *MockRepository_Suggest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Suggest_Call) RunAndReturn(run func(context.Context, string, int) ([]string, error)) *MockRepository_Suggest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 type mockConstructorTestingTNewMockRepository interface {
 	mock.TestingT
 	Cleanup(func())
@@ -16,8 +16,10 @@ var (
 		"user_id",
 		"title",
 		"content",
+		"language",
 		"up_votes",
 		"down_votes",
+		"deleted_at",
 	}
 	exposedColumnsSTR = strings.Join(exposedColumns, ",")
 )
@@ -45,6 +47,9 @@ type Model struct {
 	Title string `json:"title" bun:"title"`
 	// Content is a novel scene that the user wants to improve.
 	Content string `json:"content" bun:"content"`
+	// Language is the text search configuration used to index the Title and Content. It uses the database
+	// default when empty.
+	Language string `json:"language" bun:"language,nullzero"`
 
 	// UpVotes is the number of up votes the request has received. This value is indirectly updated from the
 	// votes table.
@@ -53,6 +58,13 @@ type Model struct {
 	// votes table.
 	DownVotes int64 `json:"down_votes" bun:"down_votes"`
 
+	// DeletedAt is set once the revision is deleted. Deleted revisions are ignored by every read, until they are
+	// restored or purged.
+	DeletedAt *time.Time `json:"deleted_at" bun:"deleted_at"`
+
+	// Tags are the IDs of the tags attached to this revision. They are stored in a separate table (RequestTag).
+	Tags []uuid.UUID `json:"tags" bun:"tags,array,scanonly"`
+
 	SuggestionsCount         int `json:"suggestions_count" bun:"suggestions_count,scanonly"`
 	AcceptedSuggestionsCount int `json:"accepted_suggestions_count" bun:"accepted_suggestions_count,scanonly"`
 }
@@ -79,6 +91,11 @@ type Preview struct {
 	// DownVotes is the number of down votes the request and all its revisions has received. This value is indirectly
 	// updated from the votes table.
 	DownVotes int64 `json:"down_votes" bun:"down_votes"`
+	// Reactions counts the reactions left on the request and all its revisions, by reaction.
+	Reactions map[string]int64 `json:"reactions" bun:"reactions,type:jsonb"`
+
+	// Tags are the IDs of the tags attached to the current revision.
+	Tags []uuid.UUID `json:"tags" bun:"tags,array"`
 
 	// RevisionCount is the number of revisions the request has.
 	RevisionCount int64 `json:"revision_count" bun:"revision_count"`
@@ -88,9 +105,36 @@ type Preview struct {
 	AcceptedSuggestionsCount int `json:"accepted_suggestions_count" bun:"accepted_suggestions_count"`
 }
 
+// RequestTag is the database model for the improve_request_tags table. It attaches a tag
+// (tags_storage.Model) to a specific revision of an improvement request.
+type RequestTag struct {
+	bun.BaseModel `bun:"table:improve_request_tags"`
+
+	RequestID uuid.UUID `json:"request_id" bun:"request_id,pk,type:uuid"`
+	TagID     uuid.UUID `json:"tag_id" bun:"tag_id,pk,type:uuid"`
+}
+
+// Related is the database model for the improve_request_related table. It caches the posts most similar to a given
+// one, as returned by Repository.Related. The entry of a post is removed whenever a revision of this post, or of one
+// of its related posts, is created, deleted or restored.
+type Related struct {
+	bun.BaseModel `bun:"table:improve_request_related"`
+
+	// Source is the ID of the first revision of the reference post.
+	Source uuid.UUID `json:"source" bun:"source,pk,type:uuid"`
+	// Related are the sources of the most similar posts, by decreasing similarity.
+	Related []uuid.UUID `json:"related" bun:"related,type:uuid[],array"`
+	// ComputedAt is the time at which the similarity was computed.
+	ComputedAt time.Time `json:"computed_at" bun:"computed_at,notnull"`
+}
+
 type SearchQueryOrder struct {
 	Created bool `json:"created"`
 	Score   bool `json:"score"`
+	// Best orders by the lower bound of the Wilson score interval of the votes.
+	Best bool `json:"best"`
+	// Hot orders by the time-decayed recent activity, as of the last call to Repository.RefreshRankings.
+	Hot bool `json:"hot"`
 }
 
 // SearchQuery allows to filter improve requests.
@@ -98,6 +142,86 @@ type SearchQuery struct {
 	// UserID is an optional parameter, to only target requests that were created/revised by a specific author.
 	UserID *uuid.UUID `json:"user_id"`
 	// Query is an optional parameter, to filter requests based on their title or content.
-	Query string            `json:"query"`
-	Order *SearchQueryOrder `json:"order"`
+	Query string `json:"query"`
+	// Tags is an optional parameter, to only target requests which latest revision has all the given tags.
+	Tags []uuid.UUID `json:"tags"`
+	// States is an optional parameter, to only target threads in one of the given states (see
+	// thread_state_storage.State). Requests without an explicit state are open.
+	States []string `json:"states"`
+	// Reactions is an optional parameter, to only target requests which received all the given reactions, on any
+	// of their revisions.
+	Reactions []string `json:"reactions"`
+	// Language is the text search configuration used to parse the Query. It defaults to DefaultLanguage.
+	Language string            `json:"language"`
+	Order    *SearchQueryOrder `json:"order"`
+}
+
+// TagFacet counts the search results that carry a given tag.
+type TagFacet struct {
+	ID       uuid.UUID             `json:"id" bun:"id,type:uuid"`
+	Category tags_storage.Category `json:"category" bun:"category"`
+	Slug     string                `json:"slug" bun:"slug"`
+	Name     string                `json:"name" bun:"name"`
+	// Count is the number of results carrying the tag.
+	Count int64 `json:"count" bun:"count"`
+}
+
+// AuthorFacet counts the search results created by a given user.
+type AuthorFacet struct {
+	UserID uuid.UUID `json:"user_id" bun:"user_id,type:uuid"`
+	// Count is the number of results created by the user.
+	Count int64 `json:"count" bun:"count"`
+}
+
+// AcceptedFacet splits the search results, depending on whether they have at least one accepted suggestion.
+type AcceptedFacet struct {
+	With    int64 `json:"with"`
+	Without int64 `json:"without"`
+}
+
+// CreatedAtFacet splits the search results into creation date buckets, relative to the time of the search. A request
+// is dated by its first revision.
+// Buckets are exclusive: a request created 2 hours ago is only counted in LastDay.
+type CreatedAtFacet struct {
+	LastDay   int64 `json:"last_day"`
+	LastWeek  int64 `json:"last_week"`
+	LastMonth int64 `json:"last_month"`
+	LastYear  int64 `json:"last_year"`
+	Older     int64 `json:"older"`
+}
+
+// RevisionsFacet splits the search results based on their number of revisions.
+type RevisionsFacet struct {
+	// Single counts the requests that were never revised.
+	Single int64 `json:"single"`
+	// Few counts the requests with 2 to FewRevisionsMax revisions.
+	Few int64 `json:"few"`
+	// Many counts the requests with more than FewRevisionsMax revisions.
+	Many int64 `json:"many"`
+}
+
+// Facets contains aggregated counts over the whole set of results of a search, regardless of pagination.
+type Facets struct {
+	Tags []*TagFacet `json:"tags"`
+	// Authors only contains the MaxAuthorFacets most active authors.
+	Authors   []*AuthorFacet `json:"authors"`
+	Accepted  AcceptedFacet  `json:"accepted"`
+	CreatedAt CreatedAtFacet `json:"created_at"`
+	Revisions RevisionsFacet `json:"revisions"`
+}
+
+// Flat counters, computed in a single aggregation.
+type facetCounters struct {
+	WithAccepted    int64 `bun:"with_accepted"`
+	WithoutAccepted int64 `bun:"without_accepted"`
+
+	LastDay   int64 `bun:"last_day"`
+	LastWeek  int64 `bun:"last_week"`
+	LastMonth int64 `bun:"last_month"`
+	LastYear  int64 `bun:"last_year"`
+	Older     int64 `bun:"older"`
+
+	SingleRevision int64 `bun:"single_revision"`
+	FewRevisions   int64 `bun:"few_revisions"`
+	ManyRevisions  int64 `bun:"many_revisions"`
 }
@@ -0,0 +1,6 @@
+package improve_request_queries
+
+import _ "embed"
+
+//go:embed related.sql
+var RelatedQuery string
@@ -0,0 +1,60 @@
+/*
+Rank the other requests by similarity with the latest revision of the reference request. Only the latest
+revision of each request is compared. The score adds up:
+  - the trigram similarity of the titles;
+  - the full text proximity of the contents, using every lexeme of the reference as an alternative;
+  - 0.25 per tag shared with the reference;
+  - 0.1 per user who voted on both requests, capped to 1 so popular requests do not outweigh the content.
+*/
+WITH latest AS (
+    SELECT DISTINCT ON (source) id, source, title, text_searchable_index_col
+    FROM improve_requests
+    WHERE deleted_at IS NULL
+    ORDER BY source, created_at DESC
+),
+reference AS (
+    SELECT latest.id,
+           latest.source,
+           latest.title,
+           /* Lexemes are already normalized, so the simple configuration keeps them untouched. */
+           (
+               SELECT to_tsquery('simple', string_agg(quote_literal(lexeme), ' | '))
+               FROM unnest(latest.text_searchable_index_col)
+           ) AS query
+    FROM latest
+    WHERE latest.source = ?0
+),
+shared_tags AS (
+    SELECT latest.source AS source, COUNT(*) AS count
+    FROM reference
+        JOIN improve_request_tags AS reference_tags ON reference_tags.request_id = reference.id
+        JOIN improve_request_tags AS other_tags ON other_tags.tag_id = reference_tags.tag_id
+        JOIN latest ON latest.id = other_tags.request_id
+    GROUP BY latest.source
+),
+co_voters AS (
+    SELECT other_revisions.source AS source, COUNT(DISTINCT other_votes.user_id) AS count
+    FROM votes AS reference_votes
+        JOIN improve_requests AS reference_revisions ON reference_revisions.id = reference_votes.post_id
+        JOIN votes AS other_votes ON other_votes.user_id = reference_votes.user_id
+        JOIN improve_requests AS other_revisions ON other_revisions.id = other_votes.post_id
+    WHERE reference_votes.target = 'improve_request'
+      AND other_votes.target = 'improve_request'
+      AND reference_revisions.source = ?0
+    GROUP BY other_revisions.source
+)
+SELECT latest.source
+FROM latest
+    CROSS JOIN reference
+    LEFT JOIN shared_tags ON shared_tags.source = latest.source
+    LEFT JOIN co_voters ON co_voters.source = latest.source
+    LEFT JOIN LATERAL (
+        SELECT similarity(format_user_search(reference.title), format_user_search(latest.title)) +
+               COALESCE(ts_rank_cd(latest.text_searchable_index_col, reference.query, 32), 0) +
+               0.25 * COALESCE(shared_tags.count, 0) +
+               LEAST(0.1 * COALESCE(co_voters.count, 0), 1) AS score
+    ) AS relevance ON TRUE
+WHERE latest.source <> reference.source
+  AND relevance.score > ?2
+ORDER BY relevance.score DESC, latest.source
+LIMIT ?1;
@@ -3,6 +3,8 @@ package improve_request_storage
 import (
 	"context"
 	"fmt"
+	"github.com/a-novel/agora-backend/domains/forum/storage/improve_request/queries"
+	"github.com/a-novel/agora-backend/framework/pagination"
 	"github.com/a-novel/agora-backend/framework/validation"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
@@ -17,26 +19,77 @@ type Repository interface {
 	ReadRevisions(ctx context.Context, id uuid.UUID) ([]*Model, error)
 
 	// Create creates a brand-new post. The returned model will have matching Model.Source and Model.ID.
-	Create(ctx context.Context, userID uuid.UUID, title, content string, id uuid.UUID, now time.Time) (*Model, error)
+	// The language is the text search configuration used to index the post.
+	Create(ctx context.Context, userID uuid.UUID, title, content, language string, tags []uuid.UUID, id uuid.UUID, now time.Time) (*Model, error)
 	// CreateRevision creates a new revision for a given post. The ID must be the one of the source post.
-	CreateRevision(ctx context.Context, userID, sourceID uuid.UUID, title, content string, id uuid.UUID, now time.Time) (*Model, error)
+	// Tags are not inherited from the previous revisions, and must be provided again.
+	CreateRevision(ctx context.Context, userID, sourceID uuid.UUID, title, content, language string, tags []uuid.UUID, id uuid.UUID, now time.Time) (*Model, error)
 
 	// Delete a single revision for a post. If the provided id is the source id, then all associated revisions will
-	// also be deleted.
-	Delete(ctx context.Context, requestID uuid.UUID) error
+	// also be deleted. Deleted revisions are kept until they are purged, and can be restored in the meantime.
+	Delete(ctx context.Context, requestID uuid.UUID, now time.Time) error
+	// ReadDeleted reads a single deleted post revision, based on its ID.
+	ReadDeleted(ctx context.Context, id uuid.UUID) (*Model, error)
+	// Restore restores a deleted revision, if it was deleted after the given time. If the provided id is the source
+	// id, then the revisions that were deleted along with it are also restored.
+	Restore(ctx context.Context, requestID uuid.UUID, deletedAfter time.Time) error
+	// Purge permanently removes the revisions deleted before the given time, and returns how many were removed.
+	// Once a request has no revision left, its suggestions are removed as well.
+	// Requests with an open report case are kept until the case is closed.
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
 
 	// Search returns a list of posts, matching the provided query. Results must be paginated using the limit and
 	// offset parameters.
 	// It also returns the total number of available results, to help with pagination.
 	Search(ctx context.Context, query SearchQuery, limit, offset int) ([]*Preview, int64, error)
+	// SearchAfter returns the posts matching the query, in the same order as Search, that come after the given
+	// cursor. An empty cursor starts from the first result.
+	// It also returns the cursor of the next page, which is empty when there are no more results.
+	SearchAfter(ctx context.Context, query SearchQuery, cursor string, limit int) ([]*Preview, string, error)
+	// Facets returns aggregated counts over every result matching the query. Creation date buckets are computed
+	// relatively to now.
+	Facets(ctx context.Context, query SearchQuery, now time.Time) (*Facets, error)
+	// Suggest returns the titles of the posts that loosely resemble the query, ordered by proximity. It is meant to
+	// suggest alternative queries when a search has no result.
+	Suggest(ctx context.Context, query string, limit int) ([]string, error)
+	// RefreshRankings recomputes the hot score of every post. Posts created since the last refresh have a null
+	// hot score until then.
+	RefreshRankings(ctx context.Context) error
 
 	// IsCreator returns whether the user is a creator of the improvement suggestion. ID can be the id of any revision.
+	// Accepted collaborators of the request are creators as well, even if they have not published a revision yet.
 	// To only check if the user is the creator of the specific revision, set strict flag to true.
 	IsCreator(ctx context.Context, userID, postID uuid.UUID, strict bool) (bool, error)
 
-	GetPreviews(ctx context.Context, ids []uuid.UUID) ([]*Preview, error)
+	// GetPreviews returns the previews of the given revisions. Requests that are not public are only returned to
+	// their authors, collaborators and invited users, when viewerID is set.
+	GetPreviews(ctx context.Context, ids []uuid.UUID, viewerID *uuid.UUID) ([]*Preview, error)
+	// Related returns the latest revision of the posts most similar to the given one, by decreasing similarity. ID
+	// can be the id of any revision. The ranking is cached per post, and only computed again once a revision of
+	// the post, of one of the related posts, or of a post sharing a tag with it, has been created, deleted, restored
+	// or hidden. A post that shares no tag with a cached post is thus missing from its ranking until then.
+	Related(ctx context.Context, id uuid.UUID, limit int, now time.Time) ([]*Preview, error)
 }
 
+const (
+	// MaxAuthorFacets is the maximum number of authors returned in Facets.Authors.
+	MaxAuthorFacets = 10
+	// FewRevisionsMax is the upper bound of the RevisionsFacet.Few bucket.
+	FewRevisionsMax = 5
+	// DefaultLanguage is the text search configuration used by default, both in queries and in the database.
+	DefaultLanguage = "french"
+	// FuzzyTitleThreshold is the minimum trigram word similarity between the query and a title, for a post to
+	// match even when the full text search does not (for example, because of a typo).
+	FuzzyTitleThreshold = 0.3
+	// SuggestTitleThreshold is the minimum trigram word similarity between the query and a title, for the title to
+	// be returned by Suggest.
+	SuggestTitleThreshold = 0.1
+	// MaxRelated is the number of related posts computed and cached by Repository.Related.
+	MaxRelated = 20
+	// RelatedMinScore is the minimum similarity score for a post to be related to another.
+	RelatedMinScore = 0.1
+)
+
 // NewRepository returns a new Repository instance.
 // To use a mocked one, call NewMockRepository.
 func NewRepository(db bun.IDB, cropPreviewContent int) Repository {
@@ -57,7 +110,8 @@ func (repository *repositoryImpl) selectModelWithStats() *bun.SelectQuery {
 		// Stats.
 		ColumnExpr("SUM(up_votes) OVER (PARTITION BY source) AS total_up_votes").
 		ColumnExpr("SUM(down_votes) OVER (PARTITION BY source) AS total_down_votes").
-		ColumnExpr("COUNT(*) OVER (PARTITION BY source) AS revision_count")
+		ColumnExpr("COUNT(*) OVER (PARTITION BY source) AS revision_count").
+		Where("deleted_at IS NULL")
 }
 
 // Return a column selector, that will count the more recent revisions of a given post. Alias is the name of the
@@ -67,20 +121,69 @@ func (repository *repositoryImpl) selectMoreRecentRevisions(alias string) *bun.S
 		ColumnExpr("COUNT(*)").
 		TableExpr("improve_requests AS more_recent").
 		Where(fmt.Sprintf("more_recent.source = %s.source", alias)).
-		Where(fmt.Sprintf("more_recent.created_at > %s.created_at", alias))
+		Where(fmt.Sprintf("more_recent.created_at > %s.created_at", alias)).
+		Where("more_recent.deleted_at IS NULL")
 }
 
 func (repository *repositoryImpl) selectSuggestions(alias string) *bun.SelectQuery {
 	return repository.db.NewSelect().
 		ColumnExpr("COUNT(*)").
 		TableExpr("improve_suggestions").
-		Where(fmt.Sprintf("improve_suggestions.source_id = %s.source", alias))
+		Where(fmt.Sprintf("improve_suggestions.source_id = %s.source", alias)).
+		Where("improve_suggestions.deleted_at IS NULL")
 }
 
 func (repository *repositoryImpl) selectValidatedSuggestions(alias string) *bun.SelectQuery {
 	return repository.selectSuggestions(alias).Where("improve_suggestions.validated = TRUE")
 }
 
+// Return a column selector, that aggregates the tags of a given revision. Alias is the name of the source table.
+func (repository *repositoryImpl) selectTags(alias string) *bun.SelectQuery {
+	return repository.db.NewSelect().
+		ColumnExpr("array_agg(improve_request_tags.tag_id ORDER BY improve_request_tags.tag_id)").
+		TableExpr("improve_request_tags").
+		Where(fmt.Sprintf("improve_request_tags.request_id = %s.id", alias))
+}
+
+// Return a query over the reactions left on every revision of a given post. Alias is the name of the source table.
+func (repository *repositoryImpl) selectReactionsOf(alias string) *bun.SelectQuery {
+	return repository.db.NewSelect().
+		TableExpr("reactions").
+		Join("JOIN improve_requests AS revisions ON revisions.id = reactions.post_id").
+		Where("reactions.target = 'improve_request'").
+		Where(fmt.Sprintf("revisions.source = %s.source", alias)).
+		Where("revisions.deleted_at IS NULL")
+}
+
+// Return a column selector, that counts the reactions of a given post, by reaction. Alias is the name of the source
+// table.
+func (repository *repositoryImpl) selectReactions(alias string) *bun.SelectQuery {
+	queryCounts := repository.selectReactionsOf(alias).
+		ColumnExpr("reactions.reaction").
+		ColumnExpr("COUNT(*) AS count").
+		GroupExpr("reactions.reaction")
+
+	return repository.db.NewSelect().
+		ColumnExpr("COALESCE(jsonb_object_agg(counts.reaction, counts.count), '{}'::jsonb)").
+		TableExpr("(?) AS counts", queryCounts)
+}
+
+// SelectHiddenSources returns the sources of the requests that are not public, or were hidden after being reported.
+// Those never appear in searches, nor in any other listing of requests.
+func SelectHiddenSources(db bun.IDB) *bun.SelectQuery {
+	queryReported := db.NewSelect().
+		ColumnExpr("target_id AS source").
+		TableExpr("forum_report_cases").
+		Where("target = 'improve_request'").
+		Where("hidden_at IS NOT NULL")
+
+	return db.NewSelect().
+		Column("source").
+		TableExpr("improve_request_access").
+		Where("visibility <> 'public'").
+		Union(queryReported)
+}
+
 // Select columns for a Preview model. Alias is the name of the source table. The source table must contain
 // the stats columns (see selectModelWithStats).
 func (repository *repositoryImpl) selectPreview(alias string) *bun.SelectQuery {
@@ -96,6 +199,8 @@ func (repository *repositoryImpl) selectPreview(alias string) *bun.SelectQuery {
 		ColumnExpr("(?) AS more_recent_revisions", repository.selectMoreRecentRevisions(alias)).
 		ColumnExpr("(?) AS suggestions_count", repository.selectSuggestions(alias)).
 		ColumnExpr("(?) AS accepted_suggestions_count", repository.selectValidatedSuggestions(alias)).
+		ColumnExpr("(?) AS tags", repository.selectTags(alias)).
+		ColumnExpr("(?) AS reactions", repository.selectReactions(alias)).
 		ColumnExpr(fmt.Sprintf("%s.content::VARCHAR(?)", alias), repository.cropPreviewContent).
 		ColumnExpr(fmt.Sprintf("%s.total_up_votes as up_votes", alias)).
 		ColumnExpr(fmt.Sprintf("%s.total_down_votes as down_votes", alias))
@@ -103,7 +208,13 @@ func (repository *repositoryImpl) selectPreview(alias string) *bun.SelectQuery {
 
 func (repository *repositoryImpl) Read(ctx context.Context, id uuid.UUID) (*Model, error) {
 	model := &Model{ID: id}
-	if err := repository.db.NewSelect().Model(model).Column(exposedColumns...).WherePK().Scan(ctx); err != nil {
+	if err := repository.db.NewSelect().
+		Model(model).
+		Column(exposedColumns...).
+		ColumnExpr("(?) AS tags", repository.selectTags("improve_requests")).
+		WherePK().
+		Where("deleted_at IS NULL").
+		Scan(ctx); err != nil {
 		return nil, validation.HandlePGError(err)
 	}
 
@@ -115,9 +226,11 @@ func (repository *repositoryImpl) ReadRevisions(ctx context.Context, id uuid.UUI
 	if err := repository.db.NewSelect().
 		Model(&models).
 		Column(exposedColumns...).
-		ColumnExpr("(?) AS suggestions_count", repository.selectSuggestions("i")).
-		ColumnExpr("(?) AS accepted_suggestions_count", repository.selectValidatedSuggestions("i")).
+		ColumnExpr("(?) AS suggestions_count", repository.selectSuggestions("improve_requests")).
+		ColumnExpr("(?) AS accepted_suggestions_count", repository.selectValidatedSuggestions("improve_requests")).
+		ColumnExpr("(?) AS tags", repository.selectTags("improve_requests")).
 		Where("source = ?", id).
+		Where("deleted_at IS NULL").
 		Order("created_at DESC").
 		Scan(ctx); err != nil {
 		return nil, validation.HandlePGError(err)
@@ -130,7 +243,65 @@ func (repository *repositoryImpl) ReadRevisions(ctx context.Context, id uuid.UUI
 	return models, nil
 }
 
-func (repository *repositoryImpl) Create(ctx context.Context, userID uuid.UUID, title, content string, id uuid.UUID, now time.Time) (*Model, error) {
+// Attach the given tags to a revision. Every tag must exist, otherwise validation.ErrMissingRelation is returned.
+func (repository *repositoryImpl) createTags(ctx context.Context, tx bun.Tx, requestID uuid.UUID, tags []uuid.UUID) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	// Ignore duplicates.
+	unique := make(map[uuid.UUID]bool, len(tags))
+	models := make([]*RequestTag, 0, len(tags))
+	for _, tag := range tags {
+		if unique[tag] {
+			continue
+		}
+
+		unique[tag] = true
+		models = append(models, &RequestTag{RequestID: requestID, TagID: tag})
+	}
+
+	count, err := tx.NewSelect().Table("forum_tags").Where("id IN (?)", bun.In(tags)).Count(ctx)
+	if err != nil {
+		return validation.HandlePGError(err)
+	}
+	if count != len(models) {
+		return validation.ErrMissingRelation
+	}
+
+	if _, err := tx.NewInsert().Model(&models).Exec(ctx); err != nil {
+		return validation.HandlePGError(err)
+	}
+
+	return nil
+}
+
+// Drop the cached related posts of every request sharing a tag with a new revision, so the revision can rank among
+// them. The database only invalidates the entries that already involve the request, since tags are attached after
+// the revision is inserted.
+func (repository *repositoryImpl) invalidateRelated(ctx context.Context, tx bun.Tx, tags []uuid.UUID) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	queryTaggedSources := tx.NewSelect().
+		Model((*Model)(nil)).
+		Column("improve_requests.source").
+		Join("JOIN improve_request_tags ON improve_request_tags.request_id = improve_requests.id").
+		Where("improve_request_tags.tag_id IN (?)", bun.In(tags)).
+		Where("improve_requests.deleted_at IS NULL")
+
+	if _, err := tx.NewDelete().
+		Model((*Related)(nil)).
+		Where("source IN (?)", queryTaggedSources).
+		Exec(ctx); err != nil {
+		return validation.HandlePGError(err)
+	}
+
+	return nil
+}
+
+func (repository *repositoryImpl) Create(ctx context.Context, userID uuid.UUID, title, content, language string, tags []uuid.UUID, id uuid.UUID, now time.Time) (*Model, error) {
 	model := &Model{
 		ID:        id,
 		Source:    id,
@@ -138,16 +309,32 @@ func (repository *repositoryImpl) Create(ctx context.Context, userID uuid.UUID,
 		UserID:    userID,
 		Title:     title,
 		Content:   content,
+		Language:  language,
 	}
 
-	if err := repository.db.NewInsert().Model(model).Returning(exposedColumnsSTR).Scan(ctx); err != nil {
-		return nil, validation.HandlePGError(err)
+	err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := tx.NewInsert().Model(model).Returning(exposedColumnsSTR).Scan(ctx); err != nil {
+			return validation.HandlePGError(err)
+		}
+
+		if err := repository.createTags(ctx, tx, id, tags); err != nil {
+			return err
+		}
+
+		return repository.invalidateRelated(ctx, tx, tags)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tags) > 0 {
+		model.Tags = tags
 	}
 
 	return model, nil
 }
 
-func (repository *repositoryImpl) CreateRevision(ctx context.Context, userID, sourceID uuid.UUID, title, content string, id uuid.UUID, now time.Time) (*Model, error) {
+func (repository *repositoryImpl) CreateRevision(ctx context.Context, userID, sourceID uuid.UUID, title, content, language string, tags []uuid.UUID, id uuid.UUID, now time.Time) (*Model, error) {
 	model := &Model{
 		ID:        id,
 		Source:    sourceID,
@@ -155,10 +342,16 @@ func (repository *repositoryImpl) CreateRevision(ctx context.Context, userID, so
 		UserID:    userID,
 		Title:     title,
 		Content:   content,
+		Language:  language,
 	}
 
 	// Ensure source exists
-	count, err := repository.db.NewSelect().Model(new(Model)).Where("id = ?", sourceID).Where("source = ?", sourceID).Count(ctx)
+	count, err := repository.db.NewSelect().
+		Model(new(Model)).
+		Where("id = ?", sourceID).
+		Where("source = ?", sourceID).
+		Where("deleted_at IS NULL").
+		Count(ctx)
 	if err != nil {
 		return nil, validation.HandlePGError(err)
 	}
@@ -166,25 +359,105 @@ func (repository *repositoryImpl) CreateRevision(ctx context.Context, userID, so
 		return nil, validation.ErrMissingRelation
 	}
 
-	if err := repository.db.NewInsert().Model(model).Returning(exposedColumnsSTR).Scan(ctx); err != nil {
-		return nil, validation.HandlePGError(err)
+	err = repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := tx.NewInsert().Model(model).Returning(exposedColumnsSTR).Scan(ctx); err != nil {
+			return validation.HandlePGError(err)
+		}
+
+		if err := repository.createTags(ctx, tx, id, tags); err != nil {
+			return err
+		}
+
+		return repository.invalidateRelated(ctx, tx, tags)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tags) > 0 {
+		model.Tags = tags
 	}
 
 	return model, nil
 }
 
-func (repository *repositoryImpl) Delete(ctx context.Context, requestID uuid.UUID) error {
-	model := &Model{ID: requestID}
-	if _, err := repository.db.NewDelete().Model(model).Where("source = ?0::uuid OR id = ?0::uuid", requestID).Exec(ctx); err != nil {
+func (repository *repositoryImpl) Delete(ctx context.Context, requestID uuid.UUID, now time.Time) error {
+	if _, err := repository.db.NewUpdate().
+		Model((*Model)(nil)).
+		Set("deleted_at = ?", now).
+		Where("source = ?0::uuid OR id = ?0::uuid", requestID).
+		Where("deleted_at IS NULL").
+		Exec(ctx); err != nil {
 		return validation.HandlePGError(err)
 	}
 
 	return nil
 }
 
-func (repository *repositoryImpl) Search(ctx context.Context, query SearchQuery, limit, offset int) ([]*Preview, int64, error) {
-	var results []*Preview
+func (repository *repositoryImpl) ReadDeleted(ctx context.Context, id uuid.UUID) (*Model, error) {
+	model := &Model{ID: id}
+	if err := repository.db.NewSelect().
+		Model(model).
+		Column(exposedColumns...).
+		ColumnExpr("(?) AS tags", repository.selectTags("improve_requests")).
+		WherePK().
+		Where("deleted_at IS NOT NULL").
+		Scan(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+func (repository *repositoryImpl) Restore(ctx context.Context, requestID uuid.UUID, deletedAfter time.Time) error {
+	// Revisions deleted on their own, before the whole request, remain deleted.
+	queryDeletedAt := repository.db.NewSelect().
+		Model((*Model)(nil)).
+		Column("deleted_at").
+		Where("id = ?", requestID)
+
+	res, err := repository.db.NewUpdate().
+		Model((*Model)(nil)).
+		Set("deleted_at = NULL").
+		Where("source = ?0::uuid OR id = ?0::uuid", requestID).
+		Where("deleted_at = (?)", queryDeletedAt).
+		Where("deleted_at >= ?", deletedAfter).
+		Exec(ctx)
+	if err != nil {
+		return validation.HandlePGError(err)
+	}
+
+	return validation.ForceRowsUpdate(res)
+}
 
+func (repository *repositoryImpl) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	queryReported := repository.db.NewSelect().
+		Column("target_id").
+		TableExpr("forum_report_cases").
+		Where("target = 'improve_request'").
+		Where("status IN ('pending', 'claimed')")
+
+	// Once the last revision of a request is gone, the database removes its suggestions.
+	res, err := repository.db.NewDelete().
+		Model((*Model)(nil)).
+		Where("deleted_at < ?", deletedBefore).
+		Where("source NOT IN (?)", queryReported).
+		Exec(ctx)
+	if err != nil {
+		return 0, validation.HandlePGError(err)
+	}
+
+	purged, err := res.RowsAffected()
+	if err != nil {
+		return 0, validation.HandlePGError(err)
+	}
+
+	return purged, nil
+}
+
+// Return the latest revision of every post, with aggregated stats. Filters that apply to the revision itself are
+// directly applied.
+func (repository *repositoryImpl) selectLatestRevisions(query SearchQuery) *bun.SelectQuery {
 	queryRequestWithStats := repository.selectModelWithStats()
 
 	// Filter previous query to keep only the latest revisions for the current query.
@@ -193,38 +466,141 @@ func (repository *repositoryImpl) Search(ctx context.Context, query SearchQuery,
 		Column("*").
 		// Filter latest revision.
 		DistinctOn("with_stats.source").
-		Order("with_stats.source", "with_stats.created_at DESC")
+		Order("with_stats.source", "with_stats.created_at DESC").
+		Where("with_stats.source NOT IN (?)", SelectHiddenSources(repository.db))
 
 	// Apply filters.
 	if query.UserID != nil {
 		queryLatestRevision = queryLatestRevision.Where("user_id = ?", query.UserID)
 	}
+	if len(query.States) > 0 {
+		queryState := repository.db.NewSelect().
+			Column("state").
+			TableExpr("improve_request_states").
+			Where("improve_request_states.source = with_stats.source")
+
+		queryLatestRevision = queryLatestRevision.
+			Where("COALESCE((?)::text, 'open') IN (?)", queryState, bun.In(query.States))
+	}
 
-	queryPreviews := repository.selectPreview("i").
-		TableExpr("(?) as i", queryLatestRevision).
-		Limit(limit).
-		Offset(offset)
+	return queryLatestRevision
+}
+
+// Apply the remaining search filters to a query built over selectLatestRevisions, under the "i" alias.
+// When a full text query is provided, the parsed query is available under search.query, and the normalized
+// query under search.term.
+func (repository *repositoryImpl) applySearchFilters(q *bun.SelectQuery, query SearchQuery) *bun.SelectQuery {
+	if len(query.Tags) > 0 {
+		// Count unique tags, so duplicates in the query do not exclude every result.
+		unique := make(map[uuid.UUID]bool, len(query.Tags))
+		for _, tag := range query.Tags {
+			unique[tag] = true
+		}
+
+		queryMatchingTags := repository.db.NewSelect().
+			ColumnExpr("COUNT(*)").
+			TableExpr("improve_request_tags").
+			Where("improve_request_tags.request_id = i.id").
+			Where("improve_request_tags.tag_id IN (?)", bun.In(query.Tags))
+
+		q = q.Where("(?) = ?", queryMatchingTags, len(unique))
+	}
+
+	if len(query.Reactions) > 0 {
+		unique := make(map[string]bool, len(query.Reactions))
+		for _, reaction := range query.Reactions {
+			unique[reaction] = true
+		}
+
+		queryMatchingReactions := repository.selectReactionsOf("i").
+			ColumnExpr("COUNT(DISTINCT reactions.reaction)").
+			Where("reactions.reaction IN (?)", bun.In(query.Reactions))
+
+		q = q.Where("(?) = ?", queryMatchingReactions, len(unique))
+	}
 
 	// Use FullText search filter.
 	if query.Query != "" {
+		language := query.Language
+		if language == "" {
+			language = DefaultLanguage
+		}
+
 		queryFullText := repository.db.NewSelect().
-			ColumnExpr("to_tsquery('french', string_agg(lexeme || ':*', ' & ' order by positions)) AS query").
-			TableExpr("unnest(to_tsvector('french', unaccent(?)))", query.Query)
+			ColumnExpr("to_tsquery(?::regconfig, string_agg(lexeme || ':*', ' & ' order by positions)) AS query", language).
+			ColumnExpr("format_user_search(?) AS term", query.Query).
+			TableExpr("unnest(to_tsvector(?::regconfig, unaccent(?)))", language, query.Query)
 
-		queryPreviews = queryPreviews.
+		// Titles are also matched using trigrams, so a typo does not discard an otherwise relevant post.
+		q = q.
 			TableExpr("(?) AS search", queryFullText).
-			Where("i.text_searchable_index_col @@ search.query").
-			OrderExpr("ts_rank_cd(i.text_searchable_index_col, search.query) DESC")
+			WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+				return q.
+					Where("i.text_searchable_index_col @@ search.query").
+					WhereOr("word_similarity(search.term, format_user_search(i.title)) > ?", FuzzyTitleThreshold)
+			})
+	}
+
+	return q
+}
+
+// Return the ordering keys of a search, from the most to the least significant. The keys apply to a query built
+// with applySearchFilters.
+func (repository *repositoryImpl) searchKeys(query SearchQuery) []pagination.Key {
+	var keys []pagination.Key
+
+	if query.Query != "" {
+		// The query might not produce any lexeme (stop words only), in which case search.query is NULL.
+		keys = append(keys, pagination.Key{
+			Expr: "COALESCE(ts_rank_cd(i.text_searchable_index_col, search.query), 0) + " +
+				"word_similarity(search.term, format_user_search(i.title))",
+			Type: "real",
+		})
 	}
 
 	if query.Order != nil {
+		if query.Order.Hot {
+			queryHotScore := repository.db.NewSelect().
+				Column("hot_score").
+				TableExpr("improve_request_rankings").
+				Where("improve_request_rankings.source = i.source")
+
+			keys = append(keys, pagination.Key{
+				Expr: "COALESCE((?), 0)", Args: []interface{}{queryHotScore}, Type: "double precision",
+			})
+		}
+		if query.Order.Best {
+			keys = append(keys, pagination.Key{
+				// Sums of bigint columns are numeric, which does not implicitly cast to the bigint arguments.
+				Expr: "wilson_lower_bound(i.total_up_votes::bigint, i.total_down_votes::bigint)", Type: "double precision",
+			})
+		}
 		if query.Order.Score {
-			queryPreviews = queryPreviews.OrderExpr("i.up_votes - i.down_votes DESC")
+			keys = append(keys, pagination.Key{Expr: "COALESCE(i.up_votes, 0) - COALESCE(i.down_votes, 0)", Type: "bigint"})
 		}
 	}
 
-	// Order by date by default.
-	queryPreviews = queryPreviews.OrderExpr("i.created_at DESC")
+	// Order by date by default. The ID breaks the remaining ties, so the order is stable across pages.
+	return append(
+		keys,
+		pagination.Key{Expr: "i.created_at", Type: "timestamp"},
+		pagination.Key{Expr: "i.id", Type: "uuid"},
+	)
+}
+
+func (repository *repositoryImpl) Search(ctx context.Context, query SearchQuery, limit, offset int) ([]*Preview, int64, error) {
+	var results []*Preview
+
+	queryPreviews := repository.selectPreview("i").
+		TableExpr("(?) as i", repository.selectLatestRevisions(query)).
+		Limit(limit).
+		Offset(offset)
+
+	queryPreviews = repository.applySearchFilters(queryPreviews, query)
+
+	for _, key := range repository.searchKeys(query) {
+		queryPreviews = queryPreviews.OrderExpr(key.Expr+" DESC", key.Args...)
+	}
 
 	count, err := queryPreviews.ScanAndCount(ctx, &results)
 	if err != nil {
@@ -234,6 +610,173 @@ func (repository *repositoryImpl) Search(ctx context.Context, query SearchQuery,
 	return results, int64(count), nil
 }
 
+func (repository *repositoryImpl) SearchAfter(ctx context.Context, query SearchQuery, cursor string, limit int) ([]*Preview, string, error) {
+	type previewWithCursor struct {
+		Preview `bun:",extend"`
+		Cursor  string `bun:"cursor,scanonly"`
+	}
+
+	var results []*previewWithCursor
+
+	queryPreviews := repository.selectPreview("i").
+		TableExpr("(?) as i", repository.selectLatestRevisions(query)).
+		Limit(limit)
+
+	queryPreviews = repository.applySearchFilters(queryPreviews, query)
+
+	queryPreviews, err := pagination.Apply(queryPreviews, repository.searchKeys(query), cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := queryPreviews.Scan(ctx, &results); err != nil {
+		return nil, "", validation.HandlePGError(err)
+	}
+
+	previews := make([]*Preview, len(results))
+	for i, result := range results {
+		previews[i] = &result.Preview
+	}
+
+	next := pagination.Next(results, limit, func(row *previewWithCursor) string {
+		return row.Cursor
+	})
+
+	return previews, next, nil
+}
+
+func (repository *repositoryImpl) Facets(ctx context.Context, query SearchQuery, now time.Time) (*Facets, error) {
+	facets := &Facets{Tags: make([]*TagFacet, 0), Authors: make([]*AuthorFacet, 0)}
+
+	// Requests are dated by their first revision, so revising a request does not move it to a more recent bucket.
+	queryFirstRevision := repository.db.NewSelect().
+		Column("first_revision.created_at").
+		TableExpr("improve_requests AS first_revision").
+		Where("first_revision.id = i.source")
+
+	queryMatches := repository.applySearchFilters(
+		repository.db.NewSelect().
+			Column("i.id", "i.user_id", "i.revision_count").
+			ColumnExpr("COALESCE((?), i.created_at) AS created_at", queryFirstRevision).
+			ColumnExpr("(?) AS accepted_suggestions_count", repository.selectValidatedSuggestions("i")).
+			TableExpr("(?) as i", repository.selectLatestRevisions(query)),
+		query,
+	)
+
+	err := repository.db.NewSelect().
+		ColumnExpr("forum_tags.id, forum_tags.category, forum_tags.slug, forum_tags.name").
+		ColumnExpr("COUNT(*) AS count").
+		TableExpr("(?) AS matches", queryMatches).
+		Join("JOIN improve_request_tags ON improve_request_tags.request_id = matches.id").
+		Join("JOIN forum_tags ON forum_tags.id = improve_request_tags.tag_id").
+		GroupExpr("forum_tags.id").
+		OrderExpr("count DESC, forum_tags.name").
+		Scan(ctx, &facets.Tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count tags: %w", validation.HandlePGError(err))
+	}
+
+	err = repository.db.NewSelect().
+		ColumnExpr("matches.user_id").
+		ColumnExpr("COUNT(*) AS count").
+		TableExpr("(?) AS matches", queryMatches).
+		GroupExpr("matches.user_id").
+		OrderExpr("count DESC, matches.user_id").
+		Limit(MaxAuthorFacets).
+		Scan(ctx, &facets.Authors)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count authors: %w", validation.HandlePGError(err))
+	}
+
+	counters := new(facetCounters)
+	err = repository.db.NewSelect().
+		ColumnExpr("COUNT(*) FILTER (WHERE matches.accepted_suggestions_count > 0) AS with_accepted").
+		ColumnExpr("COUNT(*) FILTER (WHERE matches.accepted_suggestions_count = 0) AS without_accepted").
+		// Exclusive date buckets.
+		ColumnExpr("COUNT(*) FILTER (WHERE matches.created_at >= ?) AS last_day", now.AddDate(0, 0, -1)).
+		ColumnExpr(
+			"COUNT(*) FILTER (WHERE matches.created_at < ? AND matches.created_at >= ?) AS last_week",
+			now.AddDate(0, 0, -1), now.AddDate(0, 0, -7),
+		).
+		ColumnExpr(
+			"COUNT(*) FILTER (WHERE matches.created_at < ? AND matches.created_at >= ?) AS last_month",
+			now.AddDate(0, 0, -7), now.AddDate(0, -1, 0),
+		).
+		ColumnExpr(
+			"COUNT(*) FILTER (WHERE matches.created_at < ? AND matches.created_at >= ?) AS last_year",
+			now.AddDate(0, -1, 0), now.AddDate(-1, 0, 0),
+		).
+		ColumnExpr("COUNT(*) FILTER (WHERE matches.created_at < ?) AS older", now.AddDate(-1, 0, 0)).
+		// Revision buckets.
+		ColumnExpr("COUNT(*) FILTER (WHERE matches.revision_count = 1) AS single_revision").
+		ColumnExpr(
+			"COUNT(*) FILTER (WHERE matches.revision_count > 1 AND matches.revision_count <= ?) AS few_revisions",
+			FewRevisionsMax,
+		).
+		ColumnExpr("COUNT(*) FILTER (WHERE matches.revision_count > ?) AS many_revisions", FewRevisionsMax).
+		TableExpr("(?) AS matches", queryMatches).
+		Scan(ctx, counters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute counters: %w", validation.HandlePGError(err))
+	}
+
+	facets.Accepted = AcceptedFacet{
+		With:    counters.WithAccepted,
+		Without: counters.WithoutAccepted,
+	}
+	facets.CreatedAt = CreatedAtFacet{
+		LastDay:   counters.LastDay,
+		LastWeek:  counters.LastWeek,
+		LastMonth: counters.LastMonth,
+		LastYear:  counters.LastYear,
+		Older:     counters.Older,
+	}
+	facets.Revisions = RevisionsFacet{
+		Single: counters.SingleRevision,
+		Few:    counters.FewRevisions,
+		Many:   counters.ManyRevisions,
+	}
+
+	return facets, nil
+}
+
+func (repository *repositoryImpl) Suggest(ctx context.Context, query string, limit int) ([]string, error) {
+	results := make([]string, 0)
+
+	queryLatestTitles := repository.db.NewSelect().
+		Column("title").
+		TableExpr("improve_requests").
+		Where("deleted_at IS NULL").
+		Where("source NOT IN (?)", SelectHiddenSources(repository.db)).
+		DistinctOn("source").
+		Order("source", "created_at DESC")
+
+	err := repository.db.NewSelect().
+		Column("latest.title").
+		TableExpr("(?) AS latest", queryLatestTitles).
+		TableExpr("(SELECT format_user_search(?) AS term) AS search", query).
+		Where("word_similarity(search.term, format_user_search(latest.title)) > ?", SuggestTitleThreshold).
+		// Different posts may share the same title.
+		GroupExpr("latest.title, search.term").
+		OrderExpr("word_similarity(search.term, format_user_search(latest.title)) DESC, latest.title").
+		Limit(limit).
+		Scan(ctx, &results)
+	if err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return results, nil
+}
+
+func (repository *repositoryImpl) RefreshRankings(ctx context.Context) error {
+	// Concurrent refresh does not lock the view, so searches keep running during the computation.
+	if _, err := repository.db.NewRaw("REFRESH MATERIALIZED VIEW CONCURRENTLY improve_request_rankings").Exec(ctx); err != nil {
+		return validation.HandlePGError(err)
+	}
+
+	return nil
+}
+
 func (repository *repositoryImpl) IsCreator(ctx context.Context, userID, postID uuid.UUID, strict bool) (bool, error) {
 	var (
 		err error
@@ -257,11 +800,16 @@ func (repository *repositoryImpl) IsCreator(ctx context.Context, userID, postID
 			Where("same_source.source = improve_requests.source").
 			Where("same_source.user_id = ?", userID)
 
-		// The user is the author of any revision on the same source as the targeted revision.
+		// The user is the author of any revision on the same source as the targeted revision, or a collaborator
+		// of the request.
 		ok, err = repository.db.NewSelect().
 			Model((*Model)(nil)).
 			Where("id = ?", postID).
-			Where("EXISTS(?)", querySameSource).
+			WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+				return q.
+					Where("EXISTS(?)", querySameSource).
+					WhereOr("EXISTS(?)", repository.selectCollaborator("improve_requests.source", userID))
+			}).
 			Exists(ctx)
 		if err != nil {
 			return false, validation.HandlePGError(err)
@@ -271,16 +819,111 @@ func (repository *repositoryImpl) IsCreator(ctx context.Context, userID, postID
 	return ok, nil
 }
 
-func (repository *repositoryImpl) GetPreviews(ctx context.Context, ids []uuid.UUID) ([]*Preview, error) {
+// Select the accepted collaboration of a user, on the request whose source is given by column.
+func (repository *repositoryImpl) selectCollaborator(column string, userID uuid.UUID) *bun.SelectQuery {
+	return repository.db.NewSelect().
+		ColumnExpr("1").
+		TableExpr("improve_request_collaborators AS collaborators").
+		Where("collaborators.source = ?", bun.Ident(column)).
+		Where("collaborators.user_id = ?", userID).
+		Where("collaborators.accepted_at IS NOT NULL")
+}
+
+func (repository *repositoryImpl) GetPreviews(ctx context.Context, ids []uuid.UUID, viewerID *uuid.UUID) ([]*Preview, error) {
 	var results []*Preview
 
 	queryRequestWithStats := repository.selectModelWithStats()
 
-	err := repository.selectPreview("i").
+	dbQuery := repository.selectPreview("i").
 		TableExpr("(?) as i", queryRequestWithStats).
-		Where("i.id IN (?)", bun.In(ids)).
-		Scan(ctx, &results)
+		Where("i.id IN (?)", bun.In(ids))
+
+	if viewerID == nil {
+		dbQuery = dbQuery.Where("i.source NOT IN (?)", SelectHiddenSources(repository.db))
+	} else {
+		queryIsAuthor := repository.db.NewSelect().
+			ColumnExpr("1").
+			TableExpr("improve_requests AS same_source").
+			Where("same_source.source = i.source").
+			Where("same_source.user_id = ?", *viewerID)
+
+		queryIsInvited := repository.db.NewSelect().
+			ColumnExpr("1").
+			TableExpr("improve_request_invites AS invites").
+			Where("invites.source = i.source").
+			Where("invites.user_id = ?", *viewerID)
+
+		dbQuery = dbQuery.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("i.source NOT IN (?)", SelectHiddenSources(repository.db)).
+				WhereOr("EXISTS(?)", queryIsAuthor).
+				WhereOr("EXISTS(?)", repository.selectCollaborator("i.source", *viewerID)).
+				WhereOr("EXISTS(?)", queryIsInvited)
+		})
+	}
+
+	err := dbQuery.Scan(ctx, &results)
+	if err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return results, nil
+}
+
+// Compute and cache the related posts of a given source, unless they are already cached.
+func (repository *repositoryImpl) cacheRelated(ctx context.Context, source uuid.UUID, now time.Time) error {
+	cached, err := repository.db.NewSelect().Model((*Related)(nil)).Where("source = ?", source).Exists(ctx)
 	if err != nil {
+		return validation.HandlePGError(err)
+	}
+	if cached {
+		return nil
+	}
+
+	related := make([]uuid.UUID, 0)
+	if err := repository.db.
+		NewRaw(improve_request_queries.RelatedQuery, source, MaxRelated, RelatedMinScore).
+		Scan(ctx, &related); err != nil {
+		return validation.HandlePGError(err)
+	}
+
+	// Another request might have computed the same entry in the meantime.
+	if _, err := repository.db.NewInsert().
+		Model(&Related{Source: source, Related: related, ComputedAt: now}).
+		On("CONFLICT (source) DO UPDATE").
+		Set("related = EXCLUDED.related").
+		Set("computed_at = EXCLUDED.computed_at").
+		Exec(ctx); err != nil {
+		return validation.HandlePGError(err)
+	}
+
+	return nil
+}
+
+func (repository *repositoryImpl) Related(ctx context.Context, id uuid.UUID, limit int, now time.Time) ([]*Preview, error) {
+	var source uuid.UUID
+	if err := repository.db.NewSelect().
+		Model((*Model)(nil)).
+		Column("source").
+		Where("id = ?", id).
+		Where("deleted_at IS NULL").
+		Scan(ctx, &source); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	if err := repository.cacheRelated(ctx, source, now); err != nil {
+		return nil, err
+	}
+
+	results := make([]*Preview, 0)
+	if err := repository.selectPreview("i").
+		TableExpr("(?) AS i", repository.selectLatestRevisions(SearchQuery{})).
+		TableExpr("improve_request_related AS related").
+		Where("related.source = ?", source).
+		Where("i.source = ANY(related.related)").
+		OrderExpr("array_position(related.related, i.source)").
+		Limit(limit).
+		Scan(ctx, &results); err != nil {
 		return nil, validation.HandlePGError(err)
 	}
 
@@ -2,6 +2,7 @@ package improve_request_storage
 
 import (
 	"context"
+	"github.com/a-novel/agora-backend/framework"
 	"github.com/a-novel/agora-backend/framework/validation"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -11,9 +12,31 @@ import (
 )
 
 var (
-	baseTime = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	baseTime   = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	deleteTime = time.Date(2020, time.May, 4, 12, 0, 0, 0, time.UTC)
 )
 
+var TagFixtures = []interface{}{
+	&tags_storage.Model{
+		ID:        test_utils.NumberUUID(100),
+		CreatedAt: baseTime,
+		Core: tags_storage.Core{
+			Category: tags_storage.CategoryGenre,
+			Slug:     "fantasy",
+			Name:     "Fantasy",
+		},
+	},
+	&tags_storage.Model{
+		ID:        test_utils.NumberUUID(101),
+		CreatedAt: baseTime,
+		Core: tags_storage.Core{
+			Category: tags_storage.CategoryPOV,
+			Slug:     "first-person",
+			Name:     "First person",
+		},
+	},
+}
+
 var Fixtures = []*Model{
 	{
 		ID:        test_utils.NumberUUID(1000),
@@ -23,6 +46,7 @@ var Fixtures = []*Model{
 		UpVotes:   10,
 		Title:     "Test",
 		Content:   "Dummy content.",
+		Language:  "french",
 	},
 	{
 		ID:        test_utils.NumberUUID(1001),
@@ -33,6 +57,7 @@ var Fixtures = []*Model{
 		DownVotes: 2,
 		Title:     "Test",
 		Content:   "Dummy content updated.",
+		Language:  "french",
 	},
 	{
 		ID:        test_utils.NumberUUID(1002),
@@ -43,6 +68,7 @@ var Fixtures = []*Model{
 		DownVotes: 8,
 		Title:     "New Test",
 		Content:   "Dummy content updated.",
+		Language:  "french",
 	},
 	{
 		ID:        test_utils.NumberUUID(5000),
@@ -53,6 +79,7 @@ var Fixtures = []*Model{
 		DownVotes: 52,
 		Title:     "Lorem Ipsum",
 		Content:   "Lorem ipsum dolor sit amet, consectetur adipiscing elit. Vestibulum tempor congue aliquam. Nam ullamcorper mi lectus, et dictum urna imperdiet a.",
+		Language:  "french",
 	},
 	{
 		ID:        test_utils.NumberUUID(6000),
@@ -63,6 +90,7 @@ var Fixtures = []*Model{
 		DownVotes: 3,
 		Title:     "New title Updated.",
 		Content:   "qwertyuiopasdfghjklzxcvbnm",
+		Language:  "french",
 	},
 }
 
@@ -166,11 +194,13 @@ func TestImproveRequestRepository_Create(t *testing.T) {
 	data := []struct {
 		name string
 
-		userID  uuid.UUID
-		title   string
-		content string
-		id      uuid.UUID
-		now     time.Time
+		userID   uuid.UUID
+		title    string
+		content  string
+		language string
+		tags     []uuid.UUID
+		id       uuid.UUID
+		now      time.Time
 
 		expect    *Model
 		expectErr error
@@ -182,8 +212,9 @@ func TestImproveRequestRepository_Create(t *testing.T) {
 			content: `Lorem ipsum dolor sit amet, consectetur adipiscing elit. Vestibulum tempor congue aliquam. Nam ullamcorper mi lectus, et dictum urna imperdiet a. Quisque venenatis hendrerit laoreet. Praesent egestas turpis imperdiet felis vulputate, a eleifend turpis luctus. Aliquam at varius metus, eu placerat orci. Pellentesque vel convallis nisl. Pellentesque porta tellus nec vulputate efficitur. Ut eleifend, quam ut ultricies vulputate, nibh felis sollicitudin ante, convallis tincidunt urna nisl sed erat.
 
 Proin rutrum commodo tincidunt. Sed convallis risus ut justo egestas vestibulum. Nullam tincidunt sed quam a viverra. Cras eu nulla at dui varius cursus ut at turpis. Phasellus nec pellentesque nisi. Aenean est dolor, facilisis a eros eu, elementum sagittis nulla. Duis pulvinar sed augue nec fermentum. Duis eu malesuada justo, a porttitor urna. Aliquam justo mi, aliquam in sem sit amet, vulputate tristique felis. Donec molestie accumsan nunc a facilisis.`,
-			id:  test_utils.NumberUUID(2),
-			now: baseTime,
+			language: "english",
+			id:       test_utils.NumberUUID(2),
+			now:      baseTime,
 			expect: &Model{
 				ID:        test_utils.NumberUUID(2),
 				Source:    test_utils.NumberUUID(2),
@@ -193,8 +224,38 @@ Proin rutrum commodo tincidunt. Sed convallis risus ut justo egestas vestibulum.
 				Content: `Lorem ipsum dolor sit amet, consectetur adipiscing elit. Vestibulum tempor congue aliquam. Nam ullamcorper mi lectus, et dictum urna imperdiet a. Quisque venenatis hendrerit laoreet. Praesent egestas turpis imperdiet felis vulputate, a eleifend turpis luctus. Aliquam at varius metus, eu placerat orci. Pellentesque vel convallis nisl. Pellentesque porta tellus nec vulputate efficitur. Ut eleifend, quam ut ultricies vulputate, nibh felis sollicitudin ante, convallis tincidunt urna nisl sed erat.
 
 Proin rutrum commodo tincidunt. Sed convallis risus ut justo egestas vestibulum. Nullam tincidunt sed quam a viverra. Cras eu nulla at dui varius cursus ut at turpis. Phasellus nec pellentesque nisi. Aenean est dolor, facilisis a eros eu, elementum sagittis nulla. Duis pulvinar sed augue nec fermentum. Duis eu malesuada justo, a porttitor urna. Aliquam justo mi, aliquam in sem sit amet, vulputate tristique felis. Donec molestie accumsan nunc a facilisis.`,
+				Language: "english",
+			},
+		},
+		{
+			name:    "Success/Tags",
+			userID:  test_utils.NumberUUID(1),
+			title:   "FooBar Symphony",
+			content: "Dummy content.",
+			tags:    []uuid.UUID{test_utils.NumberUUID(100), test_utils.NumberUUID(101), test_utils.NumberUUID(100)},
+			id:      test_utils.NumberUUID(2),
+			now:     baseTime,
+			expect: &Model{
+				ID:        test_utils.NumberUUID(2),
+				Source:    test_utils.NumberUUID(2),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(1),
+				Title:     "FooBar Symphony",
+				Content:   "Dummy content.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(100), test_utils.NumberUUID(101), test_utils.NumberUUID(100)},
+				Language:  "french",
 			},
 		},
+		{
+			name:      "Error/MissingTag",
+			userID:    test_utils.NumberUUID(1),
+			title:     "FooBar Symphony",
+			content:   "Dummy content.",
+			tags:      []uuid.UUID{test_utils.NumberUUID(100), test_utils.NumberUUID(102)},
+			id:        test_utils.NumberUUID(2),
+			now:       baseTime,
+			expectErr: validation.ErrMissingRelation,
+		},
 		{
 			name:   "Error/Exists",
 			userID: test_utils.NumberUUID(1),
@@ -226,7 +287,9 @@ Proin rutrum commodo tincidunt. Sed convallis risus ut justo egestas vestibulum.
 		},
 	}
 
-	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+	fixtures := test_utils.Concat(TagFixtures, []interface{}{Fixtures[0], Fixtures[1], Fixtures[2]})
+
+	err := test_utils.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
 		for _, d := range data {
 			t.Run(d.name, func(st *testing.T) {
 				stx, err := tx.Begin()
@@ -234,7 +297,7 @@ Proin rutrum commodo tincidunt. Sed convallis risus ut justo egestas vestibulum.
 				defer stx.Rollback()
 				repository := NewRepository(stx, 10)
 
-				res, err := repository.Create(ctx, d.userID, d.title, d.content, d.id, d.now)
+				res, err := repository.Create(ctx, d.userID, d.title, d.content, d.language, d.tags, d.id, d.now)
 				test_utils.RequireError(t, d.expectErr, err)
 				require.Equal(t, d.expect, res)
 			})
@@ -255,6 +318,8 @@ func TestImproveRequestRepository_CreateRevision(t *testing.T) {
 		sourceID uuid.UUID
 		title    string
 		content  string
+		language string
+		tags     []uuid.UUID
 		id       uuid.UUID
 		now      time.Time
 
@@ -269,8 +334,9 @@ func TestImproveRequestRepository_CreateRevision(t *testing.T) {
 			content: `Lorem ipsum dolor sit amet, consectetur adipiscing elit. Vestibulum tempor congue aliquam. Nam ullamcorper mi lectus, et dictum urna imperdiet a. Quisque venenatis hendrerit laoreet. Praesent egestas turpis imperdiet felis vulputate, a eleifend turpis luctus. Aliquam at varius metus, eu placerat orci. Pellentesque vel convallis nisl. Pellentesque porta tellus nec vulputate efficitur. Ut eleifend, quam ut ultricies vulputate, nibh felis sollicitudin ante, convallis tincidunt urna nisl sed erat.
 
 Proin rutrum commodo tincidunt. Sed convallis risus ut justo egestas vestibulum. Nullam tincidunt sed quam a viverra. Cras eu nulla at dui varius cursus ut at turpis. Phasellus nec pellentesque nisi. Aenean est dolor, facilisis a eros eu, elementum sagittis nulla. Duis pulvinar sed augue nec fermentum. Duis eu malesuada justo, a porttitor urna. Aliquam justo mi, aliquam in sem sit amet, vulputate tristique felis. Donec molestie accumsan nunc a facilisis.`,
-			id:  test_utils.NumberUUID(2),
-			now: baseTime,
+			language: "english",
+			id:       test_utils.NumberUUID(2),
+			now:      baseTime,
 			expect: &Model{
 				ID:        test_utils.NumberUUID(2),
 				Source:    test_utils.NumberUUID(1000),
@@ -280,6 +346,7 @@ Proin rutrum commodo tincidunt. Sed convallis risus ut justo egestas vestibulum.
 				Content: `Lorem ipsum dolor sit amet, consectetur adipiscing elit. Vestibulum tempor congue aliquam. Nam ullamcorper mi lectus, et dictum urna imperdiet a. Quisque venenatis hendrerit laoreet. Praesent egestas turpis imperdiet felis vulputate, a eleifend turpis luctus. Aliquam at varius metus, eu placerat orci. Pellentesque vel convallis nisl. Pellentesque porta tellus nec vulputate efficitur. Ut eleifend, quam ut ultricies vulputate, nibh felis sollicitudin ante, convallis tincidunt urna nisl sed erat.
 
 Proin rutrum commodo tincidunt. Sed convallis risus ut justo egestas vestibulum. Nullam tincidunt sed quam a viverra. Cras eu nulla at dui varius cursus ut at turpis. Phasellus nec pellentesque nisi. Aenean est dolor, facilisis a eros eu, elementum sagittis nulla. Duis pulvinar sed augue nec fermentum. Duis eu malesuada justo, a porttitor urna. Aliquam justo mi, aliquam in sem sit amet, vulputate tristique felis. Donec molestie accumsan nunc a facilisis.`,
+				Language: "english",
 			},
 		},
 		{
@@ -301,8 +368,40 @@ Proin rutrum commodo tincidunt. Sed convallis risus ut justo egestas vestibulum.
 				Content: `Lorem ipsum dolor sit amet, consectetur adipiscing elit. Vestibulum tempor congue aliquam. Nam ullamcorper mi lectus, et dictum urna imperdiet a. Quisque venenatis hendrerit laoreet. Praesent egestas turpis imperdiet felis vulputate, a eleifend turpis luctus. Aliquam at varius metus, eu placerat orci. Pellentesque vel convallis nisl. Pellentesque porta tellus nec vulputate efficitur. Ut eleifend, quam ut ultricies vulputate, nibh felis sollicitudin ante, convallis tincidunt urna nisl sed erat.
 
 Proin rutrum commodo tincidunt. Sed convallis risus ut justo egestas vestibulum. Nullam tincidunt sed quam a viverra. Cras eu nulla at dui varius cursus ut at turpis. Phasellus nec pellentesque nisi. Aenean est dolor, facilisis a eros eu, elementum sagittis nulla. Duis pulvinar sed augue nec fermentum. Duis eu malesuada justo, a porttitor urna. Aliquam justo mi, aliquam in sem sit amet, vulputate tristique felis. Donec molestie accumsan nunc a facilisis.`,
+				Language: "french",
+			},
+		},
+		{
+			name:     "Success/Tags",
+			userID:   test_utils.NumberUUID(2000),
+			sourceID: test_utils.NumberUUID(1000),
+			title:    "FooBar Symphony",
+			content:  "Dummy content.",
+			tags:     []uuid.UUID{test_utils.NumberUUID(101)},
+			id:       test_utils.NumberUUID(2),
+			now:      baseTime,
+			expect: &Model{
+				ID:        test_utils.NumberUUID(2),
+				Source:    test_utils.NumberUUID(1000),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(2000),
+				Title:     "FooBar Symphony",
+				Content:   "Dummy content.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(101)},
+				Language:  "french",
 			},
 		},
+		{
+			name:      "Error/MissingTag",
+			userID:    test_utils.NumberUUID(2000),
+			sourceID:  test_utils.NumberUUID(1000),
+			title:     "FooBar Symphony",
+			content:   "Dummy content.",
+			tags:      []uuid.UUID{test_utils.NumberUUID(102)},
+			id:        test_utils.NumberUUID(2),
+			now:       baseTime,
+			expectErr: validation.ErrMissingRelation,
+		},
 		{
 			name:     "Error/Exists",
 			userID:   test_utils.NumberUUID(1),
@@ -361,7 +460,9 @@ Proin rutrum commodo tincidunt. Sed convallis risus ut justo egestas vestibulum.
 		},
 	}
 
-	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+	fixtures := test_utils.Concat(TagFixtures, []interface{}{Fixtures[0], Fixtures[1], Fixtures[2]})
+
+	err := test_utils.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
 		for _, d := range data {
 			t.Run(d.name, func(st *testing.T) {
 				stx, err := tx.Begin()
@@ -369,7 +470,7 @@ Proin rutrum commodo tincidunt. Sed convallis risus ut justo egestas vestibulum.
 				defer stx.Rollback()
 				repository := NewRepository(stx, 10)
 
-				res, err := repository.CreateRevision(ctx, d.userID, d.sourceID, d.title, d.content, d.id, d.now)
+				res, err := repository.CreateRevision(ctx, d.userID, d.sourceID, d.title, d.content, d.language, d.tags, d.id, d.now)
 				test_utils.RequireError(t, d.expectErr, err)
 				require.Equal(t, d.expect, res)
 			})
@@ -416,9 +517,243 @@ func TestImproveRequestRepository_Delete(t *testing.T) {
 				defer stx.Rollback()
 				repository := NewRepository(stx, 10)
 
-				err = repository.Delete(ctx, d.id)
+				err = repository.Delete(ctx, d.id, deleteTime)
 				test_utils.RequireError(t, d.expectErr, err)
 
+				count, err := stx.NewSelect().Model(new(Model)).Where("deleted_at IS NULL").Count(ctx)
+				require.NoError(st, err)
+				require.Equal(st, d.expectRows, count)
+
+				// Deleted revisions are kept.
+				count, err = stx.NewSelect().Model(new(Model)).Count(ctx)
+				require.NoError(st, err)
+				require.Equal(st, len(Fixtures), count)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+var DeletedFixtures = []interface{}{
+	// Deleted along with its revisions, except the last one which was deleted on its own before.
+	&Model{
+		ID:        test_utils.NumberUUID(1000),
+		CreatedAt: baseTime,
+		Source:    test_utils.NumberUUID(1000),
+		UserID:    test_utils.NumberUUID(2000),
+		Title:     "Test",
+		Content:   "Dummy content.",
+		Language:  "french",
+		DeletedAt: framework.ToPTR(deleteTime),
+	},
+	&Model{
+		ID:        test_utils.NumberUUID(1001),
+		CreatedAt: baseTime.Add(time.Minute),
+		Source:    test_utils.NumberUUID(1000),
+		UserID:    test_utils.NumberUUID(2001),
+		Title:     "Test",
+		Content:   "Dummy content updated.",
+		Language:  "french",
+		DeletedAt: framework.ToPTR(deleteTime),
+	},
+	&Model{
+		ID:        test_utils.NumberUUID(1002),
+		CreatedAt: baseTime.Add(10 * time.Minute),
+		Source:    test_utils.NumberUUID(1000),
+		UserID:    test_utils.NumberUUID(2000),
+		Title:     "New Test",
+		Content:   "Dummy content updated again.",
+		Language:  "french",
+		DeletedAt: framework.ToPTR(deleteTime.Add(-time.Hour)),
+	},
+	&Model{
+		ID:        test_utils.NumberUUID(5000),
+		CreatedAt: baseTime,
+		Source:    test_utils.NumberUUID(5000),
+		UserID:    test_utils.NumberUUID(3000),
+		Title:     "Lorem Ipsum",
+		Content:   "Lorem ipsum dolor sit amet.",
+		Language:  "french",
+	},
+	// Deleted, but still under review.
+	&Model{
+		ID:        test_utils.NumberUUID(6000),
+		CreatedAt: baseTime,
+		Source:    test_utils.NumberUUID(6000),
+		UserID:    test_utils.NumberUUID(2000),
+		Title:     "Reported",
+		Content:   "qwertyuiopasdfghjklzxcvbnm",
+		Language:  "french",
+		DeletedAt: framework.ToPTR(deleteTime),
+	},
+	&reports_storage.Case{
+		Target:    reports_storage.TargetImproveRequest,
+		TargetID:  test_utils.NumberUUID(6000),
+		Status:    reports_storage.StatusPending,
+		Reports:   1,
+		CreatedAt: baseTime,
+	},
+}
+
+func TestImproveRequestRepository_ReadDeleted(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		id uuid.UUID
+
+		expect    *Model
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			id:     test_utils.NumberUUID(1001),
+			expect: DeletedFixtures[1].(*Model),
+		},
+		{
+			name:      "Error/NotDeleted",
+			id:        test_utils.NumberUUID(5000),
+			expectErr: validation.ErrNotFound,
+		},
+		{
+			name:      "Error/NotFound",
+			id:        test_utils.NumberUUID(1010),
+			expectErr: validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, DeletedFixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx, 10)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.ReadDeleted(ctx, d.id)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveRequestRepository_Restore(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		id           uuid.UUID
+		deletedAfter time.Time
+
+		expectRestored []uuid.UUID
+		expectErr      error
+	}{
+		{
+			name:           "Success",
+			id:             test_utils.NumberUUID(1000),
+			deletedAfter:   deleteTime.Add(-time.Minute),
+			expectRestored: []uuid.UUID{test_utils.NumberUUID(1000), test_utils.NumberUUID(1001)},
+		},
+		{
+			name:           "Success/Revision",
+			id:             test_utils.NumberUUID(1002),
+			deletedAfter:   deleteTime.Add(-2 * time.Hour),
+			expectRestored: []uuid.UUID{test_utils.NumberUUID(1002)},
+		},
+		{
+			name:         "Error/Expired",
+			id:           test_utils.NumberUUID(1000),
+			deletedAfter: deleteTime.Add(time.Minute),
+			expectErr:    validation.ErrNotFound,
+		},
+		{
+			name:         "Error/NotDeleted",
+			id:           test_utils.NumberUUID(5000),
+			deletedAfter: deleteTime.Add(-time.Minute),
+			expectErr:    validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, DeletedFixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx, 10)
+				err = repository.Restore(ctx, d.id, d.deletedAfter)
+				test_utils.RequireError(st, d.expectErr, err)
+
+				restored := make([]uuid.UUID, 0)
+				err = stx.NewSelect().
+					Model((*Model)(nil)).
+					Column("id").
+					Where("deleted_at IS NULL").
+					Where("id <> ?", test_utils.NumberUUID(5000)).
+					Order("id").
+					Scan(ctx, &restored)
+				require.NoError(st, err)
+
+				if d.expectRestored == nil {
+					d.expectRestored = []uuid.UUID{}
+				}
+				require.Equal(st, d.expectRestored, restored)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveRequestRepository_Purge(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		deletedBefore time.Time
+
+		expect     int64
+		expectRows int
+	}{
+		{
+			name:          "Success",
+			deletedBefore: deleteTime.Add(time.Minute),
+			expect:        3,
+			expectRows:    2,
+		},
+		{
+			name:          "Success/Partial",
+			deletedBefore: deleteTime,
+			expect:        1,
+			expectRows:    4,
+		},
+		{
+			name:          "Success/Nothing",
+			deletedBefore: baseTime,
+			expectRows:    5,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, DeletedFixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx, 10)
+				res, err := repository.Purge(ctx, d.deletedBefore)
+				require.NoError(st, err)
+				require.Equal(st, d.expect, res)
+
 				count, err := stx.NewSelect().Model(new(Model)).Count(ctx)
 				require.NoError(st, err)
 				require.Equal(st, d.expectRows, count)
@@ -428,6 +763,123 @@ func TestImproveRequestRepository_Delete(t *testing.T) {
 	require.NoError(t, err)
 }
 
+var SearchFixtures = test_utils.Concat(TagFixtures, []interface{}{
+	// Corpus 1.
+	&Model{
+		ID:        test_utils.NumberUUID(1000),
+		CreatedAt: baseTime,
+		UserID:    test_utils.NumberUUID(2000),
+		Source:    test_utils.NumberUUID(1000),
+		UpVotes:   10,
+		Title:     "Calixte dans la lumière",
+		Content:   "Aussi, quand il rencontra Sombreval et sa fille, fut-il frappé d'un éblouissement qui ne venait pas seulement de l'incroyablement beauté nitescente Calixte, marchant dans l'éclat solaire.",
+	},
+	&Model{
+		ID:        test_utils.NumberUUID(1001),
+		CreatedAt: baseTime.Add(5 * time.Minute),
+		UserID:    test_utils.NumberUUID(3000),
+		Source:    test_utils.NumberUUID(1000),
+		UpVotes:   7,
+		DownVotes: 2,
+		Title:     "Calixte dans la lumière",
+		Content:   "Aussi, quand il rencontra Sombreval et sa fille traversant le cimetière, fut-il frappé d'un éblouissement qui ne venait pas seulement de la beauté nitescente de Calixte, marchant dans l'éclat solaire d'un jour d'été.",
+	},
+	&Model{
+		ID:        test_utils.NumberUUID(1002),
+		CreatedAt: baseTime.Add(10 * time.Minute),
+		UserID:    test_utils.NumberUUID(2000),
+		Source:    test_utils.NumberUUID(1000),
+		UpVotes:   21,
+		DownVotes: 8,
+		Title:     "Coup de foudre au premier regard",
+		Content:   "Aussi, quand il rencontra Sombreval et sa fille traversant le cimetière, fut-il frappé d'un éblouissement qui ne venait pas seulement de la beauté nitescente de Calixte, marchant dans l'éclat solaire d'un jour d'été.",
+	},
+	// Corpus 2.
+	&Model{
+		ID:        test_utils.NumberUUID(2000),
+		CreatedAt: baseTime.Add(2 * time.Minute),
+		UserID:    test_utils.NumberUUID(2000),
+		Source:    test_utils.NumberUUID(2000),
+		UpVotes:   4,
+		DownVotes: 1,
+		Title:     "Beauté nitescente dans la nuit",
+		Content:   "Une mère dont la vie n'a de sens que l'existence de son fils voit son monde basculer dans les méandres incertains d'une autre réalité. Là, règne une guerre, cachée aux yeux des mortels, entre des créatures mythiques dont seuls ses rêves pouvaient lui souffler l'existence.",
+	},
+	// Corpus 3.
+	&Model{
+		ID:        test_utils.NumberUUID(3000),
+		CreatedAt: baseTime.Add(3 * time.Minute),
+		UserID:    test_utils.NumberUUID(3000),
+		Source:    test_utils.NumberUUID(3000),
+		UpVotes:   34,
+		DownVotes: 52,
+		Title:     "Fascination étrange",
+		Content:   "Alors que ses paupières s'alourdissaient, se mirent à danser devant elle les arabesques à la beauté nitescente des esprits de la nuit, envoutantes et menaçantes à la fois. Comme si la réalité perdait de son sens.",
+	},
+	&Model{
+		ID:        test_utils.NumberUUID(3001),
+		CreatedAt: baseTime.Add(4 * time.Minute),
+		UserID:    test_utils.NumberUUID(2000),
+		Source:    test_utils.NumberUUID(3000),
+		UpVotes:   11,
+		DownVotes: 3,
+		Title:     "Fascination étrange",
+		Content:   "Alors que ses paupières s'alourdissaient, se mirent à danser devant elle les arabesques nitescentes des esprits de la nuit, envoutantes et menaçantes à la fois. Comme si la réalité perdait de son sens.",
+	},
+	// Corpus 4.
+	&Model{
+		ID:        test_utils.NumberUUID(4000),
+		CreatedAt: baseTime.Add(7 * time.Minute),
+		UserID:    test_utils.NumberUUID(5000),
+		Source:    test_utils.NumberUUID(4000),
+		UpVotes:   17,
+		DownVotes: 6,
+		Title:     "Lois robotiques",
+		Content:   "Les trois Lois constituent les principes directeurs essentiels d'une grande partie des systèmes moraux du monde. Evidemment, chaque être humain possède, en principe, l'instinct de conservation. C'est la Troisième Loi de la robotique. De même, chacun des bons êtres humains, possédant une conscience sociale et le sens de la responsabilité, doit obéir aux autorités établies, écouter son docteur, son patron, son gouvernement, son psychiatre, son semblable... même lorsque ceux-ci troublent son confort ou sa sécurité. C'est ce qui correspond à la Seconde Loi de la robotique. Chaque bon humain doit également aimer son prochain comme lui-même, risquer sa vie pour sauver celle d'un autre. Telle est la Première Loi de la robotique.",
+	},
+	// Tags.
+	&RequestTag{RequestID: test_utils.NumberUUID(1000), TagID: test_utils.NumberUUID(101)},
+	&RequestTag{RequestID: test_utils.NumberUUID(1002), TagID: test_utils.NumberUUID(100)},
+	&RequestTag{RequestID: test_utils.NumberUUID(2000), TagID: test_utils.NumberUUID(100)},
+	&RequestTag{RequestID: test_utils.NumberUUID(2000), TagID: test_utils.NumberUUID(101)},
+	// Reactions, counted on every revision.
+	&votes_storage.Reaction{
+		PostID:    test_utils.NumberUUID(1000),
+		Target:    votes_storage.TargetImproveRequest,
+		Reaction:  "moving",
+		UserID:    test_utils.NumberUUID(100),
+		CreatedAt: baseTime,
+	},
+	&votes_storage.Reaction{
+		PostID:    test_utils.NumberUUID(1002),
+		Target:    votes_storage.TargetImproveRequest,
+		Reaction:  "moving",
+		UserID:    test_utils.NumberUUID(101),
+		CreatedAt: baseTime,
+	},
+	&votes_storage.Reaction{
+		PostID:    test_utils.NumberUUID(1002),
+		Target:    votes_storage.TargetImproveRequest,
+		Reaction:  "funny",
+		UserID:    test_utils.NumberUUID(101),
+		CreatedAt: baseTime,
+	},
+	&votes_storage.Reaction{
+		PostID:    test_utils.NumberUUID(2000),
+		Target:    votes_storage.TargetImproveRequest,
+		Reaction:  "funny",
+		UserID:    test_utils.NumberUUID(100),
+		CreatedAt: baseTime,
+	},
+	// States.
+	&thread_state_storage.Model{
+		Source:    test_utils.NumberUUID(2000),
+		State:     thread_state_storage.StateClosed,
+		UpdatedAt: baseTime.Add(20 * time.Minute),
+		UpdatedBy: framework.ToPTR(test_utils.NumberUUID(2000)),
+	},
+})
+
 func TestImproveRequestRepository_Search(t *testing.T) {
 	db, sqlDB := test_utils.GetPostgres(t)
 	defer db.Close()
@@ -465,6 +917,8 @@ func TestImproveRequestRepository_Search(t *testing.T) {
 					Content:       "Une mère d",
 					UpVotes:       4,
 					DownVotes:     1,
+					Tags:          []uuid.UUID{test_utils.NumberUUID(100), test_utils.NumberUUID(101)},
+					Reactions:     map[string]int64{"funny": 1},
 					RevisionCount: 1,
 				},
 				// Only last revision
@@ -477,27 +931,229 @@ func TestImproveRequestRepository_Search(t *testing.T) {
 					Content:       "Aussi, qua",
 					UpVotes:       38,
 					DownVotes:     10,
+					Tags:          []uuid.UUID{test_utils.NumberUUID(100)},
+					Reactions:     map[string]int64{"moving": 2, "funny": 1},
 					RevisionCount: 3,
 				},
 			},
 		},
 		{
-			name:        "Success/NoQuery",
-			query:       SearchQuery{},
-			expectCount: 4,
-			limit:       2,
-			offset:      1,
-			// Ordered by date.
+			name: "Success/States",
+			query: SearchQuery{
+				Query:  "beau nitescence",
+				States: []string{"closed", "archived"},
+			},
+			expectCount: 1,
+			limit:       10,
+			offset:      0,
 			expect: []*Preview{
 				{
-					ID:            test_utils.NumberUUID(4000),
-					CreatedAt:     baseTime.Add(7 * time.Minute),
-					UserID:        test_utils.NumberUUID(5000),
-					Source:        test_utils.NumberUUID(4000),
+					ID:            test_utils.NumberUUID(2000),
+					CreatedAt:     baseTime.Add(2 * time.Minute),
+					UserID:        test_utils.NumberUUID(2000),
+					Source:        test_utils.NumberUUID(2000),
+					Title:         "Beauté nitescente dans la nuit",
+					Content:       "Une mère d",
+					UpVotes:       4,
+					DownVotes:     1,
+					Tags:          []uuid.UUID{test_utils.NumberUUID(100), test_utils.NumberUUID(101)},
+					Reactions:     map[string]int64{"funny": 1},
+					RevisionCount: 1,
+				},
+			},
+		},
+		{
+			name: "Success/States/DefaultOpen",
+			query: SearchQuery{
+				Query:  "beau nitescence",
+				States: []string{"open"},
+			},
+			expectCount: 1,
+			limit:       10,
+			offset:      0,
+			expect: []*Preview{
+				{
+					ID:            test_utils.NumberUUID(1002),
+					CreatedAt:     baseTime.Add(10 * time.Minute),
+					UserID:        test_utils.NumberUUID(2000),
+					Source:        test_utils.NumberUUID(1000),
+					Title:         "Coup de foudre au premier regard",
+					Content:       "Aussi, qua",
+					UpVotes:       38,
+					DownVotes:     10,
+					Tags:          []uuid.UUID{test_utils.NumberUUID(100)},
+					Reactions:     map[string]int64{"moving": 2, "funny": 1},
+					RevisionCount: 3,
+				},
+			},
+		},
+		{
+			name: "Success/Tags",
+			query: SearchQuery{
+				Tags: []uuid.UUID{test_utils.NumberUUID(100)},
+			},
+			expectCount: 2,
+			limit:       10,
+			offset:      0,
+			expect: []*Preview{
+				{
+					ID:            test_utils.NumberUUID(1002),
+					CreatedAt:     baseTime.Add(10 * time.Minute),
+					UserID:        test_utils.NumberUUID(2000),
+					Source:        test_utils.NumberUUID(1000),
+					Title:         "Coup de foudre au premier regard",
+					Content:       "Aussi, qua",
+					UpVotes:       38,
+					DownVotes:     10,
+					Tags:          []uuid.UUID{test_utils.NumberUUID(100)},
+					Reactions:     map[string]int64{"moving": 2, "funny": 1},
+					RevisionCount: 3,
+				},
+				{
+					ID:            test_utils.NumberUUID(2000),
+					CreatedAt:     baseTime.Add(2 * time.Minute),
+					UserID:        test_utils.NumberUUID(2000),
+					Source:        test_utils.NumberUUID(2000),
+					Title:         "Beauté nitescente dans la nuit",
+					Content:       "Une mère d",
+					UpVotes:       4,
+					DownVotes:     1,
+					Tags:          []uuid.UUID{test_utils.NumberUUID(100), test_utils.NumberUUID(101)},
+					Reactions:     map[string]int64{"funny": 1},
+					RevisionCount: 1,
+				},
+			},
+		},
+		{
+			name: "Success/AllTags",
+			query: SearchQuery{
+				// Tag 101 is also set on an older revision of source 1000, which must be ignored.
+				Tags: []uuid.UUID{test_utils.NumberUUID(101), test_utils.NumberUUID(100)},
+			},
+			expectCount: 1,
+			limit:       10,
+			offset:      0,
+			expect: []*Preview{
+				{
+					ID:            test_utils.NumberUUID(2000),
+					CreatedAt:     baseTime.Add(2 * time.Minute),
+					UserID:        test_utils.NumberUUID(2000),
+					Source:        test_utils.NumberUUID(2000),
+					Title:         "Beauté nitescente dans la nuit",
+					Content:       "Une mère d",
+					UpVotes:       4,
+					DownVotes:     1,
+					Tags:          []uuid.UUID{test_utils.NumberUUID(100), test_utils.NumberUUID(101)},
+					Reactions:     map[string]int64{"funny": 1},
+					RevisionCount: 1,
+				},
+			},
+		},
+		{
+			name: "Success/Reactions",
+			query: SearchQuery{
+				// Reactions may have been cast on any revision.
+				Reactions: []string{"moving", "funny", "moving"},
+			},
+			expectCount: 1,
+			limit:       10,
+			offset:      0,
+			expect: []*Preview{
+				{
+					ID:            test_utils.NumberUUID(1002),
+					CreatedAt:     baseTime.Add(10 * time.Minute),
+					UserID:        test_utils.NumberUUID(2000),
+					Source:        test_utils.NumberUUID(1000),
+					Title:         "Coup de foudre au premier regard",
+					Content:       "Aussi, qua",
+					UpVotes:       38,
+					DownVotes:     10,
+					Tags:          []uuid.UUID{test_utils.NumberUUID(100)},
+					Reactions:     map[string]int64{"moving": 2, "funny": 1},
+					RevisionCount: 3,
+				},
+			},
+		},
+		{
+			name:        "Success/NoQuery",
+			query:       SearchQuery{},
+			expectCount: 4,
+			limit:       2,
+			offset:      1,
+			// Ordered by date.
+			expect: []*Preview{
+				{
+					ID:            test_utils.NumberUUID(4000),
+					CreatedAt:     baseTime.Add(7 * time.Minute),
+					UserID:        test_utils.NumberUUID(5000),
+					Source:        test_utils.NumberUUID(4000),
+					UpVotes:       17,
+					DownVotes:     6,
+					Title:         "Lois robotiques",
+					Content:       "Les trois ",
+					Reactions:     map[string]int64{},
+					RevisionCount: 1,
+				},
+				{
+					ID:            test_utils.NumberUUID(3001),
+					CreatedAt:     baseTime.Add(4 * time.Minute),
+					UserID:        test_utils.NumberUUID(2000),
+					Source:        test_utils.NumberUUID(3000),
+					UpVotes:       45,
+					DownVotes:     55,
+					Title:         "Fascination étrange",
+					Content:       "Alors que ",
+					Reactions:     map[string]int64{},
+					RevisionCount: 2,
+				},
+			},
+		},
+		{
+			name: "Success/Best",
+			query: SearchQuery{
+				Order: &SearchQueryOrder{Best: true},
+			},
+			expectCount: 4,
+			limit:       10,
+			offset:      0,
+			// 38/48 positive votes rank above 4/5, despite a lower ratio, because they are more reliable.
+			expect: []*Preview{
+				{
+					ID:            test_utils.NumberUUID(1002),
+					CreatedAt:     baseTime.Add(10 * time.Minute),
+					UserID:        test_utils.NumberUUID(2000),
+					Source:        test_utils.NumberUUID(1000),
+					Title:         "Coup de foudre au premier regard",
+					Content:       "Aussi, qua",
+					UpVotes:       38,
+					DownVotes:     10,
+					Tags:          []uuid.UUID{test_utils.NumberUUID(100)},
+					Reactions:     map[string]int64{"moving": 2, "funny": 1},
+					RevisionCount: 3,
+				},
+				{
+					ID:            test_utils.NumberUUID(4000),
+					CreatedAt:     baseTime.Add(7 * time.Minute),
+					UserID:        test_utils.NumberUUID(5000),
+					Source:        test_utils.NumberUUID(4000),
 					UpVotes:       17,
 					DownVotes:     6,
 					Title:         "Lois robotiques",
 					Content:       "Les trois ",
+					Reactions:     map[string]int64{},
+					RevisionCount: 1,
+				},
+				{
+					ID:            test_utils.NumberUUID(2000),
+					CreatedAt:     baseTime.Add(2 * time.Minute),
+					UserID:        test_utils.NumberUUID(2000),
+					Source:        test_utils.NumberUUID(2000),
+					Title:         "Beauté nitescente dans la nuit",
+					Content:       "Une mère d",
+					UpVotes:       4,
+					DownVotes:     1,
+					Tags:          []uuid.UUID{test_utils.NumberUUID(100), test_utils.NumberUUID(101)},
+					Reactions:     map[string]int64{"funny": 1},
 					RevisionCount: 1,
 				},
 				{
@@ -505,10 +1161,34 @@ func TestImproveRequestRepository_Search(t *testing.T) {
 					CreatedAt:     baseTime.Add(4 * time.Minute),
 					UserID:        test_utils.NumberUUID(2000),
 					Source:        test_utils.NumberUUID(3000),
+					Title:         "Fascination étrange",
+					Content:       "Alors que ",
 					UpVotes:       45,
 					DownVotes:     55,
+					Reactions:     map[string]int64{},
+					RevisionCount: 2,
+				},
+			},
+		},
+		{
+			name: "Success/Typo",
+			query: SearchQuery{
+				Query: "fascinasion",
+			},
+			expectCount: 1,
+			limit:       10,
+			offset:      0,
+			expect: []*Preview{
+				{
+					ID:            test_utils.NumberUUID(3001),
+					CreatedAt:     baseTime.Add(4 * time.Minute),
+					UserID:        test_utils.NumberUUID(2000),
+					Source:        test_utils.NumberUUID(3000),
 					Title:         "Fascination étrange",
 					Content:       "Alors que ",
+					UpVotes:       45,
+					DownVotes:     55,
+					Reactions:     map[string]int64{},
 					RevisionCount: 2,
 				},
 			},
@@ -533,6 +1213,7 @@ func TestImproveRequestRepository_Search(t *testing.T) {
 					Content:             "Aussi, qua",
 					UpVotes:             38,
 					DownVotes:           10,
+					Reactions:           map[string]int64{"moving": 2, "funny": 1},
 					RevisionCount:       3,
 					MoreRecentRevisions: 1,
 				},
@@ -546,6 +1227,7 @@ func TestImproveRequestRepository_Search(t *testing.T) {
 					Content:             "Alors que ",
 					UpVotes:             45,
 					DownVotes:           55,
+					Reactions:           map[string]int64{},
 					RevisionCount:       2,
 					MoreRecentRevisions: 1,
 				},
@@ -553,91 +1235,585 @@ func TestImproveRequestRepository_Search(t *testing.T) {
 		},
 	}
 
-	fixtures := []interface{}{
-		// Corpus 1.
-		&Model{
-			ID:        test_utils.NumberUUID(1000),
-			CreatedAt: baseTime,
-			UserID:    test_utils.NumberUUID(2000),
-			Source:    test_utils.NumberUUID(1000),
-			UpVotes:   10,
-			Title:     "Calixte dans la lumière",
-			Content:   "Aussi, quand il rencontra Sombreval et sa fille, fut-il frappé d'un éblouissement qui ne venait pas seulement de l'incroyablement beauté nitescente Calixte, marchant dans l'éclat solaire.",
-		},
-		&Model{
-			ID:        test_utils.NumberUUID(1001),
-			CreatedAt: baseTime.Add(5 * time.Minute),
-			UserID:    test_utils.NumberUUID(3000),
-			Source:    test_utils.NumberUUID(1000),
-			UpVotes:   7,
-			DownVotes: 2,
-			Title:     "Calixte dans la lumière",
-			Content:   "Aussi, quand il rencontra Sombreval et sa fille traversant le cimetière, fut-il frappé d'un éblouissement qui ne venait pas seulement de la beauté nitescente de Calixte, marchant dans l'éclat solaire d'un jour d'été.",
-		},
-		&Model{
-			ID:        test_utils.NumberUUID(1002),
-			CreatedAt: baseTime.Add(10 * time.Minute),
-			UserID:    test_utils.NumberUUID(2000),
-			Source:    test_utils.NumberUUID(1000),
-			UpVotes:   21,
-			DownVotes: 8,
-			Title:     "Coup de foudre au premier regard",
-			Content:   "Aussi, quand il rencontra Sombreval et sa fille traversant le cimetière, fut-il frappé d'un éblouissement qui ne venait pas seulement de la beauté nitescente de Calixte, marchant dans l'éclat solaire d'un jour d'été.",
-		},
-		// Corpus 2.
-		&Model{
-			ID:        test_utils.NumberUUID(2000),
-			CreatedAt: baseTime.Add(2 * time.Minute),
-			UserID:    test_utils.NumberUUID(2000),
-			Source:    test_utils.NumberUUID(2000),
-			UpVotes:   4,
-			DownVotes: 1,
-			Title:     "Beauté nitescente dans la nuit",
-			Content:   "Une mère dont la vie n'a de sens que l'existence de son fils voit son monde basculer dans les méandres incertains d'une autre réalité. Là, règne une guerre, cachée aux yeux des mortels, entre des créatures mythiques dont seuls ses rêves pouvaient lui souffler l'existence.",
-		},
-		// Corpus 3.
-		&Model{
-			ID:        test_utils.NumberUUID(3000),
-			CreatedAt: baseTime.Add(3 * time.Minute),
-			UserID:    test_utils.NumberUUID(3000),
-			Source:    test_utils.NumberUUID(3000),
-			UpVotes:   34,
-			DownVotes: 52,
-			Title:     "Fascination étrange",
-			Content:   "Alors que ses paupières s'alourdissaient, se mirent à danser devant elle les arabesques à la beauté nitescente des esprits de la nuit, envoutantes et menaçantes à la fois. Comme si la réalité perdait de son sens.",
-		},
-		&Model{
-			ID:        test_utils.NumberUUID(3001),
-			CreatedAt: baseTime.Add(4 * time.Minute),
-			UserID:    test_utils.NumberUUID(2000),
-			Source:    test_utils.NumberUUID(3000),
-			UpVotes:   11,
-			DownVotes: 3,
-			Title:     "Fascination étrange",
-			Content:   "Alors que ses paupières s'alourdissaient, se mirent à danser devant elle les arabesques nitescentes des esprits de la nuit, envoutantes et menaçantes à la fois. Comme si la réalité perdait de son sens.",
-		},
-		// Corpus 4.
-		&Model{
-			ID:        test_utils.NumberUUID(4000),
-			CreatedAt: baseTime.Add(7 * time.Minute),
-			UserID:    test_utils.NumberUUID(5000),
-			Source:    test_utils.NumberUUID(4000),
-			UpVotes:   17,
-			DownVotes: 6,
-			Title:     "Lois robotiques",
-			Content:   "Les trois Lois constituent les principes directeurs essentiels d'une grande partie des systèmes moraux du monde. Evidemment, chaque être humain possède, en principe, l'instinct de conservation. C'est la Troisième Loi de la robotique. De même, chacun des bons êtres humains, possédant une conscience sociale et le sens de la responsabilité, doit obéir aux autorités établies, écouter son docteur, son patron, son gouvernement, son psychiatre, son semblable... même lorsque ceux-ci troublent son confort ou sa sécurité. C'est ce qui correspond à la Seconde Loi de la robotique. Chaque bon humain doit également aimer son prochain comme lui-même, risquer sa vie pour sauver celle d'un autre. Telle est la Première Loi de la robotique.",
+	err := test_utils.RunTransactionalTest(db, SearchFixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx, 10)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, count, err := repository.Search(ctx, d.query, d.limit, d.offset)
+				test_utils.RequireError(t, d.expectErr, err)
+				require.Equal(t, d.expect, res)
+				require.Equal(t, d.expectCount, count)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveRequestRepository_SearchAfter(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		query SearchQuery
+	}{
+		{
+			name: "Success",
 		},
+		{
+			name: "Success/Query",
+			query: SearchQuery{
+				Query: "beau nitescence",
+			},
+		},
+		{
+			name: "Success/Score",
+			query: SearchQuery{
+				Order: &SearchQueryOrder{Score: true},
+			},
+		},
+		{
+			name: "Success/Best",
+			query: SearchQuery{
+				Order: &SearchQueryOrder{Best: true},
+			},
+		},
+		{
+			name: "Success/Hot",
+			query: SearchQuery{
+				Order: &SearchQueryOrder{Hot: true},
+			},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, SearchFixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx, 10)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				expect, _, err := repository.Search(ctx, d.query, 100, 0)
+				require.NoError(st, err)
+
+				// Reading the results one by one must return the same results as a single page, in the same order.
+				var (
+					res    []*Preview
+					cursor string
+				)
+				for i := 0; i <= len(expect); i++ {
+					page, next, err := repository.SearchAfter(ctx, d.query, cursor, 1)
+					require.NoError(st, err)
+
+					res = append(res, page...)
+					cursor = next
+
+					if next == "" {
+						break
+					}
+				}
+
+				require.Equal(st, expect, res)
+				require.Empty(st, cursor)
+			})
+		}
+
+		t.Run("Error/InvalidCursor", func(st *testing.T) {
+			_, _, err := repository.SearchAfter(ctx, SearchQuery{}, "foo", 1)
+			require.ErrorIs(st, err, validation.ErrInvalidEntity)
+		})
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveRequestRepository_SearchBest(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	// Down votes cast on an older revision count against the whole post, and drop it to the last position.
+	var downVotes []interface{}
+	for i := 0; i < 60; i++ {
+		downVotes = append(downVotes, &votes_storage.Model{
+			UpdatedAt: baseTime,
+			PostID:    test_utils.NumberUUID(1000),
+			UserID:    test_utils.NumberUUID(10000 + i),
+			Target:    votes_storage.TargetImproveRequest,
+			Vote:      votes_storage.VoteDown,
+		})
+	}
+
+	fixtures := test_utils.Concat(SearchFixtures, downVotes)
+
+	getIDs := func(previews []*Preview) []uuid.UUID {
+		ids := make([]uuid.UUID, len(previews))
+		for i, preview := range previews {
+			ids[i] = preview.ID
+		}
+		return ids
 	}
 
 	err := test_utils.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
 		repository := NewRepository(tx, 10)
+		query := SearchQuery{Order: &SearchQueryOrder{Best: true}}
+
+		res, _, err := repository.Search(ctx, query, 10, 0)
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{
+			test_utils.NumberUUID(4000),
+			test_utils.NumberUUID(2000),
+			test_utils.NumberUUID(3001),
+			test_utils.NumberUUID(1002),
+		}, getIDs(res))
+		require.Equal(t, int64(70), res[3].DownVotes)
+
+		page, next, err := repository.SearchAfter(ctx, query, "", 2)
+		require.NoError(t, err)
+		require.Equal(t, res[:2], page)
+
+		page, _, err = repository.SearchAfter(ctx, query, next, 2)
+		require.NoError(t, err)
+		require.Equal(t, res[2:], page)
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveRequestRepository_Facets(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fantasy := TagFixtures[0].(*tags_storage.Model)
+	firstPerson := TagFixtures[1].(*tags_storage.Model)
+
+	// Revisions 1002 and 4000 were created less than a day ago. Request 1000 was first published earlier, so it is
+	// not counted in the last day.
+	now := baseTime.Add(24*time.Hour + 5*time.Minute)
+
+	data := []struct {
+		name string
+
+		query SearchQuery
+
+		expect    *Facets
+		expectErr error
+	}{
+		{
+			name:  "Success",
+			query: SearchQuery{},
+			expect: &Facets{
+				Tags: []*TagFacet{
+					{ID: fantasy.ID, Category: fantasy.Category, Slug: fantasy.Slug, Name: fantasy.Name, Count: 2},
+					{ID: firstPerson.ID, Category: firstPerson.Category, Slug: firstPerson.Slug, Name: firstPerson.Name, Count: 1},
+				},
+				Authors: []*AuthorFacet{
+					{UserID: test_utils.NumberUUID(2000), Count: 3},
+					{UserID: test_utils.NumberUUID(5000), Count: 1},
+				},
+				Accepted:  AcceptedFacet{Without: 4},
+				CreatedAt: CreatedAtFacet{LastDay: 1, LastWeek: 3},
+				Revisions: RevisionsFacet{Single: 2, Few: 2},
+			},
+		},
+		{
+			name: "Success/Tags",
+			query: SearchQuery{
+				Tags: []uuid.UUID{test_utils.NumberUUID(101)},
+			},
+			expect: &Facets{
+				Tags: []*TagFacet{
+					{ID: fantasy.ID, Category: fantasy.Category, Slug: fantasy.Slug, Name: fantasy.Name, Count: 1},
+					{ID: firstPerson.ID, Category: firstPerson.Category, Slug: firstPerson.Slug, Name: firstPerson.Name, Count: 1},
+				},
+				Authors: []*AuthorFacet{
+					{UserID: test_utils.NumberUUID(2000), Count: 1},
+				},
+				Accepted:  AcceptedFacet{Without: 1},
+				CreatedAt: CreatedAtFacet{LastWeek: 1},
+				Revisions: RevisionsFacet{Single: 1},
+			},
+		},
+		{
+			name: "Success/NoResults",
+			query: SearchQuery{
+				Query: "xylophone",
+			},
+			expect: &Facets{
+				Tags:    []*TagFacet{},
+				Authors: []*AuthorFacet{},
+			},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, SearchFixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx, 10)
 
 		for _, d := range data {
 			t.Run(d.name, func(st *testing.T) {
-				res, count, err := repository.Search(ctx, d.query, d.limit, d.offset)
+				res, err := repository.Facets(ctx, d.query, now)
 				test_utils.RequireError(t, d.expectErr, err)
 				require.Equal(t, d.expect, res)
-				require.Equal(t, d.expectCount, count)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveRequestRepository_Suggest(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		query string
+		limit int
+
+		expect    []string
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			query:  "fascinasion etrange",
+			limit:  5,
+			expect: []string{"Fascination étrange"},
+		},
+		{
+			name:   "Success/OnlyLatestTitles",
+			query:  "calixte",
+			limit:  5,
+			expect: []string{},
+		},
+		{
+			name:   "Success/Limit",
+			query:  "coup de foudr",
+			limit:  1,
+			expect: []string{"Coup de foudre au premier regard"},
+		},
+		{
+			name:   "Success/NoResults",
+			query:  "xylophone",
+			limit:  5,
+			expect: []string{},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, SearchFixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx, 10)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.Suggest(ctx, d.query, d.limit)
+				test_utils.RequireError(t, d.expectErr, err)
+				require.Equal(t, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveRequestRepository_RefreshRankings(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	// Hot scores only account for the recent activity.
+	now := time.Now().UTC()
+
+	fixtures := test_utils.Concat(SearchFixtures, []interface{}{
+		&votes_storage.Model{
+			UpdatedAt: now.Add(-time.Hour),
+			PostID:    test_utils.NumberUUID(2000),
+			UserID:    test_utils.NumberUUID(100),
+			Target:    votes_storage.TargetImproveRequest,
+			Vote:      votes_storage.VoteUp,
+		},
+		&votes_storage.Model{
+			UpdatedAt: now.Add(-2 * time.Hour),
+			PostID:    test_utils.NumberUUID(2000),
+			UserID:    test_utils.NumberUUID(101),
+			Target:    votes_storage.TargetImproveRequest,
+			Vote:      votes_storage.VoteUp,
+		},
+		// Votes on older revisions count for the whole post.
+		&votes_storage.Model{
+			UpdatedAt: now.Add(-time.Hour),
+			PostID:    test_utils.NumberUUID(3000),
+			UserID:    test_utils.NumberUUID(100),
+			Target:    votes_storage.TargetImproveRequest,
+			Vote:      votes_storage.VoteDown,
+		},
+		// Too old to be accounted for.
+		&votes_storage.Model{
+			UpdatedAt: baseTime,
+			PostID:    test_utils.NumberUUID(4000),
+			UserID:    test_utils.NumberUUID(100),
+			Target:    votes_storage.TargetImproveRequest,
+			Vote:      votes_storage.VoteUp,
+		},
+	})
+
+	getIDs := func(previews []*Preview) []uuid.UUID {
+		ids := make([]uuid.UUID, len(previews))
+		for i, preview := range previews {
+			ids[i] = preview.ID
+		}
+		return ids
+	}
+
+	err := test_utils.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx, 10)
+		query := SearchQuery{Order: &SearchQueryOrder{Hot: true}}
+
+		// Rankings are not computed yet, so posts are ordered by date.
+		res, _, err := repository.Search(ctx, query, 10, 0)
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{
+			test_utils.NumberUUID(1002),
+			test_utils.NumberUUID(4000),
+			test_utils.NumberUUID(3001),
+			test_utils.NumberUUID(2000),
+		}, getIDs(res))
+
+		require.NoError(t, repository.RefreshRankings(ctx))
+
+		res, _, err = repository.Search(ctx, query, 10, 0)
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{
+			test_utils.NumberUUID(2000),
+			test_utils.NumberUUID(1002),
+			test_utils.NumberUUID(4000),
+			test_utils.NumberUUID(3001),
+		}, getIDs(res))
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveRequestRepository_Related(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := test_utils.Concat(SearchFixtures, []interface{}{
+		// A user voting on both posts brings them closer.
+		&votes_storage.Model{
+			UpdatedAt: baseTime,
+			PostID:    test_utils.NumberUUID(1000),
+			UserID:    test_utils.NumberUUID(100),
+			Target:    votes_storage.TargetImproveRequest,
+			Vote:      votes_storage.VoteUp,
+		},
+		&votes_storage.Model{
+			UpdatedAt: baseTime,
+			PostID:    test_utils.NumberUUID(3001),
+			UserID:    test_utils.NumberUUID(100),
+			Target:    votes_storage.TargetImproveRequest,
+			Vote:      votes_storage.VoteUp,
+		},
+	})
+
+	getSources := func(previews []*Preview) []uuid.UUID {
+		sources := make([]uuid.UUID, len(previews))
+		for i, preview := range previews {
+			sources[i] = preview.Source
+		}
+		return sources
+	}
+
+	isCached := func(ctx context.Context, tx bun.Tx, source uuid.UUID) bool {
+		cached, err := tx.NewSelect().Model((*Related)(nil)).Where("source = ?", source).Exists(ctx)
+		require.NoError(t, err)
+		return cached
+	}
+
+	err := test_utils.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx, 10)
+
+		require.False(t, isCached(ctx, tx, test_utils.NumberUUID(1000)))
+
+		// Any revision leads to the same results.
+		res, err := repository.Related(ctx, test_utils.NumberUUID(1001), MaxRelated, baseTime)
+		require.NoError(t, err)
+		require.NotEmpty(t, res)
+		require.NotContains(t, getSources(res), test_utils.NumberUUID(1000))
+		require.Equal(t, test_utils.NumberUUID(2000), res[0].Source)
+		require.Equal(t, test_utils.NumberUUID(2000), res[0].ID)
+		require.Contains(t, getSources(res), test_utils.NumberUUID(3000))
+		for _, preview := range res {
+			require.Zero(t, preview.MoreRecentRevisions)
+		}
+
+		require.True(t, isCached(ctx, tx, test_utils.NumberUUID(1000)))
+
+		cachedRes, err := repository.Related(ctx, test_utils.NumberUUID(1002), MaxRelated, baseTime)
+		require.NoError(t, err)
+		require.Equal(t, res, cachedRes)
+
+		limitedRes, err := repository.Related(ctx, test_utils.NumberUUID(1002), 1, baseTime)
+		require.NoError(t, err)
+		require.Equal(t, res[:1], limitedRes)
+
+		// A new revision of a related post invalidates the cache.
+		_, err = repository.CreateRevision(
+			ctx,
+			test_utils.NumberUUID(2000),
+			test_utils.NumberUUID(2000),
+			"Lois robotiques",
+			"Les trois Lois de la robotique.",
+			"french",
+			nil,
+			test_utils.NumberUUID(2001),
+			baseTime.Add(time.Hour),
+		)
+		require.NoError(t, err)
+		require.False(t, isCached(ctx, tx, test_utils.NumberUUID(1000)))
+
+		// A new post sharing a tag invalidates the cache as well.
+		_, err = repository.Related(ctx, test_utils.NumberUUID(1000), MaxRelated, baseTime)
+		require.NoError(t, err)
+		require.True(t, isCached(ctx, tx, test_utils.NumberUUID(1000)))
+
+		_, err = repository.Create(
+			ctx,
+			test_utils.NumberUUID(2000),
+			"Lois robotiques",
+			"Les trois Lois de la robotique.",
+			"french",
+			[]uuid.UUID{test_utils.NumberUUID(100)},
+			test_utils.NumberUUID(7000),
+			baseTime.Add(2*time.Hour),
+		)
+		require.NoError(t, err)
+		require.False(t, isCached(ctx, tx, test_utils.NumberUUID(1000)))
+
+		// Posts that share no tag with the new one keep their cache.
+		_, err = repository.Related(ctx, test_utils.NumberUUID(4000), MaxRelated, baseTime)
+		require.NoError(t, err)
+		require.True(t, isCached(ctx, tx, test_utils.NumberUUID(4000)))
+
+		_, err = repository.Create(
+			ctx,
+			test_utils.NumberUUID(2000),
+			"Lois robotiques",
+			"Les trois Lois de la robotique.",
+			"french",
+			[]uuid.UUID{test_utils.NumberUUID(101)},
+			test_utils.NumberUUID(7001),
+			baseTime.Add(3*time.Hour),
+		)
+		require.NoError(t, err)
+		require.True(t, isCached(ctx, tx, test_utils.NumberUUID(4000)))
+
+		_, err = repository.Related(ctx, test_utils.NumberUUID(10), MaxRelated, baseTime)
+		require.ErrorIs(t, err, validation.ErrNotFound)
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveRequestRepository_Related_Invalidation(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	isCached := func(ctx context.Context, tx bun.Tx, source uuid.UUID) bool {
+		cached, err := tx.NewSelect().Model((*Related)(nil)).Where("source = ?", source).Exists(ctx)
+		require.NoError(t, err)
+		return cached
+	}
+
+	// Request 2000 is the most related to request 1000.
+	data := []struct {
+		name string
+
+		// Prepare runs before the related requests of 1000 are cached.
+		prepare func(ctx context.Context, tx bun.Tx, repository Repository)
+		// Update must invalidate the related requests of 1000.
+		update func(ctx context.Context, tx bun.Tx, repository Repository)
+	}{
+		{
+			name: "SoftDelete",
+			update: func(ctx context.Context, tx bun.Tx, repository Repository) {
+				require.NoError(t, repository.Delete(ctx, test_utils.NumberUUID(2000), baseTime.Add(time.Hour)))
+			},
+		},
+		{
+			name: "Restore",
+			prepare: func(ctx context.Context, tx bun.Tx, repository Repository) {
+				require.NoError(t, repository.Delete(ctx, test_utils.NumberUUID(2000), baseTime.Add(time.Hour)))
+			},
+			update: func(ctx context.Context, tx bun.Tx, repository Repository) {
+				require.NoError(t, repository.Restore(ctx, test_utils.NumberUUID(2000), baseTime))
+			},
+		},
+		{
+			name: "Hide",
+			update: func(ctx context.Context, tx bun.Tx, repository Repository) {
+				_, err := tx.NewInsert().Model(&reports_storage.Case{
+					Target:    reports_storage.TargetImproveRequest,
+					TargetID:  test_utils.NumberUUID(2000),
+					Status:    reports_storage.StatusPending,
+					Reports:   1,
+					CreatedAt: baseTime,
+					HiddenAt:  framework.ToPTR(baseTime.Add(time.Hour)),
+				}).Exec(ctx)
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Unhide",
+			prepare: func(ctx context.Context, tx bun.Tx, repository Repository) {
+				_, err := tx.NewInsert().Model(&reports_storage.Case{
+					Target:    reports_storage.TargetImproveRequest,
+					TargetID:  test_utils.NumberUUID(2000),
+					Status:    reports_storage.StatusPending,
+					Reports:   1,
+					CreatedAt: baseTime,
+					HiddenAt:  framework.ToPTR(baseTime.Add(time.Hour)),
+				}).Exec(ctx)
+				require.NoError(t, err)
+			},
+			update: func(ctx context.Context, tx bun.Tx, repository Repository) {
+				_, err := tx.NewUpdate().
+					Model((*reports_storage.Case)(nil)).
+					Set("hidden_at = NULL").
+					Where("target_id = ?", test_utils.NumberUUID(2000)).
+					Exec(ctx)
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Visibility",
+			update: func(ctx context.Context, tx bun.Tx, repository Repository) {
+				_, err := tx.NewInsert().Model(&visibility_storage.Access{
+					Source:     test_utils.NumberUUID(2000),
+					Visibility: visibility_storage.VisibilityRestricted,
+					UpdatedAt:  baseTime,
+				}).Exec(ctx)
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, SearchFixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx, 10)
+				if d.prepare != nil {
+					d.prepare(ctx, stx, repository)
+				}
+
+				_, err = repository.Related(ctx, test_utils.NumberUUID(1000), MaxRelated, baseTime)
+				require.NoError(st, err)
+				require.True(st, isCached(ctx, stx, test_utils.NumberUUID(1000)))
+
+				d.update(ctx, stx, repository)
+				require.False(st, isCached(ctx, stx, test_utils.NumberUUID(1000)))
 			})
 		}
 	})
@@ -689,9 +1865,48 @@ func TestImproveRequestRepository_IsCreator(t *testing.T) {
 			id:     test_utils.NumberUUID(2001),
 			userID: test_utils.NumberUUID(2001),
 		},
+		{
+			name:   "Success/Collaborator",
+			id:     test_utils.NumberUUID(1002),
+			userID: test_utils.NumberUUID(100),
+			expect: true,
+		},
+		{
+			name:   "Success/CollaboratorStrictMode",
+			id:     test_utils.NumberUUID(1002),
+			userID: test_utils.NumberUUID(100),
+			strict: true,
+		},
+		{
+			name:   "Success/PendingCollaborator",
+			id:     test_utils.NumberUUID(1002),
+			userID: test_utils.NumberUUID(101),
+		},
 	}
 
-	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+	acceptedAt := baseTime
+	fixtures := []interface{}{
+		&collaborator_storage.Model{
+			Source:     test_utils.NumberUUID(1000),
+			UserID:     test_utils.NumberUUID(100),
+			Role:       collaborator_storage.RoleEditor,
+			InvitedBy:  test_utils.NumberUUID(2001),
+			CreatedAt:  baseTime,
+			AcceptedAt: &acceptedAt,
+		},
+		&collaborator_storage.Model{
+			Source:    test_utils.NumberUUID(1000),
+			UserID:    test_utils.NumberUUID(101),
+			Role:      collaborator_storage.RoleOwner,
+			InvitedBy: test_utils.NumberUUID(2001),
+			CreatedAt: baseTime,
+		},
+	}
+	for _, fixture := range Fixtures {
+		fixtures = append(fixtures, fixture)
+	}
+
+	err := test_utils.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
 		repository := NewRepository(tx, 10)
 
 		for _, d := range data {
@@ -710,52 +1925,106 @@ func TestImproveRequestRepository_GetPreviews(t *testing.T) {
 	defer db.Close()
 	defer sqlDB.Close()
 
+	// Request 6000 is only visible to its author, to the invited user 100, and to the collaborator 102.
+	fixtures := []interface{}{
+		&visibility_storage.Access{
+			Source:     test_utils.NumberUUID(6000),
+			Visibility: visibility_storage.VisibilityRestricted,
+			UpdatedAt:  baseTime,
+		},
+		&visibility_storage.Invite{
+			Source:    test_utils.NumberUUID(6000),
+			UserID:    test_utils.NumberUUID(100),
+			CreatedAt: baseTime,
+		},
+		&collaborator_storage.Model{
+			Source:     test_utils.NumberUUID(6000),
+			UserID:     test_utils.NumberUUID(102),
+			Role:       collaborator_storage.RoleEditor,
+			InvitedBy:  test_utils.NumberUUID(2000),
+			CreatedAt:  baseTime,
+			AcceptedAt: &baseTime,
+		},
+	}
+	for _, fixture := range Fixtures {
+		fixtures = append(fixtures, fixture)
+	}
+
+	publicPreview := &Preview{
+		ID:                  test_utils.NumberUUID(1002),
+		CreatedAt:           baseTime.Add(time.Minute),
+		Source:              test_utils.NumberUUID(1000),
+		UserID:              test_utils.NumberUUID(2000),
+		UpVotes:             38,
+		DownVotes:           10,
+		Title:               "New Test",
+		Content:             "Dummy cont",
+		Reactions:           map[string]int64{},
+		RevisionCount:       3,
+		MoreRecentRevisions: 1,
+	}
+	restrictedPreview := &Preview{
+		ID:            test_utils.NumberUUID(6000),
+		CreatedAt:     baseTime.Add(30 * time.Minute),
+		Source:        test_utils.NumberUUID(6000),
+		UserID:        test_utils.NumberUUID(2000),
+		UpVotes:       11,
+		DownVotes:     3,
+		Title:         "New title Updated.",
+		Content:       "qwertyuiop",
+		Reactions:     map[string]int64{},
+		RevisionCount: 1,
+	}
+
+	ids := []uuid.UUID{
+		test_utils.NumberUUID(1002),
+		test_utils.NumberUUID(6000),
+		test_utils.NumberUUID(8000),
+	}
+
 	data := []struct {
-		name   string
-		ids    []uuid.UUID
-		expect []*Preview
+		name     string
+		ids      []uuid.UUID
+		viewerID *uuid.UUID
+		expect   []*Preview
 	}{
 		{
-			name: "Success",
-			ids: []uuid.UUID{
-				test_utils.NumberUUID(1002),
-				test_utils.NumberUUID(6000),
-				test_utils.NumberUUID(8000),
-			},
-			expect: []*Preview{
-				{
-					ID:                  test_utils.NumberUUID(1002),
-					CreatedAt:           baseTime.Add(time.Minute),
-					Source:              test_utils.NumberUUID(1000),
-					UserID:              test_utils.NumberUUID(2000),
-					UpVotes:             38,
-					DownVotes:           10,
-					Title:               "New Test",
-					Content:             "Dummy cont",
-					RevisionCount:       3,
-					MoreRecentRevisions: 1,
-				},
-				{
-					ID:            test_utils.NumberUUID(6000),
-					CreatedAt:     baseTime.Add(30 * time.Minute),
-					Source:        test_utils.NumberUUID(6000),
-					UserID:        test_utils.NumberUUID(2000),
-					UpVotes:       11,
-					DownVotes:     3,
-					Title:         "New title Updated.",
-					Content:       "qwertyuiop",
-					RevisionCount: 1,
-				},
-			},
+			name:     "Success/Author",
+			ids:      ids,
+			viewerID: framework.ToPTR(test_utils.NumberUUID(2000)),
+			expect:   []*Preview{publicPreview, restrictedPreview},
+		},
+		{
+			name:     "Success/Invited",
+			ids:      ids,
+			viewerID: framework.ToPTR(test_utils.NumberUUID(100)),
+			expect:   []*Preview{publicPreview, restrictedPreview},
+		},
+		{
+			name:     "Success/Collaborator",
+			ids:      ids,
+			viewerID: framework.ToPTR(test_utils.NumberUUID(102)),
+			expect:   []*Preview{publicPreview, restrictedPreview},
+		},
+		{
+			name:     "Success/NotInvited",
+			ids:      ids,
+			viewerID: framework.ToPTR(test_utils.NumberUUID(101)),
+			expect:   []*Preview{publicPreview},
+		},
+		{
+			name:   "Success/Anonymous",
+			ids:    ids,
+			expect: []*Preview{publicPreview},
 		},
 	}
 
-	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+	err := test_utils.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
 		repository := NewRepository(tx, 10)
 
 		for _, d := range data {
 			t.Run(d.name, func(st *testing.T) {
-				res, err := repository.GetPreviews(ctx, d.ids)
+				res, err := repository.GetPreviews(ctx, d.ids, d.viewerID)
 				require.NoError(st, err)
 				require.Equal(t, d.expect, res)
 			})
@@ -24,25 +24,77 @@ func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
 	return &MockRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: ctx, data, userID, sourceID, id, now
-func (_m *MockRepository) Create(ctx context.Context, data *Core, userID uuid.UUID, sourceID uuid.UUID, id uuid.UUID, now time.Time) (*Model, error) {
-	ret := _m.Called(ctx, data, userID, sourceID, id, now)
+// CountOrphans provides a mock function with given fields: ctx
+func (_m *MockRepository) CountOrphans(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_CountOrphans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountOrphans'
+type MockRepository_CountOrphans_Call struct {
+	*mock.Call
+}
+
+// CountOrphans is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRepository_Expecter) CountOrphans(ctx interface{}) *MockRepository_CountOrphans_Call {
+	return &MockRepository_CountOrphans_Call{Call: _e.mock.On("CountOrphans", ctx)}
+}
+
+func (_c *MockRepository_CountOrphans_Call) Run(run func(ctx context.Context)) *MockRepository_CountOrphans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockRepository_CountOrphans_Call) Return(_a0 int64, _a1 error) *MockRepository_CountOrphans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_CountOrphans_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockRepository_CountOrphans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, data, userID, sourceID, id, revisionID, now
+func (_m *MockRepository) Create(ctx context.Context, data *Core, userID uuid.UUID, sourceID uuid.UUID, id uuid.UUID, revisionID uuid.UUID, now time.Time) (*Model, error) {
+	ret := _m.Called(ctx, data, userID, sourceID, id, revisionID, now)
 
 	var r0 *Model
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *Core, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) (*Model, error)); ok {
-		return rf(ctx, data, userID, sourceID, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, *Core, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) (*Model, error)); ok {
+		return rf(ctx, data, userID, sourceID, id, revisionID, now)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *Core, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) *Model); ok {
-		r0 = rf(ctx, data, userID, sourceID, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, *Core, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) *Model); ok {
+		r0 = rf(ctx, data, userID, sourceID, id, revisionID, now)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*Model)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *Core, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) error); ok {
-		r1 = rf(ctx, data, userID, sourceID, id, now)
+	if rf, ok := ret.Get(1).(func(context.Context, *Core, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, data, userID, sourceID, id, revisionID, now)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -61,14 +113,15 @@ type MockRepository_Create_Call struct {
 //   - userID uuid.UUID
 //   - sourceID uuid.UUID
 //   - id uuid.UUID
+//   - revisionID uuid.UUID
 //   - now time.Time
-func (_e *MockRepository_Expecter) Create(ctx interface{}, data interface{}, userID interface{}, sourceID interface{}, id interface{}, now interface{}) *MockRepository_Create_Call {
-	return &MockRepository_Create_Call{Call: _e.mock.On("Create", ctx, data, userID, sourceID, id, now)}
+func (_e *MockRepository_Expecter) Create(ctx interface{}, data interface{}, userID interface{}, sourceID interface{}, id interface{}, revisionID interface{}, now interface{}) *MockRepository_Create_Call {
+	return &MockRepository_Create_Call{Call: _e.mock.On("Create", ctx, data, userID, sourceID, id, revisionID, now)}
 }
 
-func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, data *Core, userID uuid.UUID, sourceID uuid.UUID, id uuid.UUID, now time.Time)) *MockRepository_Create_Call {
+func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, data *Core, userID uuid.UUID, sourceID uuid.UUID, id uuid.UUID, revisionID uuid.UUID, now time.Time)) *MockRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*Core), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(uuid.UUID), args[5].(time.Time))
+		run(args[0].(context.Context), args[1].(*Core), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(uuid.UUID), args[5].(uuid.UUID), args[6].(time.Time))
 	})
 	return _c
 }
@@ -78,18 +131,18 @@ func (_c *MockRepository_Create_Call) Return(_a0 *Model, _a1 error) *MockReposit
 	return _c
 }
 
-func (_c *MockRepository_Create_Call) RunAndReturn(run func(context.Context, *Core, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) (*Model, error)) *MockRepository_Create_Call {
+func (_c *MockRepository_Create_Call) RunAndReturn(run func(context.Context, *Core, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) (*Model, error)) *MockRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: ctx, id
-func (_m *MockRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// Delete provides a mock function with given fields: ctx, id, now
+func (_m *MockRepository) Delete(ctx context.Context, id uuid.UUID, now time.Time) error {
+	ret := _m.Called(ctx, id, now)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, id, now)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -105,13 +158,14 @@ type MockRepository_Delete_Call struct {
 // Delete is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-func (_e *MockRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockRepository_Delete_Call {
-	return &MockRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+//   - now time.Time
+func (_e *MockRepository_Expecter) Delete(ctx interface{}, id interface{}, now interface{}) *MockRepository_Delete_Call {
+	return &MockRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id, now)}
 }
 
-func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockRepository_Delete_Call {
+func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID, now time.Time)) *MockRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
 	})
 	return _c
 }
@@ -121,7 +175,60 @@ func (_c *MockRepository_Delete_Call) Return(_a0 error) *MockRepository_Delete_C
 	return _c
 }
 
-func (_c *MockRepository_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockRepository_Delete_Call {
+func (_c *MockRepository_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) error) *MockRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOrphans provides a mock function with given fields: ctx, limit
+func (_m *MockRepository) DeleteOrphans(ctx context.Context, limit int) (int64, error) {
+	ret := _m.Called(ctx, limit)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int64, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int64); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_DeleteOrphans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrphans'
+type MockRepository_DeleteOrphans_Call struct {
+	*mock.Call
+}
+
+// DeleteOrphans is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockRepository_Expecter) DeleteOrphans(ctx interface{}, limit interface{}) *MockRepository_DeleteOrphans_Call {
+	return &MockRepository_DeleteOrphans_Call{Call: _e.mock.On("DeleteOrphans", ctx, limit)}
+}
+
+func (_c *MockRepository_DeleteOrphans_Call) Run(run func(ctx context.Context, limit int)) *MockRepository_DeleteOrphans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteOrphans_Call) Return(_a0 int64, _a1 error) *MockRepository_DeleteOrphans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_DeleteOrphans_Call) RunAndReturn(run func(context.Context, int) (int64, error)) *MockRepository_DeleteOrphans_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -299,6 +406,123 @@ func (_c *MockRepository_List_Call) RunAndReturn(run func(context.Context, ListQ
 	return _c
 }
 
+// ListAfter provides a mock function with given fields: ctx, query, cursor, limit
+func (_m *MockRepository) ListAfter(ctx context.Context, query ListQuery, cursor string, limit int) ([]*Model, string, error) {
+	ret := _m.Called(ctx, query, cursor, limit)
+
+	var r0 []*Model
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, ListQuery, string, int) ([]*Model, string, error)); ok {
+		return rf(ctx, query, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ListQuery, string, int) []*Model); ok {
+		r0 = rf(ctx, query, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ListQuery, string, int) string); ok {
+		r1 = rf(ctx, query, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, ListQuery, string, int) error); ok {
+		r2 = rf(ctx, query, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRepository_ListAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAfter'
+type MockRepository_ListAfter_Call struct {
+	*mock.Call
+}
+
+// ListAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query ListQuery
+//   - cursor string
+//   - limit int
+func (_e *MockRepository_Expecter) ListAfter(ctx interface{}, query interface{}, cursor interface{}, limit interface{}) *MockRepository_ListAfter_Call {
+	return &MockRepository_ListAfter_Call{Call: _e.mock.On("ListAfter", ctx, query, cursor, limit)}
+}
+
+func (_c *MockRepository_ListAfter_Call) Run(run func(ctx context.Context, query ListQuery, cursor string, limit int)) *MockRepository_ListAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ListQuery), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListAfter_Call) Return(_a0 []*Model, _a1 string, _a2 error) *MockRepository_ListAfter_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRepository_ListAfter_Call) RunAndReturn(run func(context.Context, ListQuery, string, int) ([]*Model, string, error)) *MockRepository_ListAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function with given fields: ctx, deletedBefore
+func (_m *MockRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	ret := _m.Called(ctx, deletedBefore)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, deletedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, deletedBefore)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, deletedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deletedBefore time.Time
+func (_e *MockRepository_Expecter) Purge(ctx interface{}, deletedBefore interface{}) *MockRepository_Purge_Call {
+	return &MockRepository_Purge_Call{Call: _e.mock.On("Purge", ctx, deletedBefore)}
+}
+
+func (_c *MockRepository_Purge_Call) Run(run func(ctx context.Context, deletedBefore time.Time)) *MockRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Purge_Call) Return(_a0 int64, _a1 error) *MockRepository_Purge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Purge_Call) RunAndReturn(run func(context.Context, time.Time) (int64, error)) *MockRepository_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Read provides a mock function with given fields: ctx, id
 func (_m *MockRepository) Read(ctx context.Context, id uuid.UUID) (*Model, error) {
 	ret := _m.Called(ctx, id)
@@ -354,25 +578,191 @@ func (_c *MockRepository_Read_Call) RunAndReturn(run func(context.Context, uuid.
 	return _c
 }
 
-// Update provides a mock function with given fields: ctx, data, id, now
-func (_m *MockRepository) Update(ctx context.Context, data *Core, id uuid.UUID, now time.Time) (*Model, error) {
-	ret := _m.Called(ctx, data, id, now)
+// ReadDeleted provides a mock function with given fields: ctx, id
+func (_m *MockRepository) ReadDeleted(ctx context.Context, id uuid.UUID) (*Model, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*Model, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *Model); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ReadDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDeleted'
+type MockRepository_ReadDeleted_Call struct {
+	*mock.Call
+}
+
+// ReadDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockRepository_Expecter) ReadDeleted(ctx interface{}, id interface{}) *MockRepository_ReadDeleted_Call {
+	return &MockRepository_ReadDeleted_Call{Call: _e.mock.On("ReadDeleted", ctx, id)}
+}
+
+func (_c *MockRepository_ReadDeleted_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockRepository_ReadDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ReadDeleted_Call) Return(_a0 *Model, _a1 error) *MockRepository_ReadDeleted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ReadDeleted_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*Model, error)) *MockRepository_ReadDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadRevisions provides a mock function with given fields: ctx, id
+func (_m *MockRepository) ReadRevisions(ctx context.Context, id uuid.UUID) ([]*Revision, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 []*Revision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*Revision, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*Revision); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Revision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ReadRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadRevisions'
+type MockRepository_ReadRevisions_Call struct {
+	*mock.Call
+}
+
+// ReadRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockRepository_Expecter) ReadRevisions(ctx interface{}, id interface{}) *MockRepository_ReadRevisions_Call {
+	return &MockRepository_ReadRevisions_Call{Call: _e.mock.On("ReadRevisions", ctx, id)}
+}
+
+func (_c *MockRepository_ReadRevisions_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockRepository_ReadRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ReadRevisions_Call) Return(_a0 []*Revision, _a1 error) *MockRepository_ReadRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ReadRevisions_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*Revision, error)) *MockRepository_ReadRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, id, deletedAfter
+func (_m *MockRepository) Restore(ctx context.Context, id uuid.UUID, deletedAfter time.Time) (*Model, error) {
+	ret := _m.Called(ctx, id, deletedAfter)
+
+	var r0 *Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) (*Model, error)); ok {
+		return rf(ctx, id, deletedAfter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) *Model); ok {
+		r0 = rf(ctx, id, deletedAfter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, id, deletedAfter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - deletedAfter time.Time
+func (_e *MockRepository_Expecter) Restore(ctx interface{}, id interface{}, deletedAfter interface{}) *MockRepository_Restore_Call {
+	return &MockRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id, deletedAfter)}
+}
+
+func (_c *MockRepository_Restore_Call) Run(run func(ctx context.Context, id uuid.UUID, deletedAfter time.Time)) *MockRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Restore_Call) Return(_a0 *Model, _a1 error) *MockRepository_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Restore_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) (*Model, error)) *MockRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, data, id, revisionID, now
+func (_m *MockRepository) Update(ctx context.Context, data *Core, id uuid.UUID, revisionID uuid.UUID, now time.Time) (*Model, error) {
+	ret := _m.Called(ctx, data, id, revisionID, now)
 
 	var r0 *Model
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *Core, uuid.UUID, time.Time) (*Model, error)); ok {
-		return rf(ctx, data, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, *Core, uuid.UUID, uuid.UUID, time.Time) (*Model, error)); ok {
+		return rf(ctx, data, id, revisionID, now)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *Core, uuid.UUID, time.Time) *Model); ok {
-		r0 = rf(ctx, data, id, now)
+	if rf, ok := ret.Get(0).(func(context.Context, *Core, uuid.UUID, uuid.UUID, time.Time) *Model); ok {
+		r0 = rf(ctx, data, id, revisionID, now)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*Model)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *Core, uuid.UUID, time.Time) error); ok {
-		r1 = rf(ctx, data, id, now)
+	if rf, ok := ret.Get(1).(func(context.Context, *Core, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, data, id, revisionID, now)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -389,14 +779,15 @@ type MockRepository_Update_Call struct {
 //   - ctx context.Context
 //   - data *Core
 //   - id uuid.UUID
+//   - revisionID uuid.UUID
 //   - now time.Time
-func (_e *MockRepository_Expecter) Update(ctx interface{}, data interface{}, id interface{}, now interface{}) *MockRepository_Update_Call {
-	return &MockRepository_Update_Call{Call: _e.mock.On("Update", ctx, data, id, now)}
+func (_e *MockRepository_Expecter) Update(ctx interface{}, data interface{}, id interface{}, revisionID interface{}, now interface{}) *MockRepository_Update_Call {
+	return &MockRepository_Update_Call{Call: _e.mock.On("Update", ctx, data, id, revisionID, now)}
 }
 
-func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, data *Core, id uuid.UUID, now time.Time)) *MockRepository_Update_Call {
+func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, data *Core, id uuid.UUID, revisionID uuid.UUID, now time.Time)) *MockRepository_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*Core), args[2].(uuid.UUID), args[3].(time.Time))
+		run(args[0].(context.Context), args[1].(*Core), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(time.Time))
 	})
 	return _c
 }
@@ -406,7 +797,7 @@ func (_c *MockRepository_Update_Call) Return(_a0 *Model, _a1 error) *MockReposit
 	return _c
 }
 
-func (_c *MockRepository_Update_Call) RunAndReturn(run func(context.Context, *Core, uuid.UUID, time.Time) (*Model, error)) *MockRepository_Update_Call {
+func (_c *MockRepository_Update_Call) RunAndReturn(run func(context.Context, *Core, uuid.UUID, uuid.UUID, time.Time) (*Model, error)) *MockRepository_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3,9 +3,30 @@ package improve_suggestion_storage
 import (
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
+	"strings"
 	"time"
 )
 
+// Improve suggestion table has some full text search columns we don't want to fetch.
+var (
+	exposedColumns = []string{
+		"id",
+		"created_at",
+		"updated_at",
+		"source_id",
+		"user_id",
+		"validated",
+		"revision_id",
+		"up_votes",
+		"down_votes",
+		"request_id",
+		"title",
+		"content",
+		"deleted_at",
+	}
+	exposedColumnsSTR = strings.Join(exposedColumns, ",")
+)
+
 // Model is the database model for the improve_suggestions table.
 // An improvement suggestion is a response to an improvement request (improve_request_storage.Model). It proposes
 // alterations to improve the source request, and achieve its goal.
@@ -13,6 +34,9 @@ import (
 // To remain relevant, an improvement suggestion is tied to an improvement request revision. When updated, the revision
 // can also be changed, to point to another more recent revision.
 //
+// The suggestion row always holds the latest version of its content. Every version, including the current one, is
+// also kept as an immutable Revision, so votes can be traced back to the text they were cast on.
+//
 // When the improvement request creator upvotes a suggestion, the suggestion becomes validated. It then has a special
 // display in the thread.
 type Model struct {
@@ -31,6 +55,8 @@ type Model struct {
 	UserID uuid.UUID `json:"user_id" bun:"user_id,type:uuid"`
 	// Validated is true if the suggestion has been validated by the improvement request creator.
 	Validated bool `json:"validated" bun:"validated"`
+	// RevisionID is the ID of the Revision that holds the current content of the suggestion.
+	RevisionID uuid.UUID `json:"revision_id" bun:"revision_id,type:uuid,nullzero"`
 
 	// UpVotes is the number of up votes the suggestion has received. This value is indirectly updated from the
 	// votes table.
@@ -38,6 +64,12 @@ type Model struct {
 	// DownVotes is the number of down votes the suggestion has received. This value is indirectly updated from the
 	// votes table.
 	DownVotes int64 `json:"down_votes" bun:"down_votes"`
+	// Reactions counts the reactions the suggestion has received, by reaction. It is only set on previews.
+	Reactions map[string]int64 `json:"reactions" bun:"reactions,scanonly"`
+
+	// DeletedAt is set once the suggestion is deleted. Deleted suggestions are only listed in the thread of their
+	// request, as tombstones without title or content, until they are restored or purged.
+	DeletedAt *time.Time `json:"deleted_at" bun:"deleted_at"`
 
 	Core
 }
@@ -53,6 +85,27 @@ type Core struct {
 	Content string `json:"content" bun:"content"`
 }
 
+// Revision is the database model for the improve_suggestion_revisions table.
+// A revision is an immutable snapshot of the Core of a suggestion. A new revision is created every time the
+// suggestion is created or updated.
+type Revision struct {
+	bun.BaseModel `bun:"table:improve_suggestion_revisions,alias:revision"`
+
+	// ID of the revision.
+	ID uuid.UUID `json:"id" bun:"id,pk,type:uuid"`
+	// SuggestionID is the ID of the suggestion this revision belongs to.
+	SuggestionID uuid.UUID `json:"suggestion_id" bun:"suggestion_id,type:uuid"`
+	// CreatedAt stores the time at which the revision was created.
+	CreatedAt time.Time `json:"created_at" bun:"created_at,notnull"`
+
+	// UpVotes is the number of up votes that were last cast on this revision.
+	UpVotes int64 `json:"up_votes" bun:"up_votes,scanonly"`
+	// DownVotes is the number of down votes that were last cast on this revision.
+	DownVotes int64 `json:"down_votes" bun:"down_votes,scanonly"`
+
+	Core
+}
+
 type SearchQueryOrder struct {
 	Created bool `json:"created"`
 	Score   bool `json:"score"`
@@ -63,13 +116,21 @@ type ListQuery struct {
 	// UserID is an optional parameter, to only target suggestions that were created by a specific author.
 	UserID *uuid.UUID `json:"user_id"`
 	// SourceID is an optional parameter, to only target suggestions that were created for a specific improvement
-	// request.
+	// request. Listing a thread, with SourceID or RequestID and without Query, also returns the deleted suggestions
+	// as tombstones.
 	SourceID *uuid.UUID `json:"source_id"`
 	// RequestID is an optional parameter, to only target suggestions that were created for a specific improvement
 	// request revision.
 	RequestID *uuid.UUID `json:"request_id"`
 	// Validated is an optional parameter, to only target suggestions that have been validated by the improvement
 	// request creator.
-	Validated *bool             `json:"validated"`
-	Order     *SearchQueryOrder `json:"order"`
+	Validated *bool `json:"validated"`
+	// Reactions is an optional parameter, to only target suggestions that received all the given reactions.
+	Reactions []string `json:"reactions"`
+	// Query is an optional parameter, to filter suggestions based on their title or content. When set, results
+	// are ranked by relevance.
+	Query string            `json:"query"`
+	Order *SearchQueryOrder `json:"order"`
+	// Language is the text search configuration used to parse the Query. It defaults to DefaultLanguage.
+	Language string `json:"language"`
 }
@@ -2,6 +2,7 @@ package improve_suggestion_storage
 
 import (
 	"context"
+	"github.com/a-novel/agora-backend/framework/pagination"
 	"github.com/a-novel/agora-backend/framework/validation"
 	"github.com/a-novel/agora-backend/models"
 	"github.com/google/uuid"
@@ -11,14 +12,33 @@ import (
 
 // Repository of the current layer. You can instantiate a new one with NewRepository.
 type Repository interface {
-	// Read returns the improvement suggestion with the given ID.
+	// Read returns the improvement suggestion with the given ID. Suggestions of a deleted improvement request are
+	// not found, until the request is restored.
 	Read(ctx context.Context, id uuid.UUID) (*Model, error)
-	// Create creates a new improvement suggestion for a given improvement request revision.
-	Create(ctx context.Context, data *Core, userID, sourceID, id uuid.UUID, now time.Time) (*Model, error)
-	// Update updates an existing improvement suggestion.
-	Update(ctx context.Context, data *Core, id uuid.UUID, now time.Time) (*Model, error)
-	// Delete deletes an existing improvement suggestion.
-	Delete(ctx context.Context, id uuid.UUID) error
+	// ReadRevisions returns every revision of the improvement suggestion with the given ID, most recent first. Like
+	// Read, it ignores the suggestions of deleted improvement requests.
+	ReadRevisions(ctx context.Context, id uuid.UUID) ([]*Revision, error)
+	// Create creates a new improvement suggestion for a given improvement request revision. The initial content is
+	// saved as the first revision of the suggestion, under revisionID.
+	Create(ctx context.Context, data *Core, userID, sourceID, id, revisionID uuid.UUID, now time.Time) (*Model, error)
+	// Update updates an existing improvement suggestion. The previous content is kept, and the new one is saved
+	// as a new revision, under revisionID.
+	Update(ctx context.Context, data *Core, id, revisionID uuid.UUID, now time.Time) (*Model, error)
+	// Delete deletes an existing improvement suggestion. Deleted suggestions are kept until they are purged, and can
+	// be restored in the meantime.
+	Delete(ctx context.Context, id uuid.UUID, now time.Time) error
+	// ReadDeleted returns the deleted improvement suggestion with the given ID.
+	ReadDeleted(ctx context.Context, id uuid.UUID) (*Model, error)
+	// Restore restores a deleted improvement suggestion, if it was deleted after the given time.
+	Restore(ctx context.Context, id uuid.UUID, deletedAfter time.Time) (*Model, error)
+	// Purge permanently removes the suggestions deleted before the given time, and returns how many were removed.
+	// Suggestions with an open report case are kept until the case is closed.
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+	// CountOrphans returns the number of suggestions whose improvement request no longer exists.
+	CountOrphans(ctx context.Context) (int64, error)
+	// DeleteOrphans removes up to limit suggestions whose improvement request no longer exists, and returns the
+	// number of removed suggestions.
+	DeleteOrphans(ctx context.Context, limit int) (int64, error)
 
 	// Validate validates an existing improvement suggestion.
 	Validate(ctx context.Context, validated bool, id uuid.UUID) (*Model, error)
@@ -27,6 +47,10 @@ type Repository interface {
 	// the limit and offset parameters.
 	// It also returns the total number of available results, to help with pagination.
 	List(ctx context.Context, query ListQuery, limit, offset int) ([]*Model, int64, error)
+	// ListAfter returns the suggestions matching the query, in the same order as List, that come after the given
+	// cursor. An empty cursor starts from the first result.
+	// It also returns the cursor of the next page, which is empty when there are no more results.
+	ListAfter(ctx context.Context, query ListQuery, cursor string, limit int) ([]*Model, string, error)
 
 	// IsCreator returns whether the user is the creator of the improvement suggestion.
 	IsCreator(ctx context.Context, userID, postID uuid.UUID) (bool, error)
@@ -34,6 +58,14 @@ type Repository interface {
 	GetPreviews(ctx context.Context, ids []uuid.UUID) ([]*Model, error)
 }
 
+const (
+	// DefaultLanguage is the text search configuration used to parse queries when none is provided.
+	DefaultLanguage = "french"
+	// FuzzyTitleThreshold is the minimum trigram word similarity between the query and a title, for a suggestion
+	// to match even when the full text search does not.
+	FuzzyTitleThreshold = 0.3
+)
+
 // NewRepository returns a new Repository instance.
 // To use a mocked one, call NewMockRepository.
 func NewRepository(db bun.IDB, cropPreviewContent int) Repository {
@@ -56,16 +88,53 @@ func (repository *repositoryImpl) selectPreview() *bun.SelectQuery {
 			"source_id",
 			"request_id",
 			"validated",
-			"title",
+			"revision_id",
 			"up_votes",
 			"down_votes",
+			"deleted_at",
 		).
-		ColumnExpr("content::VARCHAR(?)", repository.cropPreviewContent)
+		// Deleted suggestions are tombstones.
+		ColumnExpr("CASE WHEN deleted_at IS NULL THEN title ELSE '' END AS title").
+		ColumnExpr("CASE WHEN deleted_at IS NULL THEN content::VARCHAR(?) ELSE '' END AS content", repository.cropPreviewContent).
+		ColumnExpr("(?) AS reactions", repository.selectReactions())
+}
+
+// Return the reactions cast on the suggestion of the outer query.
+func (repository *repositoryImpl) selectReactionsOf() *bun.SelectQuery {
+	return repository.db.NewSelect().
+		TableExpr("reactions").
+		Where("reactions.target = 'improve_suggestion'").
+		Where("reactions.post_id = improve_suggestion.id")
+}
+
+// Return a column selector, that counts the reactions of the suggestion, by reaction.
+func (repository *repositoryImpl) selectReactions() *bun.SelectQuery {
+	queryCounts := repository.selectReactionsOf().
+		ColumnExpr("reactions.reaction").
+		ColumnExpr("COUNT(*) AS count").
+		GroupExpr("reactions.reaction")
+
+	return repository.db.NewSelect().
+		ColumnExpr("COALESCE(jsonb_object_agg(counts.reaction, counts.count), '{}'::jsonb)").
+		TableExpr("(?) AS counts", queryCounts)
+}
+
+// Return the IDs of the requests that were deleted. Their suggestions are hidden along with them.
+func (repository *repositoryImpl) selectDeletedSources() *bun.SelectQuery {
+	return repository.db.NewSelect().
+		Column("id").
+		TableExpr("improve_requests").
+		Where("id = source").
+		Where("deleted_at IS NOT NULL")
 }
 
 func (repository *repositoryImpl) validateSource(ctx context.Context, sourceID, requestID uuid.UUID) error {
 	// Source must exist.
-	count, err := repository.db.NewSelect().Table("improve_requests").Where("id = ?", sourceID).Count(ctx)
+	count, err := repository.db.NewSelect().
+		Table("improve_requests").
+		Where("id = ?", sourceID).
+		Where("deleted_at IS NULL").
+		Count(ctx)
 	if err != nil {
 		return validation.HandlePGError(err)
 	}
@@ -77,6 +146,7 @@ func (repository *repositoryImpl) validateSource(ctx context.Context, sourceID,
 	count, err = repository.db.NewSelect().Table("improve_requests").
 		Where("id = ?", requestID).
 		Where("source = ?", sourceID).
+		Where("deleted_at IS NULL").
 		Count(ctx)
 	if err != nil {
 		return validation.HandlePGError(err)
@@ -88,48 +158,112 @@ func (repository *repositoryImpl) validateSource(ctx context.Context, sourceID,
 	return nil
 }
 
+// createRevision saves an immutable copy of the suggestion content.
+func (repository *repositoryImpl) createRevision(ctx context.Context, tx bun.Tx, data *Core, suggestionID, id uuid.UUID, now time.Time) error {
+	revision := &Revision{
+		ID:           id,
+		SuggestionID: suggestionID,
+		CreatedAt:    now,
+		Core:         *data,
+	}
+
+	if _, err := tx.NewInsert().Model(revision).Exec(ctx); err != nil {
+		return validation.HandlePGError(err)
+	}
+
+	return nil
+}
+
 func (repository *repositoryImpl) Read(ctx context.Context, id uuid.UUID) (*Model, error) {
 	model := &Model{ID: id}
-	if err := repository.db.NewSelect().Model(model).WherePK().Scan(ctx); err != nil {
+	if err := repository.db.NewSelect().
+		Model(model).
+		WherePK().
+		Where("deleted_at IS NULL").
+		Where("source_id NOT IN (?)", repository.selectDeletedSources()).
+		Scan(ctx); err != nil {
 		return nil, validation.HandlePGError(err)
 	}
 
 	return model, nil
 }
 
-func (repository *repositoryImpl) Create(ctx context.Context, data *Core, userID, sourceID, id uuid.UUID, now time.Time) (*Model, error) {
+func (repository *repositoryImpl) ReadRevisions(ctx context.Context, id uuid.UUID) ([]*Revision, error) {
+	var revisions []*Revision
+
+	if err := repository.db.NewSelect().
+		Model(&revisions).
+		Column("id", "suggestion_id", "created_at", "request_id", "title", "content").
+		ColumnExpr(
+			"(SELECT COUNT(*) FROM votes WHERE votes.revision_id = revision.id AND votes.vote = ?) AS up_votes",
+			"up",
+		).
+		ColumnExpr(
+			"(SELECT COUNT(*) FROM votes WHERE votes.revision_id = revision.id AND votes.vote = ?) AS down_votes",
+			"down",
+		).
+		Where("suggestion_id = ?", id).
+		Where("suggestion_id NOT IN (?)", repository.db.NewSelect().
+			Model((*Model)(nil)).
+			Column("id").
+			WhereOr("deleted_at IS NOT NULL").
+			WhereOr("source_id IN (?)", repository.selectDeletedSources())).
+		Order("created_at DESC").
+		Scan(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	if len(revisions) == 0 {
+		return nil, validation.ErrNotFound
+	}
+
+	return revisions, nil
+}
+
+func (repository *repositoryImpl) Create(ctx context.Context, data *Core, userID, sourceID, id, revisionID uuid.UUID, now time.Time) (*Model, error) {
 	model := &Model{
-		ID:        id,
-		Core:      *data,
-		SourceID:  sourceID,
-		UserID:    userID,
-		CreatedAt: now,
+		ID:         id,
+		Core:       *data,
+		SourceID:   sourceID,
+		UserID:     userID,
+		RevisionID: revisionID,
+		CreatedAt:  now,
 	}
 
 	if err := repository.validateSource(ctx, sourceID, data.RequestID); err != nil {
 		return nil, err
 	}
 
-	if err := repository.db.NewInsert().Model(model).Scan(ctx); err != nil {
-		return nil, validation.HandlePGError(err)
+	err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := tx.NewInsert().Model(model).Scan(ctx); err != nil {
+			return validation.HandlePGError(err)
+		}
+
+		return repository.createRevision(ctx, tx, data, id, revisionID, now)
+	})
+
+	if err != nil {
+		return nil, err
 	}
 
 	return model, nil
 }
 
-func (repository *repositoryImpl) Update(ctx context.Context, data *Core, id uuid.UUID, now time.Time) (*Model, error) {
+func (repository *repositoryImpl) Update(ctx context.Context, data *Core, id, revisionID uuid.UUID, now time.Time) (*Model, error) {
 	model := &Model{
-		ID:        id,
-		Core:      *data,
-		UpdatedAt: &now,
+		ID:         id,
+		Core:       *data,
+		RevisionID: revisionID,
+		UpdatedAt:  &now,
 	}
 
 	err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
-		if err := repository.db.NewUpdate().
+		if err := tx.NewUpdate().
 			Model(model).
 			WherePK().
-			Column("id", "updated_at", "request_id", "title", "content").
-			Returning("*").
+			Where("deleted_at IS NULL").
+			Column("id", "updated_at", "revision_id", "request_id", "title", "content").
+			Returning(exposedColumnsSTR).
 			Scan(ctx); err != nil {
 			return validation.HandlePGError(err)
 		}
@@ -138,7 +272,7 @@ func (repository *repositoryImpl) Update(ctx context.Context, data *Core, id uui
 			return err
 		}
 
-		return nil
+		return repository.createRevision(ctx, tx, data, id, revisionID, now)
 	})
 
 	if err != nil {
@@ -148,10 +282,15 @@ func (repository *repositoryImpl) Update(ctx context.Context, data *Core, id uui
 	return model, nil
 }
 
-func (repository *repositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	model := &Model{ID: id}
+func (repository *repositoryImpl) Delete(ctx context.Context, id uuid.UUID, now time.Time) error {
+	model := &Model{ID: id, DeletedAt: &now}
 
-	if res, err := repository.db.NewDelete().Model(model).WherePK().Exec(ctx); err != nil {
+	if res, err := repository.db.NewUpdate().
+		Model(model).
+		Column("deleted_at").
+		WherePK().
+		Where("deleted_at IS NULL").
+		Exec(ctx); err != nil {
 		return validation.HandlePGError(err)
 	} else if err = validation.ForceRowsUpdate(res); err != nil {
 		return err
@@ -160,6 +299,97 @@ func (repository *repositoryImpl) Delete(ctx context.Context, id uuid.UUID) erro
 	return nil
 }
 
+func (repository *repositoryImpl) ReadDeleted(ctx context.Context, id uuid.UUID) (*Model, error) {
+	model := &Model{ID: id}
+	if err := repository.db.NewSelect().Model(model).WherePK().Where("deleted_at IS NOT NULL").Scan(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+func (repository *repositoryImpl) Restore(ctx context.Context, id uuid.UUID, deletedAfter time.Time) (*Model, error) {
+	model := &Model{ID: id}
+
+	if err := repository.db.NewUpdate().
+		Model(model).
+		Set("deleted_at = NULL").
+		WherePK().
+		Where("deleted_at >= ?", deletedAfter).
+		Returning(exposedColumnsSTR).
+		Scan(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+func (repository *repositoryImpl) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	queryReported := repository.db.NewSelect().
+		Column("target_id").
+		TableExpr("forum_report_cases").
+		Where("target = 'improve_suggestion'").
+		Where("status IN ('pending', 'claimed')")
+
+	res, err := repository.db.NewDelete().
+		Model((*Model)(nil)).
+		Where("deleted_at < ?", deletedBefore).
+		Where("id NOT IN (?)", queryReported).
+		Exec(ctx)
+	if err != nil {
+		return 0, validation.HandlePGError(err)
+	}
+
+	purged, err := res.RowsAffected()
+	if err != nil {
+		return 0, validation.HandlePGError(err)
+	}
+
+	return purged, nil
+}
+
+// Suggestions are removed along with the last revision of their request by a trigger, so this only matches the
+// suggestions left over from before the trigger existed.
+func (repository *repositoryImpl) orphansQuery() *bun.SelectQuery {
+	queryRequests := repository.db.NewSelect().
+		Column("source").
+		TableExpr("improve_requests")
+
+	return repository.db.NewSelect().
+		Model((*Model)(nil)).
+		Where("source_id NOT IN (?)", queryRequests)
+}
+
+func (repository *repositoryImpl) CountOrphans(ctx context.Context) (int64, error) {
+	count, err := repository.orphansQuery().Count(ctx)
+	if err != nil {
+		return 0, validation.HandlePGError(err)
+	}
+
+	return int64(count), nil
+}
+
+func (repository *repositoryImpl) DeleteOrphans(ctx context.Context, limit int) (int64, error) {
+	batch := repository.orphansQuery().
+		Column("id").
+		Limit(limit)
+
+	res, err := repository.db.NewDelete().
+		Model((*Model)(nil)).
+		Where("id IN (?)", batch).
+		Exec(ctx)
+	if err != nil {
+		return 0, validation.HandlePGError(err)
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return 0, validation.HandlePGError(err)
+	}
+
+	return count, nil
+}
+
 func (repository *repositoryImpl) Validate(ctx context.Context, validated bool, id uuid.UUID) (*Model, error) {
 	model := &Model{ID: id, Validated: validated}
 
@@ -167,7 +397,8 @@ func (repository *repositoryImpl) Validate(ctx context.Context, validated bool,
 		Model(model).
 		Column("validated").
 		WherePK().
-		Returning("*").
+		Where("deleted_at IS NULL").
+		Returning(exposedColumnsSTR).
 		Scan(ctx); err != nil {
 		return nil, validation.HandlePGError(err)
 	}
@@ -175,11 +406,9 @@ func (repository *repositoryImpl) Validate(ctx context.Context, validated bool,
 	return model, nil
 }
 
-func (repository *repositoryImpl) List(ctx context.Context, query ListQuery, limit, offset int) ([]*Model, int64, error) {
-	var results []*Model
-
-	dbQuery := repository.selectPreview().Limit(limit).Offset(offset)
-
+// Apply the filters of a ListQuery. When a full text query is provided, the parsed query is available under
+// search.query, and the normalized query under search.term.
+func (repository *repositoryImpl) applyListFilters(dbQuery *bun.SelectQuery, query ListQuery) *bun.SelectQuery {
 	if query.UserID != nil {
 		dbQuery = dbQuery.Where("user_id = ?", *query.UserID)
 	}
@@ -192,15 +421,98 @@ func (repository *repositoryImpl) List(ctx context.Context, query ListQuery, lim
 	if query.Validated != nil {
 		dbQuery = dbQuery.Where("validated = ?", *query.Validated)
 	}
+	if len(query.Reactions) > 0 {
+		unique := make(map[string]bool, len(query.Reactions))
+		for _, reaction := range query.Reactions {
+			unique[reaction] = true
+		}
+
+		queryMatchingReactions := repository.selectReactionsOf().
+			ColumnExpr("COUNT(DISTINCT reactions.reaction)").
+			Where("reactions.reaction IN (?)", bun.In(query.Reactions))
+
+		dbQuery = dbQuery.Where("(?) = ?", queryMatchingReactions, len(unique))
+	}
+	// Suggestions of requests that are not public are only listed from the request itself.
+	if query.SourceID == nil && query.RequestID == nil {
+		queryHiddenSources := repository.db.NewSelect().
+			Column("source").
+			TableExpr("improve_request_access").
+			Where("visibility <> 'public'")
+
+		dbQuery = dbQuery.Where("source_id NOT IN (?)", queryHiddenSources)
+	}
+	// Suggestions hidden after being reported are never listed.
+	queryReported := repository.db.NewSelect().
+		Column("target_id").
+		TableExpr("forum_report_cases").
+		Where("target = 'improve_suggestion'").
+		Where("hidden_at IS NOT NULL")
+
+	dbQuery = dbQuery.Where("id NOT IN (?)", queryReported)
+	// Deleted suggestions only remain in the thread, as tombstones. They can never match a text query.
+	if (query.SourceID == nil && query.RequestID == nil) || query.Query != "" {
+		dbQuery = dbQuery.Where("deleted_at IS NULL")
+	}
+	dbQuery = dbQuery.Where("source_id NOT IN (?)", repository.selectDeletedSources())
 
-	if query.Order != nil {
-		if query.Order.Score {
-			dbQuery = dbQuery.OrderExpr("up_votes - down_votes DESC")
+	// Use FullText search filter.
+	if query.Query != "" {
+		language := query.Language
+		if language == "" {
+			language = DefaultLanguage
 		}
+
+		queryFullText := repository.db.NewSelect().
+			ColumnExpr("to_tsquery(?::regconfig, string_agg(lexeme || ':*', ' & ' order by positions)) AS query", language).
+			ColumnExpr("format_user_search(?) AS term", query.Query).
+			TableExpr("unnest(to_tsvector(?::regconfig, unaccent(?)))", language, query.Query)
+
+		// Titles are also matched using trigrams, so a typo does not discard an otherwise relevant suggestion.
+		dbQuery = dbQuery.
+			TableExpr("(?) AS search", queryFullText).
+			WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+				return q.
+					Where("text_searchable_index_col @@ search.query").
+					WhereOr("word_similarity(search.term, format_user_search(title)) > ?", FuzzyTitleThreshold)
+			})
 	}
 
-	// Order by date by default.
-	dbQuery = dbQuery.OrderExpr("coalesce(updated_at, created_at) DESC")
+	return dbQuery
+}
+
+// Return the ordering keys of a list, from the most to the least significant.
+func (repository *repositoryImpl) listKeys(query ListQuery) []pagination.Key {
+	var keys []pagination.Key
+
+	if query.Query != "" {
+		keys = append(keys, pagination.Key{
+			Expr: "COALESCE(ts_rank_cd(text_searchable_index_col, search.query), 0) + " +
+				"word_similarity(search.term, format_user_search(title))",
+			Type: "real",
+		})
+	}
+
+	if query.Order != nil && query.Order.Score {
+		keys = append(keys, pagination.Key{Expr: "COALESCE(up_votes, 0) - COALESCE(down_votes, 0)", Type: "bigint"})
+	}
+
+	// Order by date by default. The ID breaks the remaining ties, so the order is stable across pages.
+	return append(
+		keys,
+		pagination.Key{Expr: "coalesce(updated_at, created_at)", Type: "timestamp"},
+		pagination.Key{Expr: "id", Type: "uuid"},
+	)
+}
+
+func (repository *repositoryImpl) List(ctx context.Context, query ListQuery, limit, offset int) ([]*Model, int64, error) {
+	var results []*Model
+
+	dbQuery := repository.applyListFilters(repository.selectPreview().Limit(limit).Offset(offset), query)
+
+	for _, key := range repository.listKeys(query) {
+		dbQuery = dbQuery.OrderExpr(key.Expr+" DESC", key.Args...)
+	}
 
 	count, err := dbQuery.ScanAndCount(ctx, &results)
 	if err != nil {
@@ -210,6 +522,39 @@ func (repository *repositoryImpl) List(ctx context.Context, query ListQuery, lim
 	return results, int64(count), nil
 }
 
+func (repository *repositoryImpl) ListAfter(ctx context.Context, query ListQuery, cursor string, limit int) ([]*Model, string, error) {
+	type modelWithCursor struct {
+		Model  `bun:",extend"`
+		Cursor string `bun:"cursor,scanonly"`
+	}
+
+	var results []*modelWithCursor
+
+	dbQuery, err := pagination.Apply(
+		repository.applyListFilters(repository.selectPreview().Limit(limit), query),
+		repository.listKeys(query),
+		cursor,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := dbQuery.Scan(ctx, &results); err != nil {
+		return nil, "", validation.HandlePGError(err)
+	}
+
+	suggestions := make([]*Model, len(results))
+	for i, result := range results {
+		suggestions[i] = &result.Model
+	}
+
+	next := pagination.Next(results, limit, func(row *modelWithCursor) string {
+		return row.Cursor
+	})
+
+	return suggestions, next, nil
+}
+
 func (repository *repositoryImpl) IsCreator(ctx context.Context, userID, postID uuid.UUID) (bool, error) {
 	ok, err := repository.db.NewSelect().
 		Model((*Model)(nil)).
@@ -226,7 +571,10 @@ func (repository *repositoryImpl) IsCreator(ctx context.Context, userID, postID
 func (repository *repositoryImpl) GetPreviews(ctx context.Context, ids []uuid.UUID) ([]*Model, error) {
 	var results []*Model
 
-	dbQuery := repository.selectPreview().Where("id IN (?)", bun.In(ids))
+	dbQuery := repository.selectPreview().
+		Where("id IN (?)", bun.In(ids)).
+		Where("deleted_at IS NULL").
+		Where("source_id NOT IN (?)", repository.selectDeletedSources())
 
 	if err := dbQuery.Scan(ctx, &results); err != nil {
 		return nil, validation.HandlePGError(err)
@@ -64,6 +64,109 @@ func TestImproveSuggestionRepository_Read(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestImproveSuggestionRepository_ReadRevisions(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	// The votes table belongs to another package, that already depends on this one.
+	type vote struct {
+		bun.BaseModel `bun:"table:votes"`
+
+		UpdatedAt  time.Time `bun:"updated_at"`
+		UserID     uuid.UUID `bun:"user_id,type:uuid"`
+		PostID     uuid.UUID `bun:"post_id,type:uuid"`
+		Target     string    `bun:"target"`
+		Vote       string    `bun:"vote"`
+		RevisionID uuid.UUID `bun:"revision_id,type:uuid"`
+	}
+
+	fixtures := append(
+		Fixtures,
+		&vote{
+			UpdatedAt:  baseTime,
+			UserID:     test_utils.NumberUUID(210),
+			PostID:     test_utils.NumberUUID(1002),
+			Target:     "improve_suggestion",
+			Vote:       "up",
+			RevisionID: test_utils.NumberUUID(3000),
+		},
+		&vote{
+			UpdatedAt:  baseTime,
+			UserID:     test_utils.NumberUUID(211),
+			PostID:     test_utils.NumberUUID(1002),
+			Target:     "improve_suggestion",
+			Vote:       "down",
+			RevisionID: test_utils.NumberUUID(3000),
+		},
+		&vote{
+			UpdatedAt:  updateTime,
+			UserID:     test_utils.NumberUUID(212),
+			PostID:     test_utils.NumberUUID(1002),
+			Target:     "improve_suggestion",
+			Vote:       "up",
+			RevisionID: test_utils.NumberUUID(3001),
+		},
+	)
+
+	data := []struct {
+		name string
+
+		id uuid.UUID
+
+		expect    []*Revision
+		expectErr error
+	}{
+		{
+			name: "Success",
+			id:   test_utils.NumberUUID(1002),
+			expect: []*Revision{
+				{
+					ID:           test_utils.NumberUUID(3001),
+					SuggestionID: test_utils.NumberUUID(1002),
+					CreatedAt:    updateTime,
+					UpVotes:      1,
+					Core: Core{
+						RequestID: test_utils.NumberUUID(1001),
+						Title:     "Test",
+						Content:   "Simple content.",
+					},
+				},
+				{
+					ID:           test_utils.NumberUUID(3000),
+					SuggestionID: test_utils.NumberUUID(1002),
+					CreatedAt:    baseTime,
+					UpVotes:      1,
+					DownVotes:    1,
+					Core: Core{
+						RequestID: test_utils.NumberUUID(1000),
+						Title:     "Test",
+						Content:   "Simple content, first draft.",
+					},
+				},
+			},
+		},
+		{
+			name:      "Error/NotFound",
+			id:        test_utils.NumberUUID(1010),
+			expectErr: validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx, 10)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.ReadRevisions(ctx, d.id)
+				test_utils.RequireError(t, d.expectErr, err)
+				require.Equal(t, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
 func TestImproveSuggestionRepository_Create(t *testing.T) {
 	db, sqlDB := test_utils.GetPostgres(t)
 	defer db.Close()
@@ -72,11 +175,12 @@ func TestImproveSuggestionRepository_Create(t *testing.T) {
 	data := []struct {
 		name string
 
-		data     *Core
-		userID   uuid.UUID
-		sourceID uuid.UUID
-		id       uuid.UUID
-		now      time.Time
+		data       *Core
+		userID     uuid.UUID
+		sourceID   uuid.UUID
+		id         uuid.UUID
+		revisionID uuid.UUID
+		now        time.Time
 
 		expect    *Model
 		expectErr error
@@ -88,15 +192,17 @@ func TestImproveSuggestionRepository_Create(t *testing.T) {
 				Title:     "Test",
 				Content:   "Intelligent content.",
 			},
-			userID:   test_utils.NumberUUID(200),
-			sourceID: test_utils.NumberUUID(1000),
-			id:       test_utils.NumberUUID(1),
-			now:      baseTime,
+			userID:     test_utils.NumberUUID(200),
+			sourceID:   test_utils.NumberUUID(1000),
+			id:         test_utils.NumberUUID(1),
+			revisionID: test_utils.NumberUUID(11),
+			now:        baseTime,
 			expect: &Model{
-				ID:        test_utils.NumberUUID(1),
-				CreatedAt: baseTime,
-				SourceID:  test_utils.NumberUUID(1000),
-				UserID:    test_utils.NumberUUID(200),
+				ID:         test_utils.NumberUUID(1),
+				CreatedAt:  baseTime,
+				SourceID:   test_utils.NumberUUID(1000),
+				UserID:     test_utils.NumberUUID(200),
+				RevisionID: test_utils.NumberUUID(11),
 				Core: Core{
 					RequestID: test_utils.NumberUUID(1000),
 					Title:     "Test",
@@ -111,15 +217,17 @@ func TestImproveSuggestionRepository_Create(t *testing.T) {
 				Title:     "Test",
 				Content:   "Intelligent content.",
 			},
-			userID:   test_utils.NumberUUID(200),
-			sourceID: test_utils.NumberUUID(1000),
-			id:       test_utils.NumberUUID(1),
-			now:      baseTime,
+			userID:     test_utils.NumberUUID(200),
+			sourceID:   test_utils.NumberUUID(1000),
+			id:         test_utils.NumberUUID(1),
+			revisionID: test_utils.NumberUUID(11),
+			now:        baseTime,
 			expect: &Model{
-				ID:        test_utils.NumberUUID(1),
-				CreatedAt: baseTime,
-				SourceID:  test_utils.NumberUUID(1000),
-				UserID:    test_utils.NumberUUID(200),
+				ID:         test_utils.NumberUUID(1),
+				CreatedAt:  baseTime,
+				SourceID:   test_utils.NumberUUID(1000),
+				UserID:     test_utils.NumberUUID(200),
+				RevisionID: test_utils.NumberUUID(11),
 				Core: Core{
 					RequestID: test_utils.NumberUUID(1001),
 					Title:     "Test",
@@ -134,11 +242,12 @@ func TestImproveSuggestionRepository_Create(t *testing.T) {
 				Title:     "Test",
 				Content:   "Intelligent content.",
 			},
-			userID:    test_utils.NumberUUID(200),
-			sourceID:  test_utils.NumberUUID(1001),
-			id:        test_utils.NumberUUID(1),
-			now:       baseTime,
-			expectErr: validation.ErrMissingRelation,
+			userID:     test_utils.NumberUUID(200),
+			sourceID:   test_utils.NumberUUID(1001),
+			id:         test_utils.NumberUUID(1),
+			revisionID: test_utils.NumberUUID(11),
+			now:        baseTime,
+			expectErr:  validation.ErrMissingRelation,
 		},
 		{
 			name: "Error/MissingSource",
@@ -147,11 +256,12 @@ func TestImproveSuggestionRepository_Create(t *testing.T) {
 				Title:     "Test",
 				Content:   "Intelligent content.",
 			},
-			userID:    test_utils.NumberUUID(200),
-			sourceID:  test_utils.NumberUUID(100),
-			id:        test_utils.NumberUUID(1),
-			now:       baseTime,
-			expectErr: validation.ErrMissingRelation,
+			userID:     test_utils.NumberUUID(200),
+			sourceID:   test_utils.NumberUUID(100),
+			id:         test_utils.NumberUUID(1),
+			revisionID: test_utils.NumberUUID(11),
+			now:        baseTime,
+			expectErr:  validation.ErrMissingRelation,
 		},
 		{
 			name: "Error/MissingRequest",
@@ -160,11 +270,12 @@ func TestImproveSuggestionRepository_Create(t *testing.T) {
 				Title:     "Test",
 				Content:   "Intelligent content.",
 			},
-			userID:    test_utils.NumberUUID(200),
-			sourceID:  test_utils.NumberUUID(1000),
-			id:        test_utils.NumberUUID(1),
-			now:       baseTime,
-			expectErr: validation.ErrMissingRelation,
+			userID:     test_utils.NumberUUID(200),
+			sourceID:   test_utils.NumberUUID(1000),
+			id:         test_utils.NumberUUID(1),
+			revisionID: test_utils.NumberUUID(11),
+			now:        baseTime,
+			expectErr:  validation.ErrMissingRelation,
 		},
 		{
 			name: "Error/RevisionAndSourceMismatch",
@@ -173,11 +284,12 @@ func TestImproveSuggestionRepository_Create(t *testing.T) {
 				Title:     "Test",
 				Content:   "Intelligent content.",
 			},
-			userID:    test_utils.NumberUUID(200),
-			sourceID:  test_utils.NumberUUID(1000),
-			id:        test_utils.NumberUUID(1),
-			now:       baseTime,
-			expectErr: validation.ErrMissingRelation,
+			userID:     test_utils.NumberUUID(200),
+			sourceID:   test_utils.NumberUUID(1000),
+			id:         test_utils.NumberUUID(1),
+			revisionID: test_utils.NumberUUID(11),
+			now:        baseTime,
+			expectErr:  validation.ErrMissingRelation,
 		},
 		{
 			name: "Error/AlreadyExists",
@@ -186,11 +298,12 @@ func TestImproveSuggestionRepository_Create(t *testing.T) {
 				Title:     "Test",
 				Content:   "Intelligent content.",
 			},
-			userID:    test_utils.NumberUUID(200),
-			sourceID:  test_utils.NumberUUID(1000),
-			id:        test_utils.NumberUUID(1000),
-			now:       baseTime,
-			expectErr: validation.ErrUniqConstraintViolation,
+			userID:     test_utils.NumberUUID(200),
+			sourceID:   test_utils.NumberUUID(1000),
+			id:         test_utils.NumberUUID(1000),
+			revisionID: test_utils.NumberUUID(11),
+			now:        baseTime,
+			expectErr:  validation.ErrUniqConstraintViolation,
 		},
 		{
 			name: "Error/NoTitle",
@@ -198,11 +311,12 @@ func TestImproveSuggestionRepository_Create(t *testing.T) {
 				RequestID: test_utils.NumberUUID(1000),
 				Content:   "Intelligent content.",
 			},
-			userID:    test_utils.NumberUUID(200),
-			sourceID:  test_utils.NumberUUID(1000),
-			id:        test_utils.NumberUUID(1),
-			now:       baseTime,
-			expectErr: validation.ErrConstraintViolation,
+			userID:     test_utils.NumberUUID(200),
+			sourceID:   test_utils.NumberUUID(1000),
+			id:         test_utils.NumberUUID(1),
+			revisionID: test_utils.NumberUUID(11),
+			now:        baseTime,
+			expectErr:  validation.ErrConstraintViolation,
 		},
 		{
 			name: "Error/NoContent",
@@ -210,11 +324,12 @@ func TestImproveSuggestionRepository_Create(t *testing.T) {
 				RequestID: test_utils.NumberUUID(1000),
 				Title:     "Test",
 			},
-			userID:    test_utils.NumberUUID(200),
-			sourceID:  test_utils.NumberUUID(1000),
-			id:        test_utils.NumberUUID(1),
-			now:       baseTime,
-			expectErr: validation.ErrConstraintViolation,
+			userID:     test_utils.NumberUUID(200),
+			sourceID:   test_utils.NumberUUID(1000),
+			id:         test_utils.NumberUUID(1),
+			revisionID: test_utils.NumberUUID(11),
+			now:        baseTime,
+			expectErr:  validation.ErrConstraintViolation,
 		},
 	}
 
@@ -226,7 +341,7 @@ func TestImproveSuggestionRepository_Create(t *testing.T) {
 				defer stx.Rollback()
 				repository := NewRepository(stx, 10)
 
-				res, err := repository.Create(ctx, d.data, d.userID, d.sourceID, d.id, d.now)
+				res, err := repository.Create(ctx, d.data, d.userID, d.sourceID, d.id, d.revisionID, d.now)
 				test_utils.RequireError(t, d.expectErr, err)
 				require.Equal(t, d.expect, res)
 			})
@@ -243,9 +358,10 @@ func TestImproveSuggestionRepository_Update(t *testing.T) {
 	data := []struct {
 		name string
 
-		data *Core
-		id   uuid.UUID
-		now  time.Time
+		data       *Core
+		id         uuid.UUID
+		revisionID uuid.UUID
+		now        time.Time
 
 		expect    *Model
 		expectErr error
@@ -257,16 +373,18 @@ func TestImproveSuggestionRepository_Update(t *testing.T) {
 				Title:     "Test",
 				Content:   "Good content.",
 			},
-			id:  test_utils.NumberUUID(1002),
-			now: updateTime,
+			id:         test_utils.NumberUUID(1002),
+			revisionID: test_utils.NumberUUID(12),
+			now:        updateTime,
 			expect: &Model{
-				ID:        test_utils.NumberUUID(1002),
-				CreatedAt: baseTime,
-				UpdatedAt: &updateTime,
-				SourceID:  test_utils.NumberUUID(1000),
-				UserID:    test_utils.NumberUUID(200),
-				UpVotes:   21,
-				DownVotes: 8,
+				ID:         test_utils.NumberUUID(1002),
+				CreatedAt:  baseTime,
+				UpdatedAt:  &updateTime,
+				SourceID:   test_utils.NumberUUID(1000),
+				UserID:     test_utils.NumberUUID(200),
+				RevisionID: test_utils.NumberUUID(12),
+				UpVotes:    21,
+				DownVotes:  8,
 				Core: Core{
 					RequestID: test_utils.NumberUUID(1002),
 					Title:     "Test",
@@ -281,9 +399,10 @@ func TestImproveSuggestionRepository_Update(t *testing.T) {
 				Title:     "Test",
 				Content:   "Good content.",
 			},
-			id:        test_utils.NumberUUID(1002),
-			now:       baseTime,
-			expectErr: validation.ErrMissingRelation,
+			id:         test_utils.NumberUUID(1002),
+			revisionID: test_utils.NumberUUID(12),
+			now:        baseTime,
+			expectErr:  validation.ErrMissingRelation,
 		},
 		{
 			name: "Error/MissingSource",
@@ -292,9 +411,10 @@ func TestImproveSuggestionRepository_Update(t *testing.T) {
 				Title:     "Test",
 				Content:   "Good content.",
 			},
-			id:        test_utils.NumberUUID(1002),
-			now:       baseTime,
-			expectErr: validation.ErrMissingRelation,
+			id:         test_utils.NumberUUID(1002),
+			revisionID: test_utils.NumberUUID(12),
+			now:        baseTime,
+			expectErr:  validation.ErrMissingRelation,
 		},
 		{
 			name: "Error/NoTitle",
@@ -302,9 +422,10 @@ func TestImproveSuggestionRepository_Update(t *testing.T) {
 				RequestID: test_utils.NumberUUID(1002),
 				Content:   "Good content.",
 			},
-			id:        test_utils.NumberUUID(1002),
-			now:       baseTime,
-			expectErr: validation.ErrConstraintViolation,
+			id:         test_utils.NumberUUID(1002),
+			revisionID: test_utils.NumberUUID(12),
+			now:        baseTime,
+			expectErr:  validation.ErrConstraintViolation,
 		},
 		{
 			name: "Error/NoContent",
@@ -312,9 +433,10 @@ func TestImproveSuggestionRepository_Update(t *testing.T) {
 				RequestID: test_utils.NumberUUID(1002),
 				Title:     "Test",
 			},
-			id:        test_utils.NumberUUID(1002),
-			now:       baseTime,
-			expectErr: validation.ErrConstraintViolation,
+			id:         test_utils.NumberUUID(1002),
+			revisionID: test_utils.NumberUUID(12),
+			now:        baseTime,
+			expectErr:  validation.ErrConstraintViolation,
 		},
 	}
 
@@ -326,7 +448,7 @@ func TestImproveSuggestionRepository_Update(t *testing.T) {
 				defer stx.Rollback()
 				repository := NewRepository(stx, 10)
 
-				res, err := repository.Update(ctx, d.data, d.id, d.now)
+				res, err := repository.Update(ctx, d.data, d.id, d.revisionID, d.now)
 				test_utils.RequireError(t, d.expectErr, err)
 				require.Equal(t, d.expect, res)
 			})
@@ -365,13 +487,410 @@ func TestImproveSuggestionRepository_Delete(t *testing.T) {
 				require.NoError(st, err)
 				defer stx.Rollback()
 				repository := NewRepository(stx, 10)
-				test_utils.RequireError(t, d.expectErr, repository.Delete(ctx, d.id))
+				test_utils.RequireError(t, d.expectErr, repository.Delete(ctx, d.id, deleteTime))
+
+				if d.expectErr == nil {
+					// The suggestion is kept, but can no longer be read.
+					_, err := repository.Read(ctx, d.id)
+					require.ErrorIs(st, err, validation.ErrNotFound)
+
+					deleted, err := repository.ReadDeleted(ctx, d.id)
+					require.NoError(st, err)
+					require.Equal(st, &deleteTime, deleted.DeletedAt)
+
+					// Deleting twice fails.
+					require.ErrorIs(st, repository.Delete(ctx, d.id, deleteTime), validation.ErrNotFound)
+				}
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+var DeletedFixtures = test_utils.Concat(Fixtures, []interface{}{
+	&improve_request_storage.Model{
+		ID:        test_utils.NumberUUID(7000),
+		CreatedAt: baseTime,
+		Source:    test_utils.NumberUUID(7000),
+		UserID:    test_utils.NumberUUID(200),
+		Title:     "Deleted",
+		Content:   "Deleted request.",
+		DeletedAt: framework.ToPTR(deleteTime),
+	},
+	&Model{
+		ID:        test_utils.NumberUUID(2001),
+		CreatedAt: baseTime,
+		SourceID:  test_utils.NumberUUID(5000),
+		UserID:    test_utils.NumberUUID(202),
+		UpVotes:   2,
+		DeletedAt: framework.ToPTR(deleteTime),
+		Core: Core{
+			RequestID: test_utils.NumberUUID(5000),
+			Title:     "Lorem deleted",
+			Content:   "Deleted suggestion.",
+		},
+	},
+	// Suggestion of a deleted request.
+	&Model{
+		ID:        test_utils.NumberUUID(2002),
+		CreatedAt: baseTime,
+		SourceID:  test_utils.NumberUUID(7000),
+		UserID:    test_utils.NumberUUID(202),
+		Core: Core{
+			RequestID: test_utils.NumberUUID(7000),
+			Title:     "Deleted request",
+			Content:   "Live suggestion.",
+		},
+	},
+	// Deleted, but still under review.
+	&Model{
+		ID:        test_utils.NumberUUID(2003),
+		CreatedAt: baseTime,
+		SourceID:  test_utils.NumberUUID(5000),
+		UserID:    test_utils.NumberUUID(203),
+		DeletedAt: framework.ToPTR(deleteTime),
+		Core: Core{
+			RequestID: test_utils.NumberUUID(5000),
+			Title:     "Reported",
+			Content:   "Reported suggestion.",
+		},
+	},
+	&reports_storage.Case{
+		Target:    reports_storage.TargetImproveSuggestion,
+		TargetID:  test_utils.NumberUUID(2003),
+		Status:    reports_storage.StatusPending,
+		Reports:   1,
+		CreatedAt: baseTime,
+	},
+})
+
+func TestImproveSuggestionRepository_ReadDeleted(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		id uuid.UUID
+
+		expect    *Model
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			id:     test_utils.NumberUUID(2001),
+			expect: DeletedFixtures[len(Fixtures)+1].(*Model),
+		},
+		{
+			name:      "Error/NotDeleted",
+			id:        test_utils.NumberUUID(2000),
+			expectErr: validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, DeletedFixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx, 10)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.ReadDeleted(ctx, d.id)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveSuggestionRepository_Read_DeletedRequest(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := test_utils.Concat(DeletedFixtures, []interface{}{
+		&Revision{
+			ID:           test_utils.NumberUUID(3002),
+			SuggestionID: test_utils.NumberUUID(2002),
+			CreatedAt:    baseTime,
+			Core: Core{
+				RequestID: test_utils.NumberUUID(7000),
+				Title:     "Deleted request",
+				Content:   "Live suggestion.",
+			},
+		},
+	})
+
+	err := test_utils.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx, 10)
+
+		_, err := repository.Read(ctx, test_utils.NumberUUID(2002))
+		require.ErrorIs(t, err, validation.ErrNotFound)
+
+		_, err = repository.ReadRevisions(ctx, test_utils.NumberUUID(2002))
+		require.ErrorIs(t, err, validation.ErrNotFound)
+
+		// Restoring the request makes its suggestions readable again.
+		_, err = tx.NewUpdate().
+			Table("improve_requests").
+			Set("deleted_at = NULL").
+			Where("id = ?", test_utils.NumberUUID(7000)).
+			Exec(ctx)
+		require.NoError(t, err)
+
+		res, err := repository.Read(ctx, test_utils.NumberUUID(2002))
+		require.NoError(t, err)
+		require.Equal(t, test_utils.NumberUUID(2002), res.ID)
+
+		revisions, err := repository.ReadRevisions(ctx, test_utils.NumberUUID(2002))
+		require.NoError(t, err)
+		require.Len(t, revisions, 1)
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveSuggestionRepository_Restore(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		id           uuid.UUID
+		deletedAfter time.Time
+
+		expect    *Model
+		expectErr error
+	}{
+		{
+			name:         "Success",
+			id:           test_utils.NumberUUID(2001),
+			deletedAfter: deleteTime.Add(-time.Minute),
+			expect: &Model{
+				ID:        test_utils.NumberUUID(2001),
+				CreatedAt: baseTime,
+				SourceID:  test_utils.NumberUUID(5000),
+				UserID:    test_utils.NumberUUID(202),
+				UpVotes:   2,
+				Core: Core{
+					RequestID: test_utils.NumberUUID(5000),
+					Title:     "Lorem deleted",
+					Content:   "Deleted suggestion.",
+				},
+			},
+		},
+		{
+			name:         "Error/Expired",
+			id:           test_utils.NumberUUID(2001),
+			deletedAfter: deleteTime.Add(time.Minute),
+			expectErr:    validation.ErrNotFound,
+		},
+		{
+			name:         "Error/NotDeleted",
+			id:           test_utils.NumberUUID(2000),
+			deletedAfter: deleteTime.Add(-time.Minute),
+			expectErr:    validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, DeletedFixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx, 10)
+				res, err := repository.Restore(ctx, d.id, d.deletedAfter)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveSuggestionRepository_Purge(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		deletedBefore time.Time
+
+		expect          int64
+		expectRemaining []uuid.UUID
+	}{
+		{
+			name:            "Success",
+			deletedBefore:   deleteTime.Add(time.Minute),
+			expect:          1,
+			expectRemaining: []uuid.UUID{test_utils.NumberUUID(2003)},
+		},
+		{
+			name:            "Success/Nothing",
+			deletedBefore:   deleteTime,
+			expectRemaining: []uuid.UUID{test_utils.NumberUUID(2001), test_utils.NumberUUID(2003)},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, DeletedFixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx, 10)
+				res, err := repository.Purge(ctx, d.deletedBefore)
+				require.NoError(st, err)
+				require.Equal(st, d.expect, res)
+
+				remaining := make([]uuid.UUID, 0)
+				err = stx.NewSelect().
+					Model((*Model)(nil)).
+					Column("id").
+					Where("deleted_at IS NOT NULL").
+					Order("id").
+					Scan(ctx, &remaining)
+				require.NoError(st, err)
+				require.Equal(st, d.expectRemaining, remaining)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+// Suggestions of requests that were removed before the cleanup trigger existed.
+var OrphanFixtures = test_utils.Concat(Fixtures, []interface{}{
+	&Model{
+		ID:        test_utils.NumberUUID(2100),
+		CreatedAt: baseTime,
+		SourceID:  test_utils.NumberUUID(9000),
+		UserID:    test_utils.NumberUUID(201),
+		Core: Core{
+			RequestID: test_utils.NumberUUID(9000),
+			Title:     "Orphan",
+			Content:   "Suggestion of a missing request.",
+		},
+	},
+	&Model{
+		ID:        test_utils.NumberUUID(2101),
+		CreatedAt: baseTime,
+		SourceID:  test_utils.NumberUUID(9001),
+		UserID:    test_utils.NumberUUID(202),
+		Core: Core{
+			RequestID: test_utils.NumberUUID(9001),
+			Title:     "Orphan",
+			Content:   "Suggestion of another missing request.",
+		},
+	},
+})
+
+func TestImproveSuggestionRepository_CountOrphans(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	err := test_utils.RunTransactionalTest(db, OrphanFixtures, func(ctx context.Context, tx bun.Tx) {
+		count, err := NewRepository(tx, 10).CountOrphans(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), count)
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveSuggestionRepository_DeleteOrphans(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		limit int
+
+		expect          int64
+		expectRemaining int64
+	}{
+		{
+			name:            "Success",
+			limit:           10,
+			expect:          2,
+			expectRemaining: 0,
+		},
+		{
+			name:            "Success/Batch",
+			limit:           1,
+			expect:          1,
+			expectRemaining: 1,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, OrphanFixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx, 10)
+				count, err := repository.DeleteOrphans(ctx, d.limit)
+				require.NoError(st, err)
+				require.Equal(st, d.expect, count)
+
+				remaining, err := repository.CountOrphans(ctx)
+				require.NoError(st, err)
+				require.Equal(st, d.expectRemaining, remaining)
+
+				// Suggestions of existing requests are kept.
+				_, err = repository.Read(ctx, test_utils.NumberUUID(2000))
+				require.NoError(st, err)
 			})
 		}
 	})
 	require.NoError(t, err)
 }
 
+func TestImproveSuggestionRepository_DeleteRequestCascade(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	countSuggestions := func(ctx context.Context, tx bun.Tx, source uuid.UUID) int {
+		count, err := tx.NewSelect().Model((*Model)(nil)).Where("source_id = ?", source).Count(ctx)
+		require.NoError(t, err)
+		return count
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		before := countSuggestions(ctx, tx, test_utils.NumberUUID(1000))
+		require.NotZero(t, before)
+
+		// The request keeps other revisions, so its suggestions stay.
+		_, err := tx.NewDelete().
+			Model(new(improve_request_storage.Model)).
+			Where("id = ?", test_utils.NumberUUID(1002)).
+			Exec(ctx)
+		require.NoError(t, err)
+		require.Equal(t, before, countSuggestions(ctx, tx, test_utils.NumberUUID(1000)))
+
+		_, err = tx.NewDelete().
+			Model(new(improve_request_storage.Model)).
+			Where("source = ?", test_utils.NumberUUID(1000)).
+			Exec(ctx)
+		require.NoError(t, err)
+		require.Zero(t, countSuggestions(ctx, tx, test_utils.NumberUUID(1000)))
+
+		// Other threads are left untouched.
+		require.Equal(t, 1, countSuggestions(ctx, tx, test_utils.NumberUUID(5000)))
+	})
+	require.NoError(t, err)
+}
+
 func TestImproveSuggestionRepository_Validate(t *testing.T) {
 	db, sqlDB := test_utils.GetPostgres(t)
 	defer db.Close()
@@ -465,6 +984,59 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 		expectCount int64
 		expectErr   error
 	}{
+		{
+			name: "Success/Query",
+			query: ListQuery{
+				Query: "smart",
+			},
+			expectCount: 1,
+			limit:       10,
+			offset:      0,
+			expect: []*Model{
+				{
+					ID:        test_utils.NumberUUID(1001),
+					CreatedAt: baseTime,
+					SourceID:  test_utils.NumberUUID(1000),
+					UserID:    test_utils.NumberUUID(201),
+					Validated: true,
+					UpVotes:   7,
+					DownVotes: 2,
+					Reactions: map[string]int64{"moving": 1, "funny": 1},
+					Core: Core{
+						RequestID: test_utils.NumberUUID(1000),
+						Title:     "Test",
+						Content:   "Smart cont",
+					},
+				},
+			},
+		},
+		// Reactions.
+		{
+			name: "Success/Reactions",
+			query: ListQuery{
+				Reactions: []string{"moving", "funny"},
+			},
+			expectCount: 1,
+			limit:       10,
+			offset:      0,
+			expect: []*Model{
+				{
+					ID:        test_utils.NumberUUID(1001),
+					CreatedAt: baseTime,
+					SourceID:  test_utils.NumberUUID(1000),
+					UserID:    test_utils.NumberUUID(201),
+					Validated: true,
+					UpVotes:   7,
+					DownVotes: 2,
+					Reactions: map[string]int64{"moving": 1, "funny": 1},
+					Core: Core{
+						RequestID: test_utils.NumberUUID(1000),
+						Title:     "Test",
+						Content:   "Smart cont",
+					},
+				},
+			},
+		},
 		// Validated.
 		{
 			name: "Success/Validated",
@@ -488,6 +1060,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					UserID:    test_utils.NumberUUID(200),
 					Validated: true,
 					UpVotes:   10,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1000),
 						Title:     "Test 2",
@@ -502,6 +1075,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					Validated: true,
 					UpVotes:   7,
 					DownVotes: 2,
+					Reactions: map[string]int64{"moving": 1, "funny": 1},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1000),
 						Title:     "Test",
@@ -517,6 +1091,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					Validated: true,
 					UpVotes:   16,
 					DownVotes: 13,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1001),
 						Title:     "Test 3",
@@ -530,6 +1105,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					SourceID:  test_utils.NumberUUID(1000),
 					UserID:    test_utils.NumberUUID(201),
 					Validated: true,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1001),
 						Title:     "Test 7",
@@ -562,6 +1138,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					Validated: true,
 					UpVotes:   16,
 					DownVotes: 13,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1001),
 						Title:     "Test 3",
@@ -575,6 +1152,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					SourceID:  test_utils.NumberUUID(1000),
 					UserID:    test_utils.NumberUUID(201),
 					Validated: true,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1001),
 						Title:     "Test 7",
@@ -606,6 +1184,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					Validated: true,
 					UpVotes:   16,
 					DownVotes: 13,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1001),
 						Title:     "Test 3",
@@ -619,6 +1198,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					SourceID:  test_utils.NumberUUID(1000),
 					UserID:    test_utils.NumberUUID(201),
 					Validated: true,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1001),
 						Title:     "Test 7",
@@ -649,6 +1229,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					UserID:    test_utils.NumberUUID(200),
 					Validated: true,
 					UpVotes:   10,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1000),
 						Title:     "Test 2",
@@ -663,6 +1244,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					Validated: true,
 					UpVotes:   7,
 					DownVotes: 2,
+					Reactions: map[string]int64{"moving": 1, "funny": 1},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1000),
 						Title:     "Test",
@@ -694,6 +1276,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					UserID:    test_utils.NumberUUID(200),
 					UpVotes:   21,
 					DownVotes: 8,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1001),
 						Title:     "Test",
@@ -707,6 +1290,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					SourceID:  test_utils.NumberUUID(1000),
 					UserID:    test_utils.NumberUUID(200),
 					UpVotes:   9,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1000),
 						Title:     "Test 6",
@@ -721,6 +1305,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					UserID:    test_utils.NumberUUID(200),
 					UpVotes:   32,
 					DownVotes: 24,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1000),
 						Title:     "Test 5",
@@ -735,6 +1320,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					UserID:    test_utils.NumberUUID(201),
 					UpVotes:   4,
 					DownVotes: 8,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1001),
 						Title:     "Test 4",
@@ -766,6 +1352,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					UserID:    test_utils.NumberUUID(200),
 					UpVotes:   21,
 					DownVotes: 8,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1001),
 						Title:     "Test",
@@ -780,6 +1367,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					UserID:    test_utils.NumberUUID(201),
 					UpVotes:   4,
 					DownVotes: 8,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1001),
 						Title:     "Test 4",
@@ -810,6 +1398,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					UserID:    test_utils.NumberUUID(200),
 					UpVotes:   32,
 					DownVotes: 24,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1000),
 						Title:     "Test 5",
@@ -824,6 +1413,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					UserID:    test_utils.NumberUUID(201),
 					UpVotes:   4,
 					DownVotes: 8,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1001),
 						Title:     "Test 4",
@@ -854,6 +1444,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					UserID:    test_utils.NumberUUID(200),
 					UpVotes:   21,
 					DownVotes: 8,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1001),
 						Title:     "Test",
@@ -867,6 +1458,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					SourceID:  test_utils.NumberUUID(1000),
 					UserID:    test_utils.NumberUUID(200),
 					UpVotes:   9,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1000),
 						Title:     "Test 6",
@@ -897,6 +1489,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					Validated: true,
 					UpVotes:   7,
 					DownVotes: 2,
+					Reactions: map[string]int64{"moving": 1, "funny": 1},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1000),
 						Title:     "Test",
@@ -911,6 +1504,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					UserID:    test_utils.NumberUUID(201),
 					UpVotes:   4,
 					DownVotes: 1,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(5000),
 						Title:     "Ipsum Lorem",
@@ -924,6 +1518,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					SourceID:  test_utils.NumberUUID(1000),
 					UserID:    test_utils.NumberUUID(201),
 					Validated: true,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1001),
 						Title:     "Test 7",
@@ -938,6 +1533,7 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 					UserID:    test_utils.NumberUUID(201),
 					UpVotes:   4,
 					DownVotes: 8,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1001),
 						Title:     "Test 4",
@@ -992,7 +1588,32 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 		},
 	}
 
-	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+	fixtures := test_utils.Concat(Fixtures, []interface{}{
+		&votes_storage.Reaction{
+			PostID:    test_utils.NumberUUID(1001),
+			Target:    votes_storage.TargetImproveSuggestion,
+			Reaction:  "moving",
+			UserID:    test_utils.NumberUUID(210),
+			CreatedAt: baseTime,
+		},
+		&votes_storage.Reaction{
+			PostID:    test_utils.NumberUUID(1001),
+			Target:    votes_storage.TargetImproveSuggestion,
+			Reaction:  "funny",
+			UserID:    test_utils.NumberUUID(211),
+			CreatedAt: baseTime,
+		},
+		// Reactions on a request never match its suggestions.
+		&votes_storage.Reaction{
+			PostID:    test_utils.NumberUUID(1000),
+			Target:    votes_storage.TargetImproveRequest,
+			Reaction:  "moving",
+			UserID:    test_utils.NumberUUID(210),
+			CreatedAt: baseTime,
+		},
+	})
+
+	err := test_utils.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
 		repository := NewRepository(tx, 10)
 
 		for _, d := range data {
@@ -1011,6 +1632,239 @@ func TestImproveSuggestionRepository_List(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestImproveSuggestionRepository_List_HiddenRequests(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := test_utils.Concat(Fixtures, []interface{}{
+		&visibility_storage.Access{
+			Source:     test_utils.NumberUUID(5000),
+			Visibility: visibility_storage.VisibilityUnlisted,
+			UpdatedAt:  baseTime,
+		},
+	})
+
+	data := []struct {
+		name string
+
+		query ListQuery
+
+		expectIDs []uuid.UUID
+	}{
+		{
+			name:  "Success/Hidden",
+			query: ListQuery{UserID: framework.ToPTR(test_utils.NumberUUID(201))},
+			expectIDs: []uuid.UUID{
+				test_utils.NumberUUID(1001),
+				test_utils.NumberUUID(1004),
+				test_utils.NumberUUID(1007),
+			},
+		},
+		{
+			name: "Success/FromSource",
+			query: ListQuery{
+				UserID:   framework.ToPTR(test_utils.NumberUUID(201)),
+				SourceID: framework.ToPTR(test_utils.NumberUUID(5000)),
+			},
+			expectIDs: []uuid.UUID{test_utils.NumberUUID(2000)},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx, 10)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, _, err := repository.List(ctx, d.query, 10, 0)
+				require.NoError(st, err)
+
+				ids := make([]uuid.UUID, len(res))
+				for i, suggestion := range res {
+					ids[i] = suggestion.ID
+				}
+
+				require.ElementsMatch(st, d.expectIDs, ids)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveSuggestionRepository_List_Deleted(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		query ListQuery
+
+		expectIDs        []uuid.UUID
+		expectTombstones []uuid.UUID
+	}{
+		{
+			name:  "Success/Thread",
+			query: ListQuery{SourceID: framework.ToPTR(test_utils.NumberUUID(5000))},
+			expectIDs: []uuid.UUID{
+				test_utils.NumberUUID(2000),
+				test_utils.NumberUUID(2001),
+				test_utils.NumberUUID(2003),
+			},
+			expectTombstones: []uuid.UUID{test_utils.NumberUUID(2001), test_utils.NumberUUID(2003)},
+		},
+		{
+			name: "Success/ThreadWithQuery",
+			query: ListQuery{
+				SourceID: framework.ToPTR(test_utils.NumberUUID(5000)),
+				Query:    "lorem",
+			},
+			expectIDs: []uuid.UUID{test_utils.NumberUUID(2000)},
+		},
+		{
+			name:  "Success/User",
+			query: ListQuery{UserID: framework.ToPTR(test_utils.NumberUUID(202))},
+		},
+		{
+			name:  "Success/DeletedRequest",
+			query: ListQuery{SourceID: framework.ToPTR(test_utils.NumberUUID(7000))},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, DeletedFixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx, 10)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, _, err := repository.List(ctx, d.query, 10, 0)
+				require.NoError(st, err)
+
+				ids := make([]uuid.UUID, len(res))
+				tombstones := make([]uuid.UUID, 0)
+				for i, suggestion := range res {
+					ids[i] = suggestion.ID
+
+					if suggestion.DeletedAt != nil {
+						require.Empty(st, suggestion.Title)
+						require.Empty(st, suggestion.Content)
+						tombstones = append(tombstones, suggestion.ID)
+					}
+				}
+
+				require.ElementsMatch(st, d.expectIDs, ids)
+				require.ElementsMatch(st, d.expectTombstones, tombstones)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveSuggestionRepository_List_PurgedRequest(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	err := test_utils.RunTransactionalTest(db, DeletedFixtures, func(ctx context.Context, tx bun.Tx) {
+		requestRepository := improve_request_storage.NewRepository(tx, 10)
+		repository := NewRepository(tx, 10)
+
+		purged, err := requestRepository.Purge(ctx, deleteTime.Add(time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, int64(1), purged)
+
+		// The suggestions of the purged request must not come back once the request is gone.
+		res, _, err := repository.List(ctx, ListQuery{UserID: framework.ToPTR(test_utils.NumberUUID(202))}, 10, 0)
+		require.NoError(t, err)
+		require.Empty(t, res)
+
+		res, _, err = repository.List(ctx, ListQuery{SourceID: framework.ToPTR(test_utils.NumberUUID(7000))}, 10, 0)
+		require.NoError(t, err)
+		require.Empty(t, res)
+
+		exists, err := tx.NewSelect().Model((*Model)(nil)).Where("id = ?", test_utils.NumberUUID(2002)).Exists(ctx)
+		require.NoError(t, err)
+		require.False(t, exists)
+
+		// Other threads are left untouched.
+		res, _, err = repository.List(ctx, ListQuery{SourceID: framework.ToPTR(test_utils.NumberUUID(5000))}, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, res, 3)
+	})
+	require.NoError(t, err)
+}
+
+func TestImproveSuggestionRepository_ListAfter(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		query ListQuery
+	}{
+		{
+			name: "Success",
+			query: ListQuery{
+				SourceID: framework.ToPTR(test_utils.NumberUUID(1000)),
+			},
+		},
+		{
+			name: "Success/Query",
+			query: ListQuery{
+				Query: "smart",
+			},
+		},
+		{
+			name: "Success/Score",
+			query: ListQuery{
+				SourceID: framework.ToPTR(test_utils.NumberUUID(1000)),
+				Order: &SearchQueryOrder{
+					Score: true,
+				},
+			},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx, 10)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				expect, _, err := repository.List(ctx, d.query, 100, 0)
+				require.NoError(st, err)
+
+				// Reading the results one by one must return the same results as a single page, in the same order.
+				var (
+					res    []*Model
+					cursor string
+				)
+				for i := 0; i <= len(expect); i++ {
+					page, next, err := repository.ListAfter(ctx, d.query, cursor, 1)
+					require.NoError(st, err)
+
+					res = append(res, page...)
+					cursor = next
+
+					if next == "" {
+						break
+					}
+				}
+
+				require.Equal(st, expect, res)
+				require.Empty(st, cursor)
+			})
+		}
+
+		t.Run("Error/InvalidCursor", func(st *testing.T) {
+			_, _, err := repository.ListAfter(ctx, ListQuery{}, "foo", 1)
+			require.ErrorIs(st, err, validation.ErrInvalidEntity)
+		})
+	})
+	require.NoError(t, err)
+}
+
 func TestImproveSuggestionRepository_IsCreator(t *testing.T) {
 	db, sqlDB := test_utils.GetPostgres(t)
 	defer db.Close()
@@ -1081,6 +1935,7 @@ func TestImproveSuggestionRepository_GetPreviews(t *testing.T) {
 					Validated: true,
 					UpVotes:   7,
 					DownVotes: 2,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1000),
 						Title:     "Test",
@@ -1094,6 +1949,7 @@ func TestImproveSuggestionRepository_GetPreviews(t *testing.T) {
 					SourceID:  test_utils.NumberUUID(1000),
 					UserID:    test_utils.NumberUUID(200),
 					UpVotes:   9,
+					Reactions: map[string]int64{},
 					Core: Core{
 						RequestID: test_utils.NumberUUID(1000),
 						Title:     "Test 6",
@@ -7,6 +7,7 @@ import (
 var (
 	baseTime   = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
 	updateTime = time.Date(2020, time.May, 4, 8, 10, 0, 0, time.UTC)
+	deleteTime = time.Date(2020, time.May, 4, 12, 0, 0, 0, time.UTC)
 )
 
 var Fixtures = []interface{}{
@@ -177,4 +178,25 @@ var Fixtures = []interface{}{
 			Content:   "Lorem ipsum dolor sit amet, consectetur adipiscing elit. Vestibulum tempor congue aliquam. Nam ullamcorper mi lectus, et dictum urna imperdiet a.",
 		},
 	},
+	// Revisions.
+	&Revision{
+		ID:           test_utils.NumberUUID(3000),
+		SuggestionID: test_utils.NumberUUID(1002),
+		CreatedAt:    baseTime,
+		Core: Core{
+			RequestID: test_utils.NumberUUID(1000),
+			Title:     "Test",
+			Content:   "Simple content, first draft.",
+		},
+	},
+	&Revision{
+		ID:           test_utils.NumberUUID(3001),
+		SuggestionID: test_utils.NumberUUID(1002),
+		CreatedAt:    updateTime,
+		Core: Core{
+			RequestID: test_utils.NumberUUID(1001),
+			Title:     "Test",
+			Content:   "Simple content.",
+		},
+	},
 }
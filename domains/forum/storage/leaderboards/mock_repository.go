@@ -0,0 +1,144 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package leaderboards_storage
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function with given fields: ctx, board, period, limit, offset
+func (_m *MockRepository) List(ctx context.Context, board Board, period Period, limit int, offset int) ([]*Entry, int64, error) {
+	ret := _m.Called(ctx, board, period, limit, offset)
+
+	var r0 []*Entry
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, Board, Period, int, int) ([]*Entry, int64, error)); ok {
+		return rf(ctx, board, period, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, Board, Period, int, int) []*Entry); ok {
+		r0 = rf(ctx, board, period, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Entry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, Board, Period, int, int) int64); ok {
+		r1 = rf(ctx, board, period, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, Board, Period, int, int) error); ok {
+		r2 = rf(ctx, board, period, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - board Board
+//   - period Period
+//   - limit int
+//   - offset int
+func (_e *MockRepository_Expecter) List(ctx interface{}, board interface{}, period interface{}, limit interface{}, offset interface{}) *MockRepository_List_Call {
+	return &MockRepository_List_Call{Call: _e.mock.On("List", ctx, board, period, limit, offset)}
+}
+
+func (_c *MockRepository_List_Call) Run(run func(ctx context.Context, board Board, period Period, limit int, offset int)) *MockRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(Board), args[2].(Period), args[3].(int), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_List_Call) Return(_a0 []*Entry, _a1 int64, _a2 error) *MockRepository_List_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRepository_List_Call) RunAndReturn(run func(context.Context, Board, Period, int, int) ([]*Entry, int64, error)) *MockRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function with given fields: ctx
+func (_m *MockRepository) Refresh(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type MockRepository_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRepository_Expecter) Refresh(ctx interface{}) *MockRepository_Refresh_Call {
+	return &MockRepository_Refresh_Call{Call: _e.mock.On("Refresh", ctx)}
+}
+
+func (_c *MockRepository_Refresh_Call) Run(run func(ctx context.Context)) *MockRepository_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Refresh_Call) Return(_a0 error) *MockRepository_Refresh_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Refresh_Call) RunAndReturn(run func(context.Context) error) *MockRepository_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockRepository(t mockConstructorTestingTNewMockRepository) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,42 @@
+package leaderboards_storage
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// Board is the ranking criteria of a leaderboard.
+type Board string
+
+const (
+	// BoardAcceptedSuggestions ranks users by their number of accepted suggestions.
+	BoardAcceptedSuggestions Board = "accepted_suggestions"
+	// BoardSuggestionScore ranks users by the net score of the votes on their suggestions.
+	BoardSuggestionScore Board = "suggestion_score"
+	// BoardRequests ranks improvement requests by the net score of the votes on their revisions.
+	BoardRequests Board = "requests"
+)
+
+// Period is the time window a leaderboard covers.
+type Period string
+
+const (
+	// PeriodMonth only accounts for the activity since the beginning of the current month.
+	PeriodMonth Period = "month"
+	// PeriodAllTime accounts for the whole activity of the forum.
+	PeriodAllTime Period = "all_time"
+)
+
+// Entry is the database model for the forum_leaderboards materialized view. The view is only updated by Refresh.
+type Entry struct {
+	bun.BaseModel `bun:"table:forum_leaderboards,alias:leaderboard"`
+
+	Period Period `json:"period" bun:"period"`
+	Board  Board  `json:"board" bun:"board"`
+	// TargetID is the ID of the user for the suggestion boards, and the source of the improvement request for
+	// BoardRequests.
+	TargetID uuid.UUID `json:"target_id" bun:"target_id,type:uuid"`
+	Score    int64     `json:"score" bun:"score"`
+	// Rank of the entry on its board, starting at 1. Entries with the same score share the same rank.
+	Rank int64 `json:"rank" bun:"rank,scanonly"`
+}
@@ -0,0 +1,63 @@
+package leaderboards_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/domains/forum/storage/improve_request"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/uptrace/bun"
+)
+
+// Repository of the current layer. You can instantiate a new one with NewRepository.
+type Repository interface {
+	// List returns the entries of a leaderboard, by decreasing score. Results must be paginated using the limit and
+	// offset parameters. Requests that are not public, or were hidden after being reported, are left out of
+	// BoardRequests.
+	// It also returns the total number of available results, to help with pagination.
+	List(ctx context.Context, board Board, period Period, limit, offset int) ([]*Entry, int64, error)
+	// Refresh recomputes every leaderboard. Until then, List returns the results of the previous computation.
+	Refresh(ctx context.Context) error
+}
+
+// NewRepository returns a new Repository instance.
+// To use a mocked one, call NewMockRepository.
+func NewRepository(db bun.IDB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+type repositoryImpl struct {
+	db bun.IDB
+}
+
+func (repository *repositoryImpl) List(ctx context.Context, board Board, period Period, limit, offset int) ([]*Entry, int64, error) {
+	results := make([]*Entry, 0)
+
+	query := repository.db.NewSelect().
+		Model(&results).
+		ColumnExpr("leaderboard.*").
+		ColumnExpr("RANK() OVER (ORDER BY leaderboard.score DESC) AS rank").
+		Where("leaderboard.board = ?", board).
+		Where("leaderboard.period = ?", period).
+		OrderExpr("leaderboard.score DESC, leaderboard.target_id").
+		Limit(limit).
+		Offset(offset)
+
+	if board == BoardRequests {
+		query = query.Where("leaderboard.target_id NOT IN (?)", improve_request_storage.SelectHiddenSources(repository.db))
+	}
+
+	count, err := query.ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, validation.HandlePGError(err)
+	}
+
+	return results, int64(count), nil
+}
+
+func (repository *repositoryImpl) Refresh(ctx context.Context) error {
+	// Concurrent refresh does not lock the view, so leaderboards remain readable during the computation.
+	if _, err := repository.db.NewRaw("REFRESH MATERIALIZED VIEW CONCURRENTLY forum_leaderboards").Exec(ctx); err != nil {
+		return validation.HandlePGError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,220 @@
+package leaderboards_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+)
+
+func requestFixture(id, source, userID int) *improve_request_storage.Model {
+	return &improve_request_storage.Model{
+		ID:        test_utils.NumberUUID(id),
+		CreatedAt: baseTime,
+		Source:    test_utils.NumberUUID(source),
+		UserID:    test_utils.NumberUUID(userID),
+		Title:     "Test",
+		Content:   "Dummy content.",
+	}
+}
+
+func suggestionFixture(id, userID int, validated bool, createdAt time.Time) *improve_suggestion_storage.Model {
+	return &improve_suggestion_storage.Model{
+		ID:        test_utils.NumberUUID(id),
+		CreatedAt: createdAt,
+		SourceID:  test_utils.NumberUUID(1000),
+		UserID:    test_utils.NumberUUID(userID),
+		Validated: validated,
+		Core: improve_suggestion_storage.Core{
+			RequestID: test_utils.NumberUUID(1000),
+			Title:     "Test",
+			Content:   "Dummy content.",
+		},
+	}
+}
+
+func voteFixture(postID, userID int, target votes_storage.Target, vote votes_storage.Vote, updatedAt time.Time) *votes_storage.Model {
+	return &votes_storage.Model{
+		UpdatedAt: updatedAt,
+		PostID:    test_utils.NumberUUID(postID),
+		UserID:    test_utils.NumberUUID(userID),
+		Target:    target,
+		Vote:      vote,
+	}
+}
+
+// Monthly leaderboards depend on the current time.
+func getFixtures(now time.Time) []interface{} {
+	deletedRequest := requestFixture(4000, 4000, 103)
+	deletedRequest.DeletedAt = &baseTime
+
+	deletedSuggestion := suggestionFixture(5004, 302, true, now)
+	deletedSuggestion.DeletedAt = framework.ToPTR(now)
+
+	return []interface{}{
+		requestFixture(1000, 1000, 100),
+		requestFixture(1001, 1000, 100),
+		requestFixture(2000, 2000, 101),
+		// Requests that are not public are not listed.
+		requestFixture(3000, 3000, 102),
+		&visibility_storage.Access{
+			Source:     test_utils.NumberUUID(3000),
+			Visibility: visibility_storage.VisibilityRestricted,
+			UpdatedAt:  baseTime,
+		},
+		deletedRequest,
+
+		suggestionFixture(5000, 300, true, now),
+		suggestionFixture(5001, 300, true, baseTime),
+		suggestionFixture(5002, 301, true, baseTime),
+		suggestionFixture(5003, 301, false, now),
+		deletedSuggestion,
+		suggestionFixture(5005, 302, true, baseTime),
+
+		// Votes on every revision count for the whole request.
+		voteFixture(1000, 200, votes_storage.TargetImproveRequest, votes_storage.VoteUp, now),
+		voteFixture(1000, 201, votes_storage.TargetImproveRequest, votes_storage.VoteUp, now),
+		voteFixture(1000, 202, votes_storage.TargetImproveRequest, votes_storage.VoteUp, baseTime),
+		voteFixture(1001, 203, votes_storage.TargetImproveRequest, votes_storage.VoteDown, now),
+		voteFixture(2000, 200, votes_storage.TargetImproveRequest, votes_storage.VoteUp, baseTime),
+		voteFixture(3000, 200, votes_storage.TargetImproveRequest, votes_storage.VoteUp, now),
+		voteFixture(4000, 200, votes_storage.TargetImproveRequest, votes_storage.VoteUp, now),
+
+		voteFixture(5000, 200, votes_storage.TargetImproveSuggestion, votes_storage.VoteUp, now),
+		voteFixture(5000, 201, votes_storage.TargetImproveSuggestion, votes_storage.VoteUp, now),
+		voteFixture(5001, 202, votes_storage.TargetImproveSuggestion, votes_storage.VoteDown, baseTime),
+		voteFixture(5002, 200, votes_storage.TargetImproveSuggestion, votes_storage.VoteUp, baseTime),
+		voteFixture(5002, 201, votes_storage.TargetImproveSuggestion, votes_storage.VoteUp, baseTime),
+		voteFixture(5003, 202, votes_storage.TargetImproveSuggestion, votes_storage.VoteUp, now),
+		voteFixture(5004, 200, votes_storage.TargetImproveSuggestion, votes_storage.VoteUp, now),
+	}
+}
+
+func TestLeaderboardsRepository_List(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	entry := func(board Board, period Period, targetID int, score, rank int64) *Entry {
+		return &Entry{
+			Period:   period,
+			Board:    board,
+			TargetID: test_utils.NumberUUID(targetID),
+			Score:    score,
+			Rank:     rank,
+		}
+	}
+
+	data := []struct {
+		name string
+
+		board  Board
+		period Period
+		limit  int
+		offset int
+
+		expect      []*Entry
+		expectCount int64
+		expectErr   error
+	}{
+		{
+			name:   "Success/AcceptedSuggestions/Month",
+			board:  BoardAcceptedSuggestions,
+			period: PeriodMonth,
+			limit:  10,
+			expect: []*Entry{
+				entry(BoardAcceptedSuggestions, PeriodMonth, 300, 1, 1),
+			},
+			expectCount: 1,
+		},
+		{
+			name:   "Success/AcceptedSuggestions/AllTime",
+			board:  BoardAcceptedSuggestions,
+			period: PeriodAllTime,
+			limit:  10,
+			expect: []*Entry{
+				entry(BoardAcceptedSuggestions, PeriodAllTime, 300, 2, 1),
+				entry(BoardAcceptedSuggestions, PeriodAllTime, 301, 1, 2),
+				entry(BoardAcceptedSuggestions, PeriodAllTime, 302, 1, 2),
+			},
+			expectCount: 3,
+		},
+		{
+			name:   "Success/AcceptedSuggestions/Paginated",
+			board:  BoardAcceptedSuggestions,
+			period: PeriodAllTime,
+			limit:  1,
+			offset: 1,
+			expect: []*Entry{
+				entry(BoardAcceptedSuggestions, PeriodAllTime, 301, 1, 2),
+			},
+			expectCount: 3,
+		},
+		{
+			name:   "Success/SuggestionScore/Month",
+			board:  BoardSuggestionScore,
+			period: PeriodMonth,
+			limit:  10,
+			expect: []*Entry{
+				entry(BoardSuggestionScore, PeriodMonth, 300, 2, 1),
+				entry(BoardSuggestionScore, PeriodMonth, 301, 1, 2),
+			},
+			expectCount: 2,
+		},
+		{
+			name:   "Success/SuggestionScore/AllTime",
+			board:  BoardSuggestionScore,
+			period: PeriodAllTime,
+			limit:  10,
+			expect: []*Entry{
+				entry(BoardSuggestionScore, PeriodAllTime, 301, 3, 1),
+				entry(BoardSuggestionScore, PeriodAllTime, 300, 1, 2),
+			},
+			expectCount: 2,
+		},
+		{
+			name:   "Success/Requests/Month",
+			board:  BoardRequests,
+			period: PeriodMonth,
+			limit:  10,
+			expect: []*Entry{
+				entry(BoardRequests, PeriodMonth, 1000, 1, 1),
+			},
+			expectCount: 1,
+		},
+		{
+			name:   "Success/Requests/AllTime",
+			board:  BoardRequests,
+			period: PeriodAllTime,
+			limit:  10,
+			expect: []*Entry{
+				entry(BoardRequests, PeriodAllTime, 1000, 2, 1),
+				entry(BoardRequests, PeriodAllTime, 2000, 1, 2),
+			},
+			expectCount: 2,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, getFixtures(time.Now().UTC()), func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		// Leaderboards are only computed on refresh.
+		require.NoError(t, repository.Refresh(ctx))
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, count, err := repository.List(ctx, d.board, d.period, d.limit, d.offset)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+				require.Equal(st, d.expectCount, count)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
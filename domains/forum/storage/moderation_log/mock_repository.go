@@ -0,0 +1,156 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package moderation_log_storage
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, action
+func (_m *MockRepository) Create(ctx context.Context, action *Model) (*Model, error) {
+	ret := _m.Called(ctx, action)
+
+	var r0 *Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Model) (*Model, error)); ok {
+		return rf(ctx, action)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *Model) *Model); ok {
+		r0 = rf(ctx, action)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *Model) error); ok {
+		r1 = rf(ctx, action)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - action *Model
+func (_e *MockRepository_Expecter) Create(ctx interface{}, action interface{}) *MockRepository_Create_Call {
+	return &MockRepository_Create_Call{Call: _e.mock.On("Create", ctx, action)}
+}
+
+func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, action *Model)) *MockRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Model))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Create_Call) Return(_a0 *Model, _a1 error) *MockRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Create_Call) RunAndReturn(run func(context.Context, *Model) (*Model, error)) *MockRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, query, limit, offset
+func (_m *MockRepository) List(ctx context.Context, query ListQuery, limit int, offset int) ([]*Model, int64, error) {
+	ret := _m.Called(ctx, query, limit, offset)
+
+	var r0 []*Model
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, ListQuery, int, int) ([]*Model, int64, error)); ok {
+		return rf(ctx, query, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ListQuery, int, int) []*Model); ok {
+		r0 = rf(ctx, query, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ListQuery, int, int) int64); ok {
+		r1 = rf(ctx, query, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, ListQuery, int, int) error); ok {
+		r2 = rf(ctx, query, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query ListQuery
+//   - limit int
+//   - offset int
+func (_e *MockRepository_Expecter) List(ctx interface{}, query interface{}, limit interface{}, offset interface{}) *MockRepository_List_Call {
+	return &MockRepository_List_Call{Call: _e.mock.On("List", ctx, query, limit, offset)}
+}
+
+func (_c *MockRepository_List_Call) Run(run func(ctx context.Context, query ListQuery, limit int, offset int)) *MockRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ListQuery), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_List_Call) Return(_a0 []*Model, _a1 int64, _a2 error) *MockRepository_List_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRepository_List_Call) RunAndReturn(run func(context.Context, ListQuery, int, int) ([]*Model, int64, error)) *MockRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockRepository(t mockConstructorTestingTNewMockRepository) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,62 @@
+package moderation_log_storage
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// Target is the kind of content a moderation action applies to.
+type Target string
+
+const (
+	// TargetImproveRequest actions apply to a whole improvement request, through the ID of its first revision.
+	TargetImproveRequest Target = "improve_request"
+	// TargetImproveSuggestion actions apply to a single improvement suggestion.
+	TargetImproveSuggestion Target = "improve_suggestion"
+	// TargetProfile actions apply to the public profile of a user.
+	TargetProfile Target = "profile"
+)
+
+// Action is the type of decision recorded in the log.
+type Action string
+
+const (
+	// ActionHide is recorded when a content is hidden, after receiving too many reports.
+	ActionHide Action = "hide"
+	// ActionClaim is recorded when a moderator starts reviewing the reports of a content.
+	ActionClaim Action = "claim"
+	// ActionResolve is recorded when a moderator upholds the reports of a content.
+	ActionResolve Action = "resolve"
+	// ActionDismiss is recorded when a moderator rejects the reports of a content.
+	ActionDismiss Action = "dismiss"
+	// ActionLock is recorded when a moderator locks an improvement request.
+	ActionLock Action = "lock"
+	// ActionUnlock is recorded when a moderator unlocks an improvement request.
+	ActionUnlock Action = "unlock"
+	// ActionVoidVotes is recorded when a moderator removes the votes of a suspected sockpuppet.
+	ActionVoidVotes Action = "void_votes"
+)
+
+// Model is the database model for the forum_moderation_actions table. Entries can never be updated nor deleted.
+type Model struct {
+	bun.BaseModel `bun:"table:forum_moderation_actions,alias:forum_moderation_actions"`
+
+	ID        uuid.UUID `json:"id" bun:"id,pk,type:uuid"`
+	CreatedAt time.Time `json:"created_at" bun:"created_at,notnull"`
+
+	// ModeratorID is nil for automatic actions.
+	ModeratorID *uuid.UUID `json:"moderator_id" bun:"moderator_id,type:uuid"`
+	Action      Action     `json:"action" bun:"action"`
+	Target      Target     `json:"target" bun:"target"`
+	TargetID    uuid.UUID  `json:"target_id" bun:"target_id,type:uuid"`
+	// Note is an optional message from the moderator.
+	Note string `json:"note" bun:"note"`
+}
+
+// ListQuery filters the results of Repository.List. Empty fields are ignored.
+type ListQuery struct {
+	Target      *Target
+	TargetID    *uuid.UUID
+	ModeratorID *uuid.UUID
+}
@@ -0,0 +1,62 @@
+package moderation_log_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/uptrace/bun"
+)
+
+// Repository of the current layer. You can instantiate a new one with NewRepository.
+type Repository interface {
+	// Create appends a new action to the log.
+	Create(ctx context.Context, action *Model) (*Model, error)
+	// List returns the actions matching the query, most recent first. Results must be paginated using the limit
+	// and offset parameters.
+	// It also returns the total number of available results, to help with pagination.
+	List(ctx context.Context, query ListQuery, limit, offset int) ([]*Model, int64, error)
+}
+
+// NewRepository returns a new Repository instance.
+// To use a mocked one, call NewMockRepository.
+func NewRepository(db bun.IDB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+type repositoryImpl struct {
+	db bun.IDB
+}
+
+func (repository *repositoryImpl) Create(ctx context.Context, action *Model) (*Model, error) {
+	if _, err := repository.db.NewInsert().Model(action).Returning("*").Exec(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return action, nil
+}
+
+func (repository *repositoryImpl) List(ctx context.Context, query ListQuery, limit, offset int) ([]*Model, int64, error) {
+	results := make([]*Model, 0)
+
+	dbQuery := repository.db.NewSelect().
+		Model(&results).
+		Order("created_at DESC", "id").
+		Limit(limit).
+		Offset(offset)
+
+	if query.Target != nil {
+		dbQuery = dbQuery.Where("target = ?", *query.Target)
+	}
+	if query.TargetID != nil {
+		dbQuery = dbQuery.Where("target_id = ?", *query.TargetID)
+	}
+	if query.ModeratorID != nil {
+		dbQuery = dbQuery.Where("moderator_id = ?", *query.ModeratorID)
+	}
+
+	count, err := dbQuery.ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, validation.HandlePGError(err)
+	}
+
+	return results, int64(count), nil
+}
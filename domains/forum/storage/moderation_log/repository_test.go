@@ -0,0 +1,196 @@
+package moderation_log_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime   = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	updateTime = time.Date(2020, time.May, 4, 12, 0, 0, 0, time.UTC)
+)
+
+var Fixtures = []interface{}{
+	&Model{
+		ID:        test_utils.NumberUUID(1),
+		CreatedAt: baseTime,
+		Action:    ActionHide,
+		Target:    TargetImproveRequest,
+		TargetID:  test_utils.NumberUUID(1000),
+	},
+	&Model{
+		ID:          test_utils.NumberUUID(2),
+		CreatedAt:   baseTime.Add(time.Minute),
+		ModeratorID: framework.ToPTR(test_utils.NumberUUID(10)),
+		Action:      ActionClaim,
+		Target:      TargetImproveRequest,
+		TargetID:    test_utils.NumberUUID(1000),
+	},
+	&Model{
+		ID:          test_utils.NumberUUID(3),
+		CreatedAt:   baseTime.Add(2 * time.Minute),
+		ModeratorID: framework.ToPTR(test_utils.NumberUUID(11)),
+		Action:      ActionLock,
+		Target:      TargetImproveRequest,
+		TargetID:    test_utils.NumberUUID(1001),
+		Note:        "heated discussion",
+	},
+	&Model{
+		ID:          test_utils.NumberUUID(4),
+		CreatedAt:   baseTime.Add(3 * time.Minute),
+		ModeratorID: framework.ToPTR(test_utils.NumberUUID(10)),
+		Action:      ActionDismiss,
+		Target:      TargetProfile,
+		TargetID:    test_utils.NumberUUID(300),
+	},
+}
+
+func TestModerationLogRepository_Create(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		action *Model
+
+		expect    *Model
+		expectErr error
+	}{
+		{
+			name: "Success",
+			action: &Model{
+				ID:          test_utils.NumberUUID(10),
+				CreatedAt:   updateTime,
+				ModeratorID: framework.ToPTR(test_utils.NumberUUID(10)),
+				Action:      ActionResolve,
+				Target:      TargetImproveSuggestion,
+				TargetID:    test_utils.NumberUUID(2000),
+				Note:        "spam link",
+			},
+			expect: &Model{
+				ID:          test_utils.NumberUUID(10),
+				CreatedAt:   updateTime,
+				ModeratorID: framework.ToPTR(test_utils.NumberUUID(10)),
+				Action:      ActionResolve,
+				Target:      TargetImproveSuggestion,
+				TargetID:    test_utils.NumberUUID(2000),
+				Note:        "spam link",
+			},
+		},
+		{
+			name: "Success/Automatic",
+			action: &Model{
+				ID:        test_utils.NumberUUID(10),
+				CreatedAt: updateTime,
+				Action:    ActionHide,
+				Target:    TargetProfile,
+				TargetID:  test_utils.NumberUUID(300),
+			},
+			expect: &Model{
+				ID:        test_utils.NumberUUID(10),
+				CreatedAt: updateTime,
+				Action:    ActionHide,
+				Target:    TargetProfile,
+				TargetID:  test_utils.NumberUUID(300),
+			},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				res, err := repository.Create(ctx, d.action)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestModerationLogRepository_List(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		query  ListQuery
+		limit  int
+		offset int
+
+		expect      []*Model
+		expectCount int64
+		expectErr   error
+	}{
+		{
+			name:  "Success",
+			limit: 10,
+			expect: []*Model{
+				Fixtures[3].(*Model),
+				Fixtures[2].(*Model),
+				Fixtures[1].(*Model),
+				Fixtures[0].(*Model),
+			},
+			expectCount: 4,
+		},
+		{
+			name: "Success/Target",
+			query: ListQuery{
+				Target:   framework.ToPTR(TargetImproveRequest),
+				TargetID: framework.ToPTR(test_utils.NumberUUID(1000)),
+			},
+			limit: 10,
+			expect: []*Model{
+				Fixtures[1].(*Model),
+				Fixtures[0].(*Model),
+			},
+			expectCount: 2,
+		},
+		{
+			name:  "Success/Moderator",
+			query: ListQuery{ModeratorID: framework.ToPTR(test_utils.NumberUUID(10))},
+			limit: 10,
+			expect: []*Model{
+				Fixtures[3].(*Model),
+				Fixtures[1].(*Model),
+			},
+			expectCount: 2,
+		},
+		{
+			name:   "Success/Paginate",
+			limit:  2,
+			offset: 1,
+			expect: []*Model{
+				Fixtures[2].(*Model),
+				Fixtures[1].(*Model),
+			},
+			expectCount: 4,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				repository := NewRepository(tx)
+				res, count, err := repository.List(ctx, d.query, d.limit, d.offset)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+				require.Equal(st, d.expectCount, count)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
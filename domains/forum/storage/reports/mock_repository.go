@@ -0,0 +1,502 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package reports_storage
+
+import (
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// Claim provides a mock function with given fields: ctx, target, targetID, moderatorID, now
+func (_m *MockRepository) Claim(ctx context.Context, target Target, targetID uuid.UUID, moderatorID uuid.UUID, now time.Time) (*Case, error) {
+	ret := _m.Called(ctx, target, targetID, moderatorID, now)
+
+	var r0 *Case
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, Target, uuid.UUID, uuid.UUID, time.Time) (*Case, error)); ok {
+		return rf(ctx, target, targetID, moderatorID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, Target, uuid.UUID, uuid.UUID, time.Time) *Case); ok {
+		r0 = rf(ctx, target, targetID, moderatorID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Case)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, Target, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, target, targetID, moderatorID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Claim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Claim'
+type MockRepository_Claim_Call struct {
+	*mock.Call
+}
+
+// Claim is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target Target
+//   - targetID uuid.UUID
+//   - moderatorID uuid.UUID
+//   - now time.Time
+func (_e *MockRepository_Expecter) Claim(ctx interface{}, target interface{}, targetID interface{}, moderatorID interface{}, now interface{}) *MockRepository_Claim_Call {
+	return &MockRepository_Claim_Call{Call: _e.mock.On("Claim", ctx, target, targetID, moderatorID, now)}
+}
+
+func (_c *MockRepository_Claim_Call) Run(run func(ctx context.Context, target Target, targetID uuid.UUID, moderatorID uuid.UUID, now time.Time)) *MockRepository_Claim_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(Target), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Claim_Call) Return(_a0 *Case, _a1 error) *MockRepository_Claim_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Claim_Call) RunAndReturn(run func(context.Context, Target, uuid.UUID, uuid.UUID, time.Time) (*Case, error)) *MockRepository_Claim_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, report
+func (_m *MockRepository) Create(ctx context.Context, report *Model) (*Case, error) {
+	ret := _m.Called(ctx, report)
+
+	var r0 *Case
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Model) (*Case, error)); ok {
+		return rf(ctx, report)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *Model) *Case); ok {
+		r0 = rf(ctx, report)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Case)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *Model) error); ok {
+		r1 = rf(ctx, report)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - report *Model
+func (_e *MockRepository_Expecter) Create(ctx interface{}, report interface{}) *MockRepository_Create_Call {
+	return &MockRepository_Create_Call{Call: _e.mock.On("Create", ctx, report)}
+}
+
+func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, report *Model)) *MockRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Model))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Create_Call) Return(_a0 *Case, _a1 error) *MockRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Create_Call) RunAndReturn(run func(context.Context, *Model) (*Case, error)) *MockRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Dismiss provides a mock function with given fields: ctx, target, targetID, moderatorID, now
+func (_m *MockRepository) Dismiss(ctx context.Context, target Target, targetID uuid.UUID, moderatorID uuid.UUID, now time.Time) (*Case, error) {
+	ret := _m.Called(ctx, target, targetID, moderatorID, now)
+
+	var r0 *Case
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, Target, uuid.UUID, uuid.UUID, time.Time) (*Case, error)); ok {
+		return rf(ctx, target, targetID, moderatorID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, Target, uuid.UUID, uuid.UUID, time.Time) *Case); ok {
+		r0 = rf(ctx, target, targetID, moderatorID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Case)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, Target, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, target, targetID, moderatorID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Dismiss_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dismiss'
+type MockRepository_Dismiss_Call struct {
+	*mock.Call
+}
+
+// Dismiss is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target Target
+//   - targetID uuid.UUID
+//   - moderatorID uuid.UUID
+//   - now time.Time
+func (_e *MockRepository_Expecter) Dismiss(ctx interface{}, target interface{}, targetID interface{}, moderatorID interface{}, now interface{}) *MockRepository_Dismiss_Call {
+	return &MockRepository_Dismiss_Call{Call: _e.mock.On("Dismiss", ctx, target, targetID, moderatorID, now)}
+}
+
+func (_c *MockRepository_Dismiss_Call) Run(run func(ctx context.Context, target Target, targetID uuid.UUID, moderatorID uuid.UUID, now time.Time)) *MockRepository_Dismiss_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(Target), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Dismiss_Call) Return(_a0 *Case, _a1 error) *MockRepository_Dismiss_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Dismiss_Call) RunAndReturn(run func(context.Context, Target, uuid.UUID, uuid.UUID, time.Time) (*Case, error)) *MockRepository_Dismiss_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Hide provides a mock function with given fields: ctx, target, targetID, now
+func (_m *MockRepository) Hide(ctx context.Context, target Target, targetID uuid.UUID, now time.Time) (*Case, error) {
+	ret := _m.Called(ctx, target, targetID, now)
+
+	var r0 *Case
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, Target, uuid.UUID, time.Time) (*Case, error)); ok {
+		return rf(ctx, target, targetID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, Target, uuid.UUID, time.Time) *Case); ok {
+		r0 = rf(ctx, target, targetID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Case)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, Target, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, target, targetID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Hide_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hide'
+type MockRepository_Hide_Call struct {
+	*mock.Call
+}
+
+// Hide is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target Target
+//   - targetID uuid.UUID
+//   - now time.Time
+func (_e *MockRepository_Expecter) Hide(ctx interface{}, target interface{}, targetID interface{}, now interface{}) *MockRepository_Hide_Call {
+	return &MockRepository_Hide_Call{Call: _e.mock.On("Hide", ctx, target, targetID, now)}
+}
+
+func (_c *MockRepository_Hide_Call) Run(run func(ctx context.Context, target Target, targetID uuid.UUID, now time.Time)) *MockRepository_Hide_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(Target), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Hide_Call) Return(_a0 *Case, _a1 error) *MockRepository_Hide_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Hide_Call) RunAndReturn(run func(context.Context, Target, uuid.UUID, time.Time) (*Case, error)) *MockRepository_Hide_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCases provides a mock function with given fields: ctx, statuses, limit, offset
+func (_m *MockRepository) ListCases(ctx context.Context, statuses []Status, limit int, offset int) ([]*Case, int64, error) {
+	ret := _m.Called(ctx, statuses, limit, offset)
+
+	var r0 []*Case
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []Status, int, int) ([]*Case, int64, error)); ok {
+		return rf(ctx, statuses, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []Status, int, int) []*Case); ok {
+		r0 = rf(ctx, statuses, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Case)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []Status, int, int) int64); ok {
+		r1 = rf(ctx, statuses, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []Status, int, int) error); ok {
+		r2 = rf(ctx, statuses, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRepository_ListCases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCases'
+type MockRepository_ListCases_Call struct {
+	*mock.Call
+}
+
+// ListCases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - statuses []Status
+//   - limit int
+//   - offset int
+func (_e *MockRepository_Expecter) ListCases(ctx interface{}, statuses interface{}, limit interface{}, offset interface{}) *MockRepository_ListCases_Call {
+	return &MockRepository_ListCases_Call{Call: _e.mock.On("ListCases", ctx, statuses, limit, offset)}
+}
+
+func (_c *MockRepository_ListCases_Call) Run(run func(ctx context.Context, statuses []Status, limit int, offset int)) *MockRepository_ListCases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]Status), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListCases_Call) Return(_a0 []*Case, _a1 int64, _a2 error) *MockRepository_ListCases_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRepository_ListCases_Call) RunAndReturn(run func(context.Context, []Status, int, int) ([]*Case, int64, error)) *MockRepository_ListCases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReports provides a mock function with given fields: ctx, target, targetID
+func (_m *MockRepository) ListReports(ctx context.Context, target Target, targetID uuid.UUID) ([]*Model, error) {
+	ret := _m.Called(ctx, target, targetID)
+
+	var r0 []*Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, Target, uuid.UUID) ([]*Model, error)); ok {
+		return rf(ctx, target, targetID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, Target, uuid.UUID) []*Model); ok {
+		r0 = rf(ctx, target, targetID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, Target, uuid.UUID) error); ok {
+		r1 = rf(ctx, target, targetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ListReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReports'
+type MockRepository_ListReports_Call struct {
+	*mock.Call
+}
+
+// ListReports is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target Target
+//   - targetID uuid.UUID
+func (_e *MockRepository_Expecter) ListReports(ctx interface{}, target interface{}, targetID interface{}) *MockRepository_ListReports_Call {
+	return &MockRepository_ListReports_Call{Call: _e.mock.On("ListReports", ctx, target, targetID)}
+}
+
+func (_c *MockRepository_ListReports_Call) Run(run func(ctx context.Context, target Target, targetID uuid.UUID)) *MockRepository_ListReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(Target), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListReports_Call) Return(_a0 []*Model, _a1 error) *MockRepository_ListReports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ListReports_Call) RunAndReturn(run func(context.Context, Target, uuid.UUID) ([]*Model, error)) *MockRepository_ListReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadCase provides a mock function with given fields: ctx, target, targetID
+func (_m *MockRepository) ReadCase(ctx context.Context, target Target, targetID uuid.UUID) (*Case, error) {
+	ret := _m.Called(ctx, target, targetID)
+
+	var r0 *Case
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, Target, uuid.UUID) (*Case, error)); ok {
+		return rf(ctx, target, targetID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, Target, uuid.UUID) *Case); ok {
+		r0 = rf(ctx, target, targetID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Case)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, Target, uuid.UUID) error); ok {
+		r1 = rf(ctx, target, targetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ReadCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadCase'
+type MockRepository_ReadCase_Call struct {
+	*mock.Call
+}
+
+// ReadCase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target Target
+//   - targetID uuid.UUID
+func (_e *MockRepository_Expecter) ReadCase(ctx interface{}, target interface{}, targetID interface{}) *MockRepository_ReadCase_Call {
+	return &MockRepository_ReadCase_Call{Call: _e.mock.On("ReadCase", ctx, target, targetID)}
+}
+
+func (_c *MockRepository_ReadCase_Call) Run(run func(ctx context.Context, target Target, targetID uuid.UUID)) *MockRepository_ReadCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(Target), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ReadCase_Call) Return(_a0 *Case, _a1 error) *MockRepository_ReadCase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ReadCase_Call) RunAndReturn(run func(context.Context, Target, uuid.UUID) (*Case, error)) *MockRepository_ReadCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resolve provides a mock function with given fields: ctx, target, targetID, moderatorID, now
+func (_m *MockRepository) Resolve(ctx context.Context, target Target, targetID uuid.UUID, moderatorID uuid.UUID, now time.Time) (*Case, error) {
+	ret := _m.Called(ctx, target, targetID, moderatorID, now)
+
+	var r0 *Case
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, Target, uuid.UUID, uuid.UUID, time.Time) (*Case, error)); ok {
+		return rf(ctx, target, targetID, moderatorID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, Target, uuid.UUID, uuid.UUID, time.Time) *Case); ok {
+		r0 = rf(ctx, target, targetID, moderatorID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Case)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, Target, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, target, targetID, moderatorID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Resolve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resolve'
+type MockRepository_Resolve_Call struct {
+	*mock.Call
+}
+
+// Resolve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target Target
+//   - targetID uuid.UUID
+//   - moderatorID uuid.UUID
+//   - now time.Time
+func (_e *MockRepository_Expecter) Resolve(ctx interface{}, target interface{}, targetID interface{}, moderatorID interface{}, now interface{}) *MockRepository_Resolve_Call {
+	return &MockRepository_Resolve_Call{Call: _e.mock.On("Resolve", ctx, target, targetID, moderatorID, now)}
+}
+
+func (_c *MockRepository_Resolve_Call) Run(run func(ctx context.Context, target Target, targetID uuid.UUID, moderatorID uuid.UUID, now time.Time)) *MockRepository_Resolve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(Target), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Resolve_Call) Return(_a0 *Case, _a1 error) *MockRepository_Resolve_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Resolve_Call) RunAndReturn(run func(context.Context, Target, uuid.UUID, uuid.UUID, time.Time) (*Case, error)) *MockRepository_Resolve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockRepository(t mockConstructorTestingTNewMockRepository) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,83 @@
+package reports_storage
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// Target is the kind of content a report is about.
+type Target string
+
+const (
+	// TargetImproveRequest reports target a whole improvement request, through the ID of its first revision.
+	TargetImproveRequest Target = "improve_request"
+	// TargetImproveSuggestion reports target a single improvement suggestion.
+	TargetImproveSuggestion Target = "improve_suggestion"
+	// TargetProfile reports target the public profile of a user.
+	TargetProfile Target = "profile"
+)
+
+// Reason is the category of a report.
+type Reason string
+
+const (
+	ReasonSpam          Reason = "spam"
+	ReasonHarassment    Reason = "harassment"
+	ReasonOffTopic      Reason = "off_topic"
+	ReasonPlagiarism    Reason = "plagiarism"
+	ReasonInappropriate Reason = "inappropriate"
+	ReasonOther         Reason = "other"
+)
+
+// Status is the progress of a Case in the moderation queue.
+type Status string
+
+const (
+	// StatusPending cases wait for a moderator.
+	StatusPending Status = "pending"
+	// StatusClaimed cases are being reviewed by a moderator.
+	StatusClaimed Status = "claimed"
+	// StatusResolved cases were upheld by a moderator. The content stays hidden.
+	StatusResolved Status = "resolved"
+	// StatusDismissed cases were rejected by a moderator. The content is visible again.
+	StatusDismissed Status = "dismissed"
+)
+
+// Model is the database model for the forum_reports table. Each user can only report a given content once.
+type Model struct {
+	bun.BaseModel `bun:"table:forum_reports,alias:forum_reports"`
+
+	ID        uuid.UUID `json:"id" bun:"id,pk,type:uuid"`
+	CreatedAt time.Time `json:"created_at" bun:"created_at,notnull"`
+
+	Target     Target    `json:"target" bun:"target"`
+	TargetID   uuid.UUID `json:"target_id" bun:"target_id,type:uuid"`
+	ReporterID uuid.UUID `json:"reporter_id" bun:"reporter_id,type:uuid"`
+	Reason     Reason    `json:"reason" bun:"reason"`
+	// Content is an optional message from the reporter.
+	Content string `json:"content" bun:"content"`
+}
+
+// Case is the database model for the forum_report_cases table. It groups the reports of a content, and tracks
+// their review by moderators.
+type Case struct {
+	bun.BaseModel `bun:"table:forum_report_cases,alias:forum_report_cases"`
+
+	Target   Target    `json:"target" bun:"target,pk"`
+	TargetID uuid.UUID `json:"target_id" bun:"target_id,pk,type:uuid"`
+
+	Status Status `json:"status" bun:"status"`
+	// Reports is the number of reports received since the case was last dismissed.
+	Reports int `json:"reports" bun:"reports"`
+
+	CreatedAt time.Time  `json:"created_at" bun:"created_at,notnull"`
+	UpdatedAt *time.Time `json:"updated_at" bun:"updated_at"`
+
+	ClaimedBy *uuid.UUID `json:"claimed_by" bun:"claimed_by,type:uuid"`
+	ClaimedAt *time.Time `json:"claimed_at" bun:"claimed_at"`
+	ClosedBy  *uuid.UUID `json:"closed_by" bun:"closed_by,type:uuid"`
+	ClosedAt  *time.Time `json:"closed_at" bun:"closed_at"`
+	// HiddenAt is set while the content is removed from public listings and searches.
+	HiddenAt *time.Time `json:"hidden_at" bun:"hidden_at"`
+}
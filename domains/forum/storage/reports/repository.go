@@ -0,0 +1,188 @@
+package reports_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// Repository of the current layer. You can instantiate a new one with NewRepository.
+type Repository interface {
+	// Create saves a new report, and adds it to the case of the reported content. The case is created if needed,
+	// and reopened if it was dismissed. It returns validation.ErrUniqConstraintViolation if the user already
+	// reported this content.
+	Create(ctx context.Context, report *Model) (*Case, error)
+	// ListReports returns every report of a content, oldest first.
+	ListReports(ctx context.Context, target Target, targetID uuid.UUID) ([]*Model, error)
+	// ReadCase returns the case of a reported content.
+	ReadCase(ctx context.Context, target Target, targetID uuid.UUID) (*Case, error)
+	// ListCases returns the cases with one of the given statuses, or every case if no status is given. Most
+	// reported cases come first, then the oldest ones. Results must be paginated using the limit and offset
+	// parameters.
+	// It also returns the total number of available results, to help with pagination.
+	ListCases(ctx context.Context, statuses []Status, limit, offset int) ([]*Case, int64, error)
+
+	// Hide removes a reported content from public listings, until a moderator dismisses its case.
+	Hide(ctx context.Context, target Target, targetID uuid.UUID, now time.Time) (*Case, error)
+	// Claim assigns a pending case to a moderator. It returns validation.ErrNotFound if the case is not pending.
+	Claim(ctx context.Context, target Target, targetID, moderatorID uuid.UUID, now time.Time) (*Case, error)
+	// Resolve upholds a pending or claimed case, and hides its content if it was not already. It returns
+	// validation.ErrNotFound if the case is already closed.
+	Resolve(ctx context.Context, target Target, targetID, moderatorID uuid.UUID, now time.Time) (*Case, error)
+	// Dismiss rejects a pending or claimed case, and shows its content again. It returns validation.ErrNotFound if
+	// the case is already closed.
+	Dismiss(ctx context.Context, target Target, targetID, moderatorID uuid.UUID, now time.Time) (*Case, error)
+}
+
+// NewRepository returns a new Repository instance.
+// To use a mocked one, call NewMockRepository.
+func NewRepository(db bun.IDB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+type repositoryImpl struct {
+	db bun.IDB
+}
+
+func (repository *repositoryImpl) Create(ctx context.Context, report *Model) (*Case, error) {
+	if _, err := repository.db.NewInsert().Model(report).Exec(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	model := &Case{
+		Target:    report.Target,
+		TargetID:  report.TargetID,
+		Status:    StatusPending,
+		Reports:   1,
+		CreatedAt: report.CreatedAt,
+	}
+
+	// A dismissed case starts over when the content is reported again.
+	if _, err := repository.db.NewInsert().
+		Model(model).
+		On("CONFLICT (target, target_id) DO UPDATE").
+		Set("reports = CASE WHEN forum_report_cases.status = ? THEN 1 ELSE forum_report_cases.reports + 1 END", StatusDismissed).
+		Set("status = CASE WHEN forum_report_cases.status = ? THEN ? ELSE forum_report_cases.status END", StatusDismissed, StatusPending).
+		Set("claimed_by = CASE WHEN forum_report_cases.status = ? THEN NULL ELSE forum_report_cases.claimed_by END", StatusDismissed).
+		Set("claimed_at = CASE WHEN forum_report_cases.status = ? THEN NULL ELSE forum_report_cases.claimed_at END", StatusDismissed).
+		Set("closed_by = CASE WHEN forum_report_cases.status = ? THEN NULL ELSE forum_report_cases.closed_by END", StatusDismissed).
+		Set("closed_at = CASE WHEN forum_report_cases.status = ? THEN NULL ELSE forum_report_cases.closed_at END", StatusDismissed).
+		Set("updated_at = EXCLUDED.created_at").
+		Returning("*").
+		Exec(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+func (repository *repositoryImpl) ListReports(ctx context.Context, target Target, targetID uuid.UUID) ([]*Model, error) {
+	results := make([]*Model, 0)
+
+	if err := repository.db.NewSelect().
+		Model(&results).
+		Where("target = ?", target).
+		Where("target_id = ?", targetID).
+		Order("created_at", "id").
+		Scan(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return results, nil
+}
+
+func (repository *repositoryImpl) ReadCase(ctx context.Context, target Target, targetID uuid.UUID) (*Case, error) {
+	model := &Case{Target: target, TargetID: targetID}
+	if err := repository.db.NewSelect().Model(model).WherePK().Scan(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+func (repository *repositoryImpl) ListCases(ctx context.Context, statuses []Status, limit, offset int) ([]*Case, int64, error) {
+	results := make([]*Case, 0)
+
+	query := repository.db.NewSelect().
+		Model(&results).
+		Order("reports DESC", "created_at", "target", "target_id").
+		Limit(limit).
+		Offset(offset)
+
+	if len(statuses) > 0 {
+		query = query.Where("status IN (?)", bun.In(statuses))
+	}
+
+	count, err := query.ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, validation.HandlePGError(err)
+	}
+
+	return results, int64(count), nil
+}
+
+func (repository *repositoryImpl) Hide(ctx context.Context, target Target, targetID uuid.UUID, now time.Time) (*Case, error) {
+	return repository.updateCase(ctx, target, targetID, nil, func(query *bun.UpdateQuery) *bun.UpdateQuery {
+		return query.
+			Set("hidden_at = ?", now).
+			Set("updated_at = ?", now)
+	})
+}
+
+func (repository *repositoryImpl) Claim(ctx context.Context, target Target, targetID, moderatorID uuid.UUID, now time.Time) (*Case, error) {
+	return repository.updateCase(ctx, target, targetID, []Status{StatusPending}, func(query *bun.UpdateQuery) *bun.UpdateQuery {
+		return query.
+			Set("status = ?", StatusClaimed).
+			Set("claimed_by = ?", moderatorID).
+			Set("claimed_at = ?", now).
+			Set("updated_at = ?", now)
+	})
+}
+
+func (repository *repositoryImpl) Resolve(ctx context.Context, target Target, targetID, moderatorID uuid.UUID, now time.Time) (*Case, error) {
+	return repository.updateCase(ctx, target, targetID, []Status{StatusPending, StatusClaimed}, func(query *bun.UpdateQuery) *bun.UpdateQuery {
+		return query.
+			Set("status = ?", StatusResolved).
+			Set("closed_by = ?", moderatorID).
+			Set("closed_at = ?", now).
+			Set("hidden_at = COALESCE(hidden_at, ?)", now).
+			Set("updated_at = ?", now)
+	})
+}
+
+func (repository *repositoryImpl) Dismiss(ctx context.Context, target Target, targetID, moderatorID uuid.UUID, now time.Time) (*Case, error) {
+	return repository.updateCase(ctx, target, targetID, []Status{StatusPending, StatusClaimed}, func(query *bun.UpdateQuery) *bun.UpdateQuery {
+		return query.
+			Set("status = ?", StatusDismissed).
+			Set("closed_by = ?", moderatorID).
+			Set("closed_at = ?", now).
+			Set("hidden_at = NULL").
+			Set("updated_at = ?", now)
+	})
+}
+
+// Update a case, only if its current status is one of the given ones. Any status is accepted if none is given.
+func (repository *repositoryImpl) updateCase(
+	ctx context.Context, target Target, targetID uuid.UUID, statuses []Status,
+	apply func(query *bun.UpdateQuery) *bun.UpdateQuery,
+) (*Case, error) {
+	model := &Case{Target: target, TargetID: targetID}
+
+	query := apply(repository.db.NewUpdate().Model(model).WherePK().Returning("*"))
+	if len(statuses) > 0 {
+		query = query.Where("status IN (?)", bun.In(statuses))
+	}
+
+	res, err := query.Exec(ctx)
+	if err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	if err := validation.ForceRowsUpdate(res); err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
@@ -0,0 +1,493 @@
+package reports_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime   = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	updateTime = time.Date(2020, time.May, 4, 12, 0, 0, 0, time.UTC)
+)
+
+var Fixtures = []interface{}{
+	&Model{
+		ID:         test_utils.NumberUUID(1),
+		CreatedAt:  baseTime,
+		Target:     TargetImproveRequest,
+		TargetID:   test_utils.NumberUUID(1000),
+		ReporterID: test_utils.NumberUUID(100),
+		Reason:     ReasonSpam,
+	},
+	&Model{
+		ID:         test_utils.NumberUUID(2),
+		CreatedAt:  baseTime.Add(time.Minute),
+		Target:     TargetImproveRequest,
+		TargetID:   test_utils.NumberUUID(1000),
+		ReporterID: test_utils.NumberUUID(101),
+		Reason:     ReasonHarassment,
+		Content:    "rude comments about the author",
+	},
+	&Model{
+		ID:         test_utils.NumberUUID(3),
+		CreatedAt:  baseTime.Add(time.Minute),
+		Target:     TargetImproveSuggestion,
+		TargetID:   test_utils.NumberUUID(2000),
+		ReporterID: test_utils.NumberUUID(100),
+		Reason:     ReasonOffTopic,
+	},
+	&Model{
+		ID:         test_utils.NumberUUID(4),
+		CreatedAt:  baseTime.Add(2 * time.Minute),
+		Target:     TargetProfile,
+		TargetID:   test_utils.NumberUUID(300),
+		ReporterID: test_utils.NumberUUID(101),
+		Reason:     ReasonOther,
+		Content:    "impersonates another author",
+	},
+	&Case{
+		Target:    TargetImproveRequest,
+		TargetID:  test_utils.NumberUUID(1000),
+		Status:    StatusPending,
+		Reports:   2,
+		CreatedAt: baseTime,
+		UpdatedAt: framework.ToPTR(baseTime.Add(time.Minute)),
+	},
+	&Case{
+		Target:    TargetImproveSuggestion,
+		TargetID:  test_utils.NumberUUID(2000),
+		Status:    StatusClaimed,
+		Reports:   1,
+		CreatedAt: baseTime.Add(time.Minute),
+		UpdatedAt: framework.ToPTR(baseTime.Add(2 * time.Minute)),
+		ClaimedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+		ClaimedAt: framework.ToPTR(baseTime.Add(2 * time.Minute)),
+	},
+	&Case{
+		Target:    TargetProfile,
+		TargetID:  test_utils.NumberUUID(300),
+		Status:    StatusDismissed,
+		Reports:   1,
+		CreatedAt: baseTime.Add(2 * time.Minute),
+		UpdatedAt: framework.ToPTR(baseTime.Add(3 * time.Minute)),
+		ClosedBy:  framework.ToPTR(test_utils.NumberUUID(10)),
+		ClosedAt:  framework.ToPTR(baseTime.Add(3 * time.Minute)),
+	},
+	&Case{
+		Target:    TargetImproveRequest,
+		TargetID:  test_utils.NumberUUID(1001),
+		Status:    StatusResolved,
+		Reports:   3,
+		CreatedAt: baseTime.Add(3 * time.Minute),
+		UpdatedAt: framework.ToPTR(baseTime.Add(4 * time.Minute)),
+		ClosedBy:  framework.ToPTR(test_utils.NumberUUID(11)),
+		ClosedAt:  framework.ToPTR(baseTime.Add(4 * time.Minute)),
+		HiddenAt:  framework.ToPTR(baseTime.Add(3 * time.Minute)),
+	},
+}
+
+func TestReportsRepository_Create(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		report *Model
+
+		expect    *Case
+		expectErr error
+	}{
+		{
+			name: "Success/NewCase",
+			report: &Model{
+				ID:         test_utils.NumberUUID(10),
+				CreatedAt:  updateTime,
+				Target:     TargetImproveSuggestion,
+				TargetID:   test_utils.NumberUUID(2001),
+				ReporterID: test_utils.NumberUUID(100),
+				Reason:     ReasonSpam,
+			},
+			expect: &Case{
+				Target:    TargetImproveSuggestion,
+				TargetID:  test_utils.NumberUUID(2001),
+				Status:    StatusPending,
+				Reports:   1,
+				CreatedAt: updateTime,
+			},
+		},
+		{
+			name: "Success/ExistingCase",
+			report: &Model{
+				ID:         test_utils.NumberUUID(10),
+				CreatedAt:  updateTime,
+				Target:     TargetImproveRequest,
+				TargetID:   test_utils.NumberUUID(1000),
+				ReporterID: test_utils.NumberUUID(102),
+				Reason:     ReasonSpam,
+			},
+			expect: &Case{
+				Target:    TargetImproveRequest,
+				TargetID:  test_utils.NumberUUID(1000),
+				Status:    StatusPending,
+				Reports:   3,
+				CreatedAt: baseTime,
+				UpdatedAt: &updateTime,
+			},
+		},
+		{
+			name: "Success/ClaimedCase",
+			report: &Model{
+				ID:         test_utils.NumberUUID(10),
+				CreatedAt:  updateTime,
+				Target:     TargetImproveSuggestion,
+				TargetID:   test_utils.NumberUUID(2000),
+				ReporterID: test_utils.NumberUUID(101),
+				Reason:     ReasonSpam,
+			},
+			expect: &Case{
+				Target:    TargetImproveSuggestion,
+				TargetID:  test_utils.NumberUUID(2000),
+				Status:    StatusClaimed,
+				Reports:   2,
+				CreatedAt: baseTime.Add(time.Minute),
+				UpdatedAt: &updateTime,
+				ClaimedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+				ClaimedAt: framework.ToPTR(baseTime.Add(2 * time.Minute)),
+			},
+		},
+		{
+			name: "Success/ReopensDismissedCase",
+			report: &Model{
+				ID:         test_utils.NumberUUID(10),
+				CreatedAt:  updateTime,
+				Target:     TargetProfile,
+				TargetID:   test_utils.NumberUUID(300),
+				ReporterID: test_utils.NumberUUID(100),
+				Reason:     ReasonSpam,
+			},
+			expect: &Case{
+				Target:    TargetProfile,
+				TargetID:  test_utils.NumberUUID(300),
+				Status:    StatusPending,
+				Reports:   1,
+				CreatedAt: baseTime.Add(2 * time.Minute),
+				UpdatedAt: &updateTime,
+			},
+		},
+		{
+			name: "Error/AlreadyReported",
+			report: &Model{
+				ID:         test_utils.NumberUUID(10),
+				CreatedAt:  updateTime,
+				Target:     TargetImproveRequest,
+				TargetID:   test_utils.NumberUUID(1000),
+				ReporterID: test_utils.NumberUUID(100),
+				Reason:     ReasonPlagiarism,
+			},
+			expectErr: validation.ErrUniqConstraintViolation,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				res, err := repository.Create(ctx, d.report)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+
+				if d.expect != nil {
+					stored, err := repository.ReadCase(ctx, d.report.Target, d.report.TargetID)
+					require.NoError(st, err)
+					require.Equal(st, d.expect, stored)
+				}
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestReportsRepository_ListReports(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		target   Target
+		targetID uuid.UUID
+
+		expect    []*Model
+		expectErr error
+	}{
+		{
+			name:     "Success",
+			target:   TargetImproveRequest,
+			targetID: test_utils.NumberUUID(1000),
+			expect:   []*Model{Fixtures[0].(*Model), Fixtures[1].(*Model)},
+		},
+		{
+			name:     "Success/NoResults",
+			target:   TargetImproveSuggestion,
+			targetID: test_utils.NumberUUID(1000),
+			expect:   []*Model{},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				repository := NewRepository(tx)
+				res, err := repository.ListReports(ctx, d.target, d.targetID)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestReportsRepository_ReadCase(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		target   Target
+		targetID uuid.UUID
+
+		expect    *Case
+		expectErr error
+	}{
+		{
+			name:     "Success",
+			target:   TargetImproveSuggestion,
+			targetID: test_utils.NumberUUID(2000),
+			expect:   Fixtures[5].(*Case),
+		},
+		{
+			name:      "Error/NotFound",
+			target:    TargetImproveRequest,
+			targetID:  test_utils.NumberUUID(2000),
+			expectErr: validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				repository := NewRepository(tx)
+				res, err := repository.ReadCase(ctx, d.target, d.targetID)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestReportsRepository_ListCases(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		statuses []Status
+		limit    int
+		offset   int
+
+		expect      []*Case
+		expectCount int64
+		expectErr   error
+	}{
+		{
+			name:  "Success",
+			limit: 10,
+			expect: []*Case{
+				Fixtures[7].(*Case),
+				Fixtures[4].(*Case),
+				Fixtures[5].(*Case),
+				Fixtures[6].(*Case),
+			},
+			expectCount: 4,
+		},
+		{
+			name:     "Success/Statuses",
+			statuses: []Status{StatusPending, StatusClaimed},
+			limit:    10,
+			expect: []*Case{
+				Fixtures[4].(*Case),
+				Fixtures[5].(*Case),
+			},
+			expectCount: 2,
+		},
+		{
+			name:   "Success/Paginate",
+			limit:  2,
+			offset: 1,
+			expect: []*Case{
+				Fixtures[4].(*Case),
+				Fixtures[5].(*Case),
+			},
+			expectCount: 4,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				repository := NewRepository(tx)
+				res, count, err := repository.ListCases(ctx, d.statuses, d.limit, d.offset)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+				require.Equal(st, d.expectCount, count)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestReportsRepository_UpdateCase(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	moderatorID := test_utils.NumberUUID(12)
+
+	data := []struct {
+		name string
+
+		call func(repository Repository) (*Case, error)
+
+		expect    *Case
+		expectErr error
+	}{
+		{
+			name: "Hide",
+			call: func(repository Repository) (*Case, error) {
+				return repository.Hide(context.Background(), TargetImproveRequest, test_utils.NumberUUID(1000), updateTime)
+			},
+			expect: &Case{
+				Target:    TargetImproveRequest,
+				TargetID:  test_utils.NumberUUID(1000),
+				Status:    StatusPending,
+				Reports:   2,
+				CreatedAt: baseTime,
+				UpdatedAt: &updateTime,
+				HiddenAt:  &updateTime,
+			},
+		},
+		{
+			name: "Hide/Error/NotFound",
+			call: func(repository Repository) (*Case, error) {
+				return repository.Hide(context.Background(), TargetProfile, test_utils.NumberUUID(1000), updateTime)
+			},
+			expectErr: validation.ErrNotFound,
+		},
+		{
+			name: "Claim",
+			call: func(repository Repository) (*Case, error) {
+				return repository.Claim(context.Background(), TargetImproveRequest, test_utils.NumberUUID(1000), moderatorID, updateTime)
+			},
+			expect: &Case{
+				Target:    TargetImproveRequest,
+				TargetID:  test_utils.NumberUUID(1000),
+				Status:    StatusClaimed,
+				Reports:   2,
+				CreatedAt: baseTime,
+				UpdatedAt: &updateTime,
+				ClaimedBy: &moderatorID,
+				ClaimedAt: &updateTime,
+			},
+		},
+		{
+			name: "Claim/Error/AlreadyClaimed",
+			call: func(repository Repository) (*Case, error) {
+				return repository.Claim(context.Background(), TargetImproveSuggestion, test_utils.NumberUUID(2000), moderatorID, updateTime)
+			},
+			expectErr: validation.ErrNotFound,
+		},
+		{
+			name: "Resolve",
+			call: func(repository Repository) (*Case, error) {
+				return repository.Resolve(context.Background(), TargetImproveSuggestion, test_utils.NumberUUID(2000), moderatorID, updateTime)
+			},
+			expect: &Case{
+				Target:    TargetImproveSuggestion,
+				TargetID:  test_utils.NumberUUID(2000),
+				Status:    StatusResolved,
+				Reports:   1,
+				CreatedAt: baseTime.Add(time.Minute),
+				UpdatedAt: &updateTime,
+				ClaimedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+				ClaimedAt: framework.ToPTR(baseTime.Add(2 * time.Minute)),
+				ClosedBy:  &moderatorID,
+				ClosedAt:  &updateTime,
+				HiddenAt:  &updateTime,
+			},
+		},
+		{
+			name: "Resolve/Error/Closed",
+			call: func(repository Repository) (*Case, error) {
+				return repository.Resolve(context.Background(), TargetProfile, test_utils.NumberUUID(300), moderatorID, updateTime)
+			},
+			expectErr: validation.ErrNotFound,
+		},
+		{
+			name: "Dismiss",
+			call: func(repository Repository) (*Case, error) {
+				return repository.Dismiss(context.Background(), TargetImproveRequest, test_utils.NumberUUID(1000), moderatorID, updateTime)
+			},
+			expect: &Case{
+				Target:    TargetImproveRequest,
+				TargetID:  test_utils.NumberUUID(1000),
+				Status:    StatusDismissed,
+				Reports:   2,
+				CreatedAt: baseTime,
+				UpdatedAt: &updateTime,
+				ClosedBy:  &moderatorID,
+				ClosedAt:  &updateTime,
+			},
+		},
+		{
+			name: "Dismiss/Error/Closed",
+			call: func(repository Repository) (*Case, error) {
+				return repository.Dismiss(context.Background(), TargetImproveRequest, test_utils.NumberUUID(1001), moderatorID, updateTime)
+			},
+			expectErr: validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				res, err := d.call(repository)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,165 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package search_index_storage
+
+import (
+	context "context"
+
+	uuid "github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// Drifted provides a mock function with given fields: ctx, table, limit
+func (_m *MockRepository) Drifted(ctx context.Context, table Table, limit int) ([]uuid.UUID, int64, error) {
+	ret := _m.Called(ctx, table, limit)
+
+	var r0 []uuid.UUID
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, Table, int) ([]uuid.UUID, int64, error)); ok {
+		return rf(ctx, table, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, Table, int) []uuid.UUID); ok {
+		r0 = rf(ctx, table, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, Table, int) int64); ok {
+		r1 = rf(ctx, table, limit)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, Table, int) error); ok {
+		r2 = rf(ctx, table, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRepository_Drifted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Drifted'
+type MockRepository_Drifted_Call struct {
+	*mock.Call
+}
+
+// Drifted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - table Table
+//   - limit int
+func (_e *MockRepository_Expecter) Drifted(ctx interface{}, table interface{}, limit interface{}) *MockRepository_Drifted_Call {
+	return &MockRepository_Drifted_Call{Call: _e.mock.On("Drifted", ctx, table, limit)}
+}
+
+func (_c *MockRepository_Drifted_Call) Run(run func(ctx context.Context, table Table, limit int)) *MockRepository_Drifted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(Table), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Drifted_Call) Return(_a0 []uuid.UUID, _a1 int64, _a2 error) *MockRepository_Drifted_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRepository_Drifted_Call) RunAndReturn(run func(context.Context, Table, int) ([]uuid.UUID, int64, error)) *MockRepository_Drifted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reindex provides a mock function with given fields: ctx, table, after, limit
+func (_m *MockRepository) Reindex(ctx context.Context, table Table, after uuid.UUID, limit int) (uuid.UUID, int, error) {
+	ret := _m.Called(ctx, table, after, limit)
+
+	var r0 uuid.UUID
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, Table, uuid.UUID, int) (uuid.UUID, int, error)); ok {
+		return rf(ctx, table, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, Table, uuid.UUID, int) uuid.UUID); ok {
+		r0 = rf(ctx, table, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, Table, uuid.UUID, int) int); ok {
+		r1 = rf(ctx, table, after, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, Table, uuid.UUID, int) error); ok {
+		r2 = rf(ctx, table, after, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRepository_Reindex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reindex'
+type MockRepository_Reindex_Call struct {
+	*mock.Call
+}
+
+// Reindex is a helper method to define mock.On call
+//   - ctx context.Context
+//   - table Table
+//   - after uuid.UUID
+//   - limit int
+func (_e *MockRepository_Expecter) Reindex(ctx interface{}, table interface{}, after interface{}, limit interface{}) *MockRepository_Reindex_Call {
+	return &MockRepository_Reindex_Call{Call: _e.mock.On("Reindex", ctx, table, after, limit)}
+}
+
+func (_c *MockRepository_Reindex_Call) Run(run func(ctx context.Context, table Table, after uuid.UUID, limit int)) *MockRepository_Reindex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(Table), args[2].(uuid.UUID), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Reindex_Call) Return(_a0 uuid.UUID, _a1 int, _a2 error) *MockRepository_Reindex_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRepository_Reindex_Call) RunAndReturn(run func(context.Context, Table, uuid.UUID, int) (uuid.UUID, int, error)) *MockRepository_Reindex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockRepository(t mockConstructorTestingTNewMockRepository) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,15 @@
+package search_index_storage
+
+// Table is the name of a forum table that holds a full text search column.
+type Table string
+
+const (
+	TableImproveRequests    Table = "improve_requests"
+	TableImproveSuggestions Table = "improve_suggestions"
+)
+
+// Tables lists every table that can be handled by the Repository.
+var Tables = []Table{
+	TableImproveRequests,
+	TableImproveSuggestions,
+}
@@ -0,0 +1,106 @@
+package search_index_storage
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// Expressions used by the database triggers to compute the full text search column of each table.
+var searchableContentExprs = map[Table]string{
+	TableImproveRequests: "compute_searchable_content(language, title, content)",
+	TableImproveSuggestions: "compute_searchable_content(" +
+		"COALESCE((SELECT language FROM improve_requests WHERE improve_requests.id = improve_suggestions.request_id), 'french'), " +
+		"title, content)",
+}
+
+// Repository of the current layer. You can instantiate a new one with NewRepository.
+//
+// The search vectors of forum posts are computed by database triggers. This repository exposes maintenance
+// operations, to rebuild those vectors or to detect rows where they went out of date.
+type Repository interface {
+	// Reindex recomputes the search vector of, at most, limit rows of the table. Rows are processed in ID order,
+	// starting right after the given ID (use uuid.Nil to start from the beginning).
+	// It returns the ID of the last processed row, and the number of processed rows. Once the number of processed
+	// rows is lower than limit, the whole table has been reindexed.
+	Reindex(ctx context.Context, table Table, after uuid.UUID, limit int) (uuid.UUID, int, error)
+	// Drifted returns the IDs of, at most, limit rows which search vector does not match their current content.
+	// It also returns the total number of such rows.
+	Drifted(ctx context.Context, table Table, limit int) ([]uuid.UUID, int64, error)
+}
+
+// NewRepository returns a new Repository instance.
+// To use a mocked one, call NewMockRepository.
+func NewRepository(db bun.IDB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+type repositoryImpl struct {
+	db bun.IDB
+}
+
+func searchableContentExpr(table Table) (string, error) {
+	expr, ok := searchableContentExprs[table]
+	if !ok {
+		return "", validation.NewErrInvalidEntity("table", fmt.Sprintf("%q has no full text search column", table))
+	}
+
+	return expr, nil
+}
+
+func (repository *repositoryImpl) Reindex(ctx context.Context, table Table, after uuid.UUID, limit int) (uuid.UUID, int, error) {
+	expr, err := searchableContentExpr(table)
+	if err != nil {
+		return uuid.Nil, 0, err
+	}
+
+	queryBatch := repository.db.NewSelect().
+		Table(string(table)).
+		Column("id").
+		Where("id > ?", after).
+		Order("id").
+		Limit(limit)
+
+	var ids []uuid.UUID
+	if err := repository.db.NewUpdate().
+		Table(string(table)).
+		Set("text_searchable_index_col = "+expr).
+		Where("id IN (?)", queryBatch).
+		Returning("id").
+		Scan(ctx, &ids); err != nil {
+		return uuid.Nil, 0, validation.HandlePGError(err)
+	}
+
+	// Returned rows are not ordered.
+	last := after
+	for _, id := range ids {
+		if id.String() > last.String() {
+			last = id
+		}
+	}
+
+	return last, len(ids), nil
+}
+
+func (repository *repositoryImpl) Drifted(ctx context.Context, table Table, limit int) ([]uuid.UUID, int64, error) {
+	expr, err := searchableContentExpr(table)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]uuid.UUID, 0)
+	count, err := repository.db.NewSelect().
+		Table(string(table)).
+		Column("id").
+		Where("text_searchable_index_col IS DISTINCT FROM "+expr).
+		Order("id").
+		Limit(limit).
+		ScanAndCount(ctx, &ids)
+	if err != nil {
+		return nil, 0, validation.HandlePGError(err)
+	}
+
+	return ids, int64(count), nil
+}
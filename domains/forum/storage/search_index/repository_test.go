@@ -0,0 +1,205 @@
+package search_index_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+)
+
+var Fixtures = []interface{}{
+	&improve_request_storage.Model{
+		ID:        test_utils.NumberUUID(1000),
+		CreatedAt: baseTime,
+		Source:    test_utils.NumberUUID(1000),
+		UserID:    test_utils.NumberUUID(100),
+		Title:     "Test",
+		Content:   "Dummy content.",
+	},
+	&improve_request_storage.Model{
+		ID:        test_utils.NumberUUID(1001),
+		CreatedAt: baseTime.Add(time.Minute),
+		Source:    test_utils.NumberUUID(1000),
+		UserID:    test_utils.NumberUUID(100),
+		Title:     "Test",
+		Content:   "Dummy content updated.",
+	},
+	&improve_request_storage.Model{
+		ID:        test_utils.NumberUUID(1002),
+		CreatedAt: baseTime,
+		Source:    test_utils.NumberUUID(1002),
+		UserID:    test_utils.NumberUUID(101),
+		Title:     "Lorem Ipsum",
+		Content:   "Lorem ipsum dolor sit amet.",
+	},
+	&improve_suggestion_storage.Model{
+		ID:        test_utils.NumberUUID(2000),
+		CreatedAt: baseTime,
+		SourceID:  test_utils.NumberUUID(1000),
+		UserID:    test_utils.NumberUUID(101),
+		Core: improve_suggestion_storage.Core{
+			RequestID: test_utils.NumberUUID(1001),
+			Title:     "Test",
+			Content:   "Smart content.",
+		},
+	},
+}
+
+// Simulate stale rows. Updating the search column alone does not fire the indexing triggers.
+func clearSearchVectors(ctx context.Context, t *testing.T, tx bun.Tx, table Table, ids ...uuid.UUID) {
+	_, err := tx.NewUpdate().
+		Table(string(table)).
+		Set("text_searchable_index_col = NULL").
+		Where("id IN (?)", bun.In(ids)).
+		Exec(ctx)
+	require.NoError(t, err)
+}
+
+func TestSearchIndexRepository_Reindex(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		table Table
+		after uuid.UUID
+		limit int
+
+		expectLast  uuid.UUID
+		expectCount int
+		expectErr   error
+	}{
+		{
+			name:        "Success/FirstBatch",
+			table:       TableImproveRequests,
+			after:       uuid.Nil,
+			limit:       2,
+			expectLast:  test_utils.NumberUUID(1001),
+			expectCount: 2,
+		},
+		{
+			name:        "Success/LastBatch",
+			table:       TableImproveRequests,
+			after:       test_utils.NumberUUID(1001),
+			limit:       2,
+			expectLast:  test_utils.NumberUUID(1002),
+			expectCount: 1,
+		},
+		{
+			name:       "Success/Done",
+			table:      TableImproveRequests,
+			after:      test_utils.NumberUUID(1002),
+			limit:      2,
+			expectLast: test_utils.NumberUUID(1002),
+		},
+		{
+			name:        "Success/Suggestions",
+			table:       TableImproveSuggestions,
+			after:       uuid.Nil,
+			limit:       2,
+			expectLast:  test_utils.NumberUUID(2000),
+			expectCount: 1,
+		},
+		{
+			name:      "Error/UnknownTable",
+			table:     "credentials",
+			limit:     2,
+			expectErr: validation.ErrInvalidEntity,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		clearSearchVectors(
+			ctx, t, tx, TableImproveRequests,
+			test_utils.NumberUUID(1000), test_utils.NumberUUID(1001), test_utils.NumberUUID(1002),
+		)
+		clearSearchVectors(ctx, t, tx, TableImproveSuggestions, test_utils.NumberUUID(2000))
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				last, count, err := repository.Reindex(ctx, d.table, d.after, d.limit)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expectLast, last)
+				require.Equal(st, d.expectCount, count)
+			})
+		}
+
+		for _, table := range Tables {
+			_, count, err := repository.Drifted(ctx, table, 10)
+			require.NoError(t, err)
+			require.Zero(t, count)
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestSearchIndexRepository_Drifted(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		table Table
+		limit int
+
+		expect      []uuid.UUID
+		expectCount int64
+		expectErr   error
+	}{
+		{
+			name:        "Success",
+			table:       TableImproveRequests,
+			limit:       10,
+			expect:      []uuid.UUID{test_utils.NumberUUID(1000), test_utils.NumberUUID(1002)},
+			expectCount: 2,
+		},
+		{
+			name:        "Success/Limit",
+			table:       TableImproveRequests,
+			limit:       1,
+			expect:      []uuid.UUID{test_utils.NumberUUID(1000)},
+			expectCount: 2,
+		},
+		{
+			name:   "Success/NoDrift",
+			table:  TableImproveSuggestions,
+			limit:  10,
+			expect: []uuid.UUID{},
+		},
+		{
+			name:      "Error/UnknownTable",
+			table:     "credentials",
+			limit:     10,
+			expectErr: validation.ErrInvalidEntity,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		clearSearchVectors(ctx, t, tx, TableImproveRequests, test_utils.NumberUUID(1000), test_utils.NumberUUID(1002))
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, count, err := repository.Drifted(ctx, d.table, d.limit)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+				require.Equal(st, d.expectCount, count)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,45 @@
+package tags_storage
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// Category groups tags by the aspect of the scene they describe.
+type Category string
+
+const (
+	// CategoryGenre describes the literary genre of the scene (fantasy, romance, etc.).
+	CategoryGenre Category = "genre"
+	// CategoryPOV describes the narrative point of view of the scene.
+	CategoryPOV Category = "pov"
+	// CategoryTense describes the grammatical tense the scene is written in.
+	CategoryTense Category = "tense"
+	// CategorySceneType describes the nature of the scene (dialogue, action, etc.).
+	CategorySceneType Category = "scene_type"
+)
+
+// Model is the database model for the forum_tags table.
+// Tags form a moderated taxonomy: only moderators can create or remove them, and users pick from the existing ones
+// to describe their posts.
+type Model struct {
+	bun.BaseModel `bun:"table:forum_tags,alias:forum_tags"`
+
+	// ID of the tag.
+	ID uuid.UUID `json:"id" bun:"id,pk,type:uuid"`
+	// CreatedAt stores the time at which the tag was created.
+	CreatedAt time.Time `json:"created_at" bun:"created_at,notnull"`
+
+	Core
+}
+
+// Core contains the explicitly editable data of the current model.
+type Core struct {
+	// Category of the tag.
+	Category Category `json:"category" bun:"category"`
+	// Slug is a unique, url-friendly identifier for the tag.
+	Slug string `json:"slug" bun:"slug"`
+	// Name is the displayed name of the tag.
+	Name string `json:"name" bun:"name"`
+}
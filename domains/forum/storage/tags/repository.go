@@ -0,0 +1,100 @@
+package tags_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// Repository of the current layer. You can instantiate a new one with NewRepository.
+type Repository interface {
+	// Create creates a new tag.
+	Create(ctx context.Context, data *Core, id uuid.UUID, now time.Time) (*Model, error)
+	// Delete removes a tag. The tag is also detached from every post that used it.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// List returns every available tag, optionally restricted to a single category.
+	List(ctx context.Context, category *Category) ([]*Model, error)
+	// Search returns the tags which name or slug is close to the query, best matches first. It is meant to be used
+	// for autocompletion.
+	Search(ctx context.Context, query string, category *Category, limit int) ([]*Model, error)
+}
+
+// NewRepository returns a new Repository instance.
+// To use a mocked one, call NewMockRepository.
+func NewRepository(db bun.IDB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+type repositoryImpl struct {
+	db bun.IDB
+}
+
+func (repository *repositoryImpl) Create(ctx context.Context, data *Core, id uuid.UUID, now time.Time) (*Model, error) {
+	model := &Model{
+		ID:        id,
+		CreatedAt: now,
+		Core:      *data,
+	}
+
+	if _, err := repository.db.NewInsert().Model(model).Returning("*").Exec(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+func (repository *repositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	model := &Model{ID: id}
+
+	res, err := repository.db.NewDelete().Model(model).WherePK().Exec(ctx)
+	if err != nil {
+		return validation.HandlePGError(err)
+	}
+
+	return validation.ForceRowsUpdate(res)
+}
+
+func (repository *repositoryImpl) List(ctx context.Context, category *Category) ([]*Model, error) {
+	results := make([]*Model, 0)
+
+	query := repository.db.NewSelect().Model(&results).Order("category", "name")
+	if category != nil {
+		query = query.Where("category = ?", *category)
+	}
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return results, nil
+}
+
+func (repository *repositoryImpl) Search(ctx context.Context, query string, category *Category, limit int) ([]*Model, error) {
+	results := make([]*Model, 0)
+
+	// Prefix matches are always kept, so the autocompletion works from the very first characters, where trigram
+	// similarity is still too low to be relevant.
+	searchQuery := repository.db.NewSelect().
+		Model(&results).
+		Where(
+			"(format_user_search(name) LIKE format_user_search(?0) || '%' OR slug LIKE format_user_search(?0) || '%' OR word_similarity(format_user_search(?0), format_user_search(name)) > 0.3)",
+			query,
+		).
+		OrderExpr("format_user_search(name) LIKE format_user_search(?) || '%' DESC", query).
+		OrderExpr("word_similarity(format_user_search(?), format_user_search(name)) DESC", query).
+		Order("name").
+		Limit(limit)
+
+	if category != nil {
+		searchQuery = searchQuery.Where("category = ?", *category)
+	}
+
+	if err := searchQuery.Scan(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,294 @@
+package tags_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"testing"
+	"time"
+)
+
+var baseTime = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+
+var Fixtures = []interface{}{
+	&Model{
+		ID:        test_utils.NumberUUID(1000),
+		CreatedAt: baseTime,
+		Core: Core{
+			Category: CategoryGenre,
+			Slug:     "fantasy",
+			Name:     "Fantasy",
+		},
+	},
+	&Model{
+		ID:        test_utils.NumberUUID(1001),
+		CreatedAt: baseTime,
+		Core: Core{
+			Category: CategoryGenre,
+			Slug:     "science-fiction",
+			Name:     "Science-fiction",
+		},
+	},
+	&Model{
+		ID:        test_utils.NumberUUID(1002),
+		CreatedAt: baseTime,
+		Core: Core{
+			Category: CategoryGenre,
+			Slug:     "dark-fantasy",
+			Name:     "Dark Fantasy",
+		},
+	},
+	&Model{
+		ID:        test_utils.NumberUUID(2000),
+		CreatedAt: baseTime,
+		Core: Core{
+			Category: CategoryPOV,
+			Slug:     "first-person",
+			Name:     "First person",
+		},
+	},
+	&Model{
+		ID:        test_utils.NumberUUID(3000),
+		CreatedAt: baseTime,
+		Core: Core{
+			Category: CategorySceneType,
+			Slug:     "dialogue",
+			Name:     "Dialogue",
+		},
+	},
+}
+
+func TestTagsRepository_Create(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		data *Core
+		id   uuid.UUID
+		now  time.Time
+
+		expect    *Model
+		expectErr error
+	}{
+		{
+			name: "Success",
+			data: &Core{
+				Category: CategoryTense,
+				Slug:     "present",
+				Name:     "Present tense",
+			},
+			id:  test_utils.NumberUUID(10),
+			now: baseTime,
+			expect: &Model{
+				ID:        test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Core: Core{
+					Category: CategoryTense,
+					Slug:     "present",
+					Name:     "Present tense",
+				},
+			},
+		},
+		{
+			name: "Error/SlugTaken",
+			data: &Core{
+				Category: CategoryGenre,
+				Slug:     "fantasy",
+				Name:     "Fantasy 2",
+			},
+			id:        test_utils.NumberUUID(10),
+			now:       baseTime,
+			expectErr: validation.ErrUniqConstraintViolation,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				res, err := repository.Create(ctx, d.data, d.id, d.now)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestTagsRepository_Delete(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		id uuid.UUID
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+			id:   test_utils.NumberUUID(1000),
+		},
+		{
+			name:      "Error/NotFound",
+			id:        test_utils.NumberUUID(10),
+			expectErr: validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				test_utils.RequireError(st, d.expectErr, repository.Delete(ctx, d.id))
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestTagsRepository_List(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		category *Category
+
+		expect    []*Model
+		expectErr error
+	}{
+		{
+			name: "Success",
+			expect: []*Model{
+				Fixtures[2].(*Model),
+				Fixtures[0].(*Model),
+				Fixtures[1].(*Model),
+				Fixtures[3].(*Model),
+				Fixtures[4].(*Model),
+			},
+		},
+		{
+			name:     "Success/Category",
+			category: framework.ToPTR(CategoryGenre),
+			expect: []*Model{
+				Fixtures[2].(*Model),
+				Fixtures[0].(*Model),
+				Fixtures[1].(*Model),
+			},
+		},
+		{
+			name:     "Success/NoResults",
+			category: framework.ToPTR(CategoryTense),
+			expect:   []*Model{},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.List(ctx, d.category)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestTagsRepository_Search(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		query    string
+		category *Category
+		limit    int
+
+		expect    []*Model
+		expectErr error
+	}{
+		{
+			name:  "Success/Prefix",
+			query: "fan",
+			limit: 10,
+			expect: []*Model{
+				Fixtures[0].(*Model),
+				Fixtures[2].(*Model),
+			},
+		},
+		{
+			name:  "Success/IgnoreCaseAndAccents",
+			query: "DIÀLOG",
+			limit: 10,
+			expect: []*Model{
+				Fixtures[4].(*Model),
+			},
+		},
+		{
+			name:  "Success/Slug",
+			query: "science-fi",
+			limit: 10,
+			expect: []*Model{
+				Fixtures[1].(*Model),
+			},
+		},
+		{
+			name:  "Success/Limit",
+			query: "fantasy",
+			limit: 1,
+			expect: []*Model{
+				Fixtures[0].(*Model),
+			},
+		},
+		{
+			name:     "Success/Category",
+			query:    "fantasy",
+			category: framework.ToPTR(CategoryPOV),
+			limit:    10,
+			expect:   []*Model{},
+		},
+		{
+			name:   "Success/NoResults",
+			query:  "qwertyuiop",
+			limit:  10,
+			expect: []*Model{},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.Search(ctx, d.query, d.category, d.limit)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,209 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package thread_state_storage
+
+import (
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// AutoClose provides a mock function with given fields: ctx, minAccepted, inactiveSince, now
+func (_m *MockRepository) AutoClose(ctx context.Context, minAccepted int, inactiveSince *time.Time, now time.Time) (int64, error) {
+	ret := _m.Called(ctx, minAccepted, inactiveSince, now)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *time.Time, time.Time) (int64, error)); ok {
+		return rf(ctx, minAccepted, inactiveSince, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *time.Time, time.Time) int64); ok {
+		r0 = rf(ctx, minAccepted, inactiveSince, now)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *time.Time, time.Time) error); ok {
+		r1 = rf(ctx, minAccepted, inactiveSince, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_AutoClose_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AutoClose'
+type MockRepository_AutoClose_Call struct {
+	*mock.Call
+}
+
+// AutoClose is a helper method to define mock.On call
+//   - ctx context.Context
+//   - minAccepted int
+//   - inactiveSince *time.Time
+//   - now time.Time
+func (_e *MockRepository_Expecter) AutoClose(ctx interface{}, minAccepted interface{}, inactiveSince interface{}, now interface{}) *MockRepository_AutoClose_Call {
+	return &MockRepository_AutoClose_Call{Call: _e.mock.On("AutoClose", ctx, minAccepted, inactiveSince, now)}
+}
+
+func (_c *MockRepository_AutoClose_Call) Run(run func(ctx context.Context, minAccepted int, inactiveSince *time.Time, now time.Time)) *MockRepository_AutoClose_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_AutoClose_Call) Return(_a0 int64, _a1 error) *MockRepository_AutoClose_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_AutoClose_Call) RunAndReturn(run func(context.Context, int, *time.Time, time.Time) (int64, error)) *MockRepository_AutoClose_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Read provides a mock function with given fields: ctx, source
+func (_m *MockRepository) Read(ctx context.Context, source uuid.UUID) (*Model, error) {
+	ret := _m.Called(ctx, source)
+
+	var r0 *Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*Model, error)); ok {
+		return rf(ctx, source)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *Model); ok {
+		r0 = rf(ctx, source)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, source)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Read'
+type MockRepository_Read_Call struct {
+	*mock.Call
+}
+
+// Read is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+func (_e *MockRepository_Expecter) Read(ctx interface{}, source interface{}) *MockRepository_Read_Call {
+	return &MockRepository_Read_Call{Call: _e.mock.On("Read", ctx, source)}
+}
+
+func (_c *MockRepository_Read_Call) Run(run func(ctx context.Context, source uuid.UUID)) *MockRepository_Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Read_Call) Return(_a0 *Model, _a1 error) *MockRepository_Read_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Read_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*Model, error)) *MockRepository_Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, source, state, updatedBy, reason, now
+func (_m *MockRepository) Update(ctx context.Context, source uuid.UUID, state State, updatedBy *uuid.UUID, reason string, now time.Time) (*Model, error) {
+	ret := _m.Called(ctx, source, state, updatedBy, reason, now)
+
+	var r0 *Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, State, *uuid.UUID, string, time.Time) (*Model, error)); ok {
+		return rf(ctx, source, state, updatedBy, reason, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, State, *uuid.UUID, string, time.Time) *Model); ok {
+		r0 = rf(ctx, source, state, updatedBy, reason, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, State, *uuid.UUID, string, time.Time) error); ok {
+		r1 = rf(ctx, source, state, updatedBy, reason, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - state State
+//   - updatedBy *uuid.UUID
+//   - reason string
+//   - now time.Time
+func (_e *MockRepository_Expecter) Update(ctx interface{}, source interface{}, state interface{}, updatedBy interface{}, reason interface{}, now interface{}) *MockRepository_Update_Call {
+	return &MockRepository_Update_Call{Call: _e.mock.On("Update", ctx, source, state, updatedBy, reason, now)}
+}
+
+func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, source uuid.UUID, state State, updatedBy *uuid.UUID, reason string, now time.Time)) *MockRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(State), args[3].(*uuid.UUID), args[4].(string), args[5].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Update_Call) Return(_a0 *Model, _a1 error) *MockRepository_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Update_Call) RunAndReturn(run func(context.Context, uuid.UUID, State, *uuid.UUID, string, time.Time) (*Model, error)) *MockRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockRepository(t mockConstructorTestingTNewMockRepository) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,40 @@
+package thread_state_storage
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// State controls which interactions are still possible on an improvement request (improve_request_storage.Model),
+// and its suggestions.
+type State string
+
+const (
+	// StateOpen requests accept new suggestions, edits and votes.
+	StateOpen State = "open"
+	// StateClosed requests no longer accept new suggestions. Existing suggestions can still be edited, and voted on.
+	StateClosed State = "closed"
+	// StateLocked requests were frozen by a moderator. Only moderators can unlock them.
+	StateLocked State = "locked"
+	// StateArchived requests are read-only.
+	StateArchived State = "archived"
+)
+
+// Model is the database model for the improve_request_states table. It sets the state of every revision of an
+// improvement request. Requests without an entry are open.
+type Model struct {
+	bun.BaseModel `bun:"table:improve_request_states,alias:improve_request_states"`
+
+	// Source is the ID of the first revision of the request.
+	Source uuid.UUID `json:"source" bun:"source,pk,type:uuid"`
+	// State of the request.
+	State State `json:"state" bun:"state"`
+	// UpdatedAt stores the time at which the state was last changed.
+	UpdatedAt time.Time `json:"updated_at" bun:"updated_at,notnull"`
+	// UpdatedBy is the ID of the user who last changed the state. It is nil when the request was closed
+	// automatically.
+	UpdatedBy *uuid.UUID `json:"updated_by" bun:"updated_by,type:uuid"`
+	// Reason is an optional message, explaining the last change.
+	Reason string `json:"reason" bun:"reason"`
+}
@@ -0,0 +1,143 @@
+package thread_state_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// AutoCloseReason is the Reason of the requests closed by Repository.AutoClose.
+const AutoCloseReason = "auto"
+
+// Repository of the current layer. You can instantiate a new one with NewRepository.
+type Repository interface {
+	// Read returns the state of a request, based on the ID of its first revision. It returns validation.ErrNotFound
+	// if the state of the request was never changed.
+	Read(ctx context.Context, source uuid.UUID) (*Model, error)
+	// Update sets the state of a request. UpdatedBy is nil for automatic changes.
+	Update(ctx context.Context, source uuid.UUID, state State, updatedBy *uuid.UUID, reason string, now time.Time) (*Model, error)
+	// AutoClose closes the open requests that have at least minAccepted validated suggestions, or no activity
+	// (new revision or suggestion) since inactiveSince. A criterion is ignored when minAccepted is 0, or
+	// inactiveSince is nil. It returns the number of closed requests.
+	AutoClose(ctx context.Context, minAccepted int, inactiveSince *time.Time, now time.Time) (int64, error)
+}
+
+// NewRepository returns a new Repository instance.
+// To use a mocked one, call NewMockRepository.
+func NewRepository(db bun.IDB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+type repositoryImpl struct {
+	db bun.IDB
+}
+
+func (repository *repositoryImpl) Read(ctx context.Context, source uuid.UUID) (*Model, error) {
+	model := &Model{Source: source}
+	if err := repository.db.NewSelect().Model(model).WherePK().Scan(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+func (repository *repositoryImpl) Update(ctx context.Context, source uuid.UUID, state State, updatedBy *uuid.UUID, reason string, now time.Time) (*Model, error) {
+	model := &Model{Source: source, State: state, UpdatedAt: now, UpdatedBy: updatedBy, Reason: reason}
+
+	if _, err := repository.db.NewInsert().
+		Model(model).
+		On("CONFLICT (source) DO UPDATE").
+		Set("state = EXCLUDED.state").
+		Set("updated_at = EXCLUDED.updated_at").
+		Set("updated_by = EXCLUDED.updated_by").
+		Set("reason = EXCLUDED.reason").
+		Returning("*").
+		Exec(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+func (repository *repositoryImpl) AutoClose(ctx context.Context, minAccepted int, inactiveSince *time.Time, now time.Time) (int64, error) {
+	if minAccepted <= 0 && inactiveSince == nil {
+		return 0, nil
+	}
+
+	queryNotOpen := repository.db.NewSelect().
+		Column("source").
+		TableExpr("improve_request_states").
+		Where("state <> ?", StateOpen)
+
+	queryCandidates := repository.db.NewSelect().
+		ColumnExpr("improve_requests.source").
+		TableExpr("improve_requests").
+		Where("improve_requests.id = improve_requests.source").
+		Where("improve_requests.deleted_at IS NULL").
+		Where("improve_requests.source NOT IN (?)", queryNotOpen).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			if minAccepted > 0 {
+				queryAccepted := repository.db.NewSelect().
+					ColumnExpr("COUNT(*)").
+					TableExpr("improve_suggestions").
+					Where("improve_suggestions.source_id = improve_requests.source").
+					Where("improve_suggestions.validated = TRUE").
+					Where("improve_suggestions.deleted_at IS NULL")
+
+				q = q.WhereOr("(?) >= ?", queryAccepted, minAccepted)
+			}
+			if inactiveSince != nil {
+				queryLastRevision := repository.db.NewSelect().
+					ColumnExpr("MAX(revisions.created_at)").
+					TableExpr("improve_requests AS revisions").
+					Where("revisions.source = improve_requests.source").
+					Where("revisions.deleted_at IS NULL")
+				queryLastSuggestion := repository.db.NewSelect().
+					ColumnExpr("MAX(COALESCE(improve_suggestions.updated_at, improve_suggestions.created_at))").
+					TableExpr("improve_suggestions").
+					Where("improve_suggestions.source_id = improve_requests.source").
+					Where("improve_suggestions.deleted_at IS NULL")
+
+				// GREATEST ignores NULL values, so requests without suggestions only rely on their revisions.
+				q = q.WhereOr("GREATEST((?), (?)) < ?", queryLastRevision, queryLastSuggestion, *inactiveSince)
+			}
+
+			return q
+		})
+
+	sources := make([]uuid.UUID, 0)
+	if err := queryCandidates.Scan(ctx, &sources); err != nil {
+		return 0, validation.HandlePGError(err)
+	}
+	if len(sources) == 0 {
+		return 0, nil
+	}
+
+	states := make([]*Model, len(sources))
+	for i, source := range sources {
+		states[i] = &Model{Source: source, State: StateClosed, UpdatedAt: now, Reason: AutoCloseReason}
+	}
+
+	// The state might have been changed manually in the meantime, in which case it is kept.
+	res, err := repository.db.NewInsert().
+		Model(&states).
+		On("CONFLICT (source) DO UPDATE").
+		Set("state = EXCLUDED.state").
+		Set("updated_at = EXCLUDED.updated_at").
+		Set("updated_by = EXCLUDED.updated_by").
+		Set("reason = EXCLUDED.reason").
+		Where("improve_request_states.state = ?", StateOpen).
+		Exec(ctx)
+	if err != nil {
+		return 0, validation.HandlePGError(err)
+	}
+
+	closed, err := res.RowsAffected()
+	if err != nil {
+		return 0, validation.HandlePGError(err)
+	}
+
+	return closed, nil
+}
@@ -0,0 +1,314 @@
+package thread_state_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime   = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	updateTime = time.Date(2020, time.May, 4, 12, 0, 0, 0, time.UTC)
+)
+
+var Fixtures = []interface{}{
+	&Model{
+		Source:    test_utils.NumberUUID(1004),
+		State:     StateLocked,
+		UpdatedAt: baseTime,
+		UpdatedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+		Reason:    "spam",
+	},
+	&Model{
+		Source:    test_utils.NumberUUID(1005),
+		State:     StateOpen,
+		UpdatedAt: baseTime,
+		UpdatedBy: framework.ToPTR(test_utils.NumberUUID(100)),
+	},
+}
+
+func TestThreadStateRepository_Read(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		source uuid.UUID
+
+		expect    *Model
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1004),
+			expect: Fixtures[0].(*Model),
+		},
+		{
+			name:      "Error/NotFound",
+			source:    test_utils.NumberUUID(1000),
+			expectErr: validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				repository := NewRepository(tx)
+				res, err := repository.Read(ctx, d.source)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestThreadStateRepository_Update(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		source    uuid.UUID
+		state     State
+		updatedBy *uuid.UUID
+		reason    string
+		now       time.Time
+
+		expect    *Model
+		expectErr error
+	}{
+		{
+			name:      "Success",
+			source:    test_utils.NumberUUID(1000),
+			state:     StateClosed,
+			updatedBy: framework.ToPTR(test_utils.NumberUUID(100)),
+			now:       updateTime,
+			expect: &Model{
+				Source:    test_utils.NumberUUID(1000),
+				State:     StateClosed,
+				UpdatedAt: updateTime,
+				UpdatedBy: framework.ToPTR(test_utils.NumberUUID(100)),
+			},
+		},
+		{
+			name:      "Success/Existing",
+			source:    test_utils.NumberUUID(1004),
+			state:     StateOpen,
+			updatedBy: framework.ToPTR(test_utils.NumberUUID(11)),
+			reason:    "appeal accepted",
+			now:       updateTime,
+			expect: &Model{
+				Source:    test_utils.NumberUUID(1004),
+				State:     StateOpen,
+				UpdatedAt: updateTime,
+				UpdatedBy: framework.ToPTR(test_utils.NumberUUID(11)),
+				Reason:    "appeal accepted",
+			},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				res, err := repository.Update(ctx, d.source, d.state, d.updatedBy, d.reason, d.now)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+
+				if d.expect != nil {
+					stored, err := repository.Read(ctx, d.source)
+					require.NoError(st, err)
+					require.Equal(st, d.expect, stored)
+				}
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestThreadStateRepository_AutoClose(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		// Revised after its last suggestion.
+		&improve_request_storage.Model{
+			ID:        test_utils.NumberUUID(1000),
+			CreatedAt: baseTime,
+			Source:    test_utils.NumberUUID(1000),
+			UserID:    test_utils.NumberUUID(100),
+			Title:     "Test",
+			Content:   "Dummy content.",
+		},
+		&improve_request_storage.Model{
+			ID:        test_utils.NumberUUID(1001),
+			CreatedAt: baseTime.Add(2 * time.Hour),
+			Source:    test_utils.NumberUUID(1000),
+			UserID:    test_utils.NumberUUID(100),
+			Title:     "Test",
+			Content:   "Dummy content updated.",
+		},
+		// Inactive.
+		&improve_request_storage.Model{
+			ID:        test_utils.NumberUUID(1002),
+			CreatedAt: baseTime,
+			Source:    test_utils.NumberUUID(1002),
+			UserID:    test_utils.NumberUUID(100),
+			Title:     "Test",
+			Content:   "Dummy content.",
+		},
+		// Recent, with enough accepted suggestions.
+		&improve_request_storage.Model{
+			ID:        test_utils.NumberUUID(1003),
+			CreatedAt: baseTime.Add(3 * time.Hour),
+			Source:    test_utils.NumberUUID(1003),
+			UserID:    test_utils.NumberUUID(101),
+			Title:     "Test",
+			Content:   "Dummy content.",
+		},
+		// Inactive, but locked.
+		&improve_request_storage.Model{
+			ID:        test_utils.NumberUUID(1004),
+			CreatedAt: baseTime,
+			Source:    test_utils.NumberUUID(1004),
+			UserID:    test_utils.NumberUUID(101),
+			Title:     "Test",
+			Content:   "Dummy content.",
+		},
+		// Inactive, and explicitly reopened.
+		&improve_request_storage.Model{
+			ID:        test_utils.NumberUUID(1005),
+			CreatedAt: baseTime,
+			Source:    test_utils.NumberUUID(1005),
+			UserID:    test_utils.NumberUUID(100),
+			Title:     "Test",
+			Content:   "Dummy content.",
+		},
+		&improve_suggestion_storage.Model{
+			ID:        test_utils.NumberUUID(2000),
+			CreatedAt: baseTime,
+			UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+			SourceID:  test_utils.NumberUUID(1000),
+			UserID:    test_utils.NumberUUID(101),
+			Validated: true,
+			Core: improve_suggestion_storage.Core{
+				RequestID: test_utils.NumberUUID(1000),
+				Title:     "Test",
+				Content:   "Smart content.",
+			},
+		},
+		&improve_suggestion_storage.Model{
+			ID:        test_utils.NumberUUID(2001),
+			CreatedAt: baseTime.Add(3 * time.Hour),
+			SourceID:  test_utils.NumberUUID(1003),
+			UserID:    test_utils.NumberUUID(100),
+			Validated: true,
+			Core: improve_suggestion_storage.Core{
+				RequestID: test_utils.NumberUUID(1003),
+				Title:     "Test",
+				Content:   "Smart content.",
+			},
+		},
+		&improve_suggestion_storage.Model{
+			ID:        test_utils.NumberUUID(2002),
+			CreatedAt: baseTime.Add(3 * time.Hour),
+			SourceID:  test_utils.NumberUUID(1003),
+			UserID:    test_utils.NumberUUID(102),
+			Validated: true,
+			Core: improve_suggestion_storage.Core{
+				RequestID: test_utils.NumberUUID(1003),
+				Title:     "Test",
+				Content:   "Smarter content.",
+			},
+		},
+	}
+	for _, fixture := range Fixtures {
+		fixtures = append(fixtures, fixture)
+	}
+
+	data := []struct {
+		name string
+
+		minAccepted   int
+		inactiveSince *time.Time
+
+		expect       int64
+		expectClosed []uuid.UUID
+		expectErr    error
+	}{
+		{
+			name:   "Success/Disabled",
+			expect: 0,
+		},
+		{
+			name:         "Success/Accepted",
+			minAccepted:  2,
+			expect:       1,
+			expectClosed: []uuid.UUID{test_utils.NumberUUID(1003)},
+		},
+		{
+			name:          "Success/Inactive",
+			inactiveSince: framework.ToPTR(baseTime.Add(90 * time.Minute)),
+			expect:        2,
+			expectClosed:  []uuid.UUID{test_utils.NumberUUID(1002), test_utils.NumberUUID(1005)},
+		},
+		{
+			name:          "Success/Both",
+			minAccepted:   2,
+			inactiveSince: framework.ToPTR(baseTime.Add(150 * time.Minute)),
+			expect:        4,
+			expectClosed: []uuid.UUID{
+				test_utils.NumberUUID(1000),
+				test_utils.NumberUUID(1002),
+				test_utils.NumberUUID(1003),
+				test_utils.NumberUUID(1005),
+			},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				res, err := repository.AutoClose(ctx, d.minAccepted, d.inactiveSince, updateTime)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+
+				for _, source := range d.expectClosed {
+					stored, err := repository.Read(ctx, source)
+					require.NoError(st, err)
+					require.Equal(st, &Model{
+						Source:    source,
+						State:     StateClosed,
+						UpdatedAt: updateTime,
+						Reason:    AutoCloseReason,
+					}, stored)
+				}
+
+				locked, err := repository.Read(ctx, test_utils.NumberUUID(1004))
+				require.NoError(st, err)
+				require.Equal(st, StateLocked, locked.State)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,583 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package visibility_storage
+
+import (
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateShareToken provides a mock function with given fields: ctx, source, code, id, now
+func (_m *MockRepository) CreateShareToken(ctx context.Context, source uuid.UUID, code string, id uuid.UUID, now time.Time) (*ShareToken, error) {
+	ret := _m.Called(ctx, source, code, id, now)
+
+	var r0 *ShareToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, uuid.UUID, time.Time) (*ShareToken, error)); ok {
+		return rf(ctx, source, code, id, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, uuid.UUID, time.Time) *ShareToken); ok {
+		r0 = rf(ctx, source, code, id, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ShareToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, source, code, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_CreateShareToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateShareToken'
+type MockRepository_CreateShareToken_Call struct {
+	*mock.Call
+}
+
+// CreateShareToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - code string
+//   - id uuid.UUID
+//   - now time.Time
+func (_e *MockRepository_Expecter) CreateShareToken(ctx interface{}, source interface{}, code interface{}, id interface{}, now interface{}) *MockRepository_CreateShareToken_Call {
+	return &MockRepository_CreateShareToken_Call{Call: _e.mock.On("CreateShareToken", ctx, source, code, id, now)}
+}
+
+func (_c *MockRepository_CreateShareToken_Call) Run(run func(ctx context.Context, source uuid.UUID, code string, id uuid.UUID, now time.Time)) *MockRepository_CreateShareToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(uuid.UUID), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_CreateShareToken_Call) Return(_a0 *ShareToken, _a1 error) *MockRepository_CreateShareToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_CreateShareToken_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, uuid.UUID, time.Time) (*ShareToken, error)) *MockRepository_CreateShareToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteShareToken provides a mock function with given fields: ctx, source, id
+func (_m *MockRepository) DeleteShareToken(ctx context.Context, source uuid.UUID, id uuid.UUID) error {
+	ret := _m.Called(ctx, source, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, source, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_DeleteShareToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteShareToken'
+type MockRepository_DeleteShareToken_Call struct {
+	*mock.Call
+}
+
+// DeleteShareToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - id uuid.UUID
+func (_e *MockRepository_Expecter) DeleteShareToken(ctx interface{}, source interface{}, id interface{}) *MockRepository_DeleteShareToken_Call {
+	return &MockRepository_DeleteShareToken_Call{Call: _e.mock.On("DeleteShareToken", ctx, source, id)}
+}
+
+func (_c *MockRepository_DeleteShareToken_Call) Run(run func(ctx context.Context, source uuid.UUID, id uuid.UUID)) *MockRepository_DeleteShareToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteShareToken_Call) Return(_a0 error) *MockRepository_DeleteShareToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_DeleteShareToken_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockRepository_DeleteShareToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Invite provides a mock function with given fields: ctx, source, userID, now
+func (_m *MockRepository) Invite(ctx context.Context, source uuid.UUID, userID uuid.UUID, now time.Time) (*Invite, error) {
+	ret := _m.Called(ctx, source, userID, now)
+
+	var r0 *Invite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) (*Invite, error)); ok {
+		return rf(ctx, source, userID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) *Invite); ok {
+		r0 = rf(ctx, source, userID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Invite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, source, userID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Invite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Invite'
+type MockRepository_Invite_Call struct {
+	*mock.Call
+}
+
+// Invite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - userID uuid.UUID
+//   - now time.Time
+func (_e *MockRepository_Expecter) Invite(ctx interface{}, source interface{}, userID interface{}, now interface{}) *MockRepository_Invite_Call {
+	return &MockRepository_Invite_Call{Call: _e.mock.On("Invite", ctx, source, userID, now)}
+}
+
+func (_c *MockRepository_Invite_Call) Run(run func(ctx context.Context, source uuid.UUID, userID uuid.UUID, now time.Time)) *MockRepository_Invite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Invite_Call) Return(_a0 *Invite, _a1 error) *MockRepository_Invite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Invite_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, time.Time) (*Invite, error)) *MockRepository_Invite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsInvited provides a mock function with given fields: ctx, source, userID
+func (_m *MockRepository) IsInvited(ctx context.Context, source uuid.UUID, userID uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, source, userID)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, source, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(ctx, source, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, source, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_IsInvited_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsInvited'
+type MockRepository_IsInvited_Call struct {
+	*mock.Call
+}
+
+// IsInvited is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockRepository_Expecter) IsInvited(ctx interface{}, source interface{}, userID interface{}) *MockRepository_IsInvited_Call {
+	return &MockRepository_IsInvited_Call{Call: _e.mock.On("IsInvited", ctx, source, userID)}
+}
+
+func (_c *MockRepository_IsInvited_Call) Run(run func(ctx context.Context, source uuid.UUID, userID uuid.UUID)) *MockRepository_IsInvited_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_IsInvited_Call) Return(_a0 bool, _a1 error) *MockRepository_IsInvited_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_IsInvited_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (bool, error)) *MockRepository_IsInvited_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListInvites provides a mock function with given fields: ctx, source, limit, offset
+func (_m *MockRepository) ListInvites(ctx context.Context, source uuid.UUID, limit int, offset int) ([]*Invite, int64, error) {
+	ret := _m.Called(ctx, source, limit, offset)
+
+	var r0 []*Invite
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]*Invite, int64, error)); ok {
+		return rf(ctx, source, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []*Invite); ok {
+		r0 = rf(ctx, source, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Invite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) int64); ok {
+		r1 = rf(ctx, source, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r2 = rf(ctx, source, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRepository_ListInvites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInvites'
+type MockRepository_ListInvites_Call struct {
+	*mock.Call
+}
+
+// ListInvites is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - limit int
+//   - offset int
+func (_e *MockRepository_Expecter) ListInvites(ctx interface{}, source interface{}, limit interface{}, offset interface{}) *MockRepository_ListInvites_Call {
+	return &MockRepository_ListInvites_Call{Call: _e.mock.On("ListInvites", ctx, source, limit, offset)}
+}
+
+func (_c *MockRepository_ListInvites_Call) Run(run func(ctx context.Context, source uuid.UUID, limit int, offset int)) *MockRepository_ListInvites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListInvites_Call) Return(_a0 []*Invite, _a1 int64, _a2 error) *MockRepository_ListInvites_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRepository_ListInvites_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, int) ([]*Invite, int64, error)) *MockRepository_ListInvites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListShareTokens provides a mock function with given fields: ctx, source
+func (_m *MockRepository) ListShareTokens(ctx context.Context, source uuid.UUID) ([]*ShareToken, error) {
+	ret := _m.Called(ctx, source)
+
+	var r0 []*ShareToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*ShareToken, error)); ok {
+		return rf(ctx, source)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*ShareToken); ok {
+		r0 = rf(ctx, source)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ShareToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, source)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ListShareTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListShareTokens'
+type MockRepository_ListShareTokens_Call struct {
+	*mock.Call
+}
+
+// ListShareTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+func (_e *MockRepository_Expecter) ListShareTokens(ctx interface{}, source interface{}) *MockRepository_ListShareTokens_Call {
+	return &MockRepository_ListShareTokens_Call{Call: _e.mock.On("ListShareTokens", ctx, source)}
+}
+
+func (_c *MockRepository_ListShareTokens_Call) Run(run func(ctx context.Context, source uuid.UUID)) *MockRepository_ListShareTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListShareTokens_Call) Return(_a0 []*ShareToken, _a1 error) *MockRepository_ListShareTokens_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ListShareTokens_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*ShareToken, error)) *MockRepository_ListShareTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadAccess provides a mock function with given fields: ctx, source
+func (_m *MockRepository) ReadAccess(ctx context.Context, source uuid.UUID) (*Access, error) {
+	ret := _m.Called(ctx, source)
+
+	var r0 *Access
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*Access, error)); ok {
+		return rf(ctx, source)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *Access); ok {
+		r0 = rf(ctx, source)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Access)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, source)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ReadAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadAccess'
+type MockRepository_ReadAccess_Call struct {
+	*mock.Call
+}
+
+// ReadAccess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+func (_e *MockRepository_Expecter) ReadAccess(ctx interface{}, source interface{}) *MockRepository_ReadAccess_Call {
+	return &MockRepository_ReadAccess_Call{Call: _e.mock.On("ReadAccess", ctx, source)}
+}
+
+func (_c *MockRepository_ReadAccess_Call) Run(run func(ctx context.Context, source uuid.UUID)) *MockRepository_ReadAccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ReadAccess_Call) Return(_a0 *Access, _a1 error) *MockRepository_ReadAccess_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ReadAccess_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*Access, error)) *MockRepository_ReadAccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadShareToken provides a mock function with given fields: ctx, id
+func (_m *MockRepository) ReadShareToken(ctx context.Context, id uuid.UUID) (*ShareToken, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *ShareToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*ShareToken, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *ShareToken); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ShareToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ReadShareToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadShareToken'
+type MockRepository_ReadShareToken_Call struct {
+	*mock.Call
+}
+
+// ReadShareToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockRepository_Expecter) ReadShareToken(ctx interface{}, id interface{}) *MockRepository_ReadShareToken_Call {
+	return &MockRepository_ReadShareToken_Call{Call: _e.mock.On("ReadShareToken", ctx, id)}
+}
+
+func (_c *MockRepository_ReadShareToken_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockRepository_ReadShareToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ReadShareToken_Call) Return(_a0 *ShareToken, _a1 error) *MockRepository_ReadShareToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ReadShareToken_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*ShareToken, error)) *MockRepository_ReadShareToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Uninvite provides a mock function with given fields: ctx, source, userID
+func (_m *MockRepository) Uninvite(ctx context.Context, source uuid.UUID, userID uuid.UUID) error {
+	ret := _m.Called(ctx, source, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, source, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Uninvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Uninvite'
+type MockRepository_Uninvite_Call struct {
+	*mock.Call
+}
+
+// Uninvite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockRepository_Expecter) Uninvite(ctx interface{}, source interface{}, userID interface{}) *MockRepository_Uninvite_Call {
+	return &MockRepository_Uninvite_Call{Call: _e.mock.On("Uninvite", ctx, source, userID)}
+}
+
+func (_c *MockRepository_Uninvite_Call) Run(run func(ctx context.Context, source uuid.UUID, userID uuid.UUID)) *MockRepository_Uninvite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Uninvite_Call) Return(_a0 error) *MockRepository_Uninvite_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Uninvite_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockRepository_Uninvite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAccess provides a mock function with given fields: ctx, source, visibility, now
+func (_m *MockRepository) UpdateAccess(ctx context.Context, source uuid.UUID, visibility Visibility, now time.Time) (*Access, error) {
+	ret := _m.Called(ctx, source, visibility, now)
+
+	var r0 *Access
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, Visibility, time.Time) (*Access, error)); ok {
+		return rf(ctx, source, visibility, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, Visibility, time.Time) *Access); ok {
+		r0 = rf(ctx, source, visibility, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Access)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, Visibility, time.Time) error); ok {
+		r1 = rf(ctx, source, visibility, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_UpdateAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccess'
+type MockRepository_UpdateAccess_Call struct {
+	*mock.Call
+}
+
+// UpdateAccess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source uuid.UUID
+//   - visibility Visibility
+//   - now time.Time
+func (_e *MockRepository_Expecter) UpdateAccess(ctx interface{}, source interface{}, visibility interface{}, now interface{}) *MockRepository_UpdateAccess_Call {
+	return &MockRepository_UpdateAccess_Call{Call: _e.mock.On("UpdateAccess", ctx, source, visibility, now)}
+}
+
+func (_c *MockRepository_UpdateAccess_Call) Run(run func(ctx context.Context, source uuid.UUID, visibility Visibility, now time.Time)) *MockRepository_UpdateAccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(Visibility), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateAccess_Call) Return(_a0 *Access, _a1 error) *MockRepository_UpdateAccess_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_UpdateAccess_Call) RunAndReturn(run func(context.Context, uuid.UUID, Visibility, time.Time) (*Access, error)) *MockRepository_UpdateAccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockRepository(t mockConstructorTestingTNewMockRepository) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
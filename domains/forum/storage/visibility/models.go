@@ -0,0 +1,61 @@
+package visibility_storage
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// Visibility restricts who can see an improvement request (improve_request_storage.Model).
+type Visibility string
+
+const (
+	// VisibilityPublic requests are listed in searches, and readable by anyone.
+	VisibilityPublic Visibility = "public"
+	// VisibilityUnlisted requests are only readable by their authors, invited users, and anyone with a valid
+	// share token (ShareToken).
+	VisibilityUnlisted Visibility = "unlisted"
+	// VisibilityRestricted requests are only readable by their authors and invited users.
+	VisibilityRestricted Visibility = "restricted"
+)
+
+// Access is the database model for the improve_request_access table. It sets the visibility of every revision of
+// an improvement request. Requests without an entry are public.
+type Access struct {
+	bun.BaseModel `bun:"table:improve_request_access,alias:improve_request_access"`
+
+	// Source is the ID of the first revision of the request.
+	Source uuid.UUID `json:"source" bun:"source,pk,type:uuid"`
+	// Visibility of the request.
+	Visibility Visibility `json:"visibility" bun:"visibility"`
+	// UpdatedAt stores the time at which the visibility was last changed.
+	UpdatedAt time.Time `json:"updated_at" bun:"updated_at,notnull"`
+}
+
+// Invite is the database model for the improve_request_invites table. It allows a user to read a request that is
+// not public.
+type Invite struct {
+	bun.BaseModel `bun:"table:improve_request_invites,alias:improve_request_invites"`
+
+	// Source is the ID of the first revision of the request.
+	Source uuid.UUID `json:"source" bun:"source,pk,type:uuid"`
+	// UserID is the ID of the invited user.
+	UserID uuid.UUID `json:"user_id" bun:"user_id,pk,type:uuid"`
+	// CreatedAt stores the time at which the user was invited.
+	CreatedAt time.Time `json:"created_at" bun:"created_at,notnull"`
+}
+
+// ShareToken is the database model for the improve_request_share_tokens table. It grants read access to an
+// unlisted request, to anyone who knows the code. A token is revoked by deleting it.
+type ShareToken struct {
+	bun.BaseModel `bun:"table:improve_request_share_tokens,alias:improve_request_share_tokens"`
+
+	// ID of the token.
+	ID uuid.UUID `json:"id" bun:"id,pk,type:uuid"`
+	// Source is the ID of the first revision of the request.
+	Source uuid.UUID `json:"source" bun:"source,type:uuid"`
+	// CreatedAt stores the time at which the token was created.
+	CreatedAt time.Time `json:"created_at" bun:"created_at,notnull"`
+	// Code is the hashed version of the secret part of the token.
+	Code string `json:"-" bun:"code"`
+}
@@ -0,0 +1,169 @@
+package visibility_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// Repository of the current layer. You can instantiate a new one with NewRepository.
+type Repository interface {
+	// ReadAccess returns the visibility of a request, based on the ID of its first revision. It returns
+	// validation.ErrNotFound if the visibility of the request was never changed.
+	ReadAccess(ctx context.Context, source uuid.UUID) (*Access, error)
+	// UpdateAccess sets the visibility of a request.
+	UpdateAccess(ctx context.Context, source uuid.UUID, visibility Visibility, now time.Time) (*Access, error)
+
+	// IsInvited returns whether a user was invited to read a request.
+	IsInvited(ctx context.Context, source, userID uuid.UUID) (bool, error)
+	// ListInvites returns the users invited to read a request, most recent first.
+	// It also returns the total number of available results, to help with pagination.
+	ListInvites(ctx context.Context, source uuid.UUID, limit, offset int) ([]*Invite, int64, error)
+	// Invite allows a user to read a request.
+	Invite(ctx context.Context, source, userID uuid.UUID, now time.Time) (*Invite, error)
+	// Uninvite removes the invitation of a user.
+	Uninvite(ctx context.Context, source, userID uuid.UUID) error
+
+	// ReadShareToken reads a single share token, based on its ID.
+	ReadShareToken(ctx context.Context, id uuid.UUID) (*ShareToken, error)
+	// ListShareTokens returns the share tokens of a request, most recent first.
+	ListShareTokens(ctx context.Context, source uuid.UUID) ([]*ShareToken, error)
+	// CreateShareToken saves a new share token. The code must already be hashed.
+	CreateShareToken(ctx context.Context, source uuid.UUID, code string, id uuid.UUID, now time.Time) (*ShareToken, error)
+	// DeleteShareToken revokes a share token of a request.
+	DeleteShareToken(ctx context.Context, source, id uuid.UUID) error
+}
+
+// NewRepository returns a new Repository instance.
+// To use a mocked one, call NewMockRepository.
+func NewRepository(db bun.IDB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+type repositoryImpl struct {
+	db bun.IDB
+}
+
+func (repository *repositoryImpl) ReadAccess(ctx context.Context, source uuid.UUID) (*Access, error) {
+	model := &Access{Source: source}
+	if err := repository.db.NewSelect().Model(model).WherePK().Scan(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+func (repository *repositoryImpl) UpdateAccess(ctx context.Context, source uuid.UUID, visibility Visibility, now time.Time) (*Access, error) {
+	model := &Access{Source: source, Visibility: visibility, UpdatedAt: now}
+
+	if _, err := repository.db.NewInsert().
+		Model(model).
+		On("CONFLICT (source) DO UPDATE").
+		Set("visibility = EXCLUDED.visibility").
+		Set("updated_at = EXCLUDED.updated_at").
+		Returning("*").
+		Exec(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+func (repository *repositoryImpl) IsInvited(ctx context.Context, source, userID uuid.UUID) (bool, error) {
+	ok, err := repository.db.NewSelect().
+		Model((*Invite)(nil)).
+		Where("source = ?", source).
+		Where("user_id = ?", userID).
+		Exists(ctx)
+	if err != nil {
+		return false, validation.HandlePGError(err)
+	}
+
+	return ok, nil
+}
+
+func (repository *repositoryImpl) ListInvites(ctx context.Context, source uuid.UUID, limit, offset int) ([]*Invite, int64, error) {
+	results := make([]*Invite, 0)
+
+	count, err := repository.db.NewSelect().
+		Model(&results).
+		Where("source = ?", source).
+		Order("created_at DESC", "user_id").
+		Limit(limit).
+		Offset(offset).
+		ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, validation.HandlePGError(err)
+	}
+
+	return results, int64(count), nil
+}
+
+func (repository *repositoryImpl) Invite(ctx context.Context, source, userID uuid.UUID, now time.Time) (*Invite, error) {
+	model := &Invite{Source: source, UserID: userID, CreatedAt: now}
+
+	if _, err := repository.db.NewInsert().Model(model).Returning("*").Exec(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+func (repository *repositoryImpl) Uninvite(ctx context.Context, source, userID uuid.UUID) error {
+	model := &Invite{Source: source, UserID: userID}
+
+	res, err := repository.db.NewDelete().Model(model).WherePK().Exec(ctx)
+	if err != nil {
+		return validation.HandlePGError(err)
+	}
+
+	return validation.ForceRowsUpdate(res)
+}
+
+func (repository *repositoryImpl) ReadShareToken(ctx context.Context, id uuid.UUID) (*ShareToken, error) {
+	model := &ShareToken{ID: id}
+	if err := repository.db.NewSelect().Model(model).WherePK().Scan(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+func (repository *repositoryImpl) ListShareTokens(ctx context.Context, source uuid.UUID) ([]*ShareToken, error) {
+	results := make([]*ShareToken, 0)
+
+	if err := repository.db.NewSelect().
+		Model(&results).
+		Where("source = ?", source).
+		Order("created_at DESC", "id").
+		Scan(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return results, nil
+}
+
+func (repository *repositoryImpl) CreateShareToken(ctx context.Context, source uuid.UUID, code string, id uuid.UUID, now time.Time) (*ShareToken, error) {
+	model := &ShareToken{ID: id, Source: source, CreatedAt: now, Code: code}
+
+	if _, err := repository.db.NewInsert().Model(model).Returning("*").Exec(ctx); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return model, nil
+}
+
+func (repository *repositoryImpl) DeleteShareToken(ctx context.Context, source, id uuid.UUID) error {
+	res, err := repository.db.NewDelete().
+		Model((*ShareToken)(nil)).
+		Where("id = ?", id).
+		Where("source = ?", source).
+		Exec(ctx)
+	if err != nil {
+		return validation.HandlePGError(err)
+	}
+
+	return validation.ForceRowsUpdate(res)
+}
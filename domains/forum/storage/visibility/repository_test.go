@@ -0,0 +1,512 @@
+package visibility_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime   = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	updateTime = time.Date(2020, time.May, 4, 9, 0, 0, 0, time.UTC)
+)
+
+var Fixtures = []interface{}{
+	&Access{
+		Source:     test_utils.NumberUUID(1000),
+		Visibility: VisibilityUnlisted,
+		UpdatedAt:  baseTime,
+	},
+	&Access{
+		Source:     test_utils.NumberUUID(1001),
+		Visibility: VisibilityRestricted,
+		UpdatedAt:  baseTime,
+	},
+
+	&Invite{
+		Source:    test_utils.NumberUUID(1001),
+		UserID:    test_utils.NumberUUID(100),
+		CreatedAt: baseTime,
+	},
+	&Invite{
+		Source:    test_utils.NumberUUID(1001),
+		UserID:    test_utils.NumberUUID(101),
+		CreatedAt: updateTime,
+	},
+
+	&ShareToken{
+		ID:        test_utils.NumberUUID(2000),
+		Source:    test_utils.NumberUUID(1000),
+		CreatedAt: baseTime,
+		Code:      "hashed-code",
+	},
+	&ShareToken{
+		ID:        test_utils.NumberUUID(2001),
+		Source:    test_utils.NumberUUID(1000),
+		CreatedAt: updateTime,
+		Code:      "other-hashed-code",
+	},
+}
+
+func TestVisibilityRepository_ReadAccess(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		source uuid.UUID
+
+		expect    *Access
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1000),
+			expect: Fixtures[0].(*Access),
+		},
+		{
+			name:      "Error/NotFound",
+			source:    test_utils.NumberUUID(1002),
+			expectErr: validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				repository := NewRepository(tx)
+				res, err := repository.ReadAccess(ctx, d.source)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestVisibilityRepository_UpdateAccess(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		source     uuid.UUID
+		visibility Visibility
+		now        time.Time
+
+		expect    *Access
+		expectErr error
+	}{
+		{
+			name:       "Success",
+			source:     test_utils.NumberUUID(1002),
+			visibility: VisibilityRestricted,
+			now:        updateTime,
+			expect: &Access{
+				Source:     test_utils.NumberUUID(1002),
+				Visibility: VisibilityRestricted,
+				UpdatedAt:  updateTime,
+			},
+		},
+		{
+			name:       "Success/Overwrite",
+			source:     test_utils.NumberUUID(1000),
+			visibility: VisibilityPublic,
+			now:        updateTime,
+			expect: &Access{
+				Source:     test_utils.NumberUUID(1000),
+				Visibility: VisibilityPublic,
+				UpdatedAt:  updateTime,
+			},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				res, err := repository.UpdateAccess(ctx, d.source, d.visibility, d.now)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestVisibilityRepository_IsInvited(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		source uuid.UUID
+		userID uuid.UUID
+
+		expect    bool
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1001),
+			userID: test_utils.NumberUUID(100),
+			expect: true,
+		},
+		{
+			name:   "Success/NotInvited",
+			source: test_utils.NumberUUID(1000),
+			userID: test_utils.NumberUUID(100),
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				repository := NewRepository(tx)
+				res, err := repository.IsInvited(ctx, d.source, d.userID)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestVisibilityRepository_ListInvites(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		source uuid.UUID
+		limit  int
+		offset int
+
+		expect      []*Invite
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name:        "Success",
+			source:      test_utils.NumberUUID(1001),
+			limit:       10,
+			expect:      []*Invite{Fixtures[3].(*Invite), Fixtures[2].(*Invite)},
+			expectTotal: 2,
+		},
+		{
+			name:        "Success/Paginated",
+			source:      test_utils.NumberUUID(1001),
+			limit:       1,
+			offset:      1,
+			expect:      []*Invite{Fixtures[2].(*Invite)},
+			expectTotal: 2,
+		},
+		{
+			name:   "Success/NoResults",
+			source: test_utils.NumberUUID(1000),
+			limit:  10,
+			expect: []*Invite{},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				repository := NewRepository(tx)
+				res, total, err := repository.ListInvites(ctx, d.source, d.limit, d.offset)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+				require.Equal(st, d.expectTotal, total)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestVisibilityRepository_Invite(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		source uuid.UUID
+		userID uuid.UUID
+		now    time.Time
+
+		expect    *Invite
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1000),
+			userID: test_utils.NumberUUID(100),
+			now:    updateTime,
+			expect: &Invite{
+				Source:    test_utils.NumberUUID(1000),
+				UserID:    test_utils.NumberUUID(100),
+				CreatedAt: updateTime,
+			},
+		},
+		{
+			name:      "Error/AlreadyInvited",
+			source:    test_utils.NumberUUID(1001),
+			userID:    test_utils.NumberUUID(100),
+			now:       updateTime,
+			expectErr: validation.ErrUniqConstraintViolation,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				res, err := repository.Invite(ctx, d.source, d.userID, d.now)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestVisibilityRepository_Uninvite(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		source uuid.UUID
+		userID uuid.UUID
+
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1001),
+			userID: test_utils.NumberUUID(100),
+		},
+		{
+			name:      "Error/NotFound",
+			source:    test_utils.NumberUUID(1000),
+			userID:    test_utils.NumberUUID(100),
+			expectErr: validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				test_utils.RequireError(st, d.expectErr, repository.Uninvite(ctx, d.source, d.userID))
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestVisibilityRepository_ReadShareToken(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		id uuid.UUID
+
+		expect    *ShareToken
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			id:     test_utils.NumberUUID(2000),
+			expect: Fixtures[4].(*ShareToken),
+		},
+		{
+			name:      "Error/NotFound",
+			id:        test_utils.NumberUUID(1000),
+			expectErr: validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				repository := NewRepository(tx)
+				res, err := repository.ReadShareToken(ctx, d.id)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestVisibilityRepository_ListShareTokens(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		source uuid.UUID
+
+		expect    []*ShareToken
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1000),
+			expect: []*ShareToken{Fixtures[5].(*ShareToken), Fixtures[4].(*ShareToken)},
+		},
+		{
+			name:   "Success/NoResults",
+			source: test_utils.NumberUUID(1001),
+			expect: []*ShareToken{},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				repository := NewRepository(tx)
+				res, err := repository.ListShareTokens(ctx, d.source)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestVisibilityRepository_CreateShareToken(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		source uuid.UUID
+		code   string
+		id     uuid.UUID
+		now    time.Time
+
+		expect    *ShareToken
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1001),
+			code:   "new-hashed-code",
+			id:     test_utils.NumberUUID(1),
+			now:    updateTime,
+			expect: &ShareToken{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1001),
+				CreatedAt: updateTime,
+				Code:      "new-hashed-code",
+			},
+		},
+		{
+			name:      "Error/AlreadyExists",
+			source:    test_utils.NumberUUID(1001),
+			code:      "new-hashed-code",
+			id:        test_utils.NumberUUID(2000),
+			now:       updateTime,
+			expectErr: validation.ErrUniqConstraintViolation,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				res, err := repository.CreateShareToken(ctx, d.source, d.code, d.id, d.now)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestVisibilityRepository_DeleteShareToken(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		source uuid.UUID
+		id     uuid.UUID
+
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			source: test_utils.NumberUUID(1000),
+			id:     test_utils.NumberUUID(2000),
+		},
+		{
+			name:      "Error/WrongSource",
+			source:    test_utils.NumberUUID(1001),
+			id:        test_utils.NumberUUID(2000),
+			expectErr: validation.ErrNotFound,
+		},
+		{
+			name:      "Error/NotFound",
+			source:    test_utils.NumberUUID(1000),
+			id:        test_utils.NumberUUID(1),
+			expectErr: validation.ErrNotFound,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				test_utils.RequireError(st, d.expectErr, repository.DeleteShareToken(ctx, d.source, d.id))
+			})
+		}
+	})
+	require.NoError(t, err)
+}
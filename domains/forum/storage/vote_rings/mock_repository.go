@@ -0,0 +1,219 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package vote_rings_storage
+
+import (
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// Detect provides a mock function with given fields: ctx, query, now
+func (_m *MockRepository) Detect(ctx context.Context, query DetectQuery, now time.Time) ([]*Flag, error) {
+	ret := _m.Called(ctx, query, now)
+
+	var r0 []*Flag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DetectQuery, time.Time) ([]*Flag, error)); ok {
+		return rf(ctx, query, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DetectQuery, time.Time) []*Flag); ok {
+		r0 = rf(ctx, query, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Flag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DetectQuery, time.Time) error); ok {
+		r1 = rf(ctx, query, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Detect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Detect'
+type MockRepository_Detect_Call struct {
+	*mock.Call
+}
+
+// Detect is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query DetectQuery
+//   - now time.Time
+func (_e *MockRepository_Expecter) Detect(ctx interface{}, query interface{}, now interface{}) *MockRepository_Detect_Call {
+	return &MockRepository_Detect_Call{Call: _e.mock.On("Detect", ctx, query, now)}
+}
+
+func (_c *MockRepository_Detect_Call) Run(run func(ctx context.Context, query DetectQuery, now time.Time)) *MockRepository_Detect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DetectQuery), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Detect_Call) Return(_a0 []*Flag, _a1 error) *MockRepository_Detect_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Detect_Call) RunAndReturn(run func(context.Context, DetectQuery, time.Time) ([]*Flag, error)) *MockRepository_Detect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFlags provides a mock function with given fields: ctx, reviewed, limit, offset
+func (_m *MockRepository) ListFlags(ctx context.Context, reviewed bool, limit int, offset int) ([]*Flag, int64, error) {
+	ret := _m.Called(ctx, reviewed, limit, offset)
+
+	var r0 []*Flag
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool, int, int) ([]*Flag, int64, error)); ok {
+		return rf(ctx, reviewed, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool, int, int) []*Flag); ok {
+		r0 = rf(ctx, reviewed, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Flag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool, int, int) int64); ok {
+		r1 = rf(ctx, reviewed, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, bool, int, int) error); ok {
+		r2 = rf(ctx, reviewed, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRepository_ListFlags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFlags'
+type MockRepository_ListFlags_Call struct {
+	*mock.Call
+}
+
+// ListFlags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reviewed bool
+//   - limit int
+//   - offset int
+func (_e *MockRepository_Expecter) ListFlags(ctx interface{}, reviewed interface{}, limit interface{}, offset interface{}) *MockRepository_ListFlags_Call {
+	return &MockRepository_ListFlags_Call{Call: _e.mock.On("ListFlags", ctx, reviewed, limit, offset)}
+}
+
+func (_c *MockRepository_ListFlags_Call) Run(run func(ctx context.Context, reviewed bool, limit int, offset int)) *MockRepository_ListFlags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListFlags_Call) Return(_a0 []*Flag, _a1 int64, _a2 error) *MockRepository_ListFlags_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRepository_ListFlags_Call) RunAndReturn(run func(context.Context, bool, int, int) ([]*Flag, int64, error)) *MockRepository_ListFlags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Review provides a mock function with given fields: ctx, authorID, voterID, reviewerID, void, now
+func (_m *MockRepository) Review(ctx context.Context, authorID uuid.UUID, voterID uuid.UUID, reviewerID uuid.UUID, void bool, now time.Time) (*Flag, error) {
+	ret := _m.Called(ctx, authorID, voterID, reviewerID, void, now)
+
+	var r0 *Flag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, bool, time.Time) (*Flag, error)); ok {
+		return rf(ctx, authorID, voterID, reviewerID, void, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, bool, time.Time) *Flag); ok {
+		r0 = rf(ctx, authorID, voterID, reviewerID, void, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Flag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, bool, time.Time) error); ok {
+		r1 = rf(ctx, authorID, voterID, reviewerID, void, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_Review_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Review'
+type MockRepository_Review_Call struct {
+	*mock.Call
+}
+
+// Review is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorID uuid.UUID
+//   - voterID uuid.UUID
+//   - reviewerID uuid.UUID
+//   - void bool
+//   - now time.Time
+func (_e *MockRepository_Expecter) Review(ctx interface{}, authorID interface{}, voterID interface{}, reviewerID interface{}, void interface{}, now interface{}) *MockRepository_Review_Call {
+	return &MockRepository_Review_Call{Call: _e.mock.On("Review", ctx, authorID, voterID, reviewerID, void, now)}
+}
+
+func (_c *MockRepository_Review_Call) Run(run func(ctx context.Context, authorID uuid.UUID, voterID uuid.UUID, reviewerID uuid.UUID, void bool, now time.Time)) *MockRepository_Review_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(bool), args[5].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Review_Call) Return(_a0 *Flag, _a1 error) *MockRepository_Review_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_Review_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, bool, time.Time) (*Flag, error)) *MockRepository_Review_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+type mockConstructorTestingTNewMockRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMockRepository(t mockConstructorTestingTNewMockRepository) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
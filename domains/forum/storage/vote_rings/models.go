@@ -0,0 +1,42 @@
+package vote_rings_storage
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// Flag is the database model for the forum_vote_ring_flags table.
+// A flag is raised when a recently created account up voted several posts of an author, along with enough other
+// recent accounts to look like a voting ring. It remains pending until a moderator reviews it.
+type Flag struct {
+	bun.BaseModel `bun:"table:forum_vote_ring_flags,alias:flag"`
+
+	// AuthorID is the ID of the user who received the suspicious votes.
+	AuthorID uuid.UUID `json:"author_id" bun:"author_id,pk,type:uuid"`
+	// VoterID is the ID of the suspected sockpuppet.
+	VoterID uuid.UUID `json:"voter_id" bun:"voter_id,pk,type:uuid"`
+	// CreatedAt stores the time at which the flag was raised.
+	CreatedAt time.Time `json:"created_at" bun:"created_at,notnull"`
+	// Votes is the number of posts of the author the voter up voted, when the flag was raised.
+	Votes int64 `json:"votes" bun:"votes"`
+
+	// ReviewedAt stores the time at which a moderator reviewed the flag. It is nil while the flag is pending.
+	ReviewedAt *time.Time `json:"reviewed_at" bun:"reviewed_at"`
+	// ReviewedBy is the ID of the moderator who reviewed the flag.
+	ReviewedBy *uuid.UUID `json:"reviewed_by" bun:"reviewed_by,type:uuid"`
+	// Voided is true if the moderator removed the votes of the voter on the posts of the author.
+	Voided bool `json:"voided" bun:"voided,notnull"`
+}
+
+// DetectQuery configures the thresholds of Repository.Detect.
+type DetectQuery struct {
+	// Since ignores the votes last updated before this time.
+	Since time.Time
+	// MaxAccountAge is the maximum age of an account, at the time it voted, to be considered new.
+	MaxAccountAge time.Duration
+	// MinVotes is the number of posts of the same author a new account must have up voted to be suspicious.
+	MinVotes int
+	// MinVoters is the number of suspicious voters an author must gather for their flags to be raised.
+	MinVoters int
+}
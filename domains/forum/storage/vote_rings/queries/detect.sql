@@ -0,0 +1,34 @@
+/*
+A voter is suspicious when its account was recently created at the time it voted, and it up voted several posts of
+the same author. One such voter is not enough to tell a ring from a new fan, so flags are only raised once enough
+suspicious voters gather around the same author.
+*/
+WITH posts AS (
+    SELECT id, user_id AS author_id, 'improve_request'::vote_target AS target FROM improve_requests
+    UNION ALL
+    SELECT id, user_id AS author_id, 'improve_suggestion'::vote_target AS target FROM improve_suggestions
+),
+suspicious_voters AS (
+    SELECT posts.author_id, votes.user_id AS voter_id, COUNT(*) AS votes
+    FROM votes
+        JOIN posts ON posts.id = votes.post_id AND posts.target = votes.target
+        JOIN credentials ON credentials.id = votes.user_id
+    WHERE votes.vote = 'up'
+      AND votes.updated_at >= ?0
+      AND votes.updated_at < credentials.created_at + make_interval(secs => ?1)
+    GROUP BY posts.author_id, votes.user_id
+    HAVING COUNT(*) >= ?2
+),
+rings AS (
+    SELECT author_id
+    FROM suspicious_voters
+    GROUP BY author_id
+    HAVING COUNT(*) >= ?3
+)
+INSERT INTO forum_vote_ring_flags (author_id, voter_id, created_at, votes)
+SELECT suspicious_voters.author_id, suspicious_voters.voter_id, ?4, suspicious_voters.votes
+FROM suspicious_voters
+    JOIN rings ON rings.author_id = suspicious_voters.author_id
+/* Reviewed flags are not raised again. */
+ON CONFLICT (author_id, voter_id) DO NOTHING
+RETURNING *
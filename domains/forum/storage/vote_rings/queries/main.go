@@ -0,0 +1,6 @@
+package vote_rings_queries
+
+import _ "embed"
+
+//go:embed detect.sql
+var DetectQuery string
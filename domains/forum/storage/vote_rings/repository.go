@@ -0,0 +1,117 @@
+package vote_rings_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// Repository of the current layer. You can instantiate a new one with NewRepository.
+type Repository interface {
+	// Detect looks for voting rings among the recent votes, and raises a flag for each suspicious voter. Flags that
+	// were already raised for the same author and voter are kept untouched. It returns the newly raised flags.
+	Detect(ctx context.Context, query DetectQuery, now time.Time) ([]*Flag, error)
+	// ListFlags returns either the pending or the reviewed flags, most recent first. Results must be paginated
+	// using the limit and offset parameters.
+	// It also returns the total number of available results, to help with pagination.
+	ListFlags(ctx context.Context, reviewed bool, limit, offset int) ([]*Flag, int64, error)
+	// Review marks a flag as reviewed by the given moderator. If void is true, every vote of the voter on the posts
+	// of the author is removed along the way.
+	Review(ctx context.Context, authorID, voterID, reviewerID uuid.UUID, void bool, now time.Time) (*Flag, error)
+}
+
+// NewRepository returns a new Repository instance.
+// To use a mocked one, call NewMockRepository.
+func NewRepository(db bun.IDB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+type repositoryImpl struct {
+	db bun.IDB
+}
+
+func (repository *repositoryImpl) Detect(ctx context.Context, query DetectQuery, now time.Time) ([]*Flag, error) {
+	flags := make([]*Flag, 0)
+	if err := repository.db.
+		NewRaw(
+			vote_rings_queries.DetectQuery,
+			query.Since, query.MaxAccountAge.Seconds(), query.MinVotes, query.MinVoters, now,
+		).
+		Scan(ctx, &flags); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return flags, nil
+}
+
+func (repository *repositoryImpl) ListFlags(ctx context.Context, reviewed bool, limit, offset int) ([]*Flag, int64, error) {
+	results := make([]*Flag, 0)
+
+	query := repository.db.NewSelect().
+		Model(&results).
+		OrderExpr("flag.created_at DESC, flag.author_id, flag.voter_id").
+		Limit(limit).
+		Offset(offset)
+
+	if reviewed {
+		query = query.Where("flag.reviewed_at IS NOT NULL")
+	} else {
+		query = query.Where("flag.reviewed_at IS NULL")
+	}
+
+	count, err := query.ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, validation.HandlePGError(err)
+	}
+
+	return results, int64(count), nil
+}
+
+func (repository *repositoryImpl) Review(ctx context.Context, authorID, voterID, reviewerID uuid.UUID, void bool, now time.Time) (*Flag, error) {
+	model := &Flag{
+		AuthorID:   authorID,
+		VoterID:    voterID,
+		ReviewedAt: &now,
+		ReviewedBy: &reviewerID,
+		Voided:     void,
+	}
+
+	err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		res, err := tx.NewUpdate().
+			Model(model).
+			Column("reviewed_at", "reviewed_by", "voided").
+			WherePK().
+			Returning("*").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := validation.ForceRowsUpdate(res); err != nil {
+			return err
+		}
+
+		if !void {
+			return nil
+		}
+
+		// Counters are updated by the votes trigger.
+		_, err = tx.NewDelete().
+			TableExpr("votes").
+			Where("votes.user_id = ?", voterID).
+			Where(
+				"(votes.target = 'improve_request' AND votes.post_id IN (SELECT id FROM improve_requests WHERE user_id = ?)) "+
+					"OR (votes.target = 'improve_suggestion' AND votes.post_id IN (SELECT id FROM improve_suggestions WHERE user_id = ?))",
+				authorID, authorID,
+			).
+			Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return model, nil
+}
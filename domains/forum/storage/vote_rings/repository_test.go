@@ -0,0 +1,426 @@
+package vote_rings_storage
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime   = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	updateTime = time.Date(2020, time.May, 4, 9, 0, 0, 0, time.UTC)
+	reviewerID = test_utils.NumberUUID(900)
+)
+
+func voterFixture(id int, createdAt time.Time) *credentials_storage.Model {
+	return &credentials_storage.Model{
+		ID:        test_utils.NumberUUID(id),
+		CreatedAt: createdAt,
+		Core: credentials_storage.Core{
+			Email:    models.Email{User: fmt.Sprintf("voter.%d", id), Domain: "gmail.com"},
+			Password: models.Password{Hashed: "foobarqux"},
+		},
+	}
+}
+
+func requestFixture(id, userID int) *improve_request_storage.Model {
+	return &improve_request_storage.Model{
+		ID:        test_utils.NumberUUID(id),
+		CreatedAt: baseTime.Add(-48 * time.Hour),
+		Source:    test_utils.NumberUUID(id),
+		UserID:    test_utils.NumberUUID(userID),
+		UpVotes:   3,
+		Title:     "Test",
+		Content:   "Dummy content.",
+	}
+}
+
+func upVoteFixture(postID, userID int, updatedAt time.Time) *votes_storage.Model {
+	return &votes_storage.Model{
+		UpdatedAt: updatedAt,
+		PostID:    test_utils.NumberUUID(postID),
+		UserID:    test_utils.NumberUUID(userID),
+		Target:    votes_storage.TargetImproveRequest,
+		Vote:      votes_storage.VoteUp,
+	}
+}
+
+var Fixtures = []interface{}{
+	// Voters 200 and 201 are new accounts, that up voted most of the posts of author 100 right after signing up.
+	voterFixture(200, baseTime.Add(-time.Hour)),
+	voterFixture(201, baseTime.Add(-time.Hour)),
+	// Voter 202 has been around for a while.
+	voterFixture(202, baseTime.Add(-30*24*time.Hour)),
+	// Voter 203 is a new account, but is alone to vote for author 101.
+	voterFixture(203, baseTime.Add(-time.Hour)),
+
+	requestFixture(1000, 100),
+	requestFixture(1001, 100),
+	requestFixture(1002, 100),
+	requestFixture(2000, 101),
+	requestFixture(2001, 101),
+
+	upVoteFixture(1000, 200, baseTime),
+	upVoteFixture(1001, 200, baseTime),
+	upVoteFixture(1002, 200, baseTime),
+	upVoteFixture(1000, 201, baseTime),
+	upVoteFixture(1001, 201, baseTime),
+	upVoteFixture(1000, 202, baseTime),
+	upVoteFixture(1001, 202, baseTime),
+	upVoteFixture(1002, 202, baseTime),
+	upVoteFixture(2000, 203, baseTime),
+	upVoteFixture(2001, 203, baseTime),
+
+	// Flags.
+	&Flag{
+		AuthorID:  test_utils.NumberUUID(300),
+		VoterID:   test_utils.NumberUUID(400),
+		CreatedAt: baseTime.Add(-2 * time.Hour),
+		Votes:     4,
+	},
+	&Flag{
+		AuthorID:  test_utils.NumberUUID(300),
+		VoterID:   test_utils.NumberUUID(401),
+		CreatedAt: baseTime.Add(-time.Hour),
+		Votes:     2,
+	},
+	&Flag{
+		AuthorID:   test_utils.NumberUUID(301),
+		VoterID:    test_utils.NumberUUID(402),
+		CreatedAt:  baseTime.Add(-3 * time.Hour),
+		Votes:      5,
+		ReviewedAt: &baseTime,
+		ReviewedBy: &reviewerID,
+	},
+}
+
+func TestVoteRingsRepository_Detect(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		query DetectQuery
+		now   time.Time
+
+		expect    []*Flag
+		expectErr error
+	}{
+		{
+			name: "Success",
+			query: DetectQuery{
+				Since:         baseTime.Add(-24 * time.Hour),
+				MaxAccountAge: 72 * time.Hour,
+				MinVotes:      2,
+				MinVoters:     2,
+			},
+			now: updateTime,
+			expect: []*Flag{
+				{
+					AuthorID:  test_utils.NumberUUID(100),
+					VoterID:   test_utils.NumberUUID(200),
+					CreatedAt: updateTime,
+					Votes:     3,
+				},
+				{
+					AuthorID:  test_utils.NumberUUID(100),
+					VoterID:   test_utils.NumberUUID(201),
+					CreatedAt: updateTime,
+					Votes:     2,
+				},
+			},
+		},
+		{
+			name: "Success/NotEnoughVotes",
+			query: DetectQuery{
+				Since:         baseTime.Add(-24 * time.Hour),
+				MaxAccountAge: 72 * time.Hour,
+				MinVotes:      3,
+				MinVoters:     2,
+			},
+			now:    updateTime,
+			expect: []*Flag{},
+		},
+		{
+			name: "Success/SingleVoter",
+			query: DetectQuery{
+				Since:         baseTime.Add(-24 * time.Hour),
+				MaxAccountAge: 72 * time.Hour,
+				MinVotes:      3,
+				MinVoters:     1,
+			},
+			now: updateTime,
+			expect: []*Flag{
+				{
+					AuthorID:  test_utils.NumberUUID(100),
+					VoterID:   test_utils.NumberUUID(200),
+					CreatedAt: updateTime,
+					Votes:     3,
+				},
+			},
+		},
+		{
+			name: "Success/AccountsTooOld",
+			query: DetectQuery{
+				Since:         baseTime.Add(-24 * time.Hour),
+				MaxAccountAge: time.Minute,
+				MinVotes:      2,
+				MinVoters:     2,
+			},
+			now:    updateTime,
+			expect: []*Flag{},
+		},
+		{
+			name: "Success/VotesTooOld",
+			query: DetectQuery{
+				Since:         baseTime.Add(time.Minute),
+				MaxAccountAge: 72 * time.Hour,
+				MinVotes:      2,
+				MinVoters:     2,
+			},
+			now:    updateTime,
+			expect: []*Flag{},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.BeginTx(ctx, nil)
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+
+				res, err := repository.Detect(ctx, d.query, d.now)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.ElementsMatch(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestVoteRingsRepository_DetectKeepsReviewedFlags(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	query := DetectQuery{
+		Since:         baseTime.Add(-24 * time.Hour),
+		MaxAccountAge: 72 * time.Hour,
+		MinVotes:      2,
+		MinVoters:     2,
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		res, err := repository.Detect(ctx, query, updateTime)
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+
+		_, err = repository.Review(ctx, test_utils.NumberUUID(100), test_utils.NumberUUID(201), reviewerID, false, updateTime)
+		require.NoError(t, err)
+
+		// Running the detection again does not raise the same flags twice.
+		res, err = repository.Detect(ctx, query, updateTime.Add(time.Hour))
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+	require.NoError(t, err)
+}
+
+func TestVoteRingsRepository_ListFlags(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		reviewed bool
+		limit    int
+		offset   int
+
+		expect      []*Flag
+		expectCount int64
+		expectErr   error
+	}{
+		{
+			name:  "Success/Pending",
+			limit: 10,
+			expect: []*Flag{
+				{
+					AuthorID:  test_utils.NumberUUID(300),
+					VoterID:   test_utils.NumberUUID(401),
+					CreatedAt: baseTime.Add(-time.Hour),
+					Votes:     2,
+				},
+				{
+					AuthorID:  test_utils.NumberUUID(300),
+					VoterID:   test_utils.NumberUUID(400),
+					CreatedAt: baseTime.Add(-2 * time.Hour),
+					Votes:     4,
+				},
+			},
+			expectCount: 2,
+		},
+		{
+			name:   "Success/Paginated",
+			limit:  1,
+			offset: 1,
+			expect: []*Flag{
+				{
+					AuthorID:  test_utils.NumberUUID(300),
+					VoterID:   test_utils.NumberUUID(400),
+					CreatedAt: baseTime.Add(-2 * time.Hour),
+					Votes:     4,
+				},
+			},
+			expectCount: 2,
+		},
+		{
+			name:     "Success/Reviewed",
+			reviewed: true,
+			limit:    10,
+			expect: []*Flag{
+				{
+					AuthorID:   test_utils.NumberUUID(301),
+					VoterID:    test_utils.NumberUUID(402),
+					CreatedAt:  baseTime.Add(-3 * time.Hour),
+					Votes:      5,
+					ReviewedAt: &baseTime,
+					ReviewedBy: &reviewerID,
+				},
+			},
+			expectCount: 1,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, count, err := repository.ListFlags(ctx, d.reviewed, d.limit, d.offset)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+				require.Equal(st, d.expectCount, count)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestVoteRingsRepository_Review(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		authorID uuid.UUID
+		voterID  uuid.UUID
+		void     bool
+
+		expect             *Flag
+		expectVotesRemoved bool
+		expectErr          error
+	}{
+		{
+			name:     "Success",
+			authorID: test_utils.NumberUUID(100),
+			voterID:  test_utils.NumberUUID(200),
+			expect: &Flag{
+				AuthorID:   test_utils.NumberUUID(100),
+				VoterID:    test_utils.NumberUUID(200),
+				CreatedAt:  baseTime,
+				Votes:      3,
+				ReviewedAt: &updateTime,
+				ReviewedBy: &reviewerID,
+			},
+		},
+		{
+			name:     "Success/Void",
+			authorID: test_utils.NumberUUID(100),
+			voterID:  test_utils.NumberUUID(200),
+			void:     true,
+			expect: &Flag{
+				AuthorID:   test_utils.NumberUUID(100),
+				VoterID:    test_utils.NumberUUID(200),
+				CreatedAt:  baseTime,
+				Votes:      3,
+				ReviewedAt: &updateTime,
+				ReviewedBy: &reviewerID,
+				Voided:     true,
+			},
+			expectVotesRemoved: true,
+		},
+		{
+			name:      "Error/NotFound",
+			authorID:  test_utils.NumberUUID(100),
+			voterID:   test_utils.NumberUUID(203),
+			void:      true,
+			expectErr: validation.ErrNotFound,
+		},
+	}
+
+	fixtures := test_utils.Concat(Fixtures, []interface{}{
+		&Flag{
+			AuthorID:  test_utils.NumberUUID(100),
+			VoterID:   test_utils.NumberUUID(200),
+			CreatedAt: baseTime,
+			Votes:     3,
+		},
+	})
+
+	err := test_utils.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.BeginTx(ctx, nil)
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+
+				res, err := repository.Review(ctx, d.authorID, d.voterID, reviewerID, d.void, updateTime)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+
+				if d.expectErr != nil {
+					return
+				}
+
+				votes, err := stx.NewSelect().
+					Model((*votes_storage.Model)(nil)).
+					Where("user_id = ?", d.voterID).
+					Count(ctx)
+				require.NoError(st, err)
+				if d.expectVotesRemoved {
+					require.Zero(st, votes)
+				} else {
+					require.Equal(st, 3, votes)
+				}
+
+				// Other voters are left untouched.
+				otherVotes, err := stx.NewSelect().
+					Model((*votes_storage.Model)(nil)).
+					Where("user_id = ?", test_utils.NumberUUID(201)).
+					Count(ctx)
+				require.NoError(st, err)
+				require.Equal(st, 2, otherVotes)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
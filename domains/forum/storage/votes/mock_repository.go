@@ -24,6 +24,285 @@ func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
 	return &MockRepository_Expecter{mock: &_m.Mock}
 }
 
+// CountOrphans provides a mock function with given fields: ctx
+func (_m *MockRepository) CountOrphans(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_CountOrphans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountOrphans'
+type MockRepository_CountOrphans_Call struct {
+	*mock.Call
+}
+
+// CountOrphans is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRepository_Expecter) CountOrphans(ctx interface{}) *MockRepository_CountOrphans_Call {
+	return &MockRepository_CountOrphans_Call{Call: _e.mock.On("CountOrphans", ctx)}
+}
+
+func (_c *MockRepository_CountOrphans_Call) Run(run func(ctx context.Context)) *MockRepository_CountOrphans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockRepository_CountOrphans_Call) Return(_a0 int64, _a1 error) *MockRepository_CountOrphans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_CountOrphans_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockRepository_CountOrphans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountRecent provides a mock function with given fields: ctx, userID, since
+func (_m *MockRepository) CountRecent(ctx context.Context, userID uuid.UUID, since time.Time) (int64, error) {
+	ret := _m.Called(ctx, userID, since)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) (int64, error)); ok {
+		return rf(ctx, userID, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) int64); ok {
+		r0 = rf(ctx, userID, since)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, userID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_CountRecent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountRecent'
+type MockRepository_CountRecent_Call struct {
+	*mock.Call
+}
+
+// CountRecent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - since time.Time
+func (_e *MockRepository_Expecter) CountRecent(ctx interface{}, userID interface{}, since interface{}) *MockRepository_CountRecent_Call {
+	return &MockRepository_CountRecent_Call{Call: _e.mock.On("CountRecent", ctx, userID, since)}
+}
+
+func (_c *MockRepository_CountRecent_Call) Run(run func(ctx context.Context, userID uuid.UUID, since time.Time)) *MockRepository_CountRecent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_CountRecent_Call) Return(_a0 int64, _a1 error) *MockRepository_CountRecent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_CountRecent_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) (int64, error)) *MockRepository_CountRecent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOrphans provides a mock function with given fields: ctx, limit
+func (_m *MockRepository) DeleteOrphans(ctx context.Context, limit int) (int64, error) {
+	ret := _m.Called(ctx, limit)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int64, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int64); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_DeleteOrphans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrphans'
+type MockRepository_DeleteOrphans_Call struct {
+	*mock.Call
+}
+
+// DeleteOrphans is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockRepository_Expecter) DeleteOrphans(ctx interface{}, limit interface{}) *MockRepository_DeleteOrphans_Call {
+	return &MockRepository_DeleteOrphans_Call{Call: _e.mock.On("DeleteOrphans", ctx, limit)}
+}
+
+func (_c *MockRepository_DeleteOrphans_Call) Run(run func(ctx context.Context, limit int)) *MockRepository_DeleteOrphans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteOrphans_Call) Return(_a0 int64, _a1 error) *MockRepository_DeleteOrphans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_DeleteOrphans_Call) RunAndReturn(run func(context.Context, int) (int64, error)) *MockRepository_DeleteOrphans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DriftedCounters provides a mock function with given fields: ctx, target, limit
+func (_m *MockRepository) DriftedCounters(ctx context.Context, target Target, limit int) ([]*CounterDrift, int64, error) {
+	ret := _m.Called(ctx, target, limit)
+
+	var r0 []*CounterDrift
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, Target, int) ([]*CounterDrift, int64, error)); ok {
+		return rf(ctx, target, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, Target, int) []*CounterDrift); ok {
+		r0 = rf(ctx, target, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*CounterDrift)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, Target, int) int64); ok {
+		r1 = rf(ctx, target, limit)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, Target, int) error); ok {
+		r2 = rf(ctx, target, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRepository_DriftedCounters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DriftedCounters'
+type MockRepository_DriftedCounters_Call struct {
+	*mock.Call
+}
+
+// DriftedCounters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target Target
+//   - limit int
+func (_e *MockRepository_Expecter) DriftedCounters(ctx interface{}, target interface{}, limit interface{}) *MockRepository_DriftedCounters_Call {
+	return &MockRepository_DriftedCounters_Call{Call: _e.mock.On("DriftedCounters", ctx, target, limit)}
+}
+
+func (_c *MockRepository_DriftedCounters_Call) Run(run func(ctx context.Context, target Target, limit int)) *MockRepository_DriftedCounters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(Target), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_DriftedCounters_Call) Return(_a0 []*CounterDrift, _a1 int64, _a2 error) *MockRepository_DriftedCounters_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRepository_DriftedCounters_Call) RunAndReturn(run func(context.Context, Target, int) ([]*CounterDrift, int64, error)) *MockRepository_DriftedCounters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReactions provides a mock function with given fields: ctx, postID, userID, target
+func (_m *MockRepository) GetReactions(ctx context.Context, postID uuid.UUID, userID uuid.UUID, target Target) ([]string, error) {
+	ret := _m.Called(ctx, postID, userID, target)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, Target) ([]string, error)); ok {
+		return rf(ctx, postID, userID, target)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, Target) []string); ok {
+		r0 = rf(ctx, postID, userID, target)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, Target) error); ok {
+		r1 = rf(ctx, postID, userID, target)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetReactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReactions'
+type MockRepository_GetReactions_Call struct {
+	*mock.Call
+}
+
+// GetReactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID uuid.UUID
+//   - userID uuid.UUID
+//   - target Target
+func (_e *MockRepository_Expecter) GetReactions(ctx interface{}, postID interface{}, userID interface{}, target interface{}) *MockRepository_GetReactions_Call {
+	return &MockRepository_GetReactions_Call{Call: _e.mock.On("GetReactions", ctx, postID, userID, target)}
+}
+
+func (_c *MockRepository_GetReactions_Call) Run(run func(ctx context.Context, postID uuid.UUID, userID uuid.UUID, target Target)) *MockRepository_GetReactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(Target))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetReactions_Call) Return(_a0 []string, _a1 error) *MockRepository_GetReactions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetReactions_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, Target) ([]string, error)) *MockRepository_GetReactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetVotedPosts provides a mock function with given fields: ctx, userID, target, limit, offset
 func (_m *MockRepository) GetVotedPosts(ctx context.Context, userID uuid.UUID, target Target, limit int, offset int) ([]*VotedPost, int64, error) {
 	ret := _m.Called(ctx, userID, target, limit, offset)
@@ -89,6 +368,71 @@ func (_c *MockRepository_GetVotedPosts_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetVotedPostsAfter provides a mock function with given fields: ctx, userID, target, cursor, limit
+func (_m *MockRepository) GetVotedPostsAfter(ctx context.Context, userID uuid.UUID, target Target, cursor string, limit int) ([]*VotedPost, string, error) {
+	ret := _m.Called(ctx, userID, target, cursor, limit)
+
+	var r0 []*VotedPost
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, Target, string, int) ([]*VotedPost, string, error)); ok {
+		return rf(ctx, userID, target, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, Target, string, int) []*VotedPost); ok {
+		r0 = rf(ctx, userID, target, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*VotedPost)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, Target, string, int) string); ok {
+		r1 = rf(ctx, userID, target, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, Target, string, int) error); ok {
+		r2 = rf(ctx, userID, target, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRepository_GetVotedPostsAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVotedPostsAfter'
+type MockRepository_GetVotedPostsAfter_Call struct {
+	*mock.Call
+}
+
+// GetVotedPostsAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - target Target
+//   - cursor string
+//   - limit int
+func (_e *MockRepository_Expecter) GetVotedPostsAfter(ctx interface{}, userID interface{}, target interface{}, cursor interface{}, limit interface{}) *MockRepository_GetVotedPostsAfter_Call {
+	return &MockRepository_GetVotedPostsAfter_Call{Call: _e.mock.On("GetVotedPostsAfter", ctx, userID, target, cursor, limit)}
+}
+
+func (_c *MockRepository_GetVotedPostsAfter_Call) Run(run func(ctx context.Context, userID uuid.UUID, target Target, cursor string, limit int)) *MockRepository_GetVotedPostsAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(Target), args[3].(string), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetVotedPostsAfter_Call) Return(_a0 []*VotedPost, _a1 string, _a2 error) *MockRepository_GetVotedPostsAfter_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRepository_GetVotedPostsAfter_Call) RunAndReturn(run func(context.Context, uuid.UUID, Target, string, int) ([]*VotedPost, string, error)) *MockRepository_GetVotedPostsAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HasVoted provides a mock function with given fields: ctx, postID, userID, target
 func (_m *MockRepository) HasVoted(ctx context.Context, postID uuid.UUID, userID uuid.UUID, target Target) (Vote, error) {
 	ret := _m.Called(ctx, postID, userID, target)
@@ -144,6 +488,170 @@ func (_c *MockRepository_HasVoted_Call) RunAndReturn(run func(context.Context, u
 	return _c
 }
 
+// React provides a mock function with given fields: ctx, postID, userID, target, reaction, now
+func (_m *MockRepository) React(ctx context.Context, postID uuid.UUID, userID uuid.UUID, target Target, reaction string, now time.Time) error {
+	ret := _m.Called(ctx, postID, userID, target, reaction, now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, Target, string, time.Time) error); ok {
+		r0 = rf(ctx, postID, userID, target, reaction, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_React_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'React'
+type MockRepository_React_Call struct {
+	*mock.Call
+}
+
+// React is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID uuid.UUID
+//   - userID uuid.UUID
+//   - target Target
+//   - reaction string
+//   - now time.Time
+func (_e *MockRepository_Expecter) React(ctx interface{}, postID interface{}, userID interface{}, target interface{}, reaction interface{}, now interface{}) *MockRepository_React_Call {
+	return &MockRepository_React_Call{Call: _e.mock.On("React", ctx, postID, userID, target, reaction, now)}
+}
+
+func (_c *MockRepository_React_Call) Run(run func(ctx context.Context, postID uuid.UUID, userID uuid.UUID, target Target, reaction string, now time.Time)) *MockRepository_React_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(Target), args[4].(string), args[5].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_React_Call) Return(_a0 error) *MockRepository_React_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_React_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, Target, string, time.Time) error) *MockRepository_React_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RepairCounters provides a mock function with given fields: ctx, target, after, limit
+func (_m *MockRepository) RepairCounters(ctx context.Context, target Target, after uuid.UUID, limit int) (uuid.UUID, int, int64, error) {
+	ret := _m.Called(ctx, target, after, limit)
+
+	var r0 uuid.UUID
+	var r1 int
+	var r2 int64
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, Target, uuid.UUID, int) (uuid.UUID, int, int64, error)); ok {
+		return rf(ctx, target, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, Target, uuid.UUID, int) uuid.UUID); ok {
+		r0 = rf(ctx, target, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, Target, uuid.UUID, int) int); ok {
+		r1 = rf(ctx, target, after, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, Target, uuid.UUID, int) int64); ok {
+		r2 = rf(ctx, target, after, limit)
+	} else {
+		r2 = ret.Get(2).(int64)
+	}
+
+	if rf, ok := ret.Get(3).(func(context.Context, Target, uuid.UUID, int) error); ok {
+		r3 = rf(ctx, target, after, limit)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// MockRepository_RepairCounters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepairCounters'
+type MockRepository_RepairCounters_Call struct {
+	*mock.Call
+}
+
+// RepairCounters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - target Target
+//   - after uuid.UUID
+//   - limit int
+func (_e *MockRepository_Expecter) RepairCounters(ctx interface{}, target interface{}, after interface{}, limit interface{}) *MockRepository_RepairCounters_Call {
+	return &MockRepository_RepairCounters_Call{Call: _e.mock.On("RepairCounters", ctx, target, after, limit)}
+}
+
+func (_c *MockRepository_RepairCounters_Call) Run(run func(ctx context.Context, target Target, after uuid.UUID, limit int)) *MockRepository_RepairCounters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(Target), args[2].(uuid.UUID), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_RepairCounters_Call) Return(_a0 uuid.UUID, _a1 int, _a2 int64, _a3 error) *MockRepository_RepairCounters_Call {
+	_c.Call.Return(_a0, _a1, _a2, _a3)
+	return _c
+}
+
+func (_c *MockRepository_RepairCounters_Call) RunAndReturn(run func(context.Context, Target, uuid.UUID, int) (uuid.UUID, int, int64, error)) *MockRepository_RepairCounters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unreact provides a mock function with given fields: ctx, postID, userID, target, reaction
+func (_m *MockRepository) Unreact(ctx context.Context, postID uuid.UUID, userID uuid.UUID, target Target, reaction string) error {
+	ret := _m.Called(ctx, postID, userID, target, reaction)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, Target, string) error); ok {
+		r0 = rf(ctx, postID, userID, target, reaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Unreact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unreact'
+type MockRepository_Unreact_Call struct {
+	*mock.Call
+}
+
+// Unreact is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID uuid.UUID
+//   - userID uuid.UUID
+//   - target Target
+//   - reaction string
+func (_e *MockRepository_Expecter) Unreact(ctx interface{}, postID interface{}, userID interface{}, target interface{}, reaction interface{}) *MockRepository_Unreact_Call {
+	return &MockRepository_Unreact_Call{Call: _e.mock.On("Unreact", ctx, postID, userID, target, reaction)}
+}
+
+func (_c *MockRepository_Unreact_Call) Run(run func(ctx context.Context, postID uuid.UUID, userID uuid.UUID, target Target, reaction string)) *MockRepository_Unreact_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(Target), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Unreact_Call) Return(_a0 error) *MockRepository_Unreact_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Unreact_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, Target, string) error) *MockRepository_Unreact_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Vote provides a mock function with given fields: ctx, postID, userID, target, vote, now
 func (_m *MockRepository) Vote(ctx context.Context, postID uuid.UUID, userID uuid.UUID, target Target, vote Vote, now time.Time) (Vote, error) {
 	ret := _m.Called(ctx, postID, userID, target, vote, now)
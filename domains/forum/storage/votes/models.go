@@ -35,7 +35,7 @@ const (
 // the net score of the target.
 // Only one vote per target can be cast by a user.
 type Model struct {
-	bun.BaseModel `bun:"table:votes"`
+	bun.BaseModel `bun:"table:votes,alias:votes"`
 
 	// UpdatedAt stores the time at which the vote was last updated.
 	UpdatedAt time.Time `json:"updated_at" bun:"updated_at,notnull"`
@@ -47,6 +47,44 @@ type Model struct {
 	Target Target `json:"target" bun:"target"`
 	// Vote is the value of the vote.
 	Vote Vote `json:"vote" bun:"vote"`
+	// RevisionID is the ID of the revision the vote was cast on, for targets that keep a revision history
+	// (TargetImproveSuggestion). It is updated every time the vote changes.
+	RevisionID *uuid.UUID `json:"revision_id" bun:"revision_id,type:uuid"`
+}
+
+// Event is the database model for the vote_events table. An event is logged every time a user casts, changes or
+// cancels a vote, and is never updated.
+type Event struct {
+	bun.BaseModel `bun:"table:vote_events"`
+
+	// CreatedAt stores the time at which the vote was cast.
+	CreatedAt time.Time `json:"created_at" bun:"created_at,notnull"`
+	// UserID is the ID of the user who cast the vote.
+	UserID uuid.UUID `json:"user_id" bun:"user_id,type:uuid"`
+	// PostID is the ID of the target of the vote.
+	PostID uuid.UUID `json:"post_id" bun:"post_id,type:uuid"`
+	// Target is the target of the vote.
+	Target Target `json:"target" bun:"target"`
+	// Vote is the new value of the vote. NoVote is stored as NULL, for a cancelled vote.
+	Vote Vote `json:"vote" bun:"vote,nullzero"`
+}
+
+// Reaction is the database model for the reactions table.
+// A reaction is a nuanced feedback left on a post, alongside the vote. Unlike votes, a user can leave several
+// reactions on the same post, but only once each.
+type Reaction struct {
+	bun.BaseModel `bun:"table:reactions"`
+
+	// PostID is the ID of the target of the reaction.
+	PostID uuid.UUID `json:"post_id" bun:"post_id,pk,type:uuid"`
+	// Target is the target of the reaction.
+	Target Target `json:"target" bun:"target,pk"`
+	// Reaction is the name of the reaction.
+	Reaction string `json:"reaction" bun:"reaction,pk"`
+	// UserID is the ID of the user who reacted.
+	UserID uuid.UUID `json:"user_id" bun:"user_id,pk,type:uuid"`
+	// CreatedAt stores the time at which the user reacted.
+	CreatedAt time.Time `json:"created_at" bun:"created_at,notnull"`
 }
 
 // VotedPost represents a post voted by a user for a specific target.
@@ -59,4 +97,20 @@ type VotedPost struct {
 	UpdatedAt time.Time `json:"updated_at" bun:"updated_at,notnull"`
 	// Vote is the value of the vote.
 	Vote Vote `json:"vote" bun:"vote"`
+	// RevisionID is the ID of the revision the vote was cast on, if any.
+	RevisionID *uuid.UUID `json:"revision_id" bun:"revision_id,type:uuid"`
+}
+
+// CounterDrift is a post which stored vote counters do not match the votes cast on it.
+type CounterDrift struct {
+	// PostID is the ID of the post.
+	PostID uuid.UUID `json:"post_id" bun:"id,type:uuid"`
+	// UpVotes is the stored amount of up votes. It is nil when the counter was never initialized.
+	UpVotes *int64 `json:"up_votes" bun:"up_votes"`
+	// DownVotes is the stored amount of down votes. It is nil when the counter was never initialized.
+	DownVotes *int64 `json:"down_votes" bun:"down_votes"`
+	// ExpectedUpVotes is the actual amount of up votes cast on the post.
+	ExpectedUpVotes int64 `json:"expected_up_votes" bun:"expected_up_votes"`
+	// ExpectedDownVotes is the actual amount of down votes cast on the post.
+	ExpectedDownVotes int64 `json:"expected_down_votes" bun:"expected_down_votes"`
 }
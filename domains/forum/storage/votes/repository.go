@@ -3,6 +3,8 @@ package votes_storage
 import (
 	"context"
 	"errors"
+	"fmt"
+	"github.com/a-novel/agora-backend/framework/pagination"
 	"github.com/a-novel/agora-backend/framework/validation"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
@@ -16,10 +18,37 @@ type Repository interface {
 	// HasVoted returns whether the user has voted for the targeted post. It returns VoteUp or VoteDown if the user
 	// has voted, NoVote otherwise.
 	HasVoted(ctx context.Context, postID, userID uuid.UUID, target Target) (Vote, error)
+	// React adds a reaction of the user on the targeted post. Reacting twice the same way has no effect.
+	React(ctx context.Context, postID, userID uuid.UUID, target Target, reaction string, now time.Time) error
+	// Unreact removes a reaction of the user from the targeted post, if any.
+	Unreact(ctx context.Context, postID, userID uuid.UUID, target Target, reaction string) error
+	// GetReactions returns the reactions the user left on the targeted post, in alphabetical order.
+	GetReactions(ctx context.Context, postID, userID uuid.UUID, target Target) ([]string, error)
+	// CountRecent returns the number of times the user cast, changed or cancelled a vote since the given time.
+	CountRecent(ctx context.Context, userID uuid.UUID, since time.Time) (int64, error)
 	// GetVotedPosts returns the IDs of the posts that the user has voted for, for a specific target. Results must be
-	// paginated using the limit and offset parameters.
+	// paginated using the limit and offset parameters. Deleted posts are left out, until they are restored.
 	// It also returns the total number of available results, to help with pagination.
 	GetVotedPosts(ctx context.Context, userID uuid.UUID, target Target, limit, offset int) ([]*VotedPost, int64, error)
+	// GetVotedPostsAfter returns the posts that the user has voted for, in the same order as GetVotedPosts, that
+	// come after the given cursor. An empty cursor starts from the most recent vote.
+	// It also returns the cursor of the next page, which is empty when there are no more results.
+	GetVotedPostsAfter(ctx context.Context, userID uuid.UUID, target Target, cursor string, limit int) ([]*VotedPost, string, error)
+	// CountOrphans returns the number of votes whose target post no longer exists.
+	CountOrphans(ctx context.Context) (int64, error)
+	// DeleteOrphans removes up to limit votes whose target post no longer exists, and returns the number of
+	// removed votes.
+	DeleteOrphans(ctx context.Context, limit int) (int64, error)
+	// DriftedCounters returns, at most, limit posts of the target which vote counters do not match the votes cast
+	// on them. It also returns the total number of such posts.
+	DriftedCounters(ctx context.Context, target Target, limit int) ([]*CounterDrift, int64, error)
+	// RepairCounters recomputes the vote counters of, at most, limit posts of the target, from the votes cast on
+	// them. Posts are processed in ID order, starting right after the given ID (use uuid.Nil to start from the
+	// beginning).
+	// It returns the ID of the last processed post, the number of processed posts, and the number of posts which
+	// counters were actually fixed. Once the number of processed posts is lower than limit, the whole table has been
+	// processed.
+	RepairCounters(ctx context.Context, target Target, after uuid.UUID, limit int) (uuid.UUID, int, int64, error)
 }
 
 // NewRepository returns a new Repository instance.
@@ -32,6 +61,43 @@ type repositoryImpl struct {
 	db bun.IDB
 }
 
+// Votes are removed along with their post by a trigger. This condition catches the votes left over from before the
+// trigger existed. Soft deleted posts keep their votes, so they can be restored.
+const orphanCondition = `(
+	votes.target = 'improve_request' AND NOT EXISTS (SELECT 1 FROM improve_requests WHERE improve_requests.id = votes.post_id)
+) OR (
+	votes.target = 'improve_suggestion' AND NOT EXISTS (SELECT 1 FROM improve_suggestions WHERE improve_suggestions.id = votes.post_id)
+)`
+
+// Counts the votes of a given value cast on the rows of a source table.
+func countVotesExpr(sourceTable string, target Target, vote Vote) string {
+	return fmt.Sprintf(
+		"(SELECT COUNT(*) FROM votes WHERE votes.post_id = %s.id AND votes.target = '%s' AND votes.vote = '%s')",
+		sourceTable, target, vote,
+	)
+}
+
+// Matches the rows of a source table which counters differ from their votes. NULL counters are always drifted.
+func counterDriftCondition(sourceTable string, target Target) string {
+	return fmt.Sprintf(
+		"up_votes IS DISTINCT FROM %s OR down_votes IS DISTINCT FROM %s",
+		countVotesExpr(sourceTable, target, VoteUp), countVotesExpr(sourceTable, target, VoteDown),
+	)
+}
+
+// Selects the IDs of the deleted posts of a source table, whose votes are kept until the posts are purged.
+func (repository *repositoryImpl) deletedPostsQuery(target Target) (*bun.SelectQuery, error) {
+	sourceTable, err := getSourceTable(target)
+	if err != nil {
+		return nil, err
+	}
+
+	return repository.db.NewSelect().
+		Column("id").
+		TableExpr(sourceTable).
+		Where("deleted_at IS NOT NULL"), nil
+}
+
 func getSourceTable(target Target) (string, error) {
 	switch target {
 	case TargetImproveRequest:
@@ -43,13 +109,37 @@ func getSourceTable(target Target) (string, error) {
 	}
 }
 
+// Log a vote change, so it counts towards CountRecent even once the vote is cancelled.
+func (repository *repositoryImpl) logVote(ctx context.Context, tx bun.Tx, postID, userID uuid.UUID, target Target, vote Vote, now time.Time) error {
+	event := &Event{
+		CreatedAt: now,
+		UserID:    userID,
+		PostID:    postID,
+		Target:    target,
+		Vote:      vote,
+	}
+
+	if _, err := tx.NewInsert().Model(event).Exec(ctx); err != nil {
+		return validation.HandlePGError(err)
+	}
+
+	return nil
+}
+
 func (repository *repositoryImpl) Vote(ctx context.Context, postID, userID uuid.UUID, target Target, vote Vote, now time.Time) (Vote, error) {
 	if vote == NoVote {
-		if _, err := repository.db.NewDelete().
-			Model(new(Model)).
-			Where("post_id = ? AND user_id = ? AND target = ?", postID, userID, target).
-			Exec(ctx); err != nil {
-			return NoVote, validation.HandlePGError(err)
+		err := repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.NewDelete().
+				Model(new(Model)).
+				Where("post_id = ? AND user_id = ? AND target = ?", postID, userID, target).
+				Exec(ctx); err != nil {
+				return validation.HandlePGError(err)
+			}
+
+			return repository.logVote(ctx, tx, postID, userID, target, NoVote, now)
+		})
+		if err != nil {
+			return NoVote, err
 		}
 
 		return NoVote, nil
@@ -68,7 +158,11 @@ func (repository *repositoryImpl) Vote(ctx context.Context, postID, userID uuid.
 		return NoVote, err
 	}
 
-	count, err := repository.db.NewSelect().Table(sourceTable).Where("id = ?", postID).Count(ctx)
+	count, err := repository.db.NewSelect().
+		Table(sourceTable).
+		Where("id = ?", postID).
+		Where("deleted_at IS NULL").
+		Count(ctx)
 	if err != nil {
 		return NoVote, validation.HandlePGError(err)
 	}
@@ -76,13 +170,32 @@ func (repository *repositoryImpl) Vote(ctx context.Context, postID, userID uuid.
 		return NoVote, validation.ErrMissingRelation
 	}
 
-	if _, err := repository.db.NewInsert().
-		Model(model).
-		On("conflict (post_id, user_id, target) do update").
-		Set("updated_at = ?updated_at").
-		Set("vote = ?vote").
-		Exec(ctx); err != nil {
-		return NoVote, validation.HandlePGError(err)
+	// Keep track of the content the user actually voted on.
+	if target == TargetImproveSuggestion {
+		if err := repository.db.NewSelect().
+			Table(sourceTable).
+			Column("revision_id").
+			Where("id = ?", postID).
+			Scan(ctx, &model.RevisionID); err != nil {
+			return NoVote, validation.HandlePGError(err)
+		}
+	}
+
+	err = repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().
+			Model(model).
+			On("conflict (post_id, user_id, target) do update").
+			Set("updated_at = ?updated_at").
+			Set("vote = ?vote").
+			Set("revision_id = ?revision_id").
+			Exec(ctx); err != nil {
+			return validation.HandlePGError(err)
+		}
+
+		return repository.logVote(ctx, tx, postID, userID, target, vote, now)
+	})
+	if err != nil {
+		return NoVote, err
 	}
 
 	return vote, nil
@@ -106,12 +219,80 @@ func (repository *repositoryImpl) HasVoted(ctx context.Context, postID, userID u
 	return model.Vote, nil
 }
 
+func (repository *repositoryImpl) React(ctx context.Context, postID, userID uuid.UUID, target Target, reaction string, now time.Time) error {
+	model := &Reaction{
+		PostID:    postID,
+		Target:    target,
+		Reaction:  reaction,
+		UserID:    userID,
+		CreatedAt: now,
+	}
+
+	if _, err := repository.db.NewInsert().
+		Model(model).
+		On("CONFLICT (post_id, target, reaction, user_id) DO NOTHING").
+		Exec(ctx); err != nil {
+		return validation.HandlePGError(err)
+	}
+
+	return nil
+}
+
+func (repository *repositoryImpl) Unreact(ctx context.Context, postID, userID uuid.UUID, target Target, reaction string) error {
+	if _, err := repository.db.NewDelete().
+		Model((*Reaction)(nil)).
+		Where("post_id = ?", postID).
+		Where("user_id = ?", userID).
+		Where("target = ?", target).
+		Where("reaction = ?", reaction).
+		Exec(ctx); err != nil {
+		return validation.HandlePGError(err)
+	}
+
+	return nil
+}
+
+func (repository *repositoryImpl) GetReactions(ctx context.Context, postID, userID uuid.UUID, target Target) ([]string, error) {
+	reactions := make([]string, 0)
+	if err := repository.db.NewSelect().
+		Model((*Reaction)(nil)).
+		Column("reaction").
+		Where("post_id = ?", postID).
+		Where("user_id = ?", userID).
+		Where("target = ?", target).
+		Order("reaction").
+		Scan(ctx, &reactions); err != nil {
+		return nil, validation.HandlePGError(err)
+	}
+
+	return reactions, nil
+}
+
+func (repository *repositoryImpl) CountRecent(ctx context.Context, userID uuid.UUID, since time.Time) (int64, error) {
+	count, err := repository.db.NewSelect().
+		Model((*Event)(nil)).
+		Where("user_id = ?", userID).
+		Where("created_at >= ?", since).
+		Count(ctx)
+	if err != nil {
+		return 0, validation.HandlePGError(err)
+	}
+
+	return int64(count), nil
+}
+
 func (repository *repositoryImpl) GetVotedPosts(ctx context.Context, userID uuid.UUID, target Target, limit, offset int) ([]*VotedPost, int64, error) {
+	deletedPosts, err := repository.deletedPostsQuery(target)
+	if err != nil {
+		return nil, 0, err
+	}
+
 	var models []*VotedPost
 	count, err := repository.db.NewSelect().
 		Model(&models).
-		Column("post_id", "updated_at", "vote").
+		Column("post_id", "updated_at", "vote", "revision_id").
 		Where("user_id = ? AND target = ?", userID, target).
+		Where("post_id NOT IN (?)", deletedPosts).
 		OrderExpr("updated_at DESC").
 		Limit(limit).
 		Offset(offset).
@@ -122,3 +303,163 @@ func (repository *repositoryImpl) GetVotedPosts(ctx context.Context, userID uuid
 
 	return models, int64(count), nil
 }
+
+func (repository *repositoryImpl) GetVotedPostsAfter(ctx context.Context, userID uuid.UUID, target Target, cursor string, limit int) ([]*VotedPost, string, error) {
+	type votedPostWithCursor struct {
+		VotedPost `bun:",extend"`
+		Cursor    string `bun:"cursor,scanonly"`
+	}
+
+	deletedPosts, err := repository.deletedPostsQuery(target)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var results []*votedPostWithCursor
+
+	query, err := pagination.Apply(
+		repository.db.NewSelect().
+			Model(&results).
+			Column("post_id", "updated_at", "vote", "revision_id").
+			Where("user_id = ? AND target = ?", userID, target).
+			Where("post_id NOT IN (?)", deletedPosts).
+			Limit(limit),
+		// A user votes at most once per post.
+		[]pagination.Key{
+			{Expr: "updated_at", Type: "timestamp"},
+			{Expr: "post_id", Type: "uuid"},
+		},
+		cursor,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, "", validation.HandlePGError(err)
+	}
+
+	models := make([]*VotedPost, len(results))
+	for i, result := range results {
+		models[i] = &result.VotedPost
+	}
+
+	next := pagination.Next(results, limit, func(row *votedPostWithCursor) string {
+		return row.Cursor
+	})
+
+	return models, next, nil
+}
+
+func (repository *repositoryImpl) CountOrphans(ctx context.Context) (int64, error) {
+	count, err := repository.db.NewSelect().
+		Model(new(Model)).
+		Where(orphanCondition).
+		Count(ctx)
+	if err != nil {
+		return 0, validation.HandlePGError(err)
+	}
+
+	return int64(count), nil
+}
+
+func (repository *repositoryImpl) DeleteOrphans(ctx context.Context, limit int) (int64, error) {
+	// Votes have no primary key, so the batch is selected by physical row location.
+	batch := repository.db.NewSelect().
+		Model(new(Model)).
+		ColumnExpr("ctid").
+		Where(orphanCondition).
+		Limit(limit)
+
+	res, err := repository.db.NewDelete().
+		Model(new(Model)).
+		Where("ctid IN (?)", batch).
+		Exec(ctx)
+	if err != nil {
+		return 0, validation.HandlePGError(err)
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return 0, validation.HandlePGError(err)
+	}
+
+	return count, nil
+}
+
+func (repository *repositoryImpl) DriftedCounters(ctx context.Context, target Target, limit int) ([]*CounterDrift, int64, error) {
+	sourceTable, err := getSourceTable(target)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	drifts := make([]*CounterDrift, 0)
+	count, err := repository.db.NewSelect().
+		Table(sourceTable).
+		Column("id", "up_votes", "down_votes").
+		ColumnExpr(countVotesExpr(sourceTable, target, VoteUp)+" AS expected_up_votes").
+		ColumnExpr(countVotesExpr(sourceTable, target, VoteDown)+" AS expected_down_votes").
+		Where(counterDriftCondition(sourceTable, target)).
+		Order("id").
+		Limit(limit).
+		ScanAndCount(ctx, &drifts)
+	if err != nil {
+		return nil, 0, validation.HandlePGError(err)
+	}
+
+	return drifts, int64(count), nil
+}
+
+func (repository *repositoryImpl) RepairCounters(ctx context.Context, target Target, after uuid.UUID, limit int) (uuid.UUID, int, int64, error) {
+	sourceTable, err := getSourceTable(target)
+	if err != nil {
+		return uuid.Nil, 0, 0, err
+	}
+
+	var (
+		ids      []uuid.UUID
+		repaired int64
+	)
+
+	err = repository.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Locking the batch makes concurrent votes wait for the repair, so their own counter update applies on top
+		// of the recomputed value instead of being overwritten by it.
+		if err := tx.NewSelect().
+			Table(sourceTable).
+			Column("id").
+			Where("id > ?", after).
+			Order("id").
+			Limit(limit).
+			For("UPDATE").
+			Scan(ctx, &ids); err != nil {
+			return err
+		}
+
+		if len(ids) == 0 {
+			return nil
+		}
+
+		res, err := tx.NewUpdate().
+			Table(sourceTable).
+			Set("up_votes = "+countVotesExpr(sourceTable, target, VoteUp)).
+			Set("down_votes = "+countVotesExpr(sourceTable, target, VoteDown)).
+			Where("id IN (?)", bun.In(ids)).
+			Where(counterDriftCondition(sourceTable, target)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		repaired, err = res.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return uuid.Nil, 0, 0, validation.HandlePGError(err)
+	}
+
+	if len(ids) == 0 {
+		return after, 0, 0, nil
+	}
+
+	return ids[len(ids)-1], len(ids), repaired, nil
+}
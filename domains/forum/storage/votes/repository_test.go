@@ -2,6 +2,7 @@ package votes_storage
 
 import (
 	"context"
+	"github.com/a-novel/agora-backend/framework"
 	"github.com/a-novel/agora-backend/framework/validation"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -29,13 +30,14 @@ var Fixtures = []interface{}{
 	},
 	// Suggestions.
 	&improve_suggestion_storage.Model{
-		ID:        test_utils.NumberUUID(1000),
-		CreatedAt: baseTime,
-		UpdatedAt: &updateTime,
-		SourceID:  test_utils.NumberUUID(1000),
-		UserID:    test_utils.NumberUUID(201),
-		UpVotes:   4, // 5
-		DownVotes: 1,
+		ID:         test_utils.NumberUUID(1000),
+		CreatedAt:  baseTime,
+		UpdatedAt:  &updateTime,
+		SourceID:   test_utils.NumberUUID(1000),
+		UserID:     test_utils.NumberUUID(201),
+		RevisionID: test_utils.NumberUUID(1100),
+		UpVotes:    4, // 5
+		DownVotes:  1,
 		Core: improve_suggestion_storage.Core{
 			RequestID: test_utils.NumberUUID(1000),
 			Title:     "Ipsum Lorem",
@@ -57,6 +59,43 @@ var Fixtures = []interface{}{
 		Target:    TargetImproveRequest,
 		Vote:      VoteDown,
 	},
+	// Vote events.
+	&Event{
+		CreatedAt: baseTime,
+		UserID:    test_utils.NumberUUID(210),
+		PostID:    test_utils.NumberUUID(1000),
+		Target:    TargetImproveSuggestion,
+		Vote:      VoteUp,
+	},
+	&Event{
+		CreatedAt: baseTime,
+		UserID:    test_utils.NumberUUID(211),
+		PostID:    test_utils.NumberUUID(1000),
+		Target:    TargetImproveRequest,
+		Vote:      VoteDown,
+	},
+	// Reactions.
+	&Reaction{
+		PostID:    test_utils.NumberUUID(1000),
+		Target:    TargetImproveSuggestion,
+		Reaction:  "moving",
+		UserID:    test_utils.NumberUUID(210),
+		CreatedAt: baseTime,
+	},
+	&Reaction{
+		PostID:    test_utils.NumberUUID(1000),
+		Target:    TargetImproveSuggestion,
+		Reaction:  "great-dialogue",
+		UserID:    test_utils.NumberUUID(210),
+		CreatedAt: baseTime,
+	},
+	&Reaction{
+		PostID:    test_utils.NumberUUID(1000),
+		Target:    TargetImproveRequest,
+		Reaction:  "confusing",
+		UserID:    test_utils.NumberUUID(210),
+		CreatedAt: baseTime,
+	},
 }
 
 // Generates votes for a given post.
@@ -124,7 +163,8 @@ func TestVotesRepository_Vote(t *testing.T) {
 		expect    Vote
 		expectErr error
 
-		expectScores []checkTarget
+		expectScores     []checkTarget
+		expectRevisionID *uuid.UUID
 	}{
 		{
 			name:   "Success/UpVote",
@@ -194,6 +234,7 @@ func TestVotesRepository_Vote(t *testing.T) {
 					downVotes: 1,
 				},
 			},
+			expectRevisionID: framework.ToPTR(test_utils.NumberUUID(1100)),
 		},
 		{
 			name:   "Success/DownToUpVote",
@@ -240,6 +281,7 @@ func TestVotesRepository_Vote(t *testing.T) {
 					downVotes: 2,
 				},
 			},
+			expectRevisionID: framework.ToPTR(test_utils.NumberUUID(1100)),
 		},
 		{
 			name:   "Success/RemoveDownVote",
@@ -376,6 +418,15 @@ func TestVotesRepository_Vote(t *testing.T) {
 						require.Equal(st, check.downVotes, post.(*improve_suggestion_storage.Model).DownVotes)
 					}
 				}
+
+				if d.expectRevisionID != nil {
+					vote := new(Model)
+					require.NoError(st, stx.NewSelect().
+						Model(vote).
+						Where("post_id = ? AND user_id = ? AND target = ?", d.postID, d.userID, d.target).
+						Scan(ctx))
+					require.Equal(st, d.expectRevisionID, vote.RevisionID)
+				}
 			})
 		}
 	})
@@ -434,6 +485,239 @@ func TestVotesRepository_HasVoted(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestVotesRepository_React(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		postID   uuid.UUID
+		userID   uuid.UUID
+		target   Target
+		reaction string
+		active   bool
+
+		expect    []string
+		expectErr error
+	}{
+		{
+			name:     "Success/Add",
+			postID:   test_utils.NumberUUID(1000),
+			userID:   test_utils.NumberUUID(210),
+			target:   TargetImproveSuggestion,
+			reaction: "confusing",
+			active:   true,
+			expect:   []string{"confusing", "great-dialogue", "moving"},
+		},
+		{
+			name:     "Success/AddTwice",
+			postID:   test_utils.NumberUUID(1000),
+			userID:   test_utils.NumberUUID(210),
+			target:   TargetImproveSuggestion,
+			reaction: "moving",
+			active:   true,
+			expect:   []string{"great-dialogue", "moving"},
+		},
+		{
+			name:     "Success/Remove",
+			postID:   test_utils.NumberUUID(1000),
+			userID:   test_utils.NumberUUID(210),
+			target:   TargetImproveSuggestion,
+			reaction: "moving",
+			expect:   []string{"great-dialogue"},
+		},
+		{
+			name:     "Success/RemoveMissing",
+			postID:   test_utils.NumberUUID(1000),
+			userID:   test_utils.NumberUUID(210),
+			target:   TargetImproveSuggestion,
+			reaction: "confusing",
+			expect:   []string{"great-dialogue", "moving"},
+		},
+		{
+			name:     "Success/OtherTarget",
+			postID:   test_utils.NumberUUID(1000),
+			userID:   test_utils.NumberUUID(210),
+			target:   TargetImproveRequest,
+			reaction: "moving",
+			active:   true,
+			expect:   []string{"confusing", "moving"},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.BeginTx(ctx, nil)
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+
+				if d.active {
+					err = repository.React(ctx, d.postID, d.userID, d.target, d.reaction, updateTime)
+				} else {
+					err = repository.Unreact(ctx, d.postID, d.userID, d.target, d.reaction)
+				}
+				test_utils.RequireError(st, d.expectErr, err)
+
+				res, err := repository.GetReactions(ctx, d.postID, d.userID, d.target)
+				require.NoError(st, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestVotesRepository_GetReactions(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		postID uuid.UUID
+		userID uuid.UUID
+		target Target
+
+		expect    []string
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			postID: test_utils.NumberUUID(1000),
+			userID: test_utils.NumberUUID(210),
+			target: TargetImproveSuggestion,
+			expect: []string{"great-dialogue", "moving"},
+		},
+		{
+			name:   "Success/NoReactions",
+			postID: test_utils.NumberUUID(1000),
+			userID: test_utils.NumberUUID(211),
+			target: TargetImproveSuggestion,
+			expect: []string{},
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.GetReactions(ctx, d.postID, d.userID, d.target)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestVotesRepository_CountRecent(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+		since  time.Time
+
+		expect    int64
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			userID: test_utils.NumberUUID(210),
+			since:  baseTime,
+			expect: 1,
+		},
+		{
+			name:   "Success/OlderVotesAreIgnored",
+			userID: test_utils.NumberUUID(210),
+			since:  baseTime.Add(time.Second),
+			expect: 0,
+		},
+		{
+			name:   "Success/NoVotes",
+			userID: test_utils.NumberUUID(212),
+			since:  baseTime,
+			expect: 0,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.CountRecent(ctx, d.userID, d.since)
+				test_utils.RequireError(t, d.expectErr, err)
+				require.Equal(t, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestVotesRepository_CountRecent_VoteChanges(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	// A limit of 3 votes is reached by voting, cancelling and voting again on the same post.
+	const limit = 3
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+		userID := test_utils.NumberUUID(212)
+		now := baseTime.Add(time.Hour)
+
+		t.Run("Success/VoteCancelVote", func(st *testing.T) {
+			stx, err := tx.Begin()
+			require.NoError(st, err)
+			defer stx.Rollback()
+
+			repository := NewRepository(stx)
+			for i, vote := range []Vote{VoteUp, NoVote, VoteUp} {
+				_, err := repository.Vote(ctx, test_utils.NumberUUID(1000), userID, TargetImproveRequest, vote, now.Add(time.Duration(i)*time.Second))
+				require.NoError(st, err)
+			}
+
+			count, err := repository.CountRecent(ctx, userID, now)
+			require.NoError(st, err)
+			require.Equal(st, int64(3), count)
+			require.GreaterOrEqual(st, count, int64(limit))
+		})
+
+		t.Run("Success/FlipVote", func(st *testing.T) {
+			stx, err := tx.Begin()
+			require.NoError(st, err)
+			defer stx.Rollback()
+
+			repository := NewRepository(stx)
+			for i, vote := range []Vote{VoteUp, VoteDown, VoteUp} {
+				_, err := repository.Vote(ctx, test_utils.NumberUUID(1000), userID, TargetImproveRequest, vote, now.Add(time.Duration(i)*time.Second))
+				require.NoError(st, err)
+			}
+
+			count, err := repository.CountRecent(ctx, userID, now)
+			require.NoError(st, err)
+			require.Equal(st, int64(3), count)
+		})
+
+		count, err := repository.CountRecent(ctx, userID, now)
+		require.NoError(t, err)
+		require.Zero(t, count)
+	})
+	require.NoError(t, err)
+}
+
 func TestVotesRepository_GetVotedPosts(t *testing.T) {
 	db, sqlDB := test_utils.GetPostgres(t)
 	defer db.Close()
@@ -590,3 +874,460 @@ func TestVotesRepository_GetVotedPosts(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestVotesRepository_GetVotedPostsAfter(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := test_utils.Concat(
+		improve_suggestion_storage.Fixtures,
+		// T+2m
+		generateVotesFor(
+			improve_suggestion_storage.Fixtures[5],
+			map[int]time.Time{200: baseTime.Add(10 * time.Minute), 201: baseTime.Add(2 * time.Minute), 210: baseTime},
+			map[int]time.Time{203: baseTime.Add(8 * time.Minute)},
+		),
+		// T
+		generateVotesFor(
+			improve_suggestion_storage.Fixtures[6],
+			map[int]time.Time{200: baseTime.Add(7 * time.Minute), 207: baseTime.Add(30 * time.Minute)},
+			map[int]time.Time{203: baseTime.Add(time.Minute), 201: baseTime},
+		),
+		// T+13m
+		generateVotesFor(
+			improve_suggestion_storage.Fixtures[9],
+			map[int]time.Time{201: baseTime.Add(13 * time.Minute), 210: baseTime.Add(10 * time.Minute)},
+			map[int]time.Time{},
+		),
+	)
+
+	err := test_utils.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		t.Run("Success/Pagination", func(st *testing.T) {
+			res, next, err := repository.GetVotedPostsAfter(ctx, test_utils.NumberUUID(201), TargetImproveSuggestion, "", 2)
+			require.NoError(st, err)
+			require.Equal(st, []*VotedPost{
+				{
+					PostID:    test_utils.NumberUUID(1004),
+					UpdatedAt: baseTime.Add(13 * time.Minute),
+					Vote:      VoteUp,
+				},
+				{
+					PostID:    test_utils.NumberUUID(1000),
+					UpdatedAt: baseTime.Add(2 * time.Minute),
+					Vote:      VoteUp,
+				},
+			}, res)
+			require.NotEmpty(st, next)
+
+			res, next, err = repository.GetVotedPostsAfter(ctx, test_utils.NumberUUID(201), TargetImproveSuggestion, next, 2)
+			require.NoError(st, err)
+			require.Equal(st, []*VotedPost{
+				{
+					PostID:    test_utils.NumberUUID(1001),
+					UpdatedAt: baseTime,
+					Vote:      VoteDown,
+				},
+			}, res)
+			require.Empty(st, next)
+		})
+
+		t.Run("Error/InvalidCursor", func(st *testing.T) {
+			_, _, err := repository.GetVotedPostsAfter(ctx, test_utils.NumberUUID(201), TargetImproveSuggestion, "foo", 2)
+			require.ErrorIs(st, err, validation.ErrInvalidEntity)
+		})
+	})
+	require.NoError(t, err)
+}
+
+func TestVotesRepository_GetVotedPosts_DeletedPosts(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := test_utils.Concat(
+		improve_suggestion_storage.Fixtures,
+		generateVotesFor(
+			improve_suggestion_storage.Fixtures[4],
+			map[int]time.Time{201: baseTime.Add(13 * time.Minute)},
+			map[int]time.Time{},
+		),
+		generateVotesFor(
+			improve_suggestion_storage.Fixtures[5],
+			map[int]time.Time{201: baseTime.Add(2 * time.Minute)},
+			map[int]time.Time{},
+		),
+		generateVotesFor(
+			improve_suggestion_storage.Fixtures[6],
+			map[int]time.Time{},
+			map[int]time.Time{201: baseTime},
+		),
+	)
+
+	err := test_utils.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		deleteTime := baseTime.Add(time.Hour)
+
+		_, err := tx.NewUpdate().
+			Model(new(improve_suggestion_storage.Model)).
+			Set("deleted_at = ?", deleteTime).
+			Where("id = ?", test_utils.NumberUUID(1000)).
+			Exec(ctx)
+		require.NoError(t, err)
+
+		_, err = tx.NewUpdate().
+			Model(new(improve_request_storage.Model)).
+			Set("deleted_at = ?", deleteTime).
+			Where("id = ?", test_utils.NumberUUID(6000)).
+			Exec(ctx)
+		require.NoError(t, err)
+
+		repository := NewRepository(tx)
+
+		t.Run("GetVotedPosts", func(st *testing.T) {
+			res, count, err := repository.GetVotedPosts(ctx, test_utils.NumberUUID(201), TargetImproveSuggestion, 10, 0)
+			require.NoError(st, err)
+			require.Equal(st, []*VotedPost{
+				{
+					PostID:    test_utils.NumberUUID(1001),
+					UpdatedAt: baseTime,
+					Vote:      VoteDown,
+				},
+			}, res)
+			require.Equal(st, int64(1), count)
+
+			res, count, err = repository.GetVotedPosts(ctx, test_utils.NumberUUID(201), TargetImproveRequest, 10, 0)
+			require.NoError(st, err)
+			require.Empty(st, res)
+			require.Equal(st, int64(0), count)
+		})
+
+		t.Run("GetVotedPostsAfter", func(st *testing.T) {
+			res, next, err := repository.GetVotedPostsAfter(ctx, test_utils.NumberUUID(201), TargetImproveSuggestion, "", 10)
+			require.NoError(st, err)
+			require.Equal(st, []*VotedPost{
+				{
+					PostID:    test_utils.NumberUUID(1001),
+					UpdatedAt: baseTime,
+					Vote:      VoteDown,
+				},
+			}, res)
+			require.Empty(st, next)
+
+			res, next, err = repository.GetVotedPostsAfter(ctx, test_utils.NumberUUID(201), TargetImproveRequest, "", 10)
+			require.NoError(st, err)
+			require.Empty(st, res)
+			require.Empty(st, next)
+		})
+	})
+	require.NoError(t, err)
+}
+
+// Votes on posts that were removed before the cleanup trigger existed.
+var OrphanFixtures = test_utils.Concat(Fixtures, []interface{}{
+	&Model{
+		UpdatedAt: baseTime,
+		PostID:    test_utils.NumberUUID(2000),
+		UserID:    test_utils.NumberUUID(210),
+		Target:    TargetImproveRequest,
+		Vote:      VoteUp,
+	},
+	&Model{
+		UpdatedAt: baseTime,
+		PostID:    test_utils.NumberUUID(2001),
+		UserID:    test_utils.NumberUUID(210),
+		Target:    TargetImproveSuggestion,
+		Vote:      VoteDown,
+	},
+	&Model{
+		UpdatedAt: baseTime,
+		PostID:    test_utils.NumberUUID(2001),
+		UserID:    test_utils.NumberUUID(211),
+		Target:    TargetImproveSuggestion,
+		Vote:      VoteUp,
+	},
+})
+
+func TestVotesRepository_CountOrphans(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	err := test_utils.RunTransactionalTest(db, OrphanFixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		count, err := repository.CountOrphans(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), count)
+	})
+	require.NoError(t, err)
+}
+
+func TestVotesRepository_DeleteOrphans(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		limit int
+
+		expect          int64
+		expectRemaining int64
+	}{
+		{
+			name:            "Success",
+			limit:           10,
+			expect:          3,
+			expectRemaining: 0,
+		},
+		{
+			name:            "Success/Batch",
+			limit:           2,
+			expect:          2,
+			expectRemaining: 1,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, OrphanFixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				count, err := repository.DeleteOrphans(ctx, d.limit)
+				require.NoError(st, err)
+				require.Equal(st, d.expect, count)
+
+				remaining, err := repository.CountOrphans(ctx)
+				require.NoError(st, err)
+				require.Equal(st, d.expectRemaining, remaining)
+
+				// Votes on existing posts are kept.
+				vote, err := repository.HasVoted(ctx, test_utils.NumberUUID(1000), test_utils.NumberUUID(211), TargetImproveRequest)
+				require.NoError(st, err)
+				require.Equal(st, VoteDown, vote)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestVotesRepository_DeletePostCascade(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		_, err := tx.NewDelete().
+			Model(new(improve_suggestion_storage.Model)).
+			Where("id = ?", test_utils.NumberUUID(1000)).
+			Exec(ctx)
+		require.NoError(t, err)
+
+		repository := NewRepository(tx)
+
+		vote, err := repository.HasVoted(ctx, test_utils.NumberUUID(1000), test_utils.NumberUUID(210), TargetImproveSuggestion)
+		require.NoError(t, err)
+		require.Equal(t, NoVote, vote)
+
+		// The request sharing the same ID keeps its votes.
+		vote, err = repository.HasVoted(ctx, test_utils.NumberUUID(1000), test_utils.NumberUUID(211), TargetImproveRequest)
+		require.NoError(t, err)
+		require.Equal(t, VoteDown, vote)
+
+		reactions, err := repository.GetReactions(ctx, test_utils.NumberUUID(1000), test_utils.NumberUUID(210), TargetImproveSuggestion)
+		require.NoError(t, err)
+		require.Empty(t, reactions)
+
+		reactions, err = repository.GetReactions(ctx, test_utils.NumberUUID(1000), test_utils.NumberUUID(210), TargetImproveRequest)
+		require.NoError(t, err)
+		require.Equal(t, []string{"confusing"}, reactions)
+	})
+	require.NoError(t, err)
+}
+
+// The counters of the first request and suggestion do not match their votes. The second request is consistent,
+// since its counters are updated by the votes trigger.
+var CounterFixtures = test_utils.Concat(
+	Fixtures,
+	[]interface{}{
+		&improve_request_storage.Model{
+			ID:        test_utils.NumberUUID(1001),
+			CreatedAt: baseTime,
+			Source:    test_utils.NumberUUID(1001),
+			UserID:    test_utils.NumberUUID(200),
+			Title:     "Test",
+			Content:   "Dummy content.",
+		},
+	},
+	generateVotesFor(
+		&improve_request_storage.Model{ID: test_utils.NumberUUID(1001)},
+		map[int]time.Time{210: baseTime, 211: baseTime},
+		map[int]time.Time{212: baseTime},
+	),
+)
+
+func TestVotesRepository_DriftedCounters(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		target Target
+		limit  int
+
+		expect      []*CounterDrift
+		expectCount int64
+		expectErr   error
+	}{
+		{
+			name:   "Success/ImproveRequest",
+			target: TargetImproveRequest,
+			limit:  10,
+			expect: []*CounterDrift{
+				{
+					PostID:            test_utils.NumberUUID(1000),
+					UpVotes:           framework.ToPTR(int64(10)),
+					DownVotes:         framework.ToPTR(int64(4)),
+					ExpectedUpVotes:   0,
+					ExpectedDownVotes: 1,
+				},
+			},
+			expectCount: 1,
+		},
+		{
+			name:   "Success/ImproveSuggestion",
+			target: TargetImproveSuggestion,
+			limit:  10,
+			expect: []*CounterDrift{
+				{
+					PostID:            test_utils.NumberUUID(1000),
+					UpVotes:           framework.ToPTR(int64(5)),
+					DownVotes:         framework.ToPTR(int64(1)),
+					ExpectedUpVotes:   1,
+					ExpectedDownVotes: 0,
+				},
+			},
+			expectCount: 1,
+		},
+		{
+			name:      "Error/InvalidTarget",
+			target:    "foo",
+			limit:     10,
+			expectErr: validation.ErrInvalidEntity,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, CounterFixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, count, err := repository.DriftedCounters(ctx, d.target, d.limit)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expect, res)
+				require.Equal(st, d.expectCount, count)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+func TestVotesRepository_RepairCounters(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		target Target
+		after  uuid.UUID
+		limit  int
+
+		expectLast      uuid.UUID
+		expectCount     int
+		expectRepaired  int64
+		expectRemaining int64
+		expectErr       error
+	}{
+		{
+			name:           "Success",
+			target:         TargetImproveRequest,
+			limit:          10,
+			expectLast:     test_utils.NumberUUID(1001),
+			expectCount:    2,
+			expectRepaired: 1,
+		},
+		{
+			name:           "Success/Batch",
+			target:         TargetImproveRequest,
+			limit:          1,
+			expectLast:     test_utils.NumberUUID(1000),
+			expectCount:    1,
+			expectRepaired: 1,
+		},
+		{
+			name:            "Success/After",
+			target:          TargetImproveRequest,
+			after:           test_utils.NumberUUID(1000),
+			limit:           10,
+			expectLast:      test_utils.NumberUUID(1001),
+			expectCount:     1,
+			expectRemaining: 1,
+		},
+		{
+			name:           "Success/ImproveSuggestion",
+			target:         TargetImproveSuggestion,
+			limit:          10,
+			expectLast:     test_utils.NumberUUID(1000),
+			expectCount:    1,
+			expectRepaired: 1,
+		},
+		{
+			name:            "Success/NoMoreResults",
+			target:          TargetImproveRequest,
+			after:           test_utils.NumberUUID(1001),
+			limit:           10,
+			expectLast:      test_utils.NumberUUID(1001),
+			expectRemaining: 1,
+		},
+		{
+			name:      "Error/InvalidTarget",
+			target:    "foo",
+			limit:     10,
+			expectErr: validation.ErrInvalidEntity,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, CounterFixtures, func(ctx context.Context, tx bun.Tx) {
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				stx, err := tx.Begin()
+				require.NoError(st, err)
+				defer stx.Rollback()
+
+				repository := NewRepository(stx)
+				last, count, repaired, err := repository.RepairCounters(ctx, d.target, d.after, d.limit)
+				test_utils.RequireError(st, d.expectErr, err)
+				require.Equal(st, d.expectLast, last)
+				require.Equal(st, d.expectCount, count)
+				require.Equal(st, d.expectRepaired, repaired)
+
+				if d.expectErr == nil {
+					_, remaining, err := repository.DriftedCounters(ctx, d.target, 10)
+					require.NoError(st, err)
+					require.Equal(st, d.expectRemaining, remaining)
+				}
+			})
+		}
+	})
+	require.NoError(t, err)
+}
@@ -480,6 +480,7 @@ func (service *serviceImpl) StorageToModel(source *credentials_storage.Model) *m
 		Email:     source.Email.String(),
 		NewEmail:  source.NewEmail.String(),
 		Validated: source.Email.Validation == "",
+		Moderator: source.Moderator,
 	}
 }
 
@@ -0,0 +1,29 @@
+package reputation_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+)
+
+type Service interface {
+	// Read returns the reputation of a user.
+	Read(ctx context.Context, userID uuid.UUID) (int64, error)
+}
+
+type serviceImpl struct {
+	repository reputation_storage.Repository
+}
+
+func NewService(repository reputation_storage.Repository) Service {
+	return &serviceImpl{repository: repository}
+}
+
+func (service *serviceImpl) Read(ctx context.Context, userID uuid.UUID) (int64, error) {
+	reputation, err := service.repository.Read(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read reputation of user %q: %w", userID, err)
+	}
+
+	return reputation, nil
+}
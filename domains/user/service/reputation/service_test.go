@@ -0,0 +1,55 @@
+package reputation_service
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var fooErr = errors.New("it broken")
+
+func TestReputationService_Read(t *testing.T) {
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+
+		readData int64
+		readErr  error
+
+		expect    int64
+		expectErr error
+	}{
+		{
+			name:     "Success",
+			userID:   test_utils.NumberUUID(1000),
+			readData: 42,
+			expect:   42,
+		},
+		{
+			name:      "Error/RepositoryFailure",
+			userID:    test_utils.NumberUUID(1000),
+			readErr:   fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			repository := reputation_storage.NewMockRepository(t)
+
+			repository.
+				On("Read", context.TODO(), d.userID).
+				Return(d.readData, d.readErr)
+
+			service := NewService(repository)
+			res, err := service.Read(context.TODO(), d.userID)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			repository.AssertExpectations(t)
+		})
+	}
+}
@@ -5,12 +5,12 @@ package user_service
 import (
 	context "context"
 
+	"github.com/a-novel/agora-backend/models"
 	mock "github.com/stretchr/testify/mock"
-	models "github.com/a-novel/agora-backend/models"
 
 	time "time"
 
-	user_storage "github.com/a-novel/agora-backend/domains/user/storage/user"
+	"github.com/a-novel/agora-backend/domains/user/storage/user"
 
 	uuid "github.com/google/uuid"
 )
@@ -488,6 +488,70 @@ func (_c *MockService_Search_Call) RunAndReturn(run func(context.Context, string
 	return _c
 }
 
+// SearchAfter provides a mock function with given fields: ctx, query, cursor, limit
+func (_m *MockService) SearchAfter(ctx context.Context, query string, cursor string, limit int) ([]*models.UserPublicPreview, string, error) {
+	ret := _m.Called(ctx, query, cursor, limit)
+
+	var r0 []*models.UserPublicPreview
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) ([]*models.UserPublicPreview, string, error)); ok {
+		return rf(ctx, query, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) []*models.UserPublicPreview); ok {
+		r0 = rf(ctx, query, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.UserPublicPreview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) string); ok {
+		r1 = rf(ctx, query, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, int) error); ok {
+		r2 = rf(ctx, query, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockService_SearchAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAfter'
+type MockService_SearchAfter_Call struct {
+	*mock.Call
+}
+
+// SearchAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - cursor string
+//   - limit int
+func (_e *MockService_Expecter) SearchAfter(ctx interface{}, query interface{}, cursor interface{}, limit interface{}) *MockService_SearchAfter_Call {
+	return &MockService_SearchAfter_Call{Call: _e.mock.On("SearchAfter", ctx, query, cursor, limit)}
+}
+
+func (_c *MockService_SearchAfter_Call) Run(run func(ctx context.Context, query string, cursor string, limit int)) *MockService_SearchAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockService_SearchAfter_Call) Return(_a0 []*models.UserPublicPreview, _a1 string, _a2 error) *MockService_SearchAfter_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockService_SearchAfter_Call) RunAndReturn(run func(context.Context, string, string, int) ([]*models.UserPublicPreview, string, error)) *MockService_SearchAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StorageToModel provides a mock function with given fields: source
 func (_m *MockService) StorageToModel(source *user_storage.Model) *models.User {
 	ret := _m.Called(source)
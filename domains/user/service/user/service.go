@@ -20,6 +20,7 @@ type Service interface {
 	Delete(ctx context.Context, id uuid.UUID, now time.Time) (*models.User, error)
 
 	Search(ctx context.Context, query string, limit, offset int) ([]*models.UserPublicPreview, int64, error)
+	SearchAfter(ctx context.Context, query, cursor string, limit int) ([]*models.UserPublicPreview, string, error)
 	GetPreview(ctx context.Context, id uuid.UUID) (*models.UserPreview, error)
 	GetPublic(ctx context.Context, slug string) (*models.UserPublic, error)
 	GetPublicPreviews(ctx context.Context, ids []uuid.UUID) ([]*models.UserPublicPreview, error)
@@ -52,10 +53,11 @@ func NewService(
 
 func (service *serviceImpl) publicPreviewStorageToModel(storageModel *user_storage.PublicPreview) *models.UserPublicPreview {
 	output := &models.UserPublicPreview{
-		ID:        storageModel.ID,
-		CreatedAt: storageModel.CreatedAt,
-		Username:  storageModel.Username,
-		Slug:      storageModel.Slug,
+		ID:         storageModel.ID,
+		CreatedAt:  storageModel.CreatedAt,
+		Username:   storageModel.Username,
+		Slug:       storageModel.Slug,
+		Reputation: storageModel.Reputation,
 	}
 
 	// Don't return real name when username is set.
@@ -152,6 +154,20 @@ func (service *serviceImpl) Search(ctx context.Context, query string, limit, off
 	return results, count, nil
 }
 
+func (service *serviceImpl) SearchAfter(ctx context.Context, query, cursor string, limit int) ([]*models.UserPublicPreview, string, error) {
+	storageModels, next, err := service.repository.SearchAfter(ctx, query, cursor, limit)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to search users: %w", err)
+	}
+
+	results := make([]*models.UserPublicPreview, len(storageModels))
+	for i, storageModel := range storageModels {
+		results[i] = service.publicPreviewStorageToModel(storageModel)
+	}
+
+	return results, next, nil
+}
+
 func (service *serviceImpl) GetPreview(ctx context.Context, id uuid.UUID) (*models.UserPreview, error) {
 	storageModel, err := service.repository.GetPreview(ctx, id)
 	if err != nil {
@@ -200,6 +216,7 @@ func (service *serviceImpl) GetAuthorizations(ctx context.Context, id uuid.UUID)
 
 	authorizations := map[string]bool{
 		models.UserAuthorizationsAccountValidated: storageModel.Validated,
+		models.UserAuthorizationsModerator:        storageModel.Moderator,
 	}
 
 	var output []string
@@ -459,10 +459,11 @@ func TestUserService_Search(t *testing.T) {
 			offset: 5,
 			repositoryData: []*user_storage.PublicPreview{
 				{
-					Slug:      "spaceorigin",
-					FirstName: "Elon",
-					LastName:  "Bezos",
-					CreatedAt: baseTime.Add(time.Hour),
+					Slug:       "spaceorigin",
+					FirstName:  "Elon",
+					LastName:   "Bezos",
+					CreatedAt:  baseTime.Add(time.Hour),
+					Reputation: 42,
 				},
 				{
 					Username:  "BigBrother",
@@ -481,10 +482,11 @@ func TestUserService_Search(t *testing.T) {
 			repositoryCount: 200,
 			expect: []*models.UserPublicPreview{
 				{
-					Slug:      "spaceorigin",
-					FirstName: "Elon",
-					LastName:  "Bezos",
-					CreatedAt: baseTime.Add(time.Hour),
+					Slug:       "spaceorigin",
+					FirstName:  "Elon",
+					LastName:   "Bezos",
+					CreatedAt:  baseTime.Add(time.Hour),
+					Reputation: 42,
 				},
 				{
 					Slug:      "blue-x",
@@ -530,6 +532,88 @@ func TestUserService_Search(t *testing.T) {
 	}
 }
 
+func TestUserService_SearchAfter(t *testing.T) {
+	data := []struct {
+		name string
+
+		query  string
+		cursor string
+		limit  int
+
+		repositoryErr  error
+		repositoryData []*user_storage.PublicPreview
+		repositoryNext string
+
+		expect      []*models.UserPublicPreview
+		expectNext  string
+		expectError error
+	}{
+		{
+			name:   "Success",
+			query:  "foo",
+			cursor: "cursor-1",
+			limit:  10,
+			repositoryData: []*user_storage.PublicPreview{
+				{
+					Slug:      "spaceorigin",
+					FirstName: "Elon",
+					LastName:  "Bezos",
+					CreatedAt: baseTime.Add(time.Hour),
+				},
+				{
+					Username:  "BigBrother",
+					Slug:      "blue-x",
+					FirstName: "Elon",
+					LastName:  "Bezos",
+					CreatedAt: baseTime,
+				},
+			},
+			repositoryNext: "cursor-2",
+			expect: []*models.UserPublicPreview{
+				{
+					Slug:      "spaceorigin",
+					FirstName: "Elon",
+					LastName:  "Bezos",
+					CreatedAt: baseTime.Add(time.Hour),
+				},
+				{
+					Slug:      "blue-x",
+					Username:  "BigBrother",
+					CreatedAt: baseTime,
+				},
+			},
+			expectNext: "cursor-2",
+		},
+		{
+			name:          "Error/RepositoryFailure",
+			query:         "foo",
+			cursor:        "cursor-1",
+			limit:         10,
+			repositoryErr: fooErr,
+			expectError:   fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(st *testing.T) {
+			repository := user_storage.NewMockRepository(t)
+
+			service := NewService(repository, nil, nil, nil)
+
+			repository.
+				On("SearchAfter", mock.Anything, d.query, d.cursor, d.limit).
+				Return(d.repositoryData, d.repositoryNext, d.repositoryErr)
+
+			results, next, err := service.SearchAfter(context.TODO(), d.query, d.cursor, d.limit)
+			test_utils.RequireError(st, d.expectError, err)
+			require.Equal(st, d.expect, results)
+			require.Equal(st, d.expectNext, next)
+
+			require.True(st, repository.AssertExpectations(st))
+		})
+	}
+}
+
 func TestUserService_GetPreview(t *testing.T) {
 	data := []struct {
 		name string
@@ -706,6 +790,14 @@ func TestUserService_GetAuthorizations(t *testing.T) {
 			},
 			expect: []string{"account-validated"},
 		},
+		{
+			name: "Success/Moderator",
+			id:   test_utils.NumberUUID(1),
+			getData: &models.UserCredentials{
+				Moderator: true,
+			},
+			expect: []string{"moderator"},
+		},
 		{
 			name:      "Error/CredentialsServiceFailure",
 			id:        test_utils.NumberUUID(1),
@@ -14,6 +14,10 @@ type Model struct {
 	CreatedAt time.Time  `json:"created_at,omitempty" bun:"created_at,notnull"`
 	UpdatedAt *time.Time `json:"updated_at,omitempty" bun:"updated_at"`
 
+	// Moderator grants the user access to moderation tools. It is not editable through the regular account
+	// workflows, and must be set manually.
+	Moderator bool `json:"moderator" bun:"moderator"`
+
 	Core
 }
 
@@ -0,0 +1,4 @@
+// Package reputation_storage reads the reputation ledger of users.
+// The ledger is filled by the database itself, every time a post of a user is voted on, one of its suggestions is
+// accepted, or a report against one of its contents is upheld. This layer never writes to it.
+package reputation_storage
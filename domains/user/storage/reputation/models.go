@@ -0,0 +1,36 @@
+package reputation_storage
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"time"
+)
+
+// Event is the kind of activity that changed the reputation of a user.
+type Event string
+
+const (
+	// EventVoteReceived is recorded when a post of the user is voted on, or when a vote on it changes.
+	EventVoteReceived Event = "vote_received"
+	// EventSuggestionAccepted is recorded when a suggestion of the user is accepted, or rejected after being
+	// accepted.
+	EventSuggestionAccepted Event = "suggestion_accepted"
+	// EventReportUpheld is recorded when a moderator upholds the reports against a content of the user.
+	EventReportUpheld Event = "report_upheld"
+)
+
+// Model is an entry of the reputation ledger.
+type Model struct {
+	bun.BaseModel `bun:"table:reputation_events"`
+
+	ID        uuid.UUID `json:"id" bun:"id,pk,type:uuid"`
+	CreatedAt time.Time `json:"created_at" bun:"created_at,notnull"`
+
+	// UserID is the ID of the user whose reputation changed.
+	UserID uuid.UUID `json:"user_id" bun:"user_id,type:uuid"`
+	Event  Event     `json:"event" bun:"event"`
+	// SourceID is the ID of the post or content the event originates from.
+	SourceID uuid.UUID `json:"source_id" bun:"source_id,type:uuid"`
+	// Points won or lost by the user. They are negative when the reputation decreases.
+	Points int `json:"points" bun:"points"`
+}
@@ -0,0 +1,37 @@
+package reputation_storage
+
+import (
+	"context"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// Repository of the current layer. You can instantiate a new one with NewRepository.
+type Repository interface {
+	// Read returns the reputation of a user, which is the sum of the points of its events. A user without any
+	// event has a reputation of 0.
+	Read(ctx context.Context, userID uuid.UUID) (int64, error)
+}
+
+func NewRepository(db bun.IDB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+type repositoryImpl struct {
+	db bun.IDB
+}
+
+func (repository *repositoryImpl) Read(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var reputation int64
+
+	err := repository.db.NewSelect().Model((*Model)(nil)).
+		ColumnExpr("COALESCE(SUM(points), 0)").
+		Where("user_id = ?", userID).
+		Scan(ctx, &reputation)
+	if err != nil {
+		return 0, validation.HandlePGError(err)
+	}
+
+	return reputation, nil
+}
@@ -0,0 +1,199 @@
+package reputation_storage
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime   = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	updateTime = time.Date(2020, time.May, 4, 9, 0, 0, 0, time.UTC)
+)
+
+var Fixtures = []*Model{
+	{
+		ID:        test_utils.NumberUUID(1),
+		CreatedAt: baseTime,
+		UserID:    test_utils.NumberUUID(1000),
+		Event:     EventVoteReceived,
+		SourceID:  test_utils.NumberUUID(100),
+		Points:    1,
+	},
+	{
+		ID:        test_utils.NumberUUID(2),
+		CreatedAt: baseTime,
+		UserID:    test_utils.NumberUUID(1000),
+		Event:     EventSuggestionAccepted,
+		SourceID:  test_utils.NumberUUID(100),
+		Points:    10,
+	},
+	{
+		ID:        test_utils.NumberUUID(3),
+		CreatedAt: updateTime,
+		UserID:    test_utils.NumberUUID(1000),
+		Event:     EventReportUpheld,
+		SourceID:  test_utils.NumberUUID(101),
+		Points:    -20,
+	},
+	{
+		ID:        test_utils.NumberUUID(4),
+		CreatedAt: baseTime,
+		UserID:    test_utils.NumberUUID(1001),
+		Event:     EventVoteReceived,
+		SourceID:  test_utils.NumberUUID(102),
+		Points:    1,
+	},
+}
+
+func TestReputationRepository_Read(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+
+		expect    int64
+		expectErr error
+	}{
+		{
+			name:   "Success",
+			userID: test_utils.NumberUUID(1000),
+			expect: -9,
+		},
+		{
+			name:   "Success/NoEvents",
+			userID: test_utils.NumberUUID(1002),
+			expect: 0,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, Fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		for _, d := range data {
+			t.Run(d.name, func(st *testing.T) {
+				res, err := repository.Read(ctx, d.userID)
+				test_utils.RequireError(t, d.expectErr, err)
+				require.Equal(t, d.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
+
+// The ledger is filled by the database, when the activity happens in the forum.
+func TestReputationRepository_Events(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := []interface{}{
+		&improve_suggestion_storage.Model{
+			ID:        test_utils.NumberUUID(100),
+			CreatedAt: baseTime,
+			SourceID:  test_utils.NumberUUID(10),
+			UserID:    test_utils.NumberUUID(1000),
+			Core: improve_suggestion_storage.Core{
+				RequestID: test_utils.NumberUUID(10),
+				Title:     "Foo",
+				Content:   "Bar",
+			},
+		},
+		&reports_storage.Case{
+			Target:    reports_storage.TargetImproveSuggestion,
+			TargetID:  test_utils.NumberUUID(100),
+			Status:    reports_storage.StatusPending,
+			Reports:   3,
+			CreatedAt: baseTime,
+		},
+	}
+
+	steps := []struct {
+		name string
+
+		run func(ctx context.Context, tx bun.Tx) error
+
+		expect int64
+	}{
+		{
+			name: "UpVote",
+			run: func(ctx context.Context, tx bun.Tx) error {
+				_, err := tx.NewInsert().Model(&votes_storage.Model{
+					UpdatedAt: baseTime,
+					PostID:    test_utils.NumberUUID(100),
+					UserID:    test_utils.NumberUUID(2000),
+					Target:    votes_storage.TargetImproveSuggestion,
+					Vote:      votes_storage.VoteUp,
+				}).Exec(ctx)
+				return err
+			},
+			expect: 1,
+		},
+		{
+			name: "ChangeVote",
+			run: func(ctx context.Context, tx bun.Tx) error {
+				_, err := tx.NewUpdate().Model((*votes_storage.Model)(nil)).
+					Set("vote = ?", votes_storage.VoteDown).
+					Where("user_id = ?", test_utils.NumberUUID(2000)).
+					Exec(ctx)
+				return err
+			},
+			expect: -1,
+		},
+		{
+			name: "AcceptSuggestion",
+			run: func(ctx context.Context, tx bun.Tx) error {
+				_, err := tx.NewUpdate().Model((*improve_suggestion_storage.Model)(nil)).
+					Set("validated = ?", true).
+					Where("id = ?", test_utils.NumberUUID(100)).
+					Exec(ctx)
+				return err
+			},
+			expect: 9,
+		},
+		{
+			name: "RemoveVote",
+			run: func(ctx context.Context, tx bun.Tx) error {
+				_, err := tx.NewDelete().Model((*votes_storage.Model)(nil)).
+					Where("user_id = ?", test_utils.NumberUUID(2000)).
+					Exec(ctx)
+				return err
+			},
+			expect: 10,
+		},
+		{
+			name: "UpholdReport",
+			run: func(ctx context.Context, tx bun.Tx) error {
+				_, err := tx.NewUpdate().Model((*reports_storage.Case)(nil)).
+					Set("status = ?", reports_storage.StatusResolved).
+					Where("target_id = ?", test_utils.NumberUUID(100)).
+					Exec(ctx)
+				return err
+			},
+			expect: -10,
+		},
+	}
+
+	err := test_utils.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		// Steps depend on each other, so they run in the same transaction.
+		for _, step := range steps {
+			t.Run(step.name, func(st *testing.T) {
+				require.NoError(st, step.run(ctx, tx))
+
+				res, err := repository.Read(ctx, test_utils.NumberUUID(1000))
+				require.NoError(st, err)
+				require.Equal(st, step.expect, res)
+			})
+		}
+	})
+	require.NoError(t, err)
+}
@@ -366,6 +366,70 @@ func (_c *MockRepository_Search_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
+// SearchAfter provides a mock function with given fields: ctx, query, cursor, limit
+func (_m *MockRepository) SearchAfter(ctx context.Context, query string, cursor string, limit int) ([]*PublicPreview, string, error) {
+	ret := _m.Called(ctx, query, cursor, limit)
+
+	var r0 []*PublicPreview
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) ([]*PublicPreview, string, error)); ok {
+		return rf(ctx, query, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) []*PublicPreview); ok {
+		r0 = rf(ctx, query, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*PublicPreview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) string); ok {
+		r1 = rf(ctx, query, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, int) error); ok {
+		r2 = rf(ctx, query, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRepository_SearchAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAfter'
+type MockRepository_SearchAfter_Call struct {
+	*mock.Call
+}
+
+// SearchAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - cursor string
+//   - limit int
+func (_e *MockRepository_Expecter) SearchAfter(ctx interface{}, query interface{}, cursor interface{}, limit interface{}) *MockRepository_SearchAfter_Call {
+	return &MockRepository_SearchAfter_Call{Call: _e.mock.On("SearchAfter", ctx, query, cursor, limit)}
+}
+
+func (_c *MockRepository_SearchAfter_Call) Run(run func(ctx context.Context, query string, cursor string, limit int)) *MockRepository_SearchAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_SearchAfter_Call) Return(_a0 []*PublicPreview, _a1 string, _a2 error) *MockRepository_SearchAfter_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRepository_SearchAfter_Call) RunAndReturn(run func(context.Context, string, string, int) ([]*PublicPreview, string, error)) *MockRepository_SearchAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 type mockConstructorTestingTNewMockRepository interface {
 	mock.TestingT
 	Cleanup(func())
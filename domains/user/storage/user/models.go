@@ -33,6 +33,8 @@ type PublicPreview struct {
 	FirstName string    `json:"first_name" bun:"first_name"`
 	LastName  string    `json:"last_name" bun:"last_name"`
 	CreatedAt time.Time `json:"created_at" bun:"created_at"`
+	// Reputation is the sum of the points of the user, in the reputation ledger.
+	Reputation int64 `json:"reputation" bun:"reputation"`
 }
 
 type Public struct {
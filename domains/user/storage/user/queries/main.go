@@ -4,3 +4,6 @@ import _ "embed"
 
 //go:embed search_user.sql
 var SearchUserQuery string
+
+//go:embed search_user_after.sql
+var SearchUserAfterQuery string
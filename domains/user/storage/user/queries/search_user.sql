@@ -4,10 +4,14 @@ SELECT profiles.id AS id,
        identities.first_name AS first_name,
        identities.last_name AS last_name,
        credentials.created_at AS created_at,
+       COALESCE(reputation.points, 0) AS reputation,
        COUNT(*) OVER() AS total
 FROM credentials
     LEFT JOIN identities ON identities.id = credentials.id
     LEFT JOIN profiles ON profiles.id = credentials.id
+    LEFT JOIN LATERAL (
+        SELECT SUM(points) AS points FROM reputation_events WHERE reputation_events.user_id = credentials.id
+    ) AS reputation ON TRUE
     LEFT JOIN LATERAL (SELECT CASE WHEN ?0 = '' THEN '' ELSE format_user_search(?0) END AS term) AS parsed ON TRUE
     LEFT JOIN LATERAL (
         SELECT CASE
@@ -25,5 +29,9 @@ FROM credentials
     ) AS slug_proximity ON TRUE
     LEFT JOIN LATERAL (SELECT GREATEST(username_proximity.score, slug_proximity.score) AS score) AS proximity ON TRUE
 WHERE proximity.score > 0.1
+  /* Profiles hidden after being reported never appear in searches. */
+  AND credentials.id NOT IN (
+      SELECT target_id FROM forum_report_cases WHERE target = 'profile' AND hidden_at IS NOT NULL
+  )
 ORDER BY proximity.score DESC, credentials.created_at DESC
 LIMIT ?1 OFFSET ?2;
@@ -0,0 +1,42 @@
+SELECT profiles.id AS id,
+       profiles.username AS username,
+       profiles.slug AS slug,
+       identities.first_name AS first_name,
+       identities.last_name AS last_name,
+       credentials.created_at AS created_at,
+       COALESCE(reputation.points, 0) AS reputation,
+       json_build_array(proximity.score::text, credentials.created_at::text, credentials.id::text)::text AS cursor
+FROM credentials
+    LEFT JOIN identities ON identities.id = credentials.id
+    LEFT JOIN profiles ON profiles.id = credentials.id
+    LEFT JOIN LATERAL (
+        SELECT SUM(points) AS points FROM reputation_events WHERE reputation_events.user_id = credentials.id
+    ) AS reputation ON TRUE
+    LEFT JOIN LATERAL (SELECT CASE WHEN ?0 = '' THEN '' ELSE format_user_search(?0) END AS term) AS parsed ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT CASE
+            WHEN parsed.term = '' THEN 1
+            WHEN (profiles.username IS NOT NULL AND profiles.username <> '') THEN similarity(parsed.term, format_user_search(profiles.username))
+            ELSE similarity(parsed.term, format_user_search(identities.first_name || ' ' || identities.last_name))
+        END AS score
+    ) AS username_proximity ON TRUE
+    /* Slug has no accent or uppercase or special characters, so no need to format it */
+    LEFT JOIN LATERAL (
+        SELECT CASE
+           WHEN parsed.term = '' THEN 1
+           ELSE similarity(parsed.term, profiles.slug)
+        END AS score
+    ) AS slug_proximity ON TRUE
+    LEFT JOIN LATERAL (SELECT GREATEST(username_proximity.score, slug_proximity.score) AS score) AS proximity ON TRUE
+WHERE proximity.score > 0.1
+  /* Profiles hidden after being reported never appear in searches. */
+  AND credentials.id NOT IN (
+      SELECT target_id FROM forum_report_cases WHERE target = 'profile' AND hidden_at IS NOT NULL
+  )
+  /* Only keep the results after the cursor position, if any. The id breaks ties between equal scores and dates. */
+  AND (
+      ?2::real IS NULL
+      OR (proximity.score, credentials.created_at, credentials.id) < (?2::real, ?3::timestamp, ?4::uuid)
+  )
+ORDER BY proximity.score DESC, credentials.created_at DESC, credentials.id DESC
+LIMIT ?1;
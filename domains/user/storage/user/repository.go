@@ -6,7 +6,9 @@ import (
 	"github.com/a-novel/agora-backend/domains/user/storage/credentials"
 	"github.com/a-novel/agora-backend/domains/user/storage/identity"
 	"github.com/a-novel/agora-backend/domains/user/storage/profile"
+	"github.com/a-novel/agora-backend/domains/user/storage/reputation"
 	"github.com/a-novel/agora-backend/domains/user/storage/user/queries"
+	"github.com/a-novel/agora-backend/framework/pagination"
 	"github.com/a-novel/agora-backend/framework/validation"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
@@ -24,6 +26,10 @@ type Repository interface {
 	Delete(ctx context.Context, id uuid.UUID, now time.Time) (*Model, error)
 	// Search performs a cross-table search query over the user repository.
 	Search(ctx context.Context, query string, limit, offset int) ([]*PublicPreview, int64, error)
+	// SearchAfter returns the users matching the query, in the same order as Search, that come after the given
+	// cursor. An empty cursor starts from the first result.
+	// It also returns the cursor of the next page, which is empty when there are no more results.
+	SearchAfter(ctx context.Context, query, cursor string, limit int) ([]*PublicPreview, string, error)
 	// GetPreview returns a preview of a user, for private display within the application.
 	GetPreview(ctx context.Context, id uuid.UUID) (*Preview, error)
 	// GetPublic returns a user information, for public display on the application.
@@ -149,6 +155,7 @@ func (repository *repositoryImpl) Search(ctx context.Context, query string, limi
 			&result.FirstName,
 			&result.LastName,
 			&result.CreatedAt,
+			&result.Reputation,
 			&count,
 		); err != nil {
 			return nil, 0, err
@@ -164,6 +171,62 @@ func (repository *repositoryImpl) Search(ctx context.Context, query string, limi
 	return results, count, nil
 }
 
+func (repository *repositoryImpl) SearchAfter(ctx context.Context, query, cursor string, limit int) ([]*PublicPreview, string, error) {
+	// Score, creation date and ID of the last user read. They are left NULL to read the first page.
+	position := make([]interface{}, 3)
+	if cursor != "" {
+		values, err := pagination.Decode(cursor, len(position))
+		if err != nil {
+			return nil, "", err
+		}
+
+		for i, value := range values {
+			position[i] = value
+		}
+	}
+
+	rows, err := repository.db.QueryContext(ctx, user_queries.SearchUserAfterQuery, append([]interface{}{query, limit}, position...)...)
+	if err != nil {
+		return nil, "", validation.HandlePGError(err)
+	}
+
+	defer rows.Close()
+
+	var (
+		results []*PublicPreview
+		last    string
+	)
+
+	for rows.Next() {
+		var result PublicPreview
+
+		if err := rows.Scan(
+			&result.ID,
+			&result.Username,
+			&result.Slug,
+			&result.FirstName,
+			&result.LastName,
+			&result.CreatedAt,
+			&result.Reputation,
+			&last,
+		); err != nil {
+			return nil, "", err
+		}
+
+		results = append(results, &result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, "", validation.HandlePGError(err)
+	}
+
+	next := pagination.Next(results, limit, func(*PublicPreview) string {
+		return last
+	})
+
+	return results, next, nil
+}
+
 func (repository *repositoryImpl) GetPreview(ctx context.Context, id uuid.UUID) (*Preview, error) {
 	var (
 		credentialsModel credentials_storage.Model
@@ -217,6 +280,26 @@ func (repository *repositoryImpl) GetPublicPreviews(ctx context.Context, ids []u
 		return nil, fmt.Errorf("failed to get profile: %w", validation.HandlePGError(err))
 	}
 
+	var reputationModels []struct {
+		UserID     uuid.UUID `bun:"user_id"`
+		Reputation int64     `bun:"reputation"`
+	}
+
+	err = repository.db.NewSelect().Model((*reputation_storage.Model)(nil)).
+		Column("user_id").
+		ColumnExpr("SUM(points) AS reputation").
+		Where("user_id IN (?)", bun.In(ids)).
+		Group("user_id").
+		Scan(ctx, &reputationModels)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reputation: %w", validation.HandlePGError(err))
+	}
+
+	reputations := make(map[uuid.UUID]int64, len(reputationModels))
+	for _, reputationModel := range reputationModels {
+		reputations[reputationModel.UserID] = reputationModel.Reputation
+	}
+
 	results := make([]*PublicPreview, len(profileModels))
 	for i, profileModel := range profileModels {
 		// Stay stable in case order is not consistent.
@@ -235,6 +318,8 @@ func (repository *repositoryImpl) GetPublicPreviews(ctx context.Context, ids []u
 			FirstName: identityModel.FirstName,
 			LastName:  identityModel.LastName,
 			CreatedAt: identityModel.CreatedAt,
+			// Users without any event in the ledger have a reputation of 0.
+			Reputation: reputations[profileModel.ID],
 		}
 	}
 
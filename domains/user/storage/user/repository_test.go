@@ -834,6 +834,55 @@ func TestUserRepository_Search(t *testing.T) {
 	}
 }
 
+func TestUserRepository_SearchAfter(t *testing.T) {
+	db, sqlDB := test_utils.GetPostgres(t)
+	defer db.Close()
+	defer sqlDB.Close()
+
+	fixtures := concatFixtures(
+		generateSearchFixture("", "blue-x", "Elon", "Bezos", models.SexMale, baseTime, 1),
+		generateSearchFixture("", "spaceorigin", "Elon", "Bezos", models.SexMale, baseTime.Add(time.Hour), 2),
+		// Same score and creation date as the previous result.
+		generateSearchFixture("", "spaceorigin-2", "Elon", "Bezos", models.SexMale, baseTime.Add(time.Hour), 3),
+		generateSearchFixture("", "notyetwritten", "Eleonore", "Payet", models.SexFemale, baseTime, 4),
+		generateSearchFixture("", "fruit-basket", "Anna", "Banana", models.SexFemale, baseTime, 5),
+	)
+
+	err := test_utils.RunTransactionalTest(db, fixtures, func(ctx context.Context, tx bun.Tx) {
+		repository := NewRepository(tx)
+
+		t.Run("Success", func(st *testing.T) {
+			expect, _, err := repository.Search(ctx, "Ele Be", 100, 0)
+			require.NoError(st, err)
+			require.Len(st, expect, 4)
+
+			// Reading the results two by two must return the same results as a single page.
+			res, next, err := repository.SearchAfter(ctx, "Ele Be", "", 2)
+			require.NoError(st, err)
+			require.NotEmpty(st, next)
+
+			page, next, err := repository.SearchAfter(ctx, "Ele Be", next, 2)
+			require.NoError(st, err)
+			require.NotEmpty(st, next)
+			res = append(res, page...)
+
+			page, next, err = repository.SearchAfter(ctx, "Ele Be", next, 2)
+			require.NoError(st, err)
+			require.Empty(st, page)
+			require.Empty(st, next)
+
+			require.ElementsMatch(st, expect, res)
+			require.Equal(st, expect[3], res[3])
+		})
+
+		t.Run("Error/InvalidCursor", func(st *testing.T) {
+			_, _, err := repository.SearchAfter(ctx, "Ele Be", "foo", 2)
+			require.ErrorIs(st, err, validation.ErrInvalidEntity)
+		})
+	})
+	require.NoError(t, err)
+}
+
 func TestUserRepository_GetPreview(t *testing.T) {
 	db, sqlDB := test_utils.GetPostgres(t)
 	defer db.Close()
@@ -945,6 +994,24 @@ func TestUserRepository_GetPublicPreviews(t *testing.T) {
 				generateSearchFixture("3", "notyetwritten", "Eleonore", "Payet", models.SexFemale, baseTime, 4),
 				// Irrelevant result.
 				generateSearchFixture("Banananana", "fruit-basket", "Anna", "Banana", models.SexFemale, baseTime, 5),
+				[]interface{}{
+					&reputation_storage.Model{
+						ID:        test_utils.NumberUUID(100),
+						CreatedAt: baseTime,
+						UserID:    test_utils.NumberUUID(1),
+						Event:     reputation_storage.EventSuggestionAccepted,
+						SourceID:  test_utils.NumberUUID(200),
+						Points:    10,
+					},
+					&reputation_storage.Model{
+						ID:        test_utils.NumberUUID(101),
+						CreatedAt: baseTime,
+						UserID:    test_utils.NumberUUID(1),
+						Event:     reputation_storage.EventVoteReceived,
+						SourceID:  test_utils.NumberUUID(200),
+						Points:    -2,
+					},
+				},
 			),
 			ids: []uuid.UUID{
 				test_utils.NumberUUID(1),
@@ -955,12 +1022,13 @@ func TestUserRepository_GetPublicPreviews(t *testing.T) {
 			},
 			expect: []*PublicPreview{
 				{
-					ID:        test_utils.NumberUUID(1),
-					Slug:      "blue-x",
-					Username:  "BigBrother",
-					FirstName: "Elon",
-					LastName:  "Bezos",
-					CreatedAt: baseTime,
+					ID:         test_utils.NumberUUID(1),
+					Slug:       "blue-x",
+					Username:   "BigBrother",
+					FirstName:  "Elon",
+					LastName:   "Bezos",
+					CreatedAt:  baseTime,
+					Reputation: 8,
 				},
 				{
 					ID:        test_utils.NumberUUID(2),
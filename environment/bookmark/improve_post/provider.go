@@ -16,6 +16,7 @@ type Provider interface {
 	UnBookmark(ctx context.Context, token string, requestID uuid.UUID, target models.BookmarkTarget) error
 	IsBookmarked(ctx context.Context, userID, requestID uuid.UUID, target models.BookmarkTarget) (*models.BookmarkLevel, error)
 	List(ctx context.Context, userID uuid.UUID, level models.BookmarkLevel, target models.BookmarkTarget, limit, offset int) ([]*models.Bookmark, int64, error)
+	ListAfter(ctx context.Context, userID uuid.UUID, level models.BookmarkLevel, target models.BookmarkTarget, cursor string, limit int) ([]*models.Bookmark, string, error)
 }
 
 type providerImpl struct {
@@ -103,3 +104,12 @@ func (provider *providerImpl) List(ctx context.Context, userID uuid.UUID, level
 
 	return res, total, nil
 }
+
+func (provider *providerImpl) ListAfter(ctx context.Context, userID uuid.UUID, level models.BookmarkLevel, target models.BookmarkTarget, cursor string, limit int) ([]*models.Bookmark, string, error) {
+	res, next, err := provider.bookmarkService.ListAfter(ctx, userID, level, target, cursor, limit)
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to list bookmarks: %w", err)
+	}
+
+	return res, next, nil
+}
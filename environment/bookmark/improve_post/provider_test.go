@@ -457,3 +457,83 @@ func TestBookmarkImprovePostProvider_List(t *testing.T) {
 		})
 	}
 }
+
+func TestBookmarkImprovePostProvider_ListAfter(t *testing.T) {
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+		level  models.BookmarkLevel
+		target models.BookmarkTarget
+		cursor string
+		limit  int
+
+		bookmarkData []*models.Bookmark
+		bookmarkNext string
+		bookmarkErr  error
+
+		expect     []*models.Bookmark
+		expectNext string
+		expectErr  error
+	}{
+		{
+			name:   "Success",
+			userID: test_utils.NumberUUID(10),
+			level:  models.BookmarkLevelFavorite,
+			target: models.BookmarkTargetImproveRequest,
+			cursor: "cursor-1",
+			limit:  10,
+			bookmarkData: []*models.Bookmark{
+				{
+					UserID:    test_utils.NumberUUID(10),
+					RequestID: test_utils.NumberUUID(101),
+					CreatedAt: baseTime,
+					Level:     models.BookmarkLevelFavorite,
+					Target:    models.BookmarkTargetImproveRequest,
+				},
+			},
+			bookmarkNext: "cursor-2",
+			expect: []*models.Bookmark{
+				{
+					UserID:    test_utils.NumberUUID(10),
+					RequestID: test_utils.NumberUUID(101),
+					CreatedAt: baseTime,
+					Level:     models.BookmarkLevelFavorite,
+					Target:    models.BookmarkTargetImproveRequest,
+				},
+			},
+			expectNext: "cursor-2",
+		},
+		{
+			name:        "Error/BookmarkServiceFailure",
+			userID:      test_utils.NumberUUID(10),
+			level:       models.BookmarkLevelFavorite,
+			target:      models.BookmarkTargetImproveRequest,
+			cursor:      "cursor-1",
+			limit:       10,
+			bookmarkErr: fooErr,
+			expectErr:   fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			bookmarkService := improve_post_service.NewMockService(t)
+
+			bookmarkService.
+				On("ListAfter", context.TODO(), d.userID, d.level, d.target, d.cursor, d.limit).
+				Return(d.bookmarkData, d.bookmarkNext, d.bookmarkErr)
+
+			provider := NewProvider(Config{
+				BookmarkService: bookmarkService,
+			})
+
+			res, next, err := provider.ListAfter(context.TODO(), d.userID, d.level, d.target, d.cursor, d.limit)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+			require.Equal(t, d.expectNext, next)
+
+			bookmarkService.AssertExpectations(t)
+		})
+	}
+}
@@ -2,50 +2,250 @@ package improve_post
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/a-novel/agora-backend/domains/forum/service/badges"
+	"github.com/a-novel/agora-backend/domains/forum/service/collaborator"
+	"github.com/a-novel/agora-backend/domains/forum/service/critique"
+	"github.com/a-novel/agora-backend/domains/forum/service/drafts"
+	"github.com/a-novel/agora-backend/domains/forum/service/duplicates"
 	"github.com/a-novel/agora-backend/domains/forum/service/improve_request"
 	"github.com/a-novel/agora-backend/domains/forum/service/improve_suggestion"
+	"github.com/a-novel/agora-backend/domains/forum/service/leaderboards"
+	"github.com/a-novel/agora-backend/domains/forum/service/reports"
+	"github.com/a-novel/agora-backend/domains/forum/service/thread_state"
+	"github.com/a-novel/agora-backend/domains/forum/service/visibility"
 	"github.com/a-novel/agora-backend/domains/forum/service/votes"
 	"github.com/a-novel/agora-backend/domains/keys/service/jwk"
+	"github.com/a-novel/agora-backend/domains/user/service/reputation"
 	"github.com/a-novel/agora-backend/domains/user/service/token"
 	user_service "github.com/a-novel/agora-backend/domains/user/service/user"
 	"github.com/a-novel/agora-backend/environment/user/authentication"
 	"github.com/a-novel/agora-backend/framework/validation"
 	"github.com/a-novel/agora-backend/models"
 	"github.com/google/uuid"
+	"github.com/rs/zerolog"
 	"time"
 )
 
-type Provider interface {
-	ReadImproveRequest(ctx context.Context, id uuid.UUID) ([]*models.ImproveRequest, error)
-	ReadImproveSuggestion(ctx context.Context, id uuid.UUID) (*models.ImproveSuggestion, error)
+const (
+	// SearchSuggestionsLimit is the maximum number of alternative queries proposed when a search has no result.
+	SearchSuggestionsLimit = 5
+	// ScheduledDraftsBatchSize is the number of scheduled drafts published at once.
+	ScheduledDraftsBatchSize = 50
+	// VoteCountersBatchSize is the number of posts which vote counters are reconciled at once.
+	VoteCountersBatchSize = 500
+)
 
-	CreateImproveRequest(ctx context.Context, token, title, content string) (*models.ImproveRequest, error)
-	CreateImproveRequestRevision(ctx context.Context, token string, sourceID uuid.UUID, title, content string) (*models.ImproveRequest, error)
-	CreateImproveSuggestion(ctx context.Context, token string, requestID, sourceID uuid.UUID, title, content string) (*models.ImproveSuggestion, error)
+type Provider interface {
+	// ReadImproveRequest returns every revision of an improvement request. Requests that are not public can only be
+	// read by their authors, collaborators and invited users, or with a valid share token when unlisted. Requests
+	// hidden after being reported can only be read by their authors and moderators. The token is optional.
+	ReadImproveRequest(ctx context.Context, token, shareToken string, id uuid.UUID) ([]*models.ImproveRequest, error)
+	// ReadImproveSuggestion requires the same access to the improvement request of the suggestion as
+	// ReadImproveRequest. Suggestions hidden after being reported can only be read by their author and moderators.
+	// The token is optional.
+	ReadImproveSuggestion(ctx context.Context, token, shareToken string, id uuid.UUID) (*models.ImproveSuggestion, error)
+	// ReadImproveSuggestionRevisions requires the same access as ReadImproveSuggestion.
+	ReadImproveSuggestionRevisions(ctx context.Context, token, shareToken string, id uuid.UUID) ([]*models.ImproveSuggestionRevision, error)
+
+	CreateImproveRequest(ctx context.Context, token, title, content, language string, tags []uuid.UUID) (*models.ImproveRequest, error)
+	// CreateImproveRequestRevision is allowed to the creator of the request, and to its accepted collaborators.
+	CreateImproveRequestRevision(ctx context.Context, token string, sourceID uuid.UUID, title, content, language string, tags []uuid.UUID) (*models.ImproveRequest, error)
+	// CreateImproveSuggestion requires the same access to the improvement request as ReadImproveRequest, and the
+	// request to be open.
+	CreateImproveSuggestion(ctx context.Context, token, shareToken string, requestID, sourceID uuid.UUID, title, content string) (*models.ImproveSuggestion, error)
+	// UpdateImproveSuggestion is still allowed once the improvement request is closed, but not when it is locked or
+	// archived.
 	UpdateImproveSuggestion(ctx context.Context, token string, postID, requestID uuid.UUID, title, content string) (*models.ImproveSuggestion, error)
 
+	// DeleteImproveRequest deletes a revision. Revisions can be deleted by their author, or by any owner of the
+	// request. Deleting the first revision deletes the whole request.
 	DeleteImproveRequest(ctx context.Context, token string, requestID uuid.UUID) error
+	// DeleteImproveSuggestion deletes a suggestion. It remains in the thread as a tombstone, until it is purged.
 	DeleteImproveSuggestion(ctx context.Context, token string, id uuid.UUID) error
-
-	ListImproveSuggestions(ctx context.Context, query models.ImproveSuggestionsList, limit, offset int) ([]*models.ImproveSuggestion, int64, error)
+	// RestoreImproveRequest restores a deleted revision, with the same permissions as DeleteImproveRequest. Restoring
+	// the first revision restores the revisions deleted along with it. Posts can only be restored within the
+	// restore window following their deletion.
+	RestoreImproveRequest(ctx context.Context, token string, requestID uuid.UUID) error
+	// RestoreImproveSuggestion restores a deleted suggestion, as long as its request was not deleted.
+	RestoreImproveSuggestion(ctx context.Context, token string, id uuid.UUID) (*models.ImproveSuggestion, error)
+	// PurgeDeletedPosts permanently removes the posts deleted for longer than the purge delay. It is meant to be
+	// called periodically, by a backend service.
+	PurgeDeletedPosts(ctx context.Context, auth *authentication.BackendServiceAuth) error
+	// ReconcileVoteCounters recomputes the vote counters of every request and suggestion from the votes cast on
+	// them. It is meant to be called periodically by a backend service, to fix the counters that drifted.
+	ReconcileVoteCounters(ctx context.Context, auth *authentication.BackendServiceAuth) error
+
+	// ListImproveRequestCollaborators returns the co-authors of an improvement request, including pending
+	// invitations. It is restricted to the owners and editors of the request.
+	ListImproveRequestCollaborators(ctx context.Context, token string, requestID uuid.UUID) ([]*models.ImproveRequestCollaborator, error)
+	// InviteImproveRequestCollaborator asks a user to co-author an improvement request. Only owners can manage
+	// collaborators.
+	InviteImproveRequestCollaborator(ctx context.Context, token string, requestID, userID uuid.UUID, role models.ImproveRequestCollaboratorRole) (*models.ImproveRequestCollaborator, error)
+	UpdateImproveRequestCollaborator(ctx context.Context, token string, requestID, userID uuid.UUID, role models.ImproveRequestCollaboratorRole) (*models.ImproveRequestCollaborator, error)
+	// RemoveImproveRequestCollaborator is allowed to owners, and to the collaborator itself, to leave the request or
+	// decline a pending invitation.
+	RemoveImproveRequestCollaborator(ctx context.Context, token string, requestID, userID uuid.UUID) error
+	// ListImproveRequestCollaborationInvitations returns the invitations the current user has not answered yet.
+	ListImproveRequestCollaborationInvitations(ctx context.Context, token string) ([]*models.ImproveRequestCollaborator, error)
+	AcceptImproveRequestCollaboration(ctx context.Context, token string, requestID uuid.UUID) (*models.ImproveRequestCollaborator, error)
+
+	// ReadImproveRequestState requires the same access to the improvement request as ReadImproveRequest.
+	ReadImproveRequestState(ctx context.Context, token, shareToken string, requestID uuid.UUID) (*models.ImproveRequestThreadState, error)
+	// UpdateImproveRequestState opens, closes or archives an improvement request. It is restricted to the owners of
+	// the request. Locked requests can only be unlocked by a moderator.
+	UpdateImproveRequestState(ctx context.Context, token string, requestID uuid.UUID, state models.ImproveRequestState, reason string) (*models.ImproveRequestThreadState, error)
+	// CloseInactiveImproveRequests closes the open requests that received enough accepted suggestions, or had no
+	// activity for a while, as configured. It is meant to be called periodically, by a backend service.
+	CloseInactiveImproveRequests(ctx context.Context, auth *authentication.BackendServiceAuth) error
+	// AwardBadges awards their badges to the users active over the last BadgesWindow. It is meant to be called
+	// periodically, by a backend service, at an interval shorter than BadgesWindow. Users who earned their badges
+	// before they existed were awarded them once, when badges were migrated.
+	AwardBadges(ctx context.Context, auth *authentication.BackendServiceAuth) error
+
+	// ReadImproveRequestVisibility returns the visibility of an improvement request. It is restricted to the owners
+	// of the request, as every method below.
+	ReadImproveRequestVisibility(ctx context.Context, token string, requestID uuid.UUID) (*models.ImproveRequestAccess, error)
+	UpdateImproveRequestVisibility(ctx context.Context, token string, requestID uuid.UUID, visibility models.ImproveRequestVisibility) (*models.ImproveRequestAccess, error)
+	// ListImproveRequestInvites returns the users allowed to read an improvement request that is not public.
+	ListImproveRequestInvites(ctx context.Context, token string, requestID uuid.UUID, limit, offset int) ([]*models.ImproveRequestInvite, int64, error)
+	InviteToImproveRequest(ctx context.Context, token string, requestID, userID uuid.UUID) (*models.ImproveRequestInvite, error)
+	UninviteFromImproveRequest(ctx context.Context, token string, requestID, userID uuid.UUID) error
+	ListImproveRequestShareTokens(ctx context.Context, token string, requestID uuid.UUID) ([]*models.ImproveRequestShareToken, error)
+	// CreateImproveRequestShareToken returns a new token, that grants read access to the request while it is
+	// unlisted. The token cannot be retrieved afterwards.
+	CreateImproveRequestShareToken(ctx context.Context, token string, requestID uuid.UUID) (*models.ImproveRequestShareLink, error)
+	RevokeImproveRequestShareToken(ctx context.Context, token string, requestID, shareTokenID uuid.UUID) error
+
+	// ReadImproveRequestCritique returns the aspects the owners of an improvement request want feedback on, and the
+	// summary of the ratings they received. It requires the same access as ReadImproveRequest.
+	ReadImproveRequestCritique(ctx context.Context, token, shareToken string, requestID uuid.UUID) (*models.ImproveRequestCritique, error)
+	// UpdateImproveRequestAspects is restricted to the owners of an open or closed request.
+	UpdateImproveRequestAspects(ctx context.Context, token string, requestID uuid.UUID, aspects []models.CritiqueAspect) (*models.ImproveRequestCritique, error)
+	// ReadImproveSuggestionRatings requires the same access as ReadImproveSuggestion.
+	ReadImproveSuggestionRatings(ctx context.Context, token, shareToken string, id uuid.UUID) ([]*models.ImproveSuggestionRating, error)
+	// RateImproveSuggestion replaces the ratings attached to a suggestion, by its author. Only the aspects
+	// requested on the improvement request can be rated.
+	RateImproveSuggestion(ctx context.Context, token string, id uuid.UUID, ratings []*models.ImproveSuggestionRating) ([]*models.ImproveSuggestionRating, error)
+
+	// ReadImproveRequestDraft returns a draft of the current user.
+	ReadImproveRequestDraft(ctx context.Context, token string, id uuid.UUID) (*models.ImproveRequestDraft, error)
+	// ListImproveRequestDrafts returns the drafts of the current user, most recently saved first.
+	ListImproveRequestDrafts(ctx context.Context, token string, limit, offset int) ([]*models.ImproveRequestDraft, int64, error)
+	// CreateImproveRequestDraft saves a new draft for the current user. Setting a publication time requires a
+	// validated account.
+	CreateImproveRequestDraft(ctx context.Context, token string, data *models.ImproveRequestDraftUpsert) (*models.ImproveRequestDraft, error)
+	// UpdateImproveRequestDraft overwrites a draft of the current user. Setting a publication time requires a
+	// validated account.
+	UpdateImproveRequestDraft(ctx context.Context, token string, id uuid.UUID, data *models.ImproveRequestDraftUpsert) (*models.ImproveRequestDraft, error)
+	DeleteImproveRequestDraft(ctx context.Context, token string, id uuid.UUID) error
+	// PublishImproveRequestDraft turns a draft of the current user into an improvement request, with the same ID.
+	// The draft is removed once published. Publishing a draft again, after a failure to remove it, returns the
+	// request published the first time.
+	PublishImproveRequestDraft(ctx context.Context, token string, id uuid.UUID) (*models.ImproveRequest, error)
+	// PublishScheduledImproveRequestDrafts publishes every draft which publication time is over. Drafts that
+	// cannot be published anymore are unscheduled instead, so their author can fix them. It is meant to be called
+	// periodically, by a backend service.
+	PublishScheduledImproveRequestDrafts(ctx context.Context, auth *authentication.BackendServiceAuth) error
+
+	// ListImproveSuggestions requires the same access as ReadImproveRequest when the query targets a single
+	// improvement request. Other listings only include the suggestions of public requests. The token is optional.
+	ListImproveSuggestions(ctx context.Context, token, shareToken string, query models.ImproveSuggestionsList, limit, offset int) ([]*models.ImproveSuggestion, int64, error)
+	// ListImproveSuggestionsAfter is the cursor paginated version of ListImproveSuggestions. It returns the cursor
+	// of the next page, which is empty on the last page.
+	ListImproveSuggestionsAfter(ctx context.Context, token, shareToken string, query models.ImproveSuggestionsList, cursor string, limit int) ([]*models.ImproveSuggestion, string, error)
 	SearchImproveRequests(ctx context.Context, query models.ImproveRequestSearch, limit, offset int) ([]*models.ImproveRequestPreview, int64, error)
-
+	// SearchImproveRequestsAfter is the cursor paginated version of SearchImproveRequests. It returns the cursor
+	// of the next page, which is empty on the last page.
+	SearchImproveRequestsAfter(ctx context.Context, query models.ImproveRequestSearch, cursor string, limit int) ([]*models.ImproveRequestPreview, string, error)
+	GetImproveRequestSearchFacets(ctx context.Context, query models.ImproveRequestSearch) (*models.ImproveRequestSearchFacets, error)
+	// GetRelatedImproveRequests returns the improvement requests most similar to the given one, by decreasing
+	// similarity. Results are cached: a new, restored or unhidden request only shows up right away in the results of
+	// the requests it shares a tag with, and in the others once their results are computed again. Like
+	// ReadImproveRequest, the given request must be visible to the user.
+	GetRelatedImproveRequests(ctx context.Context, token, shareToken string, id uuid.UUID, limit int) ([]*models.ImproveRequestPreview, error)
+	// GetImproveRequestSearchSuggestions returns titles close to the query, to propose when a search has no result.
+	GetImproveRequestSearchSuggestions(ctx context.Context, query string) ([]string, error)
+	// RefreshImproveRequestRankings recomputes the scores behind the time sensitive search orders. It is meant to be
+	// called periodically, by a backend service.
+	RefreshImproveRequestRankings(ctx context.Context, auth *authentication.BackendServiceAuth) error
+	// SearchForum runs a full text search over both improvement requests and suggestions. Limit and offset apply
+	// to each type of post separately. When nothing matches, alternative queries are returned instead. Only the
+	// suggestions of public requests are searched, as with ListImproveSuggestions.
+	SearchForum(ctx context.Context, query, language string, limit, offset int) (*models.ForumSearchResults, error)
+	// ListLeaderboard returns the entries of a leaderboard, best ranked first, as computed by the last call to
+	// RefreshLeaderboards.
+	ListLeaderboard(ctx context.Context, board models.Leaderboard, period models.LeaderboardPeriod, limit, offset int) ([]*models.LeaderboardEntry, int64, error)
+	// RefreshLeaderboards recomputes the leaderboards. It is meant to be called periodically, by a backend service.
+	RefreshLeaderboards(ctx context.Context, auth *authentication.BackendServiceAuth) error
+
+	// Vote is not allowed on the posts of a locked or archived improvement request. It requires a validated
+	// account, and the number of votes a user can cast in a given time is limited.
 	Vote(ctx context.Context, token string, postID uuid.UUID, target models.VoteTarget, vote models.VoteValue) (models.VoteValue, error)
 	HasVoted(ctx context.Context, token string, postID uuid.UUID, target models.VoteTarget) (models.VoteValue, error)
 	GetVotedPosts(ctx context.Context, userID uuid.UUID, target models.VoteTarget, limit, offset int) ([]*models.VotedPost, int64, error)
-
-	GetImproveRequestPreviews(ctx context.Context, ids []uuid.UUID) ([]*models.ImproveRequestPreview, error)
-	GetImproveSuggestionPreviews(ctx context.Context, ids []uuid.UUID) ([]*models.ImproveSuggestion, error)
+	// GetVotedPostsAfter is the cursor paginated version of GetVotedPosts. It returns the cursor of the next page,
+	// which is empty on the last page.
+	GetVotedPostsAfter(ctx context.Context, userID uuid.UUID, target models.VoteTarget, cursor string, limit int) ([]*models.VotedPost, string, error)
+	// React adds or removes a reaction of the current user on a post, and returns every reaction the user left on
+	// it. Reactions come on top of votes, with the same restrictions, and must be part of the configured set.
+	React(ctx context.Context, token string, postID uuid.UUID, target models.VoteTarget, reaction string, active bool) ([]string, error)
+	GetReactions(ctx context.Context, token string, postID uuid.UUID, target models.VoteTarget) ([]string, error)
+	// ListReactions returns the reactions users can leave on posts.
+	ListReactions() []string
+
+	// GetImproveRequestPreviews only returns the requests that are not public to their authors and invited users.
+	// The token is optional.
+	GetImproveRequestPreviews(ctx context.Context, token string, ids []uuid.UUID) ([]*models.ImproveRequestPreview, error)
+	// GetImproveSuggestionPreviews leaves out the suggestions of the requests the user cannot read, as
+	// GetImproveRequestPreviews does. The token is optional.
+	GetImproveSuggestionPreviews(ctx context.Context, token string, ids []uuid.UUID) ([]*models.ImproveSuggestion, error)
 }
 
 type Config struct {
 	ImproveRequestService    improve_request_service.Service
 	ImproveSuggestionService improve_suggestion_service.Service
 	VotesService             votes_service.Service
+	DraftsService            drafts_service.Service
+	DuplicatesService        duplicates_service.Service
+	VisibilityService        visibility_service.Service
+	CollaboratorService      collaborator_service.Service
+	ThreadStateService       thread_state_service.Service
+	CritiqueService          critique_service.Service
 	TokenService             token_service.Service
 	KeysService              jwk_service.ServiceCached
 	UserService              user_service.Service
+	ReputationService        reputation_service.Service
+	BadgesService            badges_service.Service
+	LeaderboardsService      leaderboards_service.Service
+	ReportsService           reports_service.Service
+
+	// AutoCloseAcceptedSuggestions is the number of validated suggestions after which a request is closed by
+	// CloseInactiveImproveRequests. 0 disables it.
+	AutoCloseAcceptedSuggestions int
+	// AutoCloseInactivity is the delay without a new revision or suggestion after which a request is closed by
+	// CloseInactiveImproveRequests. 0 disables it.
+	AutoCloseInactivity time.Duration
+	// RestoreWindow is the delay after a deletion during which a post can be restored.
+	RestoreWindow time.Duration
+	// PurgeAfter is the delay after a deletion after which a post is permanently removed by PurgeDeletedPosts.
+	// 0 disables it.
+	PurgeAfter time.Duration
+	// VoteRateLimit is the maximum number of times a user can cast, change or cancel a vote during VoteRateWindow.
+	// 0 disables it.
+	VoteRateLimit int
+	// VoteRateWindow is the sliding window over which VoteRateLimit applies.
+	VoteRateWindow time.Duration
+	// Reactions is the set of reactions users can leave on posts, on top of their vote.
+	Reactions []string
+	// DownVoteReputation is the reputation a user needs to vote down a post. 0 disables it.
+	DownVoteReputation int64
+	// BadgesWindow is how far back AwardBadges looks for active users.
+	BadgesWindow time.Duration
+
+	// Logger reports the failures that do not fail the call, such as duplicate checks.
+	Logger zerolog.Logger
 
 	Time func() time.Time
 	ID   func() uuid.UUID
@@ -55,9 +255,31 @@ type providerImpl struct {
 	improveRequestService    improve_request_service.Service
 	improveSuggestionService improve_suggestion_service.Service
 	votesService             votes_service.Service
+	draftsService            drafts_service.Service
+	duplicatesService        duplicates_service.Service
+	visibilityService        visibility_service.Service
+	collaboratorService      collaborator_service.Service
+	threadStateService       thread_state_service.Service
+	critiqueService          critique_service.Service
 	tokenService             token_service.Service
 	keysService              jwk_service.ServiceCached
 	userService              user_service.Service
+	reputationService        reputation_service.Service
+	badgesService            badges_service.Service
+	leaderboardsService      leaderboards_service.Service
+	reportsService           reports_service.Service
+
+	autoCloseAcceptedSuggestions int
+	autoCloseInactivity          time.Duration
+	restoreWindow                time.Duration
+	purgeAfter                   time.Duration
+	voteRateLimit                int
+	voteRateWindow               time.Duration
+	reactions                    []string
+	downVoteReputation           int64
+	badgesWindow                 time.Duration
+
+	logger zerolog.Logger
 
 	time func() time.Time
 	id   func() uuid.UUID
@@ -68,25 +290,283 @@ func NewProvider(config Config) Provider {
 		improveRequestService:    config.ImproveRequestService,
 		improveSuggestionService: config.ImproveSuggestionService,
 		votesService:             config.VotesService,
+		draftsService:            config.DraftsService,
+		duplicatesService:        config.DuplicatesService,
+		visibilityService:        config.VisibilityService,
+		collaboratorService:      config.CollaboratorService,
+		threadStateService:       config.ThreadStateService,
+		critiqueService:          config.CritiqueService,
 		tokenService:             config.TokenService,
 		keysService:              config.KeysService,
 		userService:              config.UserService,
+		reputationService:        config.ReputationService,
+		badgesService:            config.BadgesService,
+		leaderboardsService:      config.LeaderboardsService,
+		reportsService:           config.ReportsService,
+
+		autoCloseAcceptedSuggestions: config.AutoCloseAcceptedSuggestions,
+		autoCloseInactivity:          config.AutoCloseInactivity,
+		restoreWindow:                config.RestoreWindow,
+		purgeAfter:                   config.PurgeAfter,
+		voteRateLimit:                config.VoteRateLimit,
+		voteRateWindow:               config.VoteRateWindow,
+		reactions:                    config.Reactions,
+		downVoteReputation:           config.DownVoteReputation,
+		badgesWindow:                 config.BadgesWindow,
+
+		logger: config.Logger,
 
 		time: config.Time,
 		id:   config.ID,
 	}
 }
 
-func (provider *providerImpl) ReadImproveRequest(ctx context.Context, id uuid.UUID) ([]*models.ImproveRequest, error) {
+// Flag the revision for moderation if it looks like a copy of a post from another user. The revision is kept
+// either way. It is already saved at this point, so a failure is only logged: revisions without a fingerprint are
+// checked again by the fingerprint backfill.
+func (provider *providerImpl) checkDuplicates(ctx context.Context, revision *models.ForumPostRevision, now time.Time) {
+	if _, err := provider.duplicatesService.Check(ctx, revision, now); err != nil {
+		provider.logger.Error().
+			Err(err).
+			Str("revision", revision.RevisionID.String()).
+			Msg("failed to check revision for duplicates")
+	}
+}
+
+// Ensure the user can read the improvement request, based on its visibility. The user is nil when anonymous. Share
+// tokens only apply to unlisted requests. Hidden requests are reported as missing, so their existence is not leaked.
+func (provider *providerImpl) forceCanViewImproveRequest(ctx context.Context, source uuid.UUID, userID *uuid.UUID, shareToken string) error {
+	access, err := provider.visibilityService.Read(ctx, source)
+	if err != nil {
+		return fmt.Errorf("failed to read visibility of improve request %q: %w", source, err)
+	}
+	if access.Visibility == models.ImproveRequestVisibilityPublic {
+		return nil
+	}
+
+	if userID != nil {
+		ok, err := provider.improveRequestService.IsCreator(ctx, *userID, source, false)
+		if err != nil {
+			return fmt.Errorf("failed to check authors of improve request %q: %w", source, err)
+		}
+		if ok {
+			return nil
+		}
+
+		ok, err = provider.visibilityService.IsInvited(ctx, source, *userID)
+		if err != nil {
+			return fmt.Errorf("failed to check invites of improve request %q: %w", source, err)
+		}
+		if ok {
+			return nil
+		}
+	}
+
+	if access.Visibility == models.ImproveRequestVisibilityUnlisted && shareToken != "" {
+		ok, err := provider.visibilityService.VerifyShareToken(ctx, source, shareToken)
+		if err != nil {
+			return fmt.Errorf("failed to verify share token of improve request %q: %w", source, err)
+		}
+		if ok {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: improve request %q is not visible", validation.ErrNotFound, source)
+}
+
+// Return the role of the user on the improvement request, or an empty role if the user is not a co-author. The
+// creator of the first revision is always an owner, and invitations only count once accepted.
+func (provider *providerImpl) improveRequestRole(ctx context.Context, userID, source uuid.UUID) (models.ImproveRequestCollaboratorRole, error) {
+	ok, err := provider.improveRequestService.IsCreator(ctx, userID, source, true)
+	if err != nil {
+		return "", fmt.Errorf("failed to check creator of improve request %q: %w", source, err)
+	}
+	if ok {
+		return models.ImproveRequestCollaboratorRoleOwner, nil
+	}
+
+	collaborator, err := provider.collaboratorService.Read(ctx, source, userID)
+	if errors.Is(err, validation.ErrNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to check collaborators of improve request %q: %w", source, err)
+	}
+	if collaborator.AcceptedAt == nil {
+		return "", nil
+	}
+
+	return collaborator.Role, nil
+}
+
+// Ensure the thread of the improvement request is in one of the allowed states.
+func (provider *providerImpl) forceImproveRequestState(ctx context.Context, source uuid.UUID, allowed ...models.ImproveRequestState) error {
+	state, err := provider.threadStateService.Read(ctx, source)
+	if err != nil {
+		return fmt.Errorf("failed to read state of improve request %q: %w", source, err)
+	}
+
+	for _, a := range allowed {
+		if state.State == a {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: improve request %q is %s", validation.ErrInvalidCredentials, source, state.State)
+}
+
+// Ensure the user is an owner of the improvement request, and return the ID of its first revision.
+func (provider *providerImpl) forceImproveRequestOwner(ctx context.Context, userID, requestID uuid.UUID) (uuid.UUID, error) {
+	request, err := provider.improveRequestService.Read(ctx, requestID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to fetch improve request %q: %w", requestID, err)
+	}
+
+	role, err := provider.improveRequestRole(ctx, userID, request.Source)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if role != models.ImproveRequestCollaboratorRoleOwner {
+		return uuid.Nil, fmt.Errorf(
+			"%w: user %q is not allowed to manage the post %q",
+			validation.ErrInvalidCredentials, userID, request.Source,
+		)
+	}
+
+	return request.Source, nil
+}
+
+// Ensure the user can read the suggestions matched by a list query. Queries that target a single improvement
+// request require access to it. Other queries are restricted to public requests by the storage layer.
+func (provider *providerImpl) forceCanListImproveSuggestions(ctx context.Context, query models.ImproveSuggestionsList, userID *uuid.UUID, shareToken string) error {
+	var source uuid.UUID
+	switch {
+	case query.SourceID != nil:
+		source = *query.SourceID
+	case query.RequestID != nil:
+		request, err := provider.improveRequestService.Read(ctx, *query.RequestID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch improve request %q: %w", *query.RequestID, err)
+		}
+		source = request.Source
+	default:
+		return nil
+	}
+
+	return provider.forceCanViewImproveRequest(ctx, source, userID, shareToken)
+}
+
+// Ensure the content was not hidden after being reported. Hidden contents are reported as missing, except to their
+// authors, and to the moderators who review them. The user is nil when anonymous.
+func (provider *providerImpl) forceNotHidden(
+	ctx context.Context, target models.ModerationTarget, targetID uuid.UUID, userID *uuid.UUID,
+	isAuthor func() (bool, error),
+) error {
+	reportCase, err := provider.reportsService.ReadCase(ctx, target, targetID)
+	if errors.Is(err, validation.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read report case of %s %q: %w", target, targetID, err)
+	}
+	if reportCase.HiddenAt == nil {
+		return nil
+	}
+
+	if userID != nil {
+		ok, err := isAuthor()
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+
+		ok, err = provider.userService.HasAuthorizations(ctx, *userID, models.UserAuthorizations{
+			{models.UserAuthorizationsModerator},
+		})
+		if err != nil {
+			return fmt.Errorf("unable to check user authorizations: %w", err)
+		}
+		if ok {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s %q is hidden", validation.ErrNotFound, target, targetID)
+}
+
+// Read a suggestion, and ensure the user can read the improvement request it belongs to.
+func (provider *providerImpl) readVisibleImproveSuggestion(ctx context.Context, token, shareToken string, id uuid.UUID) (*models.ImproveSuggestion, error) {
+	claims, err := authentication.OptionalAuthentication(token, provider.tokenService, provider.keysService, provider.time())
+	if err != nil {
+		return nil, err
+	}
+
+	suggestion, err := provider.improveSuggestionService.Read(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read improve suggestion %q: %w", id, err)
+	}
+
+	var userID *uuid.UUID
+	if claims != nil {
+		userID = &claims.Payload.ID
+	}
+
+	if err := provider.forceCanViewImproveRequest(ctx, suggestion.SourceID, userID, shareToken); err != nil {
+		return nil, err
+	}
+
+	err = provider.forceNotHidden(ctx, models.ModerationTargetImproveSuggestion, suggestion.ID, userID, func() (bool, error) {
+		return suggestion.UserID == *userID, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return suggestion, nil
+}
+
+func (provider *providerImpl) ReadImproveRequest(ctx context.Context, token, shareToken string, id uuid.UUID) ([]*models.ImproveRequest, error) {
+	claims, err := authentication.OptionalAuthentication(token, provider.tokenService, provider.keysService, provider.time())
+	if err != nil {
+		return nil, err
+	}
+
 	revisions, err := provider.improveRequestService.ReadRevisions(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch revisions for improve request %q: %w", id, err)
 	}
+	if len(revisions) == 0 {
+		return revisions, nil
+	}
+
+	var userID *uuid.UUID
+	if claims != nil {
+		userID = &claims.Payload.ID
+	}
+
+	if err := provider.forceCanViewImproveRequest(ctx, revisions[0].Source, userID, shareToken); err != nil {
+		return nil, err
+	}
+
+	err = provider.forceNotHidden(ctx, models.ModerationTargetImproveRequest, revisions[0].Source, userID, func() (bool, error) {
+		ok, err := provider.improveRequestService.IsCreator(ctx, *userID, revisions[0].Source, false)
+		if err != nil {
+			return false, fmt.Errorf("failed to check authors of improve request %q: %w", revisions[0].Source, err)
+		}
+
+		return ok, nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return revisions, nil
 }
 
-func (provider *providerImpl) CreateImproveRequest(ctx context.Context, token, title, content string) (*models.ImproveRequest, error) {
+func (provider *providerImpl) CreateImproveRequest(ctx context.Context, token, title, content, language string, tags []uuid.UUID) (*models.ImproveRequest, error) {
 	now := provider.time()
 	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
 	if err != nil {
@@ -103,15 +583,25 @@ func (provider *providerImpl) CreateImproveRequest(ctx context.Context, token, t
 		return nil, validation.NewErrUnauthorized("user email is not validated")
 	}
 
-	request, err := provider.improveRequestService.Create(ctx, claims.Payload.ID, title, content, provider.id(), now)
+	request, err := provider.improveRequestService.Create(ctx, claims.Payload.ID, title, content, language, tags, provider.id(), now)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create improve request %q, for user %q: %w", title, claims.Payload.ID, err)
 	}
 
+	provider.checkDuplicates(ctx, &models.ForumPostRevision{
+		RevisionID: request.ID,
+		PostID:     request.Source,
+		ThreadID:   request.Source,
+		UserID:     request.UserID,
+		Target:     models.ForumPostTargetImproveRequest,
+		CreatedAt:  request.CreatedAt,
+		Content:    request.Content,
+	}, now)
+
 	return request, nil
 }
 
-func (provider *providerImpl) CreateImproveRequestRevision(ctx context.Context, token string, sourceID uuid.UUID, title, content string) (*models.ImproveRequest, error) {
+func (provider *providerImpl) CreateImproveRequestRevision(ctx context.Context, token string, sourceID uuid.UUID, title, content, language string, tags []uuid.UUID) (*models.ImproveRequest, error) {
 	now := provider.time()
 	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
 	if err != nil {
@@ -128,24 +618,38 @@ func (provider *providerImpl) CreateImproveRequestRevision(ctx context.Context,
 		return nil, validation.NewErrUnauthorized("user email is not validated")
 	}
 
-	// Only creator is allowed to edit its own post.
+	// Only co-authors are allowed to edit the post.
 	source, err := provider.improveRequestService.Read(ctx, sourceID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch source improve request %q: %w", sourceID, err)
 	}
 
-	if source.UserID != claims.Payload.ID {
+	role, err := provider.improveRequestRole(ctx, claims.Payload.ID, source.Source)
+	if err != nil {
+		return nil, err
+	}
+	if role == "" {
 		return nil, fmt.Errorf(
 			"%w: user %q is not allowed to create a revision for the post %q (created by %q)",
 			validation.ErrInvalidCredentials, claims.Payload.ID, sourceID, source.UserID,
 		)
 	}
 
-	request, err := provider.improveRequestService.CreateRevision(ctx, claims.Payload.ID, sourceID, title, content, provider.id(), now)
+	request, err := provider.improveRequestService.CreateRevision(ctx, claims.Payload.ID, sourceID, title, content, language, tags, provider.id(), now)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create revision on improve request %q for user %q: %w", source.Title, claims.Payload.ID, err)
 	}
 
+	provider.checkDuplicates(ctx, &models.ForumPostRevision{
+		RevisionID: request.ID,
+		PostID:     request.Source,
+		ThreadID:   request.Source,
+		UserID:     request.UserID,
+		Target:     models.ForumPostTargetImproveRequest,
+		CreatedAt:  request.CreatedAt,
+		Content:    request.Content,
+	}, now)
+
 	return request, nil
 }
 
@@ -156,215 +660,1364 @@ func (provider *providerImpl) DeleteImproveRequest(ctx context.Context, token st
 		return err
 	}
 
-	// Force revision to be from the same user.
+	// Force revision to be from the same user, unless the user owns the request.
 	source, err := provider.improveRequestService.Read(ctx, requestID)
 	if err != nil {
 		return fmt.Errorf("failed to fetch improve request %q: %w", requestID, err)
 	}
 
 	if source.UserID != claims.Payload.ID {
-		return fmt.Errorf(
-			"%w: user %q is not allowed to delete the post %q (created by %q)", validation.ErrInvalidCredentials,
-			claims.Payload.ID, requestID, source.UserID,
-		)
+		role, err := provider.improveRequestRole(ctx, claims.Payload.ID, source.Source)
+		if err != nil {
+			return err
+		}
+		if role != models.ImproveRequestCollaboratorRoleOwner {
+			return fmt.Errorf(
+				"%w: user %q is not allowed to delete the post %q (created by %q)", validation.ErrInvalidCredentials,
+				claims.Payload.ID, requestID, source.UserID,
+			)
+		}
 	}
 
-	if err := provider.improveRequestService.Delete(ctx, requestID); err != nil {
+	if err := provider.improveRequestService.Delete(ctx, requestID, now); err != nil {
 		return fmt.Errorf("failed to delete improve request %q: %w", requestID, err)
 	}
 
 	return nil
 }
 
-func (provider *providerImpl) SearchImproveRequests(ctx context.Context, query models.ImproveRequestSearch, limit, offset int) ([]*models.ImproveRequestPreview, int64, error) {
-	requests, total, err := provider.improveRequestService.Search(ctx, query, limit, offset)
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to search improve requests: %w", err)
+// Ensure a post deleted at the given time can still be restored.
+func (provider *providerImpl) forceRestoreWindow(postID uuid.UUID, deletedAt *time.Time, now time.Time) error {
+	if deletedAt == nil || deletedAt.Before(now.Add(-provider.restoreWindow)) {
+		return fmt.Errorf(
+			"%w: the post %q was deleted more than %s ago", validation.ErrInvalidCredentials,
+			postID, provider.restoreWindow,
+		)
 	}
 
-	return requests, total, nil
+	return nil
 }
 
-func (provider *providerImpl) GetImproveRequestPreviews(ctx context.Context, ids []uuid.UUID) ([]*models.ImproveRequestPreview, error) {
-	requests, err := provider.improveRequestService.GetPreviews(ctx, ids)
+func (provider *providerImpl) RestoreImproveRequest(ctx context.Context, token string, requestID uuid.UUID) error {
+	now := provider.time()
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get improve request previews: %w", err)
+		return err
 	}
 
-	return requests, nil
-}
-
-func (provider *providerImpl) ReadImproveSuggestion(ctx context.Context, id uuid.UUID) (*models.ImproveSuggestion, error) {
-	suggestion, err := provider.improveSuggestionService.Read(ctx, id)
+	deleted, err := provider.improveRequestService.ReadDeleted(ctx, requestID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read improve suggestion %q: %w", id, err)
+		return fmt.Errorf("failed to fetch deleted improve request %q: %w", requestID, err)
 	}
 
-	return suggestion, nil
-}
+	if deleted.UserID != claims.Payload.ID {
+		role, err := provider.improveRequestRole(ctx, claims.Payload.ID, deleted.Source)
+		if err != nil {
+			return err
+		}
+		if role != models.ImproveRequestCollaboratorRoleOwner {
+			return fmt.Errorf(
+				"%w: user %q is not allowed to restore the post %q (created by %q)", validation.ErrInvalidCredentials,
+				claims.Payload.ID, requestID, deleted.UserID,
+			)
+		}
+	}
 
-func (provider *providerImpl) CreateImproveSuggestion(ctx context.Context, token string, requestID, sourceID uuid.UUID, title, content string) (*models.ImproveSuggestion, error) {
-	now := provider.time()
-	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
-	if err != nil {
-		return nil, err
+	// Once the whole request is deleted, its revisions can only be restored along with the first one.
+	if deleted.ID != deleted.Source {
+		if _, err := provider.improveRequestService.Read(ctx, deleted.Source); err != nil {
+			return fmt.Errorf("failed to fetch improve request %q: %w", deleted.Source, err)
+		}
 	}
 
-	suggestion, err := provider.improveSuggestionService.Create(
-		ctx, &models.ImproveSuggestionUpsert{
-			RequestID: requestID,
-			Title:     title,
-			Content:   content,
-		}, claims.Payload.ID, sourceID, provider.id(), now,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create improve suggestion %q, on improve request %q: %w", title, requestID, err)
+	if err := provider.forceRestoreWindow(requestID, deleted.DeletedAt, now); err != nil {
+		return err
 	}
 
-	return suggestion, nil
+	if err := provider.improveRequestService.Restore(ctx, requestID, now.Add(-provider.restoreWindow)); err != nil {
+		return fmt.Errorf("failed to restore improve request %q: %w", requestID, err)
+	}
+
+	return nil
 }
 
-func (provider *providerImpl) UpdateImproveSuggestion(ctx context.Context, token string, postID, requestID uuid.UUID, title, content string) (*models.ImproveSuggestion, error) {
-	now := provider.time()
-	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+func (provider *providerImpl) ListImproveRequestCollaborators(ctx context.Context, token string, requestID uuid.UUID) ([]*models.ImproveRequestCollaborator, error) {
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, provider.time())
 	if err != nil {
 		return nil, err
 	}
 
-	// Force suggestion to be from the same user.
-	source, err := provider.improveSuggestionService.Read(ctx, postID)
+	request, err := provider.improveRequestService.Read(ctx, requestID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch source improve suggestion %q: %w", postID, err)
+		return nil, fmt.Errorf("failed to fetch improve request %q: %w", requestID, err)
 	}
 
-	if source.UserID != claims.Payload.ID {
+	role, err := provider.improveRequestRole(ctx, claims.Payload.ID, request.Source)
+	if err != nil {
+		return nil, err
+	}
+	if role == "" {
 		return nil, fmt.Errorf(
-			"%w: user %q is not allowed to update improve suggestion %q (created by %q)",
-			validation.ErrInvalidCredentials, claims.Payload.ID, postID, source.UserID,
+			"%w: user %q is not a collaborator of the post %q",
+			validation.ErrInvalidCredentials, claims.Payload.ID, request.Source,
 		)
 	}
 
-	suggestion, err := provider.improveSuggestionService.Update(
-		ctx, &models.ImproveSuggestionUpsert{
-			RequestID: requestID,
-			Title:     title,
-			Content:   content,
-		}, postID, now,
-	)
+	collaborators, err := provider.collaboratorService.List(ctx, request.Source)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update improve suggestion %q for user %q: %w", source.ID, claims.Payload.ID, err)
+		return nil, fmt.Errorf("failed to list collaborators of improve request %q: %w", request.Source, err)
 	}
 
-	return suggestion, nil
+	return collaborators, nil
 }
 
-func (provider *providerImpl) DeleteImproveSuggestion(ctx context.Context, token string, id uuid.UUID) error {
+func (provider *providerImpl) InviteImproveRequestCollaborator(ctx context.Context, token string, requestID, userID uuid.UUID, role models.ImproveRequestCollaboratorRole) (*models.ImproveRequestCollaborator, error) {
 	now := provider.time()
 	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Force suggestion to be from the same user.
-	source, err := provider.improveSuggestionService.Read(ctx, id)
+	source, err := provider.forceImproveRequestOwner(ctx, claims.Payload.ID, requestID)
 	if err != nil {
-		return fmt.Errorf("failed to fetch improve suggestion %q: %w", id, err)
+		return nil, err
 	}
 
-	if source.UserID != claims.Payload.ID {
-		return fmt.Errorf(
-			"%w: user %q is not allowed to delete the post %q (created by %q)", validation.ErrInvalidCredentials,
-			claims.Payload.ID, id, source.UserID,
+	// The creator is already an owner, and cannot be demoted through an invitation.
+	isCreator, err := provider.improveRequestService.IsCreator(ctx, userID, source, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check creator of improve request %q: %w", source, err)
+	}
+	if isCreator {
+		return nil, fmt.Errorf(
+			"%w: user %q is the creator of the post %q", validation.ErrInvalidEntity, userID, source,
 		)
 	}
 
-	if err := provider.improveSuggestionService.Delete(ctx, id); err != nil {
-		return fmt.Errorf("failed to delete improve suggestion %q: %w", id, err)
+	collaborator, err := provider.collaboratorService.Invite(ctx, source, userID, claims.Payload.ID, role, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invite user %q to collaborate on improve request %q: %w", userID, source, err)
 	}
 
-	return nil
+	return collaborator, nil
 }
 
-func (provider *providerImpl) ListImproveSuggestions(ctx context.Context, query models.ImproveSuggestionsList, limit, offset int) ([]*models.ImproveSuggestion, int64, error) {
-	suggestions, total, err := provider.improveSuggestionService.List(ctx, query, limit, offset)
+func (provider *providerImpl) UpdateImproveRequestCollaborator(ctx context.Context, token string, requestID, userID uuid.UUID, role models.ImproveRequestCollaboratorRole) (*models.ImproveRequestCollaborator, error) {
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, provider.time())
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list improve suggestions: %w", err)
+		return nil, err
 	}
 
-	return suggestions, total, nil
-}
+	source, err := provider.forceImproveRequestOwner(ctx, claims.Payload.ID, requestID)
+	if err != nil {
+		return nil, err
+	}
 
-func (provider *providerImpl) GetImproveSuggestionPreviews(ctx context.Context, ids []uuid.UUID) ([]*models.ImproveSuggestion, error) {
-	suggestions, err := provider.improveSuggestionService.GetPreviews(ctx, ids)
+	collaborator, err := provider.collaboratorService.UpdateRole(ctx, source, userID, role)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get improve suggestions: %w", err)
+		return nil, fmt.Errorf("failed to update role of user %q on improve request %q: %w", userID, source, err)
 	}
 
-	return suggestions, nil
+	return collaborator, nil
 }
 
-func (provider *providerImpl) Vote(ctx context.Context, token string, postID uuid.UUID, target models.VoteTarget, vote models.VoteValue) (models.VoteValue, error) {
-	now := provider.time()
-	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+func (provider *providerImpl) RemoveImproveRequestCollaborator(ctx context.Context, token string, requestID, userID uuid.UUID) error {
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, provider.time())
 	if err != nil {
-		return models.NoVote, err
+		return err
 	}
 
-	// Cannot vote own post.
-	switch target {
-	case models.VoteTargetImproveSuggestion:
-		isCreator, err := provider.improveSuggestionService.IsCreator(ctx, claims.Payload.ID, postID)
+	var source uuid.UUID
+	if userID == claims.Payload.ID {
+		request, err := provider.improveRequestService.Read(ctx, requestID)
 		if err != nil {
-			return models.NoVote, fmt.Errorf(
-				"failed to check if user %q is the creator of improve suggestion %q: %w",
-				claims.Payload.ID, postID, err,
-			)
+			return fmt.Errorf("failed to fetch improve request %q: %w", requestID, err)
 		}
 
-		if isCreator {
-			return models.NoVote, fmt.Errorf(
-				"%w: user %q cannot vote on its own improve suggestion %q",
-				validation.ErrInvalidEntity, claims.Payload.ID, postID,
-			)
-		}
-	case models.VoteTargetImproveRequest:
-		// Cannot vote an improvement request, if you are the creator or one of the editors.
-		isCreator, err := provider.improveRequestService.IsCreator(ctx, claims.Payload.ID, postID, false)
+		source = request.Source
+	} else {
+		source, err = provider.forceImproveRequestOwner(ctx, claims.Payload.ID, requestID)
 		if err != nil {
-			return models.NoVote, fmt.Errorf(
-				"failed to check if user %q is a creator of improve request %q: %w",
-				claims.Payload.ID, postID, err,
-			)
-		}
-
-		if isCreator {
-			return models.NoVote, fmt.Errorf(
-				"%w: user %q cannot vote on its own improve request %q",
-				validation.ErrInvalidEntity, claims.Payload.ID, postID,
-			)
+			return err
 		}
 	}
 
-	res, err := provider.votesService.Vote(
-		ctx, postID, claims.Payload.ID, target, vote, now,
-	)
-	if err != nil {
-		return models.NoVote, fmt.Errorf("failed to vote for %q %q, for user %q: %w", target, postID, claims.Payload.ID, err)
+	if err := provider.collaboratorService.Remove(ctx, source, userID); err != nil {
+		return fmt.Errorf("failed to remove user %q from improve request %q: %w", userID, source, err)
 	}
 
-	return res, nil
+	return nil
 }
 
-func (provider *providerImpl) HasVoted(ctx context.Context, token string, postID uuid.UUID, target models.VoteTarget) (models.VoteValue, error) {
-	now := provider.time()
-	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+func (provider *providerImpl) ListImproveRequestCollaborationInvitations(ctx context.Context, token string) ([]*models.ImproveRequestCollaborator, error) {
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, provider.time())
 	if err != nil {
-		return models.NoVote, err
+		return nil, err
 	}
 
-	res, err := provider.votesService.HasVoted(ctx, postID, claims.Payload.ID, target)
+	invitations, err := provider.collaboratorService.ListPending(ctx, claims.Payload.ID)
 	if err != nil {
-		return models.NoVote, fmt.Errorf("failed to check vote for %q %q, for user %q: %w", target, postID, claims.Payload.ID, err)
+		return nil, fmt.Errorf("failed to list collaboration invitations of user %q: %w", claims.Payload.ID, err)
+	}
+
+	return invitations, nil
+}
+
+func (provider *providerImpl) AcceptImproveRequestCollaboration(ctx context.Context, token string, requestID uuid.UUID) (*models.ImproveRequestCollaborator, error) {
+	now := provider.time()
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := provider.improveRequestService.Read(ctx, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch improve request %q: %w", requestID, err)
+	}
+
+	collaborator, err := provider.collaboratorService.Accept(ctx, request.Source, claims.Payload.ID, now)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to accept collaboration on improve request %q for user %q: %w", request.Source, claims.Payload.ID, err,
+		)
+	}
+
+	return collaborator, nil
+}
+
+func (provider *providerImpl) ReadImproveRequestState(ctx context.Context, token, shareToken string, requestID uuid.UUID) (*models.ImproveRequestThreadState, error) {
+	claims, err := authentication.OptionalAuthentication(token, provider.tokenService, provider.keysService, provider.time())
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := provider.improveRequestService.Read(ctx, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch improve request %q: %w", requestID, err)
+	}
+
+	var userID *uuid.UUID
+	if claims != nil {
+		userID = &claims.Payload.ID
+	}
+
+	if err := provider.forceCanViewImproveRequest(ctx, request.Source, userID, shareToken); err != nil {
+		return nil, err
+	}
+
+	state, err := provider.threadStateService.Read(ctx, request.Source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read state of improve request %q: %w", request.Source, err)
+	}
+
+	return state, nil
+}
+
+func (provider *providerImpl) UpdateImproveRequestState(ctx context.Context, token string, requestID uuid.UUID, state models.ImproveRequestState, reason string) (*models.ImproveRequestThreadState, error) {
+	now := provider.time()
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// Locking is a moderation action.
+	if err := validation.CheckRestricted(
+		"state", state,
+		models.ImproveRequestStateOpen, models.ImproveRequestStateClosed, models.ImproveRequestStateArchived,
+	); err != nil {
+		return nil, err
+	}
+
+	source, err := provider.forceImproveRequestOwner(ctx, claims.Payload.ID, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := provider.forceImproveRequestState(
+		ctx, source,
+		models.ImproveRequestStateOpen, models.ImproveRequestStateClosed, models.ImproveRequestStateArchived,
+	); err != nil {
+		return nil, err
+	}
+
+	updated, err := provider.threadStateService.Update(ctx, source, state, &claims.Payload.ID, reason, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update state of improve request %q: %w", source, err)
+	}
+
+	return updated, nil
+}
+
+func (provider *providerImpl) CloseInactiveImproveRequests(ctx context.Context, auth *authentication.BackendServiceAuth) error {
+	if err := authentication.ForceBackendService(ctx, auth); err != nil {
+		return err
+	}
+
+	if _, err := provider.threadStateService.AutoClose(
+		ctx, provider.autoCloseAcceptedSuggestions, provider.autoCloseInactivity, provider.time(),
+	); err != nil {
+		return fmt.Errorf("failed to close inactive improve requests: %w", err)
+	}
+
+	return nil
+}
+
+func (provider *providerImpl) AwardBadges(ctx context.Context, auth *authentication.BackendServiceAuth) error {
+	if err := authentication.ForceBackendService(ctx, auth); err != nil {
+		return err
+	}
+
+	now := provider.time()
+	userIDs, err := provider.badgesService.ListActive(ctx, now.Add(-provider.badgesWindow))
+	if err != nil {
+		return fmt.Errorf("failed to award badges: %w", err)
+	}
+
+	for _, userID := range userIDs {
+		if _, err := provider.badgesService.Evaluate(ctx, userID, now); err != nil {
+			return fmt.Errorf("failed to award badges: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (provider *providerImpl) ReadImproveRequestVisibility(ctx context.Context, token string, requestID uuid.UUID) (*models.ImproveRequestAccess, error) {
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, provider.time())
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := provider.forceImproveRequestOwner(ctx, claims.Payload.ID, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := provider.visibilityService.Read(ctx, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read visibility of improve request %q: %w", source, err)
+	}
+
+	return access, nil
+}
+
+func (provider *providerImpl) UpdateImproveRequestVisibility(ctx context.Context, token string, requestID uuid.UUID, visibility models.ImproveRequestVisibility) (*models.ImproveRequestAccess, error) {
+	now := provider.time()
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := provider.forceImproveRequestOwner(ctx, claims.Payload.ID, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := provider.visibilityService.Update(ctx, source, visibility, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update visibility of improve request %q: %w", source, err)
+	}
+
+	return access, nil
+}
+
+func (provider *providerImpl) ListImproveRequestInvites(ctx context.Context, token string, requestID uuid.UUID, limit, offset int) ([]*models.ImproveRequestInvite, int64, error) {
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, provider.time())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	source, err := provider.forceImproveRequestOwner(ctx, claims.Payload.ID, requestID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	invites, total, err := provider.visibilityService.ListInvites(ctx, source, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list invites of improve request %q: %w", source, err)
+	}
+
+	return invites, total, nil
+}
+
+func (provider *providerImpl) InviteToImproveRequest(ctx context.Context, token string, requestID, userID uuid.UUID) (*models.ImproveRequestInvite, error) {
+	now := provider.time()
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := provider.forceImproveRequestOwner(ctx, claims.Payload.ID, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	invite, err := provider.visibilityService.Invite(ctx, source, userID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invite user %q to improve request %q: %w", userID, source, err)
+	}
+
+	return invite, nil
+}
+
+func (provider *providerImpl) UninviteFromImproveRequest(ctx context.Context, token string, requestID, userID uuid.UUID) error {
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, provider.time())
+	if err != nil {
+		return err
+	}
+
+	source, err := provider.forceImproveRequestOwner(ctx, claims.Payload.ID, requestID)
+	if err != nil {
+		return err
+	}
+
+	if err := provider.visibilityService.Uninvite(ctx, source, userID); err != nil {
+		return fmt.Errorf("failed to uninvite user %q from improve request %q: %w", userID, source, err)
+	}
+
+	return nil
+}
+
+func (provider *providerImpl) ListImproveRequestShareTokens(ctx context.Context, token string, requestID uuid.UUID) ([]*models.ImproveRequestShareToken, error) {
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, provider.time())
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := provider.forceImproveRequestOwner(ctx, claims.Payload.ID, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	shareTokens, err := provider.visibilityService.ListShareTokens(ctx, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list share tokens of improve request %q: %w", source, err)
+	}
+
+	return shareTokens, nil
+}
+
+func (provider *providerImpl) CreateImproveRequestShareToken(ctx context.Context, token string, requestID uuid.UUID) (*models.ImproveRequestShareLink, error) {
+	now := provider.time()
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := provider.forceImproveRequestOwner(ctx, claims.Payload.ID, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := provider.visibilityService.CreateShareToken(ctx, source, provider.id(), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create share token for improve request %q: %w", source, err)
+	}
+
+	return link, nil
+}
+
+func (provider *providerImpl) RevokeImproveRequestShareToken(ctx context.Context, token string, requestID, shareTokenID uuid.UUID) error {
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, provider.time())
+	if err != nil {
+		return err
+	}
+
+	source, err := provider.forceImproveRequestOwner(ctx, claims.Payload.ID, requestID)
+	if err != nil {
+		return err
+	}
+
+	if err := provider.visibilityService.RevokeShareToken(ctx, source, shareTokenID); err != nil {
+		return fmt.Errorf("failed to revoke share token %q of improve request %q: %w", shareTokenID, source, err)
+	}
+
+	return nil
+}
+
+// Gather the aspects requested on an improvement request, and the summary of the ratings they received.
+func (provider *providerImpl) readCritique(ctx context.Context, source uuid.UUID) (*models.ImproveRequestCritique, error) {
+	aspects, err := provider.critiqueService.ReadAspects(ctx, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read aspects of improve request %q: %w", source, err)
+	}
+
+	ratings, err := provider.critiqueService.Summarize(ctx, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize ratings of improve request %q: %w", source, err)
+	}
+
+	return &models.ImproveRequestCritique{Source: source, Aspects: aspects, Ratings: ratings}, nil
+}
+
+func (provider *providerImpl) ReadImproveRequestCritique(ctx context.Context, token, shareToken string, requestID uuid.UUID) (*models.ImproveRequestCritique, error) {
+	claims, err := authentication.OptionalAuthentication(token, provider.tokenService, provider.keysService, provider.time())
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := provider.improveRequestService.Read(ctx, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch improve request %q: %w", requestID, err)
+	}
+
+	var userID *uuid.UUID
+	if claims != nil {
+		userID = &claims.Payload.ID
+	}
+
+	if err := provider.forceCanViewImproveRequest(ctx, request.Source, userID, shareToken); err != nil {
+		return nil, err
+	}
+
+	return provider.readCritique(ctx, request.Source)
+}
+
+func (provider *providerImpl) UpdateImproveRequestAspects(ctx context.Context, token string, requestID uuid.UUID, aspects []models.CritiqueAspect) (*models.ImproveRequestCritique, error) {
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, provider.time())
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := provider.forceImproveRequestOwner(ctx, claims.Payload.ID, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := provider.forceImproveRequestState(
+		ctx, source, models.ImproveRequestStateOpen, models.ImproveRequestStateClosed,
+	); err != nil {
+		return nil, err
+	}
+
+	if _, err := provider.critiqueService.UpdateAspects(ctx, source, aspects); err != nil {
+		return nil, fmt.Errorf("failed to update aspects of improve request %q: %w", source, err)
+	}
+
+	return provider.readCritique(ctx, source)
+}
+
+func (provider *providerImpl) ReadImproveSuggestionRatings(ctx context.Context, token, shareToken string, id uuid.UUID) ([]*models.ImproveSuggestionRating, error) {
+	if _, err := provider.readVisibleImproveSuggestion(ctx, token, shareToken, id); err != nil {
+		return nil, err
+	}
+
+	ratings, err := provider.critiqueService.ReadRatings(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ratings of improve suggestion %q: %w", id, err)
+	}
+
+	return ratings, nil
+}
+
+func (provider *providerImpl) RateImproveSuggestion(ctx context.Context, token string, id uuid.UUID, ratings []*models.ImproveSuggestionRating) ([]*models.ImproveSuggestionRating, error) {
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, provider.time())
+	if err != nil {
+		return nil, err
+	}
+
+	suggestion, err := provider.improveSuggestionService.Read(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch improve suggestion %q: %w", id, err)
+	}
+
+	if suggestion.UserID != claims.Payload.ID {
+		return nil, fmt.Errorf(
+			"%w: user %q is not allowed to attach ratings to improve suggestion %q (created by %q)",
+			validation.ErrInvalidCredentials, claims.Payload.ID, id, suggestion.UserID,
+		)
+	}
+
+	if err := provider.forceImproveRequestState(
+		ctx, suggestion.SourceID, models.ImproveRequestStateOpen, models.ImproveRequestStateClosed,
+	); err != nil {
+		return nil, err
+	}
+
+	// Only the aspects requested by the owners of the request can be rated.
+	aspects, err := provider.critiqueService.ReadAspects(ctx, suggestion.SourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read aspects of improve request %q: %w", suggestion.SourceID, err)
+	}
+
+	for _, rating := range ratings {
+		if rating == nil {
+			continue
+		}
+		if err := validation.CheckRestricted("aspect", rating.Aspect, aspects...); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := provider.critiqueService.Rate(ctx, id, ratings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rate improve suggestion %q: %w", id, err)
+	}
+
+	return res, nil
+}
+
+// Ensure the user validated its account, which is required to publish content.
+func (provider *providerImpl) forceAccountValidated(ctx context.Context, userID uuid.UUID) error {
+	ok, err := provider.userService.HasAuthorizations(ctx, userID, models.UserAuthorizations{
+		{models.UserAuthorizationsAccountValidated},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to check user authorizations: %w", err)
+	}
+	if !ok {
+		return validation.NewErrUnauthorized("user email is not validated")
+	}
+
+	return nil
+}
+
+// Read a draft, and ensure it belongs to the given user.
+func (provider *providerImpl) readOwnDraft(ctx context.Context, userID, id uuid.UUID) (*models.ImproveRequestDraft, error) {
+	draft, err := provider.draftsService.Read(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read draft %q: %w", id, err)
+	}
+
+	if draft.UserID != userID {
+		return nil, fmt.Errorf(
+			"%w: user %q is not allowed to access the draft %q (created by %q)",
+			validation.ErrInvalidCredentials, userID, id, draft.UserID,
+		)
+	}
+
+	return draft, nil
+}
+
+// Create the improvement request of a draft, then remove the draft.
+func (provider *providerImpl) publishDraft(ctx context.Context, draft *models.ImproveRequestDraft, now time.Time) (*models.ImproveRequest, error) {
+	request, err := provider.improveRequestService.Create(
+		ctx, draft.UserID, draft.Title, draft.Content, draft.Language, draft.Tags, draft.ID, now,
+	)
+	// The request takes the ID of the draft. If it already exists, a previous attempt published the draft but failed
+	// to remove it, so the publication resumes from there.
+	if errors.Is(err, validation.ErrUniqConstraintViolation) {
+		published, readErr := provider.improveRequestService.Read(ctx, draft.ID)
+		if readErr == nil && published.UserID == draft.UserID {
+			request, err = published, nil
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to publish draft %q, for user %q: %w", draft.ID, draft.UserID, err)
+	}
+
+	if err := provider.draftsService.Delete(ctx, draft.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete published draft %q: %w", draft.ID, err)
+	}
+
+	provider.checkDuplicates(ctx, &models.ForumPostRevision{
+		RevisionID: request.ID,
+		PostID:     request.Source,
+		ThreadID:   request.Source,
+		UserID:     request.UserID,
+		Target:     models.ForumPostTargetImproveRequest,
+		CreatedAt:  request.CreatedAt,
+		Content:    request.Content,
+	}, now)
+
+	return request, nil
+}
+
+func (provider *providerImpl) ReadImproveRequestDraft(ctx context.Context, token string, id uuid.UUID) (*models.ImproveRequestDraft, error) {
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, provider.time())
+	if err != nil {
+		return nil, err
+	}
+
+	return provider.readOwnDraft(ctx, claims.Payload.ID, id)
+}
+
+func (provider *providerImpl) ListImproveRequestDrafts(ctx context.Context, token string, limit, offset int) ([]*models.ImproveRequestDraft, int64, error) {
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, provider.time())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	drafts, total, err := provider.draftsService.List(ctx, claims.Payload.ID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list drafts for user %q: %w", claims.Payload.ID, err)
+	}
+
+	return drafts, total, nil
+}
+
+func (provider *providerImpl) CreateImproveRequestDraft(ctx context.Context, token string, data *models.ImproveRequestDraftUpsert) (*models.ImproveRequestDraft, error) {
+	now := provider.time()
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// Scheduled drafts are published as is.
+	if data != nil && data.PublishAt != nil {
+		if err := provider.forceAccountValidated(ctx, claims.Payload.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	draft, err := provider.draftsService.Create(ctx, data, claims.Payload.ID, provider.id(), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create draft for user %q: %w", claims.Payload.ID, err)
+	}
+
+	return draft, nil
+}
+
+func (provider *providerImpl) UpdateImproveRequestDraft(ctx context.Context, token string, id uuid.UUID, data *models.ImproveRequestDraftUpsert) (*models.ImproveRequestDraft, error) {
+	now := provider.time()
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := provider.readOwnDraft(ctx, claims.Payload.ID, id); err != nil {
+		return nil, err
+	}
+
+	if data != nil && data.PublishAt != nil {
+		if err := provider.forceAccountValidated(ctx, claims.Payload.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	draft, err := provider.draftsService.Update(ctx, data, id, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update draft %q for user %q: %w", id, claims.Payload.ID, err)
+	}
+
+	return draft, nil
+}
+
+func (provider *providerImpl) DeleteImproveRequestDraft(ctx context.Context, token string, id uuid.UUID) error {
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, provider.time())
+	if err != nil {
+		return err
+	}
+
+	if _, err := provider.readOwnDraft(ctx, claims.Payload.ID, id); err != nil {
+		return err
+	}
+
+	if err := provider.draftsService.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete draft %q: %w", id, err)
+	}
+
+	return nil
+}
+
+func (provider *providerImpl) PublishImproveRequestDraft(ctx context.Context, token string, id uuid.UUID) (*models.ImproveRequest, error) {
+	now := provider.time()
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := provider.forceAccountValidated(ctx, claims.Payload.ID); err != nil {
+		return nil, err
+	}
+
+	draft, err := provider.readOwnDraft(ctx, claims.Payload.ID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return provider.publishDraft(ctx, draft, now)
+}
+
+func (provider *providerImpl) PublishScheduledImproveRequestDrafts(ctx context.Context, auth *authentication.BackendServiceAuth) error {
+	if err := authentication.ForceBackendService(ctx, auth); err != nil {
+		return err
+	}
+
+	now := provider.time()
+	for {
+		drafts, err := provider.draftsService.ListScheduled(ctx, now, ScheduledDraftsBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list scheduled drafts: %w", err)
+		}
+
+		for _, draft := range drafts {
+			_, err := provider.publishDraft(ctx, draft, now)
+			if err == nil {
+				continue
+			}
+			// The draft was valid when scheduled, but something changed since (for example, one of its tags was
+			// removed). Leave it to its author, rather than retrying forever.
+			if !errors.Is(err, validation.ErrInvalidEntity) {
+				return err
+			}
+
+			if _, err := provider.draftsService.Unschedule(ctx, draft.ID, now); err != nil {
+				return fmt.Errorf("failed to unschedule draft %q: %w", draft.ID, err)
+			}
+		}
+
+		if len(drafts) < ScheduledDraftsBatchSize {
+			return nil
+		}
+	}
+}
+
+func (provider *providerImpl) SearchImproveRequests(ctx context.Context, query models.ImproveRequestSearch, limit, offset int) ([]*models.ImproveRequestPreview, int64, error) {
+	requests, total, err := provider.improveRequestService.Search(ctx, query, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search improve requests: %w", err)
+	}
+
+	return requests, total, nil
+}
+
+func (provider *providerImpl) SearchImproveRequestsAfter(ctx context.Context, query models.ImproveRequestSearch, cursor string, limit int) ([]*models.ImproveRequestPreview, string, error) {
+	requests, next, err := provider.improveRequestService.SearchAfter(ctx, query, cursor, limit)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to search improve requests: %w", err)
+	}
+
+	return requests, next, nil
+}
+
+func (provider *providerImpl) GetImproveRequestSearchFacets(ctx context.Context, query models.ImproveRequestSearch) (*models.ImproveRequestSearchFacets, error) {
+	facets, err := provider.improveRequestService.Facets(ctx, query, provider.time())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get improve requests search facets: %w", err)
+	}
+
+	return facets, nil
+}
+
+func (provider *providerImpl) GetRelatedImproveRequests(ctx context.Context, token, shareToken string, id uuid.UUID, limit int) ([]*models.ImproveRequestPreview, error) {
+	now := provider.time()
+	claims, err := authentication.OptionalAuthentication(token, provider.tokenService, provider.keysService, now)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := provider.improveRequestService.Read(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch improve request %q: %w", id, err)
+	}
+
+	var userID *uuid.UUID
+	if claims != nil {
+		userID = &claims.Payload.ID
+	}
+
+	// The results depend on the content of the request, so they are only shown to the users who can read it.
+	if err := provider.forceCanViewImproveRequest(ctx, request.Source, userID, shareToken); err != nil {
+		return nil, err
+	}
+
+	requests, err := provider.improveRequestService.Related(ctx, id, limit, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get related improve requests: %w", err)
+	}
+
+	return requests, nil
+}
+
+func (provider *providerImpl) SearchForum(ctx context.Context, query, language string, limit, offset int) (*models.ForumSearchResults, error) {
+	requests, requestsTotal, err := provider.improveRequestService.Search(
+		ctx, models.ImproveRequestSearch{Query: query, Language: language}, limit, offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search improve requests: %w", err)
+	}
+
+	suggestions, suggestionsTotal, err := provider.improveSuggestionService.List(
+		ctx, models.ImproveSuggestionsList{Query: query, Language: language}, limit, offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search improve suggestions: %w", err)
+	}
+
+	results := &models.ForumSearchResults{
+		ImproveRequests:         requests,
+		ImproveRequestsTotal:    requestsTotal,
+		ImproveSuggestions:      suggestions,
+		ImproveSuggestionsTotal: suggestionsTotal,
+	}
+
+	if query != "" && requestsTotal == 0 && suggestionsTotal == 0 {
+		results.DidYouMean, err = provider.GetImproveRequestSearchSuggestions(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+func (provider *providerImpl) GetImproveRequestSearchSuggestions(ctx context.Context, query string) ([]string, error) {
+	suggestions, err := provider.improveRequestService.Suggest(ctx, query, SearchSuggestionsLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get improve requests search suggestions: %w", err)
+	}
+
+	return suggestions, nil
+}
+
+func (provider *providerImpl) RefreshImproveRequestRankings(ctx context.Context, auth *authentication.BackendServiceAuth) error {
+	if err := authentication.ForceBackendService(ctx, auth); err != nil {
+		return err
+	}
+
+	return provider.improveRequestService.RefreshRankings(ctx)
+}
+
+func (provider *providerImpl) ListLeaderboard(ctx context.Context, board models.Leaderboard, period models.LeaderboardPeriod, limit, offset int) ([]*models.LeaderboardEntry, int64, error) {
+	return provider.leaderboardsService.List(ctx, board, period, limit, offset)
+}
+
+func (provider *providerImpl) RefreshLeaderboards(ctx context.Context, auth *authentication.BackendServiceAuth) error {
+	if err := authentication.ForceBackendService(ctx, auth); err != nil {
+		return err
+	}
+
+	return provider.leaderboardsService.Refresh(ctx)
+}
+
+func (provider *providerImpl) GetImproveRequestPreviews(ctx context.Context, token string, ids []uuid.UUID) ([]*models.ImproveRequestPreview, error) {
+	claims, err := authentication.OptionalAuthentication(token, provider.tokenService, provider.keysService, provider.time())
+	if err != nil {
+		return nil, err
+	}
+
+	var viewerID *uuid.UUID
+	if claims != nil {
+		viewerID = &claims.Payload.ID
+	}
+
+	requests, err := provider.improveRequestService.GetPreviews(ctx, ids, viewerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get improve request previews: %w", err)
+	}
+
+	return requests, nil
+}
+
+func (provider *providerImpl) ReadImproveSuggestion(ctx context.Context, token, shareToken string, id uuid.UUID) (*models.ImproveSuggestion, error) {
+	return provider.readVisibleImproveSuggestion(ctx, token, shareToken, id)
+}
+
+func (provider *providerImpl) ReadImproveSuggestionRevisions(ctx context.Context, token, shareToken string, id uuid.UUID) ([]*models.ImproveSuggestionRevision, error) {
+	if _, err := provider.readVisibleImproveSuggestion(ctx, token, shareToken, id); err != nil {
+		return nil, err
+	}
+
+	revisions, err := provider.improveSuggestionService.ReadRevisions(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch revisions for improve suggestion %q: %w", id, err)
+	}
+
+	return revisions, nil
+}
+
+func (provider *providerImpl) CreateImproveSuggestion(ctx context.Context, token, shareToken string, requestID, sourceID uuid.UUID, title, content string) (*models.ImproveSuggestion, error) {
+	now := provider.time()
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := provider.forceCanViewImproveRequest(ctx, sourceID, &claims.Payload.ID, shareToken); err != nil {
+		return nil, err
+	}
+	if err := provider.forceImproveRequestState(ctx, sourceID, models.ImproveRequestStateOpen); err != nil {
+		return nil, err
+	}
+
+	suggestion, err := provider.improveSuggestionService.Create(
+		ctx, &models.ImproveSuggestionUpsert{
+			RequestID: requestID,
+			Title:     title,
+			Content:   content,
+		}, claims.Payload.ID, sourceID, provider.id(), provider.id(), now,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create improve suggestion %q, on improve request %q: %w", title, requestID, err)
+	}
+
+	provider.checkDuplicates(ctx, &models.ForumPostRevision{
+		RevisionID: suggestion.RevisionID,
+		PostID:     suggestion.ID,
+		ThreadID:   suggestion.SourceID,
+		UserID:     suggestion.UserID,
+		Target:     models.ForumPostTargetImproveSuggestion,
+		CreatedAt:  now,
+		Content:    suggestion.Content,
+	}, now)
+
+	return suggestion, nil
+}
+
+func (provider *providerImpl) UpdateImproveSuggestion(ctx context.Context, token string, postID, requestID uuid.UUID, title, content string) (*models.ImproveSuggestion, error) {
+	now := provider.time()
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// Force suggestion to be from the same user.
+	source, err := provider.improveSuggestionService.Read(ctx, postID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch source improve suggestion %q: %w", postID, err)
+	}
+
+	if source.UserID != claims.Payload.ID {
+		return nil, fmt.Errorf(
+			"%w: user %q is not allowed to update improve suggestion %q (created by %q)",
+			validation.ErrInvalidCredentials, claims.Payload.ID, postID, source.UserID,
+		)
+	}
+
+	if err := provider.forceImproveRequestState(
+		ctx, source.SourceID, models.ImproveRequestStateOpen, models.ImproveRequestStateClosed,
+	); err != nil {
+		return nil, err
+	}
+
+	suggestion, err := provider.improveSuggestionService.Update(
+		ctx, &models.ImproveSuggestionUpsert{
+			RequestID: requestID,
+			Title:     title,
+			Content:   content,
+		}, postID, provider.id(), now,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update improve suggestion %q for user %q: %w", source.ID, claims.Payload.ID, err)
+	}
+
+	provider.checkDuplicates(ctx, &models.ForumPostRevision{
+		RevisionID: suggestion.RevisionID,
+		PostID:     suggestion.ID,
+		ThreadID:   suggestion.SourceID,
+		UserID:     suggestion.UserID,
+		Target:     models.ForumPostTargetImproveSuggestion,
+		CreatedAt:  now,
+		Content:    suggestion.Content,
+	}, now)
+
+	return suggestion, nil
+}
+
+func (provider *providerImpl) DeleteImproveSuggestion(ctx context.Context, token string, id uuid.UUID) error {
+	now := provider.time()
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+	if err != nil {
+		return err
+	}
+
+	// Force suggestion to be from the same user.
+	source, err := provider.improveSuggestionService.Read(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to fetch improve suggestion %q: %w", id, err)
+	}
+
+	if source.UserID != claims.Payload.ID {
+		return fmt.Errorf(
+			"%w: user %q is not allowed to delete the post %q (created by %q)", validation.ErrInvalidCredentials,
+			claims.Payload.ID, id, source.UserID,
+		)
+	}
+
+	if err := provider.improveSuggestionService.Delete(ctx, id, now); err != nil {
+		return fmt.Errorf("failed to delete improve suggestion %q: %w", id, err)
+	}
+
+	return nil
+}
+
+func (provider *providerImpl) RestoreImproveSuggestion(ctx context.Context, token string, id uuid.UUID) (*models.ImproveSuggestion, error) {
+	now := provider.time()
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := provider.improveSuggestionService.ReadDeleted(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch deleted improve suggestion %q: %w", id, err)
+	}
+
+	if deleted.UserID != claims.Payload.ID {
+		return nil, fmt.Errorf(
+			"%w: user %q is not allowed to restore the post %q (created by %q)", validation.ErrInvalidCredentials,
+			claims.Payload.ID, id, deleted.UserID,
+		)
+	}
+
+	if _, err := provider.improveRequestService.Read(ctx, deleted.SourceID); err != nil {
+		return nil, fmt.Errorf("failed to fetch improve request %q: %w", deleted.SourceID, err)
+	}
+
+	if err := provider.forceRestoreWindow(id, deleted.DeletedAt, now); err != nil {
+		return nil, err
+	}
+
+	suggestion, err := provider.improveSuggestionService.Restore(ctx, id, now.Add(-provider.restoreWindow))
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore improve suggestion %q: %w", id, err)
+	}
+
+	return suggestion, nil
+}
+
+func (provider *providerImpl) PurgeDeletedPosts(ctx context.Context, auth *authentication.BackendServiceAuth) error {
+	if err := authentication.ForceBackendService(ctx, auth); err != nil {
+		return err
+	}
+
+	if provider.purgeAfter <= 0 {
+		return nil
+	}
+
+	deletedBefore := provider.time().Add(-provider.purgeAfter)
+
+	if _, err := provider.improveRequestService.Purge(ctx, deletedBefore); err != nil {
+		return fmt.Errorf("failed to purge deleted improve requests: %w", err)
+	}
+	if _, err := provider.improveSuggestionService.Purge(ctx, deletedBefore); err != nil {
+		return fmt.Errorf("failed to purge deleted improve suggestions: %w", err)
+	}
+
+	return nil
+}
+
+func (provider *providerImpl) ReconcileVoteCounters(ctx context.Context, auth *authentication.BackendServiceAuth) error {
+	if err := authentication.ForceBackendService(ctx, auth); err != nil {
+		return err
+	}
+
+	for _, target := range []models.VoteTarget{models.VoteTargetImproveRequest, models.VoteTargetImproveSuggestion} {
+		if _, err := provider.votesService.ReconcileCounters(ctx, target, VoteCountersBatchSize); err != nil {
+			return fmt.Errorf("failed to reconcile vote counters of %s: %w", target, err)
+		}
+	}
+
+	return nil
+}
+
+func (provider *providerImpl) ListImproveSuggestions(ctx context.Context, token, shareToken string, query models.ImproveSuggestionsList, limit, offset int) ([]*models.ImproveSuggestion, int64, error) {
+	claims, err := authentication.OptionalAuthentication(token, provider.tokenService, provider.keysService, provider.time())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var userID *uuid.UUID
+	if claims != nil {
+		userID = &claims.Payload.ID
+	}
+
+	if err := provider.forceCanListImproveSuggestions(ctx, query, userID, shareToken); err != nil {
+		return nil, 0, err
+	}
+
+	suggestions, total, err := provider.improveSuggestionService.List(ctx, query, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list improve suggestions: %w", err)
+	}
+
+	return suggestions, total, nil
+}
+
+func (provider *providerImpl) ListImproveSuggestionsAfter(ctx context.Context, token, shareToken string, query models.ImproveSuggestionsList, cursor string, limit int) ([]*models.ImproveSuggestion, string, error) {
+	claims, err := authentication.OptionalAuthentication(token, provider.tokenService, provider.keysService, provider.time())
+	if err != nil {
+		return nil, "", err
+	}
+
+	var userID *uuid.UUID
+	if claims != nil {
+		userID = &claims.Payload.ID
+	}
+
+	if err := provider.forceCanListImproveSuggestions(ctx, query, userID, shareToken); err != nil {
+		return nil, "", err
+	}
+
+	suggestions, next, err := provider.improveSuggestionService.ListAfter(ctx, query, cursor, limit)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list improve suggestions: %w", err)
+	}
+
+	return suggestions, next, nil
+}
+
+func (provider *providerImpl) GetImproveSuggestionPreviews(ctx context.Context, token string, ids []uuid.UUID) ([]*models.ImproveSuggestion, error) {
+	claims, err := authentication.OptionalAuthentication(token, provider.tokenService, provider.keysService, provider.time())
+	if err != nil {
+		return nil, err
+	}
+
+	var viewerID *uuid.UUID
+	if claims != nil {
+		viewerID = &claims.Payload.ID
+	}
+
+	suggestions, err := provider.improveSuggestionService.GetPreviews(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get improve suggestions: %w", err)
+	}
+
+	// Suggestions often share the same request, so each source is only checked once.
+	visible := make(map[uuid.UUID]bool)
+	output := make([]*models.ImproveSuggestion, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		ok, checked := visible[suggestion.SourceID]
+		if !checked {
+			err := provider.forceCanViewImproveRequest(ctx, suggestion.SourceID, viewerID, "")
+			if err != nil && !errors.Is(err, validation.ErrNotFound) {
+				return nil, err
+			}
+
+			ok = err == nil
+			visible[suggestion.SourceID] = ok
+		}
+
+		if ok {
+			output = append(output, suggestion)
+		}
+	}
+
+	return output, nil
+}
+
+// Ensure the user can vote or react on a post: users cannot give feedback on their own posts, nor on the posts of a
+// locked or archived improvement request. Action describes the feedback, for error messages.
+func (provider *providerImpl) forceCanGiveFeedback(ctx context.Context, userID, postID uuid.UUID, target models.VoteTarget, action string) error {
+	// The source of the thread is resolved along the way.
+	var source uuid.UUID
+	switch target {
+	case models.VoteTargetImproveSuggestion:
+		isCreator, err := provider.improveSuggestionService.IsCreator(ctx, userID, postID)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to check if user %q is the creator of improve suggestion %q: %w",
+				userID, postID, err,
+			)
+		}
+
+		if isCreator {
+			return fmt.Errorf(
+				"%w: user %q cannot %s on its own improve suggestion %q",
+				validation.ErrInvalidEntity, userID, action, postID,
+			)
+		}
+
+		suggestion, err := provider.improveSuggestionService.Read(ctx, postID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch improve suggestion %q: %w", postID, err)
+		}
+
+		source = suggestion.SourceID
+	case models.VoteTargetImproveRequest:
+		// Cannot give feedback on an improvement request, if you are the creator or one of the collaborators.
+		isCreator, err := provider.improveRequestService.IsCreator(ctx, userID, postID, false)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to check if user %q is a creator of improve request %q: %w",
+				userID, postID, err,
+			)
+		}
+
+		if isCreator {
+			return fmt.Errorf(
+				"%w: user %q cannot %s on its own improve request %q",
+				validation.ErrInvalidEntity, userID, action, postID,
+			)
+		}
+
+		request, err := provider.improveRequestService.Read(ctx, postID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch improve request %q: %w", postID, err)
+		}
+
+		source = request.Source
+	}
+
+	if source != uuid.Nil {
+		if err := provider.forceImproveRequestState(
+			ctx, source, models.ImproveRequestStateOpen, models.ImproveRequestStateClosed,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (provider *providerImpl) Vote(ctx context.Context, token string, postID uuid.UUID, target models.VoteTarget, vote models.VoteValue) (models.VoteValue, error) {
+	now := provider.time()
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+	if err != nil {
+		return models.NoVote, err
+	}
+
+	ok, err := provider.userService.HasAuthorizations(ctx, claims.Payload.ID, models.UserAuthorizations{
+		{models.UserAuthorizationsAccountValidated},
+	})
+	if err != nil {
+		return models.NoVote, fmt.Errorf("unable to check user authorizations: %w", err)
+	}
+	if !ok {
+		return models.NoVote, validation.NewErrUnauthorized("user email is not validated")
+	}
+
+	if vote == models.VoteDown && provider.downVoteReputation > 0 {
+		reputation, err := provider.reputationService.Read(ctx, claims.Payload.ID)
+		if err != nil {
+			return models.NoVote, fmt.Errorf("failed to read reputation of user %q: %w", claims.Payload.ID, err)
+		}
+
+		if reputation < provider.downVoteReputation {
+			return models.NoVote, validation.NewErrUnauthorized(
+				fmt.Sprintf("user %q needs a reputation of %d to vote down", claims.Payload.ID, provider.downVoteReputation),
+			)
+		}
+	}
+
+	if provider.voteRateLimit > 0 {
+		recent, err := provider.votesService.CountRecent(ctx, claims.Payload.ID, now.Add(-provider.voteRateWindow))
+		if err != nil {
+			return models.NoVote, fmt.Errorf("failed to count recent votes of user %q: %w", claims.Payload.ID, err)
+		}
+
+		if recent >= int64(provider.voteRateLimit) {
+			return models.NoVote, validation.NewErrRateLimited(
+				fmt.Sprintf("user %q cannot vote more than %d times every %s", claims.Payload.ID, provider.voteRateLimit, provider.voteRateWindow),
+			)
+		}
+	}
+
+	if err := provider.forceCanGiveFeedback(ctx, claims.Payload.ID, postID, target, "vote"); err != nil {
+		return models.NoVote, err
+	}
+
+	res, err := provider.votesService.Vote(
+		ctx, postID, claims.Payload.ID, target, vote, now,
+	)
+	if err != nil {
+		return models.NoVote, fmt.Errorf("failed to vote for %q %q, for user %q: %w", target, postID, claims.Payload.ID, err)
+	}
+
+	return res, nil
+}
+
+func (provider *providerImpl) HasVoted(ctx context.Context, token string, postID uuid.UUID, target models.VoteTarget) (models.VoteValue, error) {
+	now := provider.time()
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+	if err != nil {
+		return models.NoVote, err
+	}
+
+	res, err := provider.votesService.HasVoted(ctx, postID, claims.Payload.ID, target)
+	if err != nil {
+		return models.NoVote, fmt.Errorf("failed to check vote for %q %q, for user %q: %w", target, postID, claims.Payload.ID, err)
 	}
 
 	return res, nil
@@ -378,3 +2031,58 @@ func (provider *providerImpl) GetVotedPosts(ctx context.Context, userID uuid.UUI
 
 	return posts, total, nil
 }
+
+func (provider *providerImpl) GetVotedPostsAfter(ctx context.Context, userID uuid.UUID, target models.VoteTarget, cursor string, limit int) ([]*models.VotedPost, string, error) {
+	posts, next, err := provider.votesService.GetVotedPostsAfter(ctx, userID, target, cursor, limit)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get voted posts for user %q: %w", userID, err)
+	}
+
+	return posts, next, nil
+}
+
+func (provider *providerImpl) React(ctx context.Context, token string, postID uuid.UUID, target models.VoteTarget, reaction string, active bool) ([]string, error) {
+	now := provider.time()
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validation.CheckRestricted("reaction", reaction, provider.reactions...); err != nil {
+		return nil, err
+	}
+
+	if err := provider.forceAccountValidated(ctx, claims.Payload.ID); err != nil {
+		return nil, err
+	}
+
+	if err := provider.forceCanGiveFeedback(ctx, claims.Payload.ID, postID, target, "react"); err != nil {
+		return nil, err
+	}
+
+	res, err := provider.votesService.React(ctx, postID, claims.Payload.ID, target, reaction, active, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to react on %q %q, for user %q: %w", target, postID, claims.Payload.ID, err)
+	}
+
+	return res, nil
+}
+
+func (provider *providerImpl) GetReactions(ctx context.Context, token string, postID uuid.UUID, target models.VoteTarget) ([]string, error) {
+	now := provider.time()
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := provider.votesService.GetReactions(ctx, postID, claims.Payload.ID, target)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reactions on %q %q, for user %q: %w", target, postID, claims.Payload.ID, err)
+	}
+
+	return res, nil
+}
+
+func (provider *providerImpl) ListReactions() []string {
+	return provider.reactions
+}
@@ -4,13 +4,23 @@ import (
 	"context"
 	"crypto/ed25519"
 	"errors"
+	"github.com/a-novel/agora-backend/domains/forum/service/badges"
+	"github.com/a-novel/agora-backend/domains/forum/service/collaborator"
+	"github.com/a-novel/agora-backend/domains/forum/service/critique"
+	"github.com/a-novel/agora-backend/domains/forum/service/drafts"
+	"github.com/a-novel/agora-backend/domains/forum/service/duplicates"
 	"github.com/a-novel/agora-backend/domains/forum/service/improve_request"
 	"github.com/a-novel/agora-backend/domains/forum/service/improve_suggestion"
+	"github.com/a-novel/agora-backend/domains/forum/service/leaderboards"
+	"github.com/a-novel/agora-backend/domains/forum/service/thread_state"
+	"github.com/a-novel/agora-backend/domains/forum/service/visibility"
 	"github.com/a-novel/agora-backend/domains/forum/service/votes"
 	"github.com/a-novel/agora-backend/domains/keys/service/jwk"
 	"github.com/a-novel/agora-backend/domains/keys/storage/jwk"
+	"github.com/a-novel/agora-backend/domains/user/service/reputation"
 	"github.com/a-novel/agora-backend/domains/user/service/token"
 	user_service "github.com/a-novel/agora-backend/domains/user/service/user"
+	"github.com/a-novel/agora-backend/environment/user/authentication"
 	"github.com/a-novel/agora-backend/framework"
 	"github.com/a-novel/agora-backend/framework/test"
 	"github.com/a-novel/agora-backend/framework/validation"
@@ -29,88 +39,409 @@ var (
 )
 
 func TestImprovePostProvider_ReadImproveRequest(t *testing.T) {
+	revisions := []*models.ImproveRequest{
+		{
+			ID:        test_utils.NumberUUID(1),
+			Source:    test_utils.NumberUUID(1),
+			CreatedAt: baseTime,
+			UserID:    test_utils.NumberUUID(10),
+			Title:     "Dummy request.",
+			Content:   "Foo bar qux.",
+			UpVotes:   17,
+			DownVotes: 3,
+		},
+		{
+			ID:        test_utils.NumberUUID(2),
+			Source:    test_utils.NumberUUID(1),
+			CreatedAt: baseTime,
+			UserID:    test_utils.NumberUUID(10),
+			Title:     "Smart request.",
+			Content:   "Qux bar foo.",
+			UpVotes:   12,
+		},
+	}
+
+	userToken := &models.UserToken{
+		Header: models.UserTokenHeader{
+			IAT: baseTime.Add(-time.Hour),
+			EXP: baseTime.Add(time.Hour),
+			ID:  test_utils.NumberUUID(100),
+		},
+		Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(11)},
+	}
+
 	data := []struct {
 		name string
 
-		id uuid.UUID
+		token      string
+		shareToken string
+		id         uuid.UUID
 
-		serviceData []*models.ImproveRequest
-		serviceErr  error
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+
+		shouldCallReadRevisions bool
+		serviceData             []*models.ImproveRequest
+		serviceErr              error
+
+		shouldCallVisibilityRead bool
+		visibilityReadData       *models.ImproveRequestAccess
+		visibilityReadErr        error
+
+		shouldCallIsCreator bool
+		isCreatorData       bool
+		isCreatorErr        error
+
+		shouldCallIsInvited bool
+		isInvitedData       bool
+
+		shouldCallVerifyShareToken bool
+		verifyShareTokenData       bool
+
+		shouldCallReadCase bool
+		readCaseData       *models.ForumReportCase
+		readCaseErr        error
+
+		shouldCallHasAuthorizations bool
+		isModerator                 bool
 
 		expect    []*models.ImproveRequest
 		expectErr error
 	}{
 		{
-			name: "Success",
-			id:   test_utils.NumberUUID(1),
-			serviceData: []*models.ImproveRequest{
-				{
-					ID:        test_utils.NumberUUID(1),
-					Source:    test_utils.NumberUUID(1),
-					CreatedAt: baseTime,
-					UserID:    test_utils.NumberUUID(10),
-					Title:     "Dummy request.",
-					Content:   "Foo bar qux.",
-					UpVotes:   17,
-					DownVotes: 3,
-				},
-				{
-					ID:        test_utils.NumberUUID(2),
-					Source:    test_utils.NumberUUID(1),
-					CreatedAt: baseTime,
-					UserID:    test_utils.NumberUUID(10),
-					Title:     "Smart request.",
-					Content:   "Qux bar foo.",
-					UpVotes:   12,
-				},
+			name:                     "Success",
+			id:                       test_utils.NumberUUID(1),
+			shouldCallReadRevisions:  true,
+			serviceData:              revisions,
+			shouldCallVisibilityRead: true,
+			visibilityReadData: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityPublic,
 			},
-			expect: []*models.ImproveRequest{
-				{
-					ID:        test_utils.NumberUUID(1),
-					Source:    test_utils.NumberUUID(1),
-					CreatedAt: baseTime,
-					UserID:    test_utils.NumberUUID(10),
-					Title:     "Dummy request.",
-					Content:   "Foo bar qux.",
-					UpVotes:   17,
-					DownVotes: 3,
-				},
-				{
-					ID:        test_utils.NumberUUID(2),
-					Source:    test_utils.NumberUUID(1),
-					CreatedAt: baseTime,
-					UserID:    test_utils.NumberUUID(10),
-					Title:     "Smart request.",
-					Content:   "Qux bar foo.",
-					UpVotes:   12,
-				},
+			shouldCallReadCase: true,
+			expect:             revisions,
+		},
+		{
+			name:                     "Success/Author",
+			token:                    "foo.bar.qux",
+			id:                       test_utils.NumberUUID(2),
+			tokenServiceDecodeData:   userToken,
+			shouldCallReadRevisions:  true,
+			serviceData:              revisions,
+			shouldCallVisibilityRead: true,
+			visibilityReadData: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityRestricted,
 			},
+			shouldCallIsCreator: true,
+			isCreatorData:       true,
+			shouldCallReadCase:  true,
+			expect:              revisions,
 		},
 		{
-			name:       "Error/ServiceFailure",
-			id:         test_utils.NumberUUID(1),
-			serviceErr: fooErr,
-			expectErr:  fooErr,
+			name:                     "Success/Invited",
+			token:                    "foo.bar.qux",
+			id:                       test_utils.NumberUUID(1),
+			tokenServiceDecodeData:   userToken,
+			shouldCallReadRevisions:  true,
+			serviceData:              revisions,
+			shouldCallVisibilityRead: true,
+			visibilityReadData: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityRestricted,
+			},
+			shouldCallIsCreator: true,
+			shouldCallIsInvited: true,
+			isInvitedData:       true,
+			shouldCallReadCase:  true,
+			expect:              revisions,
+		},
+		{
+			name:                     "Success/ShareToken",
+			shareToken:               "share.token",
+			id:                       test_utils.NumberUUID(1),
+			shouldCallReadRevisions:  true,
+			serviceData:              revisions,
+			shouldCallVisibilityRead: true,
+			visibilityReadData: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityUnlisted,
+			},
+			shouldCallVerifyShareToken: true,
+			verifyShareTokenData:       true,
+			shouldCallReadCase:         true,
+			expect:                     revisions,
+		},
+		{
+			name:                    "Success/NoRevisions",
+			id:                      test_utils.NumberUUID(1),
+			shouldCallReadRevisions: true,
+			serviceData:             []*models.ImproveRequest{},
+			expect:                  []*models.ImproveRequest{},
+		},
+		{
+			name:                     "Success/HiddenToAuthor",
+			token:                    "foo.bar.qux",
+			id:                       test_utils.NumberUUID(1),
+			tokenServiceDecodeData:   userToken,
+			shouldCallReadRevisions:  true,
+			serviceData:              revisions,
+			shouldCallVisibilityRead: true,
+			visibilityReadData: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityPublic,
+			},
+			shouldCallReadCase:  true,
+			readCaseData:        &models.ForumReportCase{HiddenAt: &baseTime},
+			shouldCallIsCreator: true,
+			isCreatorData:       true,
+			expect:              revisions,
+		},
+		{
+			name:                     "Success/HiddenToModerator",
+			token:                    "foo.bar.qux",
+			id:                       test_utils.NumberUUID(1),
+			tokenServiceDecodeData:   userToken,
+			shouldCallReadRevisions:  true,
+			serviceData:              revisions,
+			shouldCallVisibilityRead: true,
+			visibilityReadData: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityPublic,
+			},
+			shouldCallReadCase:          true,
+			readCaseData:                &models.ForumReportCase{HiddenAt: &baseTime},
+			shouldCallIsCreator:         true,
+			shouldCallHasAuthorizations: true,
+			isModerator:                 true,
+			expect:                      revisions,
+		},
+		{
+			name:                     "Success/ReportedNotHidden",
+			id:                       test_utils.NumberUUID(1),
+			shouldCallReadRevisions:  true,
+			serviceData:              revisions,
+			shouldCallVisibilityRead: true,
+			visibilityReadData: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityPublic,
+			},
+			shouldCallReadCase: true,
+			readCaseData:       &models.ForumReportCase{Reports: 1},
+			expect:             revisions,
+		},
+		{
+			name:                     "Error/HiddenToAnonymous",
+			id:                       test_utils.NumberUUID(1),
+			shouldCallReadRevisions:  true,
+			serviceData:              revisions,
+			shouldCallVisibilityRead: true,
+			visibilityReadData: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityPublic,
+			},
+			shouldCallReadCase: true,
+			readCaseData:       &models.ForumReportCase{HiddenAt: &baseTime},
+			expectErr:          validation.ErrNotFound,
+		},
+		{
+			name:                     "Error/HiddenToStranger",
+			token:                    "foo.bar.qux",
+			id:                       test_utils.NumberUUID(1),
+			tokenServiceDecodeData:   userToken,
+			shouldCallReadRevisions:  true,
+			serviceData:              revisions,
+			shouldCallVisibilityRead: true,
+			visibilityReadData: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityPublic,
+			},
+			shouldCallReadCase:          true,
+			readCaseData:                &models.ForumReportCase{HiddenAt: &baseTime},
+			shouldCallIsCreator:         true,
+			shouldCallHasAuthorizations: true,
+			expectErr:                   validation.ErrNotFound,
+		},
+		{
+			name:                     "Error/ReportsServiceFailure",
+			id:                       test_utils.NumberUUID(1),
+			shouldCallReadRevisions:  true,
+			serviceData:              revisions,
+			shouldCallVisibilityRead: true,
+			visibilityReadData: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityPublic,
+			},
+			shouldCallReadCase: true,
+			readCaseErr:        fooErr,
+			expectErr:          fooErr,
+		},
+		{
+			name:                     "Error/ShareTokenOnRestricted",
+			shareToken:               "share.token",
+			id:                       test_utils.NumberUUID(1),
+			shouldCallReadRevisions:  true,
+			serviceData:              revisions,
+			shouldCallVisibilityRead: true,
+			visibilityReadData: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityRestricted,
+			},
+			expectErr: validation.ErrNotFound,
+		},
+		{
+			name:                     "Error/InvalidShareToken",
+			token:                    "foo.bar.qux",
+			shareToken:               "share.token",
+			id:                       test_utils.NumberUUID(1),
+			tokenServiceDecodeData:   userToken,
+			shouldCallReadRevisions:  true,
+			serviceData:              revisions,
+			shouldCallVisibilityRead: true,
+			visibilityReadData: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityUnlisted,
+			},
+			shouldCallIsCreator:        true,
+			shouldCallIsInvited:        true,
+			shouldCallVerifyShareToken: true,
+			expectErr:                  validation.ErrNotFound,
+		},
+		{
+			name:                     "Error/IsCreatorFailure",
+			token:                    "foo.bar.qux",
+			id:                       test_utils.NumberUUID(1),
+			tokenServiceDecodeData:   userToken,
+			shouldCallReadRevisions:  true,
+			serviceData:              revisions,
+			shouldCallVisibilityRead: true,
+			visibilityReadData: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityRestricted,
+			},
+			shouldCallIsCreator: true,
+			isCreatorErr:        fooErr,
+			expectErr:           fooErr,
+		},
+		{
+			name:                     "Error/VisibilityServiceFailure",
+			id:                       test_utils.NumberUUID(1),
+			shouldCallReadRevisions:  true,
+			serviceData:              revisions,
+			shouldCallVisibilityRead: true,
+			visibilityReadErr:        fooErr,
+			expectErr:                fooErr,
+		},
+		{
+			name:                    "Error/ServiceFailure",
+			id:                      test_utils.NumberUUID(1),
+			shouldCallReadRevisions: true,
+			serviceErr:              fooErr,
+			expectErr:               fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			id:                    test_utils.NumberUUID(1),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
 		},
 	}
 
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
 			improveRequestService := improve_request_service.NewMockService(t)
+			visibilityService := visibility_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+			reportsService := reports_service.NewMockService(t)
+			userService := user_service.NewMockService(t)
 
-			improveRequestService.
-				On("ReadRevisions", context.TODO(), d.id).
-				Return(d.serviceData, d.serviceErr)
+			if d.token != "" {
+				publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+				for i, key := range jwk_storage.MockedKeys {
+					publicKeys[i] = key.Public().(ed25519.PublicKey)
+				}
+
+				keysService.
+					On("ListPublic").
+					Return(publicKeys)
+
+				tokenService.
+					On("Decode", d.token, publicKeys, baseTime).
+					Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+			}
+
+			if d.shouldCallReadRevisions {
+				improveRequestService.
+					On("ReadRevisions", context.TODO(), d.id).
+					Return(d.serviceData, d.serviceErr)
+			}
+
+			if d.shouldCallVisibilityRead {
+				visibilityService.
+					On("Read", context.TODO(), test_utils.NumberUUID(1)).
+					Return(d.visibilityReadData, d.visibilityReadErr)
+			}
+
+			if d.shouldCallIsCreator {
+				improveRequestService.
+					On("IsCreator", context.TODO(), test_utils.NumberUUID(11), test_utils.NumberUUID(1), false).
+					Return(d.isCreatorData, d.isCreatorErr)
+			}
+
+			if d.shouldCallIsInvited {
+				visibilityService.
+					On("IsInvited", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(11)).
+					Return(d.isInvitedData, nil)
+			}
+
+			if d.shouldCallVerifyShareToken {
+				visibilityService.
+					On("VerifyShareToken", context.TODO(), test_utils.NumberUUID(1), d.shareToken).
+					Return(d.verifyShareTokenData, nil)
+			}
+
+			if d.shouldCallReadCase {
+				// Most contents were never reported.
+				readCaseErr := d.readCaseErr
+				if d.readCaseData == nil && readCaseErr == nil {
+					readCaseErr = validation.ErrNotFound
+				}
+
+				reportsService.
+					On("ReadCase", context.TODO(), models.ModerationTargetImproveRequest, test_utils.NumberUUID(1)).
+					Return(d.readCaseData, readCaseErr)
+			}
+
+			if d.shouldCallHasAuthorizations {
+				userService.
+					On("HasAuthorizations", context.TODO(), test_utils.NumberUUID(11), models.UserAuthorizations{
+						{models.UserAuthorizationsModerator},
+					}).
+					Return(d.isModerator, nil)
+			}
 
 			provider := NewProvider(Config{
 				ImproveRequestService: improveRequestService,
+				VisibilityService:     visibilityService,
+				TokenService:          tokenService,
+				KeysService:           keysService,
+				ReportsService:        reportsService,
+				UserService:           userService,
+				Time:                  test_utils.GetTimeNow(baseTime),
 			})
 
-			res, err := provider.ReadImproveRequest(context.TODO(), d.id)
+			res, err := provider.ReadImproveRequest(context.TODO(), d.token, d.shareToken, d.id)
 			test_utils.RequireError(t, d.expectErr, err)
 			require.Equal(t, d.expect, res)
 
 			improveRequestService.AssertExpectations(t)
+			visibilityService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+			reportsService.AssertExpectations(t)
+			userService.AssertExpectations(t)
 		})
 	}
 }
@@ -124,11 +455,14 @@ func TestImprovePostProvider_CreateImproveRequest(t *testing.T) {
 		id     uuid.UUID
 		userID uuid.UUID
 
-		token   string
-		title   string
-		content string
+		token    string
+		title    string
+		content  string
+		language string
+		tags     []uuid.UUID
 
 		shouldCallImproveRequestService bool
+		shouldCallDuplicatesService     bool
 		shouldCallUserService           bool
 
 		tokenServiceDecodeData *models.UserToken
@@ -138,6 +472,8 @@ func TestImprovePostProvider_CreateImproveRequest(t *testing.T) {
 		hasAuthorization       bool
 		hasAuthorizationErr    error
 
+		duplicatesErr error
+
 		expect    *models.ImproveRequest
 		expectErr error
 	}{
@@ -150,9 +486,56 @@ func TestImprovePostProvider_CreateImproveRequest(t *testing.T) {
 			token:                           "foo.bar.qux",
 			title:                           "Dummy request",
 			content:                         "Foo bar qux.",
+			tags:                            []uuid.UUID{test_utils.NumberUUID(20)},
+			shouldCallUserService:           true,
+			hasAuthorization:                true,
+			shouldCallImproveRequestService: true,
+			shouldCallDuplicatesService:     true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			improveRequestData: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(10),
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				UpVotes:   0,
+				DownVotes: 0,
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			expect: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(10),
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				UpVotes:   0,
+				DownVotes: 0,
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+		},
+		{
+			name:                            "Success/DuplicatesServiceFailure",
+			now:                             baseTime,
+			keys:                            jwk_storage.MockedKeys,
+			id:                              test_utils.NumberUUID(1),
+			userID:                          test_utils.NumberUUID(10),
+			token:                           "foo.bar.qux",
+			title:                           "Dummy request",
+			content:                         "Foo bar qux.",
+			tags:                            []uuid.UUID{test_utils.NumberUUID(20)},
 			shouldCallUserService:           true,
 			hasAuthorization:                true,
 			shouldCallImproveRequestService: true,
+			shouldCallDuplicatesService:     true,
 			tokenServiceDecodeData: &models.UserToken{
 				Header: models.UserTokenHeader{
 					IAT: baseTime.Add(-time.Hour),
@@ -170,7 +553,9 @@ func TestImprovePostProvider_CreateImproveRequest(t *testing.T) {
 				Content:   "Foo bar qux.",
 				UpVotes:   0,
 				DownVotes: 0,
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
 			},
+			duplicatesErr: fooErr,
 			expect: &models.ImproveRequest{
 				ID:        test_utils.NumberUUID(1),
 				Source:    test_utils.NumberUUID(1),
@@ -180,6 +565,7 @@ func TestImprovePostProvider_CreateImproveRequest(t *testing.T) {
 				Content:   "Foo bar qux.",
 				UpVotes:   0,
 				DownVotes: 0,
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
 			},
 		},
 		{
@@ -246,6 +632,7 @@ func TestImprovePostProvider_CreateImproveRequest(t *testing.T) {
 
 			tokenService := token_service.NewMockService(t)
 			keysService := jwk_service.NewMockServiceCached(t)
+			duplicatesService := duplicates_service.NewMockService(t)
 			userService := user_service.NewMockService(t)
 
 			publicKeys := make([]ed25519.PublicKey, len(d.keys))
@@ -269,12 +656,27 @@ func TestImprovePostProvider_CreateImproveRequest(t *testing.T) {
 
 			if d.shouldCallImproveRequestService {
 				improveRequestService.
-					On("Create", context.TODO(), d.userID, d.title, d.content, d.id, d.now).
+					On("Create", context.TODO(), d.userID, d.title, d.content, d.language, d.tags, d.id, d.now).
 					Return(d.improveRequestData, d.improveRequestErr)
 			}
 
+			if d.shouldCallDuplicatesService {
+				duplicatesService.
+					On("Check", context.TODO(), &models.ForumPostRevision{
+						RevisionID: d.improveRequestData.ID,
+						PostID:     d.improveRequestData.Source,
+						ThreadID:   d.improveRequestData.Source,
+						UserID:     d.improveRequestData.UserID,
+						Target:     models.ForumPostTargetImproveRequest,
+						CreatedAt:  d.improveRequestData.CreatedAt,
+						Content:    d.improveRequestData.Content,
+					}, d.now).
+					Return(nil, d.duplicatesErr)
+			}
+
 			provider := NewProvider(Config{
 				ImproveRequestService: improveRequestService,
+				DuplicatesService:     duplicatesService,
 				TokenService:          tokenService,
 				KeysService:           keysService,
 				UserService:           userService,
@@ -282,13 +684,14 @@ func TestImprovePostProvider_CreateImproveRequest(t *testing.T) {
 				ID:                    test_utils.GetUUID(d.id),
 			})
 
-			res, err := provider.CreateImproveRequest(context.TODO(), d.token, d.title, d.content)
+			res, err := provider.CreateImproveRequest(context.TODO(), d.token, d.title, d.content, d.language, d.tags)
 			test_utils.RequireError(t, d.expectErr, err)
 			require.Equal(t, d.expect, res)
 
 			improveRequestService.AssertExpectations(t)
 			tokenService.AssertExpectations(t)
 			keysService.AssertExpectations(t)
+			duplicatesService.AssertExpectations(t)
 		})
 	}
 }
@@ -305,10 +708,13 @@ func TestImprovePostProvider_CreateImproveRequestRevision(t *testing.T) {
 		token    string
 		title    string
 		content  string
+		language string
+		tags     []uuid.UUID
 		sourceID uuid.UUID
 
 		shouldCallImproveRequestGetService    bool
 		shouldCallImproveRequestCreateService bool
+		shouldCallDuplicatesService           bool
 		shouldCallUserService                 bool
 
 		tokenServiceDecodeData   *models.UserToken
@@ -320,11 +726,70 @@ func TestImprovePostProvider_CreateImproveRequestRevision(t *testing.T) {
 		hasAuthorization         bool
 		hasAuthorizationErr      error
 
+		isCreatorData                 bool
+		shouldCallCollaboratorService bool
+		collaboratorData              *models.ImproveRequestCollaborator
+		collaboratorErr               error
+
+		duplicatesErr error
+
 		expect    *models.ImproveRequest
 		expectErr error
 	}{
 		{
 			name:                                  "Success",
+			isCreatorData:                         true,
+			now:                                   baseTime,
+			keys:                                  jwk_storage.MockedKeys,
+			id:                                    test_utils.NumberUUID(2),
+			userID:                                test_utils.NumberUUID(10),
+			token:                                 "foo.bar.qux",
+			title:                                 "Smart request",
+			content:                               "Qux bar foo.",
+			sourceID:                              test_utils.NumberUUID(1),
+			shouldCallImproveRequestGetService:    true,
+			shouldCallImproveRequestCreateService: true,
+			shouldCallDuplicatesService:           true,
+			shouldCallUserService:                 true,
+			hasAuthorization:                      true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			improveRequestGetData: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(10),
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				UpVotes:   10,
+				DownVotes: 2,
+			},
+			improveRequestCreateData: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(10),
+				Title:     "Smart request",
+				Content:   "Qux bar foo.",
+			},
+			expect: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(10),
+				Title:     "Smart request",
+				Content:   "Qux bar foo.",
+			},
+		},
+		{
+			name:                                  "Success/DuplicatesServiceFailure",
+			isCreatorData:                         true,
 			now:                                   baseTime,
 			keys:                                  jwk_storage.MockedKeys,
 			id:                                    test_utils.NumberUUID(2),
@@ -335,6 +800,7 @@ func TestImprovePostProvider_CreateImproveRequestRevision(t *testing.T) {
 			sourceID:                              test_utils.NumberUUID(1),
 			shouldCallImproveRequestGetService:    true,
 			shouldCallImproveRequestCreateService: true,
+			shouldCallDuplicatesService:           true,
 			shouldCallUserService:                 true,
 			hasAuthorization:                      true,
 			tokenServiceDecodeData: &models.UserToken{
@@ -363,6 +829,7 @@ func TestImprovePostProvider_CreateImproveRequestRevision(t *testing.T) {
 				Title:     "Smart request",
 				Content:   "Qux bar foo.",
 			},
+			duplicatesErr: fooErr,
 			expect: &models.ImproveRequest{
 				ID:        test_utils.NumberUUID(1),
 				Source:    test_utils.NumberUUID(1),
@@ -403,21 +870,25 @@ func TestImprovePostProvider_CreateImproveRequestRevision(t *testing.T) {
 				UpVotes:   10,
 				DownVotes: 2,
 			},
-			expectErr: validation.ErrInvalidCredentials,
+			shouldCallCollaboratorService: true,
+			collaboratorErr:               validation.ErrNotFound,
+			expectErr:                     validation.ErrInvalidCredentials,
 		},
 		{
-			name:                                  "Error/ImproveRequestServiceCreateFailure",
+			name:                                  "Success/Editor",
 			now:                                   baseTime,
 			keys:                                  jwk_storage.MockedKeys,
 			id:                                    test_utils.NumberUUID(2),
-			userID:                                test_utils.NumberUUID(10),
+			userID:                                test_utils.NumberUUID(11),
 			token:                                 "foo.bar.qux",
 			title:                                 "Smart request",
 			content:                               "Qux bar foo.",
 			sourceID:                              test_utils.NumberUUID(1),
 			shouldCallImproveRequestGetService:    true,
 			shouldCallImproveRequestCreateService: true,
+			shouldCallDuplicatesService:           true,
 			shouldCallUserService:                 true,
+			shouldCallCollaboratorService:         true,
 			hasAuthorization:                      true,
 			tokenServiceDecodeData: &models.UserToken{
 				Header: models.UserTokenHeader{
@@ -425,7 +896,7 @@ func TestImprovePostProvider_CreateImproveRequestRevision(t *testing.T) {
 					EXP: baseTime.Add(time.Hour),
 					ID:  test_utils.NumberUUID(100),
 				},
-				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(11)},
 			},
 			improveRequestGetData: &models.ImproveRequest{
 				ID:        test_utils.NumberUUID(1),
@@ -434,18 +905,146 @@ func TestImprovePostProvider_CreateImproveRequestRevision(t *testing.T) {
 				UserID:    test_utils.NumberUUID(10),
 				Title:     "Dummy request",
 				Content:   "Foo bar qux.",
-				UpVotes:   10,
-				DownVotes: 2,
 			},
-			improveRequestCreateErr: fooErr,
-			expectErr:               fooErr,
+			collaboratorData: &models.ImproveRequestCollaborator{
+				Source:     test_utils.NumberUUID(1),
+				UserID:     test_utils.NumberUUID(11),
+				Role:       models.ImproveRequestCollaboratorRoleEditor,
+				InvitedBy:  test_utils.NumberUUID(10),
+				CreatedAt:  baseTime,
+				AcceptedAt: &baseTime,
+			},
+			improveRequestCreateData: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(2),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(11),
+				Title:     "Smart request",
+				Content:   "Qux bar foo.",
+			},
+			expect: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(2),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(11),
+				Title:     "Smart request",
+				Content:   "Qux bar foo.",
+			},
 		},
 		{
-			name:                               "Error/ImproveRequestServiceGetFailure",
+			name:                               "Error/PendingCollaborator",
 			now:                                baseTime,
 			keys:                               jwk_storage.MockedKeys,
 			id:                                 test_utils.NumberUUID(2),
-			userID:                             test_utils.NumberUUID(10),
+			userID:                             test_utils.NumberUUID(11),
+			token:                              "foo.bar.qux",
+			title:                              "Smart request",
+			content:                            "Qux bar foo.",
+			sourceID:                           test_utils.NumberUUID(1),
+			shouldCallImproveRequestGetService: true,
+			shouldCallUserService:              true,
+			shouldCallCollaboratorService:      true,
+			hasAuthorization:                   true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(11)},
+			},
+			improveRequestGetData: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(10),
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+			},
+			collaboratorData: &models.ImproveRequestCollaborator{
+				Source:    test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(11),
+				Role:      models.ImproveRequestCollaboratorRoleEditor,
+				InvitedBy: test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+			},
+			expectErr: validation.ErrInvalidCredentials,
+		},
+		{
+			name:                               "Error/CollaboratorServiceFailure",
+			now:                                baseTime,
+			keys:                               jwk_storage.MockedKeys,
+			id:                                 test_utils.NumberUUID(2),
+			userID:                             test_utils.NumberUUID(11),
+			token:                              "foo.bar.qux",
+			title:                              "Smart request",
+			content:                            "Qux bar foo.",
+			sourceID:                           test_utils.NumberUUID(1),
+			shouldCallImproveRequestGetService: true,
+			shouldCallUserService:              true,
+			shouldCallCollaboratorService:      true,
+			hasAuthorization:                   true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(11)},
+			},
+			improveRequestGetData: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(10),
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+			},
+			collaboratorErr: fooErr,
+			expectErr:       fooErr,
+		},
+		{
+			name:                                  "Error/ImproveRequestServiceCreateFailure",
+			isCreatorData:                         true,
+			now:                                   baseTime,
+			keys:                                  jwk_storage.MockedKeys,
+			id:                                    test_utils.NumberUUID(2),
+			userID:                                test_utils.NumberUUID(10),
+			token:                                 "foo.bar.qux",
+			title:                                 "Smart request",
+			content:                               "Qux bar foo.",
+			sourceID:                              test_utils.NumberUUID(1),
+			shouldCallImproveRequestGetService:    true,
+			shouldCallImproveRequestCreateService: true,
+			shouldCallUserService:                 true,
+			hasAuthorization:                      true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			improveRequestGetData: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(10),
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				UpVotes:   10,
+				DownVotes: 2,
+			},
+			improveRequestCreateErr: fooErr,
+			expectErr:               fooErr,
+		},
+		{
+			name:                               "Error/ImproveRequestServiceGetFailure",
+			now:                                baseTime,
+			keys:                               jwk_storage.MockedKeys,
+			id:                                 test_utils.NumberUUID(2),
+			userID:                             test_utils.NumberUUID(10),
 			token:                              "foo.bar.qux",
 			title:                              "Smart request",
 			content:                            "Qux bar foo.",
@@ -507,7 +1106,9 @@ func TestImprovePostProvider_CreateImproveRequestRevision(t *testing.T) {
 
 			tokenService := token_service.NewMockService(t)
 			keysService := jwk_service.NewMockServiceCached(t)
+			duplicatesService := duplicates_service.NewMockService(t)
 			userService := user_service.NewMockService(t)
+			collaboratorService := collaborator_service.NewMockService(t)
 
 			publicKeys := make([]ed25519.PublicKey, len(d.keys))
 			for i, key := range d.keys {
@@ -532,16 +1133,44 @@ func TestImprovePostProvider_CreateImproveRequestRevision(t *testing.T) {
 				improveRequestService.
 					On("Read", context.TODO(), d.sourceID).
 					Return(d.improveRequestGetData, d.improveRequestGetErr)
+
+				if d.improveRequestGetErr == nil {
+					improveRequestService.
+						On("IsCreator", context.TODO(), d.userID, d.improveRequestGetData.Source, true).
+						Return(d.isCreatorData, nil)
+				}
+			}
+
+			if d.shouldCallCollaboratorService {
+				collaboratorService.
+					On("Read", context.TODO(), d.improveRequestGetData.Source, d.userID).
+					Return(d.collaboratorData, d.collaboratorErr)
 			}
 
 			if d.shouldCallImproveRequestCreateService {
 				improveRequestService.
-					On("CreateRevision", context.TODO(), d.userID, d.sourceID, d.title, d.content, d.id, d.now).
+					On("CreateRevision", context.TODO(), d.userID, d.sourceID, d.title, d.content, d.language, d.tags, d.id, d.now).
 					Return(d.improveRequestCreateData, d.improveRequestCreateErr)
 			}
 
+			if d.shouldCallDuplicatesService {
+				duplicatesService.
+					On("Check", context.TODO(), &models.ForumPostRevision{
+						RevisionID: d.improveRequestCreateData.ID,
+						PostID:     d.improveRequestCreateData.Source,
+						ThreadID:   d.improveRequestCreateData.Source,
+						UserID:     d.improveRequestCreateData.UserID,
+						Target:     models.ForumPostTargetImproveRequest,
+						CreatedAt:  d.improveRequestCreateData.CreatedAt,
+						Content:    d.improveRequestCreateData.Content,
+					}, d.now).
+					Return(nil, d.duplicatesErr)
+			}
+
 			provider := NewProvider(Config{
 				ImproveRequestService: improveRequestService,
+				DuplicatesService:     duplicatesService,
+				CollaboratorService:   collaboratorService,
 				TokenService:          tokenService,
 				KeysService:           keysService,
 				UserService:           userService,
@@ -549,13 +1178,15 @@ func TestImprovePostProvider_CreateImproveRequestRevision(t *testing.T) {
 				ID:                    test_utils.GetUUID(d.id),
 			})
 
-			res, err := provider.CreateImproveRequestRevision(context.TODO(), d.token, d.sourceID, d.title, d.content)
+			res, err := provider.CreateImproveRequestRevision(context.TODO(), d.token, d.sourceID, d.title, d.content, d.language, d.tags)
 			test_utils.RequireError(t, d.expectErr, err)
 			require.Equal(t, d.expect, res)
 
 			improveRequestService.AssertExpectations(t)
 			tokenService.AssertExpectations(t)
 			keysService.AssertExpectations(t)
+			duplicatesService.AssertExpectations(t)
+			collaboratorService.AssertExpectations(t)
 		})
 	}
 }
@@ -581,6 +1212,10 @@ func TestImprovePostProvider_DeleteImproveRequest(t *testing.T) {
 		improveRequestGetErr    error
 		improveRequestDeleteErr error
 
+		shouldCheckRole  bool
+		collaboratorData *models.ImproveRequestCollaborator
+		collaboratorErr  error
+
 		expectErr error
 	}{
 		{
@@ -639,6 +1274,76 @@ func TestImprovePostProvider_DeleteImproveRequest(t *testing.T) {
 				UpVotes:   10,
 				DownVotes: 2,
 			},
+			shouldCheckRole: true,
+			collaboratorErr: validation.ErrNotFound,
+			expectErr:       validation.ErrInvalidCredentials,
+		},
+		{
+			name:                                  "Success/OwnerCollaborator",
+			now:                                   baseTime,
+			keys:                                  jwk_storage.MockedKeys,
+			token:                                 "foo.bar.qux",
+			requestID:                             test_utils.NumberUUID(2),
+			shouldCallImproveRequestGetService:    true,
+			shouldCallImproveRequestDeleteService: true,
+			shouldCheckRole:                       true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(11)},
+			},
+			improveRequestGetData: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(2),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(12),
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+			},
+			collaboratorData: &models.ImproveRequestCollaborator{
+				Source:     test_utils.NumberUUID(1),
+				UserID:     test_utils.NumberUUID(11),
+				Role:       models.ImproveRequestCollaboratorRoleOwner,
+				InvitedBy:  test_utils.NumberUUID(10),
+				CreatedAt:  baseTime,
+				AcceptedAt: &baseTime,
+			},
+		},
+		{
+			name:                               "Error/EditorCollaborator",
+			now:                                baseTime,
+			keys:                               jwk_storage.MockedKeys,
+			token:                              "foo.bar.qux",
+			requestID:                          test_utils.NumberUUID(2),
+			shouldCallImproveRequestGetService: true,
+			shouldCheckRole:                    true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(11)},
+			},
+			improveRequestGetData: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(2),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(12),
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+			},
+			collaboratorData: &models.ImproveRequestCollaborator{
+				Source:     test_utils.NumberUUID(1),
+				UserID:     test_utils.NumberUUID(11),
+				Role:       models.ImproveRequestCollaboratorRoleEditor,
+				InvitedBy:  test_utils.NumberUUID(10),
+				CreatedAt:  baseTime,
+				AcceptedAt: &baseTime,
+			},
 			expectErr: validation.ErrInvalidCredentials,
 		},
 		{
@@ -708,6 +1413,7 @@ func TestImprovePostProvider_DeleteImproveRequest(t *testing.T) {
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
 			improveRequestService := improve_request_service.NewMockService(t)
+			collaboratorService := collaborator_service.NewMockService(t)
 
 			tokenService := token_service.NewMockService(t)
 			keysService := jwk_service.NewMockServiceCached(t)
@@ -731,14 +1437,24 @@ func TestImprovePostProvider_DeleteImproveRequest(t *testing.T) {
 					Return(d.improveRequestGetData, d.improveRequestGetErr)
 			}
 
+			if d.shouldCheckRole {
+				improveRequestService.
+					On("IsCreator", context.TODO(), d.tokenServiceDecodeData.Payload.ID, d.improveRequestGetData.Source, true).
+					Return(false, nil)
+				collaboratorService.
+					On("Read", context.TODO(), d.improveRequestGetData.Source, d.tokenServiceDecodeData.Payload.ID).
+					Return(d.collaboratorData, d.collaboratorErr)
+			}
+
 			if d.shouldCallImproveRequestDeleteService {
 				improveRequestService.
-					On("Delete", context.TODO(), d.requestID).
+					On("Delete", context.TODO(), d.requestID, d.now).
 					Return(d.improveRequestDeleteErr)
 			}
 
 			provider := NewProvider(Config{
 				ImproveRequestService: improveRequestService,
+				CollaboratorService:   collaboratorService,
 				TokenService:          tokenService,
 				KeysService:           keysService,
 				Time:                  test_utils.GetTimeNow(d.now),
@@ -748,401 +1464,578 @@ func TestImprovePostProvider_DeleteImproveRequest(t *testing.T) {
 			test_utils.RequireError(t, d.expectErr, err)
 
 			improveRequestService.AssertExpectations(t)
+			collaboratorService.AssertExpectations(t)
 			tokenService.AssertExpectations(t)
 			keysService.AssertExpectations(t)
 		})
 	}
 }
 
-func TestImprovePostProvider_SearchImproveRequests(t *testing.T) {
+func TestImprovePostProvider_RestoreImproveRequest(t *testing.T) {
+	restoreWindow := 24 * time.Hour
+
 	data := []struct {
 		name string
 
-		query  models.ImproveRequestSearch
-		limit  int
-		offset int
+		userID    uuid.UUID
+		requestID uuid.UUID
 
-		serviceData  []*models.ImproveRequestPreview
-		serviceTotal int64
-		serviceErr   error
+		tokenServiceDecodeErr error
 
-		expect      []*models.ImproveRequestPreview
-		expectTotal int64
-		expectErr   error
+		readDeletedData *models.ImproveRequest
+		readDeletedErr  error
+
+		shouldCheckRole  bool
+		collaboratorData *models.ImproveRequestCollaborator
+		collaboratorErr  error
+
+		shouldReadSource bool
+		readSourceErr    error
+
+		shouldRestore bool
+		restoreErr    error
+
+		expectErr error
 	}{
 		{
-			name: "Success",
-			query: models.ImproveRequestSearch{
-				UserID: framework.ToPTR(test_utils.NumberUUID(1)),
-				Query:  "foo",
+			name:      "Success",
+			userID:    test_utils.NumberUUID(10),
+			requestID: test_utils.NumberUUID(1),
+			readDeletedData: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime.Add(-48 * time.Hour),
+				UserID:    test_utils.NumberUUID(10),
+				DeletedAt: framework.ToPTR(baseTime.Add(-time.Hour)),
 			},
-			limit:  10,
-			offset: 20,
-			serviceData: []*models.ImproveRequestPreview{
-				{
-					ID:                  test_utils.NumberUUID(1),
-					Source:              test_utils.NumberUUID(1),
-					UserID:              test_utils.NumberUUID(1),
-					CreatedAt:           baseTime,
-					Title:               "Dummy request",
-					Content:             "Foo bar qux.",
-					UpVotes:             10,
-					DownVotes:           2,
-					RevisionCount:       3,
-					MoreRecentRevisions: 1,
-				},
-				{
-					ID:            test_utils.NumberUUID(3),
-					Source:        test_utils.NumberUUID(2),
-					UserID:        test_utils.NumberUUID(1),
-					CreatedAt:     baseTime,
-					Title:         "Smart request",
-					Content:       "Qux bar foo.",
-					UpVotes:       17,
-					DownVotes:     1,
-					RevisionCount: 1,
-				},
+			shouldRestore: true,
+		},
+		{
+			name:      "Success/Revision",
+			userID:    test_utils.NumberUUID(10),
+			requestID: test_utils.NumberUUID(2),
+			readDeletedData: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(2),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime.Add(-48 * time.Hour),
+				UserID:    test_utils.NumberUUID(10),
+				DeletedAt: framework.ToPTR(baseTime.Add(-time.Hour)),
 			},
-			serviceTotal: 20,
-			expect: []*models.ImproveRequestPreview{
-				{
-					ID:                  test_utils.NumberUUID(1),
-					Source:              test_utils.NumberUUID(1),
-					UserID:              test_utils.NumberUUID(1),
-					CreatedAt:           baseTime,
-					Title:               "Dummy request",
-					Content:             "Foo bar qux.",
-					UpVotes:             10,
-					DownVotes:           2,
-					RevisionCount:       3,
-					MoreRecentRevisions: 1,
-				},
-				{
-					ID:            test_utils.NumberUUID(3),
-					Source:        test_utils.NumberUUID(2),
-					UserID:        test_utils.NumberUUID(1),
-					CreatedAt:     baseTime,
-					Title:         "Smart request",
-					Content:       "Qux bar foo.",
-					UpVotes:       17,
-					DownVotes:     1,
-					RevisionCount: 1,
-				},
+			shouldReadSource: true,
+			shouldRestore:    true,
+		},
+		{
+			name:      "Success/OwnerCollaborator",
+			userID:    test_utils.NumberUUID(11),
+			requestID: test_utils.NumberUUID(1),
+			readDeletedData: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime.Add(-48 * time.Hour),
+				UserID:    test_utils.NumberUUID(10),
+				DeletedAt: framework.ToPTR(baseTime.Add(-time.Hour)),
 			},
-			expectTotal: 20,
+			shouldCheckRole: true,
+			collaboratorData: &models.ImproveRequestCollaborator{
+				Source:     test_utils.NumberUUID(1),
+				UserID:     test_utils.NumberUUID(11),
+				Role:       models.ImproveRequestCollaboratorRoleOwner,
+				InvitedBy:  test_utils.NumberUUID(10),
+				CreatedAt:  baseTime.Add(-48 * time.Hour),
+				AcceptedAt: framework.ToPTR(baseTime.Add(-48 * time.Hour)),
+			},
+			shouldRestore: true,
 		},
 		{
-			name: "Error/ServiceFailure",
-			query: models.ImproveRequestSearch{
-				UserID: framework.ToPTR(test_utils.NumberUUID(1)),
-				Query:  "foo",
+			name:      "Error/UnauthorizedUser",
+			userID:    test_utils.NumberUUID(11),
+			requestID: test_utils.NumberUUID(1),
+			readDeletedData: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime.Add(-48 * time.Hour),
+				UserID:    test_utils.NumberUUID(10),
+				DeletedAt: framework.ToPTR(baseTime.Add(-time.Hour)),
 			},
-			limit:      10,
-			offset:     20,
-			serviceErr: fooErr,
-			expectErr:  fooErr,
+			shouldCheckRole: true,
+			collaboratorErr: validation.ErrNotFound,
+			expectErr:       validation.ErrInvalidCredentials,
 		},
-	}
-
-	for _, d := range data {
-		t.Run(d.name, func(t *testing.T) {
-			improveRequestService := improve_request_service.NewMockService(t)
-
-			improveRequestService.
-				On("Search", context.TODO(), models.ImproveRequestSearch{
-					UserID: d.query.UserID,
-					Query:  d.query.Query,
-				}, d.limit, d.offset).
-				Return(d.serviceData, d.serviceTotal, d.serviceErr)
-
-			provider := NewProvider(Config{
-				ImproveRequestService: improveRequestService,
-			})
-
-			res, total, err := provider.SearchImproveRequests(context.TODO(), d.query, d.limit, d.offset)
-			test_utils.RequireError(t, d.expectErr, err)
-			require.Equal(t, d.expect, res)
-			require.Equal(t, d.expectTotal, total)
-
-			improveRequestService.AssertExpectations(t)
-		})
-	}
-}
-
-func TestImprovePostProvider_GetImproveRequestPreviews(t *testing.T) {
-	data := []struct {
-		name string
-
-		ids []uuid.UUID
-
-		serviceData []*models.ImproveRequestPreview
-		serviceErr  error
-
-		expect    []*models.ImproveRequestPreview
-		expectErr error
-	}{
 		{
-			name: "Success",
-			ids: []uuid.UUID{
-				test_utils.NumberUUID(1),
-				test_utils.NumberUUID(2),
-			},
-			serviceData: []*models.ImproveRequestPreview{
-				{
-					ID:                  test_utils.NumberUUID(1),
-					Source:              test_utils.NumberUUID(2),
-					CreatedAt:           baseTime,
-					UserID:              test_utils.NumberUUID(1),
-					Title:               "Dummy post",
-					Content:             "Foo bar qux.",
-					UpVotes:             10,
-					DownVotes:           5,
-					MoreRecentRevisions: 1,
-					RevisionCount:       10,
-				},
-				{
-					ID:                  test_utils.NumberUUID(42),
-					Source:              test_utils.NumberUUID(666),
-					CreatedAt:           baseTime,
-					UserID:              test_utils.NumberUUID(12),
-					Title:               "Smart post",
-					Content:             "Cats taking a nap.",
-					UpVotes:             4,
-					DownVotes:           1,
-					MoreRecentRevisions: 3,
-					RevisionCount:       4,
-				},
+			name:      "Error/SourceDeleted",
+			userID:    test_utils.NumberUUID(10),
+			requestID: test_utils.NumberUUID(2),
+			readDeletedData: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(2),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime.Add(-48 * time.Hour),
+				UserID:    test_utils.NumberUUID(10),
+				DeletedAt: framework.ToPTR(baseTime.Add(-time.Hour)),
 			},
-			expect: []*models.ImproveRequestPreview{
-				{
-					ID:                  test_utils.NumberUUID(1),
-					Source:              test_utils.NumberUUID(2),
-					CreatedAt:           baseTime,
-					UserID:              test_utils.NumberUUID(1),
-					Title:               "Dummy post",
-					Content:             "Foo bar qux.",
-					UpVotes:             10,
-					DownVotes:           5,
-					MoreRecentRevisions: 1,
-					RevisionCount:       10,
-				},
-				{
-					ID:                  test_utils.NumberUUID(42),
-					Source:              test_utils.NumberUUID(666),
-					CreatedAt:           baseTime,
-					UserID:              test_utils.NumberUUID(12),
-					Title:               "Smart post",
-					Content:             "Cats taking a nap.",
-					UpVotes:             4,
-					DownVotes:           1,
-					MoreRecentRevisions: 3,
-					RevisionCount:       4,
-				},
+			shouldReadSource: true,
+			readSourceErr:    validation.ErrNotFound,
+			expectErr:        validation.ErrNotFound,
+		},
+		{
+			name:      "Error/RestoreWindowExpired",
+			userID:    test_utils.NumberUUID(10),
+			requestID: test_utils.NumberUUID(1),
+			readDeletedData: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime.Add(-72 * time.Hour),
+				UserID:    test_utils.NumberUUID(10),
+				DeletedAt: framework.ToPTR(baseTime.Add(-48 * time.Hour)),
 			},
+			expectErr: validation.ErrInvalidCredentials,
 		},
 		{
-			name: "Error/ServiceFailure",
-			ids: []uuid.UUID{
-				test_utils.NumberUUID(1),
-				test_utils.NumberUUID(2),
+			name:           "Error/ReadDeletedFailure",
+			userID:         test_utils.NumberUUID(10),
+			requestID:      test_utils.NumberUUID(1),
+			readDeletedErr: fooErr,
+			expectErr:      fooErr,
+		},
+		{
+			name:      "Error/RestoreFailure",
+			userID:    test_utils.NumberUUID(10),
+			requestID: test_utils.NumberUUID(1),
+			readDeletedData: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime.Add(-48 * time.Hour),
+				UserID:    test_utils.NumberUUID(10),
+				DeletedAt: framework.ToPTR(baseTime.Add(-time.Hour)),
 			},
-			serviceErr: fooErr,
-			expectErr:  fooErr,
+			shouldRestore: true,
+			restoreErr:    fooErr,
+			expectErr:     fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			requestID:             test_utils.NumberUUID(1),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
 		},
 	}
 
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
 			improveRequestService := improve_request_service.NewMockService(t)
+			collaboratorService := collaborator_service.NewMockService(t)
 
-			improveRequestService.
-				On("GetPreviews", context.TODO(), d.ids).
-				Return(d.serviceData, d.serviceErr)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			var tokenData *models.UserToken
+			if d.tokenServiceDecodeErr == nil {
+				tokenData = &models.UserToken{
+					Header: models.UserTokenHeader{
+						IAT: baseTime.Add(-time.Hour),
+						EXP: baseTime.Add(time.Hour),
+						ID:  test_utils.NumberUUID(100),
+					},
+					Payload: models.UserTokenPayload{ID: d.userID},
+				}
+
+				improveRequestService.
+					On("ReadDeleted", context.TODO(), d.requestID).
+					Return(d.readDeletedData, d.readDeletedErr)
+			}
+
+			tokenService.
+				On("Decode", "foo.bar.qux", publicKeys, baseTime).
+				Return(tokenData, d.tokenServiceDecodeErr)
+
+			if d.shouldCheckRole {
+				improveRequestService.
+					On("IsCreator", context.TODO(), d.userID, d.readDeletedData.Source, true).
+					Return(false, nil)
+				collaboratorService.
+					On("Read", context.TODO(), d.readDeletedData.Source, d.userID).
+					Return(d.collaboratorData, d.collaboratorErr)
+			}
+
+			if d.shouldReadSource {
+				improveRequestService.
+					On("Read", context.TODO(), d.readDeletedData.Source).
+					Return(&models.ImproveRequest{ID: d.readDeletedData.Source}, d.readSourceErr)
+			}
+
+			if d.shouldRestore {
+				improveRequestService.
+					On("Restore", context.TODO(), d.requestID, baseTime.Add(-restoreWindow)).
+					Return(d.restoreErr)
+			}
 
 			provider := NewProvider(Config{
 				ImproveRequestService: improveRequestService,
+				CollaboratorService:   collaboratorService,
+				TokenService:          tokenService,
+				KeysService:           keysService,
+				RestoreWindow:         restoreWindow,
+				Time:                  test_utils.GetTimeNow(baseTime),
 			})
 
-			res, err := provider.GetImproveRequestPreviews(context.TODO(), d.ids)
+			err := provider.RestoreImproveRequest(context.TODO(), "foo.bar.qux", d.requestID)
 			test_utils.RequireError(t, d.expectErr, err)
-			require.Equal(t, d.expect, res)
 
 			improveRequestService.AssertExpectations(t)
+			collaboratorService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
 		})
 	}
 }
 
-func TestImprovePostProvider_ReadImproveSuggestion(t *testing.T) {
+func TestImprovePostProvider_ListImproveRequestCollaborators(t *testing.T) {
+	userToken := &models.UserToken{
+		Header: models.UserTokenHeader{
+			IAT: baseTime.Add(-time.Hour),
+			EXP: baseTime.Add(time.Hour),
+			ID:  test_utils.NumberUUID(100),
+		},
+		Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+	}
+
+	collaborators := []*models.ImproveRequestCollaborator{
+		{
+			Source:     test_utils.NumberUUID(1),
+			UserID:     test_utils.NumberUUID(20),
+			Role:       models.ImproveRequestCollaboratorRoleEditor,
+			InvitedBy:  test_utils.NumberUUID(10),
+			CreatedAt:  baseTime,
+			AcceptedAt: &baseTime,
+		},
+		{
+			Source:    test_utils.NumberUUID(1),
+			UserID:    test_utils.NumberUUID(21),
+			Role:      models.ImproveRequestCollaboratorRoleOwner,
+			InvitedBy: test_utils.NumberUUID(10),
+			CreatedAt: baseTime,
+		},
+	}
+
 	data := []struct {
 		name string
 
-		id uuid.UUID
+		token     string
+		requestID uuid.UUID
 
-		serviceData *models.ImproveSuggestion
-		serviceErr  error
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
 
-		expect    *models.ImproveSuggestion
+		shouldCallRead   bool
+		readErr          error
+		isCreatorData    bool
+		collaboratorRole models.ImproveRequestCollaboratorRole
+
+		shouldCallList bool
+		listData       []*models.ImproveRequestCollaborator
+		listErr        error
+
+		expect    []*models.ImproveRequestCollaborator
 		expectErr error
 	}{
 		{
-			name: "Success",
-			id:   test_utils.NumberUUID(1),
-			serviceData: &models.ImproveSuggestion{
-				ID:        test_utils.NumberUUID(1),
-				CreatedAt: baseTime,
-				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
-				SourceID:  test_utils.NumberUUID(10),
-				UserID:    test_utils.NumberUUID(100),
-				Validated: true,
-				UpVotes:   32,
-				DownVotes: 2,
-				RequestID: test_utils.NumberUUID(11),
-				Title:     "Dummy suggestion",
-				Content:   "Foo bar qux.",
-			},
-			expect: &models.ImproveSuggestion{
-				ID:        test_utils.NumberUUID(1),
-				CreatedAt: baseTime,
-				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
-				SourceID:  test_utils.NumberUUID(10),
-				UserID:    test_utils.NumberUUID(100),
-				Validated: true,
-				UpVotes:   32,
-				DownVotes: 2,
-				RequestID: test_utils.NumberUUID(11),
-				Title:     "Dummy suggestion",
-				Content:   "Foo bar qux.",
-			},
+			name:                   "Success",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			isCreatorData:          true,
+			shouldCallList:         true,
+			listData:               collaborators,
+			expect:                 collaborators,
 		},
 		{
-			name:       "Error/ServiceFailure",
-			id:         test_utils.NumberUUID(1),
-			serviceErr: fooErr,
-			expectErr:  fooErr,
+			name:                   "Success/Editor",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			collaboratorRole:       models.ImproveRequestCollaboratorRoleEditor,
+			shouldCallList:         true,
+			listData:               collaborators,
+			expect:                 collaborators,
+		},
+		{
+			name:                   "Error/NotCollaborator",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			expectErr:              validation.ErrInvalidCredentials,
+		},
+		{
+			name:                   "Error/CollaboratorServiceFailure",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			isCreatorData:          true,
+			shouldCallList:         true,
+			listErr:                fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                   "Error/ImproveRequestServiceFailure",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			readErr:                fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			requestID:             test_utils.NumberUUID(2),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
 		},
 	}
 
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
-			improveSuggestionService := improve_suggestion_service.NewMockService(t)
+			improveRequestService := improve_request_service.NewMockService(t)
+			collaboratorService := collaborator_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
 
-			improveSuggestionService.
-				On("Read", context.TODO(), d.id).
-				Return(d.serviceData, d.serviceErr)
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallRead {
+				improveRequestService.
+					On("Read", context.TODO(), d.requestID).
+					Return(&models.ImproveRequest{
+						ID:     d.requestID,
+						Source: test_utils.NumberUUID(1),
+						UserID: test_utils.NumberUUID(10),
+					}, d.readErr)
+
+				if d.readErr == nil {
+					improveRequestService.
+						On("IsCreator", context.TODO(), test_utils.NumberUUID(10), test_utils.NumberUUID(1), true).
+						Return(d.isCreatorData, nil)
+
+					if !d.isCreatorData && d.collaboratorRole == "" {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(nil, validation.ErrNotFound)
+					} else if !d.isCreatorData {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(&models.ImproveRequestCollaborator{
+								Source:     test_utils.NumberUUID(1),
+								UserID:     test_utils.NumberUUID(10),
+								Role:       d.collaboratorRole,
+								CreatedAt:  baseTime,
+								AcceptedAt: &baseTime,
+							}, nil)
+					}
+				}
+			}
+
+			if d.shouldCallList {
+				collaboratorService.
+					On("List", context.TODO(), test_utils.NumberUUID(1)).
+					Return(d.listData, d.listErr)
+			}
 
 			provider := NewProvider(Config{
-				ImproveSuggestionService: improveSuggestionService,
+				ImproveRequestService: improveRequestService,
+				CollaboratorService:   collaboratorService,
+				TokenService:          tokenService,
+				KeysService:           keysService,
+				Time:                  test_utils.GetTimeNow(baseTime),
 			})
 
-			res, err := provider.ReadImproveSuggestion(context.TODO(), d.id)
+			res, err := provider.ListImproveRequestCollaborators(context.TODO(), d.token, d.requestID)
 			test_utils.RequireError(t, d.expectErr, err)
 			require.Equal(t, d.expect, res)
 
-			improveSuggestionService.AssertExpectations(t)
+			improveRequestService.AssertExpectations(t)
+			collaboratorService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
 		})
 	}
 }
 
-func TestImprovePostProvider_CreateImproveSuggestion(t *testing.T) {
+func TestImprovePostProvider_InviteImproveRequestCollaborator(t *testing.T) {
+	userToken := &models.UserToken{
+		Header: models.UserTokenHeader{
+			IAT: baseTime.Add(-time.Hour),
+			EXP: baseTime.Add(time.Hour),
+			ID:  test_utils.NumberUUID(100),
+		},
+		Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+	}
+
 	data := []struct {
 		name string
 
-		now       time.Time
-		keys      []ed25519.PrivateKey
-		id        uuid.UUID
-		userID    uuid.UUID
+		token     string
 		requestID uuid.UUID
-		sourceID  uuid.UUID
-
-		token   string
-		title   string
-		content string
-
-		shouldCallImproveSuggestionService bool
+		userID    uuid.UUID
+		role      models.ImproveRequestCollaboratorRole
 
 		tokenServiceDecodeData *models.UserToken
 		tokenServiceDecodeErr  error
-		improveSuggestionData  *models.ImproveSuggestion
-		improveSuggestionErr   error
 
-		expect    *models.ImproveSuggestion
+		shouldCallRead   bool
+		readErr          error
+		isCreatorData    bool
+		collaboratorRole models.ImproveRequestCollaboratorRole
+
+		shouldCheckInvitee bool
+		inviteeIsCreator   bool
+
+		shouldCallInvite bool
+		inviteData       *models.ImproveRequestCollaborator
+		inviteErr        error
+
+		expect    *models.ImproveRequestCollaborator
 		expectErr error
 	}{
 		{
-			name:                               "Success",
-			now:                                baseTime,
-			keys:                               jwk_storage.MockedKeys,
-			id:                                 test_utils.NumberUUID(1),
-			userID:                             test_utils.NumberUUID(10),
-			token:                              "foo.bar.qux",
-			title:                              "Dummy request",
-			content:                            "Foo bar qux.",
-			shouldCallImproveSuggestionService: true,
-			tokenServiceDecodeData: &models.UserToken{
-				Header: models.UserTokenHeader{
-					IAT: baseTime.Add(-time.Hour),
-					EXP: baseTime.Add(time.Hour),
-					ID:  test_utils.NumberUUID(100),
-				},
-				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
-			},
-			improveSuggestionData: &models.ImproveSuggestion{
-				ID:        test_utils.NumberUUID(1),
+			name:                   "Success",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			userID:                 test_utils.NumberUUID(20),
+			role:                   models.ImproveRequestCollaboratorRoleEditor,
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			isCreatorData:          true,
+			shouldCheckInvitee:     true,
+			shouldCallInvite:       true,
+			inviteData: &models.ImproveRequestCollaborator{
+				Source:    test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(20),
+				Role:      models.ImproveRequestCollaboratorRoleEditor,
+				InvitedBy: test_utils.NumberUUID(10),
 				CreatedAt: baseTime,
-				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
-				SourceID:  test_utils.NumberUUID(10),
-				UserID:    test_utils.NumberUUID(100),
-				Validated: true,
-				UpVotes:   32,
-				DownVotes: 2,
-				RequestID: test_utils.NumberUUID(11),
-				Title:     "Dummy suggestion",
-				Content:   "Foo bar qux.",
 			},
-			expect: &models.ImproveSuggestion{
-				ID:        test_utils.NumberUUID(1),
+			expect: &models.ImproveRequestCollaborator{
+				Source:    test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(20),
+				Role:      models.ImproveRequestCollaboratorRoleEditor,
+				InvitedBy: test_utils.NumberUUID(10),
 				CreatedAt: baseTime,
-				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
-				SourceID:  test_utils.NumberUUID(10),
-				UserID:    test_utils.NumberUUID(100),
-				Validated: true,
-				UpVotes:   32,
-				DownVotes: 2,
-				RequestID: test_utils.NumberUUID(11),
-				Title:     "Dummy suggestion",
-				Content:   "Foo bar qux.",
 			},
 		},
 		{
-			name:                               "Error/ImproveSuggestionServiceFailure",
-			now:                                baseTime,
-			keys:                               jwk_storage.MockedKeys,
-			id:                                 test_utils.NumberUUID(1),
-			userID:                             test_utils.NumberUUID(10),
-			token:                              "foo.bar.qux",
-			title:                              "Dummy request",
-			content:                            "Foo bar qux.",
-			shouldCallImproveSuggestionService: true,
-			tokenServiceDecodeData: &models.UserToken{
-				Header: models.UserTokenHeader{
-					IAT: baseTime.Add(-time.Hour),
-					EXP: baseTime.Add(time.Hour),
-					ID:  test_utils.NumberUUID(100),
-				},
-				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			name:                   "Success/OwnerCollaborator",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			userID:                 test_utils.NumberUUID(20),
+			role:                   models.ImproveRequestCollaboratorRoleOwner,
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			collaboratorRole:       models.ImproveRequestCollaboratorRoleOwner,
+			shouldCheckInvitee:     true,
+			shouldCallInvite:       true,
+			inviteData: &models.ImproveRequestCollaborator{
+				Source:    test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(20),
+				Role:      models.ImproveRequestCollaboratorRoleOwner,
+				InvitedBy: test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+			},
+			expect: &models.ImproveRequestCollaborator{
+				Source:    test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(20),
+				Role:      models.ImproveRequestCollaboratorRoleOwner,
+				InvitedBy: test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
 			},
-			improveSuggestionErr: fooErr,
-			expectErr:            fooErr,
+		},
+		{
+			name:                   "Error/InviteCreator",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			userID:                 test_utils.NumberUUID(20),
+			role:                   models.ImproveRequestCollaboratorRoleEditor,
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			collaboratorRole:       models.ImproveRequestCollaboratorRoleOwner,
+			shouldCheckInvitee:     true,
+			inviteeIsCreator:       true,
+			expectErr:              validation.ErrInvalidEntity,
+		},
+		{
+			name:                   "Error/EditorCollaborator",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			userID:                 test_utils.NumberUUID(20),
+			role:                   models.ImproveRequestCollaboratorRoleEditor,
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			collaboratorRole:       models.ImproveRequestCollaboratorRoleEditor,
+			expectErr:              validation.ErrInvalidCredentials,
+		},
+		{
+			name:                   "Error/NotOwner",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			userID:                 test_utils.NumberUUID(20),
+			role:                   models.ImproveRequestCollaboratorRoleEditor,
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			expectErr:              validation.ErrInvalidCredentials,
+		},
+		{
+			name:                   "Error/CollaboratorServiceFailure",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			userID:                 test_utils.NumberUUID(20),
+			role:                   models.ImproveRequestCollaboratorRoleEditor,
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			isCreatorData:          true,
+			shouldCheckInvitee:     true,
+			shouldCallInvite:       true,
+			inviteErr:              fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                   "Error/ImproveRequestServiceFailure",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			userID:                 test_utils.NumberUUID(20),
+			role:                   models.ImproveRequestCollaboratorRoleEditor,
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			readErr:                fooErr,
+			expectErr:              fooErr,
 		},
 		{
 			name:                  "Error/TokenServiceFailure",
-			now:                   baseTime,
-			keys:                  jwk_storage.MockedKeys,
-			id:                    test_utils.NumberUUID(1),
-			userID:                test_utils.NumberUUID(10),
 			token:                 "foo.bar.qux",
-			title:                 "Dummy request",
-			content:               "Foo bar qux.",
+			requestID:             test_utils.NumberUUID(2),
+			userID:                test_utils.NumberUUID(20),
+			role:                  models.ImproveRequestCollaboratorRoleEditor,
 			tokenServiceDecodeErr: fooErr,
 			expectErr:             fooErr,
 		},
@@ -1150,13 +2043,13 @@ func TestImprovePostProvider_CreateImproveSuggestion(t *testing.T) {
 
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
-			improveSuggestionService := improve_suggestion_service.NewMockService(t)
-
+			improveRequestService := improve_request_service.NewMockService(t)
+			collaboratorService := collaborator_service.NewMockService(t)
 			tokenService := token_service.NewMockService(t)
 			keysService := jwk_service.NewMockServiceCached(t)
 
-			publicKeys := make([]ed25519.PublicKey, len(d.keys))
-			for i, key := range d.keys {
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
 				publicKeys[i] = key.Public().(ed25519.PublicKey)
 			}
 
@@ -1165,227 +2058,163 @@ func TestImprovePostProvider_CreateImproveSuggestion(t *testing.T) {
 				Return(publicKeys)
 
 			tokenService.
-				On("Decode", d.token, publicKeys, d.now).
+				On("Decode", d.token, publicKeys, baseTime).
 				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
 
-			if d.shouldCallImproveSuggestionService {
-				improveSuggestionService.
-					On("Create", context.TODO(), &models.ImproveSuggestionUpsert{
-						RequestID: d.requestID,
-						Title:     d.title,
-						Content:   d.content,
-					}, d.userID, d.sourceID, d.id, d.now).
-					Return(d.improveSuggestionData, d.improveSuggestionErr)
+			if d.shouldCallRead {
+				improveRequestService.
+					On("Read", context.TODO(), d.requestID).
+					Return(&models.ImproveRequest{
+						ID:     d.requestID,
+						Source: test_utils.NumberUUID(1),
+						UserID: test_utils.NumberUUID(10),
+					}, d.readErr)
+
+				if d.readErr == nil {
+					improveRequestService.
+						On("IsCreator", context.TODO(), test_utils.NumberUUID(10), test_utils.NumberUUID(1), true).
+						Return(d.isCreatorData, nil)
+
+					if !d.isCreatorData && d.collaboratorRole == "" {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(nil, validation.ErrNotFound)
+					} else if !d.isCreatorData {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(&models.ImproveRequestCollaborator{
+								Source:     test_utils.NumberUUID(1),
+								UserID:     test_utils.NumberUUID(10),
+								Role:       d.collaboratorRole,
+								CreatedAt:  baseTime,
+								AcceptedAt: &baseTime,
+							}, nil)
+					}
+				}
+			}
+
+			if d.shouldCheckInvitee {
+				improveRequestService.
+					On("IsCreator", context.TODO(), d.userID, test_utils.NumberUUID(1), true).
+					Return(d.inviteeIsCreator, nil)
+			}
+
+			if d.shouldCallInvite {
+				collaboratorService.
+					On("Invite", context.TODO(), test_utils.NumberUUID(1), d.userID, test_utils.NumberUUID(10), d.role, baseTime).
+					Return(d.inviteData, d.inviteErr)
 			}
 
 			provider := NewProvider(Config{
-				ImproveSuggestionService: improveSuggestionService,
-				TokenService:             tokenService,
-				KeysService:              keysService,
-				Time:                     test_utils.GetTimeNow(d.now),
-				ID:                       test_utils.GetUUID(d.id),
+				ImproveRequestService: improveRequestService,
+				CollaboratorService:   collaboratorService,
+				TokenService:          tokenService,
+				KeysService:           keysService,
+				Time:                  test_utils.GetTimeNow(baseTime),
 			})
 
-			res, err := provider.CreateImproveSuggestion(context.TODO(), d.token, d.requestID, d.sourceID, d.title, d.content)
+			res, err := provider.InviteImproveRequestCollaborator(context.TODO(), d.token, d.requestID, d.userID, d.role)
 			test_utils.RequireError(t, d.expectErr, err)
 			require.Equal(t, d.expect, res)
 
-			improveSuggestionService.AssertExpectations(t)
+			improveRequestService.AssertExpectations(t)
+			collaboratorService.AssertExpectations(t)
 			tokenService.AssertExpectations(t)
 			keysService.AssertExpectations(t)
 		})
 	}
 }
 
-func TestImprovePostProvider_UpdateImproveSuggestion(t *testing.T) {
+func TestImprovePostProvider_UpdateImproveRequestCollaborator(t *testing.T) {
+	userToken := &models.UserToken{
+		Header: models.UserTokenHeader{
+			IAT: baseTime.Add(-time.Hour),
+			EXP: baseTime.Add(time.Hour),
+			ID:  test_utils.NumberUUID(100),
+		},
+		Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+	}
+
 	data := []struct {
 		name string
 
-		now    time.Time
-		keys   []ed25519.PrivateKey
-		userID uuid.UUID
-
 		token     string
-		title     string
-		content   string
-		postID    uuid.UUID
 		requestID uuid.UUID
+		userID    uuid.UUID
+		role      models.ImproveRequestCollaboratorRole
 
-		shouldCallImproveSuggestionGetService    bool
-		shouldCallImproveSuggestionUpdateService bool
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
 
-		tokenServiceDecodeData      *models.UserToken
-		tokenServiceDecodeErr       error
-		improveSuggestionGetData    *models.ImproveSuggestion
-		improveSuggestionGetErr     error
-		improveSuggestionUpdateData *models.ImproveSuggestion
-		improveSuggestionUpdateErr  error
+		shouldCallRead   bool
+		readErr          error
+		isCreatorData    bool
+		collaboratorRole models.ImproveRequestCollaboratorRole
 
-		expect    *models.ImproveSuggestion
+		shouldCallUpdate bool
+		updateData       *models.ImproveRequestCollaborator
+		updateErr        error
+
+		expect    *models.ImproveRequestCollaborator
 		expectErr error
 	}{
 		{
-			name:                                     "Success",
-			now:                                      baseTime,
-			keys:                                     jwk_storage.MockedKeys,
-			postID:                                   test_utils.NumberUUID(1),
-			requestID:                                test_utils.NumberUUID(10),
-			userID:                                   test_utils.NumberUUID(100),
-			token:                                    "foo.bar.qux",
-			title:                                    "Smart request",
-			content:                                  "Qux bar foo.",
-			shouldCallImproveSuggestionGetService:    true,
-			shouldCallImproveSuggestionUpdateService: true,
-			tokenServiceDecodeData: &models.UserToken{
-				Header: models.UserTokenHeader{
-					IAT: baseTime.Add(-time.Hour),
-					EXP: baseTime.Add(time.Hour),
-					ID:  test_utils.NumberUUID(100),
-				},
-				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
-			},
-			improveSuggestionGetData: &models.ImproveSuggestion{
-				ID:        test_utils.NumberUUID(1),
-				CreatedAt: baseTime,
-				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
-				SourceID:  test_utils.NumberUUID(10),
-				UserID:    test_utils.NumberUUID(100),
-				Validated: true,
-				UpVotes:   32,
-				DownVotes: 2,
-				RequestID: test_utils.NumberUUID(11),
-				Title:     "Dummy suggestion",
-				Content:   "Foo bar qux.",
-			},
-			improveSuggestionUpdateData: &models.ImproveSuggestion{
-				ID:        test_utils.NumberUUID(1),
-				CreatedAt: baseTime,
-				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
-				SourceID:  test_utils.NumberUUID(10),
-				UserID:    test_utils.NumberUUID(100),
-				Validated: true,
-				UpVotes:   32,
-				DownVotes: 2,
-				RequestID: test_utils.NumberUUID(11),
-				Title:     "Smart request",
-				Content:   "Qux bar foo.",
+			name:                   "Success",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			userID:                 test_utils.NumberUUID(20),
+			role:                   models.ImproveRequestCollaboratorRoleOwner,
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			isCreatorData:          true,
+			shouldCallUpdate:       true,
+			updateData: &models.ImproveRequestCollaborator{
+				Source:     test_utils.NumberUUID(1),
+				UserID:     test_utils.NumberUUID(20),
+				Role:       models.ImproveRequestCollaboratorRoleOwner,
+				InvitedBy:  test_utils.NumberUUID(10),
+				CreatedAt:  baseTime,
+				AcceptedAt: &baseTime,
 			},
-			expect: &models.ImproveSuggestion{
-				ID:        test_utils.NumberUUID(1),
-				CreatedAt: baseTime,
-				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
-				SourceID:  test_utils.NumberUUID(10),
-				UserID:    test_utils.NumberUUID(100),
-				Validated: true,
-				UpVotes:   32,
-				DownVotes: 2,
-				RequestID: test_utils.NumberUUID(11),
-				Title:     "Smart request",
-				Content:   "Qux bar foo.",
+			expect: &models.ImproveRequestCollaborator{
+				Source:     test_utils.NumberUUID(1),
+				UserID:     test_utils.NumberUUID(20),
+				Role:       models.ImproveRequestCollaboratorRoleOwner,
+				InvitedBy:  test_utils.NumberUUID(10),
+				CreatedAt:  baseTime,
+				AcceptedAt: &baseTime,
 			},
 		},
 		{
-			name:                                  "Error/UnauthorizedUser",
-			now:                                   baseTime,
-			keys:                                  jwk_storage.MockedKeys,
-			token:                                 "foo.bar.qux",
-			title:                                 "Smart request",
-			content:                               "Qux bar foo.",
-			postID:                                test_utils.NumberUUID(1),
-			requestID:                             test_utils.NumberUUID(10),
-			userID:                                test_utils.NumberUUID(101),
-			shouldCallImproveSuggestionGetService: true,
-			tokenServiceDecodeData: &models.UserToken{
-				Header: models.UserTokenHeader{
-					IAT: baseTime.Add(-time.Hour),
-					EXP: baseTime.Add(time.Hour),
-					ID:  test_utils.NumberUUID(100),
-				},
-				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(101)},
-			},
-			improveSuggestionGetData: &models.ImproveSuggestion{
-				ID:        test_utils.NumberUUID(1),
-				CreatedAt: baseTime,
-				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
-				SourceID:  test_utils.NumberUUID(10),
-				UserID:    test_utils.NumberUUID(100),
-				Validated: true,
-				UpVotes:   32,
-				DownVotes: 2,
-				RequestID: test_utils.NumberUUID(11),
-				Title:     "Dummy suggestion",
-				Content:   "Foo bar qux.",
-			},
-			expectErr: validation.ErrInvalidCredentials,
+			name:                   "Error/NotOwner",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			userID:                 test_utils.NumberUUID(20),
+			role:                   models.ImproveRequestCollaboratorRoleOwner,
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			collaboratorRole:       models.ImproveRequestCollaboratorRoleEditor,
+			expectErr:              validation.ErrInvalidCredentials,
 		},
 		{
-			name:                                     "Error/ImproveRequestServiceCreateFailure",
-			now:                                      baseTime,
-			keys:                                     jwk_storage.MockedKeys,
-			token:                                    "foo.bar.qux",
-			title:                                    "Smart request",
-			content:                                  "Qux bar foo.",
-			postID:                                   test_utils.NumberUUID(1),
-			requestID:                                test_utils.NumberUUID(10),
-			userID:                                   test_utils.NumberUUID(100),
-			shouldCallImproveSuggestionGetService:    true,
-			shouldCallImproveSuggestionUpdateService: true,
-			tokenServiceDecodeData: &models.UserToken{
-				Header: models.UserTokenHeader{
-					IAT: baseTime.Add(-time.Hour),
-					EXP: baseTime.Add(time.Hour),
-					ID:  test_utils.NumberUUID(100),
-				},
-				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
-			},
-			improveSuggestionGetData: &models.ImproveSuggestion{
-				ID:        test_utils.NumberUUID(1),
-				CreatedAt: baseTime,
-				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
-				SourceID:  test_utils.NumberUUID(10),
-				UserID:    test_utils.NumberUUID(100),
-				Validated: true,
-				UpVotes:   32,
-				DownVotes: 2,
-				RequestID: test_utils.NumberUUID(11),
-				Title:     "Dummy suggestion",
-				Content:   "Foo bar qux.",
-			},
-			improveSuggestionUpdateErr: fooErr,
-			expectErr:                  fooErr,
-		},
-		{
-			name:                                  "Error/ImproveRequestServiceGetFailure",
-			now:                                   baseTime,
-			keys:                                  jwk_storage.MockedKeys,
-			token:                                 "foo.bar.qux",
-			title:                                 "Smart request",
-			content:                               "Qux bar foo.",
-			postID:                                test_utils.NumberUUID(1),
-			requestID:                             test_utils.NumberUUID(10),
-			userID:                                test_utils.NumberUUID(100),
-			shouldCallImproveSuggestionGetService: true,
-			tokenServiceDecodeData: &models.UserToken{
-				Header: models.UserTokenHeader{
-					IAT: baseTime.Add(-time.Hour),
-					EXP: baseTime.Add(time.Hour),
-					ID:  test_utils.NumberUUID(100),
-				},
-				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
-			},
-			improveSuggestionGetErr: fooErr,
-			expectErr:               fooErr,
+			name:                   "Error/CollaboratorServiceFailure",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			userID:                 test_utils.NumberUUID(20),
+			role:                   models.ImproveRequestCollaboratorRoleOwner,
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			isCreatorData:          true,
+			shouldCallUpdate:       true,
+			updateErr:              fooErr,
+			expectErr:              fooErr,
 		},
 		{
 			name:                  "Error/TokenServiceFailure",
-			now:                   baseTime,
-			keys:                  jwk_storage.MockedKeys,
 			token:                 "foo.bar.qux",
-			title:                 "Smart request",
-			content:               "Qux bar foo.",
-			postID:                test_utils.NumberUUID(1),
-			requestID:             test_utils.NumberUUID(10),
-			userID:                test_utils.NumberUUID(100),
+			requestID:             test_utils.NumberUUID(2),
+			userID:                test_utils.NumberUUID(20),
+			role:                  models.ImproveRequestCollaboratorRoleOwner,
 			tokenServiceDecodeErr: fooErr,
 			expectErr:             fooErr,
 		},
@@ -1393,13 +2222,13 @@ func TestImprovePostProvider_UpdateImproveSuggestion(t *testing.T) {
 
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
-			improveSuggestionService := improve_suggestion_service.NewMockService(t)
-
+			improveRequestService := improve_request_service.NewMockService(t)
+			collaboratorService := collaborator_service.NewMockService(t)
 			tokenService := token_service.NewMockService(t)
 			keysService := jwk_service.NewMockServiceCached(t)
 
-			publicKeys := make([]ed25519.PublicKey, len(d.keys))
-			for i, key := range d.keys {
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
 				publicKeys[i] = key.Public().(ed25519.PublicKey)
 			}
 
@@ -1408,192 +2237,155 @@ func TestImprovePostProvider_UpdateImproveSuggestion(t *testing.T) {
 				Return(publicKeys)
 
 			tokenService.
-				On("Decode", d.token, publicKeys, d.now).
+				On("Decode", d.token, publicKeys, baseTime).
 				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
 
-			if d.shouldCallImproveSuggestionGetService {
-				improveSuggestionService.
-					On("Read", context.TODO(), d.postID).
-					Return(d.improveSuggestionGetData, d.improveSuggestionGetErr)
+			if d.shouldCallRead {
+				improveRequestService.
+					On("Read", context.TODO(), d.requestID).
+					Return(&models.ImproveRequest{
+						ID:     d.requestID,
+						Source: test_utils.NumberUUID(1),
+						UserID: test_utils.NumberUUID(10),
+					}, d.readErr)
+
+				if d.readErr == nil {
+					improveRequestService.
+						On("IsCreator", context.TODO(), test_utils.NumberUUID(10), test_utils.NumberUUID(1), true).
+						Return(d.isCreatorData, nil)
+
+					if !d.isCreatorData && d.collaboratorRole == "" {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(nil, validation.ErrNotFound)
+					} else if !d.isCreatorData {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(&models.ImproveRequestCollaborator{
+								Source:     test_utils.NumberUUID(1),
+								UserID:     test_utils.NumberUUID(10),
+								Role:       d.collaboratorRole,
+								CreatedAt:  baseTime,
+								AcceptedAt: &baseTime,
+							}, nil)
+					}
+				}
 			}
 
-			if d.shouldCallImproveSuggestionUpdateService {
-				improveSuggestionService.
-					On("Update", context.TODO(), &models.ImproveSuggestionUpsert{
-						RequestID: d.requestID,
-						Title:     d.title,
-						Content:   d.content,
-					}, d.postID, d.now).
-					Return(d.improveSuggestionUpdateData, d.improveSuggestionUpdateErr)
+			if d.shouldCallUpdate {
+				collaboratorService.
+					On("UpdateRole", context.TODO(), test_utils.NumberUUID(1), d.userID, d.role).
+					Return(d.updateData, d.updateErr)
 			}
 
 			provider := NewProvider(Config{
-				ImproveSuggestionService: improveSuggestionService,
-				TokenService:             tokenService,
-				KeysService:              keysService,
-				Time:                     test_utils.GetTimeNow(d.now),
+				ImproveRequestService: improveRequestService,
+				CollaboratorService:   collaboratorService,
+				TokenService:          tokenService,
+				KeysService:           keysService,
+				Time:                  test_utils.GetTimeNow(baseTime),
 			})
 
-			res, err := provider.UpdateImproveSuggestion(context.TODO(), d.token, d.postID, d.requestID, d.title, d.content)
+			res, err := provider.UpdateImproveRequestCollaborator(context.TODO(), d.token, d.requestID, d.userID, d.role)
 			test_utils.RequireError(t, d.expectErr, err)
 			require.Equal(t, d.expect, res)
 
-			improveSuggestionService.AssertExpectations(t)
+			improveRequestService.AssertExpectations(t)
+			collaboratorService.AssertExpectations(t)
 			tokenService.AssertExpectations(t)
 			keysService.AssertExpectations(t)
 		})
 	}
 }
 
-func TestImprovePostProvider_DeleteImproveSuggestion(t *testing.T) {
+func TestImprovePostProvider_RemoveImproveRequestCollaborator(t *testing.T) {
+	userToken := &models.UserToken{
+		Header: models.UserTokenHeader{
+			IAT: baseTime.Add(-time.Hour),
+			EXP: baseTime.Add(time.Hour),
+			ID:  test_utils.NumberUUID(100),
+		},
+		Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+	}
+
 	data := []struct {
 		name string
 
-		now  time.Time
-		keys []ed25519.PrivateKey
-
 		token     string
-		title     string
-		content   string
 		requestID uuid.UUID
+		userID    uuid.UUID
 
-		shouldCallImproveSuggestionGetService    bool
-		shouldCallImproveSuggestionDeleteService bool
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
 
-		tokenServiceDecodeData     *models.UserToken
-		tokenServiceDecodeErr      error
-		improveSuggestionGetData   *models.ImproveSuggestion
-		improveSuggestionGetErr    error
-		improveSuggestionDeleteErr error
+		shouldCallRead   bool
+		readErr          error
+		shouldCheckOwner bool
+		isCreatorData    bool
+		collaboratorRole models.ImproveRequestCollaboratorRole
+
+		shouldCallRemove bool
+		removeErr        error
 
 		expectErr error
 	}{
 		{
-			name:                                     "Success",
-			now:                                      baseTime,
-			keys:                                     jwk_storage.MockedKeys,
-			token:                                    "foo.bar.qux",
-			title:                                    "Smart request",
-			content:                                  "Qux bar foo.",
-			requestID:                                test_utils.NumberUUID(1),
-			shouldCallImproveSuggestionGetService:    true,
-			shouldCallImproveSuggestionDeleteService: true,
-			tokenServiceDecodeData: &models.UserToken{
-				Header: models.UserTokenHeader{
-					IAT: baseTime.Add(-time.Hour),
-					EXP: baseTime.Add(time.Hour),
-					ID:  test_utils.NumberUUID(100),
-				},
-				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
-			},
-			improveSuggestionGetData: &models.ImproveSuggestion{
-				ID:        test_utils.NumberUUID(1),
-				CreatedAt: baseTime,
-				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
-				SourceID:  test_utils.NumberUUID(10),
-				UserID:    test_utils.NumberUUID(100),
-				Validated: true,
-				UpVotes:   32,
-				DownVotes: 2,
-				RequestID: test_utils.NumberUUID(11),
-				Title:     "Dummy suggestion",
-				Content:   "Foo bar qux.",
-			},
+			name:                   "Success",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			userID:                 test_utils.NumberUUID(20),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			shouldCheckOwner:       true,
+			isCreatorData:          true,
+			shouldCallRemove:       true,
 		},
 		{
-			name:                                  "Error/UnauthorizedUser",
-			now:                                   baseTime,
-			keys:                                  jwk_storage.MockedKeys,
-			token:                                 "foo.bar.qux",
-			title:                                 "Smart request",
-			content:                               "Qux bar foo.",
-			requestID:                             test_utils.NumberUUID(1),
-			shouldCallImproveSuggestionGetService: true,
-			tokenServiceDecodeData: &models.UserToken{
-				Header: models.UserTokenHeader{
-					IAT: baseTime.Add(-time.Hour),
-					EXP: baseTime.Add(time.Hour),
-					ID:  test_utils.NumberUUID(100),
-				},
-				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(101)},
-			},
-			improveSuggestionGetData: &models.ImproveSuggestion{
-				ID:        test_utils.NumberUUID(1),
-				CreatedAt: baseTime,
-				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
-				SourceID:  test_utils.NumberUUID(10),
-				UserID:    test_utils.NumberUUID(100),
-				Validated: true,
-				UpVotes:   32,
-				DownVotes: 2,
-				RequestID: test_utils.NumberUUID(11),
-				Title:     "Dummy suggestion",
-				Content:   "Foo bar qux.",
-			},
-			expectErr: validation.ErrInvalidCredentials,
+			name:                   "Success/Leave",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			userID:                 test_utils.NumberUUID(10),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			shouldCallRemove:       true,
 		},
 		{
-			name:                                     "Error/ImproveSuggestionServiceDeleteFailure",
-			now:                                      baseTime,
-			keys:                                     jwk_storage.MockedKeys,
-			token:                                    "foo.bar.qux",
-			title:                                    "Smart request",
-			content:                                  "Qux bar foo.",
-			requestID:                                test_utils.NumberUUID(1),
-			shouldCallImproveSuggestionGetService:    true,
-			shouldCallImproveSuggestionDeleteService: true,
-			tokenServiceDecodeData: &models.UserToken{
-				Header: models.UserTokenHeader{
-					IAT: baseTime.Add(-time.Hour),
-					EXP: baseTime.Add(time.Hour),
-					ID:  test_utils.NumberUUID(100),
-				},
-				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
-			},
-			improveSuggestionGetData: &models.ImproveSuggestion{
-				ID:        test_utils.NumberUUID(1),
-				CreatedAt: baseTime,
-				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
-				SourceID:  test_utils.NumberUUID(10),
-				UserID:    test_utils.NumberUUID(100),
-				Validated: true,
-				UpVotes:   32,
-				DownVotes: 2,
-				RequestID: test_utils.NumberUUID(11),
-				Title:     "Dummy suggestion",
-				Content:   "Foo bar qux.",
-			},
-			improveSuggestionDeleteErr: fooErr,
-			expectErr:                  fooErr,
+			name:                   "Error/NotOwner",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			userID:                 test_utils.NumberUUID(20),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			shouldCheckOwner:       true,
+			collaboratorRole:       models.ImproveRequestCollaboratorRoleEditor,
+			expectErr:              validation.ErrInvalidCredentials,
 		},
 		{
-			name:                                  "Error/ImproveSuggestionServiceGetFailure",
-			now:                                   baseTime,
-			keys:                                  jwk_storage.MockedKeys,
-			token:                                 "foo.bar.qux",
-			title:                                 "Smart request",
-			content:                               "Qux bar foo.",
-			requestID:                             test_utils.NumberUUID(1),
-			shouldCallImproveSuggestionGetService: true,
-			tokenServiceDecodeData: &models.UserToken{
-				Header: models.UserTokenHeader{
-					IAT: baseTime.Add(-time.Hour),
-					EXP: baseTime.Add(time.Hour),
-					ID:  test_utils.NumberUUID(100),
-				},
-				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
-			},
-			improveSuggestionGetErr: fooErr,
-			expectErr:               fooErr,
+			name:                   "Error/CollaboratorServiceFailure",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			userID:                 test_utils.NumberUUID(10),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			shouldCallRemove:       true,
+			removeErr:              fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                   "Error/ImproveRequestServiceFailure",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			userID:                 test_utils.NumberUUID(10),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			readErr:                fooErr,
+			expectErr:              fooErr,
 		},
 		{
 			name:                  "Error/TokenServiceFailure",
-			now:                   baseTime,
-			keys:                  jwk_storage.MockedKeys,
 			token:                 "foo.bar.qux",
-			title:                 "Smart request",
-			content:               "Qux bar foo.",
-			requestID:             test_utils.NumberUUID(1),
+			requestID:             test_utils.NumberUUID(2),
+			userID:                test_utils.NumberUUID(20),
 			tokenServiceDecodeErr: fooErr,
 			expectErr:             fooErr,
 		},
@@ -1601,13 +2393,13 @@ func TestImprovePostProvider_DeleteImproveSuggestion(t *testing.T) {
 
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
-			improveSuggestionService := improve_suggestion_service.NewMockService(t)
-
+			improveRequestService := improve_request_service.NewMockService(t)
+			collaboratorService := collaborator_service.NewMockService(t)
 			tokenService := token_service.NewMockService(t)
 			keysService := jwk_service.NewMockServiceCached(t)
 
-			publicKeys := make([]ed25519.PublicKey, len(d.keys))
-			for i, key := range d.keys {
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
 				publicKeys[i] = key.Public().(ed25519.PublicKey)
 			}
 
@@ -1616,476 +2408,7950 @@ func TestImprovePostProvider_DeleteImproveSuggestion(t *testing.T) {
 				Return(publicKeys)
 
 			tokenService.
-				On("Decode", d.token, publicKeys, d.now).
+				On("Decode", d.token, publicKeys, baseTime).
 				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
 
-			if d.shouldCallImproveSuggestionGetService {
-				improveSuggestionService.
+			if d.shouldCallRead {
+				improveRequestService.
 					On("Read", context.TODO(), d.requestID).
-					Return(d.improveSuggestionGetData, d.improveSuggestionGetErr)
+					Return(&models.ImproveRequest{
+						ID:     d.requestID,
+						Source: test_utils.NumberUUID(1),
+						UserID: test_utils.NumberUUID(10),
+					}, d.readErr)
 			}
 
-			if d.shouldCallImproveSuggestionDeleteService {
-				improveSuggestionService.
-					On("Delete", context.TODO(), d.requestID).
-					Return(d.improveSuggestionDeleteErr)
+			if d.shouldCheckOwner {
+				improveRequestService.
+					On("IsCreator", context.TODO(), test_utils.NumberUUID(10), test_utils.NumberUUID(1), true).
+					Return(d.isCreatorData, nil)
+
+				if !d.isCreatorData {
+					collaboratorService.
+						On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+						Return(&models.ImproveRequestCollaborator{
+							Source:     test_utils.NumberUUID(1),
+							UserID:     test_utils.NumberUUID(10),
+							Role:       d.collaboratorRole,
+							CreatedAt:  baseTime,
+							AcceptedAt: &baseTime,
+						}, nil)
+				}
+			}
+
+			if d.shouldCallRemove {
+				collaboratorService.
+					On("Remove", context.TODO(), test_utils.NumberUUID(1), d.userID).
+					Return(d.removeErr)
 			}
 
 			provider := NewProvider(Config{
-				ImproveSuggestionService: improveSuggestionService,
-				TokenService:             tokenService,
-				KeysService:              keysService,
-				Time:                     test_utils.GetTimeNow(d.now),
+				ImproveRequestService: improveRequestService,
+				CollaboratorService:   collaboratorService,
+				TokenService:          tokenService,
+				KeysService:           keysService,
+				Time:                  test_utils.GetTimeNow(baseTime),
 			})
 
-			err := provider.DeleteImproveSuggestion(context.TODO(), d.token, d.requestID)
+			err := provider.RemoveImproveRequestCollaborator(context.TODO(), d.token, d.requestID, d.userID)
 			test_utils.RequireError(t, d.expectErr, err)
 
-			improveSuggestionService.AssertExpectations(t)
+			improveRequestService.AssertExpectations(t)
+			collaboratorService.AssertExpectations(t)
 			tokenService.AssertExpectations(t)
 			keysService.AssertExpectations(t)
 		})
 	}
 }
 
-func TestImprovePostProvider_ListImproveSuggestions(t *testing.T) {
+func TestImprovePostProvider_ListImproveRequestCollaborationInvitations(t *testing.T) {
+	userToken := &models.UserToken{
+		Header: models.UserTokenHeader{
+			IAT: baseTime.Add(-time.Hour),
+			EXP: baseTime.Add(time.Hour),
+			ID:  test_utils.NumberUUID(100),
+		},
+		Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+	}
+
+	invitations := []*models.ImproveRequestCollaborator{
+		{
+			Source:    test_utils.NumberUUID(1),
+			UserID:    test_utils.NumberUUID(10),
+			Role:      models.ImproveRequestCollaboratorRoleEditor,
+			InvitedBy: test_utils.NumberUUID(20),
+			CreatedAt: baseTime,
+		},
+	}
+
 	data := []struct {
 		name string
 
-		query  models.ImproveSuggestionsList
-		limit  int
-		offset int
+		token string
 
-		improveSuggestionData  []*models.ImproveSuggestion
-		improveSuggestionTotal int64
-		improveSuggestionErr   error
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
 
-		expectData  []*models.ImproveSuggestion
-		expectTotal int64
-		expectErr   error
+		shouldCallListPending bool
+		listPendingData       []*models.ImproveRequestCollaborator
+		listPendingErr        error
+
+		expect    []*models.ImproveRequestCollaborator
+		expectErr error
 	}{
 		{
-			name: "Success",
-			query: models.ImproveSuggestionsList{
-				UserID:    framework.ToPTR(test_utils.NumberUUID(100)),
-				SourceID:  framework.ToPTR(test_utils.NumberUUID(10)),
-				RequestID: framework.ToPTR(test_utils.NumberUUID(1)),
-				Validated: framework.ToPTR(true),
-			},
-			limit:  10,
-			offset: 20,
-			improveSuggestionData: []*models.ImproveSuggestion{
-				{
-					ID:        test_utils.NumberUUID(1),
-					CreatedAt: baseTime,
-					UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
-					SourceID:  test_utils.NumberUUID(10),
-					UserID:    test_utils.NumberUUID(100),
-					Validated: true,
-					UpVotes:   32,
-					DownVotes: 2,
-					RequestID: test_utils.NumberUUID(11),
-					Title:     "Dummy suggestion",
-					Content:   "Foo bar qux.",
-				},
-				{
-					ID:        test_utils.NumberUUID(2),
-					CreatedAt: baseTime,
-					UpdatedAt: framework.ToPTR(baseTime.Add(time.Minute)),
-					SourceID:  test_utils.NumberUUID(11),
-					UserID:    test_utils.NumberUUID(101),
-					Validated: true,
-					UpVotes:   10,
-					DownVotes: 8,
-					RequestID: test_utils.NumberUUID(12),
-					Title:     "Smart suggestion",
-					Content:   "Qux bar foo.",
-				},
-			},
-			improveSuggestionTotal: 200,
-			expectData: []*models.ImproveSuggestion{
-				{
-					ID:        test_utils.NumberUUID(1),
-					CreatedAt: baseTime,
-					UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
-					SourceID:  test_utils.NumberUUID(10),
-					UserID:    test_utils.NumberUUID(100),
-					Validated: true,
-					UpVotes:   32,
-					DownVotes: 2,
-					RequestID: test_utils.NumberUUID(11),
-					Title:     "Dummy suggestion",
-					Content:   "Foo bar qux.",
-				},
-				{
-					ID:        test_utils.NumberUUID(2),
-					CreatedAt: baseTime,
-					UpdatedAt: framework.ToPTR(baseTime.Add(time.Minute)),
-					SourceID:  test_utils.NumberUUID(11),
-					UserID:    test_utils.NumberUUID(101),
-					Validated: true,
-					UpVotes:   10,
-					DownVotes: 8,
-					RequestID: test_utils.NumberUUID(12),
-					Title:     "Smart suggestion",
-					Content:   "Qux bar foo.",
-				},
-			},
-			expectTotal: 200,
+			name:                   "Success",
+			token:                  "foo.bar.qux",
+			tokenServiceDecodeData: userToken,
+			shouldCallListPending:  true,
+			listPendingData:        invitations,
+			expect:                 invitations,
 		},
 		{
-			name: "Error/ServiceFailure",
-			query: models.ImproveSuggestionsList{
-				UserID:    framework.ToPTR(test_utils.NumberUUID(100)),
-				SourceID:  framework.ToPTR(test_utils.NumberUUID(10)),
-				RequestID: framework.ToPTR(test_utils.NumberUUID(1)),
-				Validated: framework.ToPTR(true),
-			},
-			limit:                10,
-			offset:               20,
-			improveSuggestionErr: fooErr,
-			expectErr:            fooErr,
+			name:                   "Error/CollaboratorServiceFailure",
+			token:                  "foo.bar.qux",
+			tokenServiceDecodeData: userToken,
+			shouldCallListPending:  true,
+			listPendingErr:         fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
 		},
 	}
 
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
-			improveSuggestionService := improve_suggestion_service.NewMockService(t)
+			collaboratorService := collaborator_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
 
-			improveSuggestionService.
-				On("List", context.TODO(), models.ImproveSuggestionsList{
-					SourceID:  d.query.SourceID,
-					UserID:    d.query.UserID,
-					RequestID: d.query.RequestID,
-					Validated: d.query.Validated,
-				}, d.limit, d.offset).
-				Return(d.improveSuggestionData, d.improveSuggestionTotal, d.improveSuggestionErr)
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallListPending {
+				collaboratorService.
+					On("ListPending", context.TODO(), test_utils.NumberUUID(10)).
+					Return(d.listPendingData, d.listPendingErr)
+			}
 
 			provider := NewProvider(Config{
-				ImproveSuggestionService: improveSuggestionService,
+				CollaboratorService: collaboratorService,
+				TokenService:        tokenService,
+				KeysService:         keysService,
+				Time:                test_utils.GetTimeNow(baseTime),
 			})
 
-			data, total, err := provider.ListImproveSuggestions(context.TODO(), d.query, d.limit, d.offset)
+			res, err := provider.ListImproveRequestCollaborationInvitations(context.TODO(), d.token)
 			test_utils.RequireError(t, d.expectErr, err)
-			require.Equal(t, d.expectData, data)
-			require.Equal(t, d.expectTotal, total)
+			require.Equal(t, d.expect, res)
 
-			improveSuggestionService.AssertExpectations(t)
+			collaboratorService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
 		})
 	}
 }
 
-func TestImprovePostProvider_GetImproveSuggestionPreviews(t *testing.T) {
+func TestImprovePostProvider_AcceptImproveRequestCollaboration(t *testing.T) {
+	userToken := &models.UserToken{
+		Header: models.UserTokenHeader{
+			IAT: baseTime.Add(-time.Hour),
+			EXP: baseTime.Add(time.Hour),
+			ID:  test_utils.NumberUUID(100),
+		},
+		Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+	}
+
 	data := []struct {
 		name string
 
-		ids []uuid.UUID
+		token     string
+		requestID uuid.UUID
 
-		serviceData []*models.ImproveSuggestion
-		serviceErr  error
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
 
-		expect    []*models.ImproveSuggestion
+		shouldCallRead bool
+		readErr        error
+
+		shouldCallAccept bool
+		acceptData       *models.ImproveRequestCollaborator
+		acceptErr        error
+
+		expect    *models.ImproveRequestCollaborator
 		expectErr error
 	}{
 		{
-			name: "Success",
-			ids: []uuid.UUID{
-				test_utils.NumberUUID(1),
-				test_utils.NumberUUID(2),
-			},
-			serviceData: []*models.ImproveSuggestion{
-				{
-					ID:        test_utils.NumberUUID(1),
-					CreatedAt: baseTime,
-					UpdatedAt: &updateTime,
-					SourceID:  test_utils.NumberUUID(10),
-					UserID:    test_utils.NumberUUID(100),
-					Validated: false,
-					UpVotes:   17,
-					DownVotes: 3,
-					RequestID: test_utils.NumberUUID(11),
-					Title:     "Dummy post",
-					Content:   "Foo bar qux.",
-				},
-				{
-					ID:        test_utils.NumberUUID(2),
-					CreatedAt: baseTime,
-					UpdatedAt: &updateTime,
-					SourceID:  test_utils.NumberUUID(12),
-					UserID:    test_utils.NumberUUID(101),
-					Validated: true,
-					UpVotes:   8,
-					DownVotes: 1,
-					RequestID: test_utils.NumberUUID(14),
-					Title:     "Smart post",
-					Content:   "Cats on a nap.",
-				},
+			name:                   "Success",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			shouldCallAccept:       true,
+			acceptData: &models.ImproveRequestCollaborator{
+				Source:     test_utils.NumberUUID(1),
+				UserID:     test_utils.NumberUUID(10),
+				Role:       models.ImproveRequestCollaboratorRoleEditor,
+				InvitedBy:  test_utils.NumberUUID(20),
+				CreatedAt:  baseTime,
+				AcceptedAt: &baseTime,
 			},
-			expect: []*models.ImproveSuggestion{
-				{
-					ID:        test_utils.NumberUUID(1),
-					CreatedAt: baseTime,
-					UpdatedAt: &updateTime,
-					SourceID:  test_utils.NumberUUID(10),
-					UserID:    test_utils.NumberUUID(100),
-					Validated: false,
-					UpVotes:   17,
-					DownVotes: 3,
-					RequestID: test_utils.NumberUUID(11),
-					Title:     "Dummy post",
-					Content:   "Foo bar qux.",
-				},
-				{
-					ID:        test_utils.NumberUUID(2),
-					CreatedAt: baseTime,
-					UpdatedAt: &updateTime,
-					SourceID:  test_utils.NumberUUID(12),
-					UserID:    test_utils.NumberUUID(101),
-					Validated: true,
-					UpVotes:   8,
-					DownVotes: 1,
-					RequestID: test_utils.NumberUUID(14),
-					Title:     "Smart post",
-					Content:   "Cats on a nap.",
-				},
+			expect: &models.ImproveRequestCollaborator{
+				Source:     test_utils.NumberUUID(1),
+				UserID:     test_utils.NumberUUID(10),
+				Role:       models.ImproveRequestCollaboratorRoleEditor,
+				InvitedBy:  test_utils.NumberUUID(20),
+				CreatedAt:  baseTime,
+				AcceptedAt: &baseTime,
 			},
 		},
 		{
-			name: "Error/ServiceFailure",
-			ids: []uuid.UUID{
-				test_utils.NumberUUID(1),
-				test_utils.NumberUUID(2),
-			},
-			serviceErr: fooErr,
-			expectErr:  fooErr,
+			name:                   "Error/NoInvitation",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			shouldCallAccept:       true,
+			acceptErr:              validation.ErrNotFound,
+			expectErr:              validation.ErrNotFound,
+		},
+		{
+			name:                   "Error/ImproveRequestServiceFailure",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			readErr:                fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			requestID:             test_utils.NumberUUID(2),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
 		},
 	}
 
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
-			improveSuggestionService := improve_suggestion_service.NewMockService(t)
+			improveRequestService := improve_request_service.NewMockService(t)
+			collaboratorService := collaborator_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
 
-			improveSuggestionService.
-				On("GetPreviews", context.TODO(), d.ids).
-				Return(d.serviceData, d.serviceErr)
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallRead {
+				improveRequestService.
+					On("Read", context.TODO(), d.requestID).
+					Return(&models.ImproveRequest{
+						ID:     d.requestID,
+						Source: test_utils.NumberUUID(1),
+						UserID: test_utils.NumberUUID(20),
+					}, d.readErr)
+			}
+
+			if d.shouldCallAccept {
+				collaboratorService.
+					On("Accept", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10), baseTime).
+					Return(d.acceptData, d.acceptErr)
+			}
 
 			provider := NewProvider(Config{
-				ImproveSuggestionService: improveSuggestionService,
+				ImproveRequestService: improveRequestService,
+				CollaboratorService:   collaboratorService,
+				TokenService:          tokenService,
+				KeysService:           keysService,
+				Time:                  test_utils.GetTimeNow(baseTime),
 			})
 
-			res, err := provider.GetImproveSuggestionPreviews(context.TODO(), d.ids)
+			res, err := provider.AcceptImproveRequestCollaboration(context.TODO(), d.token, d.requestID)
 			test_utils.RequireError(t, d.expectErr, err)
 			require.Equal(t, d.expect, res)
 
-			improveSuggestionService.AssertExpectations(t)
+			improveRequestService.AssertExpectations(t)
+			collaboratorService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
 		})
 	}
 }
 
-func TestImprovePostProvider_Vote(t *testing.T) {
+func TestImprovePostProvider_ReadImproveRequestState(t *testing.T) {
 	data := []struct {
 		name string
 
-		now    time.Time
-		keys   []ed25519.PrivateKey
-		userID uuid.UUID
-
-		token  string
-		postID uuid.UUID
-		target models.VoteTarget
-		vote   models.VoteValue
+		requestID uuid.UUID
 
-		shouldCallImproveRequestService    bool
-		shouldCallImproveSuggestionService bool
-		shouldCallVoteService              bool
+		readErr    error
+		visibility models.ImproveRequestVisibility
 
-		tokenServiceDecodeData       *models.UserToken
-		tokenServiceDecodeErr        error
-		improveRequestServiceData    bool
-		improveRequestServiceErr     error
-		improveSuggestionServiceData bool
-		improveSuggestionServiceErr  error
-		voteServiceData              models.VoteValue
-		voteServiceErr               error
+		shouldCallThreadStateService bool
+		threadStateData              *models.ImproveRequestThreadState
+		threadStateErr               error
 
-		expect    models.VoteValue
+		expect    *models.ImproveRequestThreadState
 		expectErr error
 	}{
 		{
-			name:                            "Success/ImproveRequest",
-			now:                             baseTime,
-			keys:                            jwk_storage.MockedKeys,
-			userID:                          test_utils.NumberUUID(100),
-			token:                           "foo.bar.qux",
-			postID:                          test_utils.NumberUUID(10),
-			target:                          models.VoteTargetImproveRequest,
-			vote:                            models.VoteUp,
-			shouldCallImproveRequestService: true,
-			shouldCallVoteService:           true,
-			tokenServiceDecodeData: &models.UserToken{
-				Header: models.UserTokenHeader{
-					IAT: baseTime.Add(-time.Hour),
-					EXP: baseTime.Add(time.Hour),
-					ID:  test_utils.NumberUUID(100),
-				},
-				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			name:                         "Success",
+			requestID:                    test_utils.NumberUUID(2),
+			visibility:                   models.ImproveRequestVisibilityPublic,
+			shouldCallThreadStateService: true,
+			threadStateData: &models.ImproveRequestThreadState{
+				Source:    test_utils.NumberUUID(1),
+				State:     models.ImproveRequestStateClosed,
+				UpdatedAt: &baseTime,
+				UpdatedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+			},
+			expect: &models.ImproveRequestThreadState{
+				Source:    test_utils.NumberUUID(1),
+				State:     models.ImproveRequestStateClosed,
+				UpdatedAt: &baseTime,
+				UpdatedBy: framework.ToPTR(test_utils.NumberUUID(10)),
 			},
-			voteServiceData: models.VoteUp,
-			expect:          models.VoteUp,
 		},
 		{
-			name:                               "Success/ImproveSuggestion",
-			now:                                baseTime,
+			name:       "Error/HiddenRequest",
+			requestID:  test_utils.NumberUUID(2),
+			visibility: models.ImproveRequestVisibilityRestricted,
+			expectErr:  validation.ErrNotFound,
+		},
+		{
+			name:                         "Error/ThreadStateServiceFailure",
+			requestID:                    test_utils.NumberUUID(2),
+			visibility:                   models.ImproveRequestVisibilityPublic,
+			shouldCallThreadStateService: true,
+			threadStateErr:               fooErr,
+			expectErr:                    fooErr,
+		},
+		{
+			name:      "Error/ImproveRequestServiceFailure",
+			requestID: test_utils.NumberUUID(2),
+			readErr:   fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			visibilityService := visibility_service.NewMockService(t)
+			threadStateService := thread_state_service.NewMockService(t)
+
+			improveRequestService.
+				On("Read", context.TODO(), d.requestID).
+				Return(&models.ImproveRequest{
+					ID:     d.requestID,
+					Source: test_utils.NumberUUID(1),
+					UserID: test_utils.NumberUUID(10),
+				}, d.readErr)
+
+			if d.readErr == nil {
+				visibilityService.
+					On("Read", context.TODO(), test_utils.NumberUUID(1)).
+					Return(&models.ImproveRequestAccess{Source: test_utils.NumberUUID(1), Visibility: d.visibility}, nil)
+			}
+
+			if d.shouldCallThreadStateService {
+				threadStateService.
+					On("Read", context.TODO(), test_utils.NumberUUID(1)).
+					Return(d.threadStateData, d.threadStateErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService: improveRequestService,
+				VisibilityService:     visibilityService,
+				ThreadStateService:    threadStateService,
+				Time:                  test_utils.GetTimeNow(baseTime),
+			})
+
+			res, err := provider.ReadImproveRequestState(context.TODO(), "", "", d.requestID)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveRequestService.AssertExpectations(t)
+			visibilityService.AssertExpectations(t)
+			threadStateService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_UpdateImproveRequestState(t *testing.T) {
+	userToken := &models.UserToken{
+		Header: models.UserTokenHeader{
+			IAT: baseTime.Add(-time.Hour),
+			EXP: baseTime.Add(time.Hour),
+			ID:  test_utils.NumberUUID(100),
+		},
+		Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+	}
+
+	data := []struct {
+		name string
+
+		token     string
+		requestID uuid.UUID
+		state     models.ImproveRequestState
+		reason    string
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+
+		shouldCallRead bool
+		readErr        error
+		isCreatorData  bool
+		// Role of the user as a collaborator, when not the creator. Empty when the user is not a collaborator.
+		collaboratorRole models.ImproveRequestCollaboratorRole
+
+		shouldReadState bool
+		currentState    models.ImproveRequestState
+
+		shouldCallUpdate bool
+		updateData       *models.ImproveRequestThreadState
+		updateErr        error
+
+		expect    *models.ImproveRequestThreadState
+		expectErr error
+	}{
+		{
+			name:                   "Success",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			state:                  models.ImproveRequestStateClosed,
+			reason:                 "enough feedback",
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			isCreatorData:          true,
+			shouldReadState:        true,
+			currentState:           models.ImproveRequestStateOpen,
+			shouldCallUpdate:       true,
+			updateData: &models.ImproveRequestThreadState{
+				Source:    test_utils.NumberUUID(1),
+				State:     models.ImproveRequestStateClosed,
+				UpdatedAt: &baseTime,
+				UpdatedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+				Reason:    "enough feedback",
+			},
+			expect: &models.ImproveRequestThreadState{
+				Source:    test_utils.NumberUUID(1),
+				State:     models.ImproveRequestStateClosed,
+				UpdatedAt: &baseTime,
+				UpdatedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+				Reason:    "enough feedback",
+			},
+		},
+		{
+			name:                   "Success/CollaboratorOwner",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			state:                  models.ImproveRequestStateOpen,
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			collaboratorRole:       models.ImproveRequestCollaboratorRoleOwner,
+			shouldReadState:        true,
+			currentState:           models.ImproveRequestStateArchived,
+			shouldCallUpdate:       true,
+			updateData: &models.ImproveRequestThreadState{
+				Source:    test_utils.NumberUUID(1),
+				State:     models.ImproveRequestStateOpen,
+				UpdatedAt: &baseTime,
+				UpdatedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+			},
+			expect: &models.ImproveRequestThreadState{
+				Source:    test_utils.NumberUUID(1),
+				State:     models.ImproveRequestStateOpen,
+				UpdatedAt: &baseTime,
+				UpdatedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+			},
+		},
+		{
+			name:                   "Error/LockNotAllowed",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			state:                  models.ImproveRequestStateLocked,
+			tokenServiceDecodeData: userToken,
+			expectErr:              validation.ErrInvalidEntity,
+		},
+		{
+			name:                   "Error/Locked",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			state:                  models.ImproveRequestStateOpen,
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			isCreatorData:          true,
+			shouldReadState:        true,
+			currentState:           models.ImproveRequestStateLocked,
+			expectErr:              validation.ErrInvalidCredentials,
+		},
+		{
+			name:                   "Error/NotOwner",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			state:                  models.ImproveRequestStateClosed,
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			collaboratorRole:       models.ImproveRequestCollaboratorRoleEditor,
+			expectErr:              validation.ErrInvalidCredentials,
+		},
+		{
+			name:                   "Error/ThreadStateServiceFailure",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			state:                  models.ImproveRequestStateClosed,
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			isCreatorData:          true,
+			shouldReadState:        true,
+			currentState:           models.ImproveRequestStateOpen,
+			shouldCallUpdate:       true,
+			updateErr:              fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                   "Error/ImproveRequestServiceFailure",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			state:                  models.ImproveRequestStateClosed,
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			readErr:                fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			requestID:             test_utils.NumberUUID(2),
+			state:                 models.ImproveRequestStateClosed,
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			collaboratorService := collaborator_service.NewMockService(t)
+			threadStateService := thread_state_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallRead {
+				improveRequestService.
+					On("Read", context.TODO(), d.requestID).
+					Return(&models.ImproveRequest{
+						ID:     d.requestID,
+						Source: test_utils.NumberUUID(1),
+						UserID: test_utils.NumberUUID(10),
+					}, d.readErr)
+
+				if d.readErr == nil {
+					improveRequestService.
+						On("IsCreator", context.TODO(), test_utils.NumberUUID(10), test_utils.NumberUUID(1), true).
+						Return(d.isCreatorData, nil)
+
+					if !d.isCreatorData && d.collaboratorRole == "" {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(nil, validation.ErrNotFound)
+					} else if !d.isCreatorData {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(&models.ImproveRequestCollaborator{
+								Source:     test_utils.NumberUUID(1),
+								UserID:     test_utils.NumberUUID(10),
+								Role:       d.collaboratorRole,
+								CreatedAt:  baseTime,
+								AcceptedAt: &baseTime,
+							}, nil)
+					}
+				}
+			}
+
+			if d.shouldReadState {
+				threadStateService.
+					On("Read", context.TODO(), test_utils.NumberUUID(1)).
+					Return(&models.ImproveRequestThreadState{Source: test_utils.NumberUUID(1), State: d.currentState}, nil)
+			}
+
+			if d.shouldCallUpdate {
+				threadStateService.
+					On("Update", context.TODO(), test_utils.NumberUUID(1), d.state, framework.ToPTR(test_utils.NumberUUID(10)), d.reason, baseTime).
+					Return(d.updateData, d.updateErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService: improveRequestService,
+				CollaboratorService:   collaboratorService,
+				ThreadStateService:    threadStateService,
+				TokenService:          tokenService,
+				KeysService:           keysService,
+				Time:                  test_utils.GetTimeNow(baseTime),
+			})
+
+			res, err := provider.UpdateImproveRequestState(context.TODO(), d.token, d.requestID, d.state, d.reason)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveRequestService.AssertExpectations(t)
+			collaboratorService.AssertExpectations(t)
+			threadStateService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_ReadImproveRequestCritique(t *testing.T) {
+	data := []struct {
+		name string
+
+		requestID uuid.UUID
+
+		readErr    error
+		visibility models.ImproveRequestVisibility
+
+		shouldCallReadAspects bool
+		readAspectsData       []models.CritiqueAspect
+		readAspectsErr        error
+		shouldCallSummarize   bool
+		summarizeData         []*models.ImproveRequestAspectSummary
+		summarizeErr          error
+
+		expect    *models.ImproveRequestCritique
+		expectErr error
+	}{
+		{
+			name:                  "Success",
+			requestID:             test_utils.NumberUUID(2),
+			visibility:            models.ImproveRequestVisibilityPublic,
+			shouldCallReadAspects: true,
+			readAspectsData:       []models.CritiqueAspect{models.CritiqueAspectPacing, models.CritiqueAspectTone},
+			shouldCallSummarize:   true,
+			summarizeData: []*models.ImproveRequestAspectSummary{
+				{Aspect: models.CritiqueAspectPacing, Average: 2.5, Count: 2},
+			},
+			expect: &models.ImproveRequestCritique{
+				Source:  test_utils.NumberUUID(1),
+				Aspects: []models.CritiqueAspect{models.CritiqueAspectPacing, models.CritiqueAspectTone},
+				Ratings: []*models.ImproveRequestAspectSummary{
+					{Aspect: models.CritiqueAspectPacing, Average: 2.5, Count: 2},
+				},
+			},
+		},
+		{
+			name:       "Error/HiddenRequest",
+			requestID:  test_utils.NumberUUID(2),
+			visibility: models.ImproveRequestVisibilityRestricted,
+			expectErr:  validation.ErrNotFound,
+		},
+		{
+			name:                  "Error/SummarizeFailure",
+			requestID:             test_utils.NumberUUID(2),
+			visibility:            models.ImproveRequestVisibilityPublic,
+			shouldCallReadAspects: true,
+			readAspectsData:       []models.CritiqueAspect{models.CritiqueAspectPacing},
+			shouldCallSummarize:   true,
+			summarizeErr:          fooErr,
+			expectErr:             fooErr,
+		},
+		{
+			name:                  "Error/ReadAspectsFailure",
+			requestID:             test_utils.NumberUUID(2),
+			visibility:            models.ImproveRequestVisibilityPublic,
+			shouldCallReadAspects: true,
+			readAspectsErr:        fooErr,
+			expectErr:             fooErr,
+		},
+		{
+			name:      "Error/ImproveRequestServiceFailure",
+			requestID: test_utils.NumberUUID(2),
+			readErr:   fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			visibilityService := visibility_service.NewMockService(t)
+			critiqueService := critique_service.NewMockService(t)
+
+			improveRequestService.
+				On("Read", context.TODO(), d.requestID).
+				Return(&models.ImproveRequest{
+					ID:     d.requestID,
+					Source: test_utils.NumberUUID(1),
+					UserID: test_utils.NumberUUID(10),
+				}, d.readErr)
+
+			if d.readErr == nil {
+				visibilityService.
+					On("Read", context.TODO(), test_utils.NumberUUID(1)).
+					Return(&models.ImproveRequestAccess{Source: test_utils.NumberUUID(1), Visibility: d.visibility}, nil)
+			}
+
+			if d.shouldCallReadAspects {
+				critiqueService.
+					On("ReadAspects", context.TODO(), test_utils.NumberUUID(1)).
+					Return(d.readAspectsData, d.readAspectsErr)
+			}
+
+			if d.shouldCallSummarize {
+				critiqueService.
+					On("Summarize", context.TODO(), test_utils.NumberUUID(1)).
+					Return(d.summarizeData, d.summarizeErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService: improveRequestService,
+				VisibilityService:     visibilityService,
+				CritiqueService:       critiqueService,
+				Time:                  test_utils.GetTimeNow(baseTime),
+			})
+
+			res, err := provider.ReadImproveRequestCritique(context.TODO(), "", "", d.requestID)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveRequestService.AssertExpectations(t)
+			visibilityService.AssertExpectations(t)
+			critiqueService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_UpdateImproveRequestAspects(t *testing.T) {
+	userToken := &models.UserToken{
+		Header: models.UserTokenHeader{
+			IAT: baseTime.Add(-time.Hour),
+			EXP: baseTime.Add(time.Hour),
+			ID:  test_utils.NumberUUID(100),
+		},
+		Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+	}
+
+	data := []struct {
+		name string
+
+		token     string
+		requestID uuid.UUID
+		aspects   []models.CritiqueAspect
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+
+		shouldCallRead  bool
+		isCreatorData   bool
+		shouldReadState bool
+		currentState    models.ImproveRequestState
+
+		shouldCallUpdate bool
+		updateErr        error
+
+		expect    *models.ImproveRequestCritique
+		expectErr error
+	}{
+		{
+			name:                   "Success",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			aspects:                []models.CritiqueAspect{models.CritiqueAspectDialogue},
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			isCreatorData:          true,
+			shouldReadState:        true,
+			currentState:           models.ImproveRequestStateOpen,
+			shouldCallUpdate:       true,
+			expect: &models.ImproveRequestCritique{
+				Source:  test_utils.NumberUUID(1),
+				Aspects: []models.CritiqueAspect{models.CritiqueAspectDialogue},
+				Ratings: []*models.ImproveRequestAspectSummary{},
+			},
+		},
+		{
+			name:                   "Error/Archived",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			aspects:                []models.CritiqueAspect{models.CritiqueAspectDialogue},
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			isCreatorData:          true,
+			shouldReadState:        true,
+			currentState:           models.ImproveRequestStateArchived,
+			expectErr:              validation.ErrInvalidCredentials,
+		},
+		{
+			name:                   "Error/NotOwner",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			aspects:                []models.CritiqueAspect{models.CritiqueAspectDialogue},
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			expectErr:              validation.ErrInvalidCredentials,
+		},
+		{
+			name:                   "Error/CritiqueServiceFailure",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			aspects:                []models.CritiqueAspect{models.CritiqueAspectDialogue},
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			isCreatorData:          true,
+			shouldReadState:        true,
+			currentState:           models.ImproveRequestStateOpen,
+			shouldCallUpdate:       true,
+			updateErr:              fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			requestID:             test_utils.NumberUUID(2),
+			aspects:               []models.CritiqueAspect{models.CritiqueAspectDialogue},
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			collaboratorService := collaborator_service.NewMockService(t)
+			threadStateService := thread_state_service.NewMockService(t)
+			critiqueService := critique_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallRead {
+				improveRequestService.
+					On("Read", context.TODO(), d.requestID).
+					Return(&models.ImproveRequest{
+						ID:     d.requestID,
+						Source: test_utils.NumberUUID(1),
+						UserID: test_utils.NumberUUID(10),
+					}, nil)
+
+				improveRequestService.
+					On("IsCreator", context.TODO(), test_utils.NumberUUID(10), test_utils.NumberUUID(1), true).
+					Return(d.isCreatorData, nil)
+
+				if !d.isCreatorData {
+					collaboratorService.
+						On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+						Return(nil, validation.ErrNotFound)
+				}
+			}
+
+			if d.shouldReadState {
+				threadStateService.
+					On("Read", context.TODO(), test_utils.NumberUUID(1)).
+					Return(&models.ImproveRequestThreadState{Source: test_utils.NumberUUID(1), State: d.currentState}, nil)
+			}
+
+			if d.shouldCallUpdate {
+				critiqueService.
+					On("UpdateAspects", context.TODO(), test_utils.NumberUUID(1), d.aspects).
+					Return(d.aspects, d.updateErr)
+
+				if d.updateErr == nil {
+					critiqueService.
+						On("ReadAspects", context.TODO(), test_utils.NumberUUID(1)).
+						Return(d.aspects, nil)
+					critiqueService.
+						On("Summarize", context.TODO(), test_utils.NumberUUID(1)).
+						Return([]*models.ImproveRequestAspectSummary{}, nil)
+				}
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService: improveRequestService,
+				CollaboratorService:   collaboratorService,
+				ThreadStateService:    threadStateService,
+				CritiqueService:       critiqueService,
+				TokenService:          tokenService,
+				KeysService:           keysService,
+				Time:                  test_utils.GetTimeNow(baseTime),
+			})
+
+			res, err := provider.UpdateImproveRequestAspects(context.TODO(), d.token, d.requestID, d.aspects)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveRequestService.AssertExpectations(t)
+			collaboratorService.AssertExpectations(t)
+			threadStateService.AssertExpectations(t)
+			critiqueService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_ReadImproveSuggestionRatings(t *testing.T) {
+	data := []struct {
+		name string
+
+		id uuid.UUID
+
+		readSuggestionErr error
+		visibility        models.ImproveRequestVisibility
+
+		shouldCallReadCase bool
+		readCaseData       *models.ForumReportCase
+		readCaseErr        error
+
+		shouldCallReadRatings bool
+		readData              []*models.ImproveSuggestionRating
+		readErr               error
+
+		expect    []*models.ImproveSuggestionRating
+		expectErr error
+	}{
+		{
+			name:                  "Success",
+			id:                    test_utils.NumberUUID(1),
+			visibility:            models.ImproveRequestVisibilityPublic,
+			shouldCallReadCase:    true,
+			shouldCallReadRatings: true,
+			readData: []*models.ImproveSuggestionRating{
+				{Aspect: models.CritiqueAspectTone, Rating: 4, Note: "Nice."},
+			},
+			expect: []*models.ImproveSuggestionRating{
+				{Aspect: models.CritiqueAspectTone, Rating: 4, Note: "Nice."},
+			},
+		},
+		{
+			name:               "Error/HiddenSuggestion",
+			id:                 test_utils.NumberUUID(1),
+			visibility:         models.ImproveRequestVisibilityPublic,
+			shouldCallReadCase: true,
+			readCaseData:       &models.ForumReportCase{HiddenAt: &baseTime},
+			expectErr:          validation.ErrNotFound,
+		},
+		{
+			name:               "Error/ReportsServiceFailure",
+			id:                 test_utils.NumberUUID(1),
+			visibility:         models.ImproveRequestVisibilityPublic,
+			shouldCallReadCase: true,
+			readCaseErr:        fooErr,
+			expectErr:          fooErr,
+		},
+		{
+			name:       "Error/HiddenRequest",
+			id:         test_utils.NumberUUID(1),
+			visibility: models.ImproveRequestVisibilityRestricted,
+			expectErr:  validation.ErrNotFound,
+		},
+		{
+			name:              "Error/ImproveSuggestionServiceFailure",
+			id:                test_utils.NumberUUID(1),
+			readSuggestionErr: fooErr,
+			expectErr:         fooErr,
+		},
+		{
+			name:                  "Error/CritiqueServiceFailure",
+			id:                    test_utils.NumberUUID(1),
+			visibility:            models.ImproveRequestVisibilityPublic,
+			shouldCallReadCase:    true,
+			shouldCallReadRatings: true,
+			readErr:               fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveSuggestionService := improve_suggestion_service.NewMockService(t)
+			visibilityService := visibility_service.NewMockService(t)
+			critiqueService := critique_service.NewMockService(t)
+			reportsService := reports_service.NewMockService(t)
+
+			improveSuggestionService.
+				On("Read", context.TODO(), d.id).
+				Return(&models.ImproveSuggestion{ID: d.id, SourceID: test_utils.NumberUUID(2)}, d.readSuggestionErr)
+
+			if d.readSuggestionErr == nil {
+				visibilityService.
+					On("Read", context.TODO(), test_utils.NumberUUID(2)).
+					Return(&models.ImproveRequestAccess{Source: test_utils.NumberUUID(2), Visibility: d.visibility}, nil)
+			}
+
+			if d.shouldCallReadCase {
+				// Most contents were never reported.
+				readCaseErr := d.readCaseErr
+				if d.readCaseData == nil && readCaseErr == nil {
+					readCaseErr = validation.ErrNotFound
+				}
+
+				reportsService.
+					On("ReadCase", context.TODO(), models.ModerationTargetImproveSuggestion, d.id).
+					Return(d.readCaseData, readCaseErr)
+			}
+
+			if d.shouldCallReadRatings {
+				critiqueService.
+					On("ReadRatings", context.TODO(), d.id).
+					Return(d.readData, d.readErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveSuggestionService: improveSuggestionService,
+				VisibilityService:        visibilityService,
+				CritiqueService:          critiqueService,
+				ReportsService:           reportsService,
+				Time:                     test_utils.GetTimeNow(baseTime),
+			})
+
+			res, err := provider.ReadImproveSuggestionRatings(context.TODO(), "", "", d.id)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveSuggestionService.AssertExpectations(t)
+			visibilityService.AssertExpectations(t)
+			critiqueService.AssertExpectations(t)
+			reportsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_RateImproveSuggestion(t *testing.T) {
+	userToken := &models.UserToken{
+		Header: models.UserTokenHeader{
+			IAT: baseTime.Add(-time.Hour),
+			EXP: baseTime.Add(time.Hour),
+			ID:  test_utils.NumberUUID(100),
+		},
+		Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+	}
+
+	data := []struct {
+		name string
+
+		token   string
+		id      uuid.UUID
+		ratings []*models.ImproveSuggestionRating
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+
+		shouldCallRead  bool
+		suggestionOwner uuid.UUID
+		shouldReadState bool
+		currentState    models.ImproveRequestState
+
+		shouldReadAspects bool
+		aspects           []models.CritiqueAspect
+
+		shouldCallRate bool
+		rateData       []*models.ImproveSuggestionRating
+		rateErr        error
+
+		expect    []*models.ImproveSuggestionRating
+		expectErr error
+	}{
+		{
+			name:  "Success",
+			token: "foo.bar.qux",
+			id:    test_utils.NumberUUID(2),
+			ratings: []*models.ImproveSuggestionRating{
+				{Aspect: models.CritiqueAspectTone, Rating: 4},
+			},
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			suggestionOwner:        test_utils.NumberUUID(10),
+			shouldReadState:        true,
+			currentState:           models.ImproveRequestStateClosed,
+			shouldReadAspects:      true,
+			aspects:                []models.CritiqueAspect{models.CritiqueAspectPacing, models.CritiqueAspectTone},
+			shouldCallRate:         true,
+			rateData: []*models.ImproveSuggestionRating{
+				{Aspect: models.CritiqueAspectTone, Rating: 4},
+			},
+			expect: []*models.ImproveSuggestionRating{
+				{Aspect: models.CritiqueAspectTone, Rating: 4},
+			},
+		},
+		{
+			name:  "Error/AspectNotRequested",
+			token: "foo.bar.qux",
+			id:    test_utils.NumberUUID(2),
+			ratings: []*models.ImproveSuggestionRating{
+				{Aspect: models.CritiqueAspectGrammar, Rating: 4},
+			},
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			suggestionOwner:        test_utils.NumberUUID(10),
+			shouldReadState:        true,
+			currentState:           models.ImproveRequestStateOpen,
+			shouldReadAspects:      true,
+			aspects:                []models.CritiqueAspect{models.CritiqueAspectPacing, models.CritiqueAspectTone},
+			expectErr:              validation.ErrInvalidEntity,
+		},
+		{
+			name:  "Error/Locked",
+			token: "foo.bar.qux",
+			id:    test_utils.NumberUUID(2),
+			ratings: []*models.ImproveSuggestionRating{
+				{Aspect: models.CritiqueAspectTone, Rating: 4},
+			},
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			suggestionOwner:        test_utils.NumberUUID(10),
+			shouldReadState:        true,
+			currentState:           models.ImproveRequestStateLocked,
+			expectErr:              validation.ErrInvalidCredentials,
+		},
+		{
+			name:  "Error/NotAuthor",
+			token: "foo.bar.qux",
+			id:    test_utils.NumberUUID(2),
+			ratings: []*models.ImproveSuggestionRating{
+				{Aspect: models.CritiqueAspectTone, Rating: 4},
+			},
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			suggestionOwner:        test_utils.NumberUUID(11),
+			expectErr:              validation.ErrInvalidCredentials,
+		},
+		{
+			name:  "Error/CritiqueServiceFailure",
+			token: "foo.bar.qux",
+			id:    test_utils.NumberUUID(2),
+			ratings: []*models.ImproveSuggestionRating{
+				{Aspect: models.CritiqueAspectTone, Rating: 4},
+			},
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			suggestionOwner:        test_utils.NumberUUID(10),
+			shouldReadState:        true,
+			currentState:           models.ImproveRequestStateOpen,
+			shouldReadAspects:      true,
+			aspects:                []models.CritiqueAspect{models.CritiqueAspectTone},
+			shouldCallRate:         true,
+			rateErr:                fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:  "Error/TokenServiceFailure",
+			token: "foo.bar.qux",
+			id:    test_utils.NumberUUID(2),
+			ratings: []*models.ImproveSuggestionRating{
+				{Aspect: models.CritiqueAspectTone, Rating: 4},
+			},
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveSuggestionService := improve_suggestion_service.NewMockService(t)
+			threadStateService := thread_state_service.NewMockService(t)
+			critiqueService := critique_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallRead {
+				improveSuggestionService.
+					On("Read", context.TODO(), d.id).
+					Return(&models.ImproveSuggestion{
+						ID:       d.id,
+						SourceID: test_utils.NumberUUID(1),
+						UserID:   d.suggestionOwner,
+					}, nil)
+			}
+
+			if d.shouldReadState {
+				threadStateService.
+					On("Read", context.TODO(), test_utils.NumberUUID(1)).
+					Return(&models.ImproveRequestThreadState{Source: test_utils.NumberUUID(1), State: d.currentState}, nil)
+			}
+
+			if d.shouldReadAspects {
+				critiqueService.
+					On("ReadAspects", context.TODO(), test_utils.NumberUUID(1)).
+					Return(d.aspects, nil)
+			}
+
+			if d.shouldCallRate {
+				critiqueService.
+					On("Rate", context.TODO(), d.id, d.ratings).
+					Return(d.rateData, d.rateErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveSuggestionService: improveSuggestionService,
+				ThreadStateService:       threadStateService,
+				CritiqueService:          critiqueService,
+				TokenService:             tokenService,
+				KeysService:              keysService,
+				Time:                     test_utils.GetTimeNow(baseTime),
+			})
+
+			res, err := provider.RateImproveSuggestion(context.TODO(), d.token, d.id, d.ratings)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveSuggestionService.AssertExpectations(t)
+			threadStateService.AssertExpectations(t)
+			critiqueService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_CloseInactiveImproveRequests(t *testing.T) {
+	data := []struct {
+		name string
+
+		auth *authentication.BackendServiceAuth
+
+		shouldCallService bool
+		autoCloseErr      error
+
+		expectErr error
+	}{
+		{
+			name:              "Success",
+			shouldCallService: true,
+		},
+		{
+			name: "Error/NotABackendService",
+			auth: &authentication.BackendServiceAuth{
+				UserAgent:    "curl/7.88.1",
+				AllowedUsers: []string{"scheduler@agora.com"},
+			},
+			expectErr: validation.ErrInvalidCredentials,
+		},
+		{
+			name:              "Error/ServiceFailure",
+			shouldCallService: true,
+			autoCloseErr:      fooErr,
+			expectErr:         fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			threadStateService := thread_state_service.NewMockService(t)
+
+			if d.shouldCallService {
+				threadStateService.
+					On("AutoClose", context.TODO(), 10, 30*24*time.Hour, baseTime).
+					Return(int64(3), d.autoCloseErr)
+			}
+
+			provider := NewProvider(Config{
+				ThreadStateService:           threadStateService,
+				AutoCloseAcceptedSuggestions: 10,
+				AutoCloseInactivity:          30 * 24 * time.Hour,
+				Time:                         test_utils.GetTimeNow(baseTime),
+			})
+
+			err := provider.CloseInactiveImproveRequests(context.TODO(), d.auth)
+			test_utils.RequireError(t, d.expectErr, err)
+
+			threadStateService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_AwardBadges(t *testing.T) {
+	data := []struct {
+		name string
+
+		auth *authentication.BackendServiceAuth
+
+		shouldCallListActive bool
+		listActiveData       []uuid.UUID
+		listActiveErr        error
+
+		evaluateErr error
+
+		expectErr error
+	}{
+		{
+			name:                 "Success",
+			shouldCallListActive: true,
+			listActiveData:       []uuid.UUID{test_utils.NumberUUID(10), test_utils.NumberUUID(11)},
+		},
+		{
+			name:                 "Success/NoActiveUsers",
+			shouldCallListActive: true,
+			listActiveData:       []uuid.UUID{},
+		},
+		{
+			name: "Error/NotABackendService",
+			auth: &authentication.BackendServiceAuth{
+				UserAgent:    "curl/7.88.1",
+				AllowedUsers: []string{"scheduler@agora.com"},
+			},
+			expectErr: validation.ErrInvalidCredentials,
+		},
+		{
+			name:                 "Error/ListActiveFailure",
+			shouldCallListActive: true,
+			listActiveErr:        fooErr,
+			expectErr:            fooErr,
+		},
+		{
+			name:                 "Error/EvaluateFailure",
+			shouldCallListActive: true,
+			listActiveData:       []uuid.UUID{test_utils.NumberUUID(10)},
+			evaluateErr:          fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			badgesService := badges_service.NewMockService(t)
+
+			if d.shouldCallListActive {
+				badgesService.
+					On("ListActive", context.TODO(), baseTime.Add(-24*time.Hour)).
+					Return(d.listActiveData, d.listActiveErr)
+			}
+
+			for _, userID := range d.listActiveData {
+				badgesService.
+					On("Evaluate", context.TODO(), userID, baseTime).
+					Return([]*models.UserBadge{}, d.evaluateErr)
+			}
+
+			provider := NewProvider(Config{
+				BadgesService: badgesService,
+				BadgesWindow:  24 * time.Hour,
+				Time:          test_utils.GetTimeNow(baseTime),
+			})
+
+			err := provider.AwardBadges(context.TODO(), d.auth)
+			test_utils.RequireError(t, d.expectErr, err)
+
+			badgesService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_ReadImproveRequestVisibility(t *testing.T) {
+	userToken := &models.UserToken{
+		Header: models.UserTokenHeader{
+			IAT: baseTime.Add(-time.Hour),
+			EXP: baseTime.Add(time.Hour),
+			ID:  test_utils.NumberUUID(100),
+		},
+		Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+	}
+
+	data := []struct {
+		name string
+
+		token     string
+		requestID uuid.UUID
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+
+		shouldCallRead bool
+		readErr        error
+		isCreatorData  bool
+		// Role of the user as a collaborator, when not the creator. Empty when the user is not a collaborator.
+		collaboratorRole models.ImproveRequestCollaboratorRole
+
+		shouldCallVisibilityService bool
+		visibilityData              *models.ImproveRequestAccess
+		visibilityErr               error
+
+		expect    *models.ImproveRequestAccess
+		expectErr error
+	}{
+		{
+			name:                        "Success",
+			token:                       "foo.bar.qux",
+			requestID:                   test_utils.NumberUUID(2),
+			tokenServiceDecodeData:      userToken,
+			shouldCallRead:              true,
+			isCreatorData:               true,
+			shouldCallVisibilityService: true,
+			visibilityData: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityUnlisted,
+				UpdatedAt:  &baseTime,
+			},
+			expect: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityUnlisted,
+				UpdatedAt:  &baseTime,
+			},
+		},
+		{
+			name:                        "Success/OwnerCollaborator",
+			token:                       "foo.bar.qux",
+			requestID:                   test_utils.NumberUUID(2),
+			tokenServiceDecodeData:      userToken,
+			shouldCallRead:              true,
+			collaboratorRole:            models.ImproveRequestCollaboratorRoleOwner,
+			shouldCallVisibilityService: true,
+			visibilityData: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityRestricted,
+				UpdatedAt:  &baseTime,
+			},
+			expect: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityRestricted,
+				UpdatedAt:  &baseTime,
+			},
+		},
+		{
+			name:                   "Error/EditorCollaborator",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			collaboratorRole:       models.ImproveRequestCollaboratorRoleEditor,
+			expectErr:              validation.ErrInvalidCredentials,
+		},
+		{
+			name:                   "Error/NotOwner",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			expectErr:              validation.ErrInvalidCredentials,
+		},
+		{
+			name:                        "Error/VisibilityServiceFailure",
+			token:                       "foo.bar.qux",
+			requestID:                   test_utils.NumberUUID(2),
+			tokenServiceDecodeData:      userToken,
+			shouldCallRead:              true,
+			isCreatorData:               true,
+			shouldCallVisibilityService: true,
+			visibilityErr:               fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:                   "Error/ImproveRequestServiceFailure",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			readErr:                fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			requestID:             test_utils.NumberUUID(2),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			visibilityService := visibility_service.NewMockService(t)
+			collaboratorService := collaborator_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallRead {
+				improveRequestService.
+					On("Read", context.TODO(), d.requestID).
+					Return(&models.ImproveRequest{
+						ID:     d.requestID,
+						Source: test_utils.NumberUUID(1),
+						UserID: test_utils.NumberUUID(10),
+					}, d.readErr)
+
+				if d.readErr == nil {
+					improveRequestService.
+						On("IsCreator", context.TODO(), test_utils.NumberUUID(10), test_utils.NumberUUID(1), true).
+						Return(d.isCreatorData, nil)
+
+					if !d.isCreatorData && d.collaboratorRole == "" {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(nil, validation.ErrNotFound)
+					} else if !d.isCreatorData {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(&models.ImproveRequestCollaborator{
+								Source:     test_utils.NumberUUID(1),
+								UserID:     test_utils.NumberUUID(10),
+								Role:       d.collaboratorRole,
+								CreatedAt:  baseTime,
+								AcceptedAt: &baseTime,
+							}, nil)
+					}
+				}
+			}
+
+			if d.shouldCallVisibilityService {
+				visibilityService.
+					On("Read", context.TODO(), test_utils.NumberUUID(1)).
+					Return(d.visibilityData, d.visibilityErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService: improveRequestService,
+				VisibilityService:     visibilityService,
+				CollaboratorService:   collaboratorService,
+				TokenService:          tokenService,
+				KeysService:           keysService,
+				Time:                  test_utils.GetTimeNow(baseTime),
+			})
+
+			res, err := provider.ReadImproveRequestVisibility(context.TODO(), d.token, d.requestID)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveRequestService.AssertExpectations(t)
+			visibilityService.AssertExpectations(t)
+			collaboratorService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_UpdateImproveRequestVisibility(t *testing.T) {
+	userToken := &models.UserToken{
+		Header: models.UserTokenHeader{
+			IAT: baseTime.Add(-time.Hour),
+			EXP: baseTime.Add(time.Hour),
+			ID:  test_utils.NumberUUID(100),
+		},
+		Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+	}
+
+	data := []struct {
+		name string
+
+		token     string
+		requestID uuid.UUID
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+
+		shouldCallRead bool
+		readErr        error
+		isCreatorData  bool
+		// Role of the user as a collaborator, when not the creator. Empty when the user is not a collaborator.
+		collaboratorRole models.ImproveRequestCollaboratorRole
+
+		shouldCallVisibilityService bool
+		visibilityData              *models.ImproveRequestAccess
+		visibilityErr               error
+
+		expect    *models.ImproveRequestAccess
+		expectErr error
+	}{
+		{
+			name:                        "Success",
+			token:                       "foo.bar.qux",
+			requestID:                   test_utils.NumberUUID(2),
+			tokenServiceDecodeData:      userToken,
+			shouldCallRead:              true,
+			isCreatorData:               true,
+			shouldCallVisibilityService: true,
+			visibilityData: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityRestricted,
+				UpdatedAt:  &baseTime,
+			},
+			expect: &models.ImproveRequestAccess{
+				Source:     test_utils.NumberUUID(1),
+				Visibility: models.ImproveRequestVisibilityRestricted,
+				UpdatedAt:  &baseTime,
+			},
+		},
+		{
+			name:                   "Error/NotOwner",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			expectErr:              validation.ErrInvalidCredentials,
+		},
+		{
+			name:                        "Error/VisibilityServiceFailure",
+			token:                       "foo.bar.qux",
+			requestID:                   test_utils.NumberUUID(2),
+			tokenServiceDecodeData:      userToken,
+			shouldCallRead:              true,
+			isCreatorData:               true,
+			shouldCallVisibilityService: true,
+			visibilityErr:               fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:                   "Error/ImproveRequestServiceFailure",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			readErr:                fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			requestID:             test_utils.NumberUUID(2),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			visibilityService := visibility_service.NewMockService(t)
+			collaboratorService := collaborator_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallRead {
+				improveRequestService.
+					On("Read", context.TODO(), d.requestID).
+					Return(&models.ImproveRequest{
+						ID:     d.requestID,
+						Source: test_utils.NumberUUID(1),
+						UserID: test_utils.NumberUUID(10),
+					}, d.readErr)
+
+				if d.readErr == nil {
+					improveRequestService.
+						On("IsCreator", context.TODO(), test_utils.NumberUUID(10), test_utils.NumberUUID(1), true).
+						Return(d.isCreatorData, nil)
+
+					if !d.isCreatorData && d.collaboratorRole == "" {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(nil, validation.ErrNotFound)
+					} else if !d.isCreatorData {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(&models.ImproveRequestCollaborator{
+								Source:     test_utils.NumberUUID(1),
+								UserID:     test_utils.NumberUUID(10),
+								Role:       d.collaboratorRole,
+								CreatedAt:  baseTime,
+								AcceptedAt: &baseTime,
+							}, nil)
+					}
+				}
+			}
+
+			if d.shouldCallVisibilityService {
+				visibilityService.
+					On("Update", context.TODO(), test_utils.NumberUUID(1), models.ImproveRequestVisibilityRestricted, baseTime).
+					Return(d.visibilityData, d.visibilityErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService: improveRequestService,
+				VisibilityService:     visibilityService,
+				CollaboratorService:   collaboratorService,
+				TokenService:          tokenService,
+				KeysService:           keysService,
+				Time:                  test_utils.GetTimeNow(baseTime),
+			})
+
+			res, err := provider.UpdateImproveRequestVisibility(context.TODO(), d.token, d.requestID, models.ImproveRequestVisibilityRestricted)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveRequestService.AssertExpectations(t)
+			visibilityService.AssertExpectations(t)
+			collaboratorService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_ListImproveRequestInvites(t *testing.T) {
+	userToken := &models.UserToken{
+		Header: models.UserTokenHeader{
+			IAT: baseTime.Add(-time.Hour),
+			EXP: baseTime.Add(time.Hour),
+			ID:  test_utils.NumberUUID(100),
+		},
+		Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+	}
+
+	data := []struct {
+		name string
+
+		token     string
+		requestID uuid.UUID
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+
+		shouldCallRead bool
+		readErr        error
+		isCreatorData  bool
+		// Role of the user as a collaborator, when not the creator. Empty when the user is not a collaborator.
+		collaboratorRole models.ImproveRequestCollaboratorRole
+
+		shouldCallVisibilityService bool
+		visibilityData              []*models.ImproveRequestInvite
+		visibilityTotal             int64
+		visibilityErr               error
+
+		expect      []*models.ImproveRequestInvite
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name:                        "Success",
+			token:                       "foo.bar.qux",
+			requestID:                   test_utils.NumberUUID(2),
+			tokenServiceDecodeData:      userToken,
+			shouldCallRead:              true,
+			isCreatorData:               true,
+			shouldCallVisibilityService: true,
+			visibilityData: []*models.ImproveRequestInvite{
+				{Source: test_utils.NumberUUID(1), UserID: test_utils.NumberUUID(20), CreatedAt: baseTime},
+			},
+			visibilityTotal: 6,
+			expect: []*models.ImproveRequestInvite{
+				{Source: test_utils.NumberUUID(1), UserID: test_utils.NumberUUID(20), CreatedAt: baseTime},
+			},
+			expectTotal: 6,
+		},
+		{
+			name:                   "Error/NotOwner",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			expectErr:              validation.ErrInvalidCredentials,
+		},
+		{
+			name:                        "Error/VisibilityServiceFailure",
+			token:                       "foo.bar.qux",
+			requestID:                   test_utils.NumberUUID(2),
+			tokenServiceDecodeData:      userToken,
+			shouldCallRead:              true,
+			isCreatorData:               true,
+			shouldCallVisibilityService: true,
+			visibilityErr:               fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:                   "Error/ImproveRequestServiceFailure",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			readErr:                fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			requestID:             test_utils.NumberUUID(2),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			visibilityService := visibility_service.NewMockService(t)
+			collaboratorService := collaborator_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallRead {
+				improveRequestService.
+					On("Read", context.TODO(), d.requestID).
+					Return(&models.ImproveRequest{
+						ID:     d.requestID,
+						Source: test_utils.NumberUUID(1),
+						UserID: test_utils.NumberUUID(10),
+					}, d.readErr)
+
+				if d.readErr == nil {
+					improveRequestService.
+						On("IsCreator", context.TODO(), test_utils.NumberUUID(10), test_utils.NumberUUID(1), true).
+						Return(d.isCreatorData, nil)
+
+					if !d.isCreatorData && d.collaboratorRole == "" {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(nil, validation.ErrNotFound)
+					} else if !d.isCreatorData {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(&models.ImproveRequestCollaborator{
+								Source:     test_utils.NumberUUID(1),
+								UserID:     test_utils.NumberUUID(10),
+								Role:       d.collaboratorRole,
+								CreatedAt:  baseTime,
+								AcceptedAt: &baseTime,
+							}, nil)
+					}
+				}
+			}
+
+			if d.shouldCallVisibilityService {
+				visibilityService.
+					On("ListInvites", context.TODO(), test_utils.NumberUUID(1), 10, 5).
+					Return(d.visibilityData, d.visibilityTotal, d.visibilityErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService: improveRequestService,
+				VisibilityService:     visibilityService,
+				CollaboratorService:   collaboratorService,
+				TokenService:          tokenService,
+				KeysService:           keysService,
+				Time:                  test_utils.GetTimeNow(baseTime),
+			})
+
+			res, total, err := provider.ListImproveRequestInvites(context.TODO(), d.token, d.requestID, 10, 5)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+			require.Equal(t, d.expectTotal, total)
+
+			improveRequestService.AssertExpectations(t)
+			visibilityService.AssertExpectations(t)
+			collaboratorService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_InviteToImproveRequest(t *testing.T) {
+	userToken := &models.UserToken{
+		Header: models.UserTokenHeader{
+			IAT: baseTime.Add(-time.Hour),
+			EXP: baseTime.Add(time.Hour),
+			ID:  test_utils.NumberUUID(100),
+		},
+		Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+	}
+
+	data := []struct {
+		name string
+
+		token     string
+		requestID uuid.UUID
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+
+		shouldCallRead bool
+		readErr        error
+		isCreatorData  bool
+		// Role of the user as a collaborator, when not the creator. Empty when the user is not a collaborator.
+		collaboratorRole models.ImproveRequestCollaboratorRole
+
+		shouldCallVisibilityService bool
+		visibilityData              *models.ImproveRequestInvite
+		visibilityErr               error
+
+		expect    *models.ImproveRequestInvite
+		expectErr error
+	}{
+		{
+			name:                        "Success",
+			token:                       "foo.bar.qux",
+			requestID:                   test_utils.NumberUUID(2),
+			tokenServiceDecodeData:      userToken,
+			shouldCallRead:              true,
+			isCreatorData:               true,
+			shouldCallVisibilityService: true,
+			visibilityData: &models.ImproveRequestInvite{
+				Source:    test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(20),
+				CreatedAt: baseTime,
+			},
+			expect: &models.ImproveRequestInvite{
+				Source:    test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(20),
+				CreatedAt: baseTime,
+			},
+		},
+		{
+			name:                   "Error/NotOwner",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			expectErr:              validation.ErrInvalidCredentials,
+		},
+		{
+			name:                        "Error/VisibilityServiceFailure",
+			token:                       "foo.bar.qux",
+			requestID:                   test_utils.NumberUUID(2),
+			tokenServiceDecodeData:      userToken,
+			shouldCallRead:              true,
+			isCreatorData:               true,
+			shouldCallVisibilityService: true,
+			visibilityErr:               fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:                   "Error/ImproveRequestServiceFailure",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			readErr:                fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			requestID:             test_utils.NumberUUID(2),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			visibilityService := visibility_service.NewMockService(t)
+			collaboratorService := collaborator_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallRead {
+				improveRequestService.
+					On("Read", context.TODO(), d.requestID).
+					Return(&models.ImproveRequest{
+						ID:     d.requestID,
+						Source: test_utils.NumberUUID(1),
+						UserID: test_utils.NumberUUID(10),
+					}, d.readErr)
+
+				if d.readErr == nil {
+					improveRequestService.
+						On("IsCreator", context.TODO(), test_utils.NumberUUID(10), test_utils.NumberUUID(1), true).
+						Return(d.isCreatorData, nil)
+
+					if !d.isCreatorData && d.collaboratorRole == "" {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(nil, validation.ErrNotFound)
+					} else if !d.isCreatorData {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(&models.ImproveRequestCollaborator{
+								Source:     test_utils.NumberUUID(1),
+								UserID:     test_utils.NumberUUID(10),
+								Role:       d.collaboratorRole,
+								CreatedAt:  baseTime,
+								AcceptedAt: &baseTime,
+							}, nil)
+					}
+				}
+			}
+
+			if d.shouldCallVisibilityService {
+				visibilityService.
+					On("Invite", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(20), baseTime).
+					Return(d.visibilityData, d.visibilityErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService: improveRequestService,
+				VisibilityService:     visibilityService,
+				CollaboratorService:   collaboratorService,
+				TokenService:          tokenService,
+				KeysService:           keysService,
+				Time:                  test_utils.GetTimeNow(baseTime),
+			})
+
+			res, err := provider.InviteToImproveRequest(context.TODO(), d.token, d.requestID, test_utils.NumberUUID(20))
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveRequestService.AssertExpectations(t)
+			visibilityService.AssertExpectations(t)
+			collaboratorService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_UninviteFromImproveRequest(t *testing.T) {
+	userToken := &models.UserToken{
+		Header: models.UserTokenHeader{
+			IAT: baseTime.Add(-time.Hour),
+			EXP: baseTime.Add(time.Hour),
+			ID:  test_utils.NumberUUID(100),
+		},
+		Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+	}
+
+	data := []struct {
+		name string
+
+		token     string
+		requestID uuid.UUID
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+
+		shouldCallRead bool
+		readErr        error
+		isCreatorData  bool
+		// Role of the user as a collaborator, when not the creator. Empty when the user is not a collaborator.
+		collaboratorRole models.ImproveRequestCollaboratorRole
+
+		shouldCallVisibilityService bool
+		visibilityErr               error
+
+		expectErr error
+	}{
+		{
+			name:                        "Success",
+			token:                       "foo.bar.qux",
+			requestID:                   test_utils.NumberUUID(2),
+			tokenServiceDecodeData:      userToken,
+			shouldCallRead:              true,
+			isCreatorData:               true,
+			shouldCallVisibilityService: true,
+		},
+		{
+			name:                   "Error/NotOwner",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			expectErr:              validation.ErrInvalidCredentials,
+		},
+		{
+			name:                        "Error/VisibilityServiceFailure",
+			token:                       "foo.bar.qux",
+			requestID:                   test_utils.NumberUUID(2),
+			tokenServiceDecodeData:      userToken,
+			shouldCallRead:              true,
+			isCreatorData:               true,
+			shouldCallVisibilityService: true,
+			visibilityErr:               fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:                   "Error/ImproveRequestServiceFailure",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			readErr:                fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			requestID:             test_utils.NumberUUID(2),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			visibilityService := visibility_service.NewMockService(t)
+			collaboratorService := collaborator_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallRead {
+				improveRequestService.
+					On("Read", context.TODO(), d.requestID).
+					Return(&models.ImproveRequest{
+						ID:     d.requestID,
+						Source: test_utils.NumberUUID(1),
+						UserID: test_utils.NumberUUID(10),
+					}, d.readErr)
+
+				if d.readErr == nil {
+					improveRequestService.
+						On("IsCreator", context.TODO(), test_utils.NumberUUID(10), test_utils.NumberUUID(1), true).
+						Return(d.isCreatorData, nil)
+
+					if !d.isCreatorData && d.collaboratorRole == "" {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(nil, validation.ErrNotFound)
+					} else if !d.isCreatorData {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(&models.ImproveRequestCollaborator{
+								Source:     test_utils.NumberUUID(1),
+								UserID:     test_utils.NumberUUID(10),
+								Role:       d.collaboratorRole,
+								CreatedAt:  baseTime,
+								AcceptedAt: &baseTime,
+							}, nil)
+					}
+				}
+			}
+
+			if d.shouldCallVisibilityService {
+				visibilityService.
+					On("Uninvite", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(20)).
+					Return(d.visibilityErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService: improveRequestService,
+				VisibilityService:     visibilityService,
+				CollaboratorService:   collaboratorService,
+				TokenService:          tokenService,
+				KeysService:           keysService,
+				Time:                  test_utils.GetTimeNow(baseTime),
+			})
+
+			err := provider.UninviteFromImproveRequest(context.TODO(), d.token, d.requestID, test_utils.NumberUUID(20))
+			test_utils.RequireError(t, d.expectErr, err)
+
+			improveRequestService.AssertExpectations(t)
+			visibilityService.AssertExpectations(t)
+			collaboratorService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_ListImproveRequestShareTokens(t *testing.T) {
+	userToken := &models.UserToken{
+		Header: models.UserTokenHeader{
+			IAT: baseTime.Add(-time.Hour),
+			EXP: baseTime.Add(time.Hour),
+			ID:  test_utils.NumberUUID(100),
+		},
+		Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+	}
+
+	data := []struct {
+		name string
+
+		token     string
+		requestID uuid.UUID
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+
+		shouldCallRead bool
+		readErr        error
+		isCreatorData  bool
+		// Role of the user as a collaborator, when not the creator. Empty when the user is not a collaborator.
+		collaboratorRole models.ImproveRequestCollaboratorRole
+
+		shouldCallVisibilityService bool
+		visibilityData              []*models.ImproveRequestShareToken
+		visibilityErr               error
+
+		expect    []*models.ImproveRequestShareToken
+		expectErr error
+	}{
+		{
+			name:                        "Success",
+			token:                       "foo.bar.qux",
+			requestID:                   test_utils.NumberUUID(2),
+			tokenServiceDecodeData:      userToken,
+			shouldCallRead:              true,
+			isCreatorData:               true,
+			shouldCallVisibilityService: true,
+			visibilityData: []*models.ImproveRequestShareToken{
+				{ID: test_utils.NumberUUID(30), Source: test_utils.NumberUUID(1), CreatedAt: baseTime},
+			},
+			expect: []*models.ImproveRequestShareToken{
+				{ID: test_utils.NumberUUID(30), Source: test_utils.NumberUUID(1), CreatedAt: baseTime},
+			},
+		},
+		{
+			name:                   "Error/NotOwner",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			expectErr:              validation.ErrInvalidCredentials,
+		},
+		{
+			name:                        "Error/VisibilityServiceFailure",
+			token:                       "foo.bar.qux",
+			requestID:                   test_utils.NumberUUID(2),
+			tokenServiceDecodeData:      userToken,
+			shouldCallRead:              true,
+			isCreatorData:               true,
+			shouldCallVisibilityService: true,
+			visibilityErr:               fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:                   "Error/ImproveRequestServiceFailure",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			readErr:                fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			requestID:             test_utils.NumberUUID(2),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			visibilityService := visibility_service.NewMockService(t)
+			collaboratorService := collaborator_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallRead {
+				improveRequestService.
+					On("Read", context.TODO(), d.requestID).
+					Return(&models.ImproveRequest{
+						ID:     d.requestID,
+						Source: test_utils.NumberUUID(1),
+						UserID: test_utils.NumberUUID(10),
+					}, d.readErr)
+
+				if d.readErr == nil {
+					improveRequestService.
+						On("IsCreator", context.TODO(), test_utils.NumberUUID(10), test_utils.NumberUUID(1), true).
+						Return(d.isCreatorData, nil)
+
+					if !d.isCreatorData && d.collaboratorRole == "" {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(nil, validation.ErrNotFound)
+					} else if !d.isCreatorData {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(&models.ImproveRequestCollaborator{
+								Source:     test_utils.NumberUUID(1),
+								UserID:     test_utils.NumberUUID(10),
+								Role:       d.collaboratorRole,
+								CreatedAt:  baseTime,
+								AcceptedAt: &baseTime,
+							}, nil)
+					}
+				}
+			}
+
+			if d.shouldCallVisibilityService {
+				visibilityService.
+					On("ListShareTokens", context.TODO(), test_utils.NumberUUID(1)).
+					Return(d.visibilityData, d.visibilityErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService: improveRequestService,
+				VisibilityService:     visibilityService,
+				CollaboratorService:   collaboratorService,
+				TokenService:          tokenService,
+				KeysService:           keysService,
+				Time:                  test_utils.GetTimeNow(baseTime),
+			})
+
+			res, err := provider.ListImproveRequestShareTokens(context.TODO(), d.token, d.requestID)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveRequestService.AssertExpectations(t)
+			visibilityService.AssertExpectations(t)
+			collaboratorService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_CreateImproveRequestShareToken(t *testing.T) {
+	userToken := &models.UserToken{
+		Header: models.UserTokenHeader{
+			IAT: baseTime.Add(-time.Hour),
+			EXP: baseTime.Add(time.Hour),
+			ID:  test_utils.NumberUUID(100),
+		},
+		Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+	}
+
+	data := []struct {
+		name string
+
+		token     string
+		requestID uuid.UUID
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+
+		shouldCallRead bool
+		readErr        error
+		isCreatorData  bool
+		// Role of the user as a collaborator, when not the creator. Empty when the user is not a collaborator.
+		collaboratorRole models.ImproveRequestCollaboratorRole
+
+		shouldCallVisibilityService bool
+		visibilityData              *models.ImproveRequestShareLink
+		visibilityErr               error
+
+		expect    *models.ImproveRequestShareLink
+		expectErr error
+	}{
+		{
+			name:                        "Success",
+			token:                       "foo.bar.qux",
+			requestID:                   test_utils.NumberUUID(2),
+			tokenServiceDecodeData:      userToken,
+			shouldCallRead:              true,
+			isCreatorData:               true,
+			shouldCallVisibilityService: true,
+			visibilityData: &models.ImproveRequestShareLink{
+				ImproveRequestShareToken: models.ImproveRequestShareToken{
+					ID:        test_utils.NumberUUID(30),
+					Source:    test_utils.NumberUUID(1),
+					CreatedAt: baseTime,
+				},
+				Token: "share.token",
+			},
+			expect: &models.ImproveRequestShareLink{
+				ImproveRequestShareToken: models.ImproveRequestShareToken{
+					ID:        test_utils.NumberUUID(30),
+					Source:    test_utils.NumberUUID(1),
+					CreatedAt: baseTime,
+				},
+				Token: "share.token",
+			},
+		},
+		{
+			name:                   "Error/NotOwner",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			expectErr:              validation.ErrInvalidCredentials,
+		},
+		{
+			name:                        "Error/VisibilityServiceFailure",
+			token:                       "foo.bar.qux",
+			requestID:                   test_utils.NumberUUID(2),
+			tokenServiceDecodeData:      userToken,
+			shouldCallRead:              true,
+			isCreatorData:               true,
+			shouldCallVisibilityService: true,
+			visibilityErr:               fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:                   "Error/ImproveRequestServiceFailure",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			readErr:                fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			requestID:             test_utils.NumberUUID(2),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			visibilityService := visibility_service.NewMockService(t)
+			collaboratorService := collaborator_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallRead {
+				improveRequestService.
+					On("Read", context.TODO(), d.requestID).
+					Return(&models.ImproveRequest{
+						ID:     d.requestID,
+						Source: test_utils.NumberUUID(1),
+						UserID: test_utils.NumberUUID(10),
+					}, d.readErr)
+
+				if d.readErr == nil {
+					improveRequestService.
+						On("IsCreator", context.TODO(), test_utils.NumberUUID(10), test_utils.NumberUUID(1), true).
+						Return(d.isCreatorData, nil)
+
+					if !d.isCreatorData && d.collaboratorRole == "" {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(nil, validation.ErrNotFound)
+					} else if !d.isCreatorData {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(&models.ImproveRequestCollaborator{
+								Source:     test_utils.NumberUUID(1),
+								UserID:     test_utils.NumberUUID(10),
+								Role:       d.collaboratorRole,
+								CreatedAt:  baseTime,
+								AcceptedAt: &baseTime,
+							}, nil)
+					}
+				}
+			}
+
+			if d.shouldCallVisibilityService {
+				visibilityService.
+					On("CreateShareToken", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(30), baseTime).
+					Return(d.visibilityData, d.visibilityErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService: improveRequestService,
+				VisibilityService:     visibilityService,
+				CollaboratorService:   collaboratorService,
+				TokenService:          tokenService,
+				KeysService:           keysService,
+				Time:                  test_utils.GetTimeNow(baseTime),
+				ID:                    test_utils.GetUUID(test_utils.NumberUUID(30)),
+			})
+
+			res, err := provider.CreateImproveRequestShareToken(context.TODO(), d.token, d.requestID)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveRequestService.AssertExpectations(t)
+			visibilityService.AssertExpectations(t)
+			collaboratorService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_RevokeImproveRequestShareToken(t *testing.T) {
+	userToken := &models.UserToken{
+		Header: models.UserTokenHeader{
+			IAT: baseTime.Add(-time.Hour),
+			EXP: baseTime.Add(time.Hour),
+			ID:  test_utils.NumberUUID(100),
+		},
+		Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+	}
+
+	data := []struct {
+		name string
+
+		token     string
+		requestID uuid.UUID
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+
+		shouldCallRead bool
+		readErr        error
+		isCreatorData  bool
+		// Role of the user as a collaborator, when not the creator. Empty when the user is not a collaborator.
+		collaboratorRole models.ImproveRequestCollaboratorRole
+
+		shouldCallVisibilityService bool
+		visibilityErr               error
+
+		expectErr error
+	}{
+		{
+			name:                        "Success",
+			token:                       "foo.bar.qux",
+			requestID:                   test_utils.NumberUUID(2),
+			tokenServiceDecodeData:      userToken,
+			shouldCallRead:              true,
+			isCreatorData:               true,
+			shouldCallVisibilityService: true,
+		},
+		{
+			name:                   "Error/NotOwner",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			expectErr:              validation.ErrInvalidCredentials,
+		},
+		{
+			name:                        "Error/VisibilityServiceFailure",
+			token:                       "foo.bar.qux",
+			requestID:                   test_utils.NumberUUID(2),
+			tokenServiceDecodeData:      userToken,
+			shouldCallRead:              true,
+			isCreatorData:               true,
+			shouldCallVisibilityService: true,
+			visibilityErr:               fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:                   "Error/ImproveRequestServiceFailure",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(2),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			readErr:                fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			requestID:             test_utils.NumberUUID(2),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			visibilityService := visibility_service.NewMockService(t)
+			collaboratorService := collaborator_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallRead {
+				improveRequestService.
+					On("Read", context.TODO(), d.requestID).
+					Return(&models.ImproveRequest{
+						ID:     d.requestID,
+						Source: test_utils.NumberUUID(1),
+						UserID: test_utils.NumberUUID(10),
+					}, d.readErr)
+
+				if d.readErr == nil {
+					improveRequestService.
+						On("IsCreator", context.TODO(), test_utils.NumberUUID(10), test_utils.NumberUUID(1), true).
+						Return(d.isCreatorData, nil)
+
+					if !d.isCreatorData && d.collaboratorRole == "" {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(nil, validation.ErrNotFound)
+					} else if !d.isCreatorData {
+						collaboratorService.
+							On("Read", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(10)).
+							Return(&models.ImproveRequestCollaborator{
+								Source:     test_utils.NumberUUID(1),
+								UserID:     test_utils.NumberUUID(10),
+								Role:       d.collaboratorRole,
+								CreatedAt:  baseTime,
+								AcceptedAt: &baseTime,
+							}, nil)
+					}
+				}
+			}
+
+			if d.shouldCallVisibilityService {
+				visibilityService.
+					On("RevokeShareToken", context.TODO(), test_utils.NumberUUID(1), test_utils.NumberUUID(30)).
+					Return(d.visibilityErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService: improveRequestService,
+				VisibilityService:     visibilityService,
+				CollaboratorService:   collaboratorService,
+				TokenService:          tokenService,
+				KeysService:           keysService,
+				Time:                  test_utils.GetTimeNow(baseTime),
+			})
+
+			err := provider.RevokeImproveRequestShareToken(context.TODO(), d.token, d.requestID, test_utils.NumberUUID(30))
+			test_utils.RequireError(t, d.expectErr, err)
+
+			improveRequestService.AssertExpectations(t)
+			visibilityService.AssertExpectations(t)
+			collaboratorService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_SearchImproveRequests(t *testing.T) {
+	data := []struct {
+		name string
+
+		query  models.ImproveRequestSearch
+		limit  int
+		offset int
+
+		serviceData  []*models.ImproveRequestPreview
+		serviceTotal int64
+		serviceErr   error
+
+		expect      []*models.ImproveRequestPreview
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name: "Success",
+			query: models.ImproveRequestSearch{
+				UserID: framework.ToPTR(test_utils.NumberUUID(1)),
+				Query:  "foo",
+				Tags:   []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			limit:  10,
+			offset: 20,
+			serviceData: []*models.ImproveRequestPreview{
+				{
+					ID:                  test_utils.NumberUUID(1),
+					Source:              test_utils.NumberUUID(1),
+					UserID:              test_utils.NumberUUID(1),
+					CreatedAt:           baseTime,
+					Title:               "Dummy request",
+					Content:             "Foo bar qux.",
+					UpVotes:             10,
+					DownVotes:           2,
+					RevisionCount:       3,
+					MoreRecentRevisions: 1,
+				},
+				{
+					ID:            test_utils.NumberUUID(3),
+					Source:        test_utils.NumberUUID(2),
+					UserID:        test_utils.NumberUUID(1),
+					CreatedAt:     baseTime,
+					Title:         "Smart request",
+					Content:       "Qux bar foo.",
+					UpVotes:       17,
+					DownVotes:     1,
+					RevisionCount: 1,
+				},
+			},
+			serviceTotal: 20,
+			expect: []*models.ImproveRequestPreview{
+				{
+					ID:                  test_utils.NumberUUID(1),
+					Source:              test_utils.NumberUUID(1),
+					UserID:              test_utils.NumberUUID(1),
+					CreatedAt:           baseTime,
+					Title:               "Dummy request",
+					Content:             "Foo bar qux.",
+					UpVotes:             10,
+					DownVotes:           2,
+					RevisionCount:       3,
+					MoreRecentRevisions: 1,
+				},
+				{
+					ID:            test_utils.NumberUUID(3),
+					Source:        test_utils.NumberUUID(2),
+					UserID:        test_utils.NumberUUID(1),
+					CreatedAt:     baseTime,
+					Title:         "Smart request",
+					Content:       "Qux bar foo.",
+					UpVotes:       17,
+					DownVotes:     1,
+					RevisionCount: 1,
+				},
+			},
+			expectTotal: 20,
+		},
+		{
+			name: "Error/ServiceFailure",
+			query: models.ImproveRequestSearch{
+				UserID: framework.ToPTR(test_utils.NumberUUID(1)),
+				Query:  "foo",
+			},
+			limit:      10,
+			offset:     20,
+			serviceErr: fooErr,
+			expectErr:  fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+
+			improveRequestService.
+				On("Search", context.TODO(), models.ImproveRequestSearch{
+					UserID: d.query.UserID,
+					Query:  d.query.Query,
+					Tags:   d.query.Tags,
+				}, d.limit, d.offset).
+				Return(d.serviceData, d.serviceTotal, d.serviceErr)
+
+			provider := NewProvider(Config{
+				ImproveRequestService: improveRequestService,
+			})
+
+			res, total, err := provider.SearchImproveRequests(context.TODO(), d.query, d.limit, d.offset)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+			require.Equal(t, d.expectTotal, total)
+
+			improveRequestService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_SearchImproveRequestsAfter(t *testing.T) {
+	data := []struct {
+		name string
+
+		query  models.ImproveRequestSearch
+		cursor string
+		limit  int
+
+		serviceData []*models.ImproveRequestPreview
+		serviceNext string
+		serviceErr  error
+
+		expect     []*models.ImproveRequestPreview
+		expectNext string
+		expectErr  error
+	}{
+		{
+			name: "Success",
+			query: models.ImproveRequestSearch{
+				Query: "foo",
+			},
+			cursor: "cursor-1",
+			limit:  10,
+			serviceData: []*models.ImproveRequestPreview{
+				{
+					ID:            test_utils.NumberUUID(1),
+					Source:        test_utils.NumberUUID(1),
+					UserID:        test_utils.NumberUUID(1),
+					CreatedAt:     baseTime,
+					Title:         "Dummy request",
+					Content:       "Foo bar qux.",
+					RevisionCount: 1,
+				},
+			},
+			serviceNext: "cursor-2",
+			expect: []*models.ImproveRequestPreview{
+				{
+					ID:            test_utils.NumberUUID(1),
+					Source:        test_utils.NumberUUID(1),
+					UserID:        test_utils.NumberUUID(1),
+					CreatedAt:     baseTime,
+					Title:         "Dummy request",
+					Content:       "Foo bar qux.",
+					RevisionCount: 1,
+				},
+			},
+			expectNext: "cursor-2",
+		},
+		{
+			name: "Error/ServiceFailure",
+			query: models.ImproveRequestSearch{
+				Query: "foo",
+			},
+			cursor:     "cursor-1",
+			limit:      10,
+			serviceErr: fooErr,
+			expectErr:  fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+
+			improveRequestService.
+				On("SearchAfter", context.TODO(), d.query, d.cursor, d.limit).
+				Return(d.serviceData, d.serviceNext, d.serviceErr)
+
+			provider := NewProvider(Config{
+				ImproveRequestService: improveRequestService,
+			})
+
+			res, next, err := provider.SearchImproveRequestsAfter(context.TODO(), d.query, d.cursor, d.limit)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+			require.Equal(t, d.expectNext, next)
+
+			improveRequestService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_GetImproveRequestSearchFacets(t *testing.T) {
+	data := []struct {
+		name string
+
+		query models.ImproveRequestSearch
+		now   time.Time
+
+		serviceData *models.ImproveRequestSearchFacets
+		serviceErr  error
+
+		expect    *models.ImproveRequestSearchFacets
+		expectErr error
+	}{
+		{
+			name: "Success",
+			query: models.ImproveRequestSearch{
+				Query: "foo",
+				Tags:  []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			now: baseTime,
+			serviceData: &models.ImproveRequestSearchFacets{
+				Tags: []*models.ImproveRequestTagFacet{
+					{
+						ID:       test_utils.NumberUUID(20),
+						Category: models.ForumTagCategoryGenre,
+						Slug:     "fantasy",
+						Name:     "Fantasy",
+						Count:    4,
+					},
+				},
+				Authors: []*models.ImproveRequestAuthorFacet{
+					{UserID: test_utils.NumberUUID(1), Count: 4},
+				},
+				Accepted:  models.ImproveRequestAcceptedFacet{With: 1, Without: 3},
+				CreatedAt: models.ImproveRequestCreatedAtFacet{LastWeek: 4},
+				Revisions: models.ImproveRequestRevisionsFacet{Single: 2, Few: 2},
+			},
+			expect: &models.ImproveRequestSearchFacets{
+				Tags: []*models.ImproveRequestTagFacet{
+					{
+						ID:       test_utils.NumberUUID(20),
+						Category: models.ForumTagCategoryGenre,
+						Slug:     "fantasy",
+						Name:     "Fantasy",
+						Count:    4,
+					},
+				},
+				Authors: []*models.ImproveRequestAuthorFacet{
+					{UserID: test_utils.NumberUUID(1), Count: 4},
+				},
+				Accepted:  models.ImproveRequestAcceptedFacet{With: 1, Without: 3},
+				CreatedAt: models.ImproveRequestCreatedAtFacet{LastWeek: 4},
+				Revisions: models.ImproveRequestRevisionsFacet{Single: 2, Few: 2},
+			},
+		},
+		{
+			name: "Error/ServiceFailure",
+			query: models.ImproveRequestSearch{
+				Query: "foo",
+			},
+			now:        baseTime,
+			serviceErr: fooErr,
+			expectErr:  fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+
+			improveRequestService.
+				On("Facets", context.TODO(), d.query, d.now).
+				Return(d.serviceData, d.serviceErr)
+
+			provider := NewProvider(Config{
+				ImproveRequestService: improveRequestService,
+				Time:                  test_utils.GetTimeNow(d.now),
+			})
+
+			res, err := provider.GetImproveRequestSearchFacets(context.TODO(), d.query)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveRequestService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_GetRelatedImproveRequests(t *testing.T) {
+	related := []*models.ImproveRequestPreview{
+		{
+			ID:            test_utils.NumberUUID(42),
+			Source:        test_utils.NumberUUID(42),
+			CreatedAt:     baseTime,
+			UserID:        test_utils.NumberUUID(12),
+			Title:         "Smart post",
+			Content:       "Cats taking a nap.",
+			RevisionCount: 1,
+		},
+	}
+
+	data := []struct {
+		name string
+
+		id         uuid.UUID
+		shareToken string
+		limit      int
+		now        time.Time
+
+		readErr    error
+		visibility models.ImproveRequestVisibility
+
+		shouldCallShareToken bool
+		shareTokenData       bool
+
+		shouldCallService bool
+		serviceData       []*models.ImproveRequestPreview
+		serviceErr        error
+
+		expect    []*models.ImproveRequestPreview
+		expectErr error
+	}{
+		{
+			name:              "Success",
+			id:                test_utils.NumberUUID(2),
+			limit:             10,
+			now:               baseTime,
+			visibility:        models.ImproveRequestVisibilityPublic,
+			shouldCallService: true,
+			serviceData:       related,
+			expect:            related,
+		},
+		{
+			name:                 "Success/ShareToken",
+			id:                   test_utils.NumberUUID(2),
+			shareToken:           "share-token",
+			limit:                10,
+			now:                  baseTime,
+			visibility:           models.ImproveRequestVisibilityUnlisted,
+			shouldCallShareToken: true,
+			shareTokenData:       true,
+			shouldCallService:    true,
+			serviceData:          related,
+			expect:               related,
+		},
+		{
+			name:       "Error/Unlisted",
+			id:         test_utils.NumberUUID(2),
+			limit:      10,
+			now:        baseTime,
+			visibility: models.ImproveRequestVisibilityUnlisted,
+			expectErr:  validation.ErrNotFound,
+		},
+		{
+			name:                 "Error/WrongShareToken",
+			id:                   test_utils.NumberUUID(2),
+			shareToken:           "share-token",
+			limit:                10,
+			now:                  baseTime,
+			visibility:           models.ImproveRequestVisibilityUnlisted,
+			shouldCallShareToken: true,
+			expectErr:            validation.ErrNotFound,
+		},
+		{
+			name:       "Error/Restricted",
+			id:         test_utils.NumberUUID(2),
+			shareToken: "share-token",
+			limit:      10,
+			now:        baseTime,
+			visibility: models.ImproveRequestVisibilityRestricted,
+			expectErr:  validation.ErrNotFound,
+		},
+		{
+			name:      "Error/ImproveRequestServiceFailure",
+			id:        test_utils.NumberUUID(2),
+			limit:     10,
+			now:       baseTime,
+			readErr:   fooErr,
+			expectErr: fooErr,
+		},
+		{
+			name:              "Error/ServiceFailure",
+			id:                test_utils.NumberUUID(2),
+			limit:             10,
+			now:               baseTime,
+			visibility:        models.ImproveRequestVisibilityPublic,
+			shouldCallService: true,
+			serviceErr:        fooErr,
+			expectErr:         fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			visibilityService := visibility_service.NewMockService(t)
+
+			improveRequestService.
+				On("Read", context.TODO(), d.id).
+				Return(&models.ImproveRequest{
+					ID:     d.id,
+					Source: test_utils.NumberUUID(1),
+					UserID: test_utils.NumberUUID(10),
+				}, d.readErr)
+
+			if d.readErr == nil {
+				visibilityService.
+					On("Read", context.TODO(), test_utils.NumberUUID(1)).
+					Return(&models.ImproveRequestAccess{Source: test_utils.NumberUUID(1), Visibility: d.visibility}, nil)
+			}
+
+			if d.shouldCallShareToken {
+				visibilityService.
+					On("VerifyShareToken", context.TODO(), test_utils.NumberUUID(1), d.shareToken).
+					Return(d.shareTokenData, nil)
+			}
+
+			if d.shouldCallService {
+				improveRequestService.
+					On("Related", context.TODO(), d.id, d.limit, d.now).
+					Return(d.serviceData, d.serviceErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService: improveRequestService,
+				VisibilityService:     visibilityService,
+				Time:                  test_utils.GetTimeNow(d.now),
+			})
+
+			res, err := provider.GetRelatedImproveRequests(context.TODO(), "", d.shareToken, d.id, d.limit)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveRequestService.AssertExpectations(t)
+			visibilityService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_SearchForum(t *testing.T) {
+	data := []struct {
+		name string
+
+		query    string
+		language string
+		limit    int
+		offset   int
+
+		shouldCallSuggestionService bool
+
+		requestsData  []*models.ImproveRequestPreview
+		requestsTotal int64
+		requestsErr   error
+
+		suggestionsData  []*models.ImproveSuggestion
+		suggestionsTotal int64
+		suggestionsErr   error
+
+		shouldCallSuggestService bool
+		suggestData              []string
+		suggestErr               error
+
+		expect    *models.ForumSearchResults
+		expectErr error
+	}{
+		{
+			name:                        "Success",
+			query:                       "foo",
+			language:                    "en",
+			limit:                       10,
+			offset:                      20,
+			shouldCallSuggestionService: true,
+			requestsData: []*models.ImproveRequestPreview{
+				{
+					ID:            test_utils.NumberUUID(1),
+					Source:        test_utils.NumberUUID(1),
+					UserID:        test_utils.NumberUUID(1),
+					CreatedAt:     baseTime,
+					Title:         "Dummy request",
+					Content:       "Foo bar qux.",
+					RevisionCount: 1,
+				},
+			},
+			requestsTotal: 21,
+			suggestionsData: []*models.ImproveSuggestion{
+				{
+					ID:        test_utils.NumberUUID(10),
+					CreatedAt: baseTime,
+					SourceID:  test_utils.NumberUUID(1),
+					UserID:    test_utils.NumberUUID(2),
+					RequestID: test_utils.NumberUUID(1),
+					Title:     "Dummy suggestion",
+					Content:   "Foo qux bar.",
+				},
+			},
+			suggestionsTotal: 22,
+			expect: &models.ForumSearchResults{
+				ImproveRequests: []*models.ImproveRequestPreview{
+					{
+						ID:            test_utils.NumberUUID(1),
+						Source:        test_utils.NumberUUID(1),
+						UserID:        test_utils.NumberUUID(1),
+						CreatedAt:     baseTime,
+						Title:         "Dummy request",
+						Content:       "Foo bar qux.",
+						RevisionCount: 1,
+					},
+				},
+				ImproveRequestsTotal: 21,
+				ImproveSuggestions: []*models.ImproveSuggestion{
+					{
+						ID:        test_utils.NumberUUID(10),
+						CreatedAt: baseTime,
+						SourceID:  test_utils.NumberUUID(1),
+						UserID:    test_utils.NumberUUID(2),
+						RequestID: test_utils.NumberUUID(1),
+						Title:     "Dummy suggestion",
+						Content:   "Foo qux bar.",
+					},
+				},
+				ImproveSuggestionsTotal: 22,
+			},
+		},
+		{
+			name:                        "Success/DidYouMean",
+			query:                       "fascinasion",
+			limit:                       10,
+			offset:                      20,
+			shouldCallSuggestionService: true,
+			shouldCallSuggestService:    true,
+			suggestData:                 []string{"Fascination étrange"},
+			expect: &models.ForumSearchResults{
+				DidYouMean: []string{"Fascination étrange"},
+			},
+		},
+		{
+			name:        "Error/RequestServiceFailure",
+			query:       "foo",
+			limit:       10,
+			offset:      20,
+			requestsErr: fooErr,
+			expectErr:   fooErr,
+		},
+		{
+			name:                        "Error/SuggestionServiceFailure",
+			query:                       "foo",
+			limit:                       10,
+			offset:                      20,
+			shouldCallSuggestionService: true,
+			suggestionsErr:              fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:                        "Error/SuggestFailure",
+			query:                       "fascinasion",
+			limit:                       10,
+			offset:                      20,
+			shouldCallSuggestionService: true,
+			shouldCallSuggestService:    true,
+			suggestErr:                  fooErr,
+			expectErr:                   fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			improveSuggestionService := improve_suggestion_service.NewMockService(t)
+
+			improveRequestService.
+				On("Search", context.TODO(), models.ImproveRequestSearch{Query: d.query, Language: d.language}, d.limit, d.offset).
+				Return(d.requestsData, d.requestsTotal, d.requestsErr)
+
+			if d.shouldCallSuggestionService {
+				improveSuggestionService.
+					On("List", context.TODO(), models.ImproveSuggestionsList{Query: d.query, Language: d.language}, d.limit, d.offset).
+					Return(d.suggestionsData, d.suggestionsTotal, d.suggestionsErr)
+			}
+
+			if d.shouldCallSuggestService {
+				improveRequestService.
+					On("Suggest", context.TODO(), d.query, SearchSuggestionsLimit).
+					Return(d.suggestData, d.suggestErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService:    improveRequestService,
+				ImproveSuggestionService: improveSuggestionService,
+			})
+
+			res, err := provider.SearchForum(context.TODO(), d.query, d.language, d.limit, d.offset)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveRequestService.AssertExpectations(t)
+			improveSuggestionService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_GetImproveRequestPreviews(t *testing.T) {
+	data := []struct {
+		name string
+
+		token string
+		ids   []uuid.UUID
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+
+		shouldCallService bool
+		viewerID          *uuid.UUID
+		serviceData       []*models.ImproveRequestPreview
+		serviceErr        error
+
+		expect    []*models.ImproveRequestPreview
+		expectErr error
+	}{
+		{
+			name: "Success",
+			ids: []uuid.UUID{
+				test_utils.NumberUUID(1),
+				test_utils.NumberUUID(2),
+			},
+			shouldCallService: true,
+			serviceData: []*models.ImproveRequestPreview{
+				{
+					ID:                  test_utils.NumberUUID(1),
+					Source:              test_utils.NumberUUID(2),
+					CreatedAt:           baseTime,
+					UserID:              test_utils.NumberUUID(1),
+					Title:               "Dummy post",
+					Content:             "Foo bar qux.",
+					UpVotes:             10,
+					DownVotes:           5,
+					MoreRecentRevisions: 1,
+					RevisionCount:       10,
+				},
+				{
+					ID:                  test_utils.NumberUUID(42),
+					Source:              test_utils.NumberUUID(666),
+					CreatedAt:           baseTime,
+					UserID:              test_utils.NumberUUID(12),
+					Title:               "Smart post",
+					Content:             "Cats taking a nap.",
+					UpVotes:             4,
+					DownVotes:           1,
+					MoreRecentRevisions: 3,
+					RevisionCount:       4,
+				},
+			},
+			expect: []*models.ImproveRequestPreview{
+				{
+					ID:                  test_utils.NumberUUID(1),
+					Source:              test_utils.NumberUUID(2),
+					CreatedAt:           baseTime,
+					UserID:              test_utils.NumberUUID(1),
+					Title:               "Dummy post",
+					Content:             "Foo bar qux.",
+					UpVotes:             10,
+					DownVotes:           5,
+					MoreRecentRevisions: 1,
+					RevisionCount:       10,
+				},
+				{
+					ID:                  test_utils.NumberUUID(42),
+					Source:              test_utils.NumberUUID(666),
+					CreatedAt:           baseTime,
+					UserID:              test_utils.NumberUUID(12),
+					Title:               "Smart post",
+					Content:             "Cats taking a nap.",
+					UpVotes:             4,
+					DownVotes:           1,
+					MoreRecentRevisions: 3,
+					RevisionCount:       4,
+				},
+			},
+		},
+		{
+			name:  "Success/Authenticated",
+			token: "foo.bar.qux",
+			ids: []uuid.UUID{
+				test_utils.NumberUUID(1),
+			},
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			shouldCallService: true,
+			viewerID:          framework.ToPTR(test_utils.NumberUUID(10)),
+			serviceData:       []*models.ImproveRequestPreview{},
+			expect:            []*models.ImproveRequestPreview{},
+		},
+		{
+			name: "Error/ServiceFailure",
+			ids: []uuid.UUID{
+				test_utils.NumberUUID(1),
+				test_utils.NumberUUID(2),
+			},
+			shouldCallService: true,
+			serviceErr:        fooErr,
+			expectErr:         fooErr,
+		},
+		{
+			name:  "Error/TokenServiceFailure",
+			token: "foo.bar.qux",
+			ids: []uuid.UUID{
+				test_utils.NumberUUID(1),
+			},
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+
+			if d.token != "" {
+				publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+				for i, key := range jwk_storage.MockedKeys {
+					publicKeys[i] = key.Public().(ed25519.PublicKey)
+				}
+
+				keysService.
+					On("ListPublic").
+					Return(publicKeys)
+
+				tokenService.
+					On("Decode", d.token, publicKeys, baseTime).
+					Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+			}
+
+			if d.shouldCallService {
+				improveRequestService.
+					On("GetPreviews", context.TODO(), d.ids, d.viewerID).
+					Return(d.serviceData, d.serviceErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService: improveRequestService,
+				TokenService:          tokenService,
+				KeysService:           keysService,
+				Time:                  test_utils.GetTimeNow(baseTime),
+			})
+
+			res, err := provider.GetImproveRequestPreviews(context.TODO(), d.token, d.ids)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveRequestService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_ReadImproveSuggestion(t *testing.T) {
+	suggestion := &models.ImproveSuggestion{
+		ID:        test_utils.NumberUUID(1),
+		CreatedAt: baseTime,
+		UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+		SourceID:  test_utils.NumberUUID(10),
+		UserID:    test_utils.NumberUUID(100),
+		Validated: true,
+		UpVotes:   32,
+		DownVotes: 2,
+		RequestID: test_utils.NumberUUID(11),
+		Title:     "Dummy suggestion",
+		Content:   "Foo bar qux.",
+	}
+
+	ownSuggestion := &models.ImproveSuggestion{
+		ID:        test_utils.NumberUUID(1),
+		CreatedAt: baseTime,
+		SourceID:  test_utils.NumberUUID(10),
+		UserID:    test_utils.NumberUUID(200),
+		RequestID: test_utils.NumberUUID(11),
+		Title:     "Dummy suggestion",
+		Content:   "Foo bar qux.",
+	}
+
+	userToken := &models.UserToken{
+		Header: models.UserTokenHeader{
+			IAT: baseTime.Add(-time.Hour),
+			EXP: baseTime.Add(time.Hour),
+			ID:  test_utils.NumberUUID(1000),
+		},
+		Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(200)},
+	}
+
+	data := []struct {
+		name string
+
+		token      string
+		shareToken string
+		id         uuid.UUID
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+
+		shouldCallRead bool
+		serviceData    *models.ImproveSuggestion
+		serviceErr     error
+
+		visibility                 models.ImproveRequestVisibility
+		shouldCallIsCreator        bool
+		isCreatorData              bool
+		shouldCallIsInvited        bool
+		shouldCallVerifyShareToken bool
+		verifyShareTokenData       bool
+
+		shouldCallReadCase bool
+		readCaseData       *models.ForumReportCase
+		readCaseErr        error
+
+		shouldCallHasAuthorizations bool
+		isModerator                 bool
+
+		expect    *models.ImproveSuggestion
+		expectErr error
+	}{
+		{
+			name:               "Success",
+			id:                 test_utils.NumberUUID(1),
+			shouldCallRead:     true,
+			serviceData:        suggestion,
+			visibility:         models.ImproveRequestVisibilityPublic,
+			shouldCallReadCase: true,
+			expect:             suggestion,
+		},
+		{
+			name:                   "Success/RestrictedToAuthor",
+			token:                  "foo.bar.qux",
+			id:                     test_utils.NumberUUID(1),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			serviceData:            suggestion,
+			visibility:             models.ImproveRequestVisibilityRestricted,
+			shouldCallIsCreator:    true,
+			isCreatorData:          true,
+			shouldCallReadCase:     true,
+			expect:                 suggestion,
+		},
+		{
+			name:                       "Success/UnlistedWithShareToken",
+			shareToken:                 "share-token",
+			id:                         test_utils.NumberUUID(1),
+			shouldCallRead:             true,
+			serviceData:                suggestion,
+			visibility:                 models.ImproveRequestVisibilityUnlisted,
+			shouldCallVerifyShareToken: true,
+			verifyShareTokenData:       true,
+			shouldCallReadCase:         true,
+			expect:                     suggestion,
+		},
+		{
+			name:                   "Success/HiddenToAuthor",
+			token:                  "foo.bar.qux",
+			id:                     test_utils.NumberUUID(1),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			serviceData:            ownSuggestion,
+			visibility:             models.ImproveRequestVisibilityPublic,
+			shouldCallReadCase:     true,
+			readCaseData:           &models.ForumReportCase{HiddenAt: &baseTime},
+			expect:                 ownSuggestion,
+		},
+		{
+			name:                        "Success/HiddenToModerator",
+			token:                       "foo.bar.qux",
+			id:                          test_utils.NumberUUID(1),
+			tokenServiceDecodeData:      userToken,
+			shouldCallRead:              true,
+			serviceData:                 suggestion,
+			visibility:                  models.ImproveRequestVisibilityPublic,
+			shouldCallReadCase:          true,
+			readCaseData:                &models.ForumReportCase{HiddenAt: &baseTime},
+			shouldCallHasAuthorizations: true,
+			isModerator:                 true,
+			expect:                      suggestion,
+		},
+		{
+			name:               "Error/HiddenToAnonymous",
+			id:                 test_utils.NumberUUID(1),
+			shouldCallRead:     true,
+			serviceData:        suggestion,
+			visibility:         models.ImproveRequestVisibilityPublic,
+			shouldCallReadCase: true,
+			readCaseData:       &models.ForumReportCase{HiddenAt: &baseTime},
+			expectErr:          validation.ErrNotFound,
+		},
+		{
+			name:                        "Error/HiddenToStranger",
+			token:                       "foo.bar.qux",
+			id:                          test_utils.NumberUUID(1),
+			tokenServiceDecodeData:      userToken,
+			shouldCallRead:              true,
+			serviceData:                 suggestion,
+			visibility:                  models.ImproveRequestVisibilityPublic,
+			shouldCallReadCase:          true,
+			readCaseData:                &models.ForumReportCase{HiddenAt: &baseTime},
+			shouldCallHasAuthorizations: true,
+			expectErr:                   validation.ErrNotFound,
+		},
+		{
+			name:               "Error/ReportsServiceFailure",
+			id:                 test_utils.NumberUUID(1),
+			shouldCallRead:     true,
+			serviceData:        suggestion,
+			visibility:         models.ImproveRequestVisibilityPublic,
+			shouldCallReadCase: true,
+			readCaseErr:        fooErr,
+			expectErr:          fooErr,
+		},
+		{
+			name:           "Error/RestrictedToAnonymous",
+			id:             test_utils.NumberUUID(1),
+			shouldCallRead: true,
+			serviceData:    suggestion,
+			visibility:     models.ImproveRequestVisibilityRestricted,
+			expectErr:      validation.ErrNotFound,
+		},
+		{
+			name:                   "Error/RestrictedToStranger",
+			token:                  "foo.bar.qux",
+			id:                     test_utils.NumberUUID(1),
+			tokenServiceDecodeData: userToken,
+			shouldCallRead:         true,
+			serviceData:            suggestion,
+			visibility:             models.ImproveRequestVisibilityRestricted,
+			shouldCallIsCreator:    true,
+			shouldCallIsInvited:    true,
+			expectErr:              validation.ErrNotFound,
+		},
+		{
+			name:                       "Error/UnlistedWithWrongShareToken",
+			shareToken:                 "share-token",
+			id:                         test_utils.NumberUUID(1),
+			shouldCallRead:             true,
+			serviceData:                suggestion,
+			visibility:                 models.ImproveRequestVisibilityUnlisted,
+			shouldCallVerifyShareToken: true,
+			expectErr:                  validation.ErrNotFound,
+		},
+		{
+			name:           "Error/ServiceFailure",
+			id:             test_utils.NumberUUID(1),
+			shouldCallRead: true,
+			serviceErr:     fooErr,
+			expectErr:      fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			id:                    test_utils.NumberUUID(1),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveSuggestionService := improve_suggestion_service.NewMockService(t)
+			improveRequestService := improve_request_service.NewMockService(t)
+			visibilityService := visibility_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+			reportsService := reports_service.NewMockService(t)
+			userService := user_service.NewMockService(t)
+
+			if d.token != "" {
+				publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+				for i, key := range jwk_storage.MockedKeys {
+					publicKeys[i] = key.Public().(ed25519.PublicKey)
+				}
+
+				keysService.
+					On("ListPublic").
+					Return(publicKeys)
+
+				tokenService.
+					On("Decode", d.token, publicKeys, baseTime).
+					Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+			}
+
+			if d.shouldCallRead {
+				improveSuggestionService.
+					On("Read", context.TODO(), d.id).
+					Return(d.serviceData, d.serviceErr)
+
+				if d.serviceErr == nil {
+					visibilityService.
+						On("Read", context.TODO(), test_utils.NumberUUID(10)).
+						Return(&models.ImproveRequestAccess{Source: test_utils.NumberUUID(10), Visibility: d.visibility}, nil)
+				}
+			}
+
+			if d.shouldCallIsCreator {
+				improveRequestService.
+					On("IsCreator", context.TODO(), test_utils.NumberUUID(200), test_utils.NumberUUID(10), false).
+					Return(d.isCreatorData, nil)
+			}
+
+			if d.shouldCallIsInvited {
+				visibilityService.
+					On("IsInvited", context.TODO(), test_utils.NumberUUID(10), test_utils.NumberUUID(200)).
+					Return(false, nil)
+			}
+
+			if d.shouldCallVerifyShareToken {
+				visibilityService.
+					On("VerifyShareToken", context.TODO(), test_utils.NumberUUID(10), d.shareToken).
+					Return(d.verifyShareTokenData, nil)
+			}
+
+			if d.shouldCallReadCase {
+				// Most contents were never reported.
+				readCaseErr := d.readCaseErr
+				if d.readCaseData == nil && readCaseErr == nil {
+					readCaseErr = validation.ErrNotFound
+				}
+
+				reportsService.
+					On("ReadCase", context.TODO(), models.ModerationTargetImproveSuggestion, d.id).
+					Return(d.readCaseData, readCaseErr)
+			}
+
+			if d.shouldCallHasAuthorizations {
+				userService.
+					On("HasAuthorizations", context.TODO(), test_utils.NumberUUID(200), models.UserAuthorizations{
+						{models.UserAuthorizationsModerator},
+					}).
+					Return(d.isModerator, nil)
+			}
+
+			provider := NewProvider(Config{
+				ImproveSuggestionService: improveSuggestionService,
+				ImproveRequestService:    improveRequestService,
+				VisibilityService:        visibilityService,
+				TokenService:             tokenService,
+				KeysService:              keysService,
+				ReportsService:           reportsService,
+				UserService:              userService,
+				Time:                     test_utils.GetTimeNow(baseTime),
+			})
+
+			res, err := provider.ReadImproveSuggestion(context.TODO(), d.token, d.shareToken, d.id)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveSuggestionService.AssertExpectations(t)
+			improveRequestService.AssertExpectations(t)
+			visibilityService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+			reportsService.AssertExpectations(t)
+			userService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_ReadImproveSuggestionRevisions(t *testing.T) {
+	data := []struct {
+		name string
+
+		id uuid.UUID
+
+		readErr    error
+		visibility models.ImproveRequestVisibility
+
+		shouldCallReadCase bool
+		readCaseData       *models.ForumReportCase
+		readCaseErr        error
+
+		shouldCallReadRevisions bool
+		serviceData             []*models.ImproveSuggestionRevision
+		serviceErr              error
+
+		expect    []*models.ImproveSuggestionRevision
+		expectErr error
+	}{
+		{
+			name:                    "Success",
+			id:                      test_utils.NumberUUID(1),
+			visibility:              models.ImproveRequestVisibilityPublic,
+			shouldCallReadCase:      true,
+			shouldCallReadRevisions: true,
+			serviceData: []*models.ImproveSuggestionRevision{
+				{
+					ID:           test_utils.NumberUUID(3),
+					SuggestionID: test_utils.NumberUUID(1),
+					CreatedAt:    baseTime.Add(time.Hour),
+					UpVotes:      4,
+					RequestID:    test_utils.NumberUUID(11),
+					Title:        "Dummy suggestion",
+					Content:      "Foo bar qux, revised.",
+				},
+				{
+					ID:           test_utils.NumberUUID(2),
+					SuggestionID: test_utils.NumberUUID(1),
+					CreatedAt:    baseTime,
+					UpVotes:      28,
+					DownVotes:    2,
+					RequestID:    test_utils.NumberUUID(11),
+					Title:        "Dummy suggestion",
+					Content:      "Foo bar qux.",
+				},
+			},
+			expect: []*models.ImproveSuggestionRevision{
+				{
+					ID:           test_utils.NumberUUID(3),
+					SuggestionID: test_utils.NumberUUID(1),
+					CreatedAt:    baseTime.Add(time.Hour),
+					UpVotes:      4,
+					RequestID:    test_utils.NumberUUID(11),
+					Title:        "Dummy suggestion",
+					Content:      "Foo bar qux, revised.",
+				},
+				{
+					ID:           test_utils.NumberUUID(2),
+					SuggestionID: test_utils.NumberUUID(1),
+					CreatedAt:    baseTime,
+					UpVotes:      28,
+					DownVotes:    2,
+					RequestID:    test_utils.NumberUUID(11),
+					Title:        "Dummy suggestion",
+					Content:      "Foo bar qux.",
+				},
+			},
+		},
+		{
+			name:               "Error/HiddenSuggestion",
+			id:                 test_utils.NumberUUID(1),
+			visibility:         models.ImproveRequestVisibilityPublic,
+			shouldCallReadCase: true,
+			readCaseData:       &models.ForumReportCase{HiddenAt: &baseTime},
+			expectErr:          validation.ErrNotFound,
+		},
+		{
+			name:               "Error/ReportsServiceFailure",
+			id:                 test_utils.NumberUUID(1),
+			visibility:         models.ImproveRequestVisibilityPublic,
+			shouldCallReadCase: true,
+			readCaseErr:        fooErr,
+			expectErr:          fooErr,
+		},
+		{
+			name:       "Error/HiddenRequest",
+			id:         test_utils.NumberUUID(1),
+			visibility: models.ImproveRequestVisibilityRestricted,
+			expectErr:  validation.ErrNotFound,
+		},
+		{
+			name:      "Error/ReadFailure",
+			id:        test_utils.NumberUUID(1),
+			readErr:   fooErr,
+			expectErr: fooErr,
+		},
+		{
+			name:                    "Error/ServiceFailure",
+			id:                      test_utils.NumberUUID(1),
+			visibility:              models.ImproveRequestVisibilityPublic,
+			shouldCallReadCase:      true,
+			shouldCallReadRevisions: true,
+			serviceErr:              fooErr,
+			expectErr:               fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveSuggestionService := improve_suggestion_service.NewMockService(t)
+			visibilityService := visibility_service.NewMockService(t)
+			reportsService := reports_service.NewMockService(t)
+
+			improveSuggestionService.
+				On("Read", context.TODO(), d.id).
+				Return(&models.ImproveSuggestion{ID: d.id, SourceID: test_utils.NumberUUID(10)}, d.readErr)
+
+			if d.readErr == nil {
+				visibilityService.
+					On("Read", context.TODO(), test_utils.NumberUUID(10)).
+					Return(&models.ImproveRequestAccess{Source: test_utils.NumberUUID(10), Visibility: d.visibility}, nil)
+			}
+
+			if d.shouldCallReadCase {
+				// Most contents were never reported.
+				readCaseErr := d.readCaseErr
+				if d.readCaseData == nil && readCaseErr == nil {
+					readCaseErr = validation.ErrNotFound
+				}
+
+				reportsService.
+					On("ReadCase", context.TODO(), models.ModerationTargetImproveSuggestion, d.id).
+					Return(d.readCaseData, readCaseErr)
+			}
+
+			if d.shouldCallReadRevisions {
+				improveSuggestionService.
+					On("ReadRevisions", context.TODO(), d.id).
+					Return(d.serviceData, d.serviceErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveSuggestionService: improveSuggestionService,
+				VisibilityService:        visibilityService,
+				ReportsService:           reportsService,
+				Time:                     test_utils.GetTimeNow(baseTime),
+			})
+
+			res, err := provider.ReadImproveSuggestionRevisions(context.TODO(), "", "", d.id)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveSuggestionService.AssertExpectations(t)
+			visibilityService.AssertExpectations(t)
+			reportsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_CreateImproveSuggestion(t *testing.T) {
+	data := []struct {
+		name string
+
+		now       time.Time
+		keys      []ed25519.PrivateKey
+		id        uuid.UUID
+		userID    uuid.UUID
+		requestID uuid.UUID
+		sourceID  uuid.UUID
+
+		token      string
+		shareToken string
+		title      string
+		content    string
+
+		shouldCallVisibilityService        bool
+		visibility                         models.ImproveRequestVisibility
+		shouldCheckAccess                  bool
+		shouldCallVerifyShareToken         bool
+		verifyShareTokenData               bool
+		shouldCallThreadStateService       bool
+		state                              models.ImproveRequestState
+		shouldCallImproveSuggestionService bool
+		shouldCallDuplicatesService        bool
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+		improveSuggestionData  *models.ImproveSuggestion
+		improveSuggestionErr   error
+
+		duplicatesErr error
+
+		expect    *models.ImproveSuggestion
+		expectErr error
+	}{
+		{
+			name:                               "Success",
+			now:                                baseTime,
+			keys:                               jwk_storage.MockedKeys,
+			id:                                 test_utils.NumberUUID(1),
+			userID:                             test_utils.NumberUUID(10),
+			token:                              "foo.bar.qux",
+			title:                              "Dummy request",
+			content:                            "Foo bar qux.",
+			shouldCallVisibilityService:        true,
+			visibility:                         models.ImproveRequestVisibilityPublic,
+			shouldCallThreadStateService:       true,
+			state:                              models.ImproveRequestStateOpen,
+			shouldCallImproveSuggestionService: true,
+			shouldCallDuplicatesService:        true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			improveSuggestionData: &models.ImproveSuggestion{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				Validated: true,
+				UpVotes:   32,
+				DownVotes: 2,
+				RequestID: test_utils.NumberUUID(11),
+				Title:     "Dummy suggestion",
+				Content:   "Foo bar qux.",
+			},
+			expect: &models.ImproveSuggestion{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				Validated: true,
+				UpVotes:   32,
+				DownVotes: 2,
+				RequestID: test_utils.NumberUUID(11),
+				Title:     "Dummy suggestion",
+				Content:   "Foo bar qux.",
+			},
+		},
+		{
+			name:                               "Success/ShareToken",
+			now:                                baseTime,
+			keys:                               jwk_storage.MockedKeys,
+			id:                                 test_utils.NumberUUID(1),
+			userID:                             test_utils.NumberUUID(10),
+			token:                              "foo.bar.qux",
+			shareToken:                         "share.token",
+			title:                              "Dummy request",
+			content:                            "Foo bar qux.",
+			shouldCallVisibilityService:        true,
+			visibility:                         models.ImproveRequestVisibilityUnlisted,
+			shouldCheckAccess:                  true,
+			shouldCallVerifyShareToken:         true,
+			verifyShareTokenData:               true,
+			shouldCallThreadStateService:       true,
+			state:                              models.ImproveRequestStateOpen,
+			shouldCallImproveSuggestionService: true,
+			shouldCallDuplicatesService:        true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			improveSuggestionData: &models.ImproveSuggestion{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				Validated: true,
+				UpVotes:   32,
+				DownVotes: 2,
+				RequestID: test_utils.NumberUUID(11),
+				Title:     "Dummy suggestion",
+				Content:   "Foo bar qux.",
+			},
+			expect: &models.ImproveSuggestion{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				Validated: true,
+				UpVotes:   32,
+				DownVotes: 2,
+				RequestID: test_utils.NumberUUID(11),
+				Title:     "Dummy suggestion",
+				Content:   "Foo bar qux.",
+			},
+		},
+		{
+			name:                        "Error/HiddenRequest",
+			now:                         baseTime,
+			keys:                        jwk_storage.MockedKeys,
+			id:                          test_utils.NumberUUID(1),
+			userID:                      test_utils.NumberUUID(10),
+			token:                       "foo.bar.qux",
+			title:                       "Dummy request",
+			content:                     "Foo bar qux.",
+			shouldCallVisibilityService: true,
+			visibility:                  models.ImproveRequestVisibilityRestricted,
+			shouldCheckAccess:           true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			expectErr: validation.ErrNotFound,
+		},
+		{
+			name:                               "Success/DuplicatesServiceFailure",
+			now:                                baseTime,
+			keys:                               jwk_storage.MockedKeys,
+			id:                                 test_utils.NumberUUID(1),
+			userID:                             test_utils.NumberUUID(10),
+			token:                              "foo.bar.qux",
+			title:                              "Dummy request",
+			content:                            "Foo bar qux.",
+			shouldCallVisibilityService:        true,
+			visibility:                         models.ImproveRequestVisibilityPublic,
+			shouldCallThreadStateService:       true,
+			state:                              models.ImproveRequestStateOpen,
+			shouldCallImproveSuggestionService: true,
+			shouldCallDuplicatesService:        true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			improveSuggestionData: &models.ImproveSuggestion{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				Validated: true,
+				UpVotes:   32,
+				DownVotes: 2,
+				RequestID: test_utils.NumberUUID(11),
+				Title:     "Dummy suggestion",
+				Content:   "Foo bar qux.",
+			},
+			duplicatesErr: fooErr,
+			expect: &models.ImproveSuggestion{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				Validated: true,
+				UpVotes:   32,
+				DownVotes: 2,
+				RequestID: test_utils.NumberUUID(11),
+				Title:     "Dummy suggestion",
+				Content:   "Foo bar qux.",
+			},
+		},
+		{
+			name:                               "Error/ImproveSuggestionServiceFailure",
+			now:                                baseTime,
+			keys:                               jwk_storage.MockedKeys,
+			id:                                 test_utils.NumberUUID(1),
+			userID:                             test_utils.NumberUUID(10),
+			token:                              "foo.bar.qux",
+			title:                              "Dummy request",
+			content:                            "Foo bar qux.",
+			shouldCallVisibilityService:        true,
+			visibility:                         models.ImproveRequestVisibilityPublic,
+			shouldCallThreadStateService:       true,
+			state:                              models.ImproveRequestStateOpen,
+			shouldCallImproveSuggestionService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			improveSuggestionErr: fooErr,
+			expectErr:            fooErr,
+		},
+		{
+			name:                         "Error/RequestClosed",
+			now:                          baseTime,
+			keys:                         jwk_storage.MockedKeys,
+			id:                           test_utils.NumberUUID(1),
+			userID:                       test_utils.NumberUUID(10),
+			token:                        "foo.bar.qux",
+			title:                        "Dummy request",
+			content:                      "Foo bar qux.",
+			shouldCallVisibilityService:  true,
+			visibility:                   models.ImproveRequestVisibilityPublic,
+			shouldCallThreadStateService: true,
+			state:                        models.ImproveRequestStateClosed,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			expectErr: validation.ErrInvalidCredentials,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			now:                   baseTime,
+			keys:                  jwk_storage.MockedKeys,
+			id:                    test_utils.NumberUUID(1),
+			userID:                test_utils.NumberUUID(10),
+			token:                 "foo.bar.qux",
+			title:                 "Dummy request",
+			content:               "Foo bar qux.",
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveSuggestionService := improve_suggestion_service.NewMockService(t)
+
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+			duplicatesService := duplicates_service.NewMockService(t)
+			improveRequestService := improve_request_service.NewMockService(t)
+			visibilityService := visibility_service.NewMockService(t)
+			threadStateService := thread_state_service.NewMockService(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(d.keys))
+			for i, key := range d.keys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, d.now).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallVisibilityService {
+				visibilityService.
+					On("Read", context.TODO(), d.sourceID).
+					Return(&models.ImproveRequestAccess{Source: d.sourceID, Visibility: d.visibility}, nil)
+			}
+
+			if d.shouldCheckAccess {
+				improveRequestService.
+					On("IsCreator", context.TODO(), d.userID, d.sourceID, false).
+					Return(false, nil)
+				visibilityService.
+					On("IsInvited", context.TODO(), d.sourceID, d.userID).
+					Return(false, nil)
+			}
+
+			if d.shouldCallVerifyShareToken {
+				visibilityService.
+					On("VerifyShareToken", context.TODO(), d.sourceID, d.shareToken).
+					Return(d.verifyShareTokenData, nil)
+			}
+
+			if d.shouldCallThreadStateService {
+				threadStateService.
+					On("Read", context.TODO(), d.sourceID).
+					Return(&models.ImproveRequestThreadState{Source: d.sourceID, State: d.state}, nil)
+			}
+
+			if d.shouldCallImproveSuggestionService {
+				improveSuggestionService.
+					On("Create", context.TODO(), &models.ImproveSuggestionUpsert{
+						RequestID: d.requestID,
+						Title:     d.title,
+						Content:   d.content,
+					}, d.userID, d.sourceID, d.id, d.id, d.now).
+					Return(d.improveSuggestionData, d.improveSuggestionErr)
+			}
+
+			if d.shouldCallDuplicatesService {
+				duplicatesService.
+					On("Check", context.TODO(), &models.ForumPostRevision{
+						RevisionID: d.improveSuggestionData.RevisionID,
+						PostID:     d.improveSuggestionData.ID,
+						ThreadID:   d.improveSuggestionData.SourceID,
+						UserID:     d.improveSuggestionData.UserID,
+						Target:     models.ForumPostTargetImproveSuggestion,
+						CreatedAt:  d.now,
+						Content:    d.improveSuggestionData.Content,
+					}, d.now).
+					Return(nil, d.duplicatesErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService:    improveRequestService,
+				ImproveSuggestionService: improveSuggestionService,
+				DuplicatesService:        duplicatesService,
+				VisibilityService:        visibilityService,
+				ThreadStateService:       threadStateService,
+				TokenService:             tokenService,
+				KeysService:              keysService,
+				Time:                     test_utils.GetTimeNow(d.now),
+				ID:                       test_utils.GetUUID(d.id),
+			})
+
+			res, err := provider.CreateImproveSuggestion(context.TODO(), d.token, d.shareToken, d.requestID, d.sourceID, d.title, d.content)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveSuggestionService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+			duplicatesService.AssertExpectations(t)
+			improveRequestService.AssertExpectations(t)
+			visibilityService.AssertExpectations(t)
+			threadStateService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_UpdateImproveSuggestion(t *testing.T) {
+	data := []struct {
+		name string
+
+		now    time.Time
+		keys   []ed25519.PrivateKey
+		userID uuid.UUID
+
+		token      string
+		title      string
+		content    string
+		postID     uuid.UUID
+		requestID  uuid.UUID
+		revisionID uuid.UUID
+
+		shouldCallImproveSuggestionGetService    bool
+		shouldCallThreadStateService             bool
+		state                                    models.ImproveRequestState
+		shouldCallImproveSuggestionUpdateService bool
+		shouldCallDuplicatesService              bool
+
+		tokenServiceDecodeData      *models.UserToken
+		tokenServiceDecodeErr       error
+		improveSuggestionGetData    *models.ImproveSuggestion
+		improveSuggestionGetErr     error
+		improveSuggestionUpdateData *models.ImproveSuggestion
+		improveSuggestionUpdateErr  error
+
+		duplicatesErr error
+
+		expect    *models.ImproveSuggestion
+		expectErr error
+	}{
+		{
+			name:                                     "Success",
+			now:                                      baseTime,
+			keys:                                     jwk_storage.MockedKeys,
+			postID:                                   test_utils.NumberUUID(1),
+			requestID:                                test_utils.NumberUUID(10),
+			revisionID:                               test_utils.NumberUUID(2),
+			userID:                                   test_utils.NumberUUID(100),
+			token:                                    "foo.bar.qux",
+			title:                                    "Smart request",
+			content:                                  "Qux bar foo.",
+			shouldCallImproveSuggestionGetService:    true,
+			shouldCallThreadStateService:             true,
+			state:                                    models.ImproveRequestStateOpen,
+			shouldCallImproveSuggestionUpdateService: true,
+			shouldCallDuplicatesService:              true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			improveSuggestionGetData: &models.ImproveSuggestion{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				Validated: true,
+				UpVotes:   32,
+				DownVotes: 2,
+				RequestID: test_utils.NumberUUID(11),
+				Title:     "Dummy suggestion",
+				Content:   "Foo bar qux.",
+			},
+			improveSuggestionUpdateData: &models.ImproveSuggestion{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				Validated: true,
+				UpVotes:   32,
+				DownVotes: 2,
+				RequestID: test_utils.NumberUUID(11),
+				Title:     "Smart request",
+				Content:   "Qux bar foo.",
+			},
+			expect: &models.ImproveSuggestion{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				Validated: true,
+				UpVotes:   32,
+				DownVotes: 2,
+				RequestID: test_utils.NumberUUID(11),
+				Title:     "Smart request",
+				Content:   "Qux bar foo.",
+			},
+		},
+		{
+			name:                                     "Success/DuplicatesServiceFailure",
+			now:                                      baseTime,
+			keys:                                     jwk_storage.MockedKeys,
+			postID:                                   test_utils.NumberUUID(1),
+			requestID:                                test_utils.NumberUUID(10),
+			revisionID:                               test_utils.NumberUUID(2),
+			userID:                                   test_utils.NumberUUID(100),
+			token:                                    "foo.bar.qux",
+			title:                                    "Smart request",
+			content:                                  "Qux bar foo.",
+			shouldCallImproveSuggestionGetService:    true,
+			shouldCallThreadStateService:             true,
+			state:                                    models.ImproveRequestStateClosed,
+			shouldCallImproveSuggestionUpdateService: true,
+			shouldCallDuplicatesService:              true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			improveSuggestionGetData: &models.ImproveSuggestion{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				Validated: true,
+				UpVotes:   32,
+				DownVotes: 2,
+				RequestID: test_utils.NumberUUID(11),
+				Title:     "Dummy suggestion",
+				Content:   "Foo bar qux.",
+			},
+			improveSuggestionUpdateData: &models.ImproveSuggestion{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				Validated: true,
+				UpVotes:   32,
+				DownVotes: 2,
+				RequestID: test_utils.NumberUUID(11),
+				Title:     "Smart request",
+				Content:   "Qux bar foo.",
+			},
+			duplicatesErr: fooErr,
+			expect: &models.ImproveSuggestion{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				Validated: true,
+				UpVotes:   32,
+				DownVotes: 2,
+				RequestID: test_utils.NumberUUID(11),
+				Title:     "Smart request",
+				Content:   "Qux bar foo.",
+			},
+		},
+		{
+			name:                                  "Error/UnauthorizedUser",
+			now:                                   baseTime,
+			keys:                                  jwk_storage.MockedKeys,
+			token:                                 "foo.bar.qux",
+			title:                                 "Smart request",
+			content:                               "Qux bar foo.",
+			postID:                                test_utils.NumberUUID(1),
+			requestID:                             test_utils.NumberUUID(10),
+			userID:                                test_utils.NumberUUID(101),
+			shouldCallImproveSuggestionGetService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(101)},
+			},
+			improveSuggestionGetData: &models.ImproveSuggestion{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				Validated: true,
+				UpVotes:   32,
+				DownVotes: 2,
+				RequestID: test_utils.NumberUUID(11),
+				Title:     "Dummy suggestion",
+				Content:   "Foo bar qux.",
+			},
+			expectErr: validation.ErrInvalidCredentials,
+		},
+		{
+			name:                                     "Error/ImproveRequestServiceCreateFailure",
+			now:                                      baseTime,
+			keys:                                     jwk_storage.MockedKeys,
+			token:                                    "foo.bar.qux",
+			title:                                    "Smart request",
+			content:                                  "Qux bar foo.",
+			postID:                                   test_utils.NumberUUID(1),
+			requestID:                                test_utils.NumberUUID(10),
+			userID:                                   test_utils.NumberUUID(100),
+			shouldCallImproveSuggestionGetService:    true,
+			shouldCallThreadStateService:             true,
+			state:                                    models.ImproveRequestStateOpen,
+			shouldCallImproveSuggestionUpdateService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			improveSuggestionGetData: &models.ImproveSuggestion{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				Validated: true,
+				UpVotes:   32,
+				DownVotes: 2,
+				RequestID: test_utils.NumberUUID(11),
+				Title:     "Dummy suggestion",
+				Content:   "Foo bar qux.",
+			},
+			improveSuggestionUpdateErr: fooErr,
+			expectErr:                  fooErr,
+		},
+		{
+			name:                                  "Error/RequestLocked",
+			now:                                   baseTime,
+			keys:                                  jwk_storage.MockedKeys,
+			token:                                 "foo.bar.qux",
+			title:                                 "Smart request",
+			content:                               "Qux bar foo.",
+			postID:                                test_utils.NumberUUID(1),
+			requestID:                             test_utils.NumberUUID(10),
+			userID:                                test_utils.NumberUUID(100),
+			shouldCallImproveSuggestionGetService: true,
+			shouldCallThreadStateService:          true,
+			state:                                 models.ImproveRequestStateLocked,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			improveSuggestionGetData: &models.ImproveSuggestion{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				Validated: true,
+				UpVotes:   32,
+				DownVotes: 2,
+				RequestID: test_utils.NumberUUID(11),
+				Title:     "Dummy suggestion",
+				Content:   "Foo bar qux.",
+			},
+			expectErr: validation.ErrInvalidCredentials,
+		},
+		{
+			name:                                  "Error/ImproveRequestServiceGetFailure",
+			now:                                   baseTime,
+			keys:                                  jwk_storage.MockedKeys,
+			token:                                 "foo.bar.qux",
+			title:                                 "Smart request",
+			content:                               "Qux bar foo.",
+			postID:                                test_utils.NumberUUID(1),
+			requestID:                             test_utils.NumberUUID(10),
+			userID:                                test_utils.NumberUUID(100),
+			shouldCallImproveSuggestionGetService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			improveSuggestionGetErr: fooErr,
+			expectErr:               fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			now:                   baseTime,
+			keys:                  jwk_storage.MockedKeys,
+			token:                 "foo.bar.qux",
+			title:                 "Smart request",
+			content:               "Qux bar foo.",
+			postID:                test_utils.NumberUUID(1),
+			requestID:             test_utils.NumberUUID(10),
+			userID:                test_utils.NumberUUID(100),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveSuggestionService := improve_suggestion_service.NewMockService(t)
+
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+			duplicatesService := duplicates_service.NewMockService(t)
+			threadStateService := thread_state_service.NewMockService(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(d.keys))
+			for i, key := range d.keys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, d.now).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallImproveSuggestionGetService {
+				improveSuggestionService.
+					On("Read", context.TODO(), d.postID).
+					Return(d.improveSuggestionGetData, d.improveSuggestionGetErr)
+			}
+
+			if d.shouldCallThreadStateService {
+				sourceID := d.improveSuggestionGetData.SourceID
+				threadStateService.
+					On("Read", context.TODO(), sourceID).
+					Return(&models.ImproveRequestThreadState{Source: sourceID, State: d.state}, nil)
+			}
+
+			if d.shouldCallImproveSuggestionUpdateService {
+				improveSuggestionService.
+					On("Update", context.TODO(), &models.ImproveSuggestionUpsert{
+						RequestID: d.requestID,
+						Title:     d.title,
+						Content:   d.content,
+					}, d.postID, d.revisionID, d.now).
+					Return(d.improveSuggestionUpdateData, d.improveSuggestionUpdateErr)
+			}
+
+			if d.shouldCallDuplicatesService {
+				duplicatesService.
+					On("Check", context.TODO(), &models.ForumPostRevision{
+						RevisionID: d.improveSuggestionUpdateData.RevisionID,
+						PostID:     d.improveSuggestionUpdateData.ID,
+						ThreadID:   d.improveSuggestionUpdateData.SourceID,
+						UserID:     d.improveSuggestionUpdateData.UserID,
+						Target:     models.ForumPostTargetImproveSuggestion,
+						CreatedAt:  d.now,
+						Content:    d.improveSuggestionUpdateData.Content,
+					}, d.now).
+					Return(nil, d.duplicatesErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveSuggestionService: improveSuggestionService,
+				DuplicatesService:        duplicatesService,
+				ThreadStateService:       threadStateService,
+				TokenService:             tokenService,
+				KeysService:              keysService,
+				Time:                     test_utils.GetTimeNow(d.now),
+				ID:                       test_utils.GetUUID(d.revisionID),
+			})
+
+			res, err := provider.UpdateImproveSuggestion(context.TODO(), d.token, d.postID, d.requestID, d.title, d.content)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveSuggestionService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+			duplicatesService.AssertExpectations(t)
+			threadStateService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_DeleteImproveSuggestion(t *testing.T) {
+	data := []struct {
+		name string
+
+		now  time.Time
+		keys []ed25519.PrivateKey
+
+		token     string
+		title     string
+		content   string
+		requestID uuid.UUID
+
+		shouldCallImproveSuggestionGetService    bool
+		shouldCallImproveSuggestionDeleteService bool
+
+		tokenServiceDecodeData     *models.UserToken
+		tokenServiceDecodeErr      error
+		improveSuggestionGetData   *models.ImproveSuggestion
+		improveSuggestionGetErr    error
+		improveSuggestionDeleteErr error
+
+		expectErr error
+	}{
+		{
+			name:                                     "Success",
+			now:                                      baseTime,
+			keys:                                     jwk_storage.MockedKeys,
+			token:                                    "foo.bar.qux",
+			title:                                    "Smart request",
+			content:                                  "Qux bar foo.",
+			requestID:                                test_utils.NumberUUID(1),
+			shouldCallImproveSuggestionGetService:    true,
+			shouldCallImproveSuggestionDeleteService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			improveSuggestionGetData: &models.ImproveSuggestion{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				Validated: true,
+				UpVotes:   32,
+				DownVotes: 2,
+				RequestID: test_utils.NumberUUID(11),
+				Title:     "Dummy suggestion",
+				Content:   "Foo bar qux.",
+			},
+		},
+		{
+			name:                                  "Error/UnauthorizedUser",
+			now:                                   baseTime,
+			keys:                                  jwk_storage.MockedKeys,
+			token:                                 "foo.bar.qux",
+			title:                                 "Smart request",
+			content:                               "Qux bar foo.",
+			requestID:                             test_utils.NumberUUID(1),
+			shouldCallImproveSuggestionGetService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(101)},
+			},
+			improveSuggestionGetData: &models.ImproveSuggestion{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				Validated: true,
+				UpVotes:   32,
+				DownVotes: 2,
+				RequestID: test_utils.NumberUUID(11),
+				Title:     "Dummy suggestion",
+				Content:   "Foo bar qux.",
+			},
+			expectErr: validation.ErrInvalidCredentials,
+		},
+		{
+			name:                                     "Error/ImproveSuggestionServiceDeleteFailure",
+			now:                                      baseTime,
+			keys:                                     jwk_storage.MockedKeys,
+			token:                                    "foo.bar.qux",
+			title:                                    "Smart request",
+			content:                                  "Qux bar foo.",
+			requestID:                                test_utils.NumberUUID(1),
+			shouldCallImproveSuggestionGetService:    true,
+			shouldCallImproveSuggestionDeleteService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			improveSuggestionGetData: &models.ImproveSuggestion{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				Validated: true,
+				UpVotes:   32,
+				DownVotes: 2,
+				RequestID: test_utils.NumberUUID(11),
+				Title:     "Dummy suggestion",
+				Content:   "Foo bar qux.",
+			},
+			improveSuggestionDeleteErr: fooErr,
+			expectErr:                  fooErr,
+		},
+		{
+			name:                                  "Error/ImproveSuggestionServiceGetFailure",
+			now:                                   baseTime,
+			keys:                                  jwk_storage.MockedKeys,
+			token:                                 "foo.bar.qux",
+			title:                                 "Smart request",
+			content:                               "Qux bar foo.",
+			requestID:                             test_utils.NumberUUID(1),
+			shouldCallImproveSuggestionGetService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			improveSuggestionGetErr: fooErr,
+			expectErr:               fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			now:                   baseTime,
+			keys:                  jwk_storage.MockedKeys,
+			token:                 "foo.bar.qux",
+			title:                 "Smart request",
+			content:               "Qux bar foo.",
+			requestID:             test_utils.NumberUUID(1),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveSuggestionService := improve_suggestion_service.NewMockService(t)
+
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(d.keys))
+			for i, key := range d.keys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, d.now).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallImproveSuggestionGetService {
+				improveSuggestionService.
+					On("Read", context.TODO(), d.requestID).
+					Return(d.improveSuggestionGetData, d.improveSuggestionGetErr)
+			}
+
+			if d.shouldCallImproveSuggestionDeleteService {
+				improveSuggestionService.
+					On("Delete", context.TODO(), d.requestID, d.now).
+					Return(d.improveSuggestionDeleteErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveSuggestionService: improveSuggestionService,
+				TokenService:             tokenService,
+				KeysService:              keysService,
+				Time:                     test_utils.GetTimeNow(d.now),
+			})
+
+			err := provider.DeleteImproveSuggestion(context.TODO(), d.token, d.requestID)
+			test_utils.RequireError(t, d.expectErr, err)
+
+			improveSuggestionService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_RestoreImproveSuggestion(t *testing.T) {
+	restoreWindow := 24 * time.Hour
+
+	deleted := &models.ImproveSuggestion{
+		ID:        test_utils.NumberUUID(1),
+		CreatedAt: baseTime.Add(-48 * time.Hour),
+		SourceID:  test_utils.NumberUUID(10),
+		UserID:    test_utils.NumberUUID(100),
+		RequestID: test_utils.NumberUUID(11),
+		Title:     "Dummy suggestion",
+		Content:   "Foo bar qux.",
+		DeletedAt: framework.ToPTR(baseTime.Add(-time.Hour)),
+	}
+	restored := &models.ImproveSuggestion{
+		ID:        test_utils.NumberUUID(1),
+		CreatedAt: baseTime.Add(-48 * time.Hour),
+		SourceID:  test_utils.NumberUUID(10),
+		UserID:    test_utils.NumberUUID(100),
+		RequestID: test_utils.NumberUUID(11),
+		Title:     "Dummy suggestion",
+		Content:   "Foo bar qux.",
+	}
+
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+
+		tokenServiceDecodeErr error
+
+		readDeletedData *models.ImproveSuggestion
+		readDeletedErr  error
+
+		shouldReadSource bool
+		readSourceErr    error
+
+		shouldRestore bool
+		restoreData   *models.ImproveSuggestion
+		restoreErr    error
+
+		expect    *models.ImproveSuggestion
+		expectErr error
+	}{
+		{
+			name:             "Success",
+			userID:           test_utils.NumberUUID(100),
+			readDeletedData:  deleted,
+			shouldReadSource: true,
+			shouldRestore:    true,
+			restoreData:      restored,
+			expect:           restored,
+		},
+		{
+			name:            "Error/UnauthorizedUser",
+			userID:          test_utils.NumberUUID(101),
+			readDeletedData: deleted,
+			expectErr:       validation.ErrInvalidCredentials,
+		},
+		{
+			name:             "Error/RequestDeleted",
+			userID:           test_utils.NumberUUID(100),
+			readDeletedData:  deleted,
+			shouldReadSource: true,
+			readSourceErr:    validation.ErrNotFound,
+			expectErr:        validation.ErrNotFound,
+		},
+		{
+			name:   "Error/RestoreWindowExpired",
+			userID: test_utils.NumberUUID(100),
+			readDeletedData: &models.ImproveSuggestion{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime.Add(-72 * time.Hour),
+				SourceID:  test_utils.NumberUUID(10),
+				UserID:    test_utils.NumberUUID(100),
+				RequestID: test_utils.NumberUUID(11),
+				DeletedAt: framework.ToPTR(baseTime.Add(-48 * time.Hour)),
+			},
+			shouldReadSource: true,
+			expectErr:        validation.ErrInvalidCredentials,
+		},
+		{
+			name:           "Error/ReadDeletedFailure",
+			userID:         test_utils.NumberUUID(100),
+			readDeletedErr: fooErr,
+			expectErr:      fooErr,
+		},
+		{
+			name:             "Error/RestoreFailure",
+			userID:           test_utils.NumberUUID(100),
+			readDeletedData:  deleted,
+			shouldReadSource: true,
+			shouldRestore:    true,
+			restoreErr:       fooErr,
+			expectErr:        fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			improveSuggestionService := improve_suggestion_service.NewMockService(t)
+
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			var tokenData *models.UserToken
+			if d.tokenServiceDecodeErr == nil {
+				tokenData = &models.UserToken{
+					Header: models.UserTokenHeader{
+						IAT: baseTime.Add(-time.Hour),
+						EXP: baseTime.Add(time.Hour),
+						ID:  test_utils.NumberUUID(1000),
+					},
+					Payload: models.UserTokenPayload{ID: d.userID},
+				}
+
+				improveSuggestionService.
+					On("ReadDeleted", context.TODO(), test_utils.NumberUUID(1)).
+					Return(d.readDeletedData, d.readDeletedErr)
+			}
+
+			tokenService.
+				On("Decode", "foo.bar.qux", publicKeys, baseTime).
+				Return(tokenData, d.tokenServiceDecodeErr)
+
+			if d.shouldReadSource {
+				improveRequestService.
+					On("Read", context.TODO(), d.readDeletedData.SourceID).
+					Return(&models.ImproveRequest{ID: d.readDeletedData.SourceID}, d.readSourceErr)
+			}
+
+			if d.shouldRestore {
+				improveSuggestionService.
+					On("Restore", context.TODO(), test_utils.NumberUUID(1), baseTime.Add(-restoreWindow)).
+					Return(d.restoreData, d.restoreErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService:    improveRequestService,
+				ImproveSuggestionService: improveSuggestionService,
+				TokenService:             tokenService,
+				KeysService:              keysService,
+				RestoreWindow:            restoreWindow,
+				Time:                     test_utils.GetTimeNow(baseTime),
+			})
+
+			res, err := provider.RestoreImproveSuggestion(context.TODO(), "foo.bar.qux", test_utils.NumberUUID(1))
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveRequestService.AssertExpectations(t)
+			improveSuggestionService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_PurgeDeletedPosts(t *testing.T) {
+	data := []struct {
+		name string
+
+		auth       *authentication.BackendServiceAuth
+		purgeAfter time.Duration
+
+		shouldPurgeRequests    bool
+		purgeRequestsErr       error
+		shouldPurgeSuggestions bool
+		purgeSuggestionsErr    error
+
+		expectErr error
+	}{
+		{
+			name:                   "Success",
+			purgeAfter:             30 * 24 * time.Hour,
+			shouldPurgeRequests:    true,
+			shouldPurgeSuggestions: true,
+		},
+		{
+			name: "Success/Disabled",
+		},
+		{
+			name:       "Error/NotABackendService",
+			purgeAfter: 30 * 24 * time.Hour,
+			auth: &authentication.BackendServiceAuth{
+				UserAgent:    "curl/7.88.1",
+				AllowedUsers: []string{"scheduler@agora.com"},
+			},
+			expectErr: validation.ErrInvalidCredentials,
+		},
+		{
+			name:                "Error/ImproveRequestServiceFailure",
+			purgeAfter:          30 * 24 * time.Hour,
+			shouldPurgeRequests: true,
+			purgeRequestsErr:    fooErr,
+			expectErr:           fooErr,
+		},
+		{
+			name:                   "Error/ImproveSuggestionServiceFailure",
+			purgeAfter:             30 * 24 * time.Hour,
+			shouldPurgeRequests:    true,
+			shouldPurgeSuggestions: true,
+			purgeSuggestionsErr:    fooErr,
+			expectErr:              fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			improveSuggestionService := improve_suggestion_service.NewMockService(t)
+
+			if d.shouldPurgeRequests {
+				improveRequestService.
+					On("Purge", context.TODO(), baseTime.Add(-d.purgeAfter)).
+					Return(int64(2), d.purgeRequestsErr)
+			}
+			if d.shouldPurgeSuggestions {
+				improveSuggestionService.
+					On("Purge", context.TODO(), baseTime.Add(-d.purgeAfter)).
+					Return(int64(5), d.purgeSuggestionsErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService:    improveRequestService,
+				ImproveSuggestionService: improveSuggestionService,
+				PurgeAfter:               d.purgeAfter,
+				Time:                     test_utils.GetTimeNow(baseTime),
+			})
+
+			err := provider.PurgeDeletedPosts(context.TODO(), d.auth)
+			test_utils.RequireError(t, d.expectErr, err)
+
+			improveRequestService.AssertExpectations(t)
+			improveSuggestionService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_ReconcileVoteCounters(t *testing.T) {
+	data := []struct {
+		name string
+
+		auth *authentication.BackendServiceAuth
+
+		shouldReconcileRequests    bool
+		reconcileRequestsErr       error
+		shouldReconcileSuggestions bool
+		reconcileSuggestionsErr    error
+
+		expectErr error
+	}{
+		{
+			name:                       "Success",
+			shouldReconcileRequests:    true,
+			shouldReconcileSuggestions: true,
+		},
+		{
+			name: "Error/NotABackendService",
+			auth: &authentication.BackendServiceAuth{
+				UserAgent:    "curl/7.88.1",
+				AllowedUsers: []string{"scheduler@agora.com"},
+			},
+			expectErr: validation.ErrInvalidCredentials,
+		},
+		{
+			name:                    "Error/ImproveRequestFailure",
+			shouldReconcileRequests: true,
+			reconcileRequestsErr:    fooErr,
+			expectErr:               fooErr,
+		},
+		{
+			name:                       "Error/ImproveSuggestionFailure",
+			shouldReconcileRequests:    true,
+			shouldReconcileSuggestions: true,
+			reconcileSuggestionsErr:    fooErr,
+			expectErr:                  fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			votesService := votes_service.NewMockService(t)
+
+			if d.shouldReconcileRequests {
+				votesService.
+					On("ReconcileCounters", context.TODO(), models.VoteTargetImproveRequest, VoteCountersBatchSize).
+					Return(&models.VoteCountersReport{Target: models.VoteTargetImproveRequest, Scanned: 10, Repaired: 1}, d.reconcileRequestsErr)
+			}
+			if d.shouldReconcileSuggestions {
+				votesService.
+					On("ReconcileCounters", context.TODO(), models.VoteTargetImproveSuggestion, VoteCountersBatchSize).
+					Return(&models.VoteCountersReport{Target: models.VoteTargetImproveSuggestion, Scanned: 20}, d.reconcileSuggestionsErr)
+			}
+
+			provider := NewProvider(Config{
+				VotesService: votesService,
+				Time:         test_utils.GetTimeNow(baseTime),
+			})
+
+			err := provider.ReconcileVoteCounters(context.TODO(), d.auth)
+			test_utils.RequireError(t, d.expectErr, err)
+
+			votesService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_ListImproveSuggestions(t *testing.T) {
+	data := []struct {
+		name string
+
+		query  models.ImproveSuggestionsList
+		limit  int
+		offset int
+
+		shouldReadRequest    bool
+		shouldCallVisibility bool
+		visibility           models.ImproveRequestVisibility
+
+		shouldCallList         bool
+		improveSuggestionData  []*models.ImproveSuggestion
+		improveSuggestionTotal int64
+		improveSuggestionErr   error
+
+		expectData  []*models.ImproveSuggestion
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name: "Success",
+			query: models.ImproveSuggestionsList{
+				UserID:    framework.ToPTR(test_utils.NumberUUID(100)),
+				SourceID:  framework.ToPTR(test_utils.NumberUUID(10)),
+				RequestID: framework.ToPTR(test_utils.NumberUUID(1)),
+				Validated: framework.ToPTR(true),
+			},
+			limit:                10,
+			offset:               20,
+			shouldCallVisibility: true,
+			visibility:           models.ImproveRequestVisibilityPublic,
+			shouldCallList:       true,
+			improveSuggestionData: []*models.ImproveSuggestion{
+				{
+					ID:        test_utils.NumberUUID(1),
+					CreatedAt: baseTime,
+					UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+					SourceID:  test_utils.NumberUUID(10),
+					UserID:    test_utils.NumberUUID(100),
+					Validated: true,
+					UpVotes:   32,
+					DownVotes: 2,
+					RequestID: test_utils.NumberUUID(11),
+					Title:     "Dummy suggestion",
+					Content:   "Foo bar qux.",
+				},
+				{
+					ID:        test_utils.NumberUUID(2),
+					CreatedAt: baseTime,
+					UpdatedAt: framework.ToPTR(baseTime.Add(time.Minute)),
+					SourceID:  test_utils.NumberUUID(11),
+					UserID:    test_utils.NumberUUID(101),
+					Validated: true,
+					UpVotes:   10,
+					DownVotes: 8,
+					RequestID: test_utils.NumberUUID(12),
+					Title:     "Smart suggestion",
+					Content:   "Qux bar foo.",
+				},
+			},
+			improveSuggestionTotal: 200,
+			expectData: []*models.ImproveSuggestion{
+				{
+					ID:        test_utils.NumberUUID(1),
+					CreatedAt: baseTime,
+					UpdatedAt: framework.ToPTR(baseTime.Add(time.Hour)),
+					SourceID:  test_utils.NumberUUID(10),
+					UserID:    test_utils.NumberUUID(100),
+					Validated: true,
+					UpVotes:   32,
+					DownVotes: 2,
+					RequestID: test_utils.NumberUUID(11),
+					Title:     "Dummy suggestion",
+					Content:   "Foo bar qux.",
+				},
+				{
+					ID:        test_utils.NumberUUID(2),
+					CreatedAt: baseTime,
+					UpdatedAt: framework.ToPTR(baseTime.Add(time.Minute)),
+					SourceID:  test_utils.NumberUUID(11),
+					UserID:    test_utils.NumberUUID(101),
+					Validated: true,
+					UpVotes:   10,
+					DownVotes: 8,
+					RequestID: test_utils.NumberUUID(12),
+					Title:     "Smart suggestion",
+					Content:   "Qux bar foo.",
+				},
+			},
+			expectTotal: 200,
+		},
+		{
+			name: "Success/FromRevision",
+			query: models.ImproveSuggestionsList{
+				RequestID: framework.ToPTR(test_utils.NumberUUID(1)),
+			},
+			limit:                  10,
+			offset:                 20,
+			shouldReadRequest:      true,
+			shouldCallVisibility:   true,
+			visibility:             models.ImproveRequestVisibilityPublic,
+			shouldCallList:         true,
+			improveSuggestionData:  []*models.ImproveSuggestion{},
+			improveSuggestionTotal: 0,
+			expectData:             []*models.ImproveSuggestion{},
+		},
+		{
+			// Listings across requests are restricted to public requests by the storage layer.
+			name: "Success/AcrossRequests",
+			query: models.ImproveSuggestionsList{
+				UserID: framework.ToPTR(test_utils.NumberUUID(100)),
+			},
+			limit:                  10,
+			offset:                 20,
+			shouldCallList:         true,
+			improveSuggestionData:  []*models.ImproveSuggestion{},
+			improveSuggestionTotal: 0,
+			expectData:             []*models.ImproveSuggestion{},
+		},
+		{
+			name: "Error/HiddenRequest",
+			query: models.ImproveSuggestionsList{
+				SourceID: framework.ToPTR(test_utils.NumberUUID(10)),
+			},
+			limit:                10,
+			offset:               20,
+			shouldCallVisibility: true,
+			visibility:           models.ImproveRequestVisibilityRestricted,
+			expectErr:            validation.ErrNotFound,
+		},
+		{
+			name: "Error/ServiceFailure",
+			query: models.ImproveSuggestionsList{
+				UserID:    framework.ToPTR(test_utils.NumberUUID(100)),
+				SourceID:  framework.ToPTR(test_utils.NumberUUID(10)),
+				RequestID: framework.ToPTR(test_utils.NumberUUID(1)),
+				Validated: framework.ToPTR(true),
+			},
+			limit:                10,
+			offset:               20,
+			shouldCallVisibility: true,
+			visibility:           models.ImproveRequestVisibilityPublic,
+			shouldCallList:       true,
+			improveSuggestionErr: fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveSuggestionService := improve_suggestion_service.NewMockService(t)
+			improveRequestService := improve_request_service.NewMockService(t)
+			visibilityService := visibility_service.NewMockService(t)
+
+			if d.shouldReadRequest {
+				improveRequestService.
+					On("Read", context.TODO(), *d.query.RequestID).
+					Return(&models.ImproveRequest{ID: *d.query.RequestID, Source: test_utils.NumberUUID(10)}, nil)
+			}
+
+			if d.shouldCallVisibility {
+				visibilityService.
+					On("Read", context.TODO(), test_utils.NumberUUID(10)).
+					Return(&models.ImproveRequestAccess{Source: test_utils.NumberUUID(10), Visibility: d.visibility}, nil)
+			}
+
+			if d.shouldCallList {
+				improveSuggestionService.
+					On("List", context.TODO(), models.ImproveSuggestionsList{
+						SourceID:  d.query.SourceID,
+						UserID:    d.query.UserID,
+						RequestID: d.query.RequestID,
+						Validated: d.query.Validated,
+					}, d.limit, d.offset).
+					Return(d.improveSuggestionData, d.improveSuggestionTotal, d.improveSuggestionErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveSuggestionService: improveSuggestionService,
+				ImproveRequestService:    improveRequestService,
+				VisibilityService:        visibilityService,
+				Time:                     test_utils.GetTimeNow(baseTime),
+			})
+
+			data, total, err := provider.ListImproveSuggestions(context.TODO(), "", "", d.query, d.limit, d.offset)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expectData, data)
+			require.Equal(t, d.expectTotal, total)
+
+			improveSuggestionService.AssertExpectations(t)
+			improveRequestService.AssertExpectations(t)
+			visibilityService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_ListImproveSuggestionsAfter(t *testing.T) {
+	data := []struct {
+		name string
+
+		query  models.ImproveSuggestionsList
+		cursor string
+		limit  int
+
+		visibility     models.ImproveRequestVisibility
+		shouldCallList bool
+
+		improveSuggestionData []*models.ImproveSuggestion
+		improveSuggestionNext string
+		improveSuggestionErr  error
+
+		expectData []*models.ImproveSuggestion
+		expectNext string
+		expectErr  error
+	}{
+		{
+			name: "Success",
+			query: models.ImproveSuggestionsList{
+				SourceID: framework.ToPTR(test_utils.NumberUUID(1)),
+			},
+			cursor:         "cursor-1",
+			limit:          10,
+			visibility:     models.ImproveRequestVisibilityPublic,
+			shouldCallList: true,
+			improveSuggestionData: []*models.ImproveSuggestion{
+				{
+					ID:        test_utils.NumberUUID(10),
+					SourceID:  test_utils.NumberUUID(1),
+					RequestID: test_utils.NumberUUID(1),
+					UserID:    test_utils.NumberUUID(2),
+					CreatedAt: baseTime,
+					Title:     "Dummy suggestion",
+					Content:   "Foo bar qux.",
+				},
+			},
+			improveSuggestionNext: "cursor-2",
+			expectData: []*models.ImproveSuggestion{
+				{
+					ID:        test_utils.NumberUUID(10),
+					SourceID:  test_utils.NumberUUID(1),
+					RequestID: test_utils.NumberUUID(1),
+					UserID:    test_utils.NumberUUID(2),
+					CreatedAt: baseTime,
+					Title:     "Dummy suggestion",
+					Content:   "Foo bar qux.",
+				},
+			},
+			expectNext: "cursor-2",
+		},
+		{
+			name: "Error/HiddenRequest",
+			query: models.ImproveSuggestionsList{
+				SourceID: framework.ToPTR(test_utils.NumberUUID(1)),
+			},
+			cursor:     "cursor-1",
+			limit:      10,
+			visibility: models.ImproveRequestVisibilityRestricted,
+			expectErr:  validation.ErrNotFound,
+		},
+		{
+			name: "Error/ServiceFailure",
+			query: models.ImproveSuggestionsList{
+				SourceID: framework.ToPTR(test_utils.NumberUUID(1)),
+			},
+			cursor:               "cursor-1",
+			limit:                10,
+			visibility:           models.ImproveRequestVisibilityPublic,
+			shouldCallList:       true,
+			improveSuggestionErr: fooErr,
+			expectErr:            fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveSuggestionService := improve_suggestion_service.NewMockService(t)
+			visibilityService := visibility_service.NewMockService(t)
+
+			visibilityService.
+				On("Read", context.TODO(), *d.query.SourceID).
+				Return(&models.ImproveRequestAccess{Source: *d.query.SourceID, Visibility: d.visibility}, nil)
+
+			if d.shouldCallList {
+				improveSuggestionService.
+					On("ListAfter", context.TODO(), d.query, d.cursor, d.limit).
+					Return(d.improveSuggestionData, d.improveSuggestionNext, d.improveSuggestionErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveSuggestionService: improveSuggestionService,
+				VisibilityService:        visibilityService,
+				Time:                     test_utils.GetTimeNow(baseTime),
+			})
+
+			data, next, err := provider.ListImproveSuggestionsAfter(context.TODO(), "", "", d.query, d.cursor, d.limit)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expectData, data)
+			require.Equal(t, d.expectNext, next)
+
+			improveSuggestionService.AssertExpectations(t)
+			visibilityService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_GetImproveSuggestionPreviews(t *testing.T) {
+	data := []struct {
+		name string
+
+		ids []uuid.UUID
+
+		serviceData []*models.ImproveSuggestion
+		serviceErr  error
+
+		visibilities  map[uuid.UUID]models.ImproveRequestVisibility
+		visibilityErr error
+
+		expect    []*models.ImproveSuggestion
+		expectErr error
+	}{
+		{
+			name: "Success",
+			ids: []uuid.UUID{
+				test_utils.NumberUUID(1),
+				test_utils.NumberUUID(2),
+			},
+			serviceData: []*models.ImproveSuggestion{
+				{
+					ID:        test_utils.NumberUUID(1),
+					CreatedAt: baseTime,
+					UpdatedAt: &updateTime,
+					SourceID:  test_utils.NumberUUID(10),
+					UserID:    test_utils.NumberUUID(100),
+					Validated: false,
+					UpVotes:   17,
+					DownVotes: 3,
+					RequestID: test_utils.NumberUUID(11),
+					Title:     "Dummy post",
+					Content:   "Foo bar qux.",
+				},
+				{
+					ID:        test_utils.NumberUUID(2),
+					CreatedAt: baseTime,
+					UpdatedAt: &updateTime,
+					SourceID:  test_utils.NumberUUID(12),
+					UserID:    test_utils.NumberUUID(101),
+					Validated: true,
+					UpVotes:   8,
+					DownVotes: 1,
+					RequestID: test_utils.NumberUUID(14),
+					Title:     "Smart post",
+					Content:   "Cats on a nap.",
+				},
+			},
+			visibilities: map[uuid.UUID]models.ImproveRequestVisibility{
+				test_utils.NumberUUID(10): models.ImproveRequestVisibilityPublic,
+				test_utils.NumberUUID(12): models.ImproveRequestVisibilityPublic,
+			},
+			expect: []*models.ImproveSuggestion{
+				{
+					ID:        test_utils.NumberUUID(1),
+					CreatedAt: baseTime,
+					UpdatedAt: &updateTime,
+					SourceID:  test_utils.NumberUUID(10),
+					UserID:    test_utils.NumberUUID(100),
+					Validated: false,
+					UpVotes:   17,
+					DownVotes: 3,
+					RequestID: test_utils.NumberUUID(11),
+					Title:     "Dummy post",
+					Content:   "Foo bar qux.",
+				},
+				{
+					ID:        test_utils.NumberUUID(2),
+					CreatedAt: baseTime,
+					UpdatedAt: &updateTime,
+					SourceID:  test_utils.NumberUUID(12),
+					UserID:    test_utils.NumberUUID(101),
+					Validated: true,
+					UpVotes:   8,
+					DownVotes: 1,
+					RequestID: test_utils.NumberUUID(14),
+					Title:     "Smart post",
+					Content:   "Cats on a nap.",
+				},
+			},
+		},
+		{
+			name: "Success/HiddenRequests",
+			ids: []uuid.UUID{
+				test_utils.NumberUUID(1),
+				test_utils.NumberUUID(2),
+				test_utils.NumberUUID(3),
+			},
+			serviceData: []*models.ImproveSuggestion{
+				{ID: test_utils.NumberUUID(1), SourceID: test_utils.NumberUUID(10), Title: "Dummy post"},
+				{ID: test_utils.NumberUUID(2), SourceID: test_utils.NumberUUID(12), Title: "Smart post"},
+				{ID: test_utils.NumberUUID(3), SourceID: test_utils.NumberUUID(10), Title: "Other post"},
+			},
+			visibilities: map[uuid.UUID]models.ImproveRequestVisibility{
+				test_utils.NumberUUID(10): models.ImproveRequestVisibilityRestricted,
+				test_utils.NumberUUID(12): models.ImproveRequestVisibilityPublic,
+			},
+			expect: []*models.ImproveSuggestion{
+				{ID: test_utils.NumberUUID(2), SourceID: test_utils.NumberUUID(12), Title: "Smart post"},
+			},
+		},
+		{
+			name: "Error/VisibilityServiceFailure",
+			ids: []uuid.UUID{
+				test_utils.NumberUUID(1),
+				test_utils.NumberUUID(2),
+			},
+			serviceData: []*models.ImproveSuggestion{
+				{ID: test_utils.NumberUUID(1), SourceID: test_utils.NumberUUID(10), Title: "Dummy post"},
+				{ID: test_utils.NumberUUID(2), SourceID: test_utils.NumberUUID(12), Title: "Smart post"},
+			},
+			visibilities: map[uuid.UUID]models.ImproveRequestVisibility{
+				test_utils.NumberUUID(10): models.ImproveRequestVisibilityPublic,
+			},
+			visibilityErr: fooErr,
+			expectErr:     fooErr,
+		},
+		{
+			name: "Error/ServiceFailure",
+			ids: []uuid.UUID{
+				test_utils.NumberUUID(1),
+				test_utils.NumberUUID(2),
+			},
+			serviceErr: fooErr,
+			expectErr:  fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveSuggestionService := improve_suggestion_service.NewMockService(t)
+			visibilityService := visibility_service.NewMockService(t)
+
+			improveSuggestionService.
+				On("GetPreviews", context.TODO(), d.ids).
+				Return(d.serviceData, d.serviceErr)
+
+			// Each source is checked only once.
+			for source, visibility := range d.visibilities {
+				visibilityService.
+					On("Read", context.TODO(), source).
+					Return(&models.ImproveRequestAccess{Source: source, Visibility: visibility}, d.visibilityErr).
+					Once()
+			}
+
+			provider := NewProvider(Config{
+				ImproveSuggestionService: improveSuggestionService,
+				VisibilityService:        visibilityService,
+				Time:                     test_utils.GetTimeNow(baseTime),
+			})
+
+			res, err := provider.GetImproveSuggestionPreviews(context.TODO(), "", d.ids)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveSuggestionService.AssertExpectations(t)
+			visibilityService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_Vote(t *testing.T) {
+	data := []struct {
+		name string
+
+		now    time.Time
+		keys   []ed25519.PrivateKey
+		userID uuid.UUID
+
+		token  string
+		postID uuid.UUID
+		target models.VoteTarget
+		vote   models.VoteValue
+
+		voteRateLimit      int
+		voteRateWindow     time.Duration
+		downVoteReputation int64
+
+		shouldCallUserService              bool
+		shouldCallReputationService        bool
+		shouldCallCountRecent              bool
+		shouldCallImproveRequestService    bool
+		shouldCallImproveSuggestionService bool
+		shouldCallThreadStateService       bool
+		sourceID                           uuid.UUID
+		state                              models.ImproveRequestState
+		shouldCallVoteService              bool
+
+		tokenServiceDecodeData       *models.UserToken
+		tokenServiceDecodeErr        error
+		hasAuthorization             bool
+		hasAuthorizationErr          error
+		reputationData               int64
+		reputationErr                error
+		countRecentData              int64
+		countRecentErr               error
+		improveRequestServiceData    bool
+		improveRequestServiceErr     error
+		improveSuggestionServiceData bool
+		improveSuggestionServiceErr  error
+		voteServiceData              models.VoteValue
+		voteServiceErr               error
+
+		expect    models.VoteValue
+		expectErr error
+	}{
+		{
+			name:                            "Success/ImproveRequest",
+			voteRateLimit:                   10,
+			voteRateWindow:                  time.Hour,
+			shouldCallCountRecent:           true,
+			countRecentData:                 9,
+			now:                             baseTime,
+			keys:                            jwk_storage.MockedKeys,
+			userID:                          test_utils.NumberUUID(100),
+			token:                           "foo.bar.qux",
+			postID:                          test_utils.NumberUUID(10),
+			target:                          models.VoteTargetImproveRequest,
+			vote:                            models.VoteUp,
+			shouldCallUserService:           true,
+			hasAuthorization:                true,
+			shouldCallImproveRequestService: true,
+			shouldCallThreadStateService:    true,
+			sourceID:                        test_utils.NumberUUID(1),
+			state:                           models.ImproveRequestStateOpen,
+			shouldCallVoteService:           true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			voteServiceData: models.VoteUp,
+			expect:          models.VoteUp,
+		},
+		{
+			name:                            "Success/DownVote",
+			downVoteReputation:              15,
+			now:                             baseTime,
+			keys:                            jwk_storage.MockedKeys,
+			userID:                          test_utils.NumberUUID(100),
+			token:                           "foo.bar.qux",
+			postID:                          test_utils.NumberUUID(10),
+			target:                          models.VoteTargetImproveRequest,
+			vote:                            models.VoteDown,
+			shouldCallUserService:           true,
+			hasAuthorization:                true,
+			shouldCallReputationService:     true,
+			reputationData:                  15,
+			shouldCallImproveRequestService: true,
+			shouldCallThreadStateService:    true,
+			sourceID:                        test_utils.NumberUUID(1),
+			state:                           models.ImproveRequestStateOpen,
+			shouldCallVoteService:           true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			voteServiceData: models.VoteDown,
+			expect:          models.VoteDown,
+		},
+		{
+			name:                        "Error/NotEnoughReputationToDownVote",
+			downVoteReputation:          15,
+			now:                         baseTime,
+			keys:                        jwk_storage.MockedKeys,
+			userID:                      test_utils.NumberUUID(100),
+			token:                       "foo.bar.qux",
+			postID:                      test_utils.NumberUUID(10),
+			target:                      models.VoteTargetImproveRequest,
+			vote:                        models.VoteDown,
+			shouldCallUserService:       true,
+			hasAuthorization:            true,
+			shouldCallReputationService: true,
+			reputationData:              14,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			expect:    models.NoVote,
+			expectErr: validation.ErrUnauthorized,
+		},
+		{
+			name:                        "Error/ReputationServiceFailure",
+			downVoteReputation:          15,
+			now:                         baseTime,
+			keys:                        jwk_storage.MockedKeys,
+			userID:                      test_utils.NumberUUID(100),
+			token:                       "foo.bar.qux",
+			postID:                      test_utils.NumberUUID(10),
+			target:                      models.VoteTargetImproveRequest,
+			vote:                        models.VoteDown,
+			shouldCallUserService:       true,
+			hasAuthorization:            true,
+			shouldCallReputationService: true,
+			reputationErr:               fooErr,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			expect:    models.NoVote,
+			expectErr: fooErr,
+		},
+		{
+			name:                               "Success/ImproveSuggestion",
+			now:                                baseTime,
+			keys:                               jwk_storage.MockedKeys,
+			userID:                             test_utils.NumberUUID(100),
+			token:                              "foo.bar.qux",
+			postID:                             test_utils.NumberUUID(10),
+			target:                             models.VoteTargetImproveSuggestion,
+			vote:                               models.VoteUp,
+			shouldCallUserService:              true,
+			hasAuthorization:                   true,
+			shouldCallImproveSuggestionService: true,
+			shouldCallThreadStateService:       true,
+			sourceID:                           test_utils.NumberUUID(1),
+			state:                              models.ImproveRequestStateClosed,
+			shouldCallVoteService:              true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			voteServiceData: models.VoteUp,
+			expect:          models.VoteUp,
+		},
+		{
+			name:                               "Error/VoteServiceFailure",
+			now:                                baseTime,
+			keys:                               jwk_storage.MockedKeys,
+			userID:                             test_utils.NumberUUID(100),
+			token:                              "foo.bar.qux",
+			postID:                             test_utils.NumberUUID(10),
+			target:                             models.VoteTargetImproveSuggestion,
+			vote:                               models.VoteUp,
+			shouldCallUserService:              true,
+			hasAuthorization:                   true,
+			shouldCallImproveSuggestionService: true,
+			shouldCallThreadStateService:       true,
+			sourceID:                           test_utils.NumberUUID(1),
+			state:                              models.ImproveRequestStateOpen,
+			shouldCallVoteService:              true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			voteServiceErr: fooErr,
+			expect:         models.NoVote,
+			expectErr:      fooErr,
+		},
+		{
+			name:                            "Error/ImproveRequestLocked",
+			now:                             baseTime,
+			keys:                            jwk_storage.MockedKeys,
+			userID:                          test_utils.NumberUUID(100),
+			token:                           "foo.bar.qux",
+			postID:                          test_utils.NumberUUID(10),
+			target:                          models.VoteTargetImproveRequest,
+			vote:                            models.VoteUp,
+			shouldCallUserService:           true,
+			hasAuthorization:                true,
+			shouldCallImproveRequestService: true,
+			shouldCallThreadStateService:    true,
+			sourceID:                        test_utils.NumberUUID(1),
+			state:                           models.ImproveRequestStateLocked,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			expectErr: validation.ErrInvalidCredentials,
+		},
+		{
+			name:                               "Error/ImproveSuggestionArchived",
+			now:                                baseTime,
+			keys:                               jwk_storage.MockedKeys,
+			userID:                             test_utils.NumberUUID(100),
+			token:                              "foo.bar.qux",
+			postID:                             test_utils.NumberUUID(10),
+			target:                             models.VoteTargetImproveSuggestion,
+			vote:                               models.VoteUp,
+			shouldCallUserService:              true,
+			hasAuthorization:                   true,
+			shouldCallImproveSuggestionService: true,
+			shouldCallThreadStateService:       true,
+			sourceID:                           test_utils.NumberUUID(1),
+			state:                              models.ImproveRequestStateArchived,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			expectErr: validation.ErrInvalidCredentials,
+		},
+		{
+			name:                            "Error/ImproveRequestSelfVote",
+			now:                             baseTime,
+			keys:                            jwk_storage.MockedKeys,
+			userID:                          test_utils.NumberUUID(100),
+			token:                           "foo.bar.qux",
+			postID:                          test_utils.NumberUUID(10),
+			target:                          models.VoteTargetImproveRequest,
+			vote:                            models.VoteUp,
+			shouldCallUserService:           true,
+			hasAuthorization:                true,
+			shouldCallImproveRequestService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			improveRequestServiceData: true,
+			expect:                    models.NoVote,
+			expectErr:                 validation.ErrInvalidEntity,
+		},
+		{
+			name:                               "Error/ImproveSuggestionSelfVote",
+			now:                                baseTime,
+			keys:                               jwk_storage.MockedKeys,
+			userID:                             test_utils.NumberUUID(100),
+			token:                              "foo.bar.qux",
+			postID:                             test_utils.NumberUUID(10),
+			target:                             models.VoteTargetImproveSuggestion,
+			vote:                               models.VoteUp,
+			shouldCallUserService:              true,
+			hasAuthorization:                   true,
+			shouldCallImproveSuggestionService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			improveSuggestionServiceData: true,
+			expect:                       models.NoVote,
+			expectErr:                    validation.ErrInvalidEntity,
+		},
+		{
+			name:                            "Error/ImproveRequestServiceFailure",
+			now:                             baseTime,
+			keys:                            jwk_storage.MockedKeys,
+			userID:                          test_utils.NumberUUID(100),
+			token:                           "foo.bar.qux",
+			postID:                          test_utils.NumberUUID(10),
+			target:                          models.VoteTargetImproveRequest,
+			vote:                            models.VoteUp,
+			shouldCallUserService:           true,
+			hasAuthorization:                true,
+			shouldCallImproveRequestService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			improveRequestServiceErr: fooErr,
+			expect:                   models.NoVote,
+			expectErr:                fooErr,
+		},
+		{
+			name:                               "Error/ImproveSuggestionServiceFailure",
+			now:                                baseTime,
 			keys:                               jwk_storage.MockedKeys,
 			userID:                             test_utils.NumberUUID(100),
 			token:                              "foo.bar.qux",
 			postID:                             test_utils.NumberUUID(10),
 			target:                             models.VoteTargetImproveSuggestion,
-			vote:                               models.VoteUp,
+			vote:                               models.VoteUp,
+			shouldCallUserService:              true,
+			hasAuthorization:                   true,
+			shouldCallImproveSuggestionService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			improveSuggestionServiceErr: fooErr,
+			expect:                      models.NoVote,
+			expectErr:                   fooErr,
+		},
+		{
+			name:                  "Error/RateLimited",
+			now:                   baseTime,
+			keys:                  jwk_storage.MockedKeys,
+			userID:                test_utils.NumberUUID(100),
+			token:                 "foo.bar.qux",
+			postID:                test_utils.NumberUUID(10),
+			target:                models.VoteTargetImproveRequest,
+			vote:                  models.VoteUp,
+			shouldCallUserService: true,
+			hasAuthorization:      true,
+			voteRateLimit:         10,
+			voteRateWindow:        time.Hour,
+			shouldCallCountRecent: true,
+			countRecentData:       10,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			expect:    models.NoVote,
+			expectErr: validation.ErrRateLimited,
+		},
+		{
+			name:                  "Error/CountRecentFailure",
+			now:                   baseTime,
+			keys:                  jwk_storage.MockedKeys,
+			userID:                test_utils.NumberUUID(100),
+			token:                 "foo.bar.qux",
+			postID:                test_utils.NumberUUID(10),
+			target:                models.VoteTargetImproveRequest,
+			vote:                  models.VoteUp,
+			shouldCallUserService: true,
+			hasAuthorization:      true,
+			voteRateLimit:         10,
+			voteRateWindow:        time.Hour,
+			shouldCallCountRecent: true,
+			countRecentErr:        fooErr,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			expect:    models.NoVote,
+			expectErr: fooErr,
+		},
+		{
+			name:                  "Error/NotValidated",
+			now:                   baseTime,
+			keys:                  jwk_storage.MockedKeys,
+			userID:                test_utils.NumberUUID(100),
+			token:                 "foo.bar.qux",
+			postID:                test_utils.NumberUUID(10),
+			target:                models.VoteTargetImproveRequest,
+			vote:                  models.VoteUp,
+			shouldCallUserService: true,
+
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			expect:    models.NoVote,
+			expectErr: validation.ErrUnauthorized,
+		},
+		{
+			name:                  "Error/UserServiceFailure",
+			now:                   baseTime,
+			keys:                  jwk_storage.MockedKeys,
+			userID:                test_utils.NumberUUID(100),
+			token:                 "foo.bar.qux",
+			postID:                test_utils.NumberUUID(10),
+			target:                models.VoteTargetImproveRequest,
+			vote:                  models.VoteUp,
+			shouldCallUserService: true,
+			hasAuthorizationErr:   fooErr,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			expect:    models.NoVote,
+			expectErr: fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			now:                   baseTime,
+			keys:                  jwk_storage.MockedKeys,
+			userID:                test_utils.NumberUUID(100),
+			token:                 "foo.bar.qux",
+			postID:                test_utils.NumberUUID(10),
+			target:                models.VoteTargetImproveRequest,
+			vote:                  models.VoteUp,
+			tokenServiceDecodeErr: fooErr,
+			expect:                models.NoVote,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			improveSuggestionService := improve_suggestion_service.NewMockService(t)
+			voteService := votes_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+			threadStateService := thread_state_service.NewMockService(t)
+			userService := user_service.NewMockService(t)
+			reputationService := reputation_service.NewMockService(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(d.keys))
+			for i, key := range d.keys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, d.now).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallUserService {
+				userService.
+					On("HasAuthorizations", context.TODO(), d.tokenServiceDecodeData.Payload.ID, mock.Anything).
+					Return(d.hasAuthorization, d.hasAuthorizationErr)
+			}
+
+			if d.shouldCallReputationService {
+				reputationService.
+					On("Read", context.TODO(), d.userID).
+					Return(d.reputationData, d.reputationErr)
+			}
+
+			if d.shouldCallCountRecent {
+				voteService.
+					On("CountRecent", context.TODO(), d.userID, d.now.Add(-d.voteRateWindow)).
+					Return(d.countRecentData, d.countRecentErr)
+			}
+
+			if d.shouldCallImproveRequestService {
+				improveRequestService.
+					On("IsCreator", context.TODO(), d.userID, d.postID, false).
+					Return(d.improveRequestServiceData, d.improveRequestServiceErr)
+			}
+
+			if d.shouldCallImproveSuggestionService {
+				improveSuggestionService.
+					On("IsCreator", context.TODO(), d.userID, d.postID).
+					Return(d.improveSuggestionServiceData, d.improveSuggestionServiceErr)
+			}
+
+			if d.shouldCallThreadStateService {
+				switch d.target {
+				case models.VoteTargetImproveRequest:
+					improveRequestService.
+						On("Read", context.TODO(), d.postID).
+						Return(&models.ImproveRequest{ID: d.postID, Source: d.sourceID}, nil)
+				case models.VoteTargetImproveSuggestion:
+					improveSuggestionService.
+						On("Read", context.TODO(), d.postID).
+						Return(&models.ImproveSuggestion{ID: d.postID, SourceID: d.sourceID}, nil)
+				}
+
+				threadStateService.
+					On("Read", context.TODO(), d.sourceID).
+					Return(&models.ImproveRequestThreadState{Source: d.sourceID, State: d.state}, nil)
+			}
+
+			if d.shouldCallVoteService {
+				voteService.
+					On(
+						"Vote", context.TODO(),
+						d.postID, d.userID, models.VoteTarget(d.target), models.VoteValue(d.vote), d.now,
+					).
+					Return(d.voteServiceData, d.voteServiceErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService:    improveRequestService,
+				ImproveSuggestionService: improveSuggestionService,
+				VotesService:             voteService,
+				ThreadStateService:       threadStateService,
+				UserService:              userService,
+				ReputationService:        reputationService,
+				TokenService:             tokenService,
+				KeysService:              keysService,
+				VoteRateLimit:            d.voteRateLimit,
+				VoteRateWindow:           d.voteRateWindow,
+				DownVoteReputation:       d.downVoteReputation,
+				Time:                     test_utils.GetTimeNow(d.now),
+			})
+
+			vote, err := provider.Vote(context.TODO(), d.token, d.postID, d.target, d.vote)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, vote)
+
+			improveRequestService.AssertExpectations(t)
+			improveSuggestionService.AssertExpectations(t)
+			voteService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+			threadStateService.AssertExpectations(t)
+			userService.AssertExpectations(t)
+			reputationService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_HasVoted(t *testing.T) {
+	data := []struct {
+		name string
+
+		now    time.Time
+		keys   []ed25519.PrivateKey
+		userID uuid.UUID
+
+		token  string
+		postID uuid.UUID
+		target models.VoteTarget
+
+		shouldCallVoteService bool
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+		voteServiceData        models.VoteValue
+		voteServiceErr         error
+
+		expect    models.VoteValue
+		expectErr error
+	}{
+		{
+			name:                  "Success",
+			now:                   baseTime,
+			keys:                  jwk_storage.MockedKeys,
+			userID:                test_utils.NumberUUID(100),
+			token:                 "foo.bar.qux",
+			postID:                test_utils.NumberUUID(10),
+			target:                models.VoteTargetImproveRequest,
+			shouldCallVoteService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			voteServiceData: models.VoteUp,
+			expect:          models.VoteUp,
+		},
+		{
+			name:                  "Error/VoteServiceFailure",
+			now:                   baseTime,
+			keys:                  jwk_storage.MockedKeys,
+			userID:                test_utils.NumberUUID(100),
+			token:                 "foo.bar.qux",
+			postID:                test_utils.NumberUUID(10),
+			target:                models.VoteTargetImproveRequest,
+			shouldCallVoteService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			voteServiceErr: fooErr,
+			expect:         models.NoVote,
+			expectErr:      fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			now:                   baseTime,
+			keys:                  jwk_storage.MockedKeys,
+			userID:                test_utils.NumberUUID(100),
+			token:                 "foo.bar.qux",
+			postID:                test_utils.NumberUUID(10),
+			target:                models.VoteTargetImproveRequest,
+			tokenServiceDecodeErr: fooErr,
+			expect:                models.NoVote,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			voteService := votes_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(d.keys))
+			for i, key := range d.keys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, d.now).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallVoteService {
+				voteService.
+					On("HasVoted", context.TODO(), d.postID, d.userID, models.VoteTarget(d.target)).
+					Return(d.voteServiceData, d.voteServiceErr)
+			}
+
+			provider := NewProvider(Config{
+				VotesService: voteService,
+				TokenService: tokenService,
+				KeysService:  keysService,
+				Time:         test_utils.GetTimeNow(d.now),
+			})
+
+			vote, err := provider.HasVoted(context.TODO(), d.token, d.postID, d.target)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, vote)
+
+			voteService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_GetVotedPosts(t *testing.T) {
+	data := []struct {
+		name   string
+		userID uuid.UUID
+		target models.VoteTarget
+		limit  int
+		offset int
+
+		voteServiceData  []*models.VotedPost
+		voteServiceCount int64
+		voteServiceErr   error
+
+		expect      []*models.VotedPost
+		expectCount int64
+		expectErr   error
+	}{
+		{
+			name:   "Success",
+			userID: test_utils.NumberUUID(100),
+			target: models.VoteTargetImproveRequest,
+			limit:  10,
+			offset: 20,
+			voteServiceData: []*models.VotedPost{
+				{
+					PostID:    test_utils.NumberUUID(10),
+					Vote:      models.VoteUp,
+					UpdatedAt: baseTime.Add(-time.Hour),
+				},
+				{
+					PostID:    test_utils.NumberUUID(11),
+					Vote:      models.VoteDown,
+					UpdatedAt: baseTime.Add(30 * time.Minute),
+				},
+			},
+			voteServiceCount: 200,
+			expect: []*models.VotedPost{
+				{
+					PostID:    test_utils.NumberUUID(10),
+					Vote:      models.VoteUp,
+					UpdatedAt: baseTime.Add(-time.Hour),
+				},
+				{
+					PostID:    test_utils.NumberUUID(11),
+					Vote:      models.VoteDown,
+					UpdatedAt: baseTime.Add(30 * time.Minute),
+				},
+			},
+			expectCount: 200,
+		},
+		{
+			name:           "Error/ServiceFailure",
+			userID:         test_utils.NumberUUID(100),
+			target:         models.VoteTargetImproveRequest,
+			limit:          10,
+			offset:         20,
+			voteServiceErr: fooErr,
+			expectErr:      fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			voteService := votes_service.NewMockService(t)
+
+			voteService.
+				On("GetVotedPosts", context.TODO(), d.userID, models.VoteTarget(d.target), d.limit, d.offset).
+				Return(d.voteServiceData, d.voteServiceCount, d.voteServiceErr)
+
+			provider := NewProvider(Config{
+				VotesService: voteService,
+			})
+
+			posts, count, err := provider.GetVotedPosts(context.TODO(), d.userID, d.target, d.limit, d.offset)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, posts)
+			require.Equal(t, d.expectCount, count)
+
+			voteService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_GetVotedPostsAfter(t *testing.T) {
+	data := []struct {
+		name string
+
+		userID uuid.UUID
+		target models.VoteTarget
+		cursor string
+		limit  int
+
+		voteServiceData []*models.VotedPost
+		voteServiceNext string
+		voteServiceErr  error
+
+		expect     []*models.VotedPost
+		expectNext string
+		expectErr  error
+	}{
+		{
+			name:   "Success",
+			userID: test_utils.NumberUUID(1),
+			target: models.VoteTargetImproveRequest,
+			cursor: "cursor-1",
+			limit:  10,
+			voteServiceData: []*models.VotedPost{
+				{
+					PostID:    test_utils.NumberUUID(10),
+					UpdatedAt: baseTime,
+					Vote:      models.VoteUp,
+				},
+			},
+			voteServiceNext: "cursor-2",
+			expect: []*models.VotedPost{
+				{
+					PostID:    test_utils.NumberUUID(10),
+					UpdatedAt: baseTime,
+					Vote:      models.VoteUp,
+				},
+			},
+			expectNext: "cursor-2",
+		},
+		{
+			name:           "Error/ServiceFailure",
+			userID:         test_utils.NumberUUID(1),
+			target:         models.VoteTargetImproveRequest,
+			cursor:         "cursor-1",
+			limit:          10,
+			voteServiceErr: fooErr,
+			expectErr:      fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			voteService := votes_service.NewMockService(t)
+
+			voteService.
+				On("GetVotedPostsAfter", context.TODO(), d.userID, d.target, d.cursor, d.limit).
+				Return(d.voteServiceData, d.voteServiceNext, d.voteServiceErr)
+
+			provider := NewProvider(Config{
+				VotesService: voteService,
+			})
+
+			posts, next, err := provider.GetVotedPostsAfter(context.TODO(), d.userID, d.target, d.cursor, d.limit)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, posts)
+			require.Equal(t, d.expectNext, next)
+
+			voteService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_React(t *testing.T) {
+	reactions := []string{"moving", "confusing", "great-dialogue"}
+
+	userToken := &models.UserToken{
+		Header: models.UserTokenHeader{
+			IAT: baseTime.Add(-time.Hour),
+			EXP: baseTime.Add(time.Hour),
+			ID:  test_utils.NumberUUID(100),
+		},
+		Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+	}
+
+	data := []struct {
+		name string
+
+		token    string
+		postID   uuid.UUID
+		target   models.VoteTarget
+		reaction string
+		active   bool
+
+		shouldCallUserService              bool
+		shouldCallImproveRequestService    bool
+		shouldCallImproveSuggestionService bool
+		shouldCallThreadStateService       bool
+		state                              models.ImproveRequestState
+		shouldCallReactService             bool
+
+		tokenServiceDecodeErr error
+		hasAuthorization      bool
+		isCreator             bool
+		reactServiceData      []string
+		reactServiceErr       error
+
+		expect    []string
+		expectErr error
+	}{
+		{
+			name:                            "Success/ImproveRequest",
+			token:                           "foo.bar.qux",
+			postID:                          test_utils.NumberUUID(10),
+			target:                          models.VoteTargetImproveRequest,
+			reaction:                        "moving",
+			active:                          true,
+			shouldCallUserService:           true,
+			hasAuthorization:                true,
+			shouldCallImproveRequestService: true,
+			shouldCallThreadStateService:    true,
+			state:                           models.ImproveRequestStateOpen,
+			shouldCallReactService:          true,
+			reactServiceData:                []string{"great-dialogue", "moving"},
+			expect:                          []string{"great-dialogue", "moving"},
+		},
+		{
+			name:                               "Success/ImproveSuggestion/Remove",
+			token:                              "foo.bar.qux",
+			postID:                             test_utils.NumberUUID(10),
+			target:                             models.VoteTargetImproveSuggestion,
+			reaction:                           "confusing",
+			shouldCallUserService:              true,
+			hasAuthorization:                   true,
 			shouldCallImproveSuggestionService: true,
-			shouldCallVoteService:              true,
+			shouldCallThreadStateService:       true,
+			state:                              models.ImproveRequestStateClosed,
+			shouldCallReactService:             true,
+			reactServiceData:                   []string{},
+			expect:                             []string{},
+		},
+		{
+			name:      "Error/UnknownReaction",
+			token:     "foo.bar.qux",
+			postID:    test_utils.NumberUUID(10),
+			target:    models.VoteTargetImproveRequest,
+			reaction:  "funny",
+			active:    true,
+			expectErr: validation.ErrInvalidEntity,
+		},
+		{
+			name:                  "Error/AccountNotValidated",
+			token:                 "foo.bar.qux",
+			postID:                test_utils.NumberUUID(10),
+			target:                models.VoteTargetImproveRequest,
+			reaction:              "moving",
+			active:                true,
+			shouldCallUserService: true,
+			expectErr:             validation.ErrUnauthorized,
+		},
+		{
+			name:                               "Error/OwnPost",
+			token:                              "foo.bar.qux",
+			postID:                             test_utils.NumberUUID(10),
+			target:                             models.VoteTargetImproveSuggestion,
+			reaction:                           "moving",
+			active:                             true,
+			shouldCallUserService:              true,
+			hasAuthorization:                   true,
+			shouldCallImproveSuggestionService: true,
+			isCreator:                          true,
+			expectErr:                          validation.ErrInvalidEntity,
+		},
+		{
+			name:                            "Error/ImproveRequestLocked",
+			token:                           "foo.bar.qux",
+			postID:                          test_utils.NumberUUID(10),
+			target:                          models.VoteTargetImproveRequest,
+			reaction:                        "moving",
+			active:                          true,
+			shouldCallUserService:           true,
+			hasAuthorization:                true,
+			shouldCallImproveRequestService: true,
+			shouldCallThreadStateService:    true,
+			state:                           models.ImproveRequestStateLocked,
+			expectErr:                       validation.ErrInvalidCredentials,
+		},
+		{
+			name:                            "Error/ReactServiceFailure",
+			token:                           "foo.bar.qux",
+			postID:                          test_utils.NumberUUID(10),
+			target:                          models.VoteTargetImproveRequest,
+			reaction:                        "moving",
+			active:                          true,
+			shouldCallUserService:           true,
+			hasAuthorization:                true,
+			shouldCallImproveRequestService: true,
+			shouldCallThreadStateService:    true,
+			state:                           models.ImproveRequestStateOpen,
+			shouldCallReactService:          true,
+			reactServiceErr:                 fooErr,
+			expectErr:                       fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			postID:                test_utils.NumberUUID(10),
+			target:                models.VoteTargetImproveRequest,
+			reaction:              "moving",
+			active:                true,
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			improveSuggestionService := improve_suggestion_service.NewMockService(t)
+			voteService := votes_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+			threadStateService := thread_state_service.NewMockService(t)
+			userService := user_service.NewMockService(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			var decoded *models.UserToken
+			if d.tokenServiceDecodeErr == nil {
+				decoded = userToken
+			}
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(decoded, d.tokenServiceDecodeErr)
+
+			if d.shouldCallUserService {
+				userService.
+					On("HasAuthorizations", context.TODO(), userToken.Payload.ID, mock.Anything).
+					Return(d.hasAuthorization, nil)
+			}
+
+			if d.shouldCallImproveRequestService {
+				improveRequestService.
+					On("IsCreator", context.TODO(), userToken.Payload.ID, d.postID, false).
+					Return(d.isCreator, nil)
+			}
+
+			if d.shouldCallImproveSuggestionService {
+				improveSuggestionService.
+					On("IsCreator", context.TODO(), userToken.Payload.ID, d.postID).
+					Return(d.isCreator, nil)
+			}
+
+			if d.shouldCallThreadStateService {
+				switch d.target {
+				case models.VoteTargetImproveRequest:
+					improveRequestService.
+						On("Read", context.TODO(), d.postID).
+						Return(&models.ImproveRequest{ID: d.postID, Source: test_utils.NumberUUID(1)}, nil)
+				case models.VoteTargetImproveSuggestion:
+					improveSuggestionService.
+						On("Read", context.TODO(), d.postID).
+						Return(&models.ImproveSuggestion{ID: d.postID, SourceID: test_utils.NumberUUID(1)}, nil)
+				}
+
+				threadStateService.
+					On("Read", context.TODO(), test_utils.NumberUUID(1)).
+					Return(&models.ImproveRequestThreadState{Source: test_utils.NumberUUID(1), State: d.state}, nil)
+			}
+
+			if d.shouldCallReactService {
+				voteService.
+					On("React", context.TODO(), d.postID, userToken.Payload.ID, d.target, d.reaction, d.active, baseTime).
+					Return(d.reactServiceData, d.reactServiceErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService:    improveRequestService,
+				ImproveSuggestionService: improveSuggestionService,
+				VotesService:             voteService,
+				ThreadStateService:       threadStateService,
+				UserService:              userService,
+				TokenService:             tokenService,
+				KeysService:              keysService,
+				Reactions:                reactions,
+				Time:                     test_utils.GetTimeNow(baseTime),
+			})
+
+			res, err := provider.React(context.TODO(), d.token, d.postID, d.target, d.reaction, d.active)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveRequestService.AssertExpectations(t)
+			improveSuggestionService.AssertExpectations(t)
+			voteService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+			threadStateService.AssertExpectations(t)
+			userService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_GetReactions(t *testing.T) {
+	data := []struct {
+		name string
+
+		token  string
+		postID uuid.UUID
+		target models.VoteTarget
+
+		shouldCallVoteService bool
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+		voteServiceData        []string
+		voteServiceErr         error
+
+		expect    []string
+		expectErr error
+	}{
+		{
+			name:                  "Success",
+			token:                 "foo.bar.qux",
+			postID:                test_utils.NumberUUID(10),
+			target:                models.VoteTargetImproveSuggestion,
+			shouldCallVoteService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			voteServiceData: []string{"moving"},
+			expect:          []string{"moving"},
+		},
+		{
+			name:                  "Error/VoteServiceFailure",
+			token:                 "foo.bar.qux",
+			postID:                test_utils.NumberUUID(10),
+			target:                models.VoteTargetImproveSuggestion,
+			shouldCallVoteService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			},
+			voteServiceErr: fooErr,
+			expectErr:      fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			postID:                test_utils.NumberUUID(10),
+			target:                models.VoteTargetImproveSuggestion,
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			voteService := votes_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallVoteService {
+				voteService.
+					On("GetReactions", context.TODO(), d.postID, test_utils.NumberUUID(100), d.target).
+					Return(d.voteServiceData, d.voteServiceErr)
+			}
+
+			provider := NewProvider(Config{
+				VotesService: voteService,
+				TokenService: tokenService,
+				KeysService:  keysService,
+				Time:         test_utils.GetTimeNow(baseTime),
+			})
+
+			res, err := provider.GetReactions(context.TODO(), d.token, d.postID, d.target)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			voteService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_RefreshImproveRequestRankings(t *testing.T) {
+	data := []struct {
+		name string
+
+		auth *authentication.BackendServiceAuth
+
+		shouldCallService bool
+		refreshErr        error
+
+		expectErr error
+	}{
+		{
+			name:              "Success",
+			shouldCallService: true,
+		},
+		{
+			name: "Error/NotABackendService",
+			auth: &authentication.BackendServiceAuth{
+				UserAgent:    "curl/7.88.1",
+				AllowedUsers: []string{"scheduler@agora.com"},
+			},
+			expectErr: validation.ErrInvalidCredentials,
+		},
+		{
+			name:              "Error/ServiceFailure",
+			shouldCallService: true,
+			refreshErr:        fooErr,
+			expectErr:         fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+
+			if d.shouldCallService {
+				improveRequestService.
+					On("RefreshRankings", context.TODO()).
+					Return(d.refreshErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService: improveRequestService,
+			})
+
+			err := provider.RefreshImproveRequestRankings(context.TODO(), d.auth)
+			test_utils.RequireError(t, d.expectErr, err)
+
+			improveRequestService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_ListLeaderboard(t *testing.T) {
+	data := []struct {
+		name string
+
+		board  models.Leaderboard
+		period models.LeaderboardPeriod
+		limit  int
+		offset int
+
+		listData  []*models.LeaderboardEntry
+		listCount int64
+		listErr   error
+
+		expect      []*models.LeaderboardEntry
+		expectCount int64
+		expectErr   error
+	}{
+		{
+			name:   "Success",
+			board:  models.LeaderboardAcceptedSuggestions,
+			period: models.LeaderboardPeriodMonth,
+			limit:  10,
+			offset: 20,
+			listData: []*models.LeaderboardEntry{
+				{Rank: 21, TargetID: test_utils.NumberUUID(100), Score: 4},
+				{Rank: 22, TargetID: test_utils.NumberUUID(101), Score: 3},
+			},
+			listCount: 200,
+			expect: []*models.LeaderboardEntry{
+				{Rank: 21, TargetID: test_utils.NumberUUID(100), Score: 4},
+				{Rank: 22, TargetID: test_utils.NumberUUID(101), Score: 3},
+			},
+			expectCount: 200,
+		},
+		{
+			name:      "Error/ServiceFailure",
+			board:     models.LeaderboardAcceptedSuggestions,
+			period:    models.LeaderboardPeriodMonth,
+			limit:     10,
+			offset:    20,
+			listErr:   fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			leaderboardsService := leaderboards_service.NewMockService(t)
+
+			leaderboardsService.
+				On("List", context.TODO(), d.board, d.period, d.limit, d.offset).
+				Return(d.listData, d.listCount, d.listErr)
+
+			provider := NewProvider(Config{
+				LeaderboardsService: leaderboardsService,
+			})
+
+			res, count, err := provider.ListLeaderboard(context.TODO(), d.board, d.period, d.limit, d.offset)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+			require.Equal(t, d.expectCount, count)
+
+			leaderboardsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_RefreshLeaderboards(t *testing.T) {
+	data := []struct {
+		name string
+
+		auth *authentication.BackendServiceAuth
+
+		shouldCallService bool
+		refreshErr        error
+
+		expectErr error
+	}{
+		{
+			name:              "Success",
+			shouldCallService: true,
+		},
+		{
+			name: "Error/NotABackendService",
+			auth: &authentication.BackendServiceAuth{
+				UserAgent:    "curl/7.88.1",
+				AllowedUsers: []string{"scheduler@agora.com"},
+			},
+			expectErr: validation.ErrInvalidCredentials,
+		},
+		{
+			name:              "Error/ServiceFailure",
+			shouldCallService: true,
+			refreshErr:        fooErr,
+			expectErr:         fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			leaderboardsService := leaderboards_service.NewMockService(t)
+
+			if d.shouldCallService {
+				leaderboardsService.
+					On("Refresh", context.TODO()).
+					Return(d.refreshErr)
+			}
+
+			provider := NewProvider(Config{
+				LeaderboardsService: leaderboardsService,
+			})
+
+			err := provider.RefreshLeaderboards(context.TODO(), d.auth)
+			test_utils.RequireError(t, d.expectErr, err)
+
+			leaderboardsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_ReadImproveRequestDraft(t *testing.T) {
+	data := []struct {
+		name string
+
+		now  time.Time
+		keys []ed25519.PrivateKey
+
+		token string
+		id    uuid.UUID
+
+		shouldCallDraftsService bool
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+		draftData              *models.ImproveRequestDraft
+		draftErr               error
+
+		expect    *models.ImproveRequestDraft
+		expectErr error
+	}{
+		{
+			name:                    "Success",
+			now:                     baseTime,
+			keys:                    jwk_storage.MockedKeys,
+			token:                   "foo.bar.qux",
+			id:                      test_utils.NumberUUID(1),
+			shouldCallDraftsService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			draftData: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			expect: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+		},
+		{
+			name:                    "Error/NotTheAuthor",
+			now:                     baseTime,
+			keys:                    jwk_storage.MockedKeys,
+			token:                   "foo.bar.qux",
+			id:                      test_utils.NumberUUID(1),
+			shouldCallDraftsService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(11)},
+			},
+			draftData: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			expectErr: validation.ErrInvalidCredentials,
+		},
+		{
+			name:                    "Error/DraftsServiceFailure",
+			now:                     baseTime,
+			keys:                    jwk_storage.MockedKeys,
+			token:                   "foo.bar.qux",
+			id:                      test_utils.NumberUUID(1),
+			shouldCallDraftsService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			draftErr:  fooErr,
+			expectErr: fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			now:                   baseTime,
+			keys:                  jwk_storage.MockedKeys,
+			token:                 "foo.bar.qux",
+			id:                    test_utils.NumberUUID(1),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			draftsService := drafts_service.NewMockService(t)
+
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(d.keys))
+			for i, key := range d.keys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, d.now).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallDraftsService {
+				draftsService.
+					On("Read", context.TODO(), d.id).
+					Return(d.draftData, d.draftErr)
+			}
+
+			provider := NewProvider(Config{
+				DraftsService: draftsService,
+				TokenService:  tokenService,
+				KeysService:   keysService,
+				Time:          test_utils.GetTimeNow(d.now),
+			})
+
+			res, err := provider.ReadImproveRequestDraft(context.TODO(), d.token, d.id)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			draftsService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_ListImproveRequestDrafts(t *testing.T) {
+	data := []struct {
+		name string
+
+		now  time.Time
+		keys []ed25519.PrivateKey
+
+		token  string
+		limit  int
+		offset int
+
+		shouldCallDraftsService bool
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+		draftsData             []*models.ImproveRequestDraft
+		draftsTotal            int64
+		draftsErr              error
+
+		expect      []*models.ImproveRequestDraft
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name:                    "Success",
+			now:                     baseTime,
+			keys:                    jwk_storage.MockedKeys,
+			token:                   "foo.bar.qux",
+			limit:                   10,
+			offset:                  0,
+			shouldCallDraftsService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			draftsData: []*models.ImproveRequestDraft{
+				{ID: test_utils.NumberUUID(1), UserID: test_utils.NumberUUID(10), CreatedAt: baseTime},
+			},
+			draftsTotal: 1,
+			expect: []*models.ImproveRequestDraft{
+				{ID: test_utils.NumberUUID(1), UserID: test_utils.NumberUUID(10), CreatedAt: baseTime},
+			},
+			expectTotal: 1,
+		},
+		{
+			name:                    "Error/DraftsServiceFailure",
+			now:                     baseTime,
+			keys:                    jwk_storage.MockedKeys,
+			token:                   "foo.bar.qux",
+			limit:                   10,
+			offset:                  0,
+			shouldCallDraftsService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			draftsErr: fooErr,
+			expectErr: fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			now:                   baseTime,
+			keys:                  jwk_storage.MockedKeys,
+			token:                 "foo.bar.qux",
+			limit:                 10,
+			offset:                0,
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			draftsService := drafts_service.NewMockService(t)
+
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(d.keys))
+			for i, key := range d.keys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, d.now).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallDraftsService {
+				draftsService.
+					On("List", context.TODO(), test_utils.NumberUUID(10), d.limit, d.offset).
+					Return(d.draftsData, d.draftsTotal, d.draftsErr)
+			}
+
+			provider := NewProvider(Config{
+				DraftsService: draftsService,
+				TokenService:  tokenService,
+				KeysService:   keysService,
+				Time:          test_utils.GetTimeNow(d.now),
+			})
+
+			res, total, err := provider.ListImproveRequestDrafts(context.TODO(), d.token, d.limit, d.offset)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+			require.Equal(t, d.expectTotal, total)
+
+			draftsService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_CreateImproveRequestDraft(t *testing.T) {
+	data := []struct {
+		name string
+
+		now  time.Time
+		keys []ed25519.PrivateKey
+		id   uuid.UUID
+
+		token string
+		data  *models.ImproveRequestDraftUpsert
+
+		shouldCallUserService   bool
+		shouldCallDraftsService bool
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+		hasAuthorization       bool
+		hasAuthorizationErr    error
+		draftData              *models.ImproveRequestDraft
+		draftErr               error
+
+		expect    *models.ImproveRequestDraft
+		expectErr error
+	}{
+		{
+			name:  "Success",
+			now:   baseTime,
+			keys:  jwk_storage.MockedKeys,
+			id:    test_utils.NumberUUID(1),
+			token: "foo.bar.qux",
+			data: &models.ImproveRequestDraftUpsert{
+				Title:   "Dummy request",
+				Content: "Foo bar qux.",
+				Tags:    []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			shouldCallDraftsService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			draftData: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			expect: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+		},
+		{
+			name:  "Success/Scheduled",
+			now:   baseTime,
+			keys:  jwk_storage.MockedKeys,
+			id:    test_utils.NumberUUID(1),
+			token: "foo.bar.qux",
+			data: &models.ImproveRequestDraftUpsert{
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+				PublishAt: &updateTime,
+			},
+			shouldCallUserService:   true,
+			hasAuthorization:        true,
+			shouldCallDraftsService: true,
 			tokenServiceDecodeData: &models.UserToken{
 				Header: models.UserTokenHeader{
 					IAT: baseTime.Add(-time.Hour),
 					EXP: baseTime.Add(time.Hour),
 					ID:  test_utils.NumberUUID(100),
 				},
-				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
 			},
-			voteServiceData: models.VoteUp,
-			expect:          models.VoteUp,
+			draftData: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+				PublishAt: &updateTime,
+			},
+			expect: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+				PublishAt: &updateTime,
+			},
+		},
+		{
+			name:  "Error/ScheduledUserNotValidated",
+			now:   baseTime,
+			keys:  jwk_storage.MockedKeys,
+			id:    test_utils.NumberUUID(1),
+			token: "foo.bar.qux",
+			data: &models.ImproveRequestDraftUpsert{
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+				PublishAt: &updateTime,
+			},
+			shouldCallUserService: true,
+			hasAuthorization:      false,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			expectErr: validation.ErrUnauthorized,
+		},
+		{
+			name:  "Error/DraftsServiceFailure",
+			now:   baseTime,
+			keys:  jwk_storage.MockedKeys,
+			id:    test_utils.NumberUUID(1),
+			token: "foo.bar.qux",
+			data: &models.ImproveRequestDraftUpsert{
+				Title:   "Dummy request",
+				Content: "Foo bar qux.",
+				Tags:    []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			shouldCallDraftsService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			draftErr:  fooErr,
+			expectErr: fooErr,
+		},
+		{
+			name:  "Error/TokenServiceFailure",
+			now:   baseTime,
+			keys:  jwk_storage.MockedKeys,
+			id:    test_utils.NumberUUID(1),
+			token: "foo.bar.qux",
+			data: &models.ImproveRequestDraftUpsert{
+				Title:   "Dummy request",
+				Content: "Foo bar qux.",
+				Tags:    []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			draftsService := drafts_service.NewMockService(t)
+			userService := user_service.NewMockService(t)
+
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(d.keys))
+			for i, key := range d.keys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, d.now).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallUserService {
+				userService.
+					On("HasAuthorizations", context.TODO(), d.tokenServiceDecodeData.Payload.ID, mock.Anything).
+					Return(d.hasAuthorization, d.hasAuthorizationErr)
+			}
+
+			if d.shouldCallDraftsService {
+				draftsService.
+					On("Create", context.TODO(), d.data, d.tokenServiceDecodeData.Payload.ID, d.id, d.now).
+					Return(d.draftData, d.draftErr)
+			}
+
+			provider := NewProvider(Config{
+				DraftsService: draftsService,
+				TokenService:  tokenService,
+				KeysService:   keysService,
+				UserService:   userService,
+				Time:          test_utils.GetTimeNow(d.now),
+				ID:            test_utils.GetUUID(d.id),
+			})
+
+			res, err := provider.CreateImproveRequestDraft(context.TODO(), d.token, d.data)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			draftsService.AssertExpectations(t)
+			userService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_UpdateImproveRequestDraft(t *testing.T) {
+	data := []struct {
+		name string
+
+		now  time.Time
+		keys []ed25519.PrivateKey
+
+		token string
+		id    uuid.UUID
+		data  *models.ImproveRequestDraftUpsert
+
+		shouldCallUserService         bool
+		shouldCallDraftsUpdateService bool
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+		hasAuthorization       bool
+		hasAuthorizationErr    error
+		draftReadData          *models.ImproveRequestDraft
+		draftReadErr           error
+		draftUpdateData        *models.ImproveRequestDraft
+		draftUpdateErr         error
+
+		expect    *models.ImproveRequestDraft
+		expectErr error
+	}{
+		{
+			name:  "Success",
+			now:   baseTime,
+			keys:  jwk_storage.MockedKeys,
+			token: "foo.bar.qux",
+			id:    test_utils.NumberUUID(1),
+			data: &models.ImproveRequestDraftUpsert{
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+				PublishAt: &updateTime,
+			},
+			shouldCallUserService:         true,
+			hasAuthorization:              true,
+			shouldCallDraftsUpdateService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			draftReadData: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			draftUpdateData: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+				PublishAt: &updateTime,
+			},
+			expect: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+				PublishAt: &updateTime,
+			},
+		},
+		{
+			name:  "Error/NotTheAuthor",
+			now:   baseTime,
+			keys:  jwk_storage.MockedKeys,
+			token: "foo.bar.qux",
+			id:    test_utils.NumberUUID(1),
+			data: &models.ImproveRequestDraftUpsert{
+				Title:   "Dummy request",
+				Content: "Foo bar qux.",
+				Tags:    []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(11)},
+			},
+			draftReadData: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			expectErr: validation.ErrInvalidCredentials,
+		},
+		{
+			name:  "Error/DraftsServiceReadFailure",
+			now:   baseTime,
+			keys:  jwk_storage.MockedKeys,
+			token: "foo.bar.qux",
+			id:    test_utils.NumberUUID(1),
+			data: &models.ImproveRequestDraftUpsert{
+				Title:   "Dummy request",
+				Content: "Foo bar qux.",
+				Tags:    []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			draftReadErr: fooErr,
+			expectErr:    fooErr,
+		},
+		{
+			name:  "Error/DraftsServiceUpdateFailure",
+			now:   baseTime,
+			keys:  jwk_storage.MockedKeys,
+			token: "foo.bar.qux",
+			id:    test_utils.NumberUUID(1),
+			data: &models.ImproveRequestDraftUpsert{
+				Title:   "Dummy request",
+				Content: "Foo bar qux.",
+				Tags:    []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			shouldCallDraftsUpdateService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			draftReadData: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			draftUpdateErr: fooErr,
+			expectErr:      fooErr,
+		},
+		{
+			name:  "Error/TokenServiceFailure",
+			now:   baseTime,
+			keys:  jwk_storage.MockedKeys,
+			token: "foo.bar.qux",
+			id:    test_utils.NumberUUID(1),
+			data: &models.ImproveRequestDraftUpsert{
+				Title:   "Dummy request",
+				Content: "Foo bar qux.",
+				Tags:    []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
 		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			draftsService := drafts_service.NewMockService(t)
+			userService := user_service.NewMockService(t)
+
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(d.keys))
+			for i, key := range d.keys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, d.now).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.tokenServiceDecodeErr == nil {
+				draftsService.
+					On("Read", context.TODO(), d.id).
+					Return(d.draftReadData, d.draftReadErr)
+			}
+
+			if d.shouldCallUserService {
+				userService.
+					On("HasAuthorizations", context.TODO(), d.tokenServiceDecodeData.Payload.ID, mock.Anything).
+					Return(d.hasAuthorization, d.hasAuthorizationErr)
+			}
+
+			if d.shouldCallDraftsUpdateService {
+				draftsService.
+					On("Update", context.TODO(), d.data, d.id, d.now).
+					Return(d.draftUpdateData, d.draftUpdateErr)
+			}
+
+			provider := NewProvider(Config{
+				DraftsService: draftsService,
+				TokenService:  tokenService,
+				KeysService:   keysService,
+				UserService:   userService,
+				Time:          test_utils.GetTimeNow(d.now),
+			})
+
+			res, err := provider.UpdateImproveRequestDraft(context.TODO(), d.token, d.id, d.data)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			draftsService.AssertExpectations(t)
+			userService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImprovePostProvider_DeleteImproveRequestDraft(t *testing.T) {
+	data := []struct {
+		name string
+
+		now  time.Time
+		keys []ed25519.PrivateKey
+
+		token string
+		id    uuid.UUID
+
+		shouldCallDraftsDeleteService bool
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+		draftReadData          *models.ImproveRequestDraft
+		draftReadErr           error
+		draftDeleteErr         error
+
+		expectErr error
+	}{
 		{
-			name:                               "Error/VoteServiceFailure",
-			now:                                baseTime,
-			keys:                               jwk_storage.MockedKeys,
-			userID:                             test_utils.NumberUUID(100),
-			token:                              "foo.bar.qux",
-			postID:                             test_utils.NumberUUID(10),
-			target:                             models.VoteTargetImproveSuggestion,
-			vote:                               models.VoteUp,
-			shouldCallImproveSuggestionService: true,
-			shouldCallVoteService:              true,
+			name:                          "Success",
+			now:                           baseTime,
+			keys:                          jwk_storage.MockedKeys,
+			token:                         "foo.bar.qux",
+			id:                            test_utils.NumberUUID(1),
+			shouldCallDraftsDeleteService: true,
 			tokenServiceDecodeData: &models.UserToken{
 				Header: models.UserTokenHeader{
 					IAT: baseTime.Add(-time.Hour),
 					EXP: baseTime.Add(time.Hour),
 					ID:  test_utils.NumberUUID(100),
 				},
-				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
 			},
-			voteServiceErr: fooErr,
-			expect:         models.NoVote,
-			expectErr:      fooErr,
-		},
-		{
-			name:                            "Error/ImproveRequestSelfVote",
-			now:                             baseTime,
-			keys:                            jwk_storage.MockedKeys,
-			userID:                          test_utils.NumberUUID(100),
-			token:                           "foo.bar.qux",
-			postID:                          test_utils.NumberUUID(10),
-			target:                          models.VoteTargetImproveRequest,
-			vote:                            models.VoteUp,
-			shouldCallImproveRequestService: true,
-			tokenServiceDecodeData: &models.UserToken{
-				Header: models.UserTokenHeader{
-					IAT: baseTime.Add(-time.Hour),
-					EXP: baseTime.Add(time.Hour),
-					ID:  test_utils.NumberUUID(100),
-				},
-				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			draftReadData: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
 			},
-			improveRequestServiceData: true,
-			expect:                    models.NoVote,
-			expectErr:                 validation.ErrInvalidEntity,
 		},
 		{
-			name:                               "Error/ImproveSuggestionSelfVote",
-			now:                                baseTime,
-			keys:                               jwk_storage.MockedKeys,
-			userID:                             test_utils.NumberUUID(100),
-			token:                              "foo.bar.qux",
-			postID:                             test_utils.NumberUUID(10),
-			target:                             models.VoteTargetImproveSuggestion,
-			vote:                               models.VoteUp,
-			shouldCallImproveSuggestionService: true,
+			name:  "Error/NotTheAuthor",
+			now:   baseTime,
+			keys:  jwk_storage.MockedKeys,
+			token: "foo.bar.qux",
+			id:    test_utils.NumberUUID(1),
 			tokenServiceDecodeData: &models.UserToken{
 				Header: models.UserTokenHeader{
 					IAT: baseTime.Add(-time.Hour),
 					EXP: baseTime.Add(time.Hour),
 					ID:  test_utils.NumberUUID(100),
 				},
-				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(11)},
 			},
-			improveSuggestionServiceData: true,
-			expect:                       models.NoVote,
-			expectErr:                    validation.ErrInvalidEntity,
-		},
-		{
-			name:                            "Error/ImproveRequestServiceFailure",
-			now:                             baseTime,
-			keys:                            jwk_storage.MockedKeys,
-			userID:                          test_utils.NumberUUID(100),
-			token:                           "foo.bar.qux",
-			postID:                          test_utils.NumberUUID(10),
-			target:                          models.VoteTargetImproveRequest,
-			vote:                            models.VoteUp,
-			shouldCallImproveRequestService: true,
-			tokenServiceDecodeData: &models.UserToken{
-				Header: models.UserTokenHeader{
-					IAT: baseTime.Add(-time.Hour),
-					EXP: baseTime.Add(time.Hour),
-					ID:  test_utils.NumberUUID(100),
-				},
-				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+			draftReadData: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
 			},
-			improveRequestServiceErr: fooErr,
-			expect:                   models.NoVote,
-			expectErr:                fooErr,
+			expectErr: validation.ErrInvalidCredentials,
 		},
 		{
-			name:                               "Error/ImproveSuggestionServiceFailure",
-			now:                                baseTime,
-			keys:                               jwk_storage.MockedKeys,
-			userID:                             test_utils.NumberUUID(100),
-			token:                              "foo.bar.qux",
-			postID:                             test_utils.NumberUUID(10),
-			target:                             models.VoteTargetImproveSuggestion,
-			vote:                               models.VoteUp,
-			shouldCallImproveSuggestionService: true,
+			name:                          "Error/DraftsServiceDeleteFailure",
+			now:                           baseTime,
+			keys:                          jwk_storage.MockedKeys,
+			token:                         "foo.bar.qux",
+			id:                            test_utils.NumberUUID(1),
+			shouldCallDraftsDeleteService: true,
 			tokenServiceDecodeData: &models.UserToken{
 				Header: models.UserTokenHeader{
 					IAT: baseTime.Add(-time.Hour),
 					EXP: baseTime.Add(time.Hour),
 					ID:  test_utils.NumberUUID(100),
 				},
-				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
 			},
-			improveSuggestionServiceErr: fooErr,
-			expect:                      models.NoVote,
-			expectErr:                   fooErr,
+			draftReadData: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			draftDeleteErr: fooErr,
+			expectErr:      fooErr,
 		},
 		{
 			name:                  "Error/TokenServiceFailure",
 			now:                   baseTime,
 			keys:                  jwk_storage.MockedKeys,
-			userID:                test_utils.NumberUUID(100),
 			token:                 "foo.bar.qux",
-			postID:                test_utils.NumberUUID(10),
-			target:                models.VoteTargetImproveRequest,
-			vote:                  models.VoteUp,
+			id:                    test_utils.NumberUUID(1),
 			tokenServiceDecodeErr: fooErr,
-			expect:                models.NoVote,
 			expectErr:             fooErr,
 		},
 	}
 
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
-			improveRequestService := improve_request_service.NewMockService(t)
-			improveSuggestionService := improve_suggestion_service.NewMockService(t)
-			voteService := votes_service.NewMockService(t)
+			draftsService := drafts_service.NewMockService(t)
+
 			tokenService := token_service.NewMockService(t)
 			keysService := jwk_service.NewMockServiceCached(t)
 
@@ -2102,129 +10368,236 @@ func TestImprovePostProvider_Vote(t *testing.T) {
 				On("Decode", d.token, publicKeys, d.now).
 				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
 
-			if d.shouldCallImproveRequestService {
-				improveRequestService.
-					On("IsCreator", context.TODO(), d.userID, d.postID, false).
-					Return(d.improveRequestServiceData, d.improveRequestServiceErr)
-			}
-
-			if d.shouldCallImproveSuggestionService {
-				improveSuggestionService.
-					On("IsCreator", context.TODO(), d.userID, d.postID).
-					Return(d.improveSuggestionServiceData, d.improveSuggestionServiceErr)
+			if d.tokenServiceDecodeErr == nil {
+				draftsService.
+					On("Read", context.TODO(), d.id).
+					Return(d.draftReadData, d.draftReadErr)
 			}
 
-			if d.shouldCallVoteService {
-				voteService.
-					On(
-						"Vote", context.TODO(),
-						d.postID, d.userID, models.VoteTarget(d.target), models.VoteValue(d.vote), d.now,
-					).
-					Return(d.voteServiceData, d.voteServiceErr)
+			if d.shouldCallDraftsDeleteService {
+				draftsService.
+					On("Delete", context.TODO(), d.id).
+					Return(d.draftDeleteErr)
 			}
 
 			provider := NewProvider(Config{
-				ImproveRequestService:    improveRequestService,
-				ImproveSuggestionService: improveSuggestionService,
-				VotesService:             voteService,
-				TokenService:             tokenService,
-				KeysService:              keysService,
-				Time:                     test_utils.GetTimeNow(d.now),
+				DraftsService: draftsService,
+				TokenService:  tokenService,
+				KeysService:   keysService,
+				Time:          test_utils.GetTimeNow(d.now),
 			})
 
-			vote, err := provider.Vote(context.TODO(), d.token, d.postID, d.target, d.vote)
+			err := provider.DeleteImproveRequestDraft(context.TODO(), d.token, d.id)
 			test_utils.RequireError(t, d.expectErr, err)
-			require.Equal(t, d.expect, vote)
 
-			improveRequestService.AssertExpectations(t)
-			improveSuggestionService.AssertExpectations(t)
-			voteService.AssertExpectations(t)
+			draftsService.AssertExpectations(t)
 			tokenService.AssertExpectations(t)
 			keysService.AssertExpectations(t)
 		})
 	}
 }
 
-func TestImprovePostProvider_HasVoted(t *testing.T) {
+func TestImprovePostProvider_PublishImproveRequestDraft(t *testing.T) {
 	data := []struct {
 		name string
 
-		now    time.Time
-		keys   []ed25519.PrivateKey
-		userID uuid.UUID
+		now  time.Time
+		keys []ed25519.PrivateKey
 
-		token  string
-		postID uuid.UUID
-		target models.VoteTarget
+		token string
+		id    uuid.UUID
 
-		shouldCallVoteService bool
+		shouldCallDraftsReadService     bool
+		shouldCallImproveRequestService bool
+		shouldCallDraftsDeleteService   bool
+		shouldCallDuplicatesService     bool
 
 		tokenServiceDecodeData *models.UserToken
 		tokenServiceDecodeErr  error
-		voteServiceData        models.VoteValue
-		voteServiceErr         error
+		hasAuthorization       bool
+		hasAuthorizationErr    error
+		draftReadData          *models.ImproveRequestDraft
+		draftReadErr           error
+		improveRequestData     *models.ImproveRequest
+		improveRequestErr      error
+		draftDeleteErr         error
 
-		expect    models.VoteValue
+		expect    *models.ImproveRequest
 		expectErr error
 	}{
 		{
-			name:                  "Success",
-			now:                   baseTime,
-			keys:                  jwk_storage.MockedKeys,
-			userID:                test_utils.NumberUUID(100),
-			token:                 "foo.bar.qux",
-			postID:                test_utils.NumberUUID(10),
-			target:                models.VoteTargetImproveRequest,
-			shouldCallVoteService: true,
+			name:                            "Success",
+			now:                             baseTime,
+			keys:                            jwk_storage.MockedKeys,
+			token:                           "foo.bar.qux",
+			id:                              test_utils.NumberUUID(1),
+			hasAuthorization:                true,
+			shouldCallDraftsReadService:     true,
+			shouldCallImproveRequestService: true,
+			shouldCallDraftsDeleteService:   true,
+			shouldCallDuplicatesService:     true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			draftReadData: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			improveRequestData: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(10),
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			expect: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(10),
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+		},
+		{
+			name:                            "Error/DraftsServiceDeleteFailure",
+			now:                             baseTime,
+			keys:                            jwk_storage.MockedKeys,
+			token:                           "foo.bar.qux",
+			id:                              test_utils.NumberUUID(1),
+			hasAuthorization:                true,
+			shouldCallDraftsReadService:     true,
+			shouldCallImproveRequestService: true,
+			shouldCallDraftsDeleteService:   true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			draftReadData: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			improveRequestData: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(10),
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			draftDeleteErr: fooErr,
+			expectErr:      fooErr,
+		},
+		{
+			name:                            "Error/ImproveRequestServiceFailure",
+			now:                             baseTime,
+			keys:                            jwk_storage.MockedKeys,
+			token:                           "foo.bar.qux",
+			id:                              test_utils.NumberUUID(1),
+			hasAuthorization:                true,
+			shouldCallDraftsReadService:     true,
+			shouldCallImproveRequestService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			draftReadData: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			improveRequestErr: fooErr,
+			expectErr:         fooErr,
+		},
+		{
+			name:                        "Error/NotTheAuthor",
+			now:                         baseTime,
+			keys:                        jwk_storage.MockedKeys,
+			token:                       "foo.bar.qux",
+			id:                          test_utils.NumberUUID(1),
+			hasAuthorization:            true,
+			shouldCallDraftsReadService: true,
 			tokenServiceDecodeData: &models.UserToken{
 				Header: models.UserTokenHeader{
 					IAT: baseTime.Add(-time.Hour),
 					EXP: baseTime.Add(time.Hour),
 					ID:  test_utils.NumberUUID(100),
 				},
-				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(11)},
 			},
-			voteServiceData: models.VoteUp,
-			expect:          models.VoteUp,
+			draftReadData: &models.ImproveRequestDraft{
+				ID:        test_utils.NumberUUID(1),
+				UserID:    test_utils.NumberUUID(10),
+				CreatedAt: baseTime,
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			expectErr: validation.ErrInvalidCredentials,
 		},
 		{
-			name:                  "Error/VoteServiceFailure",
-			now:                   baseTime,
-			keys:                  jwk_storage.MockedKeys,
-			userID:                test_utils.NumberUUID(100),
-			token:                 "foo.bar.qux",
-			postID:                test_utils.NumberUUID(10),
-			target:                models.VoteTargetImproveRequest,
-			shouldCallVoteService: true,
+			name:             "Error/UserNotValidated",
+			now:              baseTime,
+			keys:             jwk_storage.MockedKeys,
+			token:            "foo.bar.qux",
+			id:               test_utils.NumberUUID(1),
+			hasAuthorization: false,
 			tokenServiceDecodeData: &models.UserToken{
 				Header: models.UserTokenHeader{
 					IAT: baseTime.Add(-time.Hour),
 					EXP: baseTime.Add(time.Hour),
 					ID:  test_utils.NumberUUID(100),
 				},
-				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(100)},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
 			},
-			voteServiceErr: fooErr,
-			expect:         models.NoVote,
-			expectErr:      fooErr,
+			expectErr: validation.ErrUnauthorized,
 		},
 		{
 			name:                  "Error/TokenServiceFailure",
 			now:                   baseTime,
 			keys:                  jwk_storage.MockedKeys,
-			userID:                test_utils.NumberUUID(100),
 			token:                 "foo.bar.qux",
-			postID:                test_utils.NumberUUID(10),
-			target:                models.VoteTargetImproveRequest,
+			id:                    test_utils.NumberUUID(1),
 			tokenServiceDecodeErr: fooErr,
-			expect:                models.NoVote,
 			expectErr:             fooErr,
 		},
 	}
 
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
-			voteService := votes_service.NewMockService(t)
+			draftsService := drafts_service.NewMockService(t)
+			improveRequestService := improve_request_service.NewMockService(t)
+			duplicatesService := duplicates_service.NewMockService(t)
+			userService := user_service.NewMockService(t)
+
 			tokenService := token_service.NewMockService(t)
 			keysService := jwk_service.NewMockServiceCached(t)
 
@@ -2241,108 +10614,352 @@ func TestImprovePostProvider_HasVoted(t *testing.T) {
 				On("Decode", d.token, publicKeys, d.now).
 				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
 
-			if d.shouldCallVoteService {
-				voteService.
-					On("HasVoted", context.TODO(), d.postID, d.userID, models.VoteTarget(d.target)).
-					Return(d.voteServiceData, d.voteServiceErr)
+			if d.tokenServiceDecodeErr == nil {
+				userService.
+					On("HasAuthorizations", context.TODO(), d.tokenServiceDecodeData.Payload.ID, mock.Anything).
+					Return(d.hasAuthorization, d.hasAuthorizationErr)
+			}
+
+			if d.shouldCallDraftsReadService {
+				draftsService.
+					On("Read", context.TODO(), d.id).
+					Return(d.draftReadData, d.draftReadErr)
+			}
+
+			if d.shouldCallImproveRequestService {
+				improveRequestService.
+					On(
+						"Create", context.TODO(), d.draftReadData.UserID, d.draftReadData.Title, d.draftReadData.Content,
+						d.draftReadData.Language, d.draftReadData.Tags, d.draftReadData.ID, d.now,
+					).
+					Return(d.improveRequestData, d.improveRequestErr)
+			}
+
+			if d.shouldCallDraftsDeleteService {
+				draftsService.
+					On("Delete", context.TODO(), d.id).
+					Return(d.draftDeleteErr)
+			}
+
+			if d.shouldCallDuplicatesService {
+				duplicatesService.
+					On("Check", context.TODO(), &models.ForumPostRevision{
+						RevisionID: d.improveRequestData.ID,
+						PostID:     d.improveRequestData.Source,
+						ThreadID:   d.improveRequestData.Source,
+						UserID:     d.improveRequestData.UserID,
+						Target:     models.ForumPostTargetImproveRequest,
+						CreatedAt:  d.improveRequestData.CreatedAt,
+						Content:    d.improveRequestData.Content,
+					}, d.now).
+					Return(nil, nil)
 			}
 
 			provider := NewProvider(Config{
-				VotesService: voteService,
-				TokenService: tokenService,
-				KeysService:  keysService,
-				Time:         test_utils.GetTimeNow(d.now),
+				ImproveRequestService: improveRequestService,
+				DraftsService:         draftsService,
+				DuplicatesService:     duplicatesService,
+				TokenService:          tokenService,
+				KeysService:           keysService,
+				UserService:           userService,
+				Time:                  test_utils.GetTimeNow(d.now),
 			})
 
-			vote, err := provider.HasVoted(context.TODO(), d.token, d.postID, d.target)
+			res, err := provider.PublishImproveRequestDraft(context.TODO(), d.token, d.id)
 			test_utils.RequireError(t, d.expectErr, err)
-			require.Equal(t, d.expect, vote)
+			require.Equal(t, d.expect, res)
 
-			voteService.AssertExpectations(t)
+			draftsService.AssertExpectations(t)
+			improveRequestService.AssertExpectations(t)
+			duplicatesService.AssertExpectations(t)
+			userService.AssertExpectations(t)
 			tokenService.AssertExpectations(t)
 			keysService.AssertExpectations(t)
 		})
 	}
 }
 
-func TestImprovePostProvider_GetVotedPosts(t *testing.T) {
+func TestImprovePostProvider_PublishScheduledImproveRequestDrafts(t *testing.T) {
 	data := []struct {
-		name   string
-		userID uuid.UUID
-		target models.VoteTarget
-		limit  int
-		offset int
+		name string
 
-		voteServiceData  []*models.VotedPost
-		voteServiceCount int64
-		voteServiceErr   error
+		now  time.Time
+		auth *authentication.BackendServiceAuth
 
-		expect      []*models.VotedPost
-		expectCount int64
-		expectErr   error
+		shouldCallDraftsService         bool
+		shouldCallImproveRequestService bool
+		shouldCallDraftsDeleteService   bool
+		shouldCallDuplicatesService     bool
+		shouldCallUnscheduleService     bool
+		shouldCallReadPublished         bool
+
+		scheduledData      []*models.ImproveRequestDraft
+		scheduledErr       error
+		improveRequestData *models.ImproveRequest
+		improveRequestErr  error
+		duplicatesErr      error
+		unscheduleErr      error
+		readPublishedData  *models.ImproveRequest
+
+		expectErr error
 	}{
 		{
-			name:   "Success",
-			userID: test_utils.NumberUUID(100),
-			target: models.VoteTargetImproveRequest,
-			limit:  10,
-			offset: 20,
-			voteServiceData: []*models.VotedPost{
+			name:                            "Success",
+			now:                             updateTime,
+			shouldCallDraftsService:         true,
+			shouldCallImproveRequestService: true,
+			shouldCallDraftsDeleteService:   true,
+			shouldCallDuplicatesService:     true,
+			scheduledData: []*models.ImproveRequestDraft{
 				{
-					PostID:    test_utils.NumberUUID(10),
-					Vote:      models.VoteUp,
-					UpdatedAt: baseTime.Add(-time.Hour),
+					ID:        test_utils.NumberUUID(1),
+					UserID:    test_utils.NumberUUID(10),
+					CreatedAt: baseTime,
+					Title:     "Dummy request",
+					Content:   "Foo bar qux.",
+					Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+					PublishAt: &updateTime,
+				},
+			},
+			improveRequestData: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(10),
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+		},
+		{
+			// The draft is published anyway, and its duplicates are checked again by the backfill.
+			name:                            "Success/DuplicatesServiceFailure",
+			now:                             updateTime,
+			shouldCallDraftsService:         true,
+			shouldCallImproveRequestService: true,
+			shouldCallDraftsDeleteService:   true,
+			shouldCallDuplicatesService:     true,
+			scheduledData: []*models.ImproveRequestDraft{
+				{
+					ID:        test_utils.NumberUUID(1),
+					UserID:    test_utils.NumberUUID(10),
+					CreatedAt: baseTime,
+					Title:     "Dummy request",
+					Content:   "Foo bar qux.",
+					Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+					PublishAt: &updateTime,
 				},
+			},
+			improveRequestData: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				UserID:    test_utils.NumberUUID(10),
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+			duplicatesErr: fooErr,
+		},
+		{
+			// A previous run published the draft, but failed to remove it.
+			name:                            "Success/AlreadyPublished",
+			now:                             updateTime,
+			shouldCallDraftsService:         true,
+			shouldCallImproveRequestService: true,
+			shouldCallReadPublished:         true,
+			shouldCallDraftsDeleteService:   true,
+			shouldCallDuplicatesService:     true,
+			scheduledData: []*models.ImproveRequestDraft{
 				{
-					PostID:    test_utils.NumberUUID(11),
-					Vote:      models.VoteDown,
-					UpdatedAt: baseTime.Add(30 * time.Minute),
+					ID:        test_utils.NumberUUID(1),
+					UserID:    test_utils.NumberUUID(10),
+					CreatedAt: baseTime,
+					Title:     "Dummy request",
+					Content:   "Foo bar qux.",
+					Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+					PublishAt: &updateTime,
 				},
 			},
-			voteServiceCount: 200,
-			expect: []*models.VotedPost{
+			improveRequestErr: validation.ErrUniqConstraintViolation,
+			readPublishedData: &models.ImproveRequest{
+				ID:        test_utils.NumberUUID(1),
+				Source:    test_utils.NumberUUID(1),
+				CreatedAt: updateTime,
+				UserID:    test_utils.NumberUUID(10),
+				Title:     "Dummy request",
+				Content:   "Foo bar qux.",
+				Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+			},
+		},
+		{
+			// The ID is taken by a request from another user, so the draft was never published.
+			name:                            "Error/IDTaken",
+			now:                             updateTime,
+			shouldCallDraftsService:         true,
+			shouldCallImproveRequestService: true,
+			shouldCallReadPublished:         true,
+			scheduledData: []*models.ImproveRequestDraft{
 				{
-					PostID:    test_utils.NumberUUID(10),
-					Vote:      models.VoteUp,
-					UpdatedAt: baseTime.Add(-time.Hour),
+					ID:        test_utils.NumberUUID(1),
+					UserID:    test_utils.NumberUUID(10),
+					CreatedAt: baseTime,
+					Title:     "Dummy request",
+					Content:   "Foo bar qux.",
+					Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+					PublishAt: &updateTime,
 				},
+			},
+			improveRequestErr: validation.ErrUniqConstraintViolation,
+			readPublishedData: &models.ImproveRequest{
+				ID:     test_utils.NumberUUID(1),
+				Source: test_utils.NumberUUID(1),
+				UserID: test_utils.NumberUUID(11),
+			},
+			expectErr: validation.ErrUniqConstraintViolation,
+		},
+		{
+			name:                    "Success/NothingScheduled",
+			now:                     updateTime,
+			shouldCallDraftsService: true,
+			scheduledData:           []*models.ImproveRequestDraft{},
+		},
+		{
+			name:                            "Success/UnscheduleInvalidDraft",
+			now:                             updateTime,
+			shouldCallDraftsService:         true,
+			shouldCallImproveRequestService: true,
+			shouldCallUnscheduleService:     true,
+			scheduledData: []*models.ImproveRequestDraft{
 				{
-					PostID:    test_utils.NumberUUID(11),
-					Vote:      models.VoteDown,
-					UpdatedAt: baseTime.Add(30 * time.Minute),
+					ID:        test_utils.NumberUUID(1),
+					UserID:    test_utils.NumberUUID(10),
+					CreatedAt: baseTime,
+					Title:     "Dummy request",
+					Content:   "Foo bar qux.",
+					Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+					PublishAt: &updateTime,
 				},
 			},
-			expectCount: 200,
+			improveRequestErr: validation.ErrMissingRelation,
 		},
 		{
-			name:           "Error/ServiceFailure",
-			userID:         test_utils.NumberUUID(100),
-			target:         models.VoteTargetImproveRequest,
-			limit:          10,
-			offset:         20,
-			voteServiceErr: fooErr,
-			expectErr:      fooErr,
+			name:                            "Error/UnscheduleFailure",
+			now:                             updateTime,
+			shouldCallDraftsService:         true,
+			shouldCallImproveRequestService: true,
+			shouldCallUnscheduleService:     true,
+			scheduledData: []*models.ImproveRequestDraft{
+				{
+					ID:        test_utils.NumberUUID(1),
+					UserID:    test_utils.NumberUUID(10),
+					CreatedAt: baseTime,
+					Title:     "Dummy request",
+					Content:   "Foo bar qux.",
+					Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+					PublishAt: &updateTime,
+				},
+			},
+			improveRequestErr: validation.ErrMissingRelation,
+			unscheduleErr:     fooErr,
+			expectErr:         fooErr,
+		},
+		{
+			name:                            "Error/ImproveRequestServiceFailure",
+			now:                             updateTime,
+			shouldCallDraftsService:         true,
+			shouldCallImproveRequestService: true,
+			scheduledData: []*models.ImproveRequestDraft{
+				{
+					ID:        test_utils.NumberUUID(1),
+					UserID:    test_utils.NumberUUID(10),
+					CreatedAt: baseTime,
+					Title:     "Dummy request",
+					Content:   "Foo bar qux.",
+					Tags:      []uuid.UUID{test_utils.NumberUUID(20)},
+					PublishAt: &updateTime,
+				},
+			},
+			improveRequestErr: fooErr,
+			expectErr:         fooErr,
+		},
+		{
+			name:                    "Error/DraftsServiceFailure",
+			now:                     updateTime,
+			shouldCallDraftsService: true,
+			scheduledErr:            fooErr,
+			expectErr:               fooErr,
+		},
+		{
+			name: "Error/NotABackendService",
+			now:  updateTime,
+			auth: &authentication.BackendServiceAuth{
+				UserAgent:    "curl/7.88.1",
+				AllowedUsers: []string{"scheduler@agora.com"},
+			},
+			expectErr: validation.ErrInvalidCredentials,
 		},
 	}
 
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
-			voteService := votes_service.NewMockService(t)
+			draftsService := drafts_service.NewMockService(t)
+			improveRequestService := improve_request_service.NewMockService(t)
+			duplicatesService := duplicates_service.NewMockService(t)
 
-			voteService.
-				On("GetVotedPosts", context.TODO(), d.userID, models.VoteTarget(d.target), d.limit, d.offset).
-				Return(d.voteServiceData, d.voteServiceCount, d.voteServiceErr)
+			if d.shouldCallDraftsService {
+				draftsService.
+					On("ListScheduled", context.TODO(), d.now, ScheduledDraftsBatchSize).
+					Return(d.scheduledData, d.scheduledErr)
+			}
+
+			if d.shouldCallImproveRequestService {
+				draft := d.scheduledData[0]
+				improveRequestService.
+					On(
+						"Create", context.TODO(), draft.UserID, draft.Title, draft.Content, draft.Language, draft.Tags,
+						draft.ID, d.now,
+					).
+					Return(d.improveRequestData, d.improveRequestErr)
+			}
+
+			if d.shouldCallReadPublished {
+				improveRequestService.
+					On("Read", context.TODO(), d.scheduledData[0].ID).
+					Return(d.readPublishedData, nil)
+			}
+
+			if d.shouldCallDraftsDeleteService {
+				draftsService.
+					On("Delete", context.TODO(), d.scheduledData[0].ID).
+					Return(nil)
+			}
+
+			if d.shouldCallDuplicatesService {
+				duplicatesService.
+					On("Check", context.TODO(), mock.Anything, d.now).
+					Return(nil, d.duplicatesErr)
+			}
+
+			if d.shouldCallUnscheduleService {
+				draftsService.
+					On("Unschedule", context.TODO(), d.scheduledData[0].ID, d.now).
+					Return(nil, d.unscheduleErr)
+			}
 
 			provider := NewProvider(Config{
-				VotesService: voteService,
+				ImproveRequestService: improveRequestService,
+				DraftsService:         draftsService,
+				DuplicatesService:     duplicatesService,
+				Time:                  test_utils.GetTimeNow(d.now),
 			})
 
-			posts, count, err := provider.GetVotedPosts(context.TODO(), d.userID, d.target, d.limit, d.offset)
+			err := provider.PublishScheduledImproveRequestDrafts(context.TODO(), d.auth)
 			test_utils.RequireError(t, d.expectErr, err)
-			require.Equal(t, d.expect, posts)
-			require.Equal(t, d.expectCount, count)
 
-			voteService.AssertExpectations(t)
+			draftsService.AssertExpectations(t)
+			improveRequestService.AssertExpectations(t)
+			duplicatesService.AssertExpectations(t)
 		})
 	}
 }
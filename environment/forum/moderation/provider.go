@@ -0,0 +1,510 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-novel/agora-backend/domains/forum/service/duplicates"
+	"github.com/a-novel/agora-backend/domains/forum/service/improve_request"
+	"github.com/a-novel/agora-backend/domains/forum/service/improve_suggestion"
+	"github.com/a-novel/agora-backend/domains/forum/service/moderation_log"
+	"github.com/a-novel/agora-backend/domains/forum/service/reports"
+	"github.com/a-novel/agora-backend/domains/forum/service/thread_state"
+	"github.com/a-novel/agora-backend/domains/forum/service/vote_rings"
+	"github.com/a-novel/agora-backend/domains/keys/service/jwk"
+	"github.com/a-novel/agora-backend/domains/user/service/token"
+	user_service "github.com/a-novel/agora-backend/domains/user/service/user"
+	"github.com/a-novel/agora-backend/environment/user/authentication"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Provider gives moderators access to the forum posts and votes that were automatically flagged or reported by
+// users, and lets them lock threads. Every method but ReportContent and DetectVoteRings is restricted to moderators,
+// and every moderator decision is recorded in the moderation log.
+type Provider interface {
+	// ListDuplicateFlags returns the posts that look like a copy of a post from another user, either pending or
+	// already reviewed, most recent first.
+	ListDuplicateFlags(ctx context.Context, token string, reviewed bool, limit, offset int) ([]*models.ForumDuplicateFlag, int64, error)
+	// ReviewDuplicateFlag marks a flag as reviewed by the current moderator.
+	ReviewDuplicateFlag(ctx context.Context, token string, revisionID, originalRevisionID uuid.UUID) error
+
+	// ListVoteRingFlags returns the voters suspected to take part in a voting ring, either pending or already
+	// reviewed, most recent first.
+	ListVoteRingFlags(ctx context.Context, token string, reviewed bool, limit, offset int) ([]*models.VoteRingFlag, int64, error)
+	// ReviewVoteRingFlag marks a flag as reviewed by the current moderator. If void is true, the votes of the voter
+	// on the posts of the author are removed, and the decision is logged against the profile of the voter.
+	ReviewVoteRingFlag(ctx context.Context, token string, authorID, voterID uuid.UUID, void bool) (*models.VoteRingFlag, error)
+	// DetectVoteRings flags the new accounts that up voted the same authors over the last VoteRingWindow. It is
+	// meant to be called periodically by a backend service.
+	DetectVoteRings(ctx context.Context, auth *authentication.BackendServiceAuth) error
+
+	// LockImproveRequest freezes an improvement request, whatever its current state. Nothing can be posted, edited
+	// or voted on in the thread until a moderator unlocks it.
+	LockImproveRequest(ctx context.Context, token string, requestID uuid.UUID, reason string) (*models.ImproveRequestThreadState, error)
+	// UnlockImproveRequest reopens a locked improvement request.
+	UnlockImproveRequest(ctx context.Context, token string, requestID uuid.UUID, reason string) (*models.ImproveRequestThreadState, error)
+
+	// ReportContent lets any authenticated user report an improvement request, an improvement suggestion or a
+	// profile. For requests, any revision ID can be given. The content is hidden from public listings once enough
+	// distinct users reported it.
+	ReportContent(ctx context.Context, token string, target models.ModerationTarget, targetID uuid.UUID, reason models.ReportReason, content string) error
+	// ListReportCases returns the moderation queue, most reported contents first. Only the cases with one of the
+	// given statuses are returned, or every case if none is given.
+	ListReportCases(ctx context.Context, token string, statuses []models.ReportStatus, limit, offset int) ([]*models.ForumReportCase, int64, error)
+	// ListReports returns the reports received by a content, oldest first.
+	ListReports(ctx context.Context, token string, target models.ModerationTarget, targetID uuid.UUID) ([]*models.ForumReport, error)
+	// ClaimReportCase assigns a pending case to the current moderator, so other moderators don't review it twice.
+	ClaimReportCase(ctx context.Context, token string, target models.ModerationTarget, targetID uuid.UUID) (*models.ForumReportCase, error)
+	// ResolveReportCase upholds the reports of a content, which remains hidden. Cases claimed by another moderator
+	// cannot be resolved.
+	ResolveReportCase(ctx context.Context, token string, target models.ModerationTarget, targetID uuid.UUID, note string) (*models.ForumReportCase, error)
+	// DismissReportCase rejects the reports of a content, which becomes visible again. Cases claimed by another
+	// moderator cannot be dismissed.
+	DismissReportCase(ctx context.Context, token string, target models.ModerationTarget, targetID uuid.UUID, note string) (*models.ForumReportCase, error)
+	// ListModerationActions returns the moderation log, most recent first.
+	ListModerationActions(ctx context.Context, token string, query models.ModerationActionQuery, limit, offset int) ([]*models.ModerationAction, int64, error)
+}
+
+type Config struct {
+	DuplicatesService        duplicates_service.Service
+	ImproveRequestService    improve_request_service.Service
+	ImproveSuggestionService improve_suggestion_service.Service
+	ThreadStateService       thread_state_service.Service
+	ReportsService           reports_service.Service
+	ModerationLogService     moderation_log_service.Service
+	VoteRingsService         vote_rings_service.Service
+	TokenService             token_service.Service
+	KeysService              jwk_service.ServiceCached
+	UserService              user_service.Service
+
+	// AutoHideReports is the number of distinct reports after which a content is hidden, until a moderator reviews
+	// it. 0 disables it.
+	AutoHideReports int
+
+	// VoteRingWindow is how far back DetectVoteRings looks for suspicious votes.
+	VoteRingWindow time.Duration
+	// VoteRingMaxAccountAge is the age under which an account is considered new, at the time it voted.
+	VoteRingMaxAccountAge time.Duration
+	// VoteRingMinVotes is the number of posts of the same author a new account must up vote to be suspicious.
+	// 0 disables the detection.
+	VoteRingMinVotes int
+	// VoteRingMinVoters is the number of suspicious voters an author must gather before they are flagged.
+	VoteRingMinVoters int
+
+	Time func() time.Time
+	ID   func() uuid.UUID
+}
+
+type providerImpl struct {
+	duplicatesService        duplicates_service.Service
+	improveRequestService    improve_request_service.Service
+	improveSuggestionService improve_suggestion_service.Service
+	threadStateService       thread_state_service.Service
+	reportsService           reports_service.Service
+	moderationLogService     moderation_log_service.Service
+	voteRingsService         vote_rings_service.Service
+	tokenService             token_service.Service
+	keysService              jwk_service.ServiceCached
+	userService              user_service.Service
+
+	autoHideReports int
+
+	voteRingWindow        time.Duration
+	voteRingMaxAccountAge time.Duration
+	voteRingMinVotes      int
+	voteRingMinVoters     int
+
+	time func() time.Time
+	id   func() uuid.UUID
+}
+
+func NewProvider(config Config) Provider {
+	return &providerImpl{
+		duplicatesService:        config.DuplicatesService,
+		improveRequestService:    config.ImproveRequestService,
+		improveSuggestionService: config.ImproveSuggestionService,
+		threadStateService:       config.ThreadStateService,
+		reportsService:           config.ReportsService,
+		moderationLogService:     config.ModerationLogService,
+		voteRingsService:         config.VoteRingsService,
+		tokenService:             config.TokenService,
+		keysService:              config.KeysService,
+		userService:              config.UserService,
+
+		autoHideReports: config.AutoHideReports,
+
+		voteRingWindow:        config.VoteRingWindow,
+		voteRingMaxAccountAge: config.VoteRingMaxAccountAge,
+		voteRingMinVotes:      config.VoteRingMinVotes,
+		voteRingMinVoters:     config.VoteRingMinVoters,
+
+		time: config.Time,
+		id:   config.ID,
+	}
+}
+
+// Ensure the token belongs to a moderator, and return its claims.
+func (provider *providerImpl) forceModerator(ctx context.Context, token string, now time.Time) (*models.UserToken, error) {
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := provider.userService.HasAuthorizations(ctx, claims.Payload.ID, models.UserAuthorizations{
+		{models.UserAuthorizationsModerator},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to check user authorizations: %w", err)
+	}
+	if !ok {
+		return nil, validation.NewErrUnauthorized("user is not a moderator")
+	}
+
+	return claims, nil
+}
+
+// Record a decision in the moderation log. ModeratorID is nil for automatic actions.
+func (provider *providerImpl) log(
+	ctx context.Context, moderatorID *uuid.UUID, action models.ModerationActionType, target models.ModerationTarget,
+	targetID uuid.UUID, note string, now time.Time,
+) error {
+	if _, err := provider.moderationLogService.Log(ctx, moderatorID, action, target, targetID, note, provider.id(), now); err != nil {
+		return fmt.Errorf("failed to log %s action on %s %q: %w", action, target, targetID, err)
+	}
+
+	return nil
+}
+
+func (provider *providerImpl) ListDuplicateFlags(ctx context.Context, token string, reviewed bool, limit, offset int) ([]*models.ForumDuplicateFlag, int64, error) {
+	if _, err := provider.forceModerator(ctx, token, provider.time()); err != nil {
+		return nil, 0, err
+	}
+
+	flags, total, err := provider.duplicatesService.ListFlags(ctx, reviewed, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list duplicate flags: %w", err)
+	}
+
+	return flags, total, nil
+}
+
+func (provider *providerImpl) ReviewDuplicateFlag(ctx context.Context, token string, revisionID, originalRevisionID uuid.UUID) error {
+	now := provider.time()
+	claims, err := provider.forceModerator(ctx, token, now)
+	if err != nil {
+		return err
+	}
+
+	if err := provider.duplicatesService.Review(ctx, revisionID, originalRevisionID, claims.Payload.ID, now); err != nil {
+		return fmt.Errorf("failed to review duplicate flag: %w", err)
+	}
+
+	return nil
+}
+
+func (provider *providerImpl) ListVoteRingFlags(ctx context.Context, token string, reviewed bool, limit, offset int) ([]*models.VoteRingFlag, int64, error) {
+	if _, err := provider.forceModerator(ctx, token, provider.time()); err != nil {
+		return nil, 0, err
+	}
+
+	flags, total, err := provider.voteRingsService.ListFlags(ctx, reviewed, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list vote ring flags: %w", err)
+	}
+
+	return flags, total, nil
+}
+
+func (provider *providerImpl) ReviewVoteRingFlag(ctx context.Context, token string, authorID, voterID uuid.UUID, void bool) (*models.VoteRingFlag, error) {
+	now := provider.time()
+	claims, err := provider.forceModerator(ctx, token, now)
+	if err != nil {
+		return nil, err
+	}
+
+	flag, err := provider.voteRingsService.Review(ctx, authorID, voterID, claims.Payload.ID, void, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to review vote ring flag: %w", err)
+	}
+
+	if void {
+		note := fmt.Sprintf("voided %d votes on the posts of user %s", flag.Votes, authorID)
+		if err := provider.log(ctx, &claims.Payload.ID, models.ModerationActionVoidVotes, models.ModerationTargetProfile, voterID, note, now); err != nil {
+			return nil, err
+		}
+	}
+
+	return flag, nil
+}
+
+func (provider *providerImpl) DetectVoteRings(ctx context.Context, auth *authentication.BackendServiceAuth) error {
+	if err := authentication.ForceBackendService(ctx, auth); err != nil {
+		return err
+	}
+
+	if provider.voteRingMinVotes <= 0 {
+		return nil
+	}
+
+	now := provider.time()
+	if _, err := provider.voteRingsService.Detect(ctx, models.VoteRingDetection{
+		Since:         now.Add(-provider.voteRingWindow),
+		MaxAccountAge: provider.voteRingMaxAccountAge,
+		MinVotes:      provider.voteRingMinVotes,
+		MinVoters:     provider.voteRingMinVoters,
+	}, now); err != nil {
+		return fmt.Errorf("failed to detect voting rings: %w", err)
+	}
+
+	return nil
+}
+
+func (provider *providerImpl) LockImproveRequest(ctx context.Context, token string, requestID uuid.UUID, reason string) (*models.ImproveRequestThreadState, error) {
+	now := provider.time()
+	claims, err := provider.forceModerator(ctx, token, now)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := provider.improveRequestService.Read(ctx, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch improve request %q: %w", requestID, err)
+	}
+
+	state, err := provider.threadStateService.Update(
+		ctx, request.Source, models.ImproveRequestStateLocked, &claims.Payload.ID, reason, now,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock improve request %q: %w", request.Source, err)
+	}
+
+	if err := provider.log(ctx, &claims.Payload.ID, models.ModerationActionLock, models.ModerationTargetImproveRequest, request.Source, reason, now); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+func (provider *providerImpl) UnlockImproveRequest(ctx context.Context, token string, requestID uuid.UUID, reason string) (*models.ImproveRequestThreadState, error) {
+	now := provider.time()
+	claims, err := provider.forceModerator(ctx, token, now)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := provider.improveRequestService.Read(ctx, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch improve request %q: %w", requestID, err)
+	}
+
+	current, err := provider.threadStateService.Read(ctx, request.Source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read state of improve request %q: %w", request.Source, err)
+	}
+	if current.State != models.ImproveRequestStateLocked {
+		return nil, validation.NewErrInvalidEntity("state", fmt.Sprintf("improve request is %s, not locked", current.State))
+	}
+
+	state, err := provider.threadStateService.Update(
+		ctx, request.Source, models.ImproveRequestStateOpen, &claims.Payload.ID, reason, now,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unlock improve request %q: %w", request.Source, err)
+	}
+
+	if err := provider.log(ctx, &claims.Payload.ID, models.ModerationActionUnlock, models.ModerationTargetImproveRequest, request.Source, reason, now); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// Ensure the reported content exists, and return the ID the reports are attached to.
+func (provider *providerImpl) resolveReportTarget(ctx context.Context, target models.ModerationTarget, targetID uuid.UUID) (uuid.UUID, error) {
+	switch target {
+	case models.ModerationTargetImproveRequest:
+		// Reports apply to every revision of a request.
+		request, err := provider.improveRequestService.Read(ctx, targetID)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("failed to fetch improve request %q: %w", targetID, err)
+		}
+
+		return request.Source, nil
+	case models.ModerationTargetImproveSuggestion:
+		if _, err := provider.improveSuggestionService.Read(ctx, targetID); err != nil {
+			return uuid.Nil, fmt.Errorf("failed to fetch improve suggestion %q: %w", targetID, err)
+		}
+	case models.ModerationTargetProfile:
+		if _, err := provider.userService.GetPreview(ctx, targetID); err != nil {
+			return uuid.Nil, fmt.Errorf("failed to fetch user %q: %w", targetID, err)
+		}
+	default:
+		return uuid.Nil, reports_service.CheckTarget(target)
+	}
+
+	return targetID, nil
+}
+
+func (provider *providerImpl) ReportContent(ctx context.Context, token string, target models.ModerationTarget, targetID uuid.UUID, reason models.ReportReason, content string) error {
+	now := provider.time()
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+	if err != nil {
+		return err
+	}
+
+	targetID, err = provider.resolveReportTarget(ctx, target, targetID)
+	if err != nil {
+		return err
+	}
+
+	reportCase, err := provider.reportsService.Create(ctx, target, targetID, claims.Payload.ID, reason, content, provider.id(), now)
+	if err != nil {
+		return fmt.Errorf("failed to report %s %q: %w", target, targetID, err)
+	}
+
+	// Closed cases were already reviewed by a moderator.
+	open := reportCase.Status == models.ReportStatusPending || reportCase.Status == models.ReportStatusClaimed
+	if !open || reportCase.HiddenAt != nil || provider.autoHideReports <= 0 || reportCase.Reports < provider.autoHideReports {
+		return nil
+	}
+
+	if _, err := provider.reportsService.Hide(ctx, target, targetID, now); err != nil {
+		return fmt.Errorf("failed to hide %s %q: %w", target, targetID, err)
+	}
+
+	return provider.log(ctx, nil, models.ModerationActionHide, target, targetID, fmt.Sprintf("reported by %d users", reportCase.Reports), now)
+}
+
+func (provider *providerImpl) ListReportCases(ctx context.Context, token string, statuses []models.ReportStatus, limit, offset int) ([]*models.ForumReportCase, int64, error) {
+	if _, err := provider.forceModerator(ctx, token, provider.time()); err != nil {
+		return nil, 0, err
+	}
+
+	cases, total, err := provider.reportsService.ListCases(ctx, statuses, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list report cases: %w", err)
+	}
+
+	return cases, total, nil
+}
+
+func (provider *providerImpl) ListReports(ctx context.Context, token string, target models.ModerationTarget, targetID uuid.UUID) ([]*models.ForumReport, error) {
+	if _, err := provider.forceModerator(ctx, token, provider.time()); err != nil {
+		return nil, err
+	}
+
+	reports, err := provider.reportsService.ListReports(ctx, target, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reports of %s %q: %w", target, targetID, err)
+	}
+
+	return reports, nil
+}
+
+func (provider *providerImpl) ClaimReportCase(ctx context.Context, token string, target models.ModerationTarget, targetID uuid.UUID) (*models.ForumReportCase, error) {
+	now := provider.time()
+	claims, err := provider.forceModerator(ctx, token, now)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := provider.reportsService.ReadCase(ctx, target, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read report case of %s %q: %w", target, targetID, err)
+	}
+	if current.Status != models.ReportStatusPending {
+		return nil, validation.NewErrInvalidEntity("status", fmt.Sprintf("report case is %s, not pending", current.Status))
+	}
+
+	reportCase, err := provider.reportsService.Claim(ctx, target, targetID, claims.Payload.ID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim report case of %s %q: %w", target, targetID, err)
+	}
+
+	if err := provider.log(ctx, &claims.Payload.ID, models.ModerationActionClaim, target, targetID, "", now); err != nil {
+		return nil, err
+	}
+
+	return reportCase, nil
+}
+
+// Ensure a case is still open, and not claimed by another moderator.
+func (provider *providerImpl) forceOpenReportCase(ctx context.Context, target models.ModerationTarget, targetID, moderatorID uuid.UUID) error {
+	current, err := provider.reportsService.ReadCase(ctx, target, targetID)
+	if err != nil {
+		return fmt.Errorf("failed to read report case of %s %q: %w", target, targetID, err)
+	}
+
+	if current.Status != models.ReportStatusPending && current.Status != models.ReportStatusClaimed {
+		return validation.NewErrInvalidEntity("status", fmt.Sprintf("report case is already %s", current.Status))
+	}
+	if current.ClaimedBy != nil && *current.ClaimedBy != moderatorID {
+		return fmt.Errorf(
+			"%w: report case of %s %q is claimed by %q",
+			validation.ErrInvalidCredentials, target, targetID, *current.ClaimedBy,
+		)
+	}
+
+	return nil
+}
+
+func (provider *providerImpl) ResolveReportCase(ctx context.Context, token string, target models.ModerationTarget, targetID uuid.UUID, note string) (*models.ForumReportCase, error) {
+	now := provider.time()
+	claims, err := provider.forceModerator(ctx, token, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := provider.forceOpenReportCase(ctx, target, targetID, claims.Payload.ID); err != nil {
+		return nil, err
+	}
+
+	reportCase, err := provider.reportsService.Resolve(ctx, target, targetID, claims.Payload.ID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve report case of %s %q: %w", target, targetID, err)
+	}
+
+	if err := provider.log(ctx, &claims.Payload.ID, models.ModerationActionResolve, target, targetID, note, now); err != nil {
+		return nil, err
+	}
+
+	return reportCase, nil
+}
+
+func (provider *providerImpl) DismissReportCase(ctx context.Context, token string, target models.ModerationTarget, targetID uuid.UUID, note string) (*models.ForumReportCase, error) {
+	now := provider.time()
+	claims, err := provider.forceModerator(ctx, token, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := provider.forceOpenReportCase(ctx, target, targetID, claims.Payload.ID); err != nil {
+		return nil, err
+	}
+
+	reportCase, err := provider.reportsService.Dismiss(ctx, target, targetID, claims.Payload.ID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dismiss report case of %s %q: %w", target, targetID, err)
+	}
+
+	if err := provider.log(ctx, &claims.Payload.ID, models.ModerationActionDismiss, target, targetID, note, now); err != nil {
+		return nil, err
+	}
+
+	return reportCase, nil
+}
+
+func (provider *providerImpl) ListModerationActions(ctx context.Context, token string, query models.ModerationActionQuery, limit, offset int) ([]*models.ModerationAction, int64, error) {
+	if _, err := provider.forceModerator(ctx, token, provider.time()); err != nil {
+		return nil, 0, err
+	}
+
+	actions, total, err := provider.moderationLogService.List(ctx, query, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list moderation actions: %w", err)
+	}
+
+	return actions, total, nil
+}
@@ -0,0 +1,2129 @@
+package moderation
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"github.com/a-novel/agora-backend/domains/forum/service/duplicates"
+	"github.com/a-novel/agora-backend/domains/forum/service/improve_request"
+	"github.com/a-novel/agora-backend/domains/forum/service/improve_suggestion"
+	"github.com/a-novel/agora-backend/domains/forum/service/moderation_log"
+	"github.com/a-novel/agora-backend/domains/forum/service/reports"
+	"github.com/a-novel/agora-backend/domains/forum/service/thread_state"
+	"github.com/a-novel/agora-backend/domains/forum/service/vote_rings"
+	"github.com/a-novel/agora-backend/domains/keys/service/jwk"
+	"github.com/a-novel/agora-backend/domains/keys/storage/jwk"
+	"github.com/a-novel/agora-backend/domains/user/service/token"
+	user_service "github.com/a-novel/agora-backend/domains/user/service/user"
+	"github.com/a-novel/agora-backend/environment/user/authentication"
+	"github.com/a-novel/agora-backend/framework"
+	"github.com/a-novel/agora-backend/framework/test"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	fooErr   = errors.New("it broken")
+
+	moderatorToken = &models.UserToken{
+		Header: models.UserTokenHeader{
+			IAT: baseTime.Add(-time.Hour),
+			EXP: baseTime.Add(time.Hour),
+			ID:  test_utils.NumberUUID(100),
+		},
+		Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+	}
+)
+
+func TestModerationProvider_ListDuplicateFlags(t *testing.T) {
+	data := []struct {
+		name string
+
+		now  time.Time
+		keys []ed25519.PrivateKey
+
+		token    string
+		reviewed bool
+		limit    int
+		offset   int
+
+		shouldCallUserService       bool
+		shouldCallDuplicatesService bool
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+		hasAuthorization       bool
+		hasAuthorizationErr    error
+		duplicatesServiceData  []*models.ForumDuplicateFlag
+		duplicatesServiceTotal int64
+		duplicatesServiceErr   error
+
+		expect      []*models.ForumDuplicateFlag
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name:                        "Success",
+			now:                         baseTime,
+			keys:                        jwk_storage.MockedKeys,
+			token:                       "foo.bar.qux",
+			limit:                       10,
+			offset:                      20,
+			shouldCallUserService:       true,
+			shouldCallDuplicatesService: true,
+			hasAuthorization:            true,
+			tokenServiceDecodeData:      moderatorToken,
+			duplicatesServiceData: []*models.ForumDuplicateFlag{
+				{
+					CreatedAt:          baseTime,
+					Similarity:         0.8,
+					RevisionID:         test_utils.NumberUUID(1001),
+					PostID:             test_utils.NumberUUID(1000),
+					ThreadID:           test_utils.NumberUUID(1000),
+					UserID:             test_utils.NumberUUID(1),
+					Target:             models.ForumPostTargetImproveRequest,
+					OriginalRevisionID: test_utils.NumberUUID(2000),
+					OriginalPostID:     test_utils.NumberUUID(2000),
+					OriginalThreadID:   test_utils.NumberUUID(2000),
+					OriginalUserID:     test_utils.NumberUUID(2),
+					OriginalTarget:     models.ForumPostTargetImproveRequest,
+				},
+			},
+			duplicatesServiceTotal: 21,
+			expect: []*models.ForumDuplicateFlag{
+				{
+					CreatedAt:          baseTime,
+					Similarity:         0.8,
+					RevisionID:         test_utils.NumberUUID(1001),
+					PostID:             test_utils.NumberUUID(1000),
+					ThreadID:           test_utils.NumberUUID(1000),
+					UserID:             test_utils.NumberUUID(1),
+					Target:             models.ForumPostTargetImproveRequest,
+					OriginalRevisionID: test_utils.NumberUUID(2000),
+					OriginalPostID:     test_utils.NumberUUID(2000),
+					OriginalThreadID:   test_utils.NumberUUID(2000),
+					OriginalUserID:     test_utils.NumberUUID(2),
+					OriginalTarget:     models.ForumPostTargetImproveRequest,
+				},
+			},
+			expectTotal: 21,
+		},
+		{
+			name:                        "Error/DuplicatesServiceFailure",
+			now:                         baseTime,
+			keys:                        jwk_storage.MockedKeys,
+			token:                       "foo.bar.qux",
+			limit:                       10,
+			shouldCallUserService:       true,
+			shouldCallDuplicatesService: true,
+			hasAuthorization:            true,
+			tokenServiceDecodeData:      moderatorToken,
+			duplicatesServiceErr:        fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:                   "Error/NotModerator",
+			now:                    baseTime,
+			keys:                   jwk_storage.MockedKeys,
+			token:                  "foo.bar.qux",
+			limit:                  10,
+			shouldCallUserService:  true,
+			tokenServiceDecodeData: moderatorToken,
+			expectErr:              validation.ErrUnauthorized,
+		},
+		{
+			name:                   "Error/UserServiceFailure",
+			now:                    baseTime,
+			keys:                   jwk_storage.MockedKeys,
+			token:                  "foo.bar.qux",
+			limit:                  10,
+			shouldCallUserService:  true,
+			tokenServiceDecodeData: moderatorToken,
+			hasAuthorizationErr:    fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			now:                   baseTime,
+			keys:                  jwk_storage.MockedKeys,
+			token:                 "foo.bar.qux",
+			limit:                 10,
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			duplicatesService := duplicates_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+			userService := user_service.NewMockService(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(d.keys))
+			for i, key := range d.keys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, d.now).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallUserService {
+				userService.
+					On("HasAuthorizations", context.TODO(), d.tokenServiceDecodeData.Payload.ID, models.UserAuthorizations{
+						{models.UserAuthorizationsModerator},
+					}).
+					Return(d.hasAuthorization, d.hasAuthorizationErr)
+			}
+
+			if d.shouldCallDuplicatesService {
+				duplicatesService.
+					On("ListFlags", context.TODO(), d.reviewed, d.limit, d.offset).
+					Return(d.duplicatesServiceData, d.duplicatesServiceTotal, d.duplicatesServiceErr)
+			}
+
+			provider := NewProvider(Config{
+				DuplicatesService: duplicatesService,
+				TokenService:      tokenService,
+				KeysService:       keysService,
+				UserService:       userService,
+				Time:              test_utils.GetTimeNow(d.now),
+			})
+
+			res, total, err := provider.ListDuplicateFlags(context.TODO(), d.token, d.reviewed, d.limit, d.offset)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+			require.Equal(t, d.expectTotal, total)
+
+			duplicatesService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+			userService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestModerationProvider_ReviewDuplicateFlag(t *testing.T) {
+	data := []struct {
+		name string
+
+		now  time.Time
+		keys []ed25519.PrivateKey
+
+		token              string
+		revisionID         uuid.UUID
+		originalRevisionID uuid.UUID
+
+		shouldCallUserService       bool
+		shouldCallDuplicatesService bool
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+		hasAuthorization       bool
+		hasAuthorizationErr    error
+		duplicatesServiceErr   error
+
+		expectErr error
+	}{
+		{
+			name:                        "Success",
+			now:                         baseTime,
+			keys:                        jwk_storage.MockedKeys,
+			token:                       "foo.bar.qux",
+			revisionID:                  test_utils.NumberUUID(1001),
+			originalRevisionID:          test_utils.NumberUUID(2000),
+			shouldCallUserService:       true,
+			shouldCallDuplicatesService: true,
+			hasAuthorization:            true,
+			tokenServiceDecodeData:      moderatorToken,
+		},
+		{
+			name:                        "Error/DuplicatesServiceFailure",
+			now:                         baseTime,
+			keys:                        jwk_storage.MockedKeys,
+			token:                       "foo.bar.qux",
+			revisionID:                  test_utils.NumberUUID(1001),
+			originalRevisionID:          test_utils.NumberUUID(2000),
+			shouldCallUserService:       true,
+			shouldCallDuplicatesService: true,
+			hasAuthorization:            true,
+			tokenServiceDecodeData:      moderatorToken,
+			duplicatesServiceErr:        fooErr,
+			expectErr:                   fooErr,
+		},
+		{
+			name:                   "Error/NotModerator",
+			now:                    baseTime,
+			keys:                   jwk_storage.MockedKeys,
+			token:                  "foo.bar.qux",
+			revisionID:             test_utils.NumberUUID(1001),
+			originalRevisionID:     test_utils.NumberUUID(2000),
+			shouldCallUserService:  true,
+			tokenServiceDecodeData: moderatorToken,
+			expectErr:              validation.ErrUnauthorized,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			now:                   baseTime,
+			keys:                  jwk_storage.MockedKeys,
+			token:                 "foo.bar.qux",
+			revisionID:            test_utils.NumberUUID(1001),
+			originalRevisionID:    test_utils.NumberUUID(2000),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			duplicatesService := duplicates_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+			userService := user_service.NewMockService(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(d.keys))
+			for i, key := range d.keys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, d.now).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallUserService {
+				userService.
+					On("HasAuthorizations", context.TODO(), d.tokenServiceDecodeData.Payload.ID, models.UserAuthorizations{
+						{models.UserAuthorizationsModerator},
+					}).
+					Return(d.hasAuthorization, d.hasAuthorizationErr)
+			}
+
+			if d.shouldCallDuplicatesService {
+				duplicatesService.
+					On("Review", context.TODO(), d.revisionID, d.originalRevisionID, d.tokenServiceDecodeData.Payload.ID, d.now).
+					Return(d.duplicatesServiceErr)
+			}
+
+			provider := NewProvider(Config{
+				DuplicatesService: duplicatesService,
+				TokenService:      tokenService,
+				KeysService:       keysService,
+				UserService:       userService,
+				Time:              test_utils.GetTimeNow(d.now),
+			})
+
+			err := provider.ReviewDuplicateFlag(context.TODO(), d.token, d.revisionID, d.originalRevisionID)
+			test_utils.RequireError(t, d.expectErr, err)
+
+			duplicatesService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+			userService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestModerationProvider_ListVoteRingFlags(t *testing.T) {
+	data := []struct {
+		name string
+
+		token    string
+		reviewed bool
+		limit    int
+		offset   int
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+		hasAuthorization       bool
+
+		shouldCallUserService      bool
+		shouldCallVoteRingsService bool
+		voteRingsServiceData       []*models.VoteRingFlag
+		voteRingsServiceTotal      int64
+		voteRingsServiceErr        error
+
+		expect      []*models.VoteRingFlag
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name:                       "Success",
+			token:                      "foo.bar.qux",
+			reviewed:                   true,
+			limit:                      10,
+			offset:                     20,
+			tokenServiceDecodeData:     moderatorToken,
+			hasAuthorization:           true,
+			shouldCallUserService:      true,
+			shouldCallVoteRingsService: true,
+			voteRingsServiceData: []*models.VoteRingFlag{
+				{
+					AuthorID:   test_utils.NumberUUID(100),
+					VoterID:    test_utils.NumberUUID(200),
+					CreatedAt:  baseTime,
+					Votes:      3,
+					ReviewedAt: &baseTime,
+					ReviewedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+				},
+			},
+			voteRingsServiceTotal: 21,
+			expect: []*models.VoteRingFlag{
+				{
+					AuthorID:   test_utils.NumberUUID(100),
+					VoterID:    test_utils.NumberUUID(200),
+					CreatedAt:  baseTime,
+					Votes:      3,
+					ReviewedAt: &baseTime,
+					ReviewedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+				},
+			},
+			expectTotal: 21,
+		},
+		{
+			name:                       "Error/VoteRingsServiceFailure",
+			token:                      "foo.bar.qux",
+			limit:                      10,
+			tokenServiceDecodeData:     moderatorToken,
+			hasAuthorization:           true,
+			shouldCallUserService:      true,
+			shouldCallVoteRingsService: true,
+			voteRingsServiceErr:        fooErr,
+			expectErr:                  fooErr,
+		},
+		{
+			name:                   "Error/NotModerator",
+			token:                  "foo.bar.qux",
+			limit:                  10,
+			tokenServiceDecodeData: moderatorToken,
+			shouldCallUserService:  true,
+			expectErr:              validation.ErrUnauthorized,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			limit:                 10,
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			voteRingsService := vote_rings_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+			userService := user_service.NewMockService(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallUserService {
+				userService.
+					On("HasAuthorizations", context.TODO(), d.tokenServiceDecodeData.Payload.ID, models.UserAuthorizations{
+						{models.UserAuthorizationsModerator},
+					}).
+					Return(d.hasAuthorization, nil)
+			}
+
+			if d.shouldCallVoteRingsService {
+				voteRingsService.
+					On("ListFlags", context.TODO(), d.reviewed, d.limit, d.offset).
+					Return(d.voteRingsServiceData, d.voteRingsServiceTotal, d.voteRingsServiceErr)
+			}
+
+			provider := NewProvider(Config{
+				VoteRingsService: voteRingsService,
+				TokenService:     tokenService,
+				KeysService:      keysService,
+				UserService:      userService,
+				Time:             test_utils.GetTimeNow(baseTime),
+			})
+
+			res, total, err := provider.ListVoteRingFlags(context.TODO(), d.token, d.reviewed, d.limit, d.offset)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+			require.Equal(t, d.expectTotal, total)
+
+			voteRingsService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+			userService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestModerationProvider_ReviewVoteRingFlag(t *testing.T) {
+	data := []struct {
+		name string
+
+		token    string
+		authorID uuid.UUID
+		voterID  uuid.UUID
+		void     bool
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+		hasAuthorization       bool
+
+		shouldCallUserService      bool
+		shouldCallVoteRingsService bool
+		voteRingsServiceData       *models.VoteRingFlag
+		voteRingsServiceErr        error
+		shouldLog                  bool
+		logErr                     error
+
+		expect    *models.VoteRingFlag
+		expectErr error
+	}{
+		{
+			name:                       "Success",
+			token:                      "foo.bar.qux",
+			authorID:                   test_utils.NumberUUID(100),
+			voterID:                    test_utils.NumberUUID(200),
+			tokenServiceDecodeData:     moderatorToken,
+			hasAuthorization:           true,
+			shouldCallUserService:      true,
+			shouldCallVoteRingsService: true,
+			voteRingsServiceData: &models.VoteRingFlag{
+				AuthorID:   test_utils.NumberUUID(100),
+				VoterID:    test_utils.NumberUUID(200),
+				CreatedAt:  baseTime,
+				Votes:      3,
+				ReviewedAt: &baseTime,
+				ReviewedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+			},
+			expect: &models.VoteRingFlag{
+				AuthorID:   test_utils.NumberUUID(100),
+				VoterID:    test_utils.NumberUUID(200),
+				CreatedAt:  baseTime,
+				Votes:      3,
+				ReviewedAt: &baseTime,
+				ReviewedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+			},
+		},
+		{
+			name:                       "Success/Void",
+			token:                      "foo.bar.qux",
+			authorID:                   test_utils.NumberUUID(100),
+			voterID:                    test_utils.NumberUUID(200),
+			void:                       true,
+			tokenServiceDecodeData:     moderatorToken,
+			hasAuthorization:           true,
+			shouldCallUserService:      true,
+			shouldCallVoteRingsService: true,
+			voteRingsServiceData: &models.VoteRingFlag{
+				AuthorID:   test_utils.NumberUUID(100),
+				VoterID:    test_utils.NumberUUID(200),
+				CreatedAt:  baseTime,
+				Votes:      3,
+				ReviewedAt: &baseTime,
+				ReviewedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+				Voided:     true,
+			},
+			shouldLog: true,
+			expect: &models.VoteRingFlag{
+				AuthorID:   test_utils.NumberUUID(100),
+				VoterID:    test_utils.NumberUUID(200),
+				CreatedAt:  baseTime,
+				Votes:      3,
+				ReviewedAt: &baseTime,
+				ReviewedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+				Voided:     true,
+			},
+		},
+		{
+			name:                       "Error/ModerationLogFailure",
+			token:                      "foo.bar.qux",
+			authorID:                   test_utils.NumberUUID(100),
+			voterID:                    test_utils.NumberUUID(200),
+			void:                       true,
+			tokenServiceDecodeData:     moderatorToken,
+			hasAuthorization:           true,
+			shouldCallUserService:      true,
+			shouldCallVoteRingsService: true,
+			voteRingsServiceData: &models.VoteRingFlag{
+				AuthorID: test_utils.NumberUUID(100),
+				VoterID:  test_utils.NumberUUID(200),
+				Votes:    3,
+				Voided:   true,
+			},
+			shouldLog: true,
+			logErr:    fooErr,
+			expectErr: fooErr,
+		},
+		{
+			name:                       "Error/VoteRingsServiceFailure",
+			token:                      "foo.bar.qux",
+			authorID:                   test_utils.NumberUUID(100),
+			voterID:                    test_utils.NumberUUID(200),
+			void:                       true,
+			tokenServiceDecodeData:     moderatorToken,
+			hasAuthorization:           true,
+			shouldCallUserService:      true,
+			shouldCallVoteRingsService: true,
+			voteRingsServiceErr:        fooErr,
+			expectErr:                  fooErr,
+		},
+		{
+			name:                   "Error/NotModerator",
+			token:                  "foo.bar.qux",
+			authorID:               test_utils.NumberUUID(100),
+			voterID:                test_utils.NumberUUID(200),
+			tokenServiceDecodeData: moderatorToken,
+			shouldCallUserService:  true,
+			expectErr:              validation.ErrUnauthorized,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			authorID:              test_utils.NumberUUID(100),
+			voterID:               test_utils.NumberUUID(200),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			voteRingsService := vote_rings_service.NewMockService(t)
+			moderationLogService := moderation_log_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+			userService := user_service.NewMockService(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallUserService {
+				userService.
+					On("HasAuthorizations", context.TODO(), d.tokenServiceDecodeData.Payload.ID, models.UserAuthorizations{
+						{models.UserAuthorizationsModerator},
+					}).
+					Return(d.hasAuthorization, nil)
+			}
+
+			if d.shouldCallVoteRingsService {
+				voteRingsService.
+					On("Review", context.TODO(), d.authorID, d.voterID, d.tokenServiceDecodeData.Payload.ID, d.void, baseTime).
+					Return(d.voteRingsServiceData, d.voteRingsServiceErr)
+			}
+
+			if d.shouldLog {
+				moderationLogService.
+					On(
+						"Log", context.TODO(), &d.tokenServiceDecodeData.Payload.ID, models.ModerationActionVoidVotes,
+						models.ModerationTargetProfile, d.voterID,
+						"voided 3 votes on the posts of user "+d.authorID.String(),
+						test_utils.NumberUUID(1), baseTime,
+					).
+					Return(&models.ModerationAction{}, d.logErr)
+			}
+
+			provider := NewProvider(Config{
+				VoteRingsService:     voteRingsService,
+				ModerationLogService: moderationLogService,
+				TokenService:         tokenService,
+				KeysService:          keysService,
+				UserService:          userService,
+				Time:                 test_utils.GetTimeNow(baseTime),
+				ID:                   test_utils.GetUUID(test_utils.NumberUUID(1)),
+			})
+
+			res, err := provider.ReviewVoteRingFlag(context.TODO(), d.token, d.authorID, d.voterID, d.void)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			voteRingsService.AssertExpectations(t)
+			moderationLogService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+			userService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestModerationProvider_DetectVoteRings(t *testing.T) {
+	data := []struct {
+		name string
+
+		auth     *authentication.BackendServiceAuth
+		minVotes int
+
+		shouldCallService bool
+		detectErr         error
+
+		expectErr error
+	}{
+		{
+			name:              "Success",
+			minVotes:          3,
+			shouldCallService: true,
+		},
+		{
+			name: "Success/Disabled",
+		},
+		{
+			name: "Error/NotABackendService",
+			auth: &authentication.BackendServiceAuth{
+				UserAgent:    "curl/7.88.1",
+				AllowedUsers: []string{"scheduler@agora.com"},
+			},
+			minVotes:  3,
+			expectErr: validation.ErrInvalidCredentials,
+		},
+		{
+			name:              "Error/ServiceFailure",
+			minVotes:          3,
+			shouldCallService: true,
+			detectErr:         fooErr,
+			expectErr:         fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			voteRingsService := vote_rings_service.NewMockService(t)
+
+			if d.shouldCallService {
+				voteRingsService.
+					On("Detect", context.TODO(), models.VoteRingDetection{
+						Since:         baseTime.Add(-24 * time.Hour),
+						MaxAccountAge: 7 * 24 * time.Hour,
+						MinVotes:      d.minVotes,
+						MinVoters:     3,
+					}, baseTime).
+					Return([]*models.VoteRingFlag{}, d.detectErr)
+			}
+
+			provider := NewProvider(Config{
+				VoteRingsService:      voteRingsService,
+				VoteRingWindow:        24 * time.Hour,
+				VoteRingMaxAccountAge: 7 * 24 * time.Hour,
+				VoteRingMinVotes:      d.minVotes,
+				VoteRingMinVoters:     3,
+				Time:                  test_utils.GetTimeNow(baseTime),
+			})
+
+			err := provider.DetectVoteRings(context.TODO(), d.auth)
+			test_utils.RequireError(t, d.expectErr, err)
+
+			voteRingsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestModerationProvider_LockImproveRequest(t *testing.T) {
+	data := []struct {
+		name string
+
+		token     string
+		requestID uuid.UUID
+		reason    string
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+		hasAuthorization       bool
+
+		shouldCallUserService    bool
+		shouldCallImproveRequest bool
+		readErr                  error
+		shouldCallUpdate         bool
+		updateData               *models.ImproveRequestThreadState
+		updateErr                error
+		shouldLog                bool
+		logErr                   error
+
+		expect    *models.ImproveRequestThreadState
+		expectErr error
+	}{
+		{
+			name:                     "Success",
+			token:                    "foo.bar.qux",
+			requestID:                test_utils.NumberUUID(1001),
+			reason:                   "off-topic",
+			tokenServiceDecodeData:   moderatorToken,
+			hasAuthorization:         true,
+			shouldCallUserService:    true,
+			shouldCallImproveRequest: true,
+			shouldCallUpdate:         true,
+			shouldLog:                true,
+			updateData: &models.ImproveRequestThreadState{
+				Source:    test_utils.NumberUUID(1000),
+				State:     models.ImproveRequestStateLocked,
+				UpdatedAt: &baseTime,
+				UpdatedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+				Reason:    "off-topic",
+			},
+			expect: &models.ImproveRequestThreadState{
+				Source:    test_utils.NumberUUID(1000),
+				State:     models.ImproveRequestStateLocked,
+				UpdatedAt: &baseTime,
+				UpdatedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+				Reason:    "off-topic",
+			},
+		},
+		{
+			name:                     "Error/ModerationLogFailure",
+			token:                    "foo.bar.qux",
+			requestID:                test_utils.NumberUUID(1001),
+			tokenServiceDecodeData:   moderatorToken,
+			hasAuthorization:         true,
+			shouldCallUserService:    true,
+			shouldCallImproveRequest: true,
+			shouldCallUpdate:         true,
+			updateData: &models.ImproveRequestThreadState{
+				Source: test_utils.NumberUUID(1000),
+				State:  models.ImproveRequestStateLocked,
+			},
+			shouldLog: true,
+			logErr:    fooErr,
+			expectErr: fooErr,
+		},
+		{
+			name:                     "Error/ThreadStateServiceFailure",
+			token:                    "foo.bar.qux",
+			requestID:                test_utils.NumberUUID(1001),
+			tokenServiceDecodeData:   moderatorToken,
+			hasAuthorization:         true,
+			shouldCallUserService:    true,
+			shouldCallImproveRequest: true,
+			shouldCallUpdate:         true,
+			updateErr:                fooErr,
+			expectErr:                fooErr,
+		},
+		{
+			name:                     "Error/ImproveRequestServiceFailure",
+			token:                    "foo.bar.qux",
+			requestID:                test_utils.NumberUUID(1001),
+			tokenServiceDecodeData:   moderatorToken,
+			hasAuthorization:         true,
+			shouldCallUserService:    true,
+			shouldCallImproveRequest: true,
+			readErr:                  fooErr,
+			expectErr:                fooErr,
+		},
+		{
+			name:                   "Error/NotModerator",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(1001),
+			tokenServiceDecodeData: moderatorToken,
+			shouldCallUserService:  true,
+			expectErr:              validation.ErrUnauthorized,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			requestID:             test_utils.NumberUUID(1001),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			threadStateService := thread_state_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+			userService := user_service.NewMockService(t)
+			moderationLogService := moderation_log_service.NewMockService(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallUserService {
+				userService.
+					On("HasAuthorizations", context.TODO(), d.tokenServiceDecodeData.Payload.ID, models.UserAuthorizations{
+						{models.UserAuthorizationsModerator},
+					}).
+					Return(d.hasAuthorization, nil)
+			}
+
+			if d.shouldCallImproveRequest {
+				improveRequestService.
+					On("Read", context.TODO(), d.requestID).
+					Return(&models.ImproveRequest{ID: d.requestID, Source: test_utils.NumberUUID(1000)}, d.readErr)
+			}
+
+			if d.shouldCallUpdate {
+				threadStateService.
+					On(
+						"Update", context.TODO(), test_utils.NumberUUID(1000), models.ImproveRequestStateLocked,
+						&d.tokenServiceDecodeData.Payload.ID, d.reason, baseTime,
+					).
+					Return(d.updateData, d.updateErr)
+			}
+
+			if d.shouldLog {
+				moderationLogService.
+					On(
+						"Log", context.TODO(), &d.tokenServiceDecodeData.Payload.ID, models.ModerationActionLock,
+						models.ModerationTargetImproveRequest, test_utils.NumberUUID(1000), d.reason,
+						test_utils.NumberUUID(1), baseTime,
+					).
+					Return(&models.ModerationAction{}, d.logErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService: improveRequestService,
+				ThreadStateService:    threadStateService,
+				TokenService:          tokenService,
+				KeysService:           keysService,
+				ModerationLogService:  moderationLogService,
+				UserService:           userService,
+				Time:                  test_utils.GetTimeNow(baseTime),
+				ID:                    test_utils.GetUUID(test_utils.NumberUUID(1)),
+			})
+
+			res, err := provider.LockImproveRequest(context.TODO(), d.token, d.requestID, d.reason)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveRequestService.AssertExpectations(t)
+			threadStateService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+			userService.AssertExpectations(t)
+			moderationLogService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestModerationProvider_UnlockImproveRequest(t *testing.T) {
+	data := []struct {
+		name string
+
+		token     string
+		requestID uuid.UUID
+		reason    string
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+		hasAuthorization       bool
+
+		shouldCallUserService    bool
+		shouldCallImproveRequest bool
+		readErr                  error
+		shouldReadState          bool
+		currentState             models.ImproveRequestState
+		shouldCallUpdate         bool
+		updateData               *models.ImproveRequestThreadState
+		updateErr                error
+		shouldLog                bool
+		logErr                   error
+
+		expect    *models.ImproveRequestThreadState
+		expectErr error
+	}{
+		{
+			name:                     "Success",
+			token:                    "foo.bar.qux",
+			requestID:                test_utils.NumberUUID(1001),
+			reason:                   "cleaned up",
+			tokenServiceDecodeData:   moderatorToken,
+			hasAuthorization:         true,
+			shouldCallUserService:    true,
+			shouldCallImproveRequest: true,
+			shouldReadState:          true,
+			currentState:             models.ImproveRequestStateLocked,
+			shouldCallUpdate:         true,
+			shouldLog:                true,
+			updateData: &models.ImproveRequestThreadState{
+				Source:    test_utils.NumberUUID(1000),
+				State:     models.ImproveRequestStateOpen,
+				UpdatedAt: &baseTime,
+				UpdatedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+				Reason:    "cleaned up",
+			},
+			expect: &models.ImproveRequestThreadState{
+				Source:    test_utils.NumberUUID(1000),
+				State:     models.ImproveRequestStateOpen,
+				UpdatedAt: &baseTime,
+				UpdatedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+				Reason:    "cleaned up",
+			},
+		},
+		{
+			name:                     "Error/NotLocked",
+			token:                    "foo.bar.qux",
+			requestID:                test_utils.NumberUUID(1001),
+			tokenServiceDecodeData:   moderatorToken,
+			hasAuthorization:         true,
+			shouldCallUserService:    true,
+			shouldCallImproveRequest: true,
+			shouldReadState:          true,
+			currentState:             models.ImproveRequestStateArchived,
+			expectErr:                validation.ErrInvalidEntity,
+		},
+		{
+			name:                     "Error/ModerationLogFailure",
+			token:                    "foo.bar.qux",
+			requestID:                test_utils.NumberUUID(1001),
+			tokenServiceDecodeData:   moderatorToken,
+			hasAuthorization:         true,
+			shouldCallUserService:    true,
+			shouldCallImproveRequest: true,
+			shouldReadState:          true,
+			currentState:             models.ImproveRequestStateLocked,
+			shouldCallUpdate:         true,
+			updateData: &models.ImproveRequestThreadState{
+				Source: test_utils.NumberUUID(1000),
+				State:  models.ImproveRequestStateOpen,
+			},
+			shouldLog: true,
+			logErr:    fooErr,
+			expectErr: fooErr,
+		},
+		{
+			name:                     "Error/ThreadStateServiceFailure",
+			token:                    "foo.bar.qux",
+			requestID:                test_utils.NumberUUID(1001),
+			tokenServiceDecodeData:   moderatorToken,
+			hasAuthorization:         true,
+			shouldCallUserService:    true,
+			shouldCallImproveRequest: true,
+			shouldReadState:          true,
+			currentState:             models.ImproveRequestStateLocked,
+			shouldCallUpdate:         true,
+			updateErr:                fooErr,
+			expectErr:                fooErr,
+		},
+		{
+			name:                   "Error/NotModerator",
+			token:                  "foo.bar.qux",
+			requestID:              test_utils.NumberUUID(1001),
+			tokenServiceDecodeData: moderatorToken,
+			shouldCallUserService:  true,
+			expectErr:              validation.ErrUnauthorized,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			requestID:             test_utils.NumberUUID(1001),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			threadStateService := thread_state_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+			userService := user_service.NewMockService(t)
+			moderationLogService := moderation_log_service.NewMockService(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallUserService {
+				userService.
+					On("HasAuthorizations", context.TODO(), d.tokenServiceDecodeData.Payload.ID, models.UserAuthorizations{
+						{models.UserAuthorizationsModerator},
+					}).
+					Return(d.hasAuthorization, nil)
+			}
+
+			if d.shouldCallImproveRequest {
+				improveRequestService.
+					On("Read", context.TODO(), d.requestID).
+					Return(&models.ImproveRequest{ID: d.requestID, Source: test_utils.NumberUUID(1000)}, d.readErr)
+			}
+
+			if d.shouldReadState {
+				threadStateService.
+					On("Read", context.TODO(), test_utils.NumberUUID(1000)).
+					Return(&models.ImproveRequestThreadState{Source: test_utils.NumberUUID(1000), State: d.currentState}, nil)
+			}
+
+			if d.shouldCallUpdate {
+				threadStateService.
+					On(
+						"Update", context.TODO(), test_utils.NumberUUID(1000), models.ImproveRequestStateOpen,
+						&d.tokenServiceDecodeData.Payload.ID, d.reason, baseTime,
+					).
+					Return(d.updateData, d.updateErr)
+			}
+
+			if d.shouldLog {
+				moderationLogService.
+					On(
+						"Log", context.TODO(), &d.tokenServiceDecodeData.Payload.ID, models.ModerationActionUnlock,
+						models.ModerationTargetImproveRequest, test_utils.NumberUUID(1000), d.reason,
+						test_utils.NumberUUID(1), baseTime,
+					).
+					Return(&models.ModerationAction{}, d.logErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService: improveRequestService,
+				ThreadStateService:    threadStateService,
+				TokenService:          tokenService,
+				KeysService:           keysService,
+				ModerationLogService:  moderationLogService,
+				UserService:           userService,
+				Time:                  test_utils.GetTimeNow(baseTime),
+				ID:                    test_utils.GetUUID(test_utils.NumberUUID(1)),
+			})
+
+			res, err := provider.UnlockImproveRequest(context.TODO(), d.token, d.requestID, d.reason)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			improveRequestService.AssertExpectations(t)
+			threadStateService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+			userService.AssertExpectations(t)
+			moderationLogService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestModerationProvider_ReportContent(t *testing.T) {
+	data := []struct {
+		name string
+
+		token    string
+		target   models.ModerationTarget
+		targetID uuid.UUID
+		reason   models.ReportReason
+		content  string
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+
+		shouldReadRequest    bool
+		shouldReadSuggestion bool
+		shouldReadProfile    bool
+		readErr              error
+
+		expectTargetID uuid.UUID
+		shouldCreate   bool
+		createData     *models.ForumReportCase
+		createErr      error
+		shouldHide     bool
+		hideErr        error
+		shouldLog      bool
+		logErr         error
+
+		expectErr error
+	}{
+		{
+			name:                   "Success/ImproveRequest",
+			token:                  "foo.bar.qux",
+			target:                 models.ModerationTargetImproveRequest,
+			targetID:               test_utils.NumberUUID(1001),
+			reason:                 models.ReportReasonSpam,
+			tokenServiceDecodeData: moderatorToken,
+			shouldReadRequest:      true,
+			expectTargetID:         test_utils.NumberUUID(1000),
+			shouldCreate:           true,
+			createData: &models.ForumReportCase{
+				Target:   models.ModerationTargetImproveRequest,
+				TargetID: test_utils.NumberUUID(1000),
+				Status:   models.ReportStatusPending,
+				Reports:  1,
+			},
+		},
+		{
+			name:                   "Success/AutoHide",
+			token:                  "foo.bar.qux",
+			target:                 models.ModerationTargetImproveSuggestion,
+			targetID:               test_utils.NumberUUID(2000),
+			reason:                 models.ReportReasonOther,
+			content:                "copied from another post",
+			tokenServiceDecodeData: moderatorToken,
+			shouldReadSuggestion:   true,
+			expectTargetID:         test_utils.NumberUUID(2000),
+			shouldCreate:           true,
+			createData: &models.ForumReportCase{
+				Target:   models.ModerationTargetImproveSuggestion,
+				TargetID: test_utils.NumberUUID(2000),
+				Status:   models.ReportStatusClaimed,
+				Reports:  3,
+			},
+			shouldHide: true,
+			shouldLog:  true,
+		},
+		{
+			name:                   "Success/AlreadyHidden",
+			token:                  "foo.bar.qux",
+			target:                 models.ModerationTargetProfile,
+			targetID:               test_utils.NumberUUID(300),
+			reason:                 models.ReportReasonHarassment,
+			tokenServiceDecodeData: moderatorToken,
+			shouldReadProfile:      true,
+			expectTargetID:         test_utils.NumberUUID(300),
+			shouldCreate:           true,
+			createData: &models.ForumReportCase{
+				Target:   models.ModerationTargetProfile,
+				TargetID: test_utils.NumberUUID(300),
+				Status:   models.ReportStatusPending,
+				Reports:  4,
+				HiddenAt: &baseTime,
+			},
+		},
+		{
+			name:                   "Success/ClosedCase",
+			token:                  "foo.bar.qux",
+			target:                 models.ModerationTargetProfile,
+			targetID:               test_utils.NumberUUID(300),
+			reason:                 models.ReportReasonHarassment,
+			tokenServiceDecodeData: moderatorToken,
+			shouldReadProfile:      true,
+			expectTargetID:         test_utils.NumberUUID(300),
+			shouldCreate:           true,
+			createData: &models.ForumReportCase{
+				Target:   models.ModerationTargetProfile,
+				TargetID: test_utils.NumberUUID(300),
+				Status:   models.ReportStatusResolved,
+				Reports:  4,
+			},
+		},
+		{
+			name:                   "Error/LogFailure",
+			token:                  "foo.bar.qux",
+			target:                 models.ModerationTargetImproveSuggestion,
+			targetID:               test_utils.NumberUUID(2000),
+			reason:                 models.ReportReasonSpam,
+			tokenServiceDecodeData: moderatorToken,
+			shouldReadSuggestion:   true,
+			expectTargetID:         test_utils.NumberUUID(2000),
+			shouldCreate:           true,
+			createData: &models.ForumReportCase{
+				Target:   models.ModerationTargetImproveSuggestion,
+				TargetID: test_utils.NumberUUID(2000),
+				Status:   models.ReportStatusPending,
+				Reports:  3,
+			},
+			shouldHide: true,
+			shouldLog:  true,
+			logErr:     fooErr,
+			expectErr:  fooErr,
+		},
+		{
+			name:                   "Error/HideFailure",
+			token:                  "foo.bar.qux",
+			target:                 models.ModerationTargetImproveSuggestion,
+			targetID:               test_utils.NumberUUID(2000),
+			reason:                 models.ReportReasonSpam,
+			tokenServiceDecodeData: moderatorToken,
+			shouldReadSuggestion:   true,
+			expectTargetID:         test_utils.NumberUUID(2000),
+			shouldCreate:           true,
+			createData: &models.ForumReportCase{
+				Target:   models.ModerationTargetImproveSuggestion,
+				TargetID: test_utils.NumberUUID(2000),
+				Status:   models.ReportStatusPending,
+				Reports:  3,
+			},
+			shouldHide: true,
+			hideErr:    fooErr,
+			expectErr:  fooErr,
+		},
+		{
+			name:                   "Error/ReportsServiceFailure",
+			token:                  "foo.bar.qux",
+			target:                 models.ModerationTargetImproveRequest,
+			targetID:               test_utils.NumberUUID(1001),
+			reason:                 models.ReportReasonSpam,
+			tokenServiceDecodeData: moderatorToken,
+			shouldReadRequest:      true,
+			expectTargetID:         test_utils.NumberUUID(1000),
+			shouldCreate:           true,
+			createErr:              fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                   "Error/TargetNotFound",
+			token:                  "foo.bar.qux",
+			target:                 models.ModerationTargetImproveSuggestion,
+			targetID:               test_utils.NumberUUID(2000),
+			reason:                 models.ReportReasonSpam,
+			tokenServiceDecodeData: moderatorToken,
+			shouldReadSuggestion:   true,
+			readErr:                validation.ErrNotFound,
+			expectErr:              validation.ErrNotFound,
+		},
+		{
+			name:                   "Error/UnknownTarget",
+			token:                  "foo.bar.qux",
+			target:                 "comment",
+			targetID:               test_utils.NumberUUID(2000),
+			reason:                 models.ReportReasonSpam,
+			tokenServiceDecodeData: moderatorToken,
+			expectErr:              validation.ErrInvalidEntity,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			target:                models.ModerationTargetImproveRequest,
+			targetID:              test_utils.NumberUUID(1001),
+			reason:                models.ReportReasonSpam,
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			improveRequestService := improve_request_service.NewMockService(t)
+			improveSuggestionService := improve_suggestion_service.NewMockService(t)
+			reportsService := reports_service.NewMockService(t)
+			moderationLogService := moderation_log_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+			userService := user_service.NewMockService(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldReadRequest {
+				improveRequestService.
+					On("Read", context.TODO(), d.targetID).
+					Return(&models.ImproveRequest{ID: d.targetID, Source: test_utils.NumberUUID(1000)}, d.readErr)
+			}
+			if d.shouldReadSuggestion {
+				improveSuggestionService.
+					On("Read", context.TODO(), d.targetID).
+					Return(&models.ImproveSuggestion{ID: d.targetID}, d.readErr)
+			}
+			if d.shouldReadProfile {
+				userService.
+					On("GetPreview", context.TODO(), d.targetID).
+					Return(&models.UserPreview{}, d.readErr)
+			}
+
+			if d.shouldCreate {
+				reportsService.
+					On(
+						"Create", context.TODO(), d.target, d.expectTargetID, d.tokenServiceDecodeData.Payload.ID,
+						d.reason, d.content, test_utils.NumberUUID(1), baseTime,
+					).
+					Return(d.createData, d.createErr)
+			}
+
+			if d.shouldHide {
+				reportsService.
+					On("Hide", context.TODO(), d.target, d.expectTargetID, baseTime).
+					Return(&models.ForumReportCase{}, d.hideErr)
+			}
+
+			if d.shouldLog {
+				moderationLogService.
+					On(
+						"Log", context.TODO(), (*uuid.UUID)(nil), models.ModerationActionHide, d.target, d.expectTargetID,
+						"reported by 3 users", test_utils.NumberUUID(1), baseTime,
+					).
+					Return(&models.ModerationAction{}, d.logErr)
+			}
+
+			provider := NewProvider(Config{
+				ImproveRequestService:    improveRequestService,
+				ImproveSuggestionService: improveSuggestionService,
+				ReportsService:           reportsService,
+				ModerationLogService:     moderationLogService,
+				TokenService:             tokenService,
+				KeysService:              keysService,
+				UserService:              userService,
+				AutoHideReports:          3,
+				Time:                     test_utils.GetTimeNow(baseTime),
+				ID:                       test_utils.GetUUID(test_utils.NumberUUID(1)),
+			})
+
+			err := provider.ReportContent(context.TODO(), d.token, d.target, d.targetID, d.reason, d.content)
+			test_utils.RequireError(t, d.expectErr, err)
+
+			improveRequestService.AssertExpectations(t)
+			improveSuggestionService.AssertExpectations(t)
+			reportsService.AssertExpectations(t)
+			moderationLogService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+			userService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestModerationProvider_ListReportCases(t *testing.T) {
+	data := []struct {
+		name string
+
+		token    string
+		statuses []models.ReportStatus
+		limit    int
+		offset   int
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+		hasAuthorization       bool
+
+		shouldCallUserService    bool
+		shouldCallReportsService bool
+		listData                 []*models.ForumReportCase
+		listTotal                int64
+		listErr                  error
+
+		expect      []*models.ForumReportCase
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name:                     "Success",
+			token:                    "foo.bar.qux",
+			statuses:                 []models.ReportStatus{models.ReportStatusPending},
+			limit:                    10,
+			offset:                   20,
+			tokenServiceDecodeData:   moderatorToken,
+			hasAuthorization:         true,
+			shouldCallUserService:    true,
+			shouldCallReportsService: true,
+			listData: []*models.ForumReportCase{
+				{
+					Target:    models.ModerationTargetProfile,
+					TargetID:  test_utils.NumberUUID(300),
+					Status:    models.ReportStatusPending,
+					Reports:   2,
+					CreatedAt: baseTime,
+				},
+			},
+			listTotal: 21,
+			expect: []*models.ForumReportCase{
+				{
+					Target:    models.ModerationTargetProfile,
+					TargetID:  test_utils.NumberUUID(300),
+					Status:    models.ReportStatusPending,
+					Reports:   2,
+					CreatedAt: baseTime,
+				},
+			},
+			expectTotal: 21,
+		},
+		{
+			name:                     "Error/ReportsServiceFailure",
+			token:                    "foo.bar.qux",
+			limit:                    10,
+			tokenServiceDecodeData:   moderatorToken,
+			hasAuthorization:         true,
+			shouldCallUserService:    true,
+			shouldCallReportsService: true,
+			listErr:                  fooErr,
+			expectErr:                fooErr,
+		},
+		{
+			name:                   "Error/NotModerator",
+			token:                  "foo.bar.qux",
+			limit:                  10,
+			tokenServiceDecodeData: moderatorToken,
+			shouldCallUserService:  true,
+			expectErr:              validation.ErrUnauthorized,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			limit:                 10,
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			reportsService := reports_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+			userService := user_service.NewMockService(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallUserService {
+				userService.
+					On("HasAuthorizations", context.TODO(), d.tokenServiceDecodeData.Payload.ID, models.UserAuthorizations{
+						{models.UserAuthorizationsModerator},
+					}).
+					Return(d.hasAuthorization, nil)
+			}
+
+			if d.shouldCallReportsService {
+				reportsService.
+					On("ListCases", context.TODO(), d.statuses, d.limit, d.offset).
+					Return(d.listData, d.listTotal, d.listErr)
+			}
+
+			provider := NewProvider(Config{
+				ReportsService: reportsService,
+				TokenService:   tokenService,
+				KeysService:    keysService,
+				UserService:    userService,
+				Time:           test_utils.GetTimeNow(baseTime),
+			})
+
+			res, total, err := provider.ListReportCases(context.TODO(), d.token, d.statuses, d.limit, d.offset)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+			require.Equal(t, d.expectTotal, total)
+
+			reportsService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+			userService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestModerationProvider_ClaimReportCase(t *testing.T) {
+	data := []struct {
+		name string
+
+		token    string
+		target   models.ModerationTarget
+		targetID uuid.UUID
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+		hasAuthorization       bool
+
+		shouldCallUserService bool
+		shouldReadCase        bool
+		currentStatus         models.ReportStatus
+		readCaseErr           error
+		shouldClaim           bool
+		claimData             *models.ForumReportCase
+		claimErr              error
+		shouldLog             bool
+		logErr                error
+
+		expect    *models.ForumReportCase
+		expectErr error
+	}{
+		{
+			name:                   "Success",
+			token:                  "foo.bar.qux",
+			target:                 models.ModerationTargetImproveRequest,
+			targetID:               test_utils.NumberUUID(1000),
+			tokenServiceDecodeData: moderatorToken,
+			hasAuthorization:       true,
+			shouldCallUserService:  true,
+			shouldReadCase:         true,
+			currentStatus:          models.ReportStatusPending,
+			shouldClaim:            true,
+			claimData: &models.ForumReportCase{
+				Target:    models.ModerationTargetImproveRequest,
+				TargetID:  test_utils.NumberUUID(1000),
+				Status:    models.ReportStatusClaimed,
+				ClaimedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+				ClaimedAt: &baseTime,
+			},
+			shouldLog: true,
+			expect: &models.ForumReportCase{
+				Target:    models.ModerationTargetImproveRequest,
+				TargetID:  test_utils.NumberUUID(1000),
+				Status:    models.ReportStatusClaimed,
+				ClaimedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+				ClaimedAt: &baseTime,
+			},
+		},
+		{
+			name:                   "Error/LogFailure",
+			token:                  "foo.bar.qux",
+			target:                 models.ModerationTargetImproveRequest,
+			targetID:               test_utils.NumberUUID(1000),
+			tokenServiceDecodeData: moderatorToken,
+			hasAuthorization:       true,
+			shouldCallUserService:  true,
+			shouldReadCase:         true,
+			currentStatus:          models.ReportStatusPending,
+			shouldClaim:            true,
+			claimData:              &models.ForumReportCase{},
+			shouldLog:              true,
+			logErr:                 fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                   "Error/ClaimFailure",
+			token:                  "foo.bar.qux",
+			target:                 models.ModerationTargetImproveRequest,
+			targetID:               test_utils.NumberUUID(1000),
+			tokenServiceDecodeData: moderatorToken,
+			hasAuthorization:       true,
+			shouldCallUserService:  true,
+			shouldReadCase:         true,
+			currentStatus:          models.ReportStatusPending,
+			shouldClaim:            true,
+			claimErr:               fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                   "Error/NotPending",
+			token:                  "foo.bar.qux",
+			target:                 models.ModerationTargetImproveRequest,
+			targetID:               test_utils.NumberUUID(1000),
+			tokenServiceDecodeData: moderatorToken,
+			hasAuthorization:       true,
+			shouldCallUserService:  true,
+			shouldReadCase:         true,
+			currentStatus:          models.ReportStatusClaimed,
+			expectErr:              validation.ErrInvalidEntity,
+		},
+		{
+			name:                   "Error/ReadCaseFailure",
+			token:                  "foo.bar.qux",
+			target:                 models.ModerationTargetImproveRequest,
+			targetID:               test_utils.NumberUUID(1000),
+			tokenServiceDecodeData: moderatorToken,
+			hasAuthorization:       true,
+			shouldCallUserService:  true,
+			shouldReadCase:         true,
+			readCaseErr:            validation.ErrNotFound,
+			expectErr:              validation.ErrNotFound,
+		},
+		{
+			name:                   "Error/NotModerator",
+			token:                  "foo.bar.qux",
+			target:                 models.ModerationTargetImproveRequest,
+			targetID:               test_utils.NumberUUID(1000),
+			tokenServiceDecodeData: moderatorToken,
+			shouldCallUserService:  true,
+			expectErr:              validation.ErrUnauthorized,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			target:                models.ModerationTargetImproveRequest,
+			targetID:              test_utils.NumberUUID(1000),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			reportsService := reports_service.NewMockService(t)
+			moderationLogService := moderation_log_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+			userService := user_service.NewMockService(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallUserService {
+				userService.
+					On("HasAuthorizations", context.TODO(), d.tokenServiceDecodeData.Payload.ID, models.UserAuthorizations{
+						{models.UserAuthorizationsModerator},
+					}).
+					Return(d.hasAuthorization, nil)
+			}
+
+			if d.shouldReadCase {
+				reportsService.
+					On("ReadCase", context.TODO(), d.target, d.targetID).
+					Return(&models.ForumReportCase{Target: d.target, TargetID: d.targetID, Status: d.currentStatus}, d.readCaseErr)
+			}
+
+			if d.shouldClaim {
+				reportsService.
+					On("Claim", context.TODO(), d.target, d.targetID, d.tokenServiceDecodeData.Payload.ID, baseTime).
+					Return(d.claimData, d.claimErr)
+			}
+
+			if d.shouldLog {
+				moderationLogService.
+					On(
+						"Log", context.TODO(), &d.tokenServiceDecodeData.Payload.ID, models.ModerationActionClaim,
+						d.target, d.targetID, "", test_utils.NumberUUID(1), baseTime,
+					).
+					Return(&models.ModerationAction{}, d.logErr)
+			}
+
+			provider := NewProvider(Config{
+				ReportsService:       reportsService,
+				ModerationLogService: moderationLogService,
+				TokenService:         tokenService,
+				KeysService:          keysService,
+				UserService:          userService,
+				Time:                 test_utils.GetTimeNow(baseTime),
+				ID:                   test_utils.GetUUID(test_utils.NumberUUID(1)),
+			})
+
+			res, err := provider.ClaimReportCase(context.TODO(), d.token, d.target, d.targetID)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			reportsService.AssertExpectations(t)
+			moderationLogService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+			userService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestModerationProvider_CloseReportCase(t *testing.T) {
+	data := []struct {
+		name string
+
+		token    string
+		dismiss  bool
+		target   models.ModerationTarget
+		targetID uuid.UUID
+		note     string
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+		hasAuthorization       bool
+
+		shouldCallUserService bool
+		shouldReadCase        bool
+		currentCase           *models.ForumReportCase
+		shouldClose           bool
+		closeData             *models.ForumReportCase
+		closeErr              error
+		shouldLog             bool
+		logErr                error
+
+		expect    *models.ForumReportCase
+		expectErr error
+	}{
+		{
+			name:                   "Resolve/Success",
+			token:                  "foo.bar.qux",
+			target:                 models.ModerationTargetImproveSuggestion,
+			targetID:               test_utils.NumberUUID(2000),
+			note:                   "spam link",
+			tokenServiceDecodeData: moderatorToken,
+			hasAuthorization:       true,
+			shouldCallUserService:  true,
+			shouldReadCase:         true,
+			currentCase: &models.ForumReportCase{
+				Status:    models.ReportStatusClaimed,
+				ClaimedBy: framework.ToPTR(test_utils.NumberUUID(10)),
+			},
+			shouldClose: true,
+			closeData: &models.ForumReportCase{
+				Target:   models.ModerationTargetImproveSuggestion,
+				TargetID: test_utils.NumberUUID(2000),
+				Status:   models.ReportStatusResolved,
+				HiddenAt: &baseTime,
+			},
+			shouldLog: true,
+			expect: &models.ForumReportCase{
+				Target:   models.ModerationTargetImproveSuggestion,
+				TargetID: test_utils.NumberUUID(2000),
+				Status:   models.ReportStatusResolved,
+				HiddenAt: &baseTime,
+			},
+		},
+		{
+			name:                   "Dismiss/Success",
+			token:                  "foo.bar.qux",
+			dismiss:                true,
+			target:                 models.ModerationTargetProfile,
+			targetID:               test_utils.NumberUUID(300),
+			tokenServiceDecodeData: moderatorToken,
+			hasAuthorization:       true,
+			shouldCallUserService:  true,
+			shouldReadCase:         true,
+			currentCase:            &models.ForumReportCase{Status: models.ReportStatusPending},
+			shouldClose:            true,
+			closeData: &models.ForumReportCase{
+				Target:   models.ModerationTargetProfile,
+				TargetID: test_utils.NumberUUID(300),
+				Status:   models.ReportStatusDismissed,
+			},
+			shouldLog: true,
+			expect: &models.ForumReportCase{
+				Target:   models.ModerationTargetProfile,
+				TargetID: test_utils.NumberUUID(300),
+				Status:   models.ReportStatusDismissed,
+			},
+		},
+		{
+			name:                   "Error/LogFailure",
+			token:                  "foo.bar.qux",
+			target:                 models.ModerationTargetProfile,
+			targetID:               test_utils.NumberUUID(300),
+			tokenServiceDecodeData: moderatorToken,
+			hasAuthorization:       true,
+			shouldCallUserService:  true,
+			shouldReadCase:         true,
+			currentCase:            &models.ForumReportCase{Status: models.ReportStatusPending},
+			shouldClose:            true,
+			closeData:              &models.ForumReportCase{},
+			shouldLog:              true,
+			logErr:                 fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                   "Error/ReportsServiceFailure",
+			token:                  "foo.bar.qux",
+			dismiss:                true,
+			target:                 models.ModerationTargetProfile,
+			targetID:               test_utils.NumberUUID(300),
+			tokenServiceDecodeData: moderatorToken,
+			hasAuthorization:       true,
+			shouldCallUserService:  true,
+			shouldReadCase:         true,
+			currentCase:            &models.ForumReportCase{Status: models.ReportStatusPending},
+			shouldClose:            true,
+			closeErr:               fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                   "Error/ClaimedByAnotherModerator",
+			token:                  "foo.bar.qux",
+			target:                 models.ModerationTargetProfile,
+			targetID:               test_utils.NumberUUID(300),
+			tokenServiceDecodeData: moderatorToken,
+			hasAuthorization:       true,
+			shouldCallUserService:  true,
+			shouldReadCase:         true,
+			currentCase: &models.ForumReportCase{
+				Status:    models.ReportStatusClaimed,
+				ClaimedBy: framework.ToPTR(test_utils.NumberUUID(11)),
+			},
+			expectErr: validation.ErrInvalidCredentials,
+		},
+		{
+			name:                   "Error/AlreadyClosed",
+			token:                  "foo.bar.qux",
+			dismiss:                true,
+			target:                 models.ModerationTargetProfile,
+			targetID:               test_utils.NumberUUID(300),
+			tokenServiceDecodeData: moderatorToken,
+			hasAuthorization:       true,
+			shouldCallUserService:  true,
+			shouldReadCase:         true,
+			currentCase:            &models.ForumReportCase{Status: models.ReportStatusResolved},
+			expectErr:              validation.ErrInvalidEntity,
+		},
+		{
+			name:                   "Error/NotModerator",
+			token:                  "foo.bar.qux",
+			target:                 models.ModerationTargetProfile,
+			targetID:               test_utils.NumberUUID(300),
+			tokenServiceDecodeData: moderatorToken,
+			shouldCallUserService:  true,
+			expectErr:              validation.ErrUnauthorized,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			target:                models.ModerationTargetProfile,
+			targetID:              test_utils.NumberUUID(300),
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			reportsService := reports_service.NewMockService(t)
+			moderationLogService := moderation_log_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+			userService := user_service.NewMockService(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallUserService {
+				userService.
+					On("HasAuthorizations", context.TODO(), d.tokenServiceDecodeData.Payload.ID, models.UserAuthorizations{
+						{models.UserAuthorizationsModerator},
+					}).
+					Return(d.hasAuthorization, nil)
+			}
+
+			if d.shouldReadCase {
+				reportsService.
+					On("ReadCase", context.TODO(), d.target, d.targetID).
+					Return(d.currentCase, nil)
+			}
+
+			method, action := "Resolve", models.ModerationActionResolve
+			if d.dismiss {
+				method, action = "Dismiss", models.ModerationActionDismiss
+			}
+
+			if d.shouldClose {
+				reportsService.
+					On(method, context.TODO(), d.target, d.targetID, d.tokenServiceDecodeData.Payload.ID, baseTime).
+					Return(d.closeData, d.closeErr)
+			}
+
+			if d.shouldLog {
+				moderationLogService.
+					On(
+						"Log", context.TODO(), &d.tokenServiceDecodeData.Payload.ID, action,
+						d.target, d.targetID, d.note, test_utils.NumberUUID(1), baseTime,
+					).
+					Return(&models.ModerationAction{}, d.logErr)
+			}
+
+			provider := NewProvider(Config{
+				ReportsService:       reportsService,
+				ModerationLogService: moderationLogService,
+				TokenService:         tokenService,
+				KeysService:          keysService,
+				UserService:          userService,
+				Time:                 test_utils.GetTimeNow(baseTime),
+				ID:                   test_utils.GetUUID(test_utils.NumberUUID(1)),
+			})
+
+			var (
+				res *models.ForumReportCase
+				err error
+			)
+			if d.dismiss {
+				res, err = provider.DismissReportCase(context.TODO(), d.token, d.target, d.targetID, d.note)
+			} else {
+				res, err = provider.ResolveReportCase(context.TODO(), d.token, d.target, d.targetID, d.note)
+			}
+
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			reportsService.AssertExpectations(t)
+			moderationLogService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+			userService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestModerationProvider_ListModerationActions(t *testing.T) {
+	data := []struct {
+		name string
+
+		token  string
+		query  models.ModerationActionQuery
+		limit  int
+		offset int
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+		hasAuthorization       bool
+
+		shouldCallUserService bool
+		shouldCallLogService  bool
+		listData              []*models.ModerationAction
+		listTotal             int64
+		listErr               error
+
+		expect      []*models.ModerationAction
+		expectTotal int64
+		expectErr   error
+	}{
+		{
+			name:                   "Success",
+			token:                  "foo.bar.qux",
+			query:                  models.ModerationActionQuery{ModeratorID: framework.ToPTR(test_utils.NumberUUID(11))},
+			limit:                  10,
+			tokenServiceDecodeData: moderatorToken,
+			hasAuthorization:       true,
+			shouldCallUserService:  true,
+			shouldCallLogService:   true,
+			listData: []*models.ModerationAction{
+				{
+					ID:          test_utils.NumberUUID(1),
+					CreatedAt:   baseTime,
+					ModeratorID: framework.ToPTR(test_utils.NumberUUID(11)),
+					Action:      models.ModerationActionLock,
+					Target:      models.ModerationTargetImproveRequest,
+					TargetID:    test_utils.NumberUUID(1000),
+				},
+			},
+			listTotal: 1,
+			expect: []*models.ModerationAction{
+				{
+					ID:          test_utils.NumberUUID(1),
+					CreatedAt:   baseTime,
+					ModeratorID: framework.ToPTR(test_utils.NumberUUID(11)),
+					Action:      models.ModerationActionLock,
+					Target:      models.ModerationTargetImproveRequest,
+					TargetID:    test_utils.NumberUUID(1000),
+				},
+			},
+			expectTotal: 1,
+		},
+		{
+			name:                   "Error/ModerationLogServiceFailure",
+			token:                  "foo.bar.qux",
+			limit:                  10,
+			tokenServiceDecodeData: moderatorToken,
+			hasAuthorization:       true,
+			shouldCallUserService:  true,
+			shouldCallLogService:   true,
+			listErr:                fooErr,
+			expectErr:              fooErr,
+		},
+		{
+			name:                   "Error/NotModerator",
+			token:                  "foo.bar.qux",
+			limit:                  10,
+			tokenServiceDecodeData: moderatorToken,
+			shouldCallUserService:  true,
+			expectErr:              validation.ErrUnauthorized,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			token:                 "foo.bar.qux",
+			limit:                 10,
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			moderationLogService := moderation_log_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+			userService := user_service.NewMockService(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(jwk_storage.MockedKeys))
+			for i, key := range jwk_storage.MockedKeys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, baseTime).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallUserService {
+				userService.
+					On("HasAuthorizations", context.TODO(), d.tokenServiceDecodeData.Payload.ID, models.UserAuthorizations{
+						{models.UserAuthorizationsModerator},
+					}).
+					Return(d.hasAuthorization, nil)
+			}
+
+			if d.shouldCallLogService {
+				moderationLogService.
+					On("List", context.TODO(), d.query, d.limit, d.offset).
+					Return(d.listData, d.listTotal, d.listErr)
+			}
+
+			provider := NewProvider(Config{
+				ModerationLogService: moderationLogService,
+				TokenService:         tokenService,
+				KeysService:          keysService,
+				UserService:          userService,
+				Time:                 test_utils.GetTimeNow(baseTime),
+			})
+
+			res, total, err := provider.ListModerationActions(context.TODO(), d.token, d.query, d.limit, d.offset)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+			require.Equal(t, d.expectTotal, total)
+
+			moderationLogService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+			userService.AssertExpectations(t)
+		})
+	}
+}
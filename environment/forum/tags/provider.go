@@ -0,0 +1,174 @@
+package tags
+
+import (
+	"context"
+	"fmt"
+	"github.com/a-novel/agora-backend/domains/forum/service/tags"
+	"github.com/a-novel/agora-backend/domains/keys/service/jwk"
+	"github.com/a-novel/agora-backend/domains/user/service/reputation"
+	"github.com/a-novel/agora-backend/domains/user/service/token"
+	user_service "github.com/a-novel/agora-backend/domains/user/service/user"
+	"github.com/a-novel/agora-backend/environment/user/authentication"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"time"
+)
+
+type Provider interface {
+	ListTags(ctx context.Context, category *models.ForumTagCategory) ([]*models.ForumTag, error)
+	SearchTags(ctx context.Context, query string, category *models.ForumTagCategory, limit int) ([]*models.ForumTag, error)
+
+	// CreateTag is restricted to moderators, and to users with enough reputation.
+	CreateTag(ctx context.Context, token string, data *models.ForumTagCreate) (*models.ForumTag, error)
+	// DeleteTag is restricted to moderators.
+	DeleteTag(ctx context.Context, token string, id uuid.UUID) error
+}
+
+type Config struct {
+	TagsService       tags_service.Service
+	TokenService      token_service.Service
+	KeysService       jwk_service.ServiceCached
+	UserService       user_service.Service
+	ReputationService reputation_service.Service
+
+	// CreateTagReputation is the reputation a user needs to create tags, without being a moderator. 0 restricts
+	// tag creation to moderators.
+	CreateTagReputation int64
+
+	Time func() time.Time
+	ID   func() uuid.UUID
+}
+
+type providerImpl struct {
+	tagsService       tags_service.Service
+	tokenService      token_service.Service
+	keysService       jwk_service.ServiceCached
+	userService       user_service.Service
+	reputationService reputation_service.Service
+
+	createTagReputation int64
+
+	time func() time.Time
+	id   func() uuid.UUID
+}
+
+func NewProvider(config Config) Provider {
+	return &providerImpl{
+		tagsService:       config.TagsService,
+		tokenService:      config.TokenService,
+		keysService:       config.KeysService,
+		userService:       config.UserService,
+		reputationService: config.ReputationService,
+
+		createTagReputation: config.CreateTagReputation,
+
+		time: config.Time,
+		id:   config.ID,
+	}
+}
+
+func (provider *providerImpl) isModerator(ctx context.Context, userID uuid.UUID) (bool, error) {
+	ok, err := provider.userService.HasAuthorizations(ctx, userID, models.UserAuthorizations{
+		{models.UserAuthorizationsModerator},
+	})
+	if err != nil {
+		return false, fmt.Errorf("unable to check user authorizations: %w", err)
+	}
+
+	return ok, nil
+}
+
+// Ensure the token belongs to a moderator.
+func (provider *providerImpl) forceModerator(ctx context.Context, token string, now time.Time) error {
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+	if err != nil {
+		return err
+	}
+
+	ok, err := provider.isModerator(ctx, claims.Payload.ID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return validation.NewErrUnauthorized("user is not a moderator")
+	}
+
+	return nil
+}
+
+// Ensure the token belongs to a moderator, or to a user with enough reputation to create tags.
+func (provider *providerImpl) forceTagCreator(ctx context.Context, token string, now time.Time) error {
+	claims, err := authentication.ForceAuthentication(token, provider.tokenService, provider.keysService, now)
+	if err != nil {
+		return err
+	}
+
+	ok, err := provider.isModerator(ctx, claims.Payload.ID)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return nil
+	}
+	if provider.createTagReputation == 0 {
+		return validation.NewErrUnauthorized("user is not a moderator")
+	}
+
+	reputation, err := provider.reputationService.Read(ctx, claims.Payload.ID)
+	if err != nil {
+		return fmt.Errorf("failed to read reputation of user %q: %w", claims.Payload.ID, err)
+	}
+
+	if reputation < provider.createTagReputation {
+		return validation.NewErrUnauthorized(
+			fmt.Sprintf("user %q needs a reputation of %d to create tags", claims.Payload.ID, provider.createTagReputation),
+		)
+	}
+
+	return nil
+}
+
+func (provider *providerImpl) ListTags(ctx context.Context, category *models.ForumTagCategory) ([]*models.ForumTag, error) {
+	tags, err := provider.tagsService.List(ctx, category)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+func (provider *providerImpl) SearchTags(ctx context.Context, query string, category *models.ForumTagCategory, limit int) ([]*models.ForumTag, error) {
+	tags, err := provider.tagsService.Search(ctx, query, category, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+func (provider *providerImpl) CreateTag(ctx context.Context, token string, data *models.ForumTagCreate) (*models.ForumTag, error) {
+	now := provider.time()
+	if err := provider.forceTagCreator(ctx, token, now); err != nil {
+		return nil, err
+	}
+
+	tag, err := provider.tagsService.Create(ctx, data, provider.id(), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	return tag, nil
+}
+
+func (provider *providerImpl) DeleteTag(ctx context.Context, token string, id uuid.UUID) error {
+	if err := provider.forceModerator(ctx, token, provider.time()); err != nil {
+		return err
+	}
+
+	if err := provider.tagsService.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete tag %q: %w", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,600 @@
+package tags
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"github.com/a-novel/agora-backend/domains/forum/service/tags"
+	"github.com/a-novel/agora-backend/domains/keys/service/jwk"
+	"github.com/a-novel/agora-backend/domains/keys/storage/jwk"
+	"github.com/a-novel/agora-backend/domains/user/service/reputation"
+	"github.com/a-novel/agora-backend/domains/user/service/token"
+	user_service "github.com/a-novel/agora-backend/domains/user/service/user"
+	"github.com/a-novel/agora-backend/framework"
+	"github.com/a-novel/agora-backend/framework/test"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/a-novel/agora-backend/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var (
+	baseTime = time.Date(2020, time.May, 4, 8, 0, 0, 0, time.UTC)
+	fooErr   = errors.New("it broken")
+)
+
+func TestTagsProvider_ListTags(t *testing.T) {
+	data := []struct {
+		name string
+
+		category *models.ForumTagCategory
+
+		serviceData []*models.ForumTag
+		serviceErr  error
+
+		expect    []*models.ForumTag
+		expectErr error
+	}{
+		{
+			name:     "Success",
+			category: framework.ToPTR(models.ForumTagCategoryGenre),
+			serviceData: []*models.ForumTag{
+				{
+					ID:        test_utils.NumberUUID(1),
+					CreatedAt: baseTime,
+					Category:  models.ForumTagCategoryGenre,
+					Slug:      "fantasy",
+					Name:      "Fantasy",
+				},
+			},
+			expect: []*models.ForumTag{
+				{
+					ID:        test_utils.NumberUUID(1),
+					CreatedAt: baseTime,
+					Category:  models.ForumTagCategoryGenre,
+					Slug:      "fantasy",
+					Name:      "Fantasy",
+				},
+			},
+		},
+		{
+			name:       "Error/ServiceFailure",
+			serviceErr: fooErr,
+			expectErr:  fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			tagsService := tags_service.NewMockService(t)
+
+			tagsService.
+				On("List", context.TODO(), d.category).
+				Return(d.serviceData, d.serviceErr)
+
+			provider := NewProvider(Config{
+				TagsService: tagsService,
+			})
+
+			res, err := provider.ListTags(context.TODO(), d.category)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			tagsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTagsProvider_SearchTags(t *testing.T) {
+	data := []struct {
+		name string
+
+		query    string
+		category *models.ForumTagCategory
+		limit    int
+
+		serviceData []*models.ForumTag
+		serviceErr  error
+
+		expect    []*models.ForumTag
+		expectErr error
+	}{
+		{
+			name:  "Success",
+			query: "fan",
+			limit: 10,
+			serviceData: []*models.ForumTag{
+				{
+					ID:        test_utils.NumberUUID(1),
+					CreatedAt: baseTime,
+					Category:  models.ForumTagCategoryGenre,
+					Slug:      "fantasy",
+					Name:      "Fantasy",
+				},
+			},
+			expect: []*models.ForumTag{
+				{
+					ID:        test_utils.NumberUUID(1),
+					CreatedAt: baseTime,
+					Category:  models.ForumTagCategoryGenre,
+					Slug:      "fantasy",
+					Name:      "Fantasy",
+				},
+			},
+		},
+		{
+			name:       "Error/ServiceFailure",
+			query:      "fan",
+			limit:      10,
+			serviceErr: fooErr,
+			expectErr:  fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			tagsService := tags_service.NewMockService(t)
+
+			tagsService.
+				On("Search", context.TODO(), d.query, d.category, d.limit).
+				Return(d.serviceData, d.serviceErr)
+
+			provider := NewProvider(Config{
+				TagsService: tagsService,
+			})
+
+			res, err := provider.SearchTags(context.TODO(), d.query, d.category, d.limit)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			tagsService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTagsProvider_CreateTag(t *testing.T) {
+	data := []struct {
+		name string
+
+		now  time.Time
+		keys []ed25519.PrivateKey
+		id   uuid.UUID
+
+		token string
+		data  *models.ForumTagCreate
+
+		createTagReputation int64
+
+		shouldCallUserService       bool
+		shouldCallReputationService bool
+		shouldCallTagsService       bool
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+		hasAuthorization       bool
+		hasAuthorizationErr    error
+		reputationData         int64
+		reputationErr          error
+		tagsServiceData        *models.ForumTag
+		tagsServiceErr         error
+
+		expect    *models.ForumTag
+		expectErr error
+	}{
+		{
+			name:  "Success",
+			now:   baseTime,
+			keys:  jwk_storage.MockedKeys,
+			id:    test_utils.NumberUUID(1),
+			token: "foo.bar.qux",
+			data: &models.ForumTagCreate{
+				Category: models.ForumTagCategoryGenre,
+				Slug:     "fantasy",
+				Name:     "Fantasy",
+			},
+			shouldCallUserService: true,
+			shouldCallTagsService: true,
+			hasAuthorization:      true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			tagsServiceData: &models.ForumTag{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				Category:  models.ForumTagCategoryGenre,
+				Slug:      "fantasy",
+				Name:      "Fantasy",
+			},
+			expect: &models.ForumTag{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				Category:  models.ForumTagCategoryGenre,
+				Slug:      "fantasy",
+				Name:      "Fantasy",
+			},
+		},
+		{
+			name:  "Success/Reputation",
+			now:   baseTime,
+			keys:  jwk_storage.MockedKeys,
+			id:    test_utils.NumberUUID(1),
+			token: "foo.bar.qux",
+			data: &models.ForumTagCreate{
+				Category: models.ForumTagCategoryGenre,
+				Slug:     "fantasy",
+				Name:     "Fantasy",
+			},
+			createTagReputation:         500,
+			shouldCallUserService:       true,
+			shouldCallReputationService: true,
+			shouldCallTagsService:       true,
+			reputationData:              500,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			tagsServiceData: &models.ForumTag{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				Category:  models.ForumTagCategoryGenre,
+				Slug:      "fantasy",
+				Name:      "Fantasy",
+			},
+			expect: &models.ForumTag{
+				ID:        test_utils.NumberUUID(1),
+				CreatedAt: baseTime,
+				Category:  models.ForumTagCategoryGenre,
+				Slug:      "fantasy",
+				Name:      "Fantasy",
+			},
+		},
+		{
+			name:  "Error/TagsServiceFailure",
+			now:   baseTime,
+			keys:  jwk_storage.MockedKeys,
+			id:    test_utils.NumberUUID(1),
+			token: "foo.bar.qux",
+			data: &models.ForumTagCreate{
+				Category: models.ForumTagCategoryGenre,
+				Slug:     "fantasy",
+				Name:     "Fantasy",
+			},
+			shouldCallUserService: true,
+			shouldCallTagsService: true,
+			hasAuthorization:      true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			tagsServiceErr: fooErr,
+			expectErr:      fooErr,
+		},
+		{
+			name:  "Error/NotModerator",
+			now:   baseTime,
+			keys:  jwk_storage.MockedKeys,
+			id:    test_utils.NumberUUID(1),
+			token: "foo.bar.qux",
+			data: &models.ForumTagCreate{
+				Category: models.ForumTagCategoryGenre,
+				Slug:     "fantasy",
+				Name:     "Fantasy",
+			},
+			shouldCallUserService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			expectErr: validation.ErrUnauthorized,
+		},
+		{
+			name:  "Error/NotEnoughReputation",
+			now:   baseTime,
+			keys:  jwk_storage.MockedKeys,
+			id:    test_utils.NumberUUID(1),
+			token: "foo.bar.qux",
+			data: &models.ForumTagCreate{
+				Category: models.ForumTagCategoryGenre,
+				Slug:     "fantasy",
+				Name:     "Fantasy",
+			},
+			createTagReputation:         500,
+			shouldCallUserService:       true,
+			shouldCallReputationService: true,
+			reputationData:              499,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			expectErr: validation.ErrUnauthorized,
+		},
+		{
+			name:  "Error/ReputationServiceFailure",
+			now:   baseTime,
+			keys:  jwk_storage.MockedKeys,
+			id:    test_utils.NumberUUID(1),
+			token: "foo.bar.qux",
+			data: &models.ForumTagCreate{
+				Category: models.ForumTagCategoryGenre,
+				Slug:     "fantasy",
+				Name:     "Fantasy",
+			},
+			createTagReputation:         500,
+			shouldCallUserService:       true,
+			shouldCallReputationService: true,
+			reputationErr:               fooErr,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			expectErr: fooErr,
+		},
+		{
+			name:  "Error/UserServiceFailure",
+			now:   baseTime,
+			keys:  jwk_storage.MockedKeys,
+			id:    test_utils.NumberUUID(1),
+			token: "foo.bar.qux",
+			data: &models.ForumTagCreate{
+				Category: models.ForumTagCategoryGenre,
+				Slug:     "fantasy",
+				Name:     "Fantasy",
+			},
+			shouldCallUserService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			hasAuthorizationErr: fooErr,
+			expectErr:           fooErr,
+		},
+		{
+			name:  "Error/TokenServiceFailure",
+			now:   baseTime,
+			keys:  jwk_storage.MockedKeys,
+			id:    test_utils.NumberUUID(1),
+			token: "foo.bar.qux",
+			data: &models.ForumTagCreate{
+				Category: models.ForumTagCategoryGenre,
+				Slug:     "fantasy",
+				Name:     "Fantasy",
+			},
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			tagsService := tags_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+			userService := user_service.NewMockService(t)
+			reputationService := reputation_service.NewMockService(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(d.keys))
+			for i, key := range d.keys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, d.now).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallUserService {
+				userService.
+					On("HasAuthorizations", context.TODO(), d.tokenServiceDecodeData.Payload.ID, models.UserAuthorizations{
+						{models.UserAuthorizationsModerator},
+					}).
+					Return(d.hasAuthorization, d.hasAuthorizationErr)
+			}
+
+			if d.shouldCallReputationService {
+				reputationService.
+					On("Read", context.TODO(), d.tokenServiceDecodeData.Payload.ID).
+					Return(d.reputationData, d.reputationErr)
+			}
+
+			if d.shouldCallTagsService {
+				tagsService.
+					On("Create", context.TODO(), d.data, d.id, d.now).
+					Return(d.tagsServiceData, d.tagsServiceErr)
+			}
+
+			provider := NewProvider(Config{
+				TagsService:         tagsService,
+				TokenService:        tokenService,
+				KeysService:         keysService,
+				UserService:         userService,
+				ReputationService:   reputationService,
+				CreateTagReputation: d.createTagReputation,
+				Time:                test_utils.GetTimeNow(d.now),
+				ID:                  test_utils.GetUUID(d.id),
+			})
+
+			res, err := provider.CreateTag(context.TODO(), d.token, d.data)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, res)
+
+			tagsService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+			userService.AssertExpectations(t)
+			reputationService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTagsProvider_DeleteTag(t *testing.T) {
+	data := []struct {
+		name string
+
+		now  time.Time
+		keys []ed25519.PrivateKey
+		id   uuid.UUID
+
+		token string
+
+		shouldCallUserService bool
+		shouldCallTagsService bool
+
+		tokenServiceDecodeData *models.UserToken
+		tokenServiceDecodeErr  error
+		hasAuthorization       bool
+		hasAuthorizationErr    error
+		tagsServiceErr         error
+
+		expectErr error
+	}{
+		{
+			name:                  "Success",
+			now:                   baseTime,
+			keys:                  jwk_storage.MockedKeys,
+			id:                    test_utils.NumberUUID(1),
+			token:                 "foo.bar.qux",
+			shouldCallUserService: true,
+			shouldCallTagsService: true,
+			hasAuthorization:      true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+		},
+		{
+			name:                  "Error/TagsServiceFailure",
+			now:                   baseTime,
+			keys:                  jwk_storage.MockedKeys,
+			id:                    test_utils.NumberUUID(1),
+			token:                 "foo.bar.qux",
+			shouldCallUserService: true,
+			shouldCallTagsService: true,
+			hasAuthorization:      true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			tagsServiceErr: fooErr,
+			expectErr:      fooErr,
+		},
+		{
+			name:                  "Error/NotModerator",
+			now:                   baseTime,
+			keys:                  jwk_storage.MockedKeys,
+			id:                    test_utils.NumberUUID(1),
+			token:                 "foo.bar.qux",
+			shouldCallUserService: true,
+			tokenServiceDecodeData: &models.UserToken{
+				Header: models.UserTokenHeader{
+					IAT: baseTime.Add(-time.Hour),
+					EXP: baseTime.Add(time.Hour),
+					ID:  test_utils.NumberUUID(100),
+				},
+				Payload: models.UserTokenPayload{ID: test_utils.NumberUUID(10)},
+			},
+			expectErr: validation.ErrUnauthorized,
+		},
+		{
+			name:                  "Error/TokenServiceFailure",
+			now:                   baseTime,
+			keys:                  jwk_storage.MockedKeys,
+			id:                    test_utils.NumberUUID(1),
+			token:                 "foo.bar.qux",
+			tokenServiceDecodeErr: fooErr,
+			expectErr:             fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			tagsService := tags_service.NewMockService(t)
+			tokenService := token_service.NewMockService(t)
+			keysService := jwk_service.NewMockServiceCached(t)
+			userService := user_service.NewMockService(t)
+
+			publicKeys := make([]ed25519.PublicKey, len(d.keys))
+			for i, key := range d.keys {
+				publicKeys[i] = key.Public().(ed25519.PublicKey)
+			}
+
+			keysService.
+				On("ListPublic").
+				Return(publicKeys)
+
+			tokenService.
+				On("Decode", d.token, publicKeys, d.now).
+				Return(d.tokenServiceDecodeData, d.tokenServiceDecodeErr)
+
+			if d.shouldCallUserService {
+				userService.
+					On("HasAuthorizations", context.TODO(), d.tokenServiceDecodeData.Payload.ID, mock.Anything).
+					Return(d.hasAuthorization, d.hasAuthorizationErr)
+			}
+
+			if d.shouldCallTagsService {
+				tagsService.
+					On("Delete", context.TODO(), d.id).
+					Return(d.tagsServiceErr)
+			}
+
+			provider := NewProvider(Config{
+				TagsService:  tagsService,
+				TokenService: tokenService,
+				KeysService:  keysService,
+				UserService:  userService,
+				Time:         test_utils.GetTimeNow(d.now),
+			})
+
+			err := provider.DeleteTag(context.TODO(), d.token, d.id)
+			test_utils.RequireError(t, d.expectErr, err)
+
+			tagsService.AssertExpectations(t)
+			tokenService.AssertExpectations(t)
+			keysService.AssertExpectations(t)
+			userService.AssertExpectations(t)
+		})
+	}
+}
@@ -25,6 +25,16 @@ func ForceAuthentication(token string, service token_service.Service, keys jwk_s
 	return claims, nil
 }
 
+// OptionalAuthentication verifies the given token, if any. It returns nil claims for anonymous users, and an error
+// if the token is not valid.
+func OptionalAuthentication(token string, service token_service.Service, keys jwk_service.ServiceCached, now time.Time) (*models.UserToken, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	return ForceAuthentication(token, service, keys, now)
+}
+
 type BackendServiceAuth struct {
 	UserAgent     string
 	Authorization string
@@ -13,13 +13,16 @@ type Model struct {
 	LastName  string     `json:"lastName"`
 	CreatedAt time.Time  `json:"createdAt"`
 	Sex       models.Sex `json:"sex"`
+	// Badges earned by the user on the forum, in the order they were awarded.
+	Badges []*models.UserBadge `json:"badges"`
 }
 
 type Preview struct {
-	ID        uuid.UUID `json:"id"`
-	Slug      string    `json:"slug"`
-	Username  string    `json:"username"`
-	FirstName string    `json:"firstName"`
-	LastName  string    `json:"lastName"`
-	CreatedAt time.Time `json:"createdAt"`
+	ID         uuid.UUID `json:"id"`
+	Slug       string    `json:"slug"`
+	Username   string    `json:"username"`
+	FirstName  string    `json:"firstName"`
+	LastName   string    `json:"lastName"`
+	CreatedAt  time.Time `json:"createdAt"`
+	Reputation int64     `json:"reputation"`
 }
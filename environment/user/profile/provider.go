@@ -2,27 +2,33 @@ package profile
 
 import (
 	"context"
+	"github.com/a-novel/agora-backend/domains/forum/service/badges"
 	"github.com/a-novel/agora-backend/domains/user/service/user"
+	"github.com/a-novel/agora-backend/models"
 	"github.com/google/uuid"
 )
 
 type Config struct {
-	UserService user_service.Service
+	UserService   user_service.Service
+	BadgesService badges_service.Service
 }
 
 type Provider interface {
 	Read(ctx context.Context, slug string) (*Model, error)
 	Search(ctx context.Context, query string, limit, offset int) ([]*Preview, int64, error)
+	SearchAfter(ctx context.Context, query, cursor string, limit int) ([]*Preview, string, error)
 	Previews(ctx context.Context, ids []uuid.UUID) ([]*Preview, error)
 }
 
 type providerImpl struct {
-	userService user_service.Service
+	userService   user_service.Service
+	badgesService badges_service.Service
 }
 
 func NewProvider(cfg Config) Provider {
 	return &providerImpl{
-		userService: cfg.UserService,
+		userService:   cfg.UserService,
+		badgesService: cfg.BadgesService,
 	}
 }
 
@@ -32,6 +38,11 @@ func (provider *providerImpl) Read(ctx context.Context, slug string) (*Model, er
 		return nil, err
 	}
 
+	badges, err := provider.badgesService.List(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Model{
 		ID:        user.ID,
 		Username:  user.Username,
@@ -39,6 +50,7 @@ func (provider *providerImpl) Read(ctx context.Context, slug string) (*Model, er
 		LastName:  user.LastName,
 		CreatedAt: user.CreatedAt,
 		Sex:       user.Sex,
+		Badges:    badges,
 	}, nil
 }
 
@@ -48,19 +60,16 @@ func (provider *providerImpl) Search(ctx context.Context, query string, limit, o
 		return nil, 0, err
 	}
 
-	profiles := make([]*Preview, 0, len(users))
-	for _, user := range users {
-		profiles = append(profiles, &Preview{
-			ID:        user.ID,
-			Slug:      user.Slug,
-			Username:  user.Username,
-			FirstName: user.FirstName,
-			LastName:  user.LastName,
-			CreatedAt: user.CreatedAt,
-		})
+	return publicPreviewsToPreviews(users), count, nil
+}
+
+func (provider *providerImpl) SearchAfter(ctx context.Context, query, cursor string, limit int) ([]*Preview, string, error) {
+	users, next, err := provider.userService.SearchAfter(ctx, query, cursor, limit)
+	if err != nil {
+		return nil, "", err
 	}
 
-	return profiles, count, nil
+	return publicPreviewsToPreviews(users), next, nil
 }
 
 func (provider *providerImpl) Previews(ctx context.Context, ids []uuid.UUID) ([]*Preview, error) {
@@ -69,17 +78,22 @@ func (provider *providerImpl) Previews(ctx context.Context, ids []uuid.UUID) ([]
 		return nil, err
 	}
 
+	return publicPreviewsToPreviews(users), nil
+}
+
+func publicPreviewsToPreviews(users []*models.UserPublicPreview) []*Preview {
 	profiles := make([]*Preview, 0, len(users))
 	for _, user := range users {
 		profiles = append(profiles, &Preview{
-			ID:        user.ID,
-			Slug:      user.Slug,
-			Username:  user.Username,
-			FirstName: user.FirstName,
-			LastName:  user.LastName,
-			CreatedAt: user.CreatedAt,
+			ID:         user.ID,
+			Slug:       user.Slug,
+			Username:   user.Username,
+			FirstName:  user.FirstName,
+			LastName:   user.LastName,
+			CreatedAt:  user.CreatedAt,
+			Reputation: user.Reputation,
 		})
 	}
 
-	return profiles, nil
+	return profiles
 }
@@ -24,6 +24,10 @@ func TestProfileProvider_Read(t *testing.T) {
 		userData *models.UserPublic
 		userErr  error
 
+		shouldCallBadges bool
+		badgesData       []*models.UserBadge
+		badgesErr        error
+
 		expect    *Model
 		expectErr error
 	}{
@@ -38,6 +42,10 @@ func TestProfileProvider_Read(t *testing.T) {
 				CreatedAt: baseTime,
 				Sex:       models.SexFemale,
 			},
+			shouldCallBadges: true,
+			badgesData: []*models.UserBadge{
+				{Badge: models.ForumBadgePersistentWriter, AwardedAt: baseTime},
+			},
 			expect: &Model{
 				ID:        test_utils.NumberUUID(1),
 				Username:  "qwerty",
@@ -45,6 +53,9 @@ func TestProfileProvider_Read(t *testing.T) {
 				LastName:  "Bar",
 				CreatedAt: baseTime,
 				Sex:       models.SexFemale,
+				Badges: []*models.UserBadge{
+					{Badge: models.ForumBadgePersistentWriter, AwardedAt: baseTime},
+				},
 			},
 		},
 		{
@@ -53,21 +64,43 @@ func TestProfileProvider_Read(t *testing.T) {
 			userErr:   fooErr,
 			expectErr: fooErr,
 		},
+		{
+			name: "Error/BadgesServiceFailure",
+			slug: "foobar",
+			userData: &models.UserPublic{
+				ID:        test_utils.NumberUUID(1),
+				Username:  "qwerty",
+				FirstName: "Foo",
+				LastName:  "Bar",
+				CreatedAt: baseTime,
+				Sex:       models.SexFemale,
+			},
+			shouldCallBadges: true,
+			badgesErr:        fooErr,
+			expectErr:        fooErr,
+		},
 	}
 
 	for _, d := range data {
 		t.Run(d.name, func(t *testing.T) {
 			userService := user_service.NewMockService(t)
 
+			badgesService := badges_service.NewMockService(t)
+
 			userService.On("GetPublic", context.TODO(), d.slug).Return(d.userData, d.userErr)
 
-			provider := NewProvider(Config{UserService: userService})
+			if d.shouldCallBadges {
+				badgesService.On("List", context.TODO(), d.userData.ID).Return(d.badgesData, d.badgesErr)
+			}
+
+			provider := NewProvider(Config{UserService: userService, BadgesService: badgesService})
 
 			profile, err := provider.Read(context.TODO(), d.slug)
 			test_utils.RequireError(t, d.expectErr, err)
 			require.Equal(t, d.expect, profile)
 
 			userService.AssertExpectations(t)
+			badgesService.AssertExpectations(t)
 		})
 	}
 }
@@ -95,12 +128,13 @@ func TestProfileProvider_Search(t *testing.T) {
 			offset: 20,
 			userData: []*models.UserPublicPreview{
 				{
-					ID:        test_utils.NumberUUID(1),
-					Slug:      "foobar",
-					Username:  "qwerty",
-					FirstName: "Foo",
-					LastName:  "Bar",
-					CreatedAt: baseTime,
+					ID:         test_utils.NumberUUID(1),
+					Slug:       "foobar",
+					Username:   "qwerty",
+					FirstName:  "Foo",
+					LastName:   "Bar",
+					CreatedAt:  baseTime,
+					Reputation: 42,
 				},
 				{
 					ID:        test_utils.NumberUUID(2),
@@ -114,12 +148,13 @@ func TestProfileProvider_Search(t *testing.T) {
 			userCount: 200,
 			expect: []*Preview{
 				{
-					ID:        test_utils.NumberUUID(1),
-					Slug:      "foobar",
-					Username:  "qwerty",
-					FirstName: "Foo",
-					LastName:  "Bar",
-					CreatedAt: baseTime,
+					ID:         test_utils.NumberUUID(1),
+					Slug:       "foobar",
+					Username:   "qwerty",
+					FirstName:  "Foo",
+					LastName:   "Bar",
+					CreatedAt:  baseTime,
+					Reputation: 42,
 				},
 				{
 					ID:        test_utils.NumberUUID(2),
@@ -162,6 +197,80 @@ func TestProfileProvider_Search(t *testing.T) {
 	}
 }
 
+func TestProfileProvider_SearchAfter(t *testing.T) {
+	data := []struct {
+		name string
+
+		query  string
+		cursor string
+		limit  int
+
+		userData []*models.UserPublicPreview
+		userNext string
+		userErr  error
+
+		expect     []*Preview
+		expectNext string
+		expectErr  error
+	}{
+		{
+			name:   "Success",
+			query:  "foo",
+			cursor: "cursor-1",
+			limit:  10,
+			userData: []*models.UserPublicPreview{
+				{
+					ID:        test_utils.NumberUUID(1),
+					Slug:      "foobar",
+					Username:  "qwerty",
+					FirstName: "Foo",
+					LastName:  "Bar",
+					CreatedAt: baseTime,
+				},
+			},
+			userNext: "cursor-2",
+			expect: []*Preview{
+				{
+					ID:        test_utils.NumberUUID(1),
+					Slug:      "foobar",
+					Username:  "qwerty",
+					FirstName: "Foo",
+					LastName:  "Bar",
+					CreatedAt: baseTime,
+				},
+			},
+			expectNext: "cursor-2",
+		},
+		{
+			name:      "Error/RepositoryFailure",
+			query:     "foo",
+			cursor:    "cursor-1",
+			limit:     10,
+			userErr:   fooErr,
+			expectErr: fooErr,
+		},
+	}
+
+	for _, d := range data {
+		t.Run(d.name, func(t *testing.T) {
+			userService := user_service.NewMockService(t)
+
+			userService.
+				On("SearchAfter", context.TODO(), d.query, d.cursor, d.limit).
+				Return(d.userData, d.userNext, d.userErr)
+
+			provider := NewProvider(Config{UserService: userService})
+
+			profiles, next, err := provider.SearchAfter(context.TODO(), d.query, d.cursor, d.limit)
+			test_utils.RequireError(t, d.expectErr, err)
+			require.Equal(t, d.expect, profiles)
+			require.Equal(t, d.expectNext, next)
+
+			userService.AssertExpectations(t)
+		})
+	}
+}
+
 func TestProfileProvider_Previews(t *testing.T) {
 	data := []struct {
 		name string
@@ -0,0 +1,94 @@
+// Package language resolves the languages supported by the full text search of the forum.
+package language
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Languages is the set of languages supported by the full text search. Languages are identified by their
+// ISO 639-1 code.
+type Languages struct {
+	// Default is the code of the language used when no supported language can be resolved.
+	Default string
+	// Configs maps each supported language code to the Postgres text search configuration used to index it.
+	Configs map[string]string
+}
+
+// IsSupported returns whether the given language code is supported.
+func (languages Languages) IsSupported(code string) bool {
+	_, ok := languages.Configs[code]
+	return ok
+}
+
+// Config returns the text search configuration of a language code. Unsupported codes resolve to the configuration
+// of the default language.
+func (languages Languages) Config(code string) string {
+	if config, ok := languages.Configs[code]; ok {
+		return config
+	}
+
+	return languages.Configs[languages.Default]
+}
+
+// Code returns the language code that uses the given text search configuration. Unknown configurations resolve to
+// the default language.
+func (languages Languages) Code(config string) string {
+	for code, candidate := range languages.Configs {
+		if candidate == config {
+			return code
+		}
+	}
+
+	return languages.Default
+}
+
+// FromLocale returns the first supported language of a locale. The locale can be a simple language code ("en"),
+// a language tag ("en-US"), or a list of tags, as found in the Accept-Language header ("fr-CH, fr;q=0.9, en;q=0.8").
+// Tags are expected to be sorted by preference.
+func (languages Languages) FromLocale(locale string) string {
+	for _, tag := range strings.Split(locale, ",") {
+		tag, _, _ = strings.Cut(tag, ";")
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		tag, _, _ = strings.Cut(strings.ReplaceAll(tag, "_", "-"), "-")
+
+		if languages.IsSupported(tag) {
+			return tag
+		}
+	}
+
+	return languages.Default
+}
+
+// Detect guesses the language of a text, by counting the occurrences of the most common words of each supported
+// language. It returns the default language if no supported language stands out.
+func (languages Languages) Detect(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '\''
+	})
+
+	scores := make(map[string]int, len(languages.Configs))
+	for _, word := range words {
+		for code := range languages.Configs {
+			if stopWords[code][word] {
+				scores[code]++
+			}
+		}
+	}
+
+	detected, best, tie := languages.Default, 0, false
+	for code, score := range scores {
+		switch {
+		case score > best:
+			detected, best, tie = code, score, false
+		case score == best:
+			tie = true
+		}
+	}
+
+	if tie {
+		return languages.Default
+	}
+
+	return detected
+}
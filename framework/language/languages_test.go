@@ -0,0 +1,44 @@
+package language
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var languages = Languages{
+	Default: "fr",
+	Configs: map[string]string{
+		"fr": "french",
+		"en": "english",
+		"es": "spanish",
+	},
+}
+
+func TestLanguages_Config(t *testing.T) {
+	require.Equal(t, "english", languages.Config("en"))
+	require.Equal(t, "french", languages.Config("de"))
+	require.Equal(t, "french", languages.Config(""))
+}
+
+func TestLanguages_Code(t *testing.T) {
+	require.Equal(t, "es", languages.Code("spanish"))
+	require.Equal(t, "fr", languages.Code("german"))
+}
+
+func TestLanguages_FromLocale(t *testing.T) {
+	require.Equal(t, "en", languages.FromLocale("en"))
+	require.Equal(t, "en", languages.FromLocale("en-US"))
+	require.Equal(t, "es", languages.FromLocale("es_MX"))
+	require.Equal(t, "en", languages.FromLocale("de-CH, de;q=0.9, EN;q=0.8, es;q=0.7"))
+	require.Equal(t, "fr", languages.FromLocale("de-CH, de;q=0.9"))
+	require.Equal(t, "fr", languages.FromLocale(""))
+}
+
+func TestLanguages_Detect(t *testing.T) {
+	require.Equal(t, "en", languages.Detect("The sun was rising over the hills, and she could not look away."))
+	require.Equal(t, "fr", languages.Detect("Le soleil se levait sur les collines, et elle ne pouvait pas détourner le regard."))
+	require.Equal(t, "es", languages.Detect("El sol salía sobre las colinas, y ella no podía apartar la mirada."))
+	// German is not supported.
+	require.Equal(t, "fr", languages.Detect("Die Sonne ging über den Hügeln auf."))
+	require.Equal(t, "fr", languages.Detect("qwertyuiop"))
+}
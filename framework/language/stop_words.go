@@ -0,0 +1,42 @@
+package language
+
+func wordSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+
+	return set
+}
+
+// Most frequent words of each language, used for detection. Words shared by several languages count for each of
+// them.
+var stopWords = map[string]map[string]bool{
+	"fr": wordSet(
+		"le", "la", "les", "des", "du", "un", "une", "et", "est", "dans", "que", "qui", "pour", "pas", "sur", "avec",
+		"il", "elle", "ils", "elles", "nous", "vous", "je", "mais", "ou", "au", "aux", "ce", "cette", "ses", "son",
+		"sa", "leur", "être", "avait", "était", "plus", "comme", "tout", "sans",
+	),
+	"en": wordSet(
+		"the", "and", "is", "are", "was", "were", "of", "to", "in", "that", "it", "with", "for", "on", "as", "he",
+		"she", "they", "we", "you", "his", "her", "their", "at", "by", "from", "this", "but", "not", "have", "had",
+		"be", "been", "which", "an", "or", "would", "could",
+	),
+	"es": wordSet(
+		"el", "los", "las", "del", "y", "es", "en", "que", "por", "con", "una", "para", "lo", "pero", "sus", "su",
+		"como", "más", "ella", "ellos", "yo", "está", "estaba", "fue", "era", "sin", "sobre", "muy", "también",
+		"cuando", "porque", "hay", "nosotros",
+	),
+	"de": wordSet(
+		"der", "die", "das", "und", "ist", "nicht", "ein", "eine", "zu", "den", "mit", "sich", "auf", "für", "im",
+		"dem", "ich", "sie", "er", "wir", "war", "auch", "aber", "wie", "noch", "nach", "bei", "oder", "wenn",
+	),
+	"it": wordSet(
+		"il", "di", "che", "è", "e", "la", "per", "non", "sono", "gli", "della", "nel", "con", "una", "ma", "anche",
+		"come", "lui", "lei", "io", "noi", "voi", "era", "questo", "quella", "molto", "perché", "sempre",
+	),
+	"pt": wordSet(
+		"o", "os", "as", "da", "do", "das", "dos", "em", "não", "um", "uma", "com", "para", "mas", "ele", "ela",
+		"eles", "eu", "nós", "você", "foi", "está", "muito", "também", "quando", "porque", "sem", "mais",
+	),
+}
@@ -0,0 +1,135 @@
+// Package minhash estimates how much two texts overlap, using MinHash signatures of their word shingles.
+//
+// Signatures have a fixed size, so they can be stored and compared without the original texts. They are also split
+// into bands: texts sharing at least one band are likely to be similar, which allows to look for candidates with an
+// index, before comparing the full signatures.
+package minhash
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// ShingleSize is the number of consecutive words in a shingle.
+	ShingleSize = 4
+	// SignatureSize is the number of hash functions used to compute a signature.
+	SignatureSize = 128
+	// BandSize is the number of signature values in a band. With 32 bands of 4 values, texts with a similarity
+	// of 0.5 share a band 87% of the time, and texts with a similarity of 0.8 more than 99.9% of the time.
+	BandSize = 4
+)
+
+// Seeds of the hash functions. They must never change, otherwise stored signatures become incomparable.
+var seeds = func() [SignatureSize]uint64 {
+	var output [SignatureSize]uint64
+
+	state := uint64(0x5f3759df)
+	for i := range output {
+		state += 0x9e3779b97f4a7c15
+		output[i] = mix(state)
+	}
+
+	return output
+}()
+
+// SplitMix64 finalizer.
+func mix(value uint64) uint64 {
+	value = (value ^ (value >> 30)) * 0xbf58476d1ce4e5b9
+	value = (value ^ (value >> 27)) * 0x94d049bb133111eb
+	return value ^ (value >> 31)
+}
+
+// Shingles returns the hashes of every sequence of ShingleSize consecutive words in the text. Words are compared
+// case-insensitively, and punctuation is ignored. A text shorter than ShingleSize produces a single shingle.
+func Shingles(text string) []uint64 {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	if len(words) == 0 {
+		return nil
+	}
+
+	count := len(words) - ShingleSize + 1
+	if count < 1 {
+		count = 1
+	}
+
+	output := make([]uint64, count)
+	for i := range output {
+		end := i + ShingleSize
+		if end > len(words) {
+			end = len(words)
+		}
+
+		hash := fnv.New64a()
+		_, _ = hash.Write([]byte(strings.Join(words[i:end], " ")))
+		output[i] = hash.Sum64()
+	}
+
+	return output
+}
+
+// Signature computes the MinHash signature of a text. It returns nil if the text has no words.
+// Values are signed, so they fit in a Postgres bigint.
+func Signature(text string) []int64 {
+	shingles := Shingles(text)
+	if len(shingles) == 0 {
+		return nil
+	}
+
+	output := make([]int64, SignatureSize)
+	for i, seed := range seeds {
+		minValue := ^uint64(0)
+		for _, shingle := range shingles {
+			if value := mix(shingle ^ seed); value < minValue {
+				minValue = value
+			}
+		}
+
+		output[i] = int64(minValue)
+	}
+
+	return output
+}
+
+// Similarity estimates the Jaccard similarity of the texts behind two signatures, from 0 (nothing in common) to 1
+// (same shingles).
+func Similarity(a, b []int64) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+
+	matches := 0
+	for i := range a {
+		if a[i] == b[i] {
+			matches++
+		}
+	}
+
+	return float64(matches) / float64(len(a))
+}
+
+// Bands hashes each band of a signature. Hashes depend on the position of the band, so two signatures share a band
+// hash only when they match on the whole band.
+func Bands(signature []int64) []int64 {
+	output := make([]int64, 0, len(signature)/BandSize)
+	buffer := make([]byte, 8)
+
+	for start := 0; start+BandSize <= len(signature); start += BandSize {
+		hash := fnv.New64a()
+
+		binary.BigEndian.PutUint64(buffer, uint64(start))
+		_, _ = hash.Write(buffer)
+		for _, value := range signature[start : start+BandSize] {
+			binary.BigEndian.PutUint64(buffer, uint64(value))
+			_, _ = hash.Write(buffer)
+		}
+
+		output = append(output, int64(hash.Sum64()))
+	}
+
+	return output
+}
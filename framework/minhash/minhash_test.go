@@ -0,0 +1,66 @@
+package minhash
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	original = "Aussi, quand il rencontra Sombreval et sa fille traversant le cimetière, fut-il frappé d'un " +
+		"éblouissement qui ne venait pas seulement de la beauté nitescente de Calixte, marchant dans l'éclat " +
+		"solaire d'un jour d'été. Il resta longtemps immobile, incapable de détourner les yeux de cette apparition."
+	// Same scene, with a few words changed.
+	edited = "Aussi, lorsqu'il rencontra Sombreval et sa fille traversant le cimetière, fut-il frappé d'un " +
+		"éblouissement qui ne venait pas seulement de la beauté nitescente de Calixte, marchant dans l'éclat " +
+		"solaire d'un jour d'été. Il resta longtemps immobile, incapable de détourner le regard de cette apparition."
+	unrelated = "Les trois Lois constituent les principes directeurs essentiels d'une grande partie des systèmes " +
+		"moraux du monde. Evidemment, chaque être humain possède, en principe, l'instinct de conservation."
+)
+
+func TestShingles(t *testing.T) {
+	require.Len(t, Shingles("one two three four five"), 2)
+	require.Len(t, Shingles("one two"), 1)
+	require.Empty(t, Shingles(" ... "))
+
+	// Case and punctuation are ignored.
+	require.Equal(t, Shingles("One, two; THREE four!"), Shingles("one two three four"))
+}
+
+func TestSignature(t *testing.T) {
+	require.Len(t, Signature(original), SignatureSize)
+	require.Equal(t, Signature(original), Signature(original))
+	require.Nil(t, Signature(""))
+}
+
+func TestSimilarity(t *testing.T) {
+	require.Equal(t, 1.0, Similarity(Signature(original), Signature(original)))
+	require.Greater(t, Similarity(Signature(original), Signature(edited)), 0.5)
+	require.Less(t, Similarity(Signature(original), Signature(unrelated)), 0.1)
+
+	require.Equal(t, 0.0, Similarity(nil, nil))
+	require.Equal(t, 0.0, Similarity(Signature(original), Signature(original)[:10]))
+}
+
+func TestBands(t *testing.T) {
+	originalBands := Bands(Signature(original))
+	require.Len(t, originalBands, SignatureSize/BandSize)
+	require.Equal(t, originalBands, Bands(Signature(original)))
+
+	shared := func(a, b []int64) int {
+		count := 0
+		for _, x := range a {
+			for _, y := range b {
+				if x == y {
+					count++
+				}
+			}
+		}
+		return count
+	}
+
+	require.NotZero(t, shared(originalBands, Bands(Signature(edited))))
+	require.Zero(t, shared(originalBands, Bands(Signature(unrelated))))
+
+	// The same values at different positions do not produce the same band.
+	require.NotEqual(t, Bands([]int64{1, 2, 3, 4, 5, 6, 7, 8})[0], Bands([]int64{5, 6, 7, 8, 1, 2, 3, 4})[1])
+}
@@ -0,0 +1,104 @@
+// Package pagination implements keyset (cursor) pagination over bun queries.
+//
+// Offset pagination has to read and discard every previous row, and shifts when rows are inserted before the
+// current page. Keyset pagination instead resumes right after the last row read, by comparing the ordering keys of
+// each row to the ones of that last row. Those keys are carried between pages by an opaque cursor.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/uptrace/bun"
+	"strings"
+)
+
+// CursorColumn is the name of the column that holds the position of each row, in a query paginated with Apply.
+// It must be scanned as a string, and converted to a cursor with Next.
+const CursorColumn = "cursor"
+
+// Key is an ordering key of a paginated query. Keys are always sorted in descending order.
+type Key struct {
+	// Expr is the SQL expression of the key. It must never evaluate to NULL, otherwise the row is skipped.
+	Expr string
+	// Args are the values of the placeholders in Expr, if any.
+	Args []interface{}
+	// Type is the SQL type of the expression. The keys are stored as text in the cursor, and cast back to this type
+	// when compared, so the comparison is exact.
+	Type string
+}
+
+// Apply orders the query by the given keys, and exposes the position of each row under CursorColumn. When a
+// cursor is provided, only the rows that come after it are selected.
+//
+// The last key must be unique (such as a primary key), otherwise rows that share the same keys as the last row of
+// a page might be skipped.
+func Apply(q *bun.SelectQuery, keys []Key, cursor string) (*bun.SelectQuery, error) {
+	expressions := make([]string, len(keys))
+	textExpressions := make([]string, len(keys))
+	var args []interface{}
+
+	for i, key := range keys {
+		expressions[i] = fmt.Sprintf("(%s)", key.Expr)
+		textExpressions[i] = fmt.Sprintf("(%s)::text", key.Expr)
+		args = append(args, key.Args...)
+
+		q = q.OrderExpr(fmt.Sprintf("%s DESC", key.Expr), key.Args...)
+	}
+
+	q = q.ColumnExpr(
+		fmt.Sprintf("json_build_array(%s)::text AS %s", strings.Join(textExpressions, ", "), CursorColumn),
+		args...,
+	)
+
+	if cursor == "" {
+		return q, nil
+	}
+
+	position, err := Decode(cursor, len(keys))
+	if err != nil {
+		return nil, err
+	}
+
+	placeholders := make([]string, len(keys))
+	positionArgs := make([]interface{}, len(keys))
+	for i, key := range keys {
+		placeholders[i] = fmt.Sprintf("?::%s", key.Type)
+		positionArgs[i] = position[i]
+	}
+
+	return q.Where(
+		fmt.Sprintf("(%s) < (%s)", strings.Join(expressions, ", "), strings.Join(placeholders, ", ")),
+		append(args, positionArgs...)...,
+	), nil
+}
+
+// Next returns the cursor of the page that follows the given rows. Position returns the value of the CursorColumn
+// column for a row. If the page is not full, there is nothing left to read, and an empty cursor is returned.
+func Next[Row any](rows []Row, limit int, position func(row Row) string) string {
+	if len(rows) == 0 || len(rows) < limit {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString([]byte(position(rows[len(rows)-1])))
+}
+
+// Decode returns the position stored in a cursor, as the text representation of each key. It is only needed by
+// queries that cannot be paginated with Apply, such as raw queries. Size is the number of keys of the query.
+func Decode(cursor string, size int) ([]string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, validation.NewErrInvalidEntity("cursor", "cursor is not properly encoded")
+	}
+
+	var position []string
+	if err := json.Unmarshal(raw, &position); err != nil {
+		return nil, validation.NewErrInvalidEntity("cursor", "cursor is not properly encoded")
+	}
+	if len(position) != size {
+		return nil, validation.NewErrInvalidEntity("cursor", "cursor does not match the requested order")
+	}
+
+	return position, nil
+}
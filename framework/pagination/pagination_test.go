@@ -0,0 +1,67 @@
+package pagination
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"github.com/a-novel/agora-backend/framework/validation"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"testing"
+)
+
+var keys = []Key{
+	{Expr: "score + ?", Args: []interface{}{1}, Type: "bigint"},
+	{Expr: "id", Type: "uuid"},
+}
+
+// Queries are only formatted, so the database is never reached.
+func newQuery() *bun.SelectQuery {
+	sqlDB := sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN("postgres://localhost:5432/test")))
+	return bun.NewDB(sqlDB, pgdialect.New()).NewSelect().Column("id").TableExpr("posts")
+}
+
+func TestApply(t *testing.T) {
+	q, err := Apply(newQuery(), keys, "")
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		`SELECT "id", json_build_array((score + 1)::text, (id)::text)::text AS cursor FROM posts `+
+			`ORDER BY score + 1 DESC, id DESC`,
+		q.String(),
+	)
+
+	cursor := base64.RawURLEncoding.EncodeToString([]byte(`["42","00000000-0000-0000-0000-000000000001"]`))
+	q, err = Apply(newQuery(), keys, cursor)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		`SELECT "id", json_build_array((score + 1)::text, (id)::text)::text AS cursor FROM posts `+
+			`WHERE (((score + 1), (id)) < ('42'::bigint, '00000000-0000-0000-0000-000000000001'::uuid)) `+
+			`ORDER BY score + 1 DESC, id DESC`,
+		q.String(),
+	)
+}
+
+func TestApply_InvalidCursor(t *testing.T) {
+	_, err := Apply(newQuery(), keys, "not a cursor")
+	require.ErrorIs(t, err, validation.ErrInvalidEntity)
+
+	_, err = Apply(newQuery(), keys, base64.RawURLEncoding.EncodeToString([]byte(`{"foo":"bar"}`)))
+	require.ErrorIs(t, err, validation.ErrInvalidEntity)
+
+	// Cursor from a query with a different order.
+	_, err = Apply(newQuery(), keys, base64.RawURLEncoding.EncodeToString([]byte(`["42"]`)))
+	require.ErrorIs(t, err, validation.ErrInvalidEntity)
+}
+
+func TestNext(t *testing.T) {
+	position := func(row string) string {
+		return row
+	}
+
+	require.Equal(t, base64.RawURLEncoding.EncodeToString([]byte(`["2"]`)), Next([]string{`["1"]`, `["2"]`}, 2, position))
+	require.Equal(t, "", Next([]string{`["1"]`}, 2, position))
+	require.Equal(t, "", Next([]string{}, 2, position))
+}
@@ -19,6 +19,7 @@ var (
 	ErrValidated               = fmt.Errorf("the current link has already been validated")
 	ErrNotFound                = fmt.Errorf("could not find any record matching the request")
 	ErrUnauthorized            = fmt.Errorf("you are not allowed to perform this action")
+	ErrRateLimited             = fmt.Errorf("too many requests, try again later")
 )
 
 // HandlePGError extends pg library typed errors. Only a few errors are typed to be targeted with errors.Is, and some
@@ -85,6 +86,10 @@ func NewErrUnauthorized(reason string) error {
 	return fmt.Errorf("%w: %s", ErrUnauthorized, reason)
 }
 
+func NewErrRateLimited(reason string) error {
+	return fmt.Errorf("%w: %s", ErrRateLimited, reason)
+}
+
 func NewErrNotAllowed[T any](field string, allowed ...T) error {
 	return fmt.Errorf("on field %q: %w: allowed values are %v", field, ErrNotAllowed, allowed)
 }
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS improve_suggestion_revisions_suggestion;
+DROP INDEX IF EXISTS votes_on_revision;
+
+--bun:split
+
+ALTER TABLE votes DROP COLUMN IF EXISTS revision_id;
+ALTER TABLE improve_suggestions DROP COLUMN IF EXISTS revision_id;
+
+--bun:split
+
+DROP TABLE IF EXISTS improve_suggestion_revisions;
@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS improve_suggestion_revisions (
+    id uuid PRIMARY KEY NOT NULL,
+    suggestion_id uuid NOT NULL REFERENCES improve_suggestions (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+
+    request_id uuid NOT NULL,
+    title VARCHAR(256) NOT NULL,
+    content TEXT NOT NULL,
+
+    CONSTRAINT title_filled CHECK ( title <> '' ),
+    CONSTRAINT content_filled CHECK ( content <> '' ),
+    CONSTRAINT content_length CHECK ( char_length(content) <= 4096 )
+);
+
+--bun:split
+
+ALTER TABLE improve_suggestions ADD COLUMN IF NOT EXISTS revision_id uuid;
+ALTER TABLE votes ADD COLUMN IF NOT EXISTS revision_id uuid;
+
+--bun:split
+
+/* Existing suggestions only know their latest content, which becomes their first revision. */
+INSERT INTO improve_suggestion_revisions (id, suggestion_id, created_at, request_id, title, content)
+    SELECT gen_random_uuid(), id, COALESCE(updated_at, created_at), request_id, title, content
+    FROM improve_suggestions;
+
+UPDATE improve_suggestions SET revision_id = improve_suggestion_revisions.id
+    FROM improve_suggestion_revisions
+    WHERE improve_suggestion_revisions.suggestion_id = improve_suggestions.id;
+
+UPDATE votes SET revision_id = improve_suggestions.revision_id
+    FROM improve_suggestions
+    WHERE votes.target = 'improve_suggestion' AND votes.post_id = improve_suggestions.id;
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS improve_suggestion_revisions_suggestion ON improve_suggestion_revisions (suggestion_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS votes_on_revision ON votes (revision_id);
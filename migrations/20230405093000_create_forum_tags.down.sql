@@ -0,0 +1,16 @@
+DROP INDEX IF EXISTS forum_tags_name_search;
+DROP INDEX IF EXISTS forum_tags_category;
+DROP INDEX IF EXISTS improve_request_tags_tag;
+
+--bun:split
+
+DROP TABLE IF EXISTS improve_request_tags;
+DROP TABLE IF EXISTS forum_tags;
+
+--bun:split
+
+DROP TYPE IF EXISTS forum_tag_category;
+
+--bun:split
+
+ALTER TABLE credentials DROP COLUMN IF EXISTS moderator;
@@ -0,0 +1,36 @@
+ALTER TABLE credentials ADD COLUMN IF NOT EXISTS moderator BOOLEAN NOT NULL DEFAULT FALSE;
+
+--bun:split
+
+CREATE TYPE forum_tag_category AS ENUM ('genre', 'pov', 'tense', 'scene_type');
+
+--bun:split
+
+CREATE TABLE IF NOT EXISTS forum_tags (
+    id uuid PRIMARY KEY NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+
+    category forum_tag_category NOT NULL,
+    slug VARCHAR(64) NOT NULL,
+    name VARCHAR(64) NOT NULL,
+
+    UNIQUE(slug),
+
+    CONSTRAINT slug_filled CHECK ( slug <> '' ),
+    CONSTRAINT name_filled CHECK ( name <> '' )
+);
+
+/* Tags are attached to a specific revision, so authors can adjust them over time. */
+CREATE TABLE IF NOT EXISTS improve_request_tags (
+    request_id uuid NOT NULL REFERENCES improve_requests (id) ON DELETE CASCADE,
+    tag_id uuid NOT NULL REFERENCES forum_tags (id) ON DELETE CASCADE,
+
+    PRIMARY KEY (request_id, tag_id)
+);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS forum_tags_name_search ON forum_tags USING gin (format_user_search(name) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS forum_tags_category ON forum_tags (category);
+CREATE INDEX IF NOT EXISTS improve_request_tags_tag ON improve_request_tags (tag_id);
+
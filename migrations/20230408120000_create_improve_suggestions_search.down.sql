@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS improve_suggestions_fts;
+
+--bun:split
+
+DROP TRIGGER IF EXISTS format_searchable_content ON improve_suggestions;
+
+--bun:split
+
+ALTER TABLE improve_suggestions DROP COLUMN IF EXISTS text_searchable_index_col;
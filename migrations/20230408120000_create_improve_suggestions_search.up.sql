@@ -0,0 +1,19 @@
+ALTER TABLE improve_suggestions ADD COLUMN IF NOT EXISTS text_searchable_index_col tsvector;
+
+--bun:split
+
+/* Suggestions can be updated, so the searchable content must also be computed on updates. */
+CREATE TRIGGER format_searchable_content
+    BEFORE INSERT OR UPDATE OF title, content ON improve_suggestions
+    FOR EACH ROW
+    EXECUTE FUNCTION format_searchable_content();
+
+--bun:split
+
+UPDATE improve_suggestions SET text_searchable_index_col =
+    setweight(to_tsvector('french', unaccent(title)), 'A') ||
+    setweight(to_tsvector('french', unaccent(content)), 'B');
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS improve_suggestions_fts ON improve_suggestions USING GIN (text_searchable_index_col);
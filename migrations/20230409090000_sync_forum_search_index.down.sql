@@ -0,0 +1,20 @@
+DROP TRIGGER IF EXISTS format_searchable_content ON improve_requests;
+
+CREATE TRIGGER format_searchable_content
+    BEFORE INSERT ON improve_requests
+    FOR EACH ROW
+    EXECUTE FUNCTION format_searchable_content();
+
+--bun:split
+
+CREATE OR REPLACE FUNCTION format_searchable_content()
+    RETURNS trigger AS $format_searchable_content$
+BEGIN
+    NEW.text_searchable_index_col :=
+                setweight(to_tsvector('french',  unaccent(NEW.title)), 'A') ||
+                setweight(to_tsvector('french', unaccent(NEW.content)), 'B');
+    RETURN NEW;
+END;
+$format_searchable_content$ LANGUAGE plpgsql;
+
+DROP FUNCTION IF EXISTS compute_searchable_content;
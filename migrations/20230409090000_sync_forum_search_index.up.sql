@@ -0,0 +1,28 @@
+/*
+Single source of truth for the searchable content of forum posts. It is used by the indexing triggers, and by
+maintenance tools to rebuild or check the stored vectors.
+*/
+CREATE OR REPLACE FUNCTION compute_searchable_content(title TEXT, content TEXT)
+    RETURNS tsvector AS $compute_searchable_content$
+BEGIN
+    RETURN setweight(to_tsvector('french', unaccent(title)), 'A') ||
+           setweight(to_tsvector('french', unaccent(content)), 'B');
+END;
+$compute_searchable_content$ LANGUAGE plpgsql STABLE;
+
+CREATE OR REPLACE FUNCTION format_searchable_content()
+    RETURNS trigger AS $format_searchable_content$
+BEGIN
+    NEW.text_searchable_index_col := compute_searchable_content(NEW.title, NEW.content);
+    RETURN NEW;
+END;
+$format_searchable_content$ LANGUAGE plpgsql;
+
+--bun:split
+
+DROP TRIGGER IF EXISTS format_searchable_content ON improve_requests;
+
+CREATE TRIGGER format_searchable_content
+    BEFORE INSERT OR UPDATE OF title, content ON improve_requests
+    FOR EACH ROW
+    EXECUTE FUNCTION format_searchable_content();
@@ -0,0 +1,39 @@
+DROP TRIGGER IF EXISTS format_searchable_content ON improve_requests;
+DROP TRIGGER IF EXISTS format_searchable_content ON improve_suggestions;
+
+--bun:split
+
+CREATE OR REPLACE FUNCTION compute_searchable_content(title TEXT, content TEXT)
+    RETURNS tsvector AS $compute_searchable_content$
+BEGIN
+    RETURN setweight(to_tsvector('french', unaccent(title)), 'A') ||
+           setweight(to_tsvector('french', unaccent(content)), 'B');
+END;
+$compute_searchable_content$ LANGUAGE plpgsql STABLE;
+
+CREATE OR REPLACE FUNCTION format_searchable_content()
+    RETURNS trigger AS $format_searchable_content$
+BEGIN
+    NEW.text_searchable_index_col := compute_searchable_content(NEW.title, NEW.content);
+    RETURN NEW;
+END;
+$format_searchable_content$ LANGUAGE plpgsql;
+
+DROP FUNCTION IF EXISTS format_suggestion_searchable_content;
+DROP FUNCTION IF EXISTS compute_searchable_content(regconfig, TEXT, TEXT);
+
+--bun:split
+
+CREATE TRIGGER format_searchable_content
+    BEFORE INSERT OR UPDATE OF title, content ON improve_requests
+    FOR EACH ROW
+    EXECUTE FUNCTION format_searchable_content();
+
+CREATE TRIGGER format_searchable_content
+    BEFORE INSERT OR UPDATE OF title, content ON improve_suggestions
+    FOR EACH ROW
+    EXECUTE FUNCTION format_searchable_content();
+
+--bun:split
+
+ALTER TABLE improve_requests DROP COLUMN IF EXISTS language;
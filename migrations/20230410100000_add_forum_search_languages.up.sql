@@ -0,0 +1,52 @@
+/* Existing posts were all indexed in french. */
+ALTER TABLE improve_requests ADD COLUMN IF NOT EXISTS language regconfig NOT NULL DEFAULT 'french';
+
+--bun:split
+
+DROP TRIGGER IF EXISTS format_searchable_content ON improve_requests;
+DROP TRIGGER IF EXISTS format_searchable_content ON improve_suggestions;
+
+--bun:split
+
+CREATE OR REPLACE FUNCTION compute_searchable_content(language regconfig, title TEXT, content TEXT)
+    RETURNS tsvector AS $compute_searchable_content$
+BEGIN
+    RETURN setweight(to_tsvector(language, unaccent(title)), 'A') ||
+           setweight(to_tsvector(language, unaccent(content)), 'B');
+END;
+$compute_searchable_content$ LANGUAGE plpgsql STABLE;
+
+CREATE OR REPLACE FUNCTION format_searchable_content()
+    RETURNS trigger AS $format_searchable_content$
+BEGIN
+    NEW.text_searchable_index_col := compute_searchable_content(NEW.language, NEW.title, NEW.content);
+    RETURN NEW;
+END;
+$format_searchable_content$ LANGUAGE plpgsql;
+
+/* Suggestions are written in the language of the request they answer to. */
+CREATE FUNCTION format_suggestion_searchable_content()
+    RETURNS trigger AS $format_suggestion_searchable_content$
+BEGIN
+    NEW.text_searchable_index_col := compute_searchable_content(
+        COALESCE((SELECT language FROM improve_requests WHERE id = NEW.request_id), 'french'),
+        NEW.title,
+        NEW.content
+    );
+    RETURN NEW;
+END;
+$format_suggestion_searchable_content$ LANGUAGE plpgsql;
+
+DROP FUNCTION IF EXISTS compute_searchable_content(TEXT, TEXT);
+
+--bun:split
+
+CREATE TRIGGER format_searchable_content
+    BEFORE INSERT OR UPDATE OF title, content, language ON improve_requests
+    FOR EACH ROW
+    EXECUTE FUNCTION format_searchable_content();
+
+CREATE TRIGGER format_searchable_content
+    BEFORE INSERT OR UPDATE OF title, content, request_id ON improve_suggestions
+    FOR EACH ROW
+    EXECUTE FUNCTION format_suggestion_searchable_content();
@@ -0,0 +1,5 @@
+DROP MATERIALIZED VIEW IF EXISTS improve_request_rankings;
+
+--bun:split
+
+DROP FUNCTION IF EXISTS wilson_lower_bound(BIGINT, BIGINT);
@@ -0,0 +1,53 @@
+/*
+Lower bound of the Wilson score confidence interval (95%), for the proportion of positive votes. Unlike the raw
+difference between up and down votes, it does not favour posts that only gathered more votes over time. Unlike the
+plain ratio, a single up vote does not rank above 90 up votes out of 100.
+*/
+CREATE FUNCTION wilson_lower_bound(up BIGINT, down BIGINT)
+    RETURNS DOUBLE PRECISION AS $wilson_lower_bound$
+DECLARE
+    n DOUBLE PRECISION := COALESCE(up, 0) + COALESCE(down, 0);
+    p DOUBLE PRECISION;
+BEGIN
+    IF n = 0 THEN
+        RETURN 0;
+    END IF;
+
+    p := COALESCE(up, 0) / n;
+    /* 1.96 is the z-score for a 95% confidence. */
+    RETURN (p + 1.9208 / n - 1.96 * sqrt(p * (1 - p) / n + 0.9604 / (n * n))) / (1 + 3.8416 / n);
+END;
+$wilson_lower_bound$ LANGUAGE plpgsql IMMUTABLE;
+
+--bun:split
+
+/*
+The hot score sums the activity of the last 7 days on every revision of a request, each event losing half its weight
+every 24 hours. Up votes count for 1, down votes for -1, and new suggestions for 2.
+The score depends on the current time, so it is materialized and refreshed periodically, rather than computed on
+every search.
+*/
+CREATE MATERIALIZED VIEW improve_request_rankings AS
+WITH activity AS (
+    SELECT improve_requests.source AS source,
+           CASE WHEN votes.vote = 'up' THEN 1 ELSE -1 END AS weight,
+           votes.updated_at AS happened_at
+    FROM votes
+        JOIN improve_requests ON improve_requests.id = votes.post_id
+    WHERE votes.target = 'improve_request'
+      AND votes.updated_at > now() - INTERVAL '7 days'
+    UNION ALL
+    SELECT improve_suggestions.source_id AS source,
+           2 AS weight,
+           improve_suggestions.created_at AS happened_at
+    FROM improve_suggestions
+    WHERE improve_suggestions.created_at > now() - INTERVAL '7 days'
+)
+SELECT improve_requests.source AS source,
+       COALESCE(SUM(activity.weight * power(0.5, EXTRACT(EPOCH FROM now() - activity.happened_at) / 86400)), 0) AS hot_score
+FROM (SELECT DISTINCT source FROM improve_requests) AS improve_requests
+    LEFT JOIN activity ON activity.source = improve_requests.source
+GROUP BY improve_requests.source;
+
+/* Required to refresh the view concurrently. */
+CREATE UNIQUE INDEX improve_request_rankings_source ON improve_request_rankings (source);
@@ -0,0 +1,9 @@
+DROP TRIGGER IF EXISTS invalidate_improve_request_related ON improve_requests;
+
+--bun:split
+
+DROP FUNCTION IF EXISTS invalidate_improve_request_related();
+
+--bun:split
+
+DROP TABLE IF EXISTS improve_request_related;
@@ -0,0 +1,42 @@
+/*
+Cache of the requests most similar to a given one, ordered by decreasing similarity. The ranking compares every
+request, so it is only computed when a post is read without a cached entry.
+*/
+CREATE TABLE IF NOT EXISTS improve_request_related (
+    source uuid PRIMARY KEY NOT NULL,
+    related uuid[] NOT NULL,
+    computed_at TIMESTAMP NOT NULL
+);
+
+/* Used to find the entries that reference a modified request. */
+CREATE INDEX IF NOT EXISTS improve_request_related_related ON improve_request_related USING GIN (related);
+
+--bun:split
+
+/*
+A new or deleted revision changes the content compared by the ranking. The entry of the request is dropped, as well
+as every entry that lists it, so they are recomputed on the next read.
+*/
+CREATE FUNCTION invalidate_improve_request_related()
+    RETURNS trigger AS $invalidate_improve_request_related$
+DECLARE
+    changed uuid;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        changed := OLD.source;
+    ELSE
+        changed := NEW.source;
+    END IF;
+
+    DELETE FROM improve_request_related
+    WHERE improve_request_related.source = changed
+       OR improve_request_related.related @> ARRAY[changed];
+
+    RETURN NULL;
+END;
+$invalidate_improve_request_related$ LANGUAGE plpgsql;
+
+CREATE TRIGGER invalidate_improve_request_related
+    AFTER INSERT OR DELETE ON improve_requests
+    FOR EACH ROW
+    EXECUTE FUNCTION invalidate_improve_request_related();
@@ -0,0 +1,15 @@
+DROP TRIGGER IF EXISTS delete_improve_suggestion_fingerprint ON improve_suggestion_revisions;
+DROP TRIGGER IF EXISTS delete_improve_request_fingerprint ON improve_requests;
+
+--bun:split
+
+DROP FUNCTION IF EXISTS delete_forum_fingerprint();
+
+--bun:split
+
+DROP TABLE IF EXISTS forum_duplicate_flags;
+DROP TABLE IF EXISTS forum_fingerprints;
+
+--bun:split
+
+DROP TYPE IF EXISTS forum_fingerprint_target;
@@ -0,0 +1,60 @@
+CREATE TYPE forum_fingerprint_target AS ENUM ('improve_request', 'improve_suggestion');
+
+--bun:split
+
+/*
+MinHash signature of the content of every post revision. Bands are hashes of slices of the signature, indexed to
+quickly find the revisions that are likely to be similar.
+*/
+CREATE TABLE IF NOT EXISTS forum_fingerprints (
+    revision_id uuid PRIMARY KEY NOT NULL,
+    post_id uuid NOT NULL,
+    thread_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    target forum_fingerprint_target NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+
+    signature bigint[] NOT NULL,
+    bands bigint[] NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS forum_fingerprints_bands ON forum_fingerprints USING GIN (bands);
+
+--bun:split
+
+/* Revisions that look like a copy of an older revision from another user, waiting for a moderator review. */
+CREATE TABLE IF NOT EXISTS forum_duplicate_flags (
+    revision_id uuid NOT NULL REFERENCES forum_fingerprints (revision_id) ON DELETE CASCADE,
+    original_revision_id uuid NOT NULL REFERENCES forum_fingerprints (revision_id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    similarity real NOT NULL,
+
+    reviewed_at TIMESTAMP,
+    reviewed_by uuid,
+
+    PRIMARY KEY (revision_id, original_revision_id)
+);
+
+CREATE INDEX IF NOT EXISTS forum_duplicate_flags_pending ON forum_duplicate_flags (created_at DESC)
+    WHERE reviewed_at IS NULL;
+
+--bun:split
+
+/* Fingerprints have no foreign key, since they point to different tables. They are removed with their revision. */
+CREATE FUNCTION delete_forum_fingerprint()
+    RETURNS trigger AS $delete_forum_fingerprint$
+BEGIN
+    DELETE FROM forum_fingerprints WHERE forum_fingerprints.revision_id = OLD.id;
+    RETURN NULL;
+END;
+$delete_forum_fingerprint$ LANGUAGE plpgsql;
+
+CREATE TRIGGER delete_improve_request_fingerprint
+    AFTER DELETE ON improve_requests
+    FOR EACH ROW
+    EXECUTE FUNCTION delete_forum_fingerprint();
+
+CREATE TRIGGER delete_improve_suggestion_fingerprint
+    AFTER DELETE ON improve_suggestion_revisions
+    FOR EACH ROW
+    EXECUTE FUNCTION delete_forum_fingerprint();
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS improve_request_drafts;
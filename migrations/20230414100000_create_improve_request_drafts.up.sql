@@ -0,0 +1,26 @@
+/*
+Drafts are kept apart from improve_requests, so they never show up in searches, previews or rankings. Title and
+content may be incomplete, the usual constraints only apply once the draft is published.
+*/
+CREATE TABLE IF NOT EXISTS improve_request_drafts (
+    id uuid PRIMARY KEY NOT NULL,
+    user_id uuid NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    title VARCHAR(256) NOT NULL DEFAULT '',
+    content TEXT NOT NULL DEFAULT '',
+    language VARCHAR(16) NOT NULL DEFAULT '',
+    tags uuid[] NOT NULL DEFAULT '{}',
+
+    publish_at TIMESTAMP,
+
+    CONSTRAINT content_length CHECK ( char_length(content) <= 4096 )
+);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS improve_request_drafts_user ON improve_request_drafts (user_id);
+/* Used by the job that publishes scheduled drafts. */
+CREATE INDEX IF NOT EXISTS improve_request_drafts_publish_at ON improve_request_drafts (publish_at)
+    WHERE publish_at IS NOT NULL;
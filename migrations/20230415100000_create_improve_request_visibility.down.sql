@@ -0,0 +1,15 @@
+DROP TRIGGER IF EXISTS delete_improve_request_access ON improve_requests;
+
+--bun:split
+
+DROP FUNCTION IF EXISTS delete_improve_request_access();
+
+--bun:split
+
+DROP TABLE IF EXISTS improve_request_share_tokens;
+DROP TABLE IF EXISTS improve_request_invites;
+DROP TABLE IF EXISTS improve_request_access;
+
+--bun:split
+
+DROP TYPE IF EXISTS improve_request_visibility;
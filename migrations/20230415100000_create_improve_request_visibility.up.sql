@@ -0,0 +1,53 @@
+CREATE TYPE improve_request_visibility AS ENUM ('public', 'unlisted', 'restricted');
+
+--bun:split
+
+/* Requests without a row are public. */
+CREATE TABLE IF NOT EXISTS improve_request_access (
+    source uuid PRIMARY KEY NOT NULL,
+    visibility improve_request_visibility NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+/* Users allowed to read a restricted or unlisted request, besides its authors. */
+CREATE TABLE IF NOT EXISTS improve_request_invites (
+    source uuid NOT NULL,
+    user_id uuid NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+
+    PRIMARY KEY (source, user_id)
+);
+
+/* Only a hash of the code is stored, the raw share token is returned once, on creation. */
+CREATE TABLE IF NOT EXISTS improve_request_share_tokens (
+    id uuid PRIMARY KEY NOT NULL,
+    source uuid NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    code TEXT NOT NULL
+);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS improve_request_access_hidden ON improve_request_access (source)
+    WHERE visibility <> 'public';
+CREATE INDEX IF NOT EXISTS improve_request_invites_user ON improve_request_invites (user_id);
+CREATE INDEX IF NOT EXISTS improve_request_share_tokens_source ON improve_request_share_tokens (source);
+
+--bun:split
+
+/* Deleting the first revision deletes the whole request. */
+CREATE FUNCTION delete_improve_request_access()
+    RETURNS trigger AS $delete_improve_request_access$
+BEGIN
+    DELETE FROM improve_request_access WHERE improve_request_access.source = OLD.source;
+    DELETE FROM improve_request_invites WHERE improve_request_invites.source = OLD.source;
+    DELETE FROM improve_request_share_tokens WHERE improve_request_share_tokens.source = OLD.source;
+    RETURN NULL;
+END;
+$delete_improve_request_access$ LANGUAGE plpgsql;
+
+CREATE TRIGGER delete_improve_request_access
+    AFTER DELETE ON improve_requests
+    FOR EACH ROW
+    WHEN (OLD.id = OLD.source)
+    EXECUTE FUNCTION delete_improve_request_access();
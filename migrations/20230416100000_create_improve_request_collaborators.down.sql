@@ -0,0 +1,13 @@
+DROP TRIGGER IF EXISTS delete_improve_request_collaborators ON improve_requests;
+
+--bun:split
+
+DROP FUNCTION IF EXISTS delete_improve_request_collaborators();
+
+--bun:split
+
+DROP TABLE IF EXISTS improve_request_collaborators;
+
+--bun:split
+
+DROP TYPE IF EXISTS improve_request_collaborator_role;
@@ -0,0 +1,39 @@
+CREATE TYPE improve_request_collaborator_role AS ENUM ('owner', 'editor');
+
+--bun:split
+
+/*
+    The author of the first revision is the implicit owner of a request, and never has a row here.
+    Invitations are pending until accepted_at is set.
+*/
+CREATE TABLE IF NOT EXISTS improve_request_collaborators (
+    source uuid NOT NULL,
+    user_id uuid NOT NULL,
+    role improve_request_collaborator_role NOT NULL,
+    invited_by uuid NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    accepted_at TIMESTAMP,
+
+    PRIMARY KEY (source, user_id)
+);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS improve_request_collaborators_user ON improve_request_collaborators (user_id);
+
+--bun:split
+
+/* Deleting the first revision deletes the whole request. */
+CREATE FUNCTION delete_improve_request_collaborators()
+    RETURNS trigger AS $delete_improve_request_collaborators$
+BEGIN
+    DELETE FROM improve_request_collaborators WHERE improve_request_collaborators.source = OLD.source;
+    RETURN NULL;
+END;
+$delete_improve_request_collaborators$ LANGUAGE plpgsql;
+
+CREATE TRIGGER delete_improve_request_collaborators
+    AFTER DELETE ON improve_requests
+    FOR EACH ROW
+    WHEN (OLD.id = OLD.source)
+    EXECUTE FUNCTION delete_improve_request_collaborators();
@@ -0,0 +1,13 @@
+DROP TRIGGER IF EXISTS delete_improve_request_state ON improve_requests;
+
+--bun:split
+
+DROP FUNCTION IF EXISTS delete_improve_request_state();
+
+--bun:split
+
+DROP TABLE IF EXISTS improve_request_states;
+
+--bun:split
+
+DROP TYPE IF EXISTS improve_request_state;
@@ -0,0 +1,34 @@
+CREATE TYPE improve_request_state AS ENUM ('open', 'closed', 'locked', 'archived');
+
+--bun:split
+
+/* Requests without a row are open. */
+CREATE TABLE IF NOT EXISTS improve_request_states (
+    source uuid PRIMARY KEY NOT NULL,
+    state improve_request_state NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    /* NULL when the state was changed automatically. */
+    updated_by uuid,
+    reason TEXT NOT NULL DEFAULT ''
+);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS improve_request_states_state ON improve_request_states (state);
+
+--bun:split
+
+/* Deleting the first revision deletes the whole request. */
+CREATE FUNCTION delete_improve_request_state()
+    RETURNS trigger AS $delete_improve_request_state$
+BEGIN
+    DELETE FROM improve_request_states WHERE improve_request_states.source = OLD.source;
+    RETURN NULL;
+END;
+$delete_improve_request_state$ LANGUAGE plpgsql;
+
+CREATE TRIGGER delete_improve_request_state
+    AFTER DELETE ON improve_requests
+    FOR EACH ROW
+    WHEN (OLD.id = OLD.source)
+    EXECUTE FUNCTION delete_improve_request_state();
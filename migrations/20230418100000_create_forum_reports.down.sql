@@ -0,0 +1,33 @@
+DROP TRIGGER IF EXISTS forbid_moderation_action_change ON forum_moderation_actions;
+
+--bun:split
+
+DROP FUNCTION IF EXISTS forbid_moderation_action_change();
+
+--bun:split
+
+DROP TABLE IF EXISTS forum_moderation_actions;
+
+--bun:split
+
+DROP TABLE IF EXISTS forum_report_cases;
+
+--bun:split
+
+DROP TABLE IF EXISTS forum_reports;
+
+--bun:split
+
+DROP TYPE IF EXISTS moderation_action;
+
+--bun:split
+
+DROP TYPE IF EXISTS report_status;
+
+--bun:split
+
+DROP TYPE IF EXISTS report_reason;
+
+--bun:split
+
+DROP TYPE IF EXISTS moderation_target;
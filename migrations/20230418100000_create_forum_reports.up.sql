@@ -0,0 +1,96 @@
+CREATE TYPE moderation_target AS ENUM ('improve_request', 'improve_suggestion', 'profile');
+
+--bun:split
+
+CREATE TYPE report_reason AS ENUM ('spam', 'harassment', 'off_topic', 'plagiarism', 'inappropriate', 'other');
+
+--bun:split
+
+CREATE TYPE report_status AS ENUM ('pending', 'claimed', 'resolved', 'dismissed');
+
+--bun:split
+
+CREATE TYPE moderation_action AS ENUM ('hide', 'claim', 'resolve', 'dismiss', 'lock', 'unlock');
+
+--bun:split
+
+/* A user can only report a given content once. */
+CREATE TABLE IF NOT EXISTS forum_reports (
+    id uuid PRIMARY KEY NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    target moderation_target NOT NULL,
+    /* ID of the first revision for improvement requests. */
+    target_id uuid NOT NULL,
+    reporter_id uuid NOT NULL,
+    reason report_reason NOT NULL,
+    content TEXT NOT NULL DEFAULT '',
+    UNIQUE (target, target_id, reporter_id)
+);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS forum_reports_target ON forum_reports (target, target_id);
+
+--bun:split
+
+/* Groups the reports of a content, for the moderation queue. */
+CREATE TABLE IF NOT EXISTS forum_report_cases (
+    target moderation_target NOT NULL,
+    target_id uuid NOT NULL,
+    status report_status NOT NULL,
+    /* Number of reports since the case was last dismissed. */
+    reports INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+    claimed_by uuid,
+    claimed_at TIMESTAMP,
+    closed_by uuid,
+    closed_at TIMESTAMP,
+    /* Hidden contents are removed from public listings and searches. */
+    hidden_at TIMESTAMP,
+    PRIMARY KEY (target, target_id)
+);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS forum_report_cases_status ON forum_report_cases (status);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS forum_report_cases_hidden ON forum_report_cases (target, target_id) WHERE hidden_at IS NOT NULL;
+
+--bun:split
+
+CREATE TABLE IF NOT EXISTS forum_moderation_actions (
+    id uuid PRIMARY KEY NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    /* NULL for automatic actions. */
+    moderator_id uuid,
+    action moderation_action NOT NULL,
+    target moderation_target NOT NULL,
+    target_id uuid NOT NULL,
+    note TEXT NOT NULL DEFAULT ''
+);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS forum_moderation_actions_target ON forum_moderation_actions (target, target_id);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS forum_moderation_actions_moderator ON forum_moderation_actions (moderator_id);
+
+--bun:split
+
+/* The moderation log is append-only. */
+CREATE FUNCTION forbid_moderation_action_change()
+    RETURNS trigger AS $forbid_moderation_action_change$
+BEGIN
+    RAISE EXCEPTION 'moderation actions cannot be modified';
+END;
+$forbid_moderation_action_change$ LANGUAGE plpgsql;
+
+CREATE TRIGGER forbid_moderation_action_change
+    BEFORE UPDATE OR DELETE ON forum_moderation_actions
+    FOR EACH ROW
+    EXECUTE FUNCTION forbid_moderation_action_change();
@@ -0,0 +1,22 @@
+DROP TRIGGER IF EXISTS invalidate_improve_request_related ON improve_requests;
+
+CREATE TRIGGER invalidate_improve_request_related
+    AFTER INSERT OR DELETE ON improve_requests
+    FOR EACH ROW
+    EXECUTE FUNCTION invalidate_improve_request_related();
+
+--bun:split
+
+DROP INDEX IF EXISTS improve_suggestions_deleted_at;
+DROP INDEX IF EXISTS improve_requests_deleted_at;
+
+--bun:split
+
+/* Without the column, deleted posts would come back. */
+DELETE FROM improve_suggestions WHERE deleted_at IS NOT NULL;
+DELETE FROM improve_requests WHERE deleted_at IS NOT NULL;
+
+--bun:split
+
+ALTER TABLE improve_suggestions DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE improve_requests DROP COLUMN IF EXISTS deleted_at;
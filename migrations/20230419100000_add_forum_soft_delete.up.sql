@@ -0,0 +1,22 @@
+/*
+Deleted posts are kept until they are purged, so they can be restored, and moderators keep their evidence. A request
+is deleted with all its revisions, which then share the same deletion time.
+*/
+ALTER TABLE improve_requests ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+ALTER TABLE improve_suggestions ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+--bun:split
+
+/* Used by the purge. */
+CREATE INDEX IF NOT EXISTS improve_requests_deleted_at ON improve_requests (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS improve_suggestions_deleted_at ON improve_suggestions (deleted_at) WHERE deleted_at IS NOT NULL;
+
+--bun:split
+
+/* Deleting or restoring a revision changes the content compared by the related ranking, just like a hard delete. */
+DROP TRIGGER IF EXISTS invalidate_improve_request_related ON improve_requests;
+
+CREATE TRIGGER invalidate_improve_request_related
+    AFTER INSERT OR DELETE OR UPDATE OF deleted_at ON improve_requests
+    FOR EACH ROW
+    EXECUTE FUNCTION invalidate_improve_request_related();
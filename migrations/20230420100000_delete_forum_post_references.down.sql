@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS improve_posts_bookmarks_request_id;
+
+--bun:split
+
+DROP TRIGGER IF EXISTS delete_improve_suggestion_references ON improve_suggestions;
+DROP TRIGGER IF EXISTS delete_improve_request_references ON improve_requests;
+
+--bun:split
+
+DROP FUNCTION IF EXISTS delete_forum_post_references();
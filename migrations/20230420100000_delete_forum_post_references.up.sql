@@ -0,0 +1,29 @@
+/*
+Votes and bookmarks point to either a request or a suggestion, so they cannot have a foreign key. They are removed
+with the post they target instead. Soft deleted posts keep them, so nothing is lost when a post is restored.
+*/
+CREATE FUNCTION delete_forum_post_references()
+    RETURNS trigger AS $delete_forum_post_references$
+BEGIN
+    DELETE FROM votes WHERE votes.post_id = OLD.id AND votes.target = TG_ARGV[0]::vote_target;
+    DELETE FROM improve_posts_bookmarks
+        WHERE improve_posts_bookmarks.request_id = OLD.id
+        AND improve_posts_bookmarks.target = TG_ARGV[0]::improve_posts_bookmark_target;
+    RETURN NULL;
+END;
+$delete_forum_post_references$ LANGUAGE plpgsql;
+
+CREATE TRIGGER delete_improve_request_references
+    AFTER DELETE ON improve_requests
+    FOR EACH ROW
+    EXECUTE FUNCTION delete_forum_post_references('improve_request');
+
+CREATE TRIGGER delete_improve_suggestion_references
+    AFTER DELETE ON improve_suggestions
+    FOR EACH ROW
+    EXECUTE FUNCTION delete_forum_post_references('improve_suggestion');
+
+--bun:split
+
+/* Votes are already indexed by post (votes_on_post). */
+CREATE INDEX IF NOT EXISTS improve_posts_bookmarks_request_id ON improve_posts_bookmarks (request_id, target);
@@ -0,0 +1,43 @@
+ALTER TABLE improve_suggestions ALTER COLUMN down_votes DROP DEFAULT;
+ALTER TABLE improve_suggestions ALTER COLUMN up_votes DROP DEFAULT;
+ALTER TABLE improve_requests ALTER COLUMN down_votes DROP DEFAULT;
+ALTER TABLE improve_requests ALTER COLUMN up_votes DROP DEFAULT;
+
+--bun:split
+
+CREATE OR REPLACE FUNCTION update_score()
+RETURNS trigger AS $update_score$
+DECLARE target vote_target; DECLARE target_id uuid; DECLARE downdiff BIGINT; DECLARE updiff BIGINT;
+BEGIN
+    target := CASE WHEN NEW IS NULL THEN OLD.target ELSE NEW.target END;
+    target_id := CASE WHEN NEW IS NULL THEN OLD.post_id ELSE NEW.post_id END;
+    updiff := 0;
+    downdiff := 0;
+
+    IF OLD IS NOT NULL THEN
+        IF OLD.vote = 'up' THEN
+            updiff := updiff - 1;
+        ELSIF OLD.vote = 'down' THEN
+            downdiff := downdiff - 1;
+        END IF;
+    END IF;
+
+    IF NEW IS NOT NULL THEN
+        IF NEW.vote = 'up' THEN
+            updiff := updiff + 1;
+        ELSIF NEW.vote = 'down' THEN
+            downdiff := downdiff + 1;
+        END IF;
+    END IF;
+
+    IF target = 'improve_request' THEN
+        UPDATE improve_requests SET up_votes = up_votes + updiff, down_votes = down_votes + downdiff WHERE id = target_id;
+    ELSIF target = 'improve_suggestion' THEN
+        UPDATE improve_suggestions SET up_votes = up_votes + updiff, down_votes = down_votes + downdiff WHERE id = target_id;
+    ELSE
+        RAISE EXCEPTION 'Invalid vote target';
+    END IF;
+
+    RETURN NEW;
+END;
+$update_score$ LANGUAGE plpgsql;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS forum_vote_ring_flags;
+
+/* Values cannot be removed from an enum: 'void_votes' stays in moderation_action. */
@@ -0,0 +1,24 @@
+/* Recorded when a moderator voids the votes of a suspected sockpuppet. */
+ALTER TYPE moderation_action ADD VALUE IF NOT EXISTS 'void_votes';
+
+--bun:split
+
+/*
+Voters suspected to take part in a voting ring around an author, waiting for a moderator review. Voided flags had the
+votes of the voter on the posts of the author removed.
+*/
+CREATE TABLE IF NOT EXISTS forum_vote_ring_flags (
+    author_id uuid NOT NULL,
+    voter_id uuid NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    votes BIGINT NOT NULL,
+
+    reviewed_at TIMESTAMP,
+    reviewed_by uuid,
+    voided boolean NOT NULL DEFAULT false,
+
+    PRIMARY KEY (author_id, voter_id)
+);
+
+CREATE INDEX IF NOT EXISTS forum_vote_ring_flags_pending ON forum_vote_ring_flags (created_at DESC)
+    WHERE reviewed_at IS NULL;
@@ -0,0 +1,15 @@
+CREATE OR REPLACE FUNCTION delete_forum_post_references()
+    RETURNS trigger AS $delete_forum_post_references$
+BEGIN
+    DELETE FROM votes WHERE votes.post_id = OLD.id AND votes.target = TG_ARGV[0]::vote_target;
+    DELETE FROM improve_posts_bookmarks
+        WHERE improve_posts_bookmarks.request_id = OLD.id
+        AND improve_posts_bookmarks.target = TG_ARGV[0]::improve_posts_bookmark_target;
+    RETURN NULL;
+END;
+$delete_forum_post_references$ LANGUAGE plpgsql;
+
+--bun:split
+
+DROP INDEX IF EXISTS reactions_by_user;
+DROP TABLE IF EXISTS reactions;
@@ -0,0 +1,29 @@
+/*
+Reactions complete votes with a more nuanced feedback. Unlike votes, a user may leave several reactions on the same
+post. The set of available reactions is configured in the application, so it is not constrained here.
+*/
+CREATE TABLE IF NOT EXISTS reactions (
+    post_id uuid NOT NULL,
+    target vote_target NOT NULL,
+    reaction VARCHAR(32) NOT NULL,
+    user_id uuid NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+
+    PRIMARY KEY (post_id, target, reaction, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS reactions_by_user ON reactions (user_id, post_id, target);
+
+--bun:split
+
+CREATE OR REPLACE FUNCTION delete_forum_post_references()
+    RETURNS trigger AS $delete_forum_post_references$
+BEGIN
+    DELETE FROM votes WHERE votes.post_id = OLD.id AND votes.target = TG_ARGV[0]::vote_target;
+    DELETE FROM reactions WHERE reactions.post_id = OLD.id AND reactions.target = TG_ARGV[0]::vote_target;
+    DELETE FROM improve_posts_bookmarks
+        WHERE improve_posts_bookmarks.request_id = OLD.id
+        AND improve_posts_bookmarks.target = TG_ARGV[0]::improve_posts_bookmark_target;
+    RETURN NULL;
+END;
+$delete_forum_post_references$ LANGUAGE plpgsql;
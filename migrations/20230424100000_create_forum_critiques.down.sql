@@ -0,0 +1,25 @@
+DROP TRIGGER IF EXISTS delete_improve_suggestion_ratings ON improve_suggestions;
+
+--bun:split
+
+DROP FUNCTION IF EXISTS delete_improve_suggestion_ratings();
+
+--bun:split
+
+DROP TRIGGER IF EXISTS delete_improve_request_aspects ON improve_requests;
+
+--bun:split
+
+DROP FUNCTION IF EXISTS delete_improve_request_aspects();
+
+--bun:split
+
+DROP TABLE IF EXISTS improve_suggestion_ratings;
+
+--bun:split
+
+DROP TABLE IF EXISTS improve_request_aspects;
+
+--bun:split
+
+DROP TYPE IF EXISTS critique_aspect;
@@ -0,0 +1,55 @@
+CREATE TYPE critique_aspect AS ENUM ('pacing', 'dialogue', 'pov', 'grammar', 'tone');
+
+--bun:split
+
+/* The aspects the owners of a request want feedback on. */
+CREATE TABLE IF NOT EXISTS improve_request_aspects (
+    source uuid NOT NULL,
+    aspect critique_aspect NOT NULL,
+
+    PRIMARY KEY (source, aspect)
+);
+
+--bun:split
+
+/* Suggesters may rate the request on each requested aspect, with an optional note. */
+CREATE TABLE IF NOT EXISTS improve_suggestion_ratings (
+    suggestion_id uuid NOT NULL,
+    aspect critique_aspect NOT NULL,
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    note TEXT NOT NULL DEFAULT '',
+
+    PRIMARY KEY (suggestion_id, aspect)
+);
+
+--bun:split
+
+/* Deleting the first revision deletes the whole request. */
+CREATE FUNCTION delete_improve_request_aspects()
+    RETURNS trigger AS $delete_improve_request_aspects$
+BEGIN
+    DELETE FROM improve_request_aspects WHERE improve_request_aspects.source = OLD.source;
+    RETURN NULL;
+END;
+$delete_improve_request_aspects$ LANGUAGE plpgsql;
+
+CREATE TRIGGER delete_improve_request_aspects
+    AFTER DELETE ON improve_requests
+    FOR EACH ROW
+    WHEN (OLD.id = OLD.source)
+    EXECUTE FUNCTION delete_improve_request_aspects();
+
+--bun:split
+
+CREATE FUNCTION delete_improve_suggestion_ratings()
+    RETURNS trigger AS $delete_improve_suggestion_ratings$
+BEGIN
+    DELETE FROM improve_suggestion_ratings WHERE improve_suggestion_ratings.suggestion_id = OLD.id;
+    RETURN NULL;
+END;
+$delete_improve_suggestion_ratings$ LANGUAGE plpgsql;
+
+CREATE TRIGGER delete_improve_suggestion_ratings
+    AFTER DELETE ON improve_suggestions
+    FOR EACH ROW
+    EXECUTE FUNCTION delete_improve_suggestion_ratings();
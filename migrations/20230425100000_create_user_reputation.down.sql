@@ -0,0 +1,18 @@
+DROP TRIGGER IF EXISTS record_report_reputation ON forum_report_cases;
+DROP TRIGGER IF EXISTS record_suggestion_reputation ON improve_suggestions;
+DROP TRIGGER IF EXISTS record_vote_reputation ON votes;
+
+--bun:split
+
+DROP FUNCTION IF EXISTS record_report_reputation();
+DROP FUNCTION IF EXISTS record_suggestion_reputation();
+DROP FUNCTION IF EXISTS record_vote_reputation();
+
+--bun:split
+
+DROP INDEX IF EXISTS reputation_events_user;
+DROP TABLE IF EXISTS reputation_events;
+
+--bun:split
+
+DROP TYPE IF EXISTS reputation_event;
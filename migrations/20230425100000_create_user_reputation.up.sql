@@ -0,0 +1,149 @@
+CREATE TYPE reputation_event AS ENUM ('vote_received', 'suggestion_accepted', 'report_upheld');
+
+--bun:split
+
+/*
+Ledger of the reputation of users. The reputation of a user is the sum of the points of its events. Events are
+recorded by the triggers below, so the ledger follows every vote, even those removed by moderators.
+*/
+CREATE TABLE IF NOT EXISTS reputation_events (
+    id uuid PRIMARY KEY NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    user_id uuid NOT NULL,
+    event reputation_event NOT NULL,
+    /* Post that received the vote, accepted suggestion, or target of the report case. */
+    source_id uuid NOT NULL,
+    points INTEGER NOT NULL
+);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS reputation_events_user ON reputation_events (user_id);
+
+--bun:split
+
+/*
+An up vote is worth 1 point, and a down vote costs 1 point. Changing or removing a vote records the difference.
+Votes removed along with their post are ignored, as the post is already gone.
+*/
+CREATE FUNCTION record_vote_reputation()
+    RETURNS trigger AS $record_vote_reputation$
+DECLARE target vote_target; DECLARE target_id uuid; DECLARE author uuid; DECLARE points INTEGER;
+BEGIN
+    target := CASE WHEN NEW IS NULL THEN OLD.target ELSE NEW.target END;
+    target_id := CASE WHEN NEW IS NULL THEN OLD.post_id ELSE NEW.post_id END;
+    points := 0;
+
+    IF OLD IS NOT NULL THEN
+        points := points - CASE WHEN OLD.vote = 'up' THEN 1 ELSE -1 END;
+    END IF;
+
+    IF NEW IS NOT NULL THEN
+        points := points + CASE WHEN NEW.vote = 'up' THEN 1 ELSE -1 END;
+    END IF;
+
+    IF points = 0 THEN
+        RETURN NULL;
+    END IF;
+
+    IF target = 'improve_request' THEN
+        SELECT user_id INTO author FROM improve_requests WHERE id = target_id;
+    ELSE
+        SELECT user_id INTO author FROM improve_suggestions WHERE id = target_id;
+    END IF;
+
+    IF author IS NOT NULL THEN
+        INSERT INTO reputation_events (id, created_at, user_id, event, source_id, points)
+            VALUES (gen_random_uuid(), timezone('utc', now()), author, 'vote_received', target_id, points);
+    END IF;
+
+    RETURN NULL;
+END;
+$record_vote_reputation$ LANGUAGE plpgsql;
+
+CREATE TRIGGER record_vote_reputation
+    AFTER INSERT OR UPDATE OF vote OR DELETE ON votes
+    FOR EACH ROW
+    EXECUTE FUNCTION record_vote_reputation();
+
+--bun:split
+
+/* An accepted suggestion is worth 10 points, taken back if the suggestion is rejected afterwards. */
+CREATE FUNCTION record_suggestion_reputation()
+    RETURNS trigger AS $record_suggestion_reputation$
+BEGIN
+    INSERT INTO reputation_events (id, created_at, user_id, event, source_id, points)
+        VALUES (
+            gen_random_uuid(), timezone('utc', now()), NEW.user_id, 'suggestion_accepted', NEW.id,
+            CASE WHEN COALESCE(NEW.validated, FALSE) THEN 10 ELSE -10 END
+        );
+    RETURN NULL;
+END;
+$record_suggestion_reputation$ LANGUAGE plpgsql;
+
+CREATE TRIGGER record_suggestion_reputation
+    AFTER UPDATE OF validated ON improve_suggestions
+    FOR EACH ROW
+    WHEN (COALESCE(OLD.validated, FALSE) IS DISTINCT FROM COALESCE(NEW.validated, FALSE))
+    EXECUTE FUNCTION record_suggestion_reputation();
+
+--bun:split
+
+/* A report upheld by a moderator costs 20 points to the author of the reported content. */
+CREATE FUNCTION record_report_reputation()
+    RETURNS trigger AS $record_report_reputation$
+DECLARE author uuid;
+BEGIN
+    IF NEW.target = 'improve_request' THEN
+        SELECT user_id INTO author FROM improve_requests WHERE id = NEW.target_id;
+    ELSIF NEW.target = 'improve_suggestion' THEN
+        SELECT user_id INTO author FROM improve_suggestions WHERE id = NEW.target_id;
+    ELSE
+        author := NEW.target_id;
+    END IF;
+
+    IF author IS NOT NULL THEN
+        INSERT INTO reputation_events (id, created_at, user_id, event, source_id, points)
+            VALUES (gen_random_uuid(), timezone('utc', now()), author, 'report_upheld', NEW.target_id, -20);
+    END IF;
+
+    RETURN NULL;
+END;
+$record_report_reputation$ LANGUAGE plpgsql;
+
+CREATE TRIGGER record_report_reputation
+    AFTER UPDATE OF status ON forum_report_cases
+    FOR EACH ROW
+    WHEN (NEW.status = 'resolved' AND OLD.status <> 'resolved')
+    EXECUTE FUNCTION record_report_reputation();
+
+--bun:split
+
+/* Existing activity is recorded, so users start with the reputation they already earned. */
+INSERT INTO reputation_events (id, created_at, user_id, event, source_id, points)
+    SELECT gen_random_uuid(), votes.updated_at, posts.user_id, 'vote_received', votes.post_id,
+           CASE WHEN votes.vote = 'up' THEN 1 ELSE -1 END
+    FROM votes
+        JOIN (
+            SELECT id, user_id, 'improve_request'::vote_target AS target FROM improve_requests
+            UNION ALL
+            SELECT id, user_id, 'improve_suggestion'::vote_target AS target FROM improve_suggestions
+        ) AS posts ON posts.id = votes.post_id AND posts.target = votes.target;
+
+INSERT INTO reputation_events (id, created_at, user_id, event, source_id, points)
+    SELECT gen_random_uuid(), COALESCE(updated_at, created_at), user_id, 'suggestion_accepted', id, 10
+    FROM improve_suggestions
+    WHERE validated = TRUE;
+
+INSERT INTO reputation_events (id, created_at, user_id, event, source_id, points)
+    SELECT gen_random_uuid(), COALESCE(cases.closed_at, cases.created_at), authors.user_id, 'report_upheld',
+           cases.target_id, -20
+    FROM forum_report_cases AS cases
+        JOIN LATERAL (
+            SELECT CASE
+                WHEN cases.target = 'improve_request' THEN (SELECT user_id FROM improve_requests WHERE id = cases.target_id)
+                WHEN cases.target = 'improve_suggestion' THEN (SELECT user_id FROM improve_suggestions WHERE id = cases.target_id)
+                ELSE cases.target_id
+            END AS user_id
+        ) AS authors ON authors.user_id IS NOT NULL
+    WHERE cases.status = 'resolved';
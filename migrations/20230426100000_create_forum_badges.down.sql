@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS improve_requests_created_at;
+DROP INDEX IF EXISTS reputation_events_created_at;
+
+--bun:split
+
+DROP TABLE IF EXISTS forum_user_badges;
+
+--bun:split
+
+DROP TYPE IF EXISTS forum_badge;
@@ -0,0 +1,17 @@
+CREATE TYPE forum_badge AS ENUM ('first_accepted_suggestion', 'persistent_writer', 'hundred_up_votes');
+
+--bun:split
+
+/* Badges earned by users on the forum. A badge is awarded once per user, and never taken back. */
+CREATE TABLE IF NOT EXISTS forum_user_badges (
+    user_id uuid NOT NULL,
+    badge forum_badge NOT NULL,
+    awarded_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, badge)
+);
+
+--bun:split
+
+/* Badges are evaluated for the users with recent events in the reputation ledger. */
+CREATE INDEX IF NOT EXISTS reputation_events_created_at ON reputation_events (created_at);
+CREATE INDEX IF NOT EXISTS improve_requests_created_at ON improve_requests (created_at);
@@ -0,0 +1,6 @@
+DROP MATERIALIZED VIEW IF EXISTS forum_leaderboards;
+
+--bun:split
+
+DROP TYPE IF EXISTS forum_leaderboard_period;
+DROP TYPE IF EXISTS forum_leaderboard;
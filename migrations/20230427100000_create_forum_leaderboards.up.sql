@@ -0,0 +1,55 @@
+CREATE TYPE forum_leaderboard AS ENUM ('accepted_suggestions', 'suggestion_score', 'requests');
+CREATE TYPE forum_leaderboard_period AS ENUM ('month', 'all_time');
+
+--bun:split
+
+/*
+Leaderboards rank suggesters by their number of accepted suggestions, or by the net score of their suggestions, and
+requests by their net score. Monthly boards start on the first day of the current month (UTC): they only count the
+suggestions created, and the votes cast or changed, since then. Deleted posts are ignored.
+Leaderboards aggregate the whole forum, so they are materialized and refreshed periodically, rather than computed on
+every read. The target is a user for the suggestion boards, and the source of a request for the requests board.
+*/
+CREATE MATERIALIZED VIEW forum_leaderboards AS
+WITH periods AS (
+    SELECT 'month'::forum_leaderboard_period AS period,
+           date_trunc('month', timezone('utc', now())) AS since
+    UNION ALL
+    SELECT 'all_time'::forum_leaderboard_period AS period,
+           '-infinity'::TIMESTAMP AS since
+)
+SELECT periods.period AS period,
+       'accepted_suggestions'::forum_leaderboard AS board,
+       improve_suggestions.user_id AS target_id,
+       COUNT(*) AS score
+FROM periods
+    JOIN improve_suggestions ON improve_suggestions.created_at >= periods.since
+WHERE improve_suggestions.validated
+  AND improve_suggestions.deleted_at IS NULL
+GROUP BY periods.period, improve_suggestions.user_id
+UNION ALL
+SELECT periods.period AS period,
+       'suggestion_score'::forum_leaderboard AS board,
+       improve_suggestions.user_id AS target_id,
+       SUM(CASE WHEN votes.vote = 'up' THEN 1 ELSE -1 END) AS score
+FROM periods
+    JOIN votes ON votes.updated_at >= periods.since
+    JOIN improve_suggestions ON improve_suggestions.id = votes.post_id
+WHERE votes.target = 'improve_suggestion'
+  AND improve_suggestions.deleted_at IS NULL
+GROUP BY periods.period, improve_suggestions.user_id
+UNION ALL
+SELECT periods.period AS period,
+       'requests'::forum_leaderboard AS board,
+       improve_requests.source AS target_id,
+       SUM(CASE WHEN votes.vote = 'up' THEN 1 ELSE -1 END) AS score
+FROM periods
+    JOIN votes ON votes.updated_at >= periods.since
+    JOIN improve_requests ON improve_requests.id = votes.post_id
+WHERE votes.target = 'improve_request'
+  AND improve_requests.deleted_at IS NULL
+GROUP BY periods.period, improve_requests.source;
+
+/* Required to refresh the view concurrently. */
+CREATE UNIQUE INDEX forum_leaderboards_target ON forum_leaderboards (period, board, target_id);
+CREATE INDEX forum_leaderboards_score ON forum_leaderboards (period, board, score DESC, target_id);
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS delete_improve_request_suggestions ON improve_requests;
+
+--bun:split
+
+DROP FUNCTION IF EXISTS delete_improve_request_suggestions();
@@ -0,0 +1,19 @@
+/*
+Suggestions point to the source of a request, which is shared by all its revisions, so they cannot have a foreign key
+either. They are removed once the last revision of their request is, which in turn removes their own references.
+*/
+CREATE FUNCTION delete_improve_request_suggestions()
+    RETURNS trigger AS $delete_improve_request_suggestions$
+BEGIN
+    DELETE FROM improve_suggestions
+        WHERE improve_suggestions.source_id = OLD.source
+        AND NOT EXISTS (SELECT 1 FROM improve_requests WHERE improve_requests.source = OLD.source);
+    RETURN NULL;
+END;
+$delete_improve_request_suggestions$ LANGUAGE plpgsql;
+
+CREATE TRIGGER delete_improve_request_suggestions
+    AFTER DELETE ON improve_requests
+    FOR EACH ROW
+    EXECUTE FUNCTION delete_improve_request_suggestions();
+
@@ -0,0 +1 @@
+/* Badges are never taken back, so the badges awarded by the up migration are kept. */
@@ -0,0 +1,32 @@
+/*
+Badges are only evaluated for the users with recent activity, so the users who earned them before badges existed
+would never get them. They are awarded once here, with the same rules and thresholds as the badges service.
+*/
+INSERT INTO forum_user_badges (user_id, badge, awarded_at)
+SELECT DISTINCT user_id, 'first_accepted_suggestion'::forum_badge, timezone('utc', now())
+FROM improve_suggestions
+WHERE validated = TRUE AND deleted_at IS NULL
+ON CONFLICT (user_id, badge) DO NOTHING;
+
+--bun:split
+
+INSERT INTO forum_user_badges (user_id, badge, awarded_at)
+SELECT DISTINCT user_id, 'persistent_writer'::forum_badge, timezone('utc', now())
+FROM improve_requests
+WHERE deleted_at IS NULL
+GROUP BY user_id, source
+HAVING COUNT(*) >= 10
+ON CONFLICT (user_id, badge) DO NOTHING;
+
+--bun:split
+
+INSERT INTO forum_user_badges (user_id, badge, awarded_at)
+SELECT user_id, 'hundred_up_votes'::forum_badge, timezone('utc', now())
+FROM (
+    SELECT user_id, up_votes FROM improve_requests WHERE deleted_at IS NULL
+    UNION ALL
+    SELECT user_id, up_votes FROM improve_suggestions WHERE deleted_at IS NULL
+) AS posts
+GROUP BY user_id
+HAVING SUM(up_votes) >= 100
+ON CONFLICT (user_id, badge) DO NOTHING;
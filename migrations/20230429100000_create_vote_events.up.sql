@@ -0,0 +1,14 @@
+/*
+Every vote cast, changed or cancelled by a user. Unlike votes, which only keep the current vote of a user on a post,
+the log keeps each change, so cancelling and casting a vote again still counts towards the vote rate limit. A NULL
+vote is a cancelled vote.
+*/
+CREATE TABLE IF NOT EXISTS vote_events (
+    created_at TIMESTAMP NOT NULL,
+    user_id uuid NOT NULL,
+    post_id uuid NOT NULL,
+    target vote_target NOT NULL,
+    vote vote
+);
+
+CREATE INDEX IF NOT EXISTS vote_events_user ON vote_events (user_id, created_at DESC);
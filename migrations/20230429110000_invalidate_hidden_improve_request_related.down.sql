@@ -0,0 +1,20 @@
+DROP TRIGGER IF EXISTS invalidate_visibility_improve_request_related ON improve_request_access;
+
+--bun:split
+
+DROP TRIGGER IF EXISTS invalidate_moderated_improve_request_related ON forum_report_cases;
+DROP TRIGGER IF EXISTS invalidate_reported_improve_request_related ON forum_report_cases;
+
+--bun:split
+
+DROP TRIGGER IF EXISTS invalidate_deleted_improve_request_related ON improve_requests;
+DROP TRIGGER IF EXISTS invalidate_improve_request_related ON improve_requests;
+
+CREATE TRIGGER invalidate_improve_request_related
+    AFTER INSERT OR DELETE OR UPDATE OF deleted_at ON improve_requests
+    FOR EACH ROW
+    EXECUTE FUNCTION invalidate_improve_request_related();
+
+--bun:split
+
+DROP FUNCTION IF EXISTS invalidate_hidden_improve_request_related();
@@ -0,0 +1,67 @@
+/*
+A request that is deleted, restored or hidden changes the posts the related ranking can show. The entry of the request
+is dropped, as well as every entry that lists it. A request that shows up again is missing from the entries that did
+not list it, so the entries of the requests sharing a tag with it are dropped too, like when a request is created.
+*/
+CREATE FUNCTION invalidate_hidden_improve_request_related()
+    RETURNS trigger AS $invalidate_hidden_improve_request_related$
+DECLARE
+    changed uuid;
+BEGIN
+    IF TG_TABLE_NAME = 'forum_report_cases' THEN
+        changed := NEW.target_id;
+    ELSE
+        changed := NEW.source;
+    END IF;
+
+    DELETE FROM improve_request_related
+    WHERE improve_request_related.source = changed
+       OR improve_request_related.related @> ARRAY[changed]
+       OR improve_request_related.source IN (
+           SELECT tagged.source
+           FROM improve_requests AS changed_revisions
+               JOIN improve_request_tags AS changed_tags ON changed_tags.request_id = changed_revisions.id
+               JOIN improve_request_tags AS other_tags ON other_tags.tag_id = changed_tags.tag_id
+               JOIN improve_requests AS tagged ON tagged.id = other_tags.request_id
+           WHERE changed_revisions.source = changed
+       );
+
+    RETURN NULL;
+END;
+$invalidate_hidden_improve_request_related$ LANGUAGE plpgsql;
+
+--bun:split
+
+DROP TRIGGER IF EXISTS invalidate_improve_request_related ON improve_requests;
+
+CREATE TRIGGER invalidate_improve_request_related
+    AFTER INSERT OR DELETE ON improve_requests
+    FOR EACH ROW
+    EXECUTE FUNCTION invalidate_improve_request_related();
+
+CREATE TRIGGER invalidate_deleted_improve_request_related
+    AFTER UPDATE OF deleted_at ON improve_requests
+    FOR EACH ROW
+    WHEN (OLD.deleted_at IS DISTINCT FROM NEW.deleted_at)
+    EXECUTE FUNCTION invalidate_hidden_improve_request_related();
+
+--bun:split
+
+CREATE TRIGGER invalidate_reported_improve_request_related
+    AFTER INSERT ON forum_report_cases
+    FOR EACH ROW
+    WHEN (NEW.target = 'improve_request' AND NEW.hidden_at IS NOT NULL)
+    EXECUTE FUNCTION invalidate_hidden_improve_request_related();
+
+CREATE TRIGGER invalidate_moderated_improve_request_related
+    AFTER UPDATE OF hidden_at ON forum_report_cases
+    FOR EACH ROW
+    WHEN (NEW.target = 'improve_request' AND OLD.hidden_at IS DISTINCT FROM NEW.hidden_at)
+    EXECUTE FUNCTION invalidate_hidden_improve_request_related();
+
+--bun:split
+
+CREATE TRIGGER invalidate_visibility_improve_request_related
+    AFTER INSERT OR UPDATE OF visibility ON improve_request_access
+    FOR EACH ROW
+    EXECUTE FUNCTION invalidate_hidden_improve_request_related();
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// ForumBadge is an achievement, awarded to users for their activity on the forum.
+type ForumBadge string
+
+const (
+	// ForumBadgeFirstAcceptedSuggestion is awarded once a suggestion of the user is accepted.
+	ForumBadgeFirstAcceptedSuggestion ForumBadge = "first_accepted_suggestion"
+	// ForumBadgePersistentWriter is awarded once the user published 10 revisions of the same improvement request.
+	ForumBadgePersistentWriter ForumBadge = "persistent_writer"
+	// ForumBadgeHundredUpVotes is awarded once the posts of the user received 100 up votes.
+	ForumBadgeHundredUpVotes ForumBadge = "hundred_up_votes"
+)
+
+// UserBadge is a badge awarded to a user.
+type UserBadge struct {
+	Badge ForumBadge `json:"badge"`
+	// AwardedAt stores the time at which the user earned the badge.
+	AwardedAt time.Time `json:"awardedAt"`
+}
+
+// ForumActivity sums up the forum activity of a user, badges are awarded for.
+type ForumActivity struct {
+	// AcceptedSuggestions is the number of suggestions of the user that were accepted.
+	AcceptedSuggestions int64 `json:"acceptedSuggestions"`
+	// MaxRevisions is the highest number of revisions the user published for a single improvement request.
+	MaxRevisions int64 `json:"maxRevisions"`
+	// UpVotes is the number of up votes received by the posts of the user.
+	UpVotes int64 `json:"upVotes"`
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// ForumPostTarget is the type of forum post a revision belongs to.
+type ForumPostTarget string
+
+const (
+	ForumPostTargetImproveRequest    ForumPostTarget = "improve_request"
+	ForumPostTargetImproveSuggestion ForumPostTarget = "improve_suggestion"
+)
+
+// ForumPostRevision locates a single revision of a forum post, and holds its content.
+type ForumPostRevision struct {
+	// RevisionID is the ID of the revision: the ID of an improvement request revision, or the RevisionID of an
+	// improvement suggestion.
+	RevisionID uuid.UUID `json:"revisionID"`
+	// PostID is the source of the improvement request, or the ID of the improvement suggestion.
+	PostID uuid.UUID `json:"postID"`
+	// ThreadID is the source of the improvement request the post belongs to.
+	ThreadID uuid.UUID `json:"threadID"`
+	// UserID is the ID of the author of the revision.
+	UserID uuid.UUID `json:"userID"`
+	// Target is the type of the post.
+	Target ForumPostTarget `json:"target"`
+	// CreatedAt stores the time at which the revision was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// Content of the revision.
+	Content string `json:"content"`
+}
+
+// ForumDuplicateFlag is raised when a post revision looks like a copy of an older revision, posted by another user.
+// Flagged posts remain visible until a moderator reviews them.
+type ForumDuplicateFlag struct {
+	// CreatedAt stores the time at which the flag was raised.
+	CreatedAt time.Time `json:"createdAt"`
+	// Similarity is the estimated share of content both revisions have in common, between 0 and 1.
+	Similarity float64 `json:"similarity"`
+
+	// RevisionID is the ID of the suspicious revision.
+	RevisionID uuid.UUID       `json:"revisionID"`
+	PostID     uuid.UUID       `json:"postID"`
+	ThreadID   uuid.UUID       `json:"threadID"`
+	UserID     uuid.UUID       `json:"userID"`
+	Target     ForumPostTarget `json:"target"`
+
+	// OriginalRevisionID is the ID of the older revision it looks like.
+	OriginalRevisionID uuid.UUID       `json:"originalRevisionID"`
+	OriginalPostID     uuid.UUID       `json:"originalPostID"`
+	OriginalThreadID   uuid.UUID       `json:"originalThreadID"`
+	OriginalUserID     uuid.UUID       `json:"originalUserID"`
+	OriginalTarget     ForumPostTarget `json:"originalTarget"`
+
+	// ReviewedAt stores the time at which a moderator reviewed the flag. It is nil while the flag is pending.
+	ReviewedAt *time.Time `json:"reviewedAt"`
+	// ReviewedBy is the ID of the moderator who reviewed the flag.
+	ReviewedBy *uuid.UUID `json:"reviewedBy"`
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// ModerationActionType is the type of decision recorded in the moderation log.
+type ModerationActionType string
+
+const (
+	ModerationActionHide    ModerationActionType = "hide"
+	ModerationActionClaim   ModerationActionType = "claim"
+	ModerationActionResolve ModerationActionType = "resolve"
+	ModerationActionDismiss ModerationActionType = "dismiss"
+	ModerationActionLock    ModerationActionType = "lock"
+	ModerationActionUnlock  ModerationActionType = "unlock"
+	// ModerationActionVoidVotes is recorded against the profile of a suspected sockpuppet, when its votes are
+	// removed.
+	ModerationActionVoidVotes ModerationActionType = "void_votes"
+)
+
+// ModerationAction is an entry of the moderation log. Entries can never be updated nor deleted.
+type ModerationAction struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ModeratorID is nil for automatic actions.
+	ModeratorID *uuid.UUID           `json:"moderatorID"`
+	Action      ModerationActionType `json:"action"`
+	Target      ModerationTarget     `json:"target"`
+	TargetID    uuid.UUID            `json:"targetID"`
+	// Note is an optional message from the moderator.
+	Note string `json:"note"`
+}
+
+// ModerationActionQuery filters the moderation log. Empty fields are ignored.
+type ModerationActionQuery struct {
+	Target      *ModerationTarget `json:"target"`
+	TargetID    *uuid.UUID        `json:"targetID"`
+	ModeratorID *uuid.UUID        `json:"moderatorID"`
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// ModerationTarget is the kind of content a report or a moderation action applies to.
+type ModerationTarget string
+
+const (
+	// ModerationTargetImproveRequest targets a whole improvement request, through the ID of its first revision.
+	ModerationTargetImproveRequest ModerationTarget = "improve_request"
+	// ModerationTargetImproveSuggestion targets a single improvement suggestion.
+	ModerationTargetImproveSuggestion ModerationTarget = "improve_suggestion"
+	// ModerationTargetProfile targets the public profile of a user.
+	ModerationTargetProfile ModerationTarget = "profile"
+)
+
+// ReportReason is the category of a ForumReport.
+type ReportReason string
+
+const (
+	ReportReasonSpam          ReportReason = "spam"
+	ReportReasonHarassment    ReportReason = "harassment"
+	ReportReasonOffTopic      ReportReason = "off_topic"
+	ReportReasonPlagiarism    ReportReason = "plagiarism"
+	ReportReasonInappropriate ReportReason = "inappropriate"
+	// ReportReasonOther requires the reporter to explain the issue.
+	ReportReasonOther ReportReason = "other"
+)
+
+// ReportStatus is the progress of a ForumReportCase in the moderation queue.
+type ReportStatus string
+
+const (
+	// ReportStatusPending cases wait for a moderator.
+	ReportStatusPending ReportStatus = "pending"
+	// ReportStatusClaimed cases are being reviewed by a moderator.
+	ReportStatusClaimed ReportStatus = "claimed"
+	// ReportStatusResolved cases were upheld by a moderator. The content stays hidden.
+	ReportStatusResolved ReportStatus = "resolved"
+	// ReportStatusDismissed cases were rejected by a moderator. The content is visible again.
+	ReportStatusDismissed ReportStatus = "dismissed"
+)
+
+// ForumReport is sent by a user, to bring a content to the attention of moderators.
+type ForumReport struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	Target ModerationTarget `json:"target"`
+	// TargetID is the ID of the reported content. For improvement requests, it is the ID of the first revision.
+	TargetID   uuid.UUID    `json:"targetID"`
+	ReporterID uuid.UUID    `json:"reporterID"`
+	Reason     ReportReason `json:"reason"`
+	// Content is an optional message from the reporter.
+	Content string `json:"content"`
+}
+
+// ForumReportCase groups the reports of a content in the moderation queue.
+type ForumReportCase struct {
+	Target   ModerationTarget `json:"target"`
+	TargetID uuid.UUID        `json:"targetID"`
+
+	Status ReportStatus `json:"status"`
+	// Reports is the number of distinct users who reported the content since the case was last dismissed.
+	Reports int `json:"reports"`
+
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+
+	// ClaimedBy is the ID of the moderator reviewing the case.
+	ClaimedBy *uuid.UUID `json:"claimedBy"`
+	ClaimedAt *time.Time `json:"claimedAt"`
+	// ClosedBy is the ID of the moderator who resolved or dismissed the case.
+	ClosedBy *uuid.UUID `json:"closedBy"`
+	ClosedAt *time.Time `json:"closedAt"`
+	// HiddenAt is set while the content is removed from public listings and searches.
+	HiddenAt *time.Time `json:"hiddenAt"`
+}
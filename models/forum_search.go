@@ -0,0 +1,16 @@
+package models
+
+// ForumSearchResults holds the results of a search across every type of forum post. Each type of post is
+// paginated independently.
+type ForumSearchResults struct {
+	// ImproveRequests contains the latest revisions of the improvement requests matching the query.
+	ImproveRequests []*ImproveRequestPreview `json:"improveRequests"`
+	// ImproveRequestsTotal is the total number of improvement requests matching the query.
+	ImproveRequestsTotal int64 `json:"improveRequestsTotal"`
+	// ImproveSuggestions contains the improvement suggestions matching the query.
+	ImproveSuggestions []*ImproveSuggestion `json:"improveSuggestions"`
+	// ImproveSuggestionsTotal is the total number of improvement suggestions matching the query.
+	ImproveSuggestionsTotal int64 `json:"improveSuggestionsTotal"`
+	// DidYouMean contains alternative queries, when nothing matched the original one.
+	DidYouMean []string `json:"didYouMean,omitempty"`
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// ForumTag is a label attached to forum posts, to describe them and help with search. Tags are part of a moderated
+// taxonomy, and grouped by ForumTagCategory.
+type ForumTag struct {
+	// ID of the tag.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt stores the time at which the tag was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// Category of the tag.
+	Category ForumTagCategory `json:"category"`
+	// Slug is a unique, url-friendly identifier for the tag.
+	Slug string `json:"slug"`
+	// Name is the displayed name of the tag.
+	Name string `json:"name"`
+}
+
+// ForumTagCreate is the form used to create a new tag.
+type ForumTagCreate struct {
+	Category ForumTagCategory `json:"category"`
+	Slug     string           `json:"slug"`
+	Name     string           `json:"name"`
+}
+
+// ForumTagCategory groups tags by the aspect of the scene they describe.
+type ForumTagCategory string
+
+const (
+	// ForumTagCategoryGenre describes the literary genre of the scene (fantasy, romance, etc.).
+	ForumTagCategoryGenre ForumTagCategory = "genre"
+	// ForumTagCategoryPOV describes the narrative point of view of the scene.
+	ForumTagCategoryPOV ForumTagCategory = "pov"
+	// ForumTagCategoryTense describes the grammatical tense the scene is written in.
+	ForumTagCategoryTense ForumTagCategory = "tense"
+	// ForumTagCategorySceneType describes the nature of the scene (dialogue, action, etc.).
+	ForumTagCategorySceneType ForumTagCategory = "scene_type"
+)
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// VoteRingFlag is raised when a recently created account up voted several posts of an author, along with enough
+// other recent accounts to look like a voting ring. The votes are kept until a moderator reviews the flag.
+type VoteRingFlag struct {
+	// AuthorID is the ID of the user who received the suspicious votes.
+	AuthorID uuid.UUID `json:"authorID"`
+	// VoterID is the ID of the suspected sockpuppet.
+	VoterID uuid.UUID `json:"voterID"`
+	// CreatedAt stores the time at which the flag was raised.
+	CreatedAt time.Time `json:"createdAt"`
+	// Votes is the number of posts of the author the voter up voted, when the flag was raised.
+	Votes int64 `json:"votes"`
+
+	// ReviewedAt stores the time at which a moderator reviewed the flag. It is nil while the flag is pending.
+	ReviewedAt *time.Time `json:"reviewedAt,omitempty"`
+	// ReviewedBy is the ID of the moderator who reviewed the flag.
+	ReviewedBy *uuid.UUID `json:"reviewedBy,omitempty"`
+	// Voided is true if the moderator removed the votes of the voter on the posts of the author.
+	Voided bool `json:"voided"`
+}
+
+// VoteRingDetection configures the thresholds used to detect voting rings.
+type VoteRingDetection struct {
+	// Since ignores the votes last updated before this time.
+	Since time.Time
+	// MaxAccountAge is the maximum age of an account, at the time it voted, to be considered new.
+	MaxAccountAge time.Duration
+	// MinVotes is the number of posts of the same author a new account must have up voted to be suspicious.
+	MinVotes int
+	// MinVoters is the number of suspicious voters an author must gather for their flags to be raised.
+	MinVoters int
+}
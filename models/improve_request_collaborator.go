@@ -0,0 +1,34 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// ImproveRequestCollaboratorRole sets what a co-author can do on an ImproveRequest.
+type ImproveRequestCollaboratorRole string
+
+const (
+	// ImproveRequestCollaboratorRoleOwner has the same rights as the creator of the request: publishing and
+	// deleting revisions, and managing visibility and collaborators. The creator is always an owner.
+	ImproveRequestCollaboratorRoleOwner ImproveRequestCollaboratorRole = "owner"
+	// ImproveRequestCollaboratorRoleEditor can publish new revisions of the request.
+	ImproveRequestCollaboratorRoleEditor ImproveRequestCollaboratorRole = "editor"
+)
+
+// ImproveRequestCollaborator is a co-author of an improvement request.
+type ImproveRequestCollaborator struct {
+	// Source is the ID of the first revision of the request.
+	Source uuid.UUID `json:"source"`
+	// UserID is the ID of the collaborator.
+	UserID uuid.UUID `json:"userID"`
+	// Role of the collaborator.
+	Role ImproveRequestCollaboratorRole `json:"role"`
+	// InvitedBy is the ID of the user who sent the invitation.
+	InvitedBy uuid.UUID `json:"invitedBy"`
+	// CreatedAt stores the time at which the user was invited.
+	CreatedAt time.Time `json:"createdAt"`
+	// AcceptedAt stores the time at which the user accepted the invitation. It is nil while the invitation is
+	// pending, and the user has no rights on the request until then.
+	AcceptedAt *time.Time `json:"acceptedAt"`
+}
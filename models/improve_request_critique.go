@@ -0,0 +1,48 @@
+package models
+
+import "github.com/google/uuid"
+
+// CritiqueAspect is a facet of a scene, the owners of an ImproveRequest can ask feedback on.
+type CritiqueAspect string
+
+const (
+	// CritiqueAspectPacing is about the rhythm of the scene.
+	CritiqueAspectPacing CritiqueAspect = "pacing"
+	// CritiqueAspectDialogue is about the lines of the characters.
+	CritiqueAspectDialogue CritiqueAspect = "dialogue"
+	// CritiqueAspectPOV is about the point of view the scene is told from.
+	CritiqueAspectPOV CritiqueAspect = "pov"
+	// CritiqueAspectGrammar is about spelling, grammar and syntax.
+	CritiqueAspectGrammar CritiqueAspect = "grammar"
+	// CritiqueAspectTone is about the mood conveyed by the scene.
+	CritiqueAspectTone CritiqueAspect = "tone"
+)
+
+// ImproveSuggestionRating is the rating given by a suggester on one aspect of the improvement request.
+type ImproveSuggestionRating struct {
+	// Aspect that is rated. It must be one of the aspects requested by the owners of the request.
+	Aspect CritiqueAspect `json:"aspect"`
+	// Rating goes from 1 (needs a lot of work) to 5 (nothing to improve).
+	Rating int `json:"rating"`
+	// Note is an optional comment, explaining the rating.
+	Note string `json:"note"`
+}
+
+// ImproveRequestAspectSummary aggregates the ratings received by an improvement request, on one aspect.
+type ImproveRequestAspectSummary struct {
+	Aspect CritiqueAspect `json:"aspect"`
+	// Average of the ratings received on the aspect.
+	Average float64 `json:"average"`
+	// Count is the number of suggestions that rated the aspect.
+	Count int64 `json:"count"`
+}
+
+// ImproveRequestCritique gathers the structured feedback of an improvement request.
+type ImproveRequestCritique struct {
+	// Source is the ID of the first revision of the request.
+	Source uuid.UUID `json:"source"`
+	// Aspects the owners of the request want feedback on. Suggestions can only rate those aspects.
+	Aspects []CritiqueAspect `json:"aspects"`
+	// Ratings summarizes the ratings of the suggestions, for each aspect that was rated at least once.
+	Ratings []*ImproveRequestAspectSummary `json:"ratings"`
+}
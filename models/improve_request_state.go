@@ -0,0 +1,37 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// ImproveRequestState controls which interactions are still possible on an ImproveRequest thread.
+type ImproveRequestState string
+
+const (
+	// ImproveRequestStateOpen threads accept new suggestions, edits and votes.
+	ImproveRequestStateOpen ImproveRequestState = "open"
+	// ImproveRequestStateClosed threads no longer accept new suggestions. Existing suggestions can still be edited,
+	// and voted on.
+	ImproveRequestStateClosed ImproveRequestState = "closed"
+	// ImproveRequestStateLocked threads were frozen by a moderator. Nothing can be posted, edited or voted on until
+	// a moderator unlocks them.
+	ImproveRequestStateLocked ImproveRequestState = "locked"
+	// ImproveRequestStateArchived threads are read-only.
+	ImproveRequestStateArchived ImproveRequestState = "archived"
+)
+
+// ImproveRequestThreadState is the current state of an improvement request thread.
+type ImproveRequestThreadState struct {
+	// Source is the ID of the first revision of the request.
+	Source uuid.UUID `json:"source"`
+	// State of the thread.
+	State ImproveRequestState `json:"state"`
+	// UpdatedAt stores the time at which the state was last changed. It is nil if the thread was never closed.
+	UpdatedAt *time.Time `json:"updatedAt"`
+	// UpdatedBy is the ID of the user who last changed the state. It is nil when the thread was closed
+	// automatically.
+	UpdatedBy *uuid.UUID `json:"updatedBy"`
+	// Reason is an optional message, explaining the last change.
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// ImproveRequestVisibility restricts who can see an ImproveRequest, and all its revisions.
+type ImproveRequestVisibility string
+
+const (
+	// ImproveRequestVisibilityPublic requests are listed in searches, and readable by anyone.
+	ImproveRequestVisibilityPublic ImproveRequestVisibility = "public"
+	// ImproveRequestVisibilityUnlisted requests never appear in searches. They are readable by their authors,
+	// invited users, and anyone with a valid ImproveRequestShareLink.
+	ImproveRequestVisibilityUnlisted ImproveRequestVisibility = "unlisted"
+	// ImproveRequestVisibilityRestricted requests never appear in searches. They are only readable by their authors
+	// and invited users.
+	ImproveRequestVisibilityRestricted ImproveRequestVisibility = "restricted"
+)
+
+// ImproveRequestAccess is the visibility of an improvement request.
+type ImproveRequestAccess struct {
+	// Source is the ID of the first revision of the request.
+	Source uuid.UUID `json:"source"`
+	// Visibility of the request. It defaults to ImproveRequestVisibilityPublic.
+	Visibility ImproveRequestVisibility `json:"visibility"`
+	// UpdatedAt stores the time at which the visibility was last changed. It is nil if the visibility was never
+	// changed.
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+// ImproveRequestInvite allows a user to read an improvement request that is not public.
+type ImproveRequestInvite struct {
+	// Source is the ID of the first revision of the request.
+	Source uuid.UUID `json:"source"`
+	// UserID is the ID of the invited user.
+	UserID uuid.UUID `json:"userID"`
+	// CreatedAt stores the time at which the user was invited.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// ImproveRequestShareToken grants read access to an unlisted improvement request. The secret part of the token
+// is never returned after creation.
+type ImproveRequestShareToken struct {
+	// ID of the token.
+	ID uuid.UUID `json:"id"`
+	// Source is the ID of the first revision of the request.
+	Source uuid.UUID `json:"source"`
+	// CreatedAt stores the time at which the token was created.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// ImproveRequestShareLink is returned once, when a new ImproveRequestShareToken is created.
+type ImproveRequestShareLink struct {
+	ImproveRequestShareToken
+	// Token is the value to share with readers. It must be sent along read requests.
+	Token string `json:"token"`
+}
@@ -25,6 +25,8 @@ type ImproveRequest struct {
 	Title string `json:"title"`
 	// Content is a novel scene that the user wants to improve.
 	Content string `json:"content"`
+	// Language is the code of the language the request is written in (eg. "fr").
+	Language string `json:"language"`
 
 	// UpVotes is the number of up votes the request has received. This value is indirectly updated from the
 	// votes table.
@@ -32,6 +34,12 @@ type ImproveRequest struct {
 	// DownVotes is the number of down votes the request has received. This value is indirectly updated from the
 	// votes table.
 	DownVotes int64 `json:"downVotes"`
+
+	// Tags are the IDs of the ForumTag attached to the current revision.
+	Tags []uuid.UUID `json:"tags"`
+
+	// DeletedAt is only set on deleted revisions, that can still be restored.
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
 }
 
 // ImproveRequestPreview merges together different metrics about an improvement request, for display in a preview
@@ -59,6 +67,11 @@ type ImproveRequestPreview struct {
 	// votes table.
 	DownVotes int64 `json:"downVotes"`
 
+	// Tags are the IDs of the ForumTag attached to the current revision.
+	Tags []uuid.UUID `json:"tags"`
+	// Reactions counts the reactions received by every revision of the request, by reaction.
+	Reactions map[string]int64 `json:"reactions"`
+
 	// RevisionCount is the number of revisions the request has.
 	RevisionCount int64 `json:"revisionCount"`
 	// MoreRecentRevisions is the number of revisions that were created after the current one.
@@ -76,6 +89,11 @@ type ImproveRequestSearchOrder struct {
 	Created bool `json:"created"`
 	// Score puts requests with the highest score first.
 	Score bool `json:"score"`
+	// Best puts requests with the most reliably positive votes first. Unlike Score, it does not favour requests
+	// that simply had more time to gather votes.
+	Best bool `json:"best"`
+	// Hot puts requests with the most recent activity (votes and suggestions) first.
+	Hot bool `json:"hot"`
 }
 
 // ImproveRequestSearch allows to filter improve requests.
@@ -84,6 +102,100 @@ type ImproveRequestSearch struct {
 	UserID *uuid.UUID `json:"userID"`
 	// Query is an optional parameter, to filter requests based on their title or content.
 	Query string `json:"query"`
+	// Tags is an optional parameter, to only target requests that have all the given tags.
+	Tags []uuid.UUID `json:"tags"`
+	// Reactions is an optional parameter, to only target requests that received all the given reactions.
+	Reactions []string `json:"reactions"`
+	// States is an optional parameter, to only target threads in one of the given states.
+	States []ImproveRequestState `json:"states"`
 	// Order is an optional parameter, to order requests based on a specific criteria.
 	Order *ImproveRequestSearchOrder `json:"order"`
+	// Language is an optional parameter, to interpret the Query in a given language. It accepts either a
+	// language code or a locale (eg. "en-US,en;q=0.9"), and falls back to the default language.
+	Language string `json:"language"`
+}
+
+// ImproveRequestSearchFacets contains aggregated counts over the whole set of results of a search, regardless of
+// pagination.
+type ImproveRequestSearchFacets struct {
+	Tags []*ImproveRequestTagFacet `json:"tags"`
+	// Authors only lists the most active authors among the results.
+	Authors   []*ImproveRequestAuthorFacet `json:"authors"`
+	Accepted  ImproveRequestAcceptedFacet  `json:"accepted"`
+	CreatedAt ImproveRequestCreatedAtFacet `json:"createdAt"`
+	Revisions ImproveRequestRevisionsFacet `json:"revisions"`
+}
+
+// ImproveRequestTagFacet counts the search results that carry a given ForumTag.
+type ImproveRequestTagFacet struct {
+	ID       uuid.UUID        `json:"id"`
+	Category ForumTagCategory `json:"category"`
+	Slug     string           `json:"slug"`
+	Name     string           `json:"name"`
+	Count    int64            `json:"count"`
+}
+
+// ImproveRequestAuthorFacet counts the search results created by a given user.
+type ImproveRequestAuthorFacet struct {
+	UserID uuid.UUID `json:"userID"`
+	Count  int64     `json:"count"`
+}
+
+// ImproveRequestAcceptedFacet splits the search results, depending on whether they have at least one accepted
+// suggestion.
+type ImproveRequestAcceptedFacet struct {
+	With    int64 `json:"with"`
+	Without int64 `json:"without"`
+}
+
+// ImproveRequestCreatedAtFacet splits the search results into exclusive creation date buckets, relative to the
+// time of the search.
+type ImproveRequestCreatedAtFacet struct {
+	LastDay   int64 `json:"lastDay"`
+	LastWeek  int64 `json:"lastWeek"`
+	LastMonth int64 `json:"lastMonth"`
+	LastYear  int64 `json:"lastYear"`
+	Older     int64 `json:"older"`
+}
+
+// ImproveRequestRevisionsFacet splits the search results based on their number of revisions.
+type ImproveRequestRevisionsFacet struct {
+	// Single counts the requests that were never revised.
+	Single int64 `json:"single"`
+	// Few counts the requests with a handful of revisions.
+	Few int64 `json:"few"`
+	// Many counts the requests that were heavily revised.
+	Many int64 `json:"many"`
+}
+
+// ImproveRequestDraft is an improvement request that is not published yet. Drafts are only visible to their author,
+// and never appear in searches.
+type ImproveRequestDraft struct {
+	// ID of the draft. The published request keeps the same ID.
+	ID uuid.UUID `json:"id"`
+	// UserID is the ID of the author of the draft.
+	UserID uuid.UUID `json:"userID"`
+	// CreatedAt stores the time at which the draft was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt stores the time at which the draft was last saved.
+	UpdatedAt *time.Time `json:"updatedAt"`
+
+	Title    string      `json:"title"`
+	Content  string      `json:"content"`
+	Language string      `json:"language"`
+	Tags     []uuid.UUID `json:"tags"`
+
+	// PublishAt is the time at which the draft is automatically published. It is nil when the author publishes
+	// the draft manually.
+	PublishAt *time.Time `json:"publishAt"`
+}
+
+// ImproveRequestDraftUpsert is the form used to save a draft. Title and content may be incomplete, unless a
+// publication time is set.
+type ImproveRequestDraftUpsert struct {
+	Title     string      `json:"title"`
+	Content   string      `json:"content"`
+	Language  string      `json:"language"`
+	Tags      []uuid.UUID `json:"tags"`
+	PublishAt *time.Time  `json:"publishAt"`
 }
@@ -28,6 +28,8 @@ type ImproveSuggestion struct {
 	UserID uuid.UUID `json:"userID"`
 	// Validated is true if the suggestion has been validated by the improvement request creator.
 	Validated bool `json:"validated"`
+	// RevisionID is the ID of the ImproveSuggestionRevision that holds the current content of the suggestion.
+	RevisionID uuid.UUID `json:"revisionID"`
 
 	// UpVotes is the number of up votes the suggestion has received. This value is indirectly updated from the
 	// votes table.
@@ -35,6 +37,9 @@ type ImproveSuggestion struct {
 	// DownVotes is the number of down votes the suggestion has received. This value is indirectly updated from the
 	// votes table.
 	DownVotes int64 `json:"downVotes"`
+	// Reactions counts the reactions the suggestion has received, by reaction. It is only set when the
+	// suggestion is listed.
+	Reactions map[string]int64 `json:"reactions,omitempty"`
 
 	// RequestID is the ID of the improvement request revision the suggestion is tied to. It must point to a revision
 	// of the improvement request with the SourceID.
@@ -43,6 +48,33 @@ type ImproveSuggestion struct {
 	Title string `json:"title"`
 	// Content contains the updated content of the source request.
 	Content string `json:"content"`
+
+	// DeletedAt is set when the suggestion was deleted. Deleted suggestions are listed in their thread as
+	// tombstones, without Title or Content.
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+}
+
+// ImproveSuggestionRevision is an immutable version of the content of an ImproveSuggestion. A new revision is
+// created every time the suggestion is created or updated.
+type ImproveSuggestionRevision struct {
+	// ID of the revision.
+	ID uuid.UUID `json:"id"`
+	// SuggestionID is the ID of the suggestion this revision belongs to.
+	SuggestionID uuid.UUID `json:"suggestionID"`
+	// CreatedAt stores the time at which the revision was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UpVotes is the number of up votes that were last cast on this revision.
+	UpVotes int64 `json:"upVotes"`
+	// DownVotes is the number of down votes that were last cast on this revision.
+	DownVotes int64 `json:"downVotes"`
+
+	// RequestID is the ID of the improvement request revision the suggestion was tied to, in this revision.
+	RequestID uuid.UUID `json:"requestID"`
+	// Title of the suggestion, in this revision.
+	Title string `json:"title"`
+	// Content of the suggestion, in this revision.
+	Content string `json:"content"`
 }
 
 // ImproveSuggestionUpsert is the data required to create or update an improvement suggestion.
@@ -77,6 +109,13 @@ type ImproveSuggestionsList struct {
 	// Validated is an optional parameter, to only target suggestions that have been validated by the improvement
 	// request creator.
 	Validated *bool `json:"validated"`
+	// Reactions is an optional parameter, to only target suggestions that received all the given reactions.
+	Reactions []string `json:"reactions"`
+	// Query is an optional parameter, to filter suggestions based on their title or content.
+	Query string `json:"query"`
 	// Order is an optional parameter, to order suggestions based on a specific criteria.
 	Order *ImproveSuggestionSearchOrder `json:"order"`
+	// Language is an optional parameter, to interpret the Query in a given language. It accepts either a
+	// language code or a locale, and falls back to the default language.
+	Language string `json:"language"`
 }
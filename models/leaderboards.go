@@ -0,0 +1,35 @@
+package models
+
+import "github.com/google/uuid"
+
+// Leaderboard is the ranking criteria of a forum leaderboard.
+type Leaderboard string
+
+const (
+	// LeaderboardAcceptedSuggestions ranks users by their number of accepted suggestions.
+	LeaderboardAcceptedSuggestions Leaderboard = "accepted_suggestions"
+	// LeaderboardSuggestionScore ranks users by the net score of the votes on their suggestions.
+	LeaderboardSuggestionScore Leaderboard = "suggestion_score"
+	// LeaderboardRequests ranks improvement requests by the net score of the votes on their revisions.
+	LeaderboardRequests Leaderboard = "requests"
+)
+
+// LeaderboardPeriod is the time window a leaderboard covers.
+type LeaderboardPeriod string
+
+const (
+	// LeaderboardPeriodMonth only accounts for the activity since the beginning of the current month.
+	LeaderboardPeriodMonth LeaderboardPeriod = "month"
+	// LeaderboardPeriodAllTime accounts for the whole activity of the forum.
+	LeaderboardPeriodAllTime LeaderboardPeriod = "all_time"
+)
+
+// LeaderboardEntry is a ranked user, or improvement request, on a leaderboard.
+type LeaderboardEntry struct {
+	// Rank starts at 1. Entries with the same score share the same rank.
+	Rank int64 `json:"rank"`
+	// TargetID is the ID of the user for the suggestion leaderboards, and the source of the improvement request
+	// for LeaderboardRequests.
+	TargetID uuid.UUID `json:"targetID"`
+	Score    int64     `json:"score"`
+}
@@ -70,6 +70,8 @@ type UserPublicPreview struct {
 	LastName string `json:"lastName"`
 	// CreatedAt stores the time at which the user was created.
 	CreatedAt time.Time `json:"createdAt"`
+	// Reputation is earned by helping other users on the forum, and lost when reported content is upheld by moderators.
+	Reputation int64 `json:"reputation"`
 }
 
 // UserPreview is the user data available to the user itself.
@@ -195,4 +197,6 @@ const (
 	// UserAuthorizationsAccountValidated is a special authorization, set once the user validated its account at least
 	// once.
 	UserAuthorizationsAccountValidated = "account-validated"
+	// UserAuthorizationsModerator is granted to users in charge of moderating the community content.
+	UserAuthorizationsModerator = "moderator"
 )
@@ -19,6 +19,8 @@ type UserCredentials struct {
 
 	// Validated indicates whether the main email (Email) is validated or not, for the current user.
 	Validated bool `json:"validated"`
+	// Moderator indicates whether the user has access to moderation tools.
+	Moderator bool `json:"moderator"`
 }
 
 // UserCredentialsRegistrationForm represents the parsed data struct, containing useful post-registration data.
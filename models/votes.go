@@ -23,6 +23,9 @@ type Vote struct {
 	Target VoteTarget `json:"target"`
 	// Vote is the value of the vote.
 	Vote VoteValue `json:"vote"`
+	// RevisionID is the ID of the revision the vote was cast on, for targets that keep a revision history
+	// (VoteTargetImproveSuggestion).
+	RevisionID *uuid.UUID `json:"revisionID"`
 }
 
 // VotedPost represents a post voted by a user for a specific target.
@@ -33,6 +36,9 @@ type VotedPost struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 	// Vote is the value of the vote.
 	Vote VoteValue `json:"vote"`
+	// RevisionID is the ID of the revision the vote was cast on, for targets that keep a revision history
+	// (VoteTargetImproveSuggestion).
+	RevisionID *uuid.UUID `json:"revisionID"`
 }
 
 // VoteTarget specifies the target table of the vote.
@@ -57,3 +63,27 @@ const (
 	// undo an existing vote.
 	NoVote VoteValue = ""
 )
+
+// VoteCounterDrift is a post which stored vote counters do not match the votes cast on it.
+type VoteCounterDrift struct {
+	// PostID is the ID of the post.
+	PostID uuid.UUID `json:"postID"`
+	// UpVotes is the stored amount of up votes. It is nil when the counter was never initialized.
+	UpVotes *int64 `json:"upVotes"`
+	// DownVotes is the stored amount of down votes. It is nil when the counter was never initialized.
+	DownVotes *int64 `json:"downVotes"`
+	// ExpectedUpVotes is the actual amount of up votes cast on the post.
+	ExpectedUpVotes int64 `json:"expectedUpVotes"`
+	// ExpectedDownVotes is the actual amount of down votes cast on the post.
+	ExpectedDownVotes int64 `json:"expectedDownVotes"`
+}
+
+// VoteCountersReport summarizes the reconciliation of the vote counters of a target.
+type VoteCountersReport struct {
+	// Target is the table which counters were reconciled.
+	Target VoteTarget `json:"target"`
+	// Scanned is the number of posts checked.
+	Scanned int64 `json:"scanned"`
+	// Repaired is the number of posts which counters had drifted, and were fixed.
+	Repaired int64 `json:"repaired"`
+}